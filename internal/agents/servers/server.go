@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/signal"
 	"sync"
+	"sync/atomic"
 	"syscall"
 
 	"go.uber.org/zap"
@@ -29,6 +30,7 @@ import (
 	aziservices "github.com/permguard/permguard/internal/agents/services"
 	azservices "github.com/permguard/permguard/pkg/agents/services"
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 )
 
@@ -39,6 +41,10 @@ type Server struct {
 	startLock   sync.Mutex
 	stopChannel chan os.Signal
 	logger      *zap.Logger
+	metrics     *httpServer
+	health      *httpServer
+	tracing     func(context.Context) error
+	ready       atomic.Bool
 }
 
 // newServer creates a new server.
@@ -72,8 +78,11 @@ func (s *Server) GetLogger() *zap.Logger {
 // serveExecStop executes the server stop.
 func serveExecStop(ctx context.Context, logger *zap.Logger, hasStarted bool, host *aziservices.Host, onShutdown func(), s *Server) {
 	logger.Info("Bootstrapper is stopping the server")
+	s.ready.Store(false)
 	if hasStarted {
-		done, err := host.GracefulStop(ctx)
+		drainCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.config.GetShutdownTimeout())
+		done, err := host.GracefulStop(drainCtx)
+		cancel()
 		if err != nil {
 			logger.Error("Bootstrapper could not execute the GracefulStop successfully", zap.Error(err))
 		}
@@ -81,6 +90,26 @@ func serveExecStop(ctx context.Context, logger *zap.Logger, hasStarted bool, hos
 			logger.Error("Bootstrapper could not execute the GracefulStop successfully", zap.Error(err))
 		}
 	}
+	stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.config.GetShutdownTimeout())
+	defer cancel()
+	if s.health != nil {
+		if err := s.health.Shutdown(stopCtx); err != nil {
+			logger.Error("Bootstrapper could not stop the health server", zap.Error(err))
+		}
+		s.health = nil
+	}
+	if s.metrics != nil {
+		if err := s.metrics.Shutdown(stopCtx); err != nil {
+			logger.Error("Bootstrapper could not stop the metrics server", zap.Error(err))
+		}
+		s.metrics = nil
+	}
+	if s.tracing != nil {
+		if err := s.tracing(stopCtx); err != nil {
+			logger.Error("Bootstrapper could not stop the tracing", zap.Error(err))
+		}
+		s.tracing = nil
+	}
 	if onShutdown != nil {
 		logger.Info("Bootstrapper is calling onshutdown")
 		onShutdown()
@@ -120,6 +149,24 @@ func (s *Server) Serve(ctx context.Context, onShutdown func()) (bool, error) {
 		return false, err
 	}
 
+	tracing, err := aztelemetry.SetupTracing(ctx, s.config.GetTracing())
+	if err != nil {
+		logger.Error("Bootstrapper cannot setup the tracing", zap.Error(err))
+		s.startLock.Unlock()
+		return false, err
+	}
+	s.tracing = tracing
+
+	if s.config.IsMetricsEnabled() {
+		metrics := newMetricsServer(logger, s.config.GetMetricsPort())
+		if err := metrics.Serve(); err != nil {
+			logger.Error("Bootstrapper cannot serve the metrics", zap.Error(err))
+			s.startLock.Unlock()
+			return false, err
+		}
+		s.metrics = metrics
+	}
+
 	stopChannel := make(chan os.Signal, 1)
 	signal.Notify(stopChannel, os.Interrupt, syscall.SIGTERM)
 	s.stopChannel = stopChannel
@@ -136,8 +183,20 @@ func (s *Server) Serve(ctx context.Context, onShutdown func()) (bool, error) {
 		serveExecStop(ctx, logger, hasStarted, host, onShutdown, s)
 	}
 
+	if hasStarted && s.config.IsHealthEnabled() {
+		health := newHealthServer(logger, s.config.GetHealthPort(), s, host)
+		if err := health.Serve(); err != nil {
+			logger.Error("Bootstrapper cannot serve the health endpoints", zap.Error(err))
+			stop()
+			s.startLock.Unlock()
+			return false, err
+		}
+		s.health = health
+	}
+
 	if hasStarted {
 		s.started = true
+		s.ready.Store(true)
 		s.startLock.Unlock()
 
 		select {
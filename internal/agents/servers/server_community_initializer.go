@@ -26,6 +26,9 @@ import (
 	azservers "github.com/permguard/permguard/pkg/agents/servers"
 	azservices "github.com/permguard/permguard/pkg/agents/services"
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azplugincedar "github.com/permguard/permguard/plugin/languages/cedar"
+	azpluginrbacyaml "github.com/permguard/permguard/plugin/languages/rbacyaml"
 	azisqlite "github.com/permguard/permguard/plugin/storage/sqlite"
 )
 
@@ -59,6 +62,13 @@ Copyright © 2022 Nitro Agility S.r.l.
 	if !host.IsValid(hosts) {
 		panic(fmt.Sprintf("server: invalid server kind: %s", host))
 	}
+	languageRegistry := azlang.DefaultLanguageRegistry()
+	if err := azplugincedar.RegisterCedarLanguage(languageRegistry); err != nil {
+		return nil, err
+	}
+	if err := azpluginrbacyaml.RegisterRBACYAMLLanguage(languageRegistry); err != nil {
+		return nil, err
+	}
 	return &CommunityServerInitializer{
 		host:      host,
 		hostInfos: hostInfos,
@@ -18,6 +18,7 @@ package servers
 
 import (
 	"flag"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -25,13 +26,24 @@ import (
 	azvalidators "github.com/permguard/permguard-common/pkg/extensions/validators"
 	azservices "github.com/permguard/permguard/pkg/agents/services"
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 )
 
 const (
-	flagPrefixServer  = "server"
-	flagSuffixAppData = "appdata"
+	flagPrefixServer              = "server"
+	flagSuffixAppData             = "appdata"
+	flagSuffixMetricsEnabled      = "metrics-enabled"
+	flagSuffixMetricsPort         = "metrics-port"
+	flagSuffixTracingExporter     = "tracing-exporter"
+	flagSuffixTracingOTLPEndpoint = "tracing-otlp-endpoint"
+	flagSuffixTracingOTLPInsecure = "tracing-otlp-insecure"
+	flagSuffixTracingFile         = "tracing-file"
+	flagSuffixTracingSampleRatio  = "tracing-sample-ratio"
+	flagSuffixHealthEnabled       = "health-enabled"
+	flagSuffixHealthPort          = "health-port"
+	flagSuffixShutdownTimeout     = "shutdown-timeout"
 )
 
 // ServerConfig holds the configuration for the server.
@@ -40,6 +52,12 @@ type ServerConfig struct {
 	debug                bool
 	logLevel             string
 	appData              string
+	metricsEnabled       bool
+	metricsPort          int
+	tracing              aztelemetry.TracingConfig
+	healthEnabled        bool
+	healthPort           int
+	shutdownTimeout      time.Duration
 	centralStorageEngine azstorage.StorageKind
 	storages             []azstorage.StorageKind
 	storagesFactories    map[azstorage.StorageKind]azstorage.StorageFactoryProvider
@@ -97,6 +115,36 @@ func (c *ServerConfig) GetAppData() string {
 	return c.appData
 }
 
+// IsMetricsEnabled returns true if the metrics endpoint is enabled.
+func (c *ServerConfig) IsMetricsEnabled() bool {
+	return c.metricsEnabled
+}
+
+// GetMetricsPort returns the port of the metrics endpoint.
+func (c *ServerConfig) GetMetricsPort() int {
+	return c.metricsPort
+}
+
+// GetTracing returns the tracing configuration.
+func (c *ServerConfig) GetTracing() aztelemetry.TracingConfig {
+	return c.tracing
+}
+
+// IsHealthEnabled returns true if the health endpoints are enabled.
+func (c *ServerConfig) IsHealthEnabled() bool {
+	return c.healthEnabled
+}
+
+// GetHealthPort returns the port of the health endpoints.
+func (c *ServerConfig) GetHealthPort() int {
+	return c.healthPort
+}
+
+// GetShutdownTimeout returns the maximum time to wait for the in-flight requests on shutdown.
+func (c *ServerConfig) GetShutdownTimeout() time.Duration {
+	return c.shutdownTimeout
+}
+
 // AddFlags adds flags.
 func (c *ServerConfig) AddFlags(flagSet *flag.FlagSet) error {
 	err := azoptions.AddFlagsForCommon(flagSet)
@@ -104,6 +152,16 @@ func (c *ServerConfig) AddFlags(flagSet *flag.FlagSet) error {
 		return err
 	}
 	flagSet.String(azoptions.FlagName(flagPrefixServer, flagSuffixAppData), "./", "directory to be used as zone data")
+	flagSet.Bool(azoptions.FlagName(flagPrefixServer, flagSuffixMetricsEnabled), false, "enable the prometheus metrics endpoint")
+	flagSet.Int(azoptions.FlagName(flagPrefixServer, flagSuffixMetricsPort), 9095, "port to be used for exposing the prometheus metrics endpoint")
+	flagSet.String(azoptions.FlagName(flagPrefixServer, flagSuffixTracingExporter), aztelemetry.TracingExporterNone, "exporter to be used for the traces (none, otlp, stdout, file)")
+	flagSet.String(azoptions.FlagName(flagPrefixServer, flagSuffixTracingOTLPEndpoint), "localhost:4317", "endpoint of the otlp grpc collector")
+	flagSet.Bool(azoptions.FlagName(flagPrefixServer, flagSuffixTracingOTLPInsecure), false, "disable the transport security of the otlp grpc collector connection")
+	flagSet.String(azoptions.FlagName(flagPrefixServer, flagSuffixTracingFile), "permguard-traces.json", "file to be used by the file traces exporter")
+	flagSet.Float64(azoptions.FlagName(flagPrefixServer, flagSuffixTracingSampleRatio), 1.0, "ratio of the traces to be sampled")
+	flagSet.Bool(azoptions.FlagName(flagPrefixServer, flagSuffixHealthEnabled), false, "enable the http health and readiness endpoints")
+	flagSet.Int(azoptions.FlagName(flagPrefixServer, flagSuffixHealthPort), 9096, "port to be used for exposing the http health and readiness endpoints")
+	flagSet.Int(azoptions.FlagName(flagPrefixServer, flagSuffixShutdownTimeout), 30, "maximum number of seconds to wait for the in-flight requests on shutdown")
 	for _, fcty := range c.storagesFactories {
 		config, _ := fcty.GetFactoryConfig()
 		err = config.AddFlags(flagSet)
@@ -133,6 +191,38 @@ func (c *ServerConfig) InitFromViper(v *viper.Viper) error {
 	if !azvalidators.IsValidPath(c.appData) {
 		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid zone data directory")
 	}
+	c.metricsEnabled = v.GetBool(azoptions.FlagName(flagPrefixServer, flagSuffixMetricsEnabled))
+	c.metricsPort = v.GetInt(azoptions.FlagName(flagPrefixServer, flagSuffixMetricsPort))
+	if c.metricsEnabled && !azvalidators.IsValidPort(c.metricsPort) {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid metrics port")
+	}
+	c.tracing = aztelemetry.TracingConfig{
+		Exporter:     v.GetString(azoptions.FlagName(flagPrefixServer, flagSuffixTracingExporter)),
+		OTLPEndpoint: v.GetString(azoptions.FlagName(flagPrefixServer, flagSuffixTracingOTLPEndpoint)),
+		OTLPInsecure: v.GetBool(azoptions.FlagName(flagPrefixServer, flagSuffixTracingOTLPInsecure)),
+		FilePath:     v.GetString(azoptions.FlagName(flagPrefixServer, flagSuffixTracingFile)),
+		SampleRatio:  v.GetFloat64(azoptions.FlagName(flagPrefixServer, flagSuffixTracingSampleRatio)),
+		Host:         c.host.String(),
+	}
+	if !aztelemetry.IsValidTracingExporter(c.tracing.Exporter) {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid tracing exporter")
+	}
+	if c.tracing.SampleRatio < 0 || c.tracing.SampleRatio > 1 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid tracing sample ratio")
+	}
+	c.healthEnabled = v.GetBool(azoptions.FlagName(flagPrefixServer, flagSuffixHealthEnabled))
+	c.healthPort = v.GetInt(azoptions.FlagName(flagPrefixServer, flagSuffixHealthPort))
+	if c.healthEnabled && !azvalidators.IsValidPort(c.healthPort) {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid health port")
+	}
+	if c.healthEnabled && c.metricsEnabled && c.healthPort == c.metricsPort {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "health port and metrics port must be different")
+	}
+	shutdownTimeout := v.GetInt(azoptions.FlagName(flagPrefixServer, flagSuffixShutdownTimeout))
+	if shutdownTimeout <= 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid shutdown timeout")
+	}
+	c.shutdownTimeout = time.Duration(shutdownTimeout) * time.Second
 	for _, fcty := range c.storagesFactories {
 		config, err := fcty.GetFactoryConfig()
 		if err != nil {
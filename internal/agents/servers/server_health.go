@@ -0,0 +1,83 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	aziservices "github.com/permguard/permguard/internal/agents/services"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// healthzPath is the path of the liveness probe.
+	healthzPath = "/healthz"
+	// readyzPath is the path of the readiness probe.
+	readyzPath = "/readyz"
+	// healthCheckTimeout is the timeout of the storage checks of the probes.
+	healthCheckTimeout = 5 * time.Second
+
+	// healthStatusOK is the status of a successful probe.
+	healthStatusOK = "ok"
+	// healthStatusUnavailable is the status of a failed probe.
+	healthStatusUnavailable = "unavailable"
+)
+
+// healthResponse is the body of the probes responses.
+type healthResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// writeHealthResponse writes the probe response.
+func writeHealthResponse(w http.ResponseWriter, err error) {
+	response := healthResponse{Status: healthStatusOK}
+	statusCode := http.StatusOK
+	if err != nil {
+		response = healthResponse{Status: healthStatusUnavailable, Error: err.Error()}
+		statusCode = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// newHealthServer creates the http server exposing the liveness and readiness probes.
+func newHealthServer(logger *zap.Logger, port int, s *Server, host *aziservices.Host) *httpServer {
+	checkHealth := func(r *http.Request) error {
+		ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+		defer cancel()
+		return host.CheckHealth(ctx)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(healthzPath, func(w http.ResponseWriter, r *http.Request) {
+		writeHealthResponse(w, checkHealth(r))
+	})
+	mux.HandleFunc(readyzPath, func(w http.ResponseWriter, r *http.Request) {
+		if !s.ready.Load() {
+			writeHealthResponse(w, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "server is not ready to accept requests"))
+			return
+		}
+		writeHealthResponse(w, checkHealth(r))
+	})
+	return newHTTPServer(logger, "Health", port, mux)
+}
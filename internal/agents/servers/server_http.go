@@ -0,0 +1,76 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// httpReadHeaderTimeout is the timeout for reading the headers of the http requests.
+	httpReadHeaderTimeout = 5 * time.Second
+)
+
+// httpServer represents an http server exposing operational endpoints such as metrics and probes.
+type httpServer struct {
+	logger     *zap.Logger
+	name       string
+	port       int
+	httpServer *http.Server
+}
+
+// newHTTPServer creates a new http server.
+func newHTTPServer(logger *zap.Logger, name string, port int, handler http.Handler) *httpServer {
+	return &httpServer{
+		logger: logger,
+		name:   name,
+		port:   port,
+		httpServer: &http.Server{
+			Addr:              fmt.Sprintf(":%d", port),
+			Handler:           handler,
+			ReadHeaderTimeout: httpReadHeaderTimeout,
+		},
+	}
+}
+
+// Serve starts the http server.
+func (h *httpServer) Serve() error {
+	lis, err := net.Listen("tcp", h.httpServer.Addr)
+	if err != nil {
+		h.logger.Error(fmt.Sprintf("%s server cannot listen on port", h.name), zap.Int("port", h.port), zap.Error(err))
+		return err
+	}
+	go func() {
+		h.logger.Info(fmt.Sprintf("%s server is serving on port: %d", h.name, h.port))
+		if err := h.httpServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			h.logger.Error(fmt.Sprintf("%s server failed to serve on port: %d", h.name, h.port), zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the http server.
+func (h *httpServer) Shutdown(ctx context.Context) error {
+	return h.httpServer.Shutdown(ctx)
+}
@@ -23,9 +23,12 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	azservices "github.com/permguard/permguard/pkg/agents/services"
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
 )
 
 // EndpointConfig represents the endpoint configuration.
@@ -75,9 +78,10 @@ func (c *EndpointConfig) GetRegistration() func(*grpc.Server, *azservices.Servic
 
 // Endpoint represents the endpoint.
 type Endpoint struct {
-	config     *EndpointConfig
-	ctx        *azservices.EndpointContext
-	grpcServer *grpc.Server
+	config       *EndpointConfig
+	ctx          *azservices.EndpointContext
+	grpcServer   *grpc.Server
+	healthServer *health.Server
 }
 
 // newEndpoint creates a new grpcendpoint.
@@ -102,7 +106,9 @@ func (e *Endpoint) Serve(ctx context.Context, serviceCtx *azservices.ServiceCont
 	logger := e.getLogger()
 	logger.Debug("Endpoint is starting")
 	grpcServer := grpc.NewServer(
-		withServerUnaryInterceptor(e.ctx),
+		aztelemetry.GrpcServerStatsHandler(),
+		withServerUnaryInterceptor(e.config.GetService(), e.ctx),
+		withServerStreamInterceptor(e.config.GetService(), e.ctx),
 	)
 	e.grpcServer = grpcServer
 	port := e.config.GetPort()
@@ -111,6 +117,12 @@ func (e *Endpoint) Serve(ctx context.Context, serviceCtx *azservices.ServiceCont
 	if err != nil {
 		return false, err
 	}
+	healthServer := health.NewServer()
+	for serviceName := range grpcServer.GetServiceInfo() {
+		healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	e.healthServer = healthServer
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		logger.Error("Endpoint cannot listen on port", zap.Error(err))
@@ -134,11 +146,29 @@ func (e *Endpoint) Serve(ctx context.Context, serviceCtx *azservices.ServiceCont
 	return true, nil
 }
 
-// GracefulStop stops the grpcendpoint.
+// GracefulStop stops the grpcendpoint waiting for the in-flight requests and streams to complete until the context is done.
 func (e *Endpoint) GracefulStop(ctx context.Context) (bool, error) {
 	logger := e.getLogger()
 	logger.Debug("Endpoint is stopping")
-	e.grpcServer.GracefulStop()
-	logger.Debug("Endpoint has stopped")
-	return true, nil
+	if e.grpcServer == nil {
+		return true, nil
+	}
+	if e.healthServer != nil {
+		e.healthServer.Shutdown()
+	}
+	stopped := make(chan struct{})
+	go func() {
+		e.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		logger.Debug("Endpoint has stopped")
+		return true, nil
+	case <-ctx.Done():
+		logger.Warn("Endpoint could not drain the in-flight requests before the shutdown timeout")
+		e.grpcServer.Stop()
+		<-stopped
+		return false, nil
+	}
 }
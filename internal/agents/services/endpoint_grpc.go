@@ -24,26 +24,61 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	azservices "github.com/permguard/permguard/pkg/agents/services"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
 )
 
-// withServerUnaryInterceptor returns a grpc.ServerOption that adds a unary interceptor to the server.
-func withServerUnaryInterceptor(serviceCtx *azservices.EndpointContext) grpc.ServerOption {
-	return grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+// newServerUnaryInterceptor creates the unary interceptor recovering the panics and recording the request metrics.
+func newServerUnaryInterceptor(service azservices.ServiceKind, serviceCtx *azservices.EndpointContext) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (h any, err error) {
 		logger := serviceCtx.GetLogger()
+		start := time.Now()
 		defer func() {
-			if err := recover(); err != nil {
-				logger.Error(serviceCtx.GetLogMessage(fmt.Sprintf("Request generted a panic: %v stacktrace:%s", err, debug.Stack())))
+			if r := recover(); r != nil {
+				logger.Error(serviceCtx.GetLogMessage(fmt.Sprintf("Request generted a panic: %v stacktrace:%s", r, debug.Stack())))
+				h, err = nil, status.Error(codes.Internal, "request generated an internal error")
+			}
+			aztelemetry.ObserveGrpcRequest(service.String(), info.FullMethod, status.Code(err).String(), time.Since(start))
+			if err != nil {
+				logger.Error(serviceCtx.GetLogMessage(fmt.Sprintf("Request failed to be served - method:%s duration:%s error:%v", info.FullMethod, time.Since(start), err)), zap.Error(err))
+			} else {
+				logger.Debug(serviceCtx.GetLogMessage(fmt.Sprintf("Request - method:%s duration:%s", info.FullMethod, time.Since(start))), zap.Duration("duration", time.Since(start)))
 			}
 		}()
+		return handler(ctx, req)
+	}
+}
+
+// withServerUnaryInterceptor returns a grpc.ServerOption that adds a unary interceptor to the server.
+func withServerUnaryInterceptor(service azservices.ServiceKind, serviceCtx *azservices.EndpointContext) grpc.ServerOption {
+	return grpc.UnaryInterceptor(newServerUnaryInterceptor(service, serviceCtx))
+}
+
+// newServerStreamInterceptor creates the stream interceptor recovering the panics and recording the request metrics.
+func newServerStreamInterceptor(service azservices.ServiceKind, serviceCtx *azservices.EndpointContext) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		logger := serviceCtx.GetLogger()
 		start := time.Now()
-		h, err := handler(ctx, req)
-		if err != nil {
-			logger.Error(serviceCtx.GetLogMessage(fmt.Sprintf("Request failed to be served - method:%s duration:%s error:%v", info.FullMethod, time.Since(start), err)), zap.Error(err))
-		} else {
-			logger.Debug(serviceCtx.GetLogMessage(fmt.Sprintf("Request - method:%s duration:%s", info.FullMethod, time.Since(start))), zap.Duration("duration", time.Since(start)))
-		}
-		return h, err
-	})
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error(serviceCtx.GetLogMessage(fmt.Sprintf("Stream generated a panic: %v stacktrace:%s", r, debug.Stack())))
+				err = status.Error(codes.Internal, "stream generated an internal error")
+			}
+			aztelemetry.ObserveGrpcRequest(service.String(), info.FullMethod, status.Code(err).String(), time.Since(start))
+			if err != nil {
+				logger.Error(serviceCtx.GetLogMessage(fmt.Sprintf("Stream failed to be served - method:%s duration:%s error:%v", info.FullMethod, time.Since(start), err)), zap.Error(err))
+			} else {
+				logger.Debug(serviceCtx.GetLogMessage(fmt.Sprintf("Stream - method:%s duration:%s", info.FullMethod, time.Since(start))), zap.Duration("duration", time.Since(start)))
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// withServerStreamInterceptor returns a grpc.ServerOption that adds a stream interceptor to the server.
+func withServerStreamInterceptor(service azservices.ServiceKind, serviceCtx *azservices.EndpointContext) grpc.ServerOption {
+	return grpc.StreamInterceptor(newServerStreamInterceptor(service, serviceCtx))
 }
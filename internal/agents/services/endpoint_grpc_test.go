@@ -0,0 +1,76 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	azservices "github.com/permguard/permguard/pkg/agents/services"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
+)
+
+// newTestEndpointContext creates the endpoint context used by the interceptor tests.
+func newTestEndpointContext(t *testing.T) *azservices.EndpointContext {
+	hostCtx, err := azservices.NewHostContext(azservices.HostAllInOne, nil, zap.NewNop(), nil)
+	assert.Nil(t, err)
+	serviceCtx, err := azservices.NewServiceContext(hostCtx, azservices.ServicePDP, nil)
+	assert.Nil(t, err)
+	endpointCtx, err := azservices.NewEndpointContext(serviceCtx, 9094)
+	assert.Nil(t, err)
+	return endpointCtx
+}
+
+// scrapeMetrics returns the metrics exposed by the telemetry handler.
+func scrapeMetrics() string {
+	recorder := httptest.NewRecorder()
+	aztelemetry.MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	return recorder.Body.String()
+}
+
+// TestStreamInterceptorRecoversPanics tests that a panicking stream fails with an internal error and is recorded.
+func TestStreamInterceptorRecoversPanics(t *testing.T) {
+	assert := assert.New(t)
+	interceptor := newServerStreamInterceptor(azservices.ServicePDP, newTestEndpointContext(t))
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/PanickingStream"}
+	err := interceptor(nil, nil, info, func(srv any, stream grpc.ServerStream) error {
+		panic("stream failure")
+	})
+	assert.Equal(codes.Internal, status.Code(err), "a panicking stream should fail with an internal error")
+	assert.Contains(scrapeMetrics(), `method="/test.Service/PanickingStream"`, "a panicking stream should be recorded")
+}
+
+// TestUnaryInterceptorRecoversPanics tests that a panicking request fails with an internal error and is recorded.
+func TestUnaryInterceptorRecoversPanics(t *testing.T) {
+	assert := assert.New(t)
+	interceptor := newServerUnaryInterceptor(azservices.ServicePDP, newTestEndpointContext(t))
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/PanickingRequest"}
+	resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		panic("request failure")
+	})
+	assert.Nil(resp)
+	assert.Equal(codes.Internal, status.Code(err), "a panicking request should fail with an internal error")
+	assert.Contains(scrapeMetrics(), `method="/test.Service/PanickingRequest"`, "a panicking request should be recorded")
+}
@@ -24,6 +24,7 @@ import (
 	azcopier "github.com/permguard/permguard-common/pkg/extensions/copier"
 	azservices "github.com/permguard/permguard/pkg/agents/services"
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
 )
 
 // HostConfig represents the host configuration.
@@ -157,6 +158,14 @@ func (h *Host) Serve(ctx context.Context) (bool, error) {
 	return hasStarted, nil
 }
 
+// CheckHealth checks the storages used by the services of the host.
+func (h *Host) CheckHealth(ctx context.Context) error {
+	if len(h.services) == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "host has no services")
+	}
+	return h.config.GetStorageConnector().CheckHealth(ctx, h.services[0].ctx)
+}
+
 // GracefulStop stops the host.
 func (h *Host) GracefulStop(ctx context.Context) (bool, error) {
 	logger := h.getLogger()
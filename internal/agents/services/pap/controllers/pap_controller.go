@@ -54,6 +54,21 @@ func (s PAPController) UpdateLedger(ledger *azmodelspap.Ledger) (*azmodelspap.Le
 	return s.storage.UpdateLedger(ledger)
 }
 
+// UpdateLedgerCandidate updates the candidate ref of a ledger evaluated in shadow mode.
+func (s PAPController) UpdateLedgerCandidate(zoneID int64, ledgerID string, candidateRef string) (*azmodelspap.Ledger, error) {
+	return s.storage.UpdateLedgerCandidate(zoneID, ledgerID, candidateRef)
+}
+
+// PromoteLedgerCandidate promotes the candidate ref of a ledger to its active ref.
+func (s PAPController) PromoteLedgerCandidate(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error) {
+	return s.storage.PromoteLedgerCandidate(zoneID, ledgerID)
+}
+
+// UpdateLedgerProtection updates the number of approvals required to move the ref of a ledger.
+func (s PAPController) UpdateLedgerProtection(zoneID int64, ledgerID string, requiredApprovals int32) (*azmodelspap.Ledger, error) {
+	return s.storage.UpdateLedgerProtection(zoneID, ledgerID, requiredApprovals)
+}
+
 // DeleteLedger deletes an ledger.
 func (s PAPController) DeleteLedger(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error) {
 	return s.storage.DeleteLedger(zoneID, ledgerID)
@@ -64,6 +79,61 @@ func (s PAPController) FetchLedgers(page int32, pageSize int32, zoneID int64, fi
 	return s.storage.FetchLedgers(page, pageSize, zoneID, fields)
 }
 
+// CreateEntity creates a new entity.
+func (s PAPController) CreateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error) {
+	return s.storage.CreateEntity(ledgerID, entity)
+}
+
+// UpdateEntity updates an entity.
+func (s PAPController) UpdateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error) {
+	return s.storage.UpdateEntity(ledgerID, entity)
+}
+
+// UpsertEntities creates or replaces a set of entities.
+func (s PAPController) UpsertEntities(zoneID int64, ledgerID string, entities []azmodelspap.Entity) ([]azmodelspap.Entity, error) {
+	return s.storage.UpsertEntities(zoneID, ledgerID, entities)
+}
+
+// DeleteEntity deletes an entity.
+func (s PAPController) DeleteEntity(zoneID int64, entityType, entityID string) (*azmodelspap.Entity, error) {
+	return s.storage.DeleteEntity(zoneID, entityType, entityID)
+}
+
+// FetchEntities gets all entities.
+func (s PAPController) FetchEntities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelspap.Entity, error) {
+	return s.storage.FetchEntities(page, pageSize, zoneID, fields)
+}
+
+// CreateTemplateLink creates a new template link.
+func (s PAPController) CreateTemplateLink(link *azmodelspap.TemplateLink) (*azmodelspap.TemplateLink, error) {
+	return s.storage.CreateTemplateLink(link)
+}
+
+// DeleteTemplateLink deletes a template link.
+func (s PAPController) DeleteTemplateLink(zoneID int64, ledgerID string, linkID string) (*azmodelspap.TemplateLink, error) {
+	return s.storage.DeleteTemplateLink(zoneID, ledgerID, linkID)
+}
+
+// FetchTemplateLinks gets all template links of a ledger.
+func (s PAPController) FetchTemplateLinks(page int32, pageSize int32, zoneID int64, ledgerID string, fields map[string]any) ([]azmodelspap.TemplateLink, error) {
+	return s.storage.FetchTemplateLinks(page, pageSize, zoneID, ledgerID, fields)
+}
+
+// FetchChangeRequests gets all change requests of a ledger.
+func (s PAPController) FetchChangeRequests(page int32, pageSize int32, zoneID int64, ledgerID string, fields map[string]any) ([]azmodelspap.ChangeRequest, error) {
+	return s.storage.FetchChangeRequests(page, pageSize, zoneID, ledgerID, fields)
+}
+
+// ApproveChangeRequest approves a pending change request.
+func (s PAPController) ApproveChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, comment string) (*azmodelspap.ChangeRequest, error) {
+	return s.storage.ApproveChangeRequest(zoneID, ledgerID, changeRequestID, reviewer, comment)
+}
+
+// RejectChangeRequest rejects a pending change request.
+func (s PAPController) RejectChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, comment string) (*azmodelspap.ChangeRequest, error) {
+	return s.storage.RejectChangeRequest(zoneID, ledgerID, changeRequestID, reviewer, comment)
+}
+
 // OnPullHandleRequestCurrentState handles the request for the current state.
 func (s PAPController) OnPullHandleRequestCurrentState(handlerCtx *notpstatemachines.HandlerContext, statePacket *notpsmpackets.StatePacket, packets []notppackets.Packetable) (*notpstatemachines.HostHandlerReturn, error) {
 	return s.storage.OnPullHandleRequestCurrentState(handlerCtx, statePacket, packets)
@@ -25,6 +25,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -38,6 +39,91 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Fetch query with the cursor pagination, sorting and filters.
+type FetchQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=Cursor,proto3,oneof" json:"Cursor,omitempty"`
+	SortBy        *string                `protobuf:"bytes,2,opt,name=SortBy,proto3,oneof" json:"SortBy,omitempty"`
+	SortOrder     *string                `protobuf:"bytes,3,opt,name=SortOrder,proto3,oneof" json:"SortOrder,omitempty"`
+	NamePrefix    *string                `protobuf:"bytes,4,opt,name=NamePrefix,proto3,oneof" json:"NamePrefix,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=CreatedAfter,proto3,oneof" json:"CreatedAfter,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedBefore,proto3,oneof" json:"CreatedBefore,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchQuery) Reset() {
+	*x = FetchQuery{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchQuery) ProtoMessage() {}
+
+func (x *FetchQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchQuery.ProtoReflect.Descriptor instead.
+func (*FetchQuery) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FetchQuery) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *FetchQuery) GetSortBy() string {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ""
+}
+
+func (x *FetchQuery) GetSortOrder() string {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return ""
+}
+
+func (x *FetchQuery) GetNamePrefix() string {
+	if x != nil && x.NamePrefix != nil {
+		return *x.NamePrefix
+	}
+	return ""
+}
+
+func (x *FetchQuery) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *FetchQuery) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
 // Ledger get request.
 type LedgerFetchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -47,13 +133,14 @@ type LedgerFetchRequest struct {
 	LedgerID      *string                `protobuf:"bytes,4,opt,name=LedgerID,proto3,oneof" json:"LedgerID,omitempty"`
 	Kind          *string                `protobuf:"bytes,5,opt,name=Kind,proto3,oneof" json:"Kind,omitempty"`
 	Name          *string                `protobuf:"bytes,6,opt,name=Name,proto3,oneof" json:"Name,omitempty"`
+	Query         *FetchQuery            `protobuf:"bytes,7,opt,name=Query,proto3,oneof" json:"Query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LedgerFetchRequest) Reset() {
 	*x = LedgerFetchRequest{}
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[0]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -65,7 +152,7 @@ func (x *LedgerFetchRequest) String() string {
 func (*LedgerFetchRequest) ProtoMessage() {}
 
 func (x *LedgerFetchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[0]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -78,7 +165,7 @@ func (x *LedgerFetchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LedgerFetchRequest.ProtoReflect.Descriptor instead.
 func (*LedgerFetchRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{0}
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *LedgerFetchRequest) GetPage() int32 {
@@ -123,6 +210,13 @@ func (x *LedgerFetchRequest) GetName() string {
 	return ""
 }
 
+func (x *LedgerFetchRequest) GetQuery() *FetchQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
 // Ledger create request.
 type LedgerCreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -135,7 +229,7 @@ type LedgerCreateRequest struct {
 
 func (x *LedgerCreateRequest) Reset() {
 	*x = LedgerCreateRequest{}
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[1]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -147,7 +241,7 @@ func (x *LedgerCreateRequest) String() string {
 func (*LedgerCreateRequest) ProtoMessage() {}
 
 func (x *LedgerCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[1]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,7 +254,7 @@ func (x *LedgerCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LedgerCreateRequest.ProtoReflect.Descriptor instead.
 func (*LedgerCreateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{1}
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *LedgerCreateRequest) GetZoneID() int64 {
@@ -197,7 +291,7 @@ type LedgerUpdateRequest struct {
 
 func (x *LedgerUpdateRequest) Reset() {
 	*x = LedgerUpdateRequest{}
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[2]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -209,7 +303,7 @@ func (x *LedgerUpdateRequest) String() string {
 func (*LedgerUpdateRequest) ProtoMessage() {}
 
 func (x *LedgerUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[2]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,7 +316,7 @@ func (x *LedgerUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LedgerUpdateRequest.ProtoReflect.Descriptor instead.
 func (*LedgerUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{2}
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LedgerUpdateRequest) GetZoneID() int64 {
@@ -264,7 +358,7 @@ type LedgerDeleteRequest struct {
 
 func (x *LedgerDeleteRequest) Reset() {
 	*x = LedgerDeleteRequest{}
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[3]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -276,7 +370,7 @@ func (x *LedgerDeleteRequest) String() string {
 func (*LedgerDeleteRequest) ProtoMessage() {}
 
 func (x *LedgerDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[3]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +383,7 @@ func (x *LedgerDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LedgerDeleteRequest.ProtoReflect.Descriptor instead.
 func (*LedgerDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{3}
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LedgerDeleteRequest) GetZoneID() int64 {
@@ -306,23 +400,200 @@ func (x *LedgerDeleteRequest) GetLedgerID() string {
 	return ""
 }
 
-// Ledger response.
-type LedgerResponse struct {
+// Ledger candidate update request.
+type LedgerCandidateUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID      string                 `protobuf:"bytes,2,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	CandidateRef  string                 `protobuf:"bytes,3,opt,name=CandidateRef,proto3" json:"CandidateRef,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerCandidateUpdateRequest) Reset() {
+	*x = LedgerCandidateUpdateRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerCandidateUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerCandidateUpdateRequest) ProtoMessage() {}
+
+func (x *LedgerCandidateUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerCandidateUpdateRequest.ProtoReflect.Descriptor instead.
+func (*LedgerCandidateUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LedgerCandidateUpdateRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *LedgerCandidateUpdateRequest) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+func (x *LedgerCandidateUpdateRequest) GetCandidateRef() string {
+	if x != nil {
+		return x.CandidateRef
+	}
+	return ""
+}
+
+// Ledger candidate promote request.
+type LedgerCandidatePromoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LedgerID      string                 `protobuf:"bytes,1,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
-	ZoneID        int64                  `protobuf:"varint,2,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
-	Kind          string                 `protobuf:"bytes,5,opt,name=Kind,proto3" json:"Kind,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=Name,proto3" json:"Name,omitempty"`
-	Ref           string                 `protobuf:"bytes,7,opt,name=Ref,proto3" json:"Ref,omitempty"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID      string                 `protobuf:"bytes,2,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *LedgerCandidatePromoteRequest) Reset() {
+	*x = LedgerCandidatePromoteRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerCandidatePromoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerCandidatePromoteRequest) ProtoMessage() {}
+
+func (x *LedgerCandidatePromoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerCandidatePromoteRequest.ProtoReflect.Descriptor instead.
+func (*LedgerCandidatePromoteRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LedgerCandidatePromoteRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *LedgerCandidatePromoteRequest) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+// Ledger protection update request.
+type LedgerProtectionUpdateRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID            int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID          string                 `protobuf:"bytes,2,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	RequiredApprovals int32                  `protobuf:"varint,3,opt,name=RequiredApprovals,proto3" json:"RequiredApprovals,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LedgerProtectionUpdateRequest) Reset() {
+	*x = LedgerProtectionUpdateRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerProtectionUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerProtectionUpdateRequest) ProtoMessage() {}
+
+func (x *LedgerProtectionUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerProtectionUpdateRequest.ProtoReflect.Descriptor instead.
+func (*LedgerProtectionUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LedgerProtectionUpdateRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *LedgerProtectionUpdateRequest) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+func (x *LedgerProtectionUpdateRequest) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+// Ledger response.
+type LedgerResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LedgerID          string                 `protobuf:"bytes,1,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	ZoneID            int64                  `protobuf:"varint,2,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Kind              string                 `protobuf:"bytes,5,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Name              string                 `protobuf:"bytes,6,opt,name=Name,proto3" json:"Name,omitempty"`
+	Ref               string                 `protobuf:"bytes,7,opt,name=Ref,proto3" json:"Ref,omitempty"`
+	CandidateRef      *string                `protobuf:"bytes,8,opt,name=CandidateRef,proto3,oneof" json:"CandidateRef,omitempty"`
+	RequiredApprovals *int32                 `protobuf:"varint,9,opt,name=RequiredApprovals,proto3,oneof" json:"RequiredApprovals,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
 func (x *LedgerResponse) Reset() {
 	*x = LedgerResponse{}
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[4]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +605,7 @@ func (x *LedgerResponse) String() string {
 func (*LedgerResponse) ProtoMessage() {}
 
 func (x *LedgerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[4]
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +618,7 @@ func (x *LedgerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LedgerResponse.ProtoReflect.Descriptor instead.
 func (*LedgerResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{4}
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *LedgerResponse) GetLedgerID() string {
@@ -399,30 +670,44 @@ func (x *LedgerResponse) GetRef() string {
 	return ""
 }
 
-// Ledger stream request.
-type LedgerStreamRequest struct {
+func (x *LedgerResponse) GetCandidateRef() string {
+	if x != nil && x.CandidateRef != nil {
+		return *x.CandidateRef
+	}
+	return ""
+}
+
+func (x *LedgerResponse) GetRequiredApprovals() int32 {
+	if x != nil && x.RequiredApprovals != nil {
+		return *x.RequiredApprovals
+	}
+	return 0
+}
+
+// Entity uid.
+type EntityUID struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
-	LedgerID      string                 `protobuf:"bytes,2,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	ID            string                 `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LedgerStreamRequest) Reset() {
-	*x = LedgerStreamRequest{}
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[5]
+func (x *EntityUID) Reset() {
+	*x = EntityUID{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LedgerStreamRequest) String() string {
+func (x *EntityUID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LedgerStreamRequest) ProtoMessage() {}
+func (*EntityUID) ProtoMessage() {}
 
-func (x *LedgerStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[5]
+func (x *EntityUID) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,48 +718,51 @@ func (x *LedgerStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LedgerStreamRequest.ProtoReflect.Descriptor instead.
-func (*LedgerStreamRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use EntityUID.ProtoReflect.Descriptor instead.
+func (*EntityUID) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LedgerStreamRequest) GetZoneID() int64 {
+func (x *EntityUID) GetType() string {
 	if x != nil {
-		return x.ZoneID
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *LedgerStreamRequest) GetLedgerID() string {
+func (x *EntityUID) GetID() string {
 	if x != nil {
-		return x.LedgerID
+		return x.ID
 	}
 	return ""
 }
 
-// PackMessage is a pack message.
-type PackMessage struct {
+// Entity request.
+type EntityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	ID            string                 `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Attributes    *structpb.Struct       `protobuf:"bytes,3,opt,name=Attributes,proto3,oneof" json:"Attributes,omitempty"`
+	Parents       []*EntityUID           `protobuf:"bytes,4,rep,name=Parents,proto3" json:"Parents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PackMessage) Reset() {
-	*x = PackMessage{}
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[6]
+func (x *EntityRequest) Reset() {
+	*x = EntityRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PackMessage) String() string {
+func (x *EntityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackMessage) ProtoMessage() {}
+func (*EntityRequest) ProtoMessage() {}
 
-func (x *PackMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[6]
+func (x *EntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,9 +773,1267 @@ func (x *PackMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackMessage.ProtoReflect.Descriptor instead.
-func (*PackMessage) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use EntityRequest.ProtoReflect.Descriptor instead.
+func (*EntityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EntityRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EntityRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *EntityRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *EntityRequest) GetParents() []*EntityUID {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+// Entity create or update request.
+type EntityUpsertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID      *string                `protobuf:"bytes,2,opt,name=LedgerID,proto3,oneof" json:"LedgerID,omitempty"`
+	Entity        *EntityRequest         `protobuf:"bytes,3,opt,name=Entity,proto3" json:"Entity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityUpsertRequest) Reset() {
+	*x = EntityUpsertRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityUpsertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityUpsertRequest) ProtoMessage() {}
+
+func (x *EntityUpsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityUpsertRequest.ProtoReflect.Descriptor instead.
+func (*EntityUpsertRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EntityUpsertRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *EntityUpsertRequest) GetLedgerID() string {
+	if x != nil && x.LedgerID != nil {
+		return *x.LedgerID
+	}
+	return ""
+}
+
+func (x *EntityUpsertRequest) GetEntity() *EntityRequest {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+// Entity bulk upsert request.
+type EntityBulkUpsertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID      *string                `protobuf:"bytes,2,opt,name=LedgerID,proto3,oneof" json:"LedgerID,omitempty"`
+	Entities      []*EntityRequest       `protobuf:"bytes,3,rep,name=Entities,proto3" json:"Entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityBulkUpsertRequest) Reset() {
+	*x = EntityBulkUpsertRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityBulkUpsertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityBulkUpsertRequest) ProtoMessage() {}
+
+func (x *EntityBulkUpsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityBulkUpsertRequest.ProtoReflect.Descriptor instead.
+func (*EntityBulkUpsertRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EntityBulkUpsertRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *EntityBulkUpsertRequest) GetLedgerID() string {
+	if x != nil && x.LedgerID != nil {
+		return *x.LedgerID
+	}
+	return ""
+}
+
+func (x *EntityBulkUpsertRequest) GetEntities() []*EntityRequest {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// Entity delete request.
+type EntityDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	ID            string                 `protobuf:"bytes,3,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityDeleteRequest) Reset() {
+	*x = EntityDeleteRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityDeleteRequest) ProtoMessage() {}
+
+func (x *EntityDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityDeleteRequest.ProtoReflect.Descriptor instead.
+func (*EntityDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EntityDeleteRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *EntityDeleteRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EntityDeleteRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// Entity fetch request.
+type EntityFetchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=Page,proto3,oneof" json:"Page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=PageSize,proto3,oneof" json:"PageSize,omitempty"`
+	ZoneID        int64                  `protobuf:"varint,3,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	Type          *string                `protobuf:"bytes,4,opt,name=Type,proto3,oneof" json:"Type,omitempty"`
+	ID            *string                `protobuf:"bytes,5,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityFetchRequest) Reset() {
+	*x = EntityFetchRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityFetchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityFetchRequest) ProtoMessage() {}
+
+func (x *EntityFetchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityFetchRequest.ProtoReflect.Descriptor instead.
+func (*EntityFetchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EntityFetchRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *EntityFetchRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *EntityFetchRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *EntityFetchRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *EntityFetchRequest) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// Entity response.
+type EntityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	ID            string                 `protobuf:"bytes,3,opt,name=ID,proto3" json:"ID,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Attributes    *structpb.Struct       `protobuf:"bytes,6,opt,name=Attributes,proto3" json:"Attributes,omitempty"`
+	Parents       []*EntityUID           `protobuf:"bytes,7,rep,name=Parents,proto3" json:"Parents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityResponse) Reset() {
+	*x = EntityResponse{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityResponse) ProtoMessage() {}
+
+func (x *EntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityResponse.ProtoReflect.Descriptor instead.
+func (*EntityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EntityResponse) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *EntityResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EntityResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *EntityResponse) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *EntityResponse) GetParents() []*EntityUID {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+// Entity bulk upsert response.
+type EntityBulkUpsertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entities      []*EntityResponse      `protobuf:"bytes,1,rep,name=Entities,proto3" json:"Entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityBulkUpsertResponse) Reset() {
+	*x = EntityBulkUpsertResponse{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityBulkUpsertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityBulkUpsertResponse) ProtoMessage() {}
+
+func (x *EntityBulkUpsertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityBulkUpsertResponse.ProtoReflect.Descriptor instead.
+func (*EntityBulkUpsertResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EntityBulkUpsertResponse) GetEntities() []*EntityResponse {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// Template link create request.
+type TemplateLinkCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID      string                 `protobuf:"bytes,2,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	LinkID        *string                `protobuf:"bytes,3,opt,name=LinkID,proto3,oneof" json:"LinkID,omitempty"`
+	TemplateID    string                 `protobuf:"bytes,4,opt,name=TemplateID,proto3" json:"TemplateID,omitempty"`
+	Slots         map[string]*EntityUID  `protobuf:"bytes,5,rep,name=Slots,proto3" json:"Slots,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateLinkCreateRequest) Reset() {
+	*x = TemplateLinkCreateRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateLinkCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLinkCreateRequest) ProtoMessage() {}
+
+func (x *TemplateLinkCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLinkCreateRequest.ProtoReflect.Descriptor instead.
+func (*TemplateLinkCreateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TemplateLinkCreateRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *TemplateLinkCreateRequest) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+func (x *TemplateLinkCreateRequest) GetLinkID() string {
+	if x != nil && x.LinkID != nil {
+		return *x.LinkID
+	}
+	return ""
+}
+
+func (x *TemplateLinkCreateRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateLinkCreateRequest) GetSlots() map[string]*EntityUID {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// Template link delete request.
+type TemplateLinkDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID      string                 `protobuf:"bytes,2,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	LinkID        string                 `protobuf:"bytes,3,opt,name=LinkID,proto3" json:"LinkID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateLinkDeleteRequest) Reset() {
+	*x = TemplateLinkDeleteRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateLinkDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLinkDeleteRequest) ProtoMessage() {}
+
+func (x *TemplateLinkDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLinkDeleteRequest.ProtoReflect.Descriptor instead.
+func (*TemplateLinkDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TemplateLinkDeleteRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *TemplateLinkDeleteRequest) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+func (x *TemplateLinkDeleteRequest) GetLinkID() string {
+	if x != nil {
+		return x.LinkID
+	}
+	return ""
+}
+
+// Template link fetch request.
+type TemplateLinkFetchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=Page,proto3,oneof" json:"Page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=PageSize,proto3,oneof" json:"PageSize,omitempty"`
+	ZoneID        int64                  `protobuf:"varint,3,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID      string                 `protobuf:"bytes,4,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	TemplateID    *string                `protobuf:"bytes,5,opt,name=TemplateID,proto3,oneof" json:"TemplateID,omitempty"`
+	LinkID        *string                `protobuf:"bytes,6,opt,name=LinkID,proto3,oneof" json:"LinkID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateLinkFetchRequest) Reset() {
+	*x = TemplateLinkFetchRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateLinkFetchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLinkFetchRequest) ProtoMessage() {}
+
+func (x *TemplateLinkFetchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLinkFetchRequest.ProtoReflect.Descriptor instead.
+func (*TemplateLinkFetchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TemplateLinkFetchRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *TemplateLinkFetchRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *TemplateLinkFetchRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *TemplateLinkFetchRequest) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+func (x *TemplateLinkFetchRequest) GetTemplateID() string {
+	if x != nil && x.TemplateID != nil {
+		return *x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateLinkFetchRequest) GetLinkID() string {
+	if x != nil && x.LinkID != nil {
+		return *x.LinkID
+	}
+	return ""
+}
+
+// Template link response.
+type TemplateLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID      string                 `protobuf:"bytes,2,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	LinkID        string                 `protobuf:"bytes,3,opt,name=LinkID,proto3" json:"LinkID,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	TemplateID    string                 `protobuf:"bytes,6,opt,name=TemplateID,proto3" json:"TemplateID,omitempty"`
+	Slots         map[string]*EntityUID  `protobuf:"bytes,7,rep,name=Slots,proto3" json:"Slots,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateLinkResponse) Reset() {
+	*x = TemplateLinkResponse{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLinkResponse) ProtoMessage() {}
+
+func (x *TemplateLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLinkResponse.ProtoReflect.Descriptor instead.
+func (*TemplateLinkResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TemplateLinkResponse) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *TemplateLinkResponse) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+func (x *TemplateLinkResponse) GetLinkID() string {
+	if x != nil {
+		return x.LinkID
+	}
+	return ""
+}
+
+func (x *TemplateLinkResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TemplateLinkResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TemplateLinkResponse) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateLinkResponse) GetSlots() map[string]*EntityUID {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// Change request fetch request.
+type ChangeRequestFetchRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            *int32                 `protobuf:"varint,1,opt,name=Page,proto3,oneof" json:"Page,omitempty"`
+	PageSize        *int32                 `protobuf:"varint,2,opt,name=PageSize,proto3,oneof" json:"PageSize,omitempty"`
+	ZoneID          int64                  `protobuf:"varint,3,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID        string                 `protobuf:"bytes,4,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	ChangeRequestID *string                `protobuf:"bytes,5,opt,name=ChangeRequestID,proto3,oneof" json:"ChangeRequestID,omitempty"`
+	Status          *string                `protobuf:"bytes,6,opt,name=Status,proto3,oneof" json:"Status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeRequestFetchRequest) Reset() {
+	*x = ChangeRequestFetchRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeRequestFetchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRequestFetchRequest) ProtoMessage() {}
+
+func (x *ChangeRequestFetchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRequestFetchRequest.ProtoReflect.Descriptor instead.
+func (*ChangeRequestFetchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChangeRequestFetchRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ChangeRequestFetchRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ChangeRequestFetchRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *ChangeRequestFetchRequest) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+func (x *ChangeRequestFetchRequest) GetChangeRequestID() string {
+	if x != nil && x.ChangeRequestID != nil {
+		return *x.ChangeRequestID
+	}
+	return ""
+}
+
+func (x *ChangeRequestFetchRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// Change request review request.
+type ChangeRequestReviewRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID          int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID        string                 `protobuf:"bytes,2,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	ChangeRequestID string                 `protobuf:"bytes,3,opt,name=ChangeRequestID,proto3" json:"ChangeRequestID,omitempty"`
+	Comment         *string                `protobuf:"bytes,5,opt,name=Comment,proto3,oneof" json:"Comment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeRequestReviewRequest) Reset() {
+	*x = ChangeRequestReviewRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeRequestReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRequestReviewRequest) ProtoMessage() {}
+
+func (x *ChangeRequestReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRequestReviewRequest.ProtoReflect.Descriptor instead.
+func (*ChangeRequestReviewRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ChangeRequestReviewRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *ChangeRequestReviewRequest) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+func (x *ChangeRequestReviewRequest) GetChangeRequestID() string {
+	if x != nil {
+		return x.ChangeRequestID
+	}
+	return ""
+}
+
+func (x *ChangeRequestReviewRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// Change request plan item.
+type ChangeRequestPlanItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CodeID        string                 `protobuf:"bytes,1,opt,name=CodeID,proto3" json:"CodeID,omitempty"`
+	CodeType      string                 `protobuf:"bytes,2,opt,name=CodeType,proto3" json:"CodeType,omitempty"`
+	OID           string                 `protobuf:"bytes,3,opt,name=OID,proto3" json:"OID,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=State,proto3" json:"State,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeRequestPlanItem) Reset() {
+	*x = ChangeRequestPlanItem{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeRequestPlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRequestPlanItem) ProtoMessage() {}
+
+func (x *ChangeRequestPlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRequestPlanItem.ProtoReflect.Descriptor instead.
+func (*ChangeRequestPlanItem) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ChangeRequestPlanItem) GetCodeID() string {
+	if x != nil {
+		return x.CodeID
+	}
+	return ""
+}
+
+func (x *ChangeRequestPlanItem) GetCodeType() string {
+	if x != nil {
+		return x.CodeType
+	}
+	return ""
+}
+
+func (x *ChangeRequestPlanItem) GetOID() string {
+	if x != nil {
+		return x.OID
+	}
+	return ""
+}
+
+func (x *ChangeRequestPlanItem) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// Change request review.
+type ChangeRequestReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviewer      string                 `protobuf:"bytes,1,opt,name=Reviewer,proto3" json:"Reviewer,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Decision      string                 `protobuf:"bytes,3,opt,name=Decision,proto3" json:"Decision,omitempty"`
+	Comment       *string                `protobuf:"bytes,4,opt,name=Comment,proto3,oneof" json:"Comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeRequestReview) Reset() {
+	*x = ChangeRequestReview{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeRequestReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRequestReview) ProtoMessage() {}
+
+func (x *ChangeRequestReview) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRequestReview.ProtoReflect.Descriptor instead.
+func (*ChangeRequestReview) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChangeRequestReview) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *ChangeRequestReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChangeRequestReview) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ChangeRequestReview) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// Change request response.
+type ChangeRequestResponse struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	ChangeRequestID   string                   `protobuf:"bytes,1,opt,name=ChangeRequestID,proto3" json:"ChangeRequestID,omitempty"`
+	CreatedAt         *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp   `protobuf:"bytes,3,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	ZoneID            int64                    `protobuf:"varint,4,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID          string                   `protobuf:"bytes,5,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	BaseRef           string                   `protobuf:"bytes,6,opt,name=BaseRef,proto3" json:"BaseRef,omitempty"`
+	CommitID          string                   `protobuf:"bytes,7,opt,name=CommitID,proto3" json:"CommitID,omitempty"`
+	Status            string                   `protobuf:"bytes,8,opt,name=Status,proto3" json:"Status,omitempty"`
+	RequiredApprovals int32                    `protobuf:"varint,9,opt,name=RequiredApprovals,proto3" json:"RequiredApprovals,omitempty"`
+	Plan              []*ChangeRequestPlanItem `protobuf:"bytes,10,rep,name=Plan,proto3" json:"Plan,omitempty"`
+	Reviews           []*ChangeRequestReview   `protobuf:"bytes,11,rep,name=Reviews,proto3" json:"Reviews,omitempty"`
+	Author            string                   `protobuf:"bytes,12,opt,name=Author,proto3" json:"Author,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChangeRequestResponse) Reset() {
+	*x = ChangeRequestResponse{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRequestResponse) ProtoMessage() {}
+
+func (x *ChangeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*ChangeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChangeRequestResponse) GetChangeRequestID() string {
+	if x != nil {
+		return x.ChangeRequestID
+	}
+	return ""
+}
+
+func (x *ChangeRequestResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChangeRequestResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ChangeRequestResponse) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *ChangeRequestResponse) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+func (x *ChangeRequestResponse) GetBaseRef() string {
+	if x != nil {
+		return x.BaseRef
+	}
+	return ""
+}
+
+func (x *ChangeRequestResponse) GetCommitID() string {
+	if x != nil {
+		return x.CommitID
+	}
+	return ""
+}
+
+func (x *ChangeRequestResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChangeRequestResponse) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *ChangeRequestResponse) GetPlan() []*ChangeRequestPlanItem {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *ChangeRequestResponse) GetReviews() []*ChangeRequestReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ChangeRequestResponse) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+// Ledger stream request.
+type LedgerStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	LedgerID      string                 `protobuf:"bytes,2,opt,name=LedgerID,proto3" json:"LedgerID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerStreamRequest) Reset() {
+	*x = LedgerStreamRequest{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerStreamRequest) ProtoMessage() {}
+
+func (x *LedgerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerStreamRequest.ProtoReflect.Descriptor instead.
+func (*LedgerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LedgerStreamRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *LedgerStreamRequest) GetLedgerID() string {
+	if x != nil {
+		return x.LedgerID
+	}
+	return ""
+}
+
+// PackMessage is a pack message.
+type PackMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackMessage) Reset() {
+	*x = PackMessage{}
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackMessage) ProtoMessage() {}
+
+func (x *PackMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackMessage.ProtoReflect.Descriptor instead.
+func (*PackMessage) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PackMessage) GetData() []byte {
@@ -507,107 +2053,502 @@ var file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDesc = strin
 	0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70,
 	0x6f, 0x69, 0x6e, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x12, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04,
-	0x50, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61,
-	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1f,
-	0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x02, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
-	0x17, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
-	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x4c, 0x65, 0x64, 0x67,
-	0x65, 0x72, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4b, 0x69, 0x6e, 0x64, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a,
-	0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x02, 0x0a, 0x0a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x1b, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x12, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12,
+	0x1f, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x40, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x05, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4b, 0x69, 0x6e, 0x64,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x22, 0x55, 0x0a, 0x13, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f,
+	0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x71, 0x0a, 0x13, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x71, 0x0a,
-	0x13, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a,
+	0x13, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
 	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x49, 0x0a, 0x13, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x22, 0x76, 0x0a, 0x1c, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66,
+	0x22, 0x53, 0x0a, 0x1d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x49, 0x44, 0x22, 0x81, 0x01, 0x0a, 0x1d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12,
 	0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x22, 0xf2, 0x01, 0x0a, 0x0e,
-	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f,
-	0x6e, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
-	0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x09, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22, 0xf5, 0x02, 0x0a, 0x0e, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44,
+	0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x52, 0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x66, 0x12, 0x27,
+	0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x01, 0x52, 0x11, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x22, 0x2f, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x44, 0x22, 0xc0, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x49, 0x44, 0x52, 0x07, 0x50,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a,
+	0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x49, 0x44, 0x22, 0xa5, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x08, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x08, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x22, 0x51, 0x0a,
+	0x13, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0xba, 0x01, 0x0a, 0x12, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x54, 0x79, 0x70, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49, 0x44, 0x22, 0xb9, 0x02,
+	0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x37, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x49, 0x44,
+	0x52, 0x07, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x61, 0x0a, 0x18, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xce, 0x02, 0x0a,
+	0x19, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f,
+	0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1b,
+	0x0a, 0x06, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x55, 0x0a, 0x05, 0x53,
+	0x6c, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x1a, 0x5e, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x55, 0x49, 0x44, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x22, 0x67, 0x0a,
+	0x19, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f,
+	0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x22, 0xfa, 0x01, 0x0a, 0x18, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01,
+	0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a,
+	0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a,
+	0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x23, 0x0a, 0x0a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4c, 0x69, 0x6e,
+	0x6b, 0x49, 0x44, 0x22, 0xa8, 0x03, 0x0a, 0x14, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f,
+	0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x50, 0x0a, 0x05,
+	0x53, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x1a, 0x5e,
+	0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x55, 0x49, 0x44, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8a,
+	0x02, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04,
+	0x50, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61,
+	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x0f, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x1a,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f,
+	0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28,
+	0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x22, 0x73, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x43, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x4f, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4f,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x52, 0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x66,
-	0x22, 0x49, 0x0a, 0x13, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12,
-	0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x22, 0x21, 0x0a, 0x0b, 0x50,
-	0x61, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x8c,
-	0x05, 0x0a, 0x0c, 0x56, 0x31, 0x50, 0x41, 0x50, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x6b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12,
-	0x2e, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67,
-	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x70,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x8d, 0x04,
+	0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x44, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x48, 0x0a, 0x07, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x49, 0x0a,
+	0x13, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x44, 0x22, 0x21, 0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xc9, 0x12, 0x0a, 0x0c,
+	0x56, 0x31, 0x50, 0x41, 0x50, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6b, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x70,
 	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70,
+	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70,
 	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
 	0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
 	0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4c,
-	0x65, 0x64, 0x67, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x50,
-	0x61, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x37, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x38, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69,
 	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-	0x50, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x26, 0x2e, 0x70, 0x6f,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x6b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6b, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x2e, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x0e,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x32,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x34, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x34, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x33, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x34, 0x2e, 0x70, 0x6f,
 	0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x0a, 0x4e, 0x4f, 0x54,
-	0x50, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x35, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
 	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
-	0x26, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x63, 0x6b,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x3a, 0x5a,
-	0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x72, 0x6d,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x70, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x69, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x13,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x35, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63,
+	0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x50, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f,
+	0x70, 0x65, 0x72, 0x6d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x61, 0x70,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -622,37 +2563,112 @@ func file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescGZIP()
 	return file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDescData
 }
 
-var file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_internal_agents_services_pap_endpoints_api_v1_pap_proto_goTypes = []any{
-	(*LedgerFetchRequest)(nil),    // 0: policyadministrationpoint.LedgerFetchRequest
-	(*LedgerCreateRequest)(nil),   // 1: policyadministrationpoint.LedgerCreateRequest
-	(*LedgerUpdateRequest)(nil),   // 2: policyadministrationpoint.LedgerUpdateRequest
-	(*LedgerDeleteRequest)(nil),   // 3: policyadministrationpoint.LedgerDeleteRequest
-	(*LedgerResponse)(nil),        // 4: policyadministrationpoint.LedgerResponse
-	(*LedgerStreamRequest)(nil),   // 5: policyadministrationpoint.LedgerStreamRequest
-	(*PackMessage)(nil),           // 6: policyadministrationpoint.PackMessage
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*FetchQuery)(nil),                    // 0: policyadministrationpoint.FetchQuery
+	(*LedgerFetchRequest)(nil),            // 1: policyadministrationpoint.LedgerFetchRequest
+	(*LedgerCreateRequest)(nil),           // 2: policyadministrationpoint.LedgerCreateRequest
+	(*LedgerUpdateRequest)(nil),           // 3: policyadministrationpoint.LedgerUpdateRequest
+	(*LedgerDeleteRequest)(nil),           // 4: policyadministrationpoint.LedgerDeleteRequest
+	(*LedgerCandidateUpdateRequest)(nil),  // 5: policyadministrationpoint.LedgerCandidateUpdateRequest
+	(*LedgerCandidatePromoteRequest)(nil), // 6: policyadministrationpoint.LedgerCandidatePromoteRequest
+	(*LedgerProtectionUpdateRequest)(nil), // 7: policyadministrationpoint.LedgerProtectionUpdateRequest
+	(*LedgerResponse)(nil),                // 8: policyadministrationpoint.LedgerResponse
+	(*EntityUID)(nil),                     // 9: policyadministrationpoint.EntityUID
+	(*EntityRequest)(nil),                 // 10: policyadministrationpoint.EntityRequest
+	(*EntityUpsertRequest)(nil),           // 11: policyadministrationpoint.EntityUpsertRequest
+	(*EntityBulkUpsertRequest)(nil),       // 12: policyadministrationpoint.EntityBulkUpsertRequest
+	(*EntityDeleteRequest)(nil),           // 13: policyadministrationpoint.EntityDeleteRequest
+	(*EntityFetchRequest)(nil),            // 14: policyadministrationpoint.EntityFetchRequest
+	(*EntityResponse)(nil),                // 15: policyadministrationpoint.EntityResponse
+	(*EntityBulkUpsertResponse)(nil),      // 16: policyadministrationpoint.EntityBulkUpsertResponse
+	(*TemplateLinkCreateRequest)(nil),     // 17: policyadministrationpoint.TemplateLinkCreateRequest
+	(*TemplateLinkDeleteRequest)(nil),     // 18: policyadministrationpoint.TemplateLinkDeleteRequest
+	(*TemplateLinkFetchRequest)(nil),      // 19: policyadministrationpoint.TemplateLinkFetchRequest
+	(*TemplateLinkResponse)(nil),          // 20: policyadministrationpoint.TemplateLinkResponse
+	(*ChangeRequestFetchRequest)(nil),     // 21: policyadministrationpoint.ChangeRequestFetchRequest
+	(*ChangeRequestReviewRequest)(nil),    // 22: policyadministrationpoint.ChangeRequestReviewRequest
+	(*ChangeRequestPlanItem)(nil),         // 23: policyadministrationpoint.ChangeRequestPlanItem
+	(*ChangeRequestReview)(nil),           // 24: policyadministrationpoint.ChangeRequestReview
+	(*ChangeRequestResponse)(nil),         // 25: policyadministrationpoint.ChangeRequestResponse
+	(*LedgerStreamRequest)(nil),           // 26: policyadministrationpoint.LedgerStreamRequest
+	(*PackMessage)(nil),                   // 27: policyadministrationpoint.PackMessage
+	nil,                                   // 28: policyadministrationpoint.TemplateLinkCreateRequest.SlotsEntry
+	nil,                                   // 29: policyadministrationpoint.TemplateLinkResponse.SlotsEntry
+	(*timestamppb.Timestamp)(nil),         // 30: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),               // 31: google.protobuf.Struct
 }
 var file_internal_agents_services_pap_endpoints_api_v1_pap_proto_depIdxs = []int32{
-	7, // 0: policyadministrationpoint.LedgerResponse.CreatedAt:type_name -> google.protobuf.Timestamp
-	7, // 1: policyadministrationpoint.LedgerResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
-	1, // 2: policyadministrationpoint.V1PAPService.CreateLedger:input_type -> policyadministrationpoint.LedgerCreateRequest
-	2, // 3: policyadministrationpoint.V1PAPService.UpdateLedger:input_type -> policyadministrationpoint.LedgerUpdateRequest
-	3, // 4: policyadministrationpoint.V1PAPService.DeleteLedger:input_type -> policyadministrationpoint.LedgerDeleteRequest
-	0, // 5: policyadministrationpoint.V1PAPService.FetchLedgers:input_type -> policyadministrationpoint.LedgerFetchRequest
-	6, // 6: policyadministrationpoint.V1PAPService.ReceivePack:input_type -> policyadministrationpoint.PackMessage
-	6, // 7: policyadministrationpoint.V1PAPService.NOTPStream:input_type -> policyadministrationpoint.PackMessage
-	4, // 8: policyadministrationpoint.V1PAPService.CreateLedger:output_type -> policyadministrationpoint.LedgerResponse
-	4, // 9: policyadministrationpoint.V1PAPService.UpdateLedger:output_type -> policyadministrationpoint.LedgerResponse
-	4, // 10: policyadministrationpoint.V1PAPService.DeleteLedger:output_type -> policyadministrationpoint.LedgerResponse
-	4, // 11: policyadministrationpoint.V1PAPService.FetchLedgers:output_type -> policyadministrationpoint.LedgerResponse
-	6, // 12: policyadministrationpoint.V1PAPService.ReceivePack:output_type -> policyadministrationpoint.PackMessage
-	6, // 13: policyadministrationpoint.V1PAPService.NOTPStream:output_type -> policyadministrationpoint.PackMessage
-	8, // [8:14] is the sub-list for method output_type
-	2, // [2:8] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	30, // 0: policyadministrationpoint.FetchQuery.CreatedAfter:type_name -> google.protobuf.Timestamp
+	30, // 1: policyadministrationpoint.FetchQuery.CreatedBefore:type_name -> google.protobuf.Timestamp
+	0,  // 2: policyadministrationpoint.LedgerFetchRequest.Query:type_name -> policyadministrationpoint.FetchQuery
+	30, // 3: policyadministrationpoint.LedgerResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	30, // 4: policyadministrationpoint.LedgerResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	31, // 5: policyadministrationpoint.EntityRequest.Attributes:type_name -> google.protobuf.Struct
+	9,  // 6: policyadministrationpoint.EntityRequest.Parents:type_name -> policyadministrationpoint.EntityUID
+	10, // 7: policyadministrationpoint.EntityUpsertRequest.Entity:type_name -> policyadministrationpoint.EntityRequest
+	10, // 8: policyadministrationpoint.EntityBulkUpsertRequest.Entities:type_name -> policyadministrationpoint.EntityRequest
+	30, // 9: policyadministrationpoint.EntityResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	30, // 10: policyadministrationpoint.EntityResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	31, // 11: policyadministrationpoint.EntityResponse.Attributes:type_name -> google.protobuf.Struct
+	9,  // 12: policyadministrationpoint.EntityResponse.Parents:type_name -> policyadministrationpoint.EntityUID
+	15, // 13: policyadministrationpoint.EntityBulkUpsertResponse.Entities:type_name -> policyadministrationpoint.EntityResponse
+	28, // 14: policyadministrationpoint.TemplateLinkCreateRequest.Slots:type_name -> policyadministrationpoint.TemplateLinkCreateRequest.SlotsEntry
+	30, // 15: policyadministrationpoint.TemplateLinkResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	30, // 16: policyadministrationpoint.TemplateLinkResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	29, // 17: policyadministrationpoint.TemplateLinkResponse.Slots:type_name -> policyadministrationpoint.TemplateLinkResponse.SlotsEntry
+	30, // 18: policyadministrationpoint.ChangeRequestReview.CreatedAt:type_name -> google.protobuf.Timestamp
+	30, // 19: policyadministrationpoint.ChangeRequestResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	30, // 20: policyadministrationpoint.ChangeRequestResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	23, // 21: policyadministrationpoint.ChangeRequestResponse.Plan:type_name -> policyadministrationpoint.ChangeRequestPlanItem
+	24, // 22: policyadministrationpoint.ChangeRequestResponse.Reviews:type_name -> policyadministrationpoint.ChangeRequestReview
+	9,  // 23: policyadministrationpoint.TemplateLinkCreateRequest.SlotsEntry.value:type_name -> policyadministrationpoint.EntityUID
+	9,  // 24: policyadministrationpoint.TemplateLinkResponse.SlotsEntry.value:type_name -> policyadministrationpoint.EntityUID
+	2,  // 25: policyadministrationpoint.V1PAPService.CreateLedger:input_type -> policyadministrationpoint.LedgerCreateRequest
+	3,  // 26: policyadministrationpoint.V1PAPService.UpdateLedger:input_type -> policyadministrationpoint.LedgerUpdateRequest
+	5,  // 27: policyadministrationpoint.V1PAPService.UpdateLedgerCandidate:input_type -> policyadministrationpoint.LedgerCandidateUpdateRequest
+	6,  // 28: policyadministrationpoint.V1PAPService.PromoteLedgerCandidate:input_type -> policyadministrationpoint.LedgerCandidatePromoteRequest
+	7,  // 29: policyadministrationpoint.V1PAPService.UpdateLedgerProtection:input_type -> policyadministrationpoint.LedgerProtectionUpdateRequest
+	4,  // 30: policyadministrationpoint.V1PAPService.DeleteLedger:input_type -> policyadministrationpoint.LedgerDeleteRequest
+	1,  // 31: policyadministrationpoint.V1PAPService.FetchLedgers:input_type -> policyadministrationpoint.LedgerFetchRequest
+	11, // 32: policyadministrationpoint.V1PAPService.CreateEntity:input_type -> policyadministrationpoint.EntityUpsertRequest
+	11, // 33: policyadministrationpoint.V1PAPService.UpdateEntity:input_type -> policyadministrationpoint.EntityUpsertRequest
+	12, // 34: policyadministrationpoint.V1PAPService.UpsertEntities:input_type -> policyadministrationpoint.EntityBulkUpsertRequest
+	13, // 35: policyadministrationpoint.V1PAPService.DeleteEntity:input_type -> policyadministrationpoint.EntityDeleteRequest
+	14, // 36: policyadministrationpoint.V1PAPService.FetchEntities:input_type -> policyadministrationpoint.EntityFetchRequest
+	17, // 37: policyadministrationpoint.V1PAPService.CreateTemplateLink:input_type -> policyadministrationpoint.TemplateLinkCreateRequest
+	18, // 38: policyadministrationpoint.V1PAPService.DeleteTemplateLink:input_type -> policyadministrationpoint.TemplateLinkDeleteRequest
+	19, // 39: policyadministrationpoint.V1PAPService.FetchTemplateLinks:input_type -> policyadministrationpoint.TemplateLinkFetchRequest
+	21, // 40: policyadministrationpoint.V1PAPService.FetchChangeRequests:input_type -> policyadministrationpoint.ChangeRequestFetchRequest
+	22, // 41: policyadministrationpoint.V1PAPService.ApproveChangeRequest:input_type -> policyadministrationpoint.ChangeRequestReviewRequest
+	22, // 42: policyadministrationpoint.V1PAPService.RejectChangeRequest:input_type -> policyadministrationpoint.ChangeRequestReviewRequest
+	27, // 43: policyadministrationpoint.V1PAPService.ReceivePack:input_type -> policyadministrationpoint.PackMessage
+	27, // 44: policyadministrationpoint.V1PAPService.NOTPStream:input_type -> policyadministrationpoint.PackMessage
+	8,  // 45: policyadministrationpoint.V1PAPService.CreateLedger:output_type -> policyadministrationpoint.LedgerResponse
+	8,  // 46: policyadministrationpoint.V1PAPService.UpdateLedger:output_type -> policyadministrationpoint.LedgerResponse
+	8,  // 47: policyadministrationpoint.V1PAPService.UpdateLedgerCandidate:output_type -> policyadministrationpoint.LedgerResponse
+	8,  // 48: policyadministrationpoint.V1PAPService.PromoteLedgerCandidate:output_type -> policyadministrationpoint.LedgerResponse
+	8,  // 49: policyadministrationpoint.V1PAPService.UpdateLedgerProtection:output_type -> policyadministrationpoint.LedgerResponse
+	8,  // 50: policyadministrationpoint.V1PAPService.DeleteLedger:output_type -> policyadministrationpoint.LedgerResponse
+	8,  // 51: policyadministrationpoint.V1PAPService.FetchLedgers:output_type -> policyadministrationpoint.LedgerResponse
+	15, // 52: policyadministrationpoint.V1PAPService.CreateEntity:output_type -> policyadministrationpoint.EntityResponse
+	15, // 53: policyadministrationpoint.V1PAPService.UpdateEntity:output_type -> policyadministrationpoint.EntityResponse
+	16, // 54: policyadministrationpoint.V1PAPService.UpsertEntities:output_type -> policyadministrationpoint.EntityBulkUpsertResponse
+	15, // 55: policyadministrationpoint.V1PAPService.DeleteEntity:output_type -> policyadministrationpoint.EntityResponse
+	15, // 56: policyadministrationpoint.V1PAPService.FetchEntities:output_type -> policyadministrationpoint.EntityResponse
+	20, // 57: policyadministrationpoint.V1PAPService.CreateTemplateLink:output_type -> policyadministrationpoint.TemplateLinkResponse
+	20, // 58: policyadministrationpoint.V1PAPService.DeleteTemplateLink:output_type -> policyadministrationpoint.TemplateLinkResponse
+	20, // 59: policyadministrationpoint.V1PAPService.FetchTemplateLinks:output_type -> policyadministrationpoint.TemplateLinkResponse
+	25, // 60: policyadministrationpoint.V1PAPService.FetchChangeRequests:output_type -> policyadministrationpoint.ChangeRequestResponse
+	25, // 61: policyadministrationpoint.V1PAPService.ApproveChangeRequest:output_type -> policyadministrationpoint.ChangeRequestResponse
+	25, // 62: policyadministrationpoint.V1PAPService.RejectChangeRequest:output_type -> policyadministrationpoint.ChangeRequestResponse
+	27, // 63: policyadministrationpoint.V1PAPService.ReceivePack:output_type -> policyadministrationpoint.PackMessage
+	27, // 64: policyadministrationpoint.V1PAPService.NOTPStream:output_type -> policyadministrationpoint.PackMessage
+	45, // [45:65] is the sub-list for method output_type
+	25, // [25:45] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_internal_agents_services_pap_endpoints_api_v1_pap_proto_init() }
@@ -661,13 +2677,24 @@ func file_internal_agents_services_pap_endpoints_api_v1_pap_proto_init() {
 		return
 	}
 	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[0].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[1].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[8].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[10].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[11].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[12].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[14].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[17].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[19].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[21].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[22].OneofWrappers = []any{}
+	file_internal_agents_services_pap_endpoints_api_v1_pap_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDesc), len(file_internal_agents_services_pap_endpoints_api_v1_pap_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,12 +35,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	V1PAPService_CreateLedger_FullMethodName = "/policyadministrationpoint.V1PAPService/CreateLedger"
-	V1PAPService_UpdateLedger_FullMethodName = "/policyadministrationpoint.V1PAPService/UpdateLedger"
-	V1PAPService_DeleteLedger_FullMethodName = "/policyadministrationpoint.V1PAPService/DeleteLedger"
-	V1PAPService_FetchLedgers_FullMethodName = "/policyadministrationpoint.V1PAPService/FetchLedgers"
-	V1PAPService_ReceivePack_FullMethodName  = "/policyadministrationpoint.V1PAPService/ReceivePack"
-	V1PAPService_NOTPStream_FullMethodName   = "/policyadministrationpoint.V1PAPService/NOTPStream"
+	V1PAPService_CreateLedger_FullMethodName           = "/policyadministrationpoint.V1PAPService/CreateLedger"
+	V1PAPService_UpdateLedger_FullMethodName           = "/policyadministrationpoint.V1PAPService/UpdateLedger"
+	V1PAPService_UpdateLedgerCandidate_FullMethodName  = "/policyadministrationpoint.V1PAPService/UpdateLedgerCandidate"
+	V1PAPService_PromoteLedgerCandidate_FullMethodName = "/policyadministrationpoint.V1PAPService/PromoteLedgerCandidate"
+	V1PAPService_UpdateLedgerProtection_FullMethodName = "/policyadministrationpoint.V1PAPService/UpdateLedgerProtection"
+	V1PAPService_DeleteLedger_FullMethodName           = "/policyadministrationpoint.V1PAPService/DeleteLedger"
+	V1PAPService_FetchLedgers_FullMethodName           = "/policyadministrationpoint.V1PAPService/FetchLedgers"
+	V1PAPService_CreateEntity_FullMethodName           = "/policyadministrationpoint.V1PAPService/CreateEntity"
+	V1PAPService_UpdateEntity_FullMethodName           = "/policyadministrationpoint.V1PAPService/UpdateEntity"
+	V1PAPService_UpsertEntities_FullMethodName         = "/policyadministrationpoint.V1PAPService/UpsertEntities"
+	V1PAPService_DeleteEntity_FullMethodName           = "/policyadministrationpoint.V1PAPService/DeleteEntity"
+	V1PAPService_FetchEntities_FullMethodName          = "/policyadministrationpoint.V1PAPService/FetchEntities"
+	V1PAPService_CreateTemplateLink_FullMethodName     = "/policyadministrationpoint.V1PAPService/CreateTemplateLink"
+	V1PAPService_DeleteTemplateLink_FullMethodName     = "/policyadministrationpoint.V1PAPService/DeleteTemplateLink"
+	V1PAPService_FetchTemplateLinks_FullMethodName     = "/policyadministrationpoint.V1PAPService/FetchTemplateLinks"
+	V1PAPService_FetchChangeRequests_FullMethodName    = "/policyadministrationpoint.V1PAPService/FetchChangeRequests"
+	V1PAPService_ApproveChangeRequest_FullMethodName   = "/policyadministrationpoint.V1PAPService/ApproveChangeRequest"
+	V1PAPService_RejectChangeRequest_FullMethodName    = "/policyadministrationpoint.V1PAPService/RejectChangeRequest"
+	V1PAPService_ReceivePack_FullMethodName            = "/policyadministrationpoint.V1PAPService/ReceivePack"
+	V1PAPService_NOTPStream_FullMethodName             = "/policyadministrationpoint.V1PAPService/NOTPStream"
 )
 
 // V1PAPServiceClient is the client API for V1PAPService service.
@@ -53,10 +67,38 @@ type V1PAPServiceClient interface {
 	CreateLedger(ctx context.Context, in *LedgerCreateRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
 	// Update an ledger.
 	UpdateLedger(ctx context.Context, in *LedgerUpdateRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
+	// Update the candidate ref of a ledger evaluated in shadow mode.
+	UpdateLedgerCandidate(ctx context.Context, in *LedgerCandidateUpdateRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
+	// Promote the candidate ref of a ledger to its active ref.
+	PromoteLedgerCandidate(ctx context.Context, in *LedgerCandidatePromoteRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
+	// Update the protection of a ledger.
+	UpdateLedgerProtection(ctx context.Context, in *LedgerProtectionUpdateRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
 	// Delete an ledger.
 	DeleteLedger(ctx context.Context, in *LedgerDeleteRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
 	// Fetch ledgers.
 	FetchLedgers(ctx context.Context, in *LedgerFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LedgerResponse], error)
+	// Create an entity.
+	CreateEntity(ctx context.Context, in *EntityUpsertRequest, opts ...grpc.CallOption) (*EntityResponse, error)
+	// Update an entity.
+	UpdateEntity(ctx context.Context, in *EntityUpsertRequest, opts ...grpc.CallOption) (*EntityResponse, error)
+	// Create or replace a set of entities.
+	UpsertEntities(ctx context.Context, in *EntityBulkUpsertRequest, opts ...grpc.CallOption) (*EntityBulkUpsertResponse, error)
+	// Delete an entity.
+	DeleteEntity(ctx context.Context, in *EntityDeleteRequest, opts ...grpc.CallOption) (*EntityResponse, error)
+	// Fetch entities.
+	FetchEntities(ctx context.Context, in *EntityFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EntityResponse], error)
+	// Create a template link.
+	CreateTemplateLink(ctx context.Context, in *TemplateLinkCreateRequest, opts ...grpc.CallOption) (*TemplateLinkResponse, error)
+	// Delete a template link.
+	DeleteTemplateLink(ctx context.Context, in *TemplateLinkDeleteRequest, opts ...grpc.CallOption) (*TemplateLinkResponse, error)
+	// Fetch template links.
+	FetchTemplateLinks(ctx context.Context, in *TemplateLinkFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TemplateLinkResponse], error)
+	// Fetch change requests.
+	FetchChangeRequests(ctx context.Context, in *ChangeRequestFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangeRequestResponse], error)
+	// Approve a change request.
+	ApproveChangeRequest(ctx context.Context, in *ChangeRequestReviewRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error)
+	// Reject a change request.
+	RejectChangeRequest(ctx context.Context, in *ChangeRequestReviewRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error)
 	// ReceivePack receives objects from the client.
 	ReceivePack(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PackMessage, PackMessage], error)
 	// NOTPStream handles bidirectional stream using the NOTP protocol.
@@ -91,6 +133,36 @@ func (c *v1PAPServiceClient) UpdateLedger(ctx context.Context, in *LedgerUpdateR
 	return out, nil
 }
 
+func (c *v1PAPServiceClient) UpdateLedgerCandidate(ctx context.Context, in *LedgerCandidateUpdateRequest, opts ...grpc.CallOption) (*LedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LedgerResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_UpdateLedgerCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PAPServiceClient) PromoteLedgerCandidate(ctx context.Context, in *LedgerCandidatePromoteRequest, opts ...grpc.CallOption) (*LedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LedgerResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_PromoteLedgerCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PAPServiceClient) UpdateLedgerProtection(ctx context.Context, in *LedgerProtectionUpdateRequest, opts ...grpc.CallOption) (*LedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LedgerResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_UpdateLedgerProtection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *v1PAPServiceClient) DeleteLedger(ctx context.Context, in *LedgerDeleteRequest, opts ...grpc.CallOption) (*LedgerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LedgerResponse)
@@ -120,9 +192,146 @@ func (c *v1PAPServiceClient) FetchLedgers(ctx context.Context, in *LedgerFetchRe
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type V1PAPService_FetchLedgersClient = grpc.ServerStreamingClient[LedgerResponse]
 
+func (c *v1PAPServiceClient) CreateEntity(ctx context.Context, in *EntityUpsertRequest, opts ...grpc.CallOption) (*EntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntityResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_CreateEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PAPServiceClient) UpdateEntity(ctx context.Context, in *EntityUpsertRequest, opts ...grpc.CallOption) (*EntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntityResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_UpdateEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PAPServiceClient) UpsertEntities(ctx context.Context, in *EntityBulkUpsertRequest, opts ...grpc.CallOption) (*EntityBulkUpsertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntityBulkUpsertResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_UpsertEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PAPServiceClient) DeleteEntity(ctx context.Context, in *EntityDeleteRequest, opts ...grpc.CallOption) (*EntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntityResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_DeleteEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PAPServiceClient) FetchEntities(ctx context.Context, in *EntityFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EntityResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &V1PAPService_ServiceDesc.Streams[1], V1PAPService_FetchEntities_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EntityFetchRequest, EntityResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1PAPService_FetchEntitiesClient = grpc.ServerStreamingClient[EntityResponse]
+
+func (c *v1PAPServiceClient) CreateTemplateLink(ctx context.Context, in *TemplateLinkCreateRequest, opts ...grpc.CallOption) (*TemplateLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateLinkResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_CreateTemplateLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PAPServiceClient) DeleteTemplateLink(ctx context.Context, in *TemplateLinkDeleteRequest, opts ...grpc.CallOption) (*TemplateLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateLinkResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_DeleteTemplateLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PAPServiceClient) FetchTemplateLinks(ctx context.Context, in *TemplateLinkFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TemplateLinkResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &V1PAPService_ServiceDesc.Streams[2], V1PAPService_FetchTemplateLinks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TemplateLinkFetchRequest, TemplateLinkResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1PAPService_FetchTemplateLinksClient = grpc.ServerStreamingClient[TemplateLinkResponse]
+
+func (c *v1PAPServiceClient) FetchChangeRequests(ctx context.Context, in *ChangeRequestFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangeRequestResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &V1PAPService_ServiceDesc.Streams[3], V1PAPService_FetchChangeRequests_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChangeRequestFetchRequest, ChangeRequestResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1PAPService_FetchChangeRequestsClient = grpc.ServerStreamingClient[ChangeRequestResponse]
+
+func (c *v1PAPServiceClient) ApproveChangeRequest(ctx context.Context, in *ChangeRequestReviewRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeRequestResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_ApproveChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PAPServiceClient) RejectChangeRequest(ctx context.Context, in *ChangeRequestReviewRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeRequestResponse)
+	err := c.cc.Invoke(ctx, V1PAPService_RejectChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *v1PAPServiceClient) ReceivePack(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PackMessage, PackMessage], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &V1PAPService_ServiceDesc.Streams[1], V1PAPService_ReceivePack_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &V1PAPService_ServiceDesc.Streams[4], V1PAPService_ReceivePack_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +344,7 @@ type V1PAPService_ReceivePackClient = grpc.BidiStreamingClient[PackMessage, Pack
 
 func (c *v1PAPServiceClient) NOTPStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PackMessage, PackMessage], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &V1PAPService_ServiceDesc.Streams[2], V1PAPService_NOTPStream_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &V1PAPService_ServiceDesc.Streams[5], V1PAPService_NOTPStream_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -156,10 +365,38 @@ type V1PAPServiceServer interface {
 	CreateLedger(context.Context, *LedgerCreateRequest) (*LedgerResponse, error)
 	// Update an ledger.
 	UpdateLedger(context.Context, *LedgerUpdateRequest) (*LedgerResponse, error)
+	// Update the candidate ref of a ledger evaluated in shadow mode.
+	UpdateLedgerCandidate(context.Context, *LedgerCandidateUpdateRequest) (*LedgerResponse, error)
+	// Promote the candidate ref of a ledger to its active ref.
+	PromoteLedgerCandidate(context.Context, *LedgerCandidatePromoteRequest) (*LedgerResponse, error)
+	// Update the protection of a ledger.
+	UpdateLedgerProtection(context.Context, *LedgerProtectionUpdateRequest) (*LedgerResponse, error)
 	// Delete an ledger.
 	DeleteLedger(context.Context, *LedgerDeleteRequest) (*LedgerResponse, error)
 	// Fetch ledgers.
 	FetchLedgers(*LedgerFetchRequest, grpc.ServerStreamingServer[LedgerResponse]) error
+	// Create an entity.
+	CreateEntity(context.Context, *EntityUpsertRequest) (*EntityResponse, error)
+	// Update an entity.
+	UpdateEntity(context.Context, *EntityUpsertRequest) (*EntityResponse, error)
+	// Create or replace a set of entities.
+	UpsertEntities(context.Context, *EntityBulkUpsertRequest) (*EntityBulkUpsertResponse, error)
+	// Delete an entity.
+	DeleteEntity(context.Context, *EntityDeleteRequest) (*EntityResponse, error)
+	// Fetch entities.
+	FetchEntities(*EntityFetchRequest, grpc.ServerStreamingServer[EntityResponse]) error
+	// Create a template link.
+	CreateTemplateLink(context.Context, *TemplateLinkCreateRequest) (*TemplateLinkResponse, error)
+	// Delete a template link.
+	DeleteTemplateLink(context.Context, *TemplateLinkDeleteRequest) (*TemplateLinkResponse, error)
+	// Fetch template links.
+	FetchTemplateLinks(*TemplateLinkFetchRequest, grpc.ServerStreamingServer[TemplateLinkResponse]) error
+	// Fetch change requests.
+	FetchChangeRequests(*ChangeRequestFetchRequest, grpc.ServerStreamingServer[ChangeRequestResponse]) error
+	// Approve a change request.
+	ApproveChangeRequest(context.Context, *ChangeRequestReviewRequest) (*ChangeRequestResponse, error)
+	// Reject a change request.
+	RejectChangeRequest(context.Context, *ChangeRequestReviewRequest) (*ChangeRequestResponse, error)
 	// ReceivePack receives objects from the client.
 	ReceivePack(grpc.BidiStreamingServer[PackMessage, PackMessage]) error
 	// NOTPStream handles bidirectional stream using the NOTP protocol.
@@ -180,12 +417,54 @@ func (UnimplementedV1PAPServiceServer) CreateLedger(context.Context, *LedgerCrea
 func (UnimplementedV1PAPServiceServer) UpdateLedger(context.Context, *LedgerUpdateRequest) (*LedgerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLedger not implemented")
 }
+func (UnimplementedV1PAPServiceServer) UpdateLedgerCandidate(context.Context, *LedgerCandidateUpdateRequest) (*LedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLedgerCandidate not implemented")
+}
+func (UnimplementedV1PAPServiceServer) PromoteLedgerCandidate(context.Context, *LedgerCandidatePromoteRequest) (*LedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteLedgerCandidate not implemented")
+}
+func (UnimplementedV1PAPServiceServer) UpdateLedgerProtection(context.Context, *LedgerProtectionUpdateRequest) (*LedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLedgerProtection not implemented")
+}
 func (UnimplementedV1PAPServiceServer) DeleteLedger(context.Context, *LedgerDeleteRequest) (*LedgerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLedger not implemented")
 }
 func (UnimplementedV1PAPServiceServer) FetchLedgers(*LedgerFetchRequest, grpc.ServerStreamingServer[LedgerResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method FetchLedgers not implemented")
 }
+func (UnimplementedV1PAPServiceServer) CreateEntity(context.Context, *EntityUpsertRequest) (*EntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntity not implemented")
+}
+func (UnimplementedV1PAPServiceServer) UpdateEntity(context.Context, *EntityUpsertRequest) (*EntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntity not implemented")
+}
+func (UnimplementedV1PAPServiceServer) UpsertEntities(context.Context, *EntityBulkUpsertRequest) (*EntityBulkUpsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertEntities not implemented")
+}
+func (UnimplementedV1PAPServiceServer) DeleteEntity(context.Context, *EntityDeleteRequest) (*EntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntity not implemented")
+}
+func (UnimplementedV1PAPServiceServer) FetchEntities(*EntityFetchRequest, grpc.ServerStreamingServer[EntityResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method FetchEntities not implemented")
+}
+func (UnimplementedV1PAPServiceServer) CreateTemplateLink(context.Context, *TemplateLinkCreateRequest) (*TemplateLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateLink not implemented")
+}
+func (UnimplementedV1PAPServiceServer) DeleteTemplateLink(context.Context, *TemplateLinkDeleteRequest) (*TemplateLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateLink not implemented")
+}
+func (UnimplementedV1PAPServiceServer) FetchTemplateLinks(*TemplateLinkFetchRequest, grpc.ServerStreamingServer[TemplateLinkResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method FetchTemplateLinks not implemented")
+}
+func (UnimplementedV1PAPServiceServer) FetchChangeRequests(*ChangeRequestFetchRequest, grpc.ServerStreamingServer[ChangeRequestResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method FetchChangeRequests not implemented")
+}
+func (UnimplementedV1PAPServiceServer) ApproveChangeRequest(context.Context, *ChangeRequestReviewRequest) (*ChangeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveChangeRequest not implemented")
+}
+func (UnimplementedV1PAPServiceServer) RejectChangeRequest(context.Context, *ChangeRequestReviewRequest) (*ChangeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectChangeRequest not implemented")
+}
 func (UnimplementedV1PAPServiceServer) ReceivePack(grpc.BidiStreamingServer[PackMessage, PackMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method ReceivePack not implemented")
 }
@@ -249,6 +528,60 @@ func _V1PAPService_UpdateLedger_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _V1PAPService_UpdateLedgerCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerCandidateUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).UpdateLedgerCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_UpdateLedgerCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).UpdateLedgerCandidate(ctx, req.(*LedgerCandidateUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PAPService_PromoteLedgerCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerCandidatePromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).PromoteLedgerCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_PromoteLedgerCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).PromoteLedgerCandidate(ctx, req.(*LedgerCandidatePromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PAPService_UpdateLedgerProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerProtectionUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).UpdateLedgerProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_UpdateLedgerProtection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).UpdateLedgerProtection(ctx, req.(*LedgerProtectionUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _V1PAPService_DeleteLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LedgerDeleteRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +611,183 @@ func _V1PAPService_FetchLedgers_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type V1PAPService_FetchLedgersServer = grpc.ServerStreamingServer[LedgerResponse]
 
+func _V1PAPService_CreateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityUpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).CreateEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_CreateEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).CreateEntity(ctx, req.(*EntityUpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PAPService_UpdateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityUpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).UpdateEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_UpdateEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).UpdateEntity(ctx, req.(*EntityUpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PAPService_UpsertEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityBulkUpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).UpsertEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_UpsertEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).UpsertEntities(ctx, req.(*EntityBulkUpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PAPService_DeleteEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).DeleteEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_DeleteEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).DeleteEntity(ctx, req.(*EntityDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PAPService_FetchEntities_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EntityFetchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(V1PAPServiceServer).FetchEntities(m, &grpc.GenericServerStream[EntityFetchRequest, EntityResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1PAPService_FetchEntitiesServer = grpc.ServerStreamingServer[EntityResponse]
+
+func _V1PAPService_CreateTemplateLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateLinkCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).CreateTemplateLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_CreateTemplateLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).CreateTemplateLink(ctx, req.(*TemplateLinkCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PAPService_DeleteTemplateLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateLinkDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).DeleteTemplateLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_DeleteTemplateLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).DeleteTemplateLink(ctx, req.(*TemplateLinkDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PAPService_FetchTemplateLinks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TemplateLinkFetchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(V1PAPServiceServer).FetchTemplateLinks(m, &grpc.GenericServerStream[TemplateLinkFetchRequest, TemplateLinkResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1PAPService_FetchTemplateLinksServer = grpc.ServerStreamingServer[TemplateLinkResponse]
+
+func _V1PAPService_FetchChangeRequests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChangeRequestFetchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(V1PAPServiceServer).FetchChangeRequests(m, &grpc.GenericServerStream[ChangeRequestFetchRequest, ChangeRequestResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1PAPService_FetchChangeRequestsServer = grpc.ServerStreamingServer[ChangeRequestResponse]
+
+func _V1PAPService_ApproveChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeRequestReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).ApproveChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_ApproveChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).ApproveChangeRequest(ctx, req.(*ChangeRequestReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PAPService_RejectChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeRequestReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PAPServiceServer).RejectChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PAPService_RejectChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PAPServiceServer).RejectChangeRequest(ctx, req.(*ChangeRequestReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _V1PAPService_ReceivePack_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(V1PAPServiceServer).ReceivePack(&grpc.GenericServerStream[PackMessage, PackMessage]{ServerStream: stream})
 }
@@ -307,10 +817,54 @@ var V1PAPService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLedger",
 			Handler:    _V1PAPService_UpdateLedger_Handler,
 		},
+		{
+			MethodName: "UpdateLedgerCandidate",
+			Handler:    _V1PAPService_UpdateLedgerCandidate_Handler,
+		},
+		{
+			MethodName: "PromoteLedgerCandidate",
+			Handler:    _V1PAPService_PromoteLedgerCandidate_Handler,
+		},
+		{
+			MethodName: "UpdateLedgerProtection",
+			Handler:    _V1PAPService_UpdateLedgerProtection_Handler,
+		},
 		{
 			MethodName: "DeleteLedger",
 			Handler:    _V1PAPService_DeleteLedger_Handler,
 		},
+		{
+			MethodName: "CreateEntity",
+			Handler:    _V1PAPService_CreateEntity_Handler,
+		},
+		{
+			MethodName: "UpdateEntity",
+			Handler:    _V1PAPService_UpdateEntity_Handler,
+		},
+		{
+			MethodName: "UpsertEntities",
+			Handler:    _V1PAPService_UpsertEntities_Handler,
+		},
+		{
+			MethodName: "DeleteEntity",
+			Handler:    _V1PAPService_DeleteEntity_Handler,
+		},
+		{
+			MethodName: "CreateTemplateLink",
+			Handler:    _V1PAPService_CreateTemplateLink_Handler,
+		},
+		{
+			MethodName: "DeleteTemplateLink",
+			Handler:    _V1PAPService_DeleteTemplateLink_Handler,
+		},
+		{
+			MethodName: "ApproveChangeRequest",
+			Handler:    _V1PAPService_ApproveChangeRequest_Handler,
+		},
+		{
+			MethodName: "RejectChangeRequest",
+			Handler:    _V1PAPService_RejectChangeRequest_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -318,6 +872,21 @@ var V1PAPService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _V1PAPService_FetchLedgers_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "FetchEntities",
+			Handler:       _V1PAPService_FetchEntities_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FetchTemplateLinks",
+			Handler:       _V1PAPService_FetchTemplateLinks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FetchChangeRequests",
+			Handler:       _V1PAPService_FetchChangeRequests_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ReceivePack",
 			Handler:       _V1PAPService_ReceivePack_Handler,
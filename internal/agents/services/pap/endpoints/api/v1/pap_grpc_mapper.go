@@ -17,27 +17,32 @@
 package v1
 
 import (
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
 )
 
 // MapGrpcLedgerResponseToAgentLedger maps the gRPC ledger to the agent ledger.
 func MapGrpcLedgerResponseToAgentLedger(ledger *LedgerResponse) (*azmodelspap.Ledger, error) {
 	return &azmodelspap.Ledger{
-		LedgerID:  ledger.LedgerID,
-		CreatedAt: ledger.CreatedAt.AsTime(),
-		UpdatedAt: ledger.UpdatedAt.AsTime(),
-		ZoneID:    ledger.ZoneID,
-		Kind:      ledger.Kind,
-		Name:      ledger.Name,
-		Ref:       ledger.Ref,
+		LedgerID:          ledger.LedgerID,
+		CreatedAt:         ledger.CreatedAt.AsTime(),
+		UpdatedAt:         ledger.UpdatedAt.AsTime(),
+		ZoneID:            ledger.ZoneID,
+		Kind:              ledger.Kind,
+		Name:              ledger.Name,
+		Ref:               ledger.Ref,
+		CandidateRef:      MapPointerStringToString(ledger.CandidateRef),
+		RequiredApprovals: ledger.GetRequiredApprovals(),
 	}, nil
 }
 
 // MapAgentLedgerToGrpcLedgerResponse maps the agent ledger to the gRPC ledger.
 func MapAgentLedgerToGrpcLedgerResponse(ledger *azmodelspap.Ledger) (*LedgerResponse, error) {
-	return &LedgerResponse{
+	ledgerResponse := &LedgerResponse{
 		LedgerID:  ledger.LedgerID,
 		CreatedAt: timestamppb.New(ledger.CreatedAt),
 		UpdatedAt: timestamppb.New(ledger.UpdatedAt),
@@ -45,6 +50,234 @@ func MapAgentLedgerToGrpcLedgerResponse(ledger *azmodelspap.Ledger) (*LedgerResp
 		Kind:      ledger.Kind,
 		Name:      ledger.Name,
 		Ref:       ledger.Ref,
+	}
+	if ledger.CandidateRef != "" {
+		ledgerResponse.CandidateRef = &ledger.CandidateRef
+	}
+	if ledger.RequiredApprovals > 0 {
+		ledgerResponse.RequiredApprovals = &ledger.RequiredApprovals
+	}
+	return ledgerResponse, nil
+}
+
+// MapGrpcEntityRequestToAgentEntity maps the gRPC entity request to the agent entity.
+func MapGrpcEntityRequestToAgentEntity(zoneID int64, entity *EntityRequest) (*azmodelspap.Entity, error) {
+	if entity == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "entity is missing")
+	}
+	attributes := map[string]any{}
+	if entity.Attributes != nil {
+		attributes = entity.Attributes.AsMap()
+	}
+	parents := make([]azmodelspap.EntityUID, 0, len(entity.Parents))
+	for _, parent := range entity.Parents {
+		parents = append(parents, azmodelspap.EntityUID{Type: parent.Type, ID: parent.ID})
+	}
+	return &azmodelspap.Entity{
+		ZoneID:     zoneID,
+		Type:       entity.Type,
+		ID:         entity.ID,
+		Attributes: attributes,
+		Parents:    parents,
+	}, nil
+}
+
+// MapAgentEntityToGrpcEntityRequest maps the agent entity to the gRPC entity request.
+func MapAgentEntityToGrpcEntityRequest(entity *azmodelspap.Entity) (*EntityRequest, error) {
+	attributes, err := structpb.NewStruct(entity.Attributes)
+	if err != nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "entity attributes cannot be converted")
+	}
+	parents := make([]*EntityUID, 0, len(entity.Parents))
+	for _, parent := range entity.Parents {
+		parents = append(parents, &EntityUID{Type: parent.Type, ID: parent.ID})
+	}
+	return &EntityRequest{
+		Type:       entity.Type,
+		ID:         entity.ID,
+		Attributes: attributes,
+		Parents:    parents,
+	}, nil
+}
+
+// MapGrpcEntityResponseToAgentEntity maps the gRPC entity to the agent entity.
+func MapGrpcEntityResponseToAgentEntity(entity *EntityResponse) (*azmodelspap.Entity, error) {
+	attributes := map[string]any{}
+	if entity.Attributes != nil {
+		attributes = entity.Attributes.AsMap()
+	}
+	parents := make([]azmodelspap.EntityUID, 0, len(entity.Parents))
+	for _, parent := range entity.Parents {
+		parents = append(parents, azmodelspap.EntityUID{Type: parent.Type, ID: parent.ID})
+	}
+	return &azmodelspap.Entity{
+		ZoneID:     entity.ZoneID,
+		Type:       entity.Type,
+		ID:         entity.ID,
+		CreatedAt:  entity.CreatedAt.AsTime(),
+		UpdatedAt:  entity.UpdatedAt.AsTime(),
+		Attributes: attributes,
+		Parents:    parents,
+	}, nil
+}
+
+// MapAgentEntityToGrpcEntityResponse maps the agent entity to the gRPC entity.
+func MapAgentEntityToGrpcEntityResponse(entity *azmodelspap.Entity) (*EntityResponse, error) {
+	attributes, err := structpb.NewStruct(entity.Attributes)
+	if err != nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "entity attributes cannot be converted")
+	}
+	parents := make([]*EntityUID, 0, len(entity.Parents))
+	for _, parent := range entity.Parents {
+		parents = append(parents, &EntityUID{Type: parent.Type, ID: parent.ID})
+	}
+	return &EntityResponse{
+		ZoneID:     entity.ZoneID,
+		Type:       entity.Type,
+		ID:         entity.ID,
+		CreatedAt:  timestamppb.New(entity.CreatedAt),
+		UpdatedAt:  timestamppb.New(entity.UpdatedAt),
+		Attributes: attributes,
+		Parents:    parents,
+	}, nil
+}
+
+// MapGrpcTemplateLinkCreateRequestToAgentTemplateLink maps the gRPC template link create request to the agent template link.
+func MapGrpcTemplateLinkCreateRequestToAgentTemplateLink(link *TemplateLinkCreateRequest) *azmodelspap.TemplateLink {
+	slots := make(map[string]azmodelspap.EntityUID, len(link.Slots))
+	for slot, entity := range link.Slots {
+		if entity == nil {
+			continue
+		}
+		slots[slot] = azmodelspap.EntityUID{Type: entity.Type, ID: entity.ID}
+	}
+	return &azmodelspap.TemplateLink{
+		ZoneID:     link.ZoneID,
+		LedgerID:   link.LedgerID,
+		LinkID:     MapPointerStringToString(link.LinkID),
+		TemplateID: link.TemplateID,
+		Slots:      slots,
+	}
+}
+
+// MapAgentTemplateLinkToGrpcTemplateLinkCreateRequest maps the agent template link to the gRPC template link create request.
+func MapAgentTemplateLinkToGrpcTemplateLinkCreateRequest(link *azmodelspap.TemplateLink) *TemplateLinkCreateRequest {
+	slots := make(map[string]*EntityUID, len(link.Slots))
+	for slot, entity := range link.Slots {
+		slots[slot] = &EntityUID{Type: entity.Type, ID: entity.ID}
+	}
+	request := &TemplateLinkCreateRequest{
+		ZoneID:     link.ZoneID,
+		LedgerID:   link.LedgerID,
+		TemplateID: link.TemplateID,
+		Slots:      slots,
+	}
+	if link.LinkID != "" {
+		request.LinkID = &link.LinkID
+	}
+	return request
+}
+
+// MapGrpcTemplateLinkResponseToAgentTemplateLink maps the gRPC template link to the agent template link.
+func MapGrpcTemplateLinkResponseToAgentTemplateLink(link *TemplateLinkResponse) (*azmodelspap.TemplateLink, error) {
+	slots := make(map[string]azmodelspap.EntityUID, len(link.Slots))
+	for slot, entity := range link.Slots {
+		if entity == nil {
+			continue
+		}
+		slots[slot] = azmodelspap.EntityUID{Type: entity.Type, ID: entity.ID}
+	}
+	return &azmodelspap.TemplateLink{
+		ZoneID:     link.ZoneID,
+		LedgerID:   link.LedgerID,
+		LinkID:     link.LinkID,
+		CreatedAt:  link.CreatedAt.AsTime(),
+		UpdatedAt:  link.UpdatedAt.AsTime(),
+		TemplateID: link.TemplateID,
+		Slots:      slots,
+	}, nil
+}
+
+// MapAgentTemplateLinkToGrpcTemplateLinkResponse maps the agent template link to the gRPC template link.
+func MapAgentTemplateLinkToGrpcTemplateLinkResponse(link *azmodelspap.TemplateLink) (*TemplateLinkResponse, error) {
+	slots := make(map[string]*EntityUID, len(link.Slots))
+	for slot, entity := range link.Slots {
+		slots[slot] = &EntityUID{Type: entity.Type, ID: entity.ID}
+	}
+	return &TemplateLinkResponse{
+		ZoneID:     link.ZoneID,
+		LedgerID:   link.LedgerID,
+		LinkID:     link.LinkID,
+		CreatedAt:  timestamppb.New(link.CreatedAt),
+		UpdatedAt:  timestamppb.New(link.UpdatedAt),
+		TemplateID: link.TemplateID,
+		Slots:      slots,
+	}, nil
+}
+
+// MapGrpcChangeRequestResponseToAgentChangeRequest maps the gRPC change request to the agent change request.
+func MapGrpcChangeRequestResponseToAgentChangeRequest(changeRequest *ChangeRequestResponse) (*azmodelspap.ChangeRequest, error) {
+	plan := make([]azmodelspap.ChangeRequestPlanItem, 0, len(changeRequest.Plan))
+	for _, item := range changeRequest.Plan {
+		plan = append(plan, azmodelspap.ChangeRequestPlanItem{CodeID: item.CodeID, CodeType: item.CodeType, OID: item.OID, State: item.State})
+	}
+	reviews := make([]azmodelspap.ChangeRequestReview, 0, len(changeRequest.Reviews))
+	for _, review := range changeRequest.Reviews {
+		reviews = append(reviews, azmodelspap.ChangeRequestReview{
+			Reviewer:  review.Reviewer,
+			CreatedAt: review.CreatedAt.AsTime(),
+			Decision:  review.Decision,
+			Comment:   MapPointerStringToString(review.Comment),
+		})
+	}
+	return &azmodelspap.ChangeRequest{
+		ChangeRequestID:   changeRequest.ChangeRequestID,
+		CreatedAt:         changeRequest.CreatedAt.AsTime(),
+		UpdatedAt:         changeRequest.UpdatedAt.AsTime(),
+		ZoneID:            changeRequest.ZoneID,
+		LedgerID:          changeRequest.LedgerID,
+		BaseRef:           changeRequest.BaseRef,
+		CommitID:          changeRequest.CommitID,
+		Author:            changeRequest.Author,
+		Status:            changeRequest.Status,
+		RequiredApprovals: changeRequest.RequiredApprovals,
+		Plan:              plan,
+		Reviews:           reviews,
+	}, nil
+}
+
+// MapAgentChangeRequestToGrpcChangeRequestResponse maps the agent change request to the gRPC change request.
+func MapAgentChangeRequestToGrpcChangeRequestResponse(changeRequest *azmodelspap.ChangeRequest) (*ChangeRequestResponse, error) {
+	plan := make([]*ChangeRequestPlanItem, 0, len(changeRequest.Plan))
+	for _, item := range changeRequest.Plan {
+		plan = append(plan, &ChangeRequestPlanItem{CodeID: item.CodeID, CodeType: item.CodeType, OID: item.OID, State: item.State})
+	}
+	reviews := make([]*ChangeRequestReview, 0, len(changeRequest.Reviews))
+	for _, review := range changeRequest.Reviews {
+		grpcReview := &ChangeRequestReview{
+			Reviewer:  review.Reviewer,
+			CreatedAt: timestamppb.New(review.CreatedAt),
+			Decision:  review.Decision,
+		}
+		if review.Comment != "" {
+			comment := review.Comment
+			grpcReview.Comment = &comment
+		}
+		reviews = append(reviews, grpcReview)
+	}
+	return &ChangeRequestResponse{
+		ChangeRequestID:   changeRequest.ChangeRequestID,
+		CreatedAt:         timestamppb.New(changeRequest.CreatedAt),
+		UpdatedAt:         timestamppb.New(changeRequest.UpdatedAt),
+		ZoneID:            changeRequest.ZoneID,
+		LedgerID:          changeRequest.LedgerID,
+		BaseRef:           changeRequest.BaseRef,
+		CommitID:          changeRequest.CommitID,
+		Author:            changeRequest.Author,
+		Status:            changeRequest.Status,
+		RequiredApprovals: changeRequest.RequiredApprovals,
+		Plan:              plan,
+		Reviews:           reviews,
 	}, nil
 }
 
@@ -56,3 +289,66 @@ func MapPointerStringToString(str *string) string {
 	}
 	return response
 }
+
+// MapGrpcFetchQueryToAgentFields maps the grpc fetch query to the agent fetch fields.
+func MapGrpcFetchQueryToAgentFields(query *FetchQuery, fields map[string]any) {
+	if query == nil {
+		return
+	}
+	if query.Cursor != nil {
+		fields[azmodels.FieldFetchCursor] = *query.Cursor
+	}
+	if query.SortBy != nil {
+		fields[azmodels.FieldFetchSortBy] = *query.SortBy
+	}
+	if query.SortOrder != nil {
+		fields[azmodels.FieldFetchSortOrder] = *query.SortOrder
+	}
+	if query.NamePrefix != nil {
+		fields[azmodels.FieldFetchNamePrefix] = *query.NamePrefix
+	}
+	if query.CreatedAfter != nil {
+		fields[azmodels.FieldFetchCreatedAfter] = query.CreatedAfter.AsTime()
+	}
+	if query.CreatedBefore != nil {
+		fields[azmodels.FieldFetchCreatedBefore] = query.CreatedBefore.AsTime()
+	}
+}
+
+// MapAgentFetchQueryToGrpcFetchQuery maps the agent fetch query to the grpc fetch query.
+func MapAgentFetchQueryToGrpcFetchQuery(query *azmodels.FetchQuery) *FetchQuery {
+	if query == nil {
+		return nil
+	}
+	grpcQuery := &FetchQuery{}
+	if query.Cursor != "" {
+		grpcQuery.Cursor = &query.Cursor
+	}
+	if query.SortBy != "" {
+		grpcQuery.SortBy = &query.SortBy
+	}
+	if query.SortOrder != "" {
+		grpcQuery.SortOrder = &query.SortOrder
+	}
+	if query.NamePrefix != "" {
+		grpcQuery.NamePrefix = &query.NamePrefix
+	}
+	if query.CreatedAfter != nil {
+		grpcQuery.CreatedAfter = timestamppb.New(*query.CreatedAfter)
+	}
+	if query.CreatedBefore != nil {
+		grpcQuery.CreatedBefore = timestamppb.New(*query.CreatedBefore)
+	}
+	return grpcQuery
+}
+
+// MapGrpcFetchQueryToAgentFetchQuery maps the grpc fetch query to the agent fetch query.
+func MapGrpcFetchQueryToAgentFetchQuery(query *FetchQuery) *azmodels.FetchQuery {
+	agentQuery := &azmodels.FetchQuery{}
+	if query == nil {
+		return agentQuery
+	}
+	agentQuery.SortBy = MapPointerStringToString(query.SortBy)
+	agentQuery.SortOrder = MapPointerStringToString(query.SortOrder)
+	return agentQuery
+}
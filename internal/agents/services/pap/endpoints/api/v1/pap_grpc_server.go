@@ -18,14 +18,20 @@ package v1
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
 	azservices "github.com/permguard/permguard/pkg/agents/services"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
 
 	notppackets "github.com/permguard/permguard-notp-protocol/pkg/notp/packets"
@@ -47,10 +53,38 @@ type PAPService interface {
 	CreateLedger(ledger *azmodelspap.Ledger) (*azmodelspap.Ledger, error)
 	// UpdateLedger updates an ledger.
 	UpdateLedger(ledger *azmodelspap.Ledger) (*azmodelspap.Ledger, error)
+	// UpdateLedgerCandidate updates the candidate ref of a ledger evaluated in shadow mode.
+	UpdateLedgerCandidate(zoneID int64, ledgerID string, candidateRef string) (*azmodelspap.Ledger, error)
+	// PromoteLedgerCandidate promotes the candidate ref of a ledger to its active ref.
+	PromoteLedgerCandidate(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error)
+	// UpdateLedgerProtection updates the number of approvals required to move the ref of a ledger.
+	UpdateLedgerProtection(zoneID int64, ledgerID string, requiredApprovals int32) (*azmodelspap.Ledger, error)
 	// DeleteLedger deletes an ledger.
 	DeleteLedger(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error)
 	// FetchLedgers gets all ledgers.
 	FetchLedgers(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelspap.Ledger, error)
+	// CreateEntity creates a new entity.
+	CreateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error)
+	// UpdateEntity updates an entity.
+	UpdateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error)
+	// UpsertEntities creates or replaces a set of entities.
+	UpsertEntities(zoneID int64, ledgerID string, entities []azmodelspap.Entity) ([]azmodelspap.Entity, error)
+	// DeleteEntity deletes an entity.
+	DeleteEntity(zoneID int64, entityType, entityID string) (*azmodelspap.Entity, error)
+	// FetchEntities gets all entities.
+	FetchEntities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelspap.Entity, error)
+	// CreateTemplateLink creates a new template link.
+	CreateTemplateLink(link *azmodelspap.TemplateLink) (*azmodelspap.TemplateLink, error)
+	// DeleteTemplateLink deletes a template link.
+	DeleteTemplateLink(zoneID int64, ledgerID string, linkID string) (*azmodelspap.TemplateLink, error)
+	// FetchTemplateLinks gets all template links of a ledger.
+	FetchTemplateLinks(page int32, pageSize int32, zoneID int64, ledgerID string, fields map[string]any) ([]azmodelspap.TemplateLink, error)
+	// FetchChangeRequests gets all change requests of a ledger.
+	FetchChangeRequests(page int32, pageSize int32, zoneID int64, ledgerID string, fields map[string]any) ([]azmodelspap.ChangeRequest, error)
+	// ApproveChangeRequest approves a pending change request.
+	ApproveChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, comment string) (*azmodelspap.ChangeRequest, error)
+	// RejectChangeRequest rejects a pending change request.
+	RejectChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, comment string) (*azmodelspap.ChangeRequest, error)
 	// OnPullHandleRequestCurrentState handles the request for the current state.
 	OnPullHandleRequestCurrentState(handlerCtx *notpstatemachines.HandlerContext, statePacket *notpsmpackets.StatePacket, packets []notppackets.Packetable) (*notpstatemachines.HostHandlerReturn, error)
 	// OnPullSendNotifyCurrentStateResponse notifies the current state.
@@ -77,19 +111,52 @@ type PAPService interface {
 	OnPushSendCommit(handlerCtx *notpstatemachines.HandlerContext, statePacket *notpsmpackets.StatePacket, packets []notppackets.Packetable) (*notpstatemachines.HostHandlerReturn, error)
 }
 
-// NewV1PAPServer creates a new PAP server.
-func NewV1PAPServer(endpointCtx *azservices.EndpointContext, Service PAPService) (*V1PAPServer, error) {
+// CallerKey returns the key of the bearer token of an identity of a zone.
+func CallerKey(zoneID int64, identityName string) string {
+	return fmt.Sprintf("%d/%s", zoneID, identityName)
+}
+
+// NewV1PAPServer creates a new PAP server, the caller tokens are keyed by zone and identity name and authenticate the
+// authors and the reviewers of the change requests.
+func NewV1PAPServer(endpointCtx *azservices.EndpointContext, Service PAPService, callerTokens map[string]string) (*V1PAPServer, error) {
 	return &V1PAPServer{
-		ctx:     endpointCtx,
-		service: Service,
+		ctx:          endpointCtx,
+		service:      Service,
+		callerTokens: callerTokens,
 	}, nil
 }
 
 // V1PAPServer is the gRPC server for the PAP.
 type V1PAPServer struct {
 	UnimplementedV1PAPServiceServer
-	ctx     *azservices.EndpointContext
-	service PAPService
+	ctx          *azservices.EndpointContext
+	service      PAPService
+	callerTokens map[string]string
+}
+
+// authenticateCaller returns the name of the identity of the zone authenticated by the bearer token of the call,
+// the call is refused when no caller tokens are configured.
+func (s *V1PAPServer) authenticateCaller(ctx context.Context, zoneID int64) (string, error) {
+	if len(s.callerTokens) == 0 {
+		return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUnauthenticated, "the caller tokens are not configured on the pap server")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUnauthenticated, "the bearer token is missing")
+	}
+	scheme, token, found := strings.Cut(values[0], " ")
+	token = strings.TrimSpace(token)
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUnauthenticated, "the bearer token is missing")
+	}
+	zonePrefix := CallerKey(zoneID, "")
+	for key, expected := range s.callerTokens {
+		if strings.HasPrefix(key, zonePrefix) && subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1 {
+			return strings.TrimPrefix(key, zonePrefix), nil
+		}
+	}
+	return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUnauthenticated, fmt.Sprintf("the bearer token is not valid for the zone %d", zoneID))
 }
 
 // CreateLedger creates a new ledger.
@@ -110,6 +177,33 @@ func (s *V1PAPServer) UpdateLedger(ctx context.Context, ledgerRequest *LedgerUpd
 	return MapAgentLedgerToGrpcLedgerResponse(ledger)
 }
 
+// UpdateLedgerCandidate updates the candidate ref of a ledger.
+func (s *V1PAPServer) UpdateLedgerCandidate(ctx context.Context, ledgerRequest *LedgerCandidateUpdateRequest) (*LedgerResponse, error) {
+	ledger, err := s.service.UpdateLedgerCandidate(ledgerRequest.ZoneID, ledgerRequest.LedgerID, ledgerRequest.CandidateRef)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentLedgerToGrpcLedgerResponse(ledger)
+}
+
+// PromoteLedgerCandidate promotes the candidate ref of a ledger.
+func (s *V1PAPServer) PromoteLedgerCandidate(ctx context.Context, ledgerRequest *LedgerCandidatePromoteRequest) (*LedgerResponse, error) {
+	ledger, err := s.service.PromoteLedgerCandidate(ledgerRequest.ZoneID, ledgerRequest.LedgerID)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentLedgerToGrpcLedgerResponse(ledger)
+}
+
+// UpdateLedgerProtection updates the number of approvals required to move the ref of a ledger.
+func (s *V1PAPServer) UpdateLedgerProtection(ctx context.Context, ledgerRequest *LedgerProtectionUpdateRequest) (*LedgerResponse, error) {
+	ledger, err := s.service.UpdateLedgerProtection(ledgerRequest.ZoneID, ledgerRequest.LedgerID, ledgerRequest.RequiredApprovals)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentLedgerToGrpcLedgerResponse(ledger)
+}
+
 // DeleteLedger deletes a ledger.
 func (s *V1PAPServer) DeleteLedger(ctx context.Context, ledgerRequest *LedgerDeleteRequest) (*LedgerResponse, error) {
 	ledger, err := s.service.DeleteLedger(ledgerRequest.ZoneID, ledgerRequest.LedgerID)
@@ -132,6 +226,7 @@ func (s *V1PAPServer) FetchLedgers(ledgerRequest *LedgerFetchRequest, stream grp
 	if ledgerRequest.LedgerID != nil {
 		fields[azmodelspap.FieldLedgerLedgerID] = *ledgerRequest.LedgerID
 	}
+	MapGrpcFetchQueryToAgentFields(ledgerRequest.Query, fields)
 	page := int32(0)
 	if ledgerRequest.Page != nil {
 		page = int32(*ledgerRequest.Page)
@@ -151,9 +246,213 @@ func (s *V1PAPServer) FetchLedgers(ledgerRequest *LedgerFetchRequest, stream grp
 		}
 		stream.SendMsg(cvtedLedger)
 	}
+	sortBy, sortOrder := MapGrpcFetchQueryToAgentFetchQuery(ledgerRequest.Query).NormalizedSort()
+	return setFetchNextCursorTrailer(stream, len(ledgers), pageSize, func() (string, error) {
+		last := ledgers[len(ledgers)-1]
+		return azmodels.NewFetchCursor(sortBy, sortOrder, last.LedgerID, last.Name, last.CreatedAt)
+	})
+}
+
+// CreateEntity creates a new entity.
+func (s *V1PAPServer) CreateEntity(ctx context.Context, entityRequest *EntityUpsertRequest) (*EntityResponse, error) {
+	entity, err := MapGrpcEntityRequestToAgentEntity(entityRequest.ZoneID, entityRequest.Entity)
+	if err != nil {
+		return nil, err
+	}
+	entity, err = s.service.CreateEntity(MapPointerStringToString(entityRequest.LedgerID), entity)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentEntityToGrpcEntityResponse(entity)
+}
+
+// UpdateEntity updates an entity.
+func (s *V1PAPServer) UpdateEntity(ctx context.Context, entityRequest *EntityUpsertRequest) (*EntityResponse, error) {
+	entity, err := MapGrpcEntityRequestToAgentEntity(entityRequest.ZoneID, entityRequest.Entity)
+	if err != nil {
+		return nil, err
+	}
+	entity, err = s.service.UpdateEntity(MapPointerStringToString(entityRequest.LedgerID), entity)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentEntityToGrpcEntityResponse(entity)
+}
+
+// UpsertEntities creates or replaces a set of entities.
+func (s *V1PAPServer) UpsertEntities(ctx context.Context, entitiesRequest *EntityBulkUpsertRequest) (*EntityBulkUpsertResponse, error) {
+	entities := make([]azmodelspap.Entity, 0, len(entitiesRequest.Entities))
+	for _, entityRequest := range entitiesRequest.Entities {
+		entity, err := MapGrpcEntityRequestToAgentEntity(entitiesRequest.ZoneID, entityRequest)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, *entity)
+	}
+	entities, err := s.service.UpsertEntities(entitiesRequest.ZoneID, MapPointerStringToString(entitiesRequest.LedgerID), entities)
+	if err != nil {
+		return nil, err
+	}
+	response := &EntityBulkUpsertResponse{Entities: make([]*EntityResponse, 0, len(entities))}
+	for _, entity := range entities {
+		cvtedEntity, err := MapAgentEntityToGrpcEntityResponse(&entity)
+		if err != nil {
+			return nil, err
+		}
+		response.Entities = append(response.Entities, cvtedEntity)
+	}
+	return response, nil
+}
+
+// DeleteEntity deletes an entity.
+func (s *V1PAPServer) DeleteEntity(ctx context.Context, entityRequest *EntityDeleteRequest) (*EntityResponse, error) {
+	entity, err := s.service.DeleteEntity(entityRequest.ZoneID, entityRequest.Type, entityRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentEntityToGrpcEntityResponse(entity)
+}
+
+// FetchEntities returns all entities.
+func (s *V1PAPServer) FetchEntities(entityRequest *EntityFetchRequest, stream grpc.ServerStreamingServer[EntityResponse]) error {
+	fields := map[string]any{}
+	fields[azmodelspap.FieldEntityZoneID] = entityRequest.ZoneID
+	if entityRequest.Type != nil {
+		fields[azmodelspap.FieldEntityType] = *entityRequest.Type
+	}
+	if entityRequest.ID != nil {
+		fields[azmodelspap.FieldEntityID] = *entityRequest.ID
+	}
+	page := int32(0)
+	if entityRequest.Page != nil {
+		page = int32(*entityRequest.Page)
+	}
+	pageSize := int32(0)
+	if entityRequest.PageSize != nil {
+		pageSize = int32(*entityRequest.PageSize)
+	}
+	entities, err := s.service.FetchEntities(page, pageSize, entityRequest.ZoneID, fields)
+	if err != nil {
+		return err
+	}
+	for _, entity := range entities {
+		cvtedEntity, err := MapAgentEntityToGrpcEntityResponse(&entity)
+		if err != nil {
+			return err
+		}
+		stream.SendMsg(cvtedEntity)
+	}
+	return nil
+}
+
+// CreateTemplateLink creates a new template link.
+func (s *V1PAPServer) CreateTemplateLink(ctx context.Context, linkRequest *TemplateLinkCreateRequest) (*TemplateLinkResponse, error) {
+	link, err := s.service.CreateTemplateLink(MapGrpcTemplateLinkCreateRequestToAgentTemplateLink(linkRequest))
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentTemplateLinkToGrpcTemplateLinkResponse(link)
+}
+
+// DeleteTemplateLink deletes a template link.
+func (s *V1PAPServer) DeleteTemplateLink(ctx context.Context, linkRequest *TemplateLinkDeleteRequest) (*TemplateLinkResponse, error) {
+	link, err := s.service.DeleteTemplateLink(linkRequest.ZoneID, linkRequest.LedgerID, linkRequest.LinkID)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentTemplateLinkToGrpcTemplateLinkResponse(link)
+}
+
+// FetchTemplateLinks returns all template links of a ledger.
+func (s *V1PAPServer) FetchTemplateLinks(linkRequest *TemplateLinkFetchRequest, stream grpc.ServerStreamingServer[TemplateLinkResponse]) error {
+	fields := map[string]any{}
+	fields[azmodelspap.FieldTemplateLinkZoneID] = linkRequest.ZoneID
+	fields[azmodelspap.FieldTemplateLinkLedgerID] = linkRequest.LedgerID
+	if linkRequest.TemplateID != nil {
+		fields[azmodelspap.FieldTemplateLinkTemplateID] = *linkRequest.TemplateID
+	}
+	if linkRequest.LinkID != nil {
+		fields[azmodelspap.FieldTemplateLinkLinkID] = *linkRequest.LinkID
+	}
+	page := int32(0)
+	if linkRequest.Page != nil {
+		page = int32(*linkRequest.Page)
+	}
+	pageSize := int32(0)
+	if linkRequest.PageSize != nil {
+		pageSize = int32(*linkRequest.PageSize)
+	}
+	links, err := s.service.FetchTemplateLinks(page, pageSize, linkRequest.ZoneID, linkRequest.LedgerID, fields)
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		cvtedLink, err := MapAgentTemplateLinkToGrpcTemplateLinkResponse(&link)
+		if err != nil {
+			return err
+		}
+		stream.SendMsg(cvtedLink)
+	}
+	return nil
+}
+
+// FetchChangeRequests returns all change requests of a ledger.
+func (s *V1PAPServer) FetchChangeRequests(changeRequestRequest *ChangeRequestFetchRequest, stream grpc.ServerStreamingServer[ChangeRequestResponse]) error {
+	fields := map[string]any{}
+	if changeRequestRequest.ChangeRequestID != nil {
+		fields[azmodelspap.FieldChangeRequestChangeRequestID] = *changeRequestRequest.ChangeRequestID
+	}
+	if changeRequestRequest.Status != nil {
+		fields[azmodelspap.FieldChangeRequestStatus] = *changeRequestRequest.Status
+	}
+	page := int32(0)
+	if changeRequestRequest.Page != nil {
+		page = int32(*changeRequestRequest.Page)
+	}
+	pageSize := int32(0)
+	if changeRequestRequest.PageSize != nil {
+		pageSize = int32(*changeRequestRequest.PageSize)
+	}
+	changeRequests, err := s.service.FetchChangeRequests(page, pageSize, changeRequestRequest.ZoneID, changeRequestRequest.LedgerID, fields)
+	if err != nil {
+		return err
+	}
+	for _, changeRequest := range changeRequests {
+		cvtedChangeRequest, err := MapAgentChangeRequestToGrpcChangeRequestResponse(&changeRequest)
+		if err != nil {
+			return err
+		}
+		stream.SendMsg(cvtedChangeRequest)
+	}
 	return nil
 }
 
+// ApproveChangeRequest approves a pending change request.
+func (s *V1PAPServer) ApproveChangeRequest(ctx context.Context, reviewRequest *ChangeRequestReviewRequest) (*ChangeRequestResponse, error) {
+	reviewer, err := s.authenticateCaller(ctx, reviewRequest.ZoneID)
+	if err != nil {
+		return nil, err
+	}
+	changeRequest, err := s.service.ApproveChangeRequest(reviewRequest.ZoneID, reviewRequest.LedgerID, reviewRequest.ChangeRequestID, reviewer, reviewRequest.GetComment())
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentChangeRequestToGrpcChangeRequestResponse(changeRequest)
+}
+
+// RejectChangeRequest rejects a pending change request.
+func (s *V1PAPServer) RejectChangeRequest(ctx context.Context, reviewRequest *ChangeRequestReviewRequest) (*ChangeRequestResponse, error) {
+	reviewer, err := s.authenticateCaller(ctx, reviewRequest.ZoneID)
+	if err != nil {
+		return nil, err
+	}
+	changeRequest, err := s.service.RejectChangeRequest(reviewRequest.ZoneID, reviewRequest.LedgerID, reviewRequest.ChangeRequestID, reviewer, reviewRequest.GetComment())
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentChangeRequestToGrpcChangeRequestResponse(changeRequest)
+}
+
 // ReceivePack receives objects from the client.
 func (s *V1PAPServer) ReceivePack(stream grpc.BidiStreamingServer[PackMessage, PackMessage]) error {
 	return nil
@@ -182,7 +481,7 @@ func (s *V1PAPServer) createWiredStateMachine(stream grpc.BidiStreamingServer[Pa
 	if err != nil {
 		return nil, err
 	}
-	var hostHandler notpstatemachines.HostHandler = func(handlerCtx *notpstatemachines.HandlerContext, statePacket *notpsmpackets.StatePacket, packets []notppackets.Packetable) (*notpstatemachines.HostHandlerReturn, error) {
+	var dispatchHandler notpstatemachines.HostHandler = func(handlerCtx *notpstatemachines.HandlerContext, statePacket *notpsmpackets.StatePacket, packets []notppackets.Packetable) (*notpstatemachines.HostHandlerReturn, error) {
 		switch handlerCtx.GetCurrentStateID() {
 		case notpstatemachines.ProcessRequestObjectsStateID:
 			switch statePacket.MessageCode {
@@ -259,6 +558,16 @@ func (s *V1PAPServer) createWiredStateMachine(stream grpc.BidiStreamingServer[Pa
 			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, fmt.Sprintf("invalid state %d", handlerCtx.GetCurrentStateID()))
 		}
 	}
+	var hostHandler notpstatemachines.HostHandler = func(handlerCtx *notpstatemachines.HandlerContext, statePacket *notpsmpackets.StatePacket, packets []notppackets.Packetable) (*notpstatemachines.HostHandlerReturn, error) {
+		_, span := aztelemetry.StartSpan(stream.Context(), "notp.state-machine-step",
+			attribute.Int("permguard.notp.state_id", int(handlerCtx.GetCurrentStateID())),
+			attribute.Int("permguard.notp.message_code", int(statePacket.MessageCode)),
+			attribute.Int("permguard.notp.packets", len(packets)),
+		)
+		handlerReturn, err := dispatchHandler(handlerCtx, statePacket, packets)
+		aztelemetry.EndSpan(span, err)
+		return handlerReturn, err
+	}
 	stateMachine, err := notpstatemachines.NewLeaderStateMachine(hostHandler, transportLayer)
 	if err != nil {
 		return nil, err
@@ -289,9 +598,28 @@ func (s *V1PAPServer) NOTPStream(stream grpc.BidiStreamingServer[PackMessage, Pa
 	bag := map[string]any{}
 	bag[azagentnotpsm.ZoneIDKey] = zoneID[0]
 	bag[azagentnotpsm.LedgerIDKey] = respositoryID[0]
+	if zoneIDValue, err := strconv.ParseInt(zoneID[0], 10, 64); err == nil {
+		// the caller is the author of the change request created when pushing to a protected ledger
+		if caller, err := s.authenticateCaller(stream.Context(), zoneIDValue); err == nil {
+			bag[azagentnotpsm.CallerKey] = caller
+		}
+	}
 	_, err = stateMachine.Run(bag, notpstatemachines.UnknownFlowType)
 	if err != nil {
 		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "notp stream unhandled err", err)
 	}
 	return nil
 }
+
+// setFetchNextCursorTrailer sets the cursor of the next page as trailer of the stream when the page is full.
+func setFetchNextCursorTrailer(stream grpc.ServerStream, count int, pageSize int32, nextCursor func() (string, error)) error {
+	if count == 0 || int32(count) < pageSize {
+		return nil
+	}
+	cursor, err := nextCursor()
+	if err != nil {
+		return err
+	}
+	stream.SetTrailer(metadata.Pairs(azmodels.NextCursorMetadataKey, cursor))
+	return nil
+}
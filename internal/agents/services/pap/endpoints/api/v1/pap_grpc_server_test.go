@@ -0,0 +1,89 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+// reviewerService records the reviewer of the reviewed change requests.
+type reviewerService struct {
+	PAPService
+	reviewer string
+}
+
+// ApproveChangeRequest records the reviewer of the approval.
+func (s *reviewerService) ApproveChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, comment string) (*azmodelspap.ChangeRequest, error) {
+	s.reviewer = reviewer
+	return &azmodelspap.ChangeRequest{ZoneID: zoneID, LedgerID: ledgerID, ChangeRequestID: changeRequestID}, nil
+}
+
+// callerContext returns an incoming context with the authorization metadata.
+func callerContext(authorization string) context.Context {
+	if authorization == "" {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authorization))
+}
+
+// TestAuthenticateCaller tests that the reviewers are the callers authenticated by their bearer token.
+func TestAuthenticateCaller(t *testing.T) {
+	callerTokens := map[string]string{
+		CallerKey(273165098782, "nicola.gallo@example.com"): "nicola-token",
+		CallerKey(273165098782, "amy.smith@example.com"):    "amy-token",
+		CallerKey(581616507495, "john.doe@example.com"):     "john-token",
+	}
+	tests := []struct {
+		name          string
+		callerTokens  map[string]string
+		authorization string
+		reviewer      string
+	}{
+		{name: "no caller tokens configured", callerTokens: nil, authorization: "Bearer amy-token"},
+		{name: "missing token", callerTokens: callerTokens},
+		{name: "not a bearer token", callerTokens: callerTokens, authorization: "Basic amy-token"},
+		{name: "unknown token", callerTokens: callerTokens, authorization: "Bearer other-token"},
+		{name: "token of another zone", callerTokens: callerTokens, authorization: "Bearer john-token"},
+		{name: "valid token", callerTokens: callerTokens, authorization: "Bearer amy-token", reviewer: "amy.smith@example.com"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			service := &reviewerService{}
+			server, err := NewV1PAPServer(nil, service, test.callerTokens)
+			assert.Nil(err)
+			reviewRequest := &ChangeRequestReviewRequest{ZoneID: 273165098782, LedgerID: "668f3771eacf4094ba8a80942ea5fd3f", ChangeRequestID: "2a5bf6d2c4e34bd1a7a5d4b9f2d0e6a1"}
+			response, err := server.ApproveChangeRequest(callerContext(test.authorization), reviewRequest)
+			if test.reviewer == "" {
+				assert.Nil(response)
+				assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUnauthenticated, err), "error should be errclientunauthenticated")
+				assert.Empty(service.reviewer, "the service should not be called")
+				return
+			}
+			assert.Nil(err)
+			assert.NotNil(response)
+			assert.Equal(test.reviewer, service.reviewer)
+		})
+	}
+}
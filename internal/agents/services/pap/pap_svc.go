@@ -72,7 +72,7 @@ func (f *PAPService) GetEndpoints() ([]azservices.EndpointInitializer, error) {
 			if err != nil {
 				return err
 			}
-			papServer, err := azapiv1pap.NewV1PAPServer(endptCtx, controller)
+			papServer, err := azapiv1pap.NewV1PAPServer(endptCtx, controller, f.config.GetCallerTokens())
 			azapiv1pap.RegisterV1PAPServiceServer(grpcServer, papServer)
 			return err
 		})
@@ -18,15 +18,20 @@ package pap
 
 import (
 	"flag"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/viper"
 
 	azcopier "github.com/permguard/permguard-common/pkg/extensions/copier"
 	azvalidators "github.com/permguard/permguard-common/pkg/extensions/validators"
+	azapiv1pap "github.com/permguard/permguard/internal/agents/services/pap/endpoints/api/v1"
 	azservices "github.com/permguard/permguard/pkg/agents/services"
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azcorevalidators "github.com/permguard/permguard/pkg/core/validators"
 )
 
 const (
@@ -35,6 +40,7 @@ const (
 	flagSuffixGrpcPort       = "grpc-port"
 	flagCentralEngine        = "engine-central"
 	flagDataFetchMaxPageSize = "data-fetch-maxpagesize"
+	flagSuffixCallerTokens   = "caller-tokens"
 )
 
 // PAPServiceConfig holds the configuration for the server.
@@ -56,6 +62,7 @@ func (c *PAPServiceConfig) AddFlags(flagSet *flag.FlagSet) error {
 	flagSet.Int(azoptions.FlagName(flagServerPAPPrefix, flagSuffixGrpcPort), 9092, "port to be used for exposing the pap grpc services")
 	flagSet.String(azoptions.FlagName(flagStoragePAPPrefix, flagCentralEngine), "", "data storage engine to be used for central data; this overrides the --storage-engine-central option")
 	flagSet.Int(azoptions.FlagName(flagServerPAPPrefix, flagDataFetchMaxPageSize), 10000, "maximum number of items to fetch per request")
+	flagSet.String(azoptions.FlagName(flagServerPAPPrefix, flagSuffixCallerTokens), "", "comma separated list of <zone-id>/<identity-name>=<token> bearer tokens authenticating the authors and the reviewers of the change requests")
 	return nil
 }
 
@@ -83,9 +90,45 @@ func (c *PAPServiceConfig) InitFromViper(v *viper.Viper) error {
 		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid data fetch max page size")
 	}
 	c.config[flagDataFetchMaxPageSize] = dataFetchMaxPageSize
+	// retrieve the caller tokens
+	flagName = azoptions.FlagName(flagServerPAPPrefix, flagSuffixCallerTokens)
+	callerTokens, err := parseCallerTokens(v.GetString(flagName))
+	if err != nil {
+		return err
+	}
+	c.config[flagSuffixCallerTokens] = callerTokens
 	return nil
 }
 
+// parseCallerTokens parses the comma separated list of <zone-id>/<identity-name>=<token> caller tokens.
+func parseCallerTokens(value string) (map[string]string, error) {
+	tokens := map[string]string{}
+	callers := map[string]string{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		scope, token, found := strings.Cut(entry, "=")
+		zone, identityName, scoped := strings.Cut(scope, "/")
+		zoneID, err := strconv.ParseInt(zone, 10, 64)
+		if !found || !scoped || err != nil || azcorevalidators.ValidateCodeID("zone", zoneID) != nil ||
+			azcorevalidators.ValidateIdentityUserName("caller", identityName) != nil || token == "" {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("invalid caller token for %q, expected <zone-id>/<identity-name>=<token>", scope))
+		}
+		key := azapiv1pap.CallerKey(zoneID, identityName)
+		if _, exists := tokens[key]; exists {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("duplicate caller token for %s", key))
+		}
+		if other, exists := callers[token]; exists {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("the callers %s and %s share the same token", other, key))
+		}
+		tokens[key] = token
+		callers[token] = key
+	}
+	return tokens, nil
+}
+
 // GetConfigData returns the configuration data.
 func (c *PAPServiceConfig) GetConfigData() map[string]any {
 	return azcopier.CopyMap(c.config)
@@ -106,6 +149,11 @@ func (c *PAPServiceConfig) GetDataFetchMaxPageSize() int {
 	return c.config[flagDataFetchMaxPageSize].(int)
 }
 
+// GetCallerTokens returns the bearer tokens of the callers keyed by zone and identity name.
+func (c *PAPServiceConfig) GetCallerTokens() map[string]string {
+	return c.config[flagSuffixCallerTokens].(map[string]string)
+}
+
 // GetService returns the service kind.
 func (c *PAPServiceConfig) GetService() azservices.ServiceKind {
 	return c.service
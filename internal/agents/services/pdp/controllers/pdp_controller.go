@@ -17,6 +17,7 @@
 package controllers
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -29,6 +30,10 @@ import (
 const (
 	// LedgerKind is the kind of the policy store.
 	LedgerKind = "ledger"
+	// dataFetchMaxPageSizeKey is the configuration key of the maximum number of items to fetch per request.
+	dataFetchMaxPageSizeKey = "data-fetch-maxpagesize"
+	// dataFetchMaxPageSizeDefault is the default maximum number of items to fetch per request.
+	dataFetchMaxPageSizeDefault = 10000
 )
 
 // PDPController is the controller for the PDP service.
@@ -37,6 +42,25 @@ type PDPController struct {
 	storage azStorage.PDPCentralStorage
 }
 
+// getDataFetchMaxPageSize returns the maximum number of items to fetch per request.
+func (s PDPController) getDataFetchMaxPageSize() int {
+	if s.ctx == nil {
+		return dataFetchMaxPageSizeDefault
+	}
+	cfgReader, err := s.ctx.GetServiceConfigReader()
+	if err != nil || cfgReader == nil {
+		return dataFetchMaxPageSizeDefault
+	}
+	value, err := cfgReader.GetValue(dataFetchMaxPageSizeKey)
+	if err != nil {
+		return dataFetchMaxPageSizeDefault
+	}
+	if maxPageSize, ok := value.(int); ok && maxPageSize > 0 {
+		return maxPageSize
+	}
+	return dataFetchMaxPageSizeDefault
+}
+
 // Setup initializes the service.
 func (s PDPController) Setup() error {
 	return nil
@@ -52,7 +76,7 @@ func NewPDPController(serviceContext *azservices.ServiceContext, storage azStora
 }
 
 // AuthorizationCheck checks if the request is authorized.
-func (s PDPController) AuthorizationCheck(request *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (*azmodelspdp.AuthorizationCheckResponse, error) {
+func (s PDPController) AuthorizationCheck(ctx context.Context, request *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (*azmodelspdp.AuthorizationCheckResponse, error) {
 	if request == nil {
 		errMsg := fmt.Sprintf("%s: received nil request", azauthzen.AuthzErrBadRequestMessage)
 		return azmodelspdp.NewAuthorizationCheckErrorResponse(nil, "", azauthzen.AuthzErrBadRequestCode, errMsg, azauthzen.AuthzErrBadRequestMessage), nil
@@ -66,7 +90,11 @@ func (s PDPController) AuthorizationCheck(request *azmodelspdp.AuthorizationChec
 		errMsg := fmt.Sprintf("%s: missing policy store in authorization model", azauthzen.AuthzErrBadRequestMessage)
 		return azmodelspdp.NewAuthorizationCheckErrorResponse(nil, requestID, azauthzen.AuthzErrBadRequestCode, errMsg, azauthzen.AuthzErrBadRequestMessage), nil
 	}
-	expReq, err := authorizationCheckExpandAuthorizationCheckWithDefaults(request)
+	if request.Options != nil && !azmodelspdp.IsValidEvaluationsSemantic(request.Options.EvaluationsSemantic) {
+		errMsg := fmt.Sprintf("%s: invalid evaluations semantic", azauthzen.AuthzErrBadRequestMessage)
+		return azmodelspdp.NewAuthorizationCheckErrorResponse(nil, requestID, azauthzen.AuthzErrBadRequestCode, errMsg, azauthzen.AuthzErrBadRequestMessage), nil
+	}
+	expReq, err := azmodelspdp.ExpandAuthorizationCheckWithDefaults(request)
 	if err != nil {
 		errMsg := fmt.Sprintf("%s: failed to expand authorization request with defaults", azauthzen.AuthzErrBadRequestMessage)
 		return azmodelspdp.NewAuthorizationCheckErrorResponse(nil, requestID, azauthzen.AuthzErrBadRequestCode, errMsg, azauthzen.AuthzErrBadRequestMessage), nil
@@ -162,7 +190,7 @@ func (s PDPController) AuthorizationCheck(request *azmodelspdp.AuthorizationChec
 	expReq.Evaluations = reqEvaluations
 	authzCheckEvaluations := []azmodelspdp.EvaluationResponse{}
 	if reqEvaluationsSize > 0 {
-		authzCheckEvaluations, err = s.storage.AuthorizationCheck(expReq)
+		authzCheckEvaluations, err = s.storage.AuthorizationCheck(ctx, expReq)
 		if err != nil {
 			errMsg := fmt.Sprintf("%s: authorization check has failed %s", azauthzen.AuthzErrInternalErrorMessage, err.Error())
 			return azmodelspdp.NewAuthorizationCheckErrorResponse(nil, requestID, azauthzen.AuthzErrBadRequestCode, errMsg, azauthzen.AuthzErrBadRequestMessage), nil
@@ -181,6 +209,7 @@ func (s PDPController) AuthorizationCheck(request *azmodelspdp.AuthorizationChec
 			evaluations = append(evaluations, authzCheckEvaluations[evalItem.listID])
 		}
 	}
+	evaluations = azmodelspdp.TruncateEvaluationsBySemantic(azmodelspdp.GetEvaluationsSemantic(request.Options), evaluations)
 	authzCheckResp := &azmodelspdp.AuthorizationCheckResponse{
 		RequestID:   request.RequestID,
 		Evaluations: evaluations,
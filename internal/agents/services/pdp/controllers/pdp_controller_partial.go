@@ -0,0 +1,99 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	azresiduals "github.com/permguard/permguard/pkg/authz/residuals"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// partialEvaluationValidateRequest validates the partial evaluation request.
+func partialEvaluationValidateRequest(request *azmodelspdp.PartialEvaluationRequest) error {
+	if request.AuthorizationModel == nil {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - missing authorization model in request")
+	}
+	if request.AuthorizationModel.ZoneID == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid zone id")
+	}
+	policyStore := request.AuthorizationModel.PolicyStore
+	if policyStore == nil {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - missing policy store in authorization model")
+	}
+	if len(policyStore.Kind) == 0 {
+		policyStore.Kind = LedgerKind
+	}
+	if strings.ToLower(policyStore.Kind) != LedgerKind {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid zone type")
+	}
+	if len(strings.TrimSpace(policyStore.ID)) == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid policy store id")
+	}
+	if request.Subject == nil || len(strings.TrimSpace(request.Subject.ID)) == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid subject id")
+	}
+	if !azmodelspdp.IsValidIdentiyType(request.Subject.Type) {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid subject type")
+	}
+	if !azmodelspdp.IsValidProperties(request.Subject.Properties) {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid subject properties")
+	}
+	if request.Resource == nil || len(strings.TrimSpace(request.Resource.Type)) == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid resource type")
+	}
+	if !azmodelspdp.IsValidProperties(request.Resource.Properties) {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid resource properties")
+	}
+	if request.Action == nil || len(strings.TrimSpace(request.Action.Name)) == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid action name")
+	}
+	if !azmodelspdp.IsValidProperties(request.Action.Properties) {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid action properties")
+	}
+	return nil
+}
+
+// PartialEvaluation partially evaluates the authorization for the resources of a type and returns the residual conditions.
+func (s PDPController) PartialEvaluation(ctx context.Context, request *azmodelspdp.PartialEvaluationRequest) (*azmodelspdp.PartialEvaluationResponse, error) {
+	if request == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - received nil request")
+	}
+	if err := partialEvaluationValidateRequest(request); err != nil {
+		return nil, err
+	}
+	if request.Context == nil {
+		request.Context = map[string]any{}
+	}
+	response, err := s.storage.PartialEvaluation(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if request.SQL != nil {
+		where, args, err := azresiduals.ToSQL(response.Residual, &azresiduals.SQLOptions{
+			ResourceIDColumn: request.SQL.ResourceIDColumn,
+			Columns:          request.SQL.Columns,
+		})
+		if err != nil {
+			return nil, err
+		}
+		response.SQL = &azmodelspdp.PartialEvaluationSQLResponse{Where: where, Args: args}
+	}
+	return response, nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	azStorage "github.com/permguard/permguard/pkg/agents/storage"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// searchCandidatesFetcher fetches the search candidates starting at the offset, it returns true if there are no more candidates.
+type searchCandidatesFetcher[T any] func(offset int, limit int) ([]T, bool, error)
+
+// searchValidateRequest validates the search request and returns the offset and the size of the page.
+func searchValidateRequest(request *azmodelspdp.SearchRequest) (int, int, error) {
+	if request.AuthorizationModel == nil {
+		return 0, 0, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - missing authorization model in request")
+	}
+	if request.AuthorizationModel.ZoneID == 0 {
+		return 0, 0, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - invalid zone id")
+	}
+	if request.AuthorizationModel.PolicyStore == nil {
+		return 0, 0, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - missing policy store in authorization model")
+	}
+	size := azmodelspdp.SearchPageSizeDefault
+	offset := 0
+	if request.Page != nil {
+		if request.Page.Size < 0 {
+			return 0, 0, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page size %d is not valid", request.Page.Size))
+		}
+		if request.Page.Size > 0 {
+			size = int(request.Page.Size)
+		}
+		var err error
+		offset, err = azmodelspdp.DecodeSearchPageToken(request.Page.NextToken)
+		if err != nil {
+			return 0, 0, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientPagination, "invalid client input - page token is not valid", err)
+		}
+	}
+	return offset, size, nil
+}
+
+// searchSliceCandidates returns the fetcher of the search candidates supplied with the request.
+func searchSliceCandidates[T any](candidates []T) searchCandidatesFetcher[T] {
+	return func(offset int, limit int) ([]T, bool, error) {
+		if offset >= len(candidates) {
+			return nil, true, nil
+		}
+		end := min(offset+limit, len(candidates))
+		return candidates[offset:end], end == len(candidates), nil
+	}
+}
+
+// searchStorageSubjects returns the fetcher of the identities of the zone as subject candidates, the fetches are limited to the maximum page size of the storage.
+func searchStorageSubjects(ctx context.Context, storage azStorage.PDPCentralStorage, zoneID int64, maxPageSize int) searchCandidatesFetcher[azmodelspdp.Subject] {
+	return func(offset int, limit int) ([]azmodelspdp.Subject, bool, error) {
+		limit = max(1, min(limit, maxPageSize))
+		page := int32(offset/limit) + 1
+		subjects, err := storage.FetchSubjectCandidates(ctx, page, int32(limit), zoneID)
+		if err != nil {
+			return nil, false, err
+		}
+		last := len(subjects) < limit
+		skip := offset % limit
+		if skip >= len(subjects) {
+			return nil, true, nil
+		}
+		return subjects[skip:], last, nil
+	}
+}
+
+// searchCandidates evaluates the candidates starting at the offset and returns the permitted ones with the page for the next candidates.
+func searchCandidates[T any](ctx context.Context, s PDPController, request *azmodelspdp.SearchRequest, offset int, size int, fetch searchCandidatesFetcher[T], accept func(T) bool, evaluation func(T) azmodelspdp.EvaluationRequest) ([]T, *azmodelspdp.SearchPageResponse, error) {
+	results := []T{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't complete the search", err)
+		}
+		candidates, last, err := fetch(offset, size)
+		if err != nil {
+			return nil, nil, err
+		}
+		authzReq := &azmodelspdp.AuthorizationCheckWithDefaultsRequest{}
+		authzReq.AuthorizationModel = request.AuthorizationModel
+		authzReq.RequestID = request.RequestID
+		authzReq.Context = request.Context
+		evaluated := []int{}
+		for i, candidate := range candidates {
+			if !accept(candidate) {
+				continue
+			}
+			authzReq.Evaluations = append(authzReq.Evaluations, evaluation(candidate))
+			evaluated = append(evaluated, i)
+		}
+		if len(evaluated) > 0 {
+			authzResp, err := s.AuthorizationCheck(ctx, authzReq)
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(authzResp.Evaluations) != len(evaluated) {
+				errMsg := "server couldn't evaluate the search candidates"
+				if authzResp.Context != nil && authzResp.Context.ReasonAdmin != nil {
+					errMsg = fmt.Sprintf("%s: %s", errMsg, authzResp.Context.ReasonAdmin.Message)
+				}
+				return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, errMsg)
+			}
+			for i, evaluation := range authzResp.Evaluations {
+				if !evaluation.Decision {
+					continue
+				}
+				results = append(results, candidates[evaluated[i]])
+				if len(results) == size {
+					nextOffset := offset + evaluated[i] + 1
+					if last && nextOffset == offset+len(candidates) {
+						return results, nil, nil
+					}
+					return results, &azmodelspdp.SearchPageResponse{NextToken: azmodelspdp.EncodeSearchPageToken(nextOffset)}, nil
+				}
+			}
+		}
+		if last {
+			return results, nil, nil
+		}
+		offset += len(candidates)
+	}
+}
+
+// SubjectSearch searches the subjects allowed to perform the action on the resource.
+func (s PDPController) SubjectSearch(ctx context.Context, request *azmodelspdp.SubjectSearchRequest) (*azmodelspdp.SubjectSearchResponse, error) {
+	if request == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - received nil request")
+	}
+	offset, size, err := searchValidateRequest(&request.SearchRequest)
+	if err != nil {
+		return nil, err
+	}
+	if request.Subject == nil || len(strings.TrimSpace(request.Subject.Type)) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - subject type is required")
+	}
+	if request.Resource == nil || request.Action == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - resource and action are required")
+	}
+	fetch := searchSliceCandidates(request.Candidates)
+	if len(request.Candidates) == 0 {
+		fetch = searchStorageSubjects(ctx, s.storage, request.AuthorizationModel.ZoneID, s.getDataFetchMaxPageSize())
+	}
+	accept := func(subject azmodelspdp.Subject) bool {
+		return strings.EqualFold(subject.Type, request.Subject.Type)
+	}
+	evaluation := func(subject azmodelspdp.Subject) azmodelspdp.EvaluationRequest {
+		return azmodelspdp.EvaluationRequest{Subject: &subject, Resource: request.Resource, Action: request.Action}
+	}
+	results, page, err := searchCandidates(ctx, s, &request.SearchRequest, offset, size, fetch, accept, evaluation)
+	if err != nil {
+		return nil, err
+	}
+	return &azmodelspdp.SubjectSearchResponse{RequestID: request.RequestID, Results: results, Page: page}, nil
+}
+
+// ResourceSearch searches the resources on which the subject is allowed to perform the action.
+func (s PDPController) ResourceSearch(ctx context.Context, request *azmodelspdp.ResourceSearchRequest) (*azmodelspdp.ResourceSearchResponse, error) {
+	if request == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - received nil request")
+	}
+	offset, size, err := searchValidateRequest(&request.SearchRequest)
+	if err != nil {
+		return nil, err
+	}
+	if request.Resource == nil || len(strings.TrimSpace(request.Resource.Type)) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - resource type is required")
+	}
+	if request.Subject == nil || request.Action == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - subject and action are required")
+	}
+	if len(request.Candidates) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - resource candidates are required")
+	}
+	accept := func(resource azmodelspdp.Resource) bool {
+		return resource.Type == request.Resource.Type
+	}
+	evaluation := func(resource azmodelspdp.Resource) azmodelspdp.EvaluationRequest {
+		return azmodelspdp.EvaluationRequest{Subject: request.Subject, Resource: &resource, Action: request.Action}
+	}
+	results, page, err := searchCandidates(ctx, s, &request.SearchRequest, offset, size, searchSliceCandidates(request.Candidates), accept, evaluation)
+	if err != nil {
+		return nil, err
+	}
+	return &azmodelspdp.ResourceSearchResponse{RequestID: request.RequestID, Results: results, Page: page}, nil
+}
+
+// ActionSearch searches the actions the subject is allowed to perform on the resource.
+func (s PDPController) ActionSearch(ctx context.Context, request *azmodelspdp.ActionSearchRequest) (*azmodelspdp.ActionSearchResponse, error) {
+	if request == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - received nil request")
+	}
+	offset, size, err := searchValidateRequest(&request.SearchRequest)
+	if err != nil {
+		return nil, err
+	}
+	if request.Subject == nil || request.Resource == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - subject and resource are required")
+	}
+	if len(request.Candidates) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - action candidates are required")
+	}
+	accept := func(azmodelspdp.Action) bool {
+		return true
+	}
+	evaluation := func(action azmodelspdp.Action) azmodelspdp.EvaluationRequest {
+		return azmodelspdp.EvaluationRequest{Subject: request.Subject, Resource: request.Resource, Action: &action}
+	}
+	results, page, err := searchCandidates(ctx, s, &request.SearchRequest, offset, size, searchSliceCandidates(request.Candidates), accept, evaluation)
+	if err != nil {
+		return nil, err
+	}
+	return &azmodelspdp.ActionSearchResponse{RequestID: request.RequestID, Results: results, Page: page}, nil
+}
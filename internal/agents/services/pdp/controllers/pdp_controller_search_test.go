@@ -0,0 +1,81 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// subjectsStorageMock is a storage mock serving the subject candidates with a maximum page size.
+type subjectsStorageMock struct {
+	subjects    []azmodelspdp.Subject
+	maxPageSize int32
+	pageSizes   []int32
+}
+
+// AuthorizationCheck is not used by the tests.
+func (s *subjectsStorageMock) AuthorizationCheck(ctx context.Context, request *azmodelspdp.AuthorizationCheckRequest) ([]azmodelspdp.EvaluationResponse, error) {
+	return nil, nil
+}
+
+// FetchSubjectCandidates fetches a page of the subjects failing as the storage for a page size above the maximum.
+func (s *subjectsStorageMock) FetchSubjectCandidates(ctx context.Context, page int32, pageSize int32, zoneID int64) ([]azmodelspdp.Subject, error) {
+	s.pageSizes = append(s.pageSizes, pageSize)
+	if page <= 0 || pageSize <= 0 || pageSize > s.maxPageSize {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, "invalid client input - page number or page size is not valid")
+	}
+	start := min(int((page-1)*pageSize), len(s.subjects))
+	end := min(start+int(pageSize), len(s.subjects))
+	return s.subjects[start:end], nil
+}
+
+// PartialEvaluation is not used by the tests.
+func (s *subjectsStorageMock) PartialEvaluation(ctx context.Context, request *azmodelspdp.PartialEvaluationRequest) (*azmodelspdp.PartialEvaluationResponse, error) {
+	return nil, nil
+}
+
+// TestSearchStorageSubjectsWithPageSizeAboveMaximum tests the fetch of the subjects with a search page size above the maximum page size of the storage.
+func TestSearchStorageSubjectsWithPageSizeAboveMaximum(t *testing.T) {
+	assert := assert.New(t)
+	storage := &subjectsStorageMock{maxPageSize: 3}
+	for i := range 8 {
+		storage.subjects = append(storage.subjects, azmodelspdp.Subject{Type: "user", ID: fmt.Sprintf("user-%d", i)})
+	}
+	fetch := searchStorageSubjects(context.Background(), storage, 273165098782, int(storage.maxPageSize))
+	fetched := []azmodelspdp.Subject{}
+	offset := 5
+	for {
+		subjects, last, err := fetch(offset, 100)
+		assert.Nil(err, "fetch should not fail for a page size above the maximum")
+		fetched = append(fetched, subjects...)
+		if last {
+			break
+		}
+		offset += len(subjects)
+	}
+	assert.Equal(storage.subjects[5:], fetched, "subjects should be fetched from the offset")
+	for _, pageSize := range storage.pageSizes {
+		assert.LessOrEqual(pageSize, storage.maxPageSize, "page size should be clamped to the maximum")
+	}
+}
@@ -36,6 +36,10 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	V1PDPService_AuthorizationCheck_FullMethodName = "/policydecisionpoint.V1PDPService/AuthorizationCheck"
+	V1PDPService_SubjectSearch_FullMethodName      = "/policydecisionpoint.V1PDPService/SubjectSearch"
+	V1PDPService_ResourceSearch_FullMethodName     = "/policydecisionpoint.V1PDPService/ResourceSearch"
+	V1PDPService_ActionSearch_FullMethodName       = "/policydecisionpoint.V1PDPService/ActionSearch"
+	V1PDPService_PartialEvaluation_FullMethodName  = "/policydecisionpoint.V1PDPService/PartialEvaluation"
 )
 
 // V1PDPServiceClient is the client API for V1PDPService service.
@@ -45,6 +49,10 @@ const (
 // V1PDPService	is the service for the Policy Decision Point.
 type V1PDPServiceClient interface {
 	AuthorizationCheck(ctx context.Context, in *AuthorizationCheckRequest, opts ...grpc.CallOption) (*AuthorizationCheckResponse, error)
+	SubjectSearch(ctx context.Context, in *SubjectSearchRequest, opts ...grpc.CallOption) (*SubjectSearchResponse, error)
+	ResourceSearch(ctx context.Context, in *ResourceSearchRequest, opts ...grpc.CallOption) (*ResourceSearchResponse, error)
+	ActionSearch(ctx context.Context, in *ActionSearchRequest, opts ...grpc.CallOption) (*ActionSearchResponse, error)
+	PartialEvaluation(ctx context.Context, in *PartialEvaluationRequest, opts ...grpc.CallOption) (*PartialEvaluationResponse, error)
 }
 
 type v1PDPServiceClient struct {
@@ -65,6 +73,46 @@ func (c *v1PDPServiceClient) AuthorizationCheck(ctx context.Context, in *Authori
 	return out, nil
 }
 
+func (c *v1PDPServiceClient) SubjectSearch(ctx context.Context, in *SubjectSearchRequest, opts ...grpc.CallOption) (*SubjectSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubjectSearchResponse)
+	err := c.cc.Invoke(ctx, V1PDPService_SubjectSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PDPServiceClient) ResourceSearch(ctx context.Context, in *ResourceSearchRequest, opts ...grpc.CallOption) (*ResourceSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceSearchResponse)
+	err := c.cc.Invoke(ctx, V1PDPService_ResourceSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PDPServiceClient) ActionSearch(ctx context.Context, in *ActionSearchRequest, opts ...grpc.CallOption) (*ActionSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionSearchResponse)
+	err := c.cc.Invoke(ctx, V1PDPService_ActionSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1PDPServiceClient) PartialEvaluation(ctx context.Context, in *PartialEvaluationRequest, opts ...grpc.CallOption) (*PartialEvaluationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PartialEvaluationResponse)
+	err := c.cc.Invoke(ctx, V1PDPService_PartialEvaluation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // V1PDPServiceServer is the server API for V1PDPService service.
 // All implementations must embed UnimplementedV1PDPServiceServer
 // for forward compatibility.
@@ -72,6 +120,10 @@ func (c *v1PDPServiceClient) AuthorizationCheck(ctx context.Context, in *Authori
 // V1PDPService	is the service for the Policy Decision Point.
 type V1PDPServiceServer interface {
 	AuthorizationCheck(context.Context, *AuthorizationCheckRequest) (*AuthorizationCheckResponse, error)
+	SubjectSearch(context.Context, *SubjectSearchRequest) (*SubjectSearchResponse, error)
+	ResourceSearch(context.Context, *ResourceSearchRequest) (*ResourceSearchResponse, error)
+	ActionSearch(context.Context, *ActionSearchRequest) (*ActionSearchResponse, error)
+	PartialEvaluation(context.Context, *PartialEvaluationRequest) (*PartialEvaluationResponse, error)
 	mustEmbedUnimplementedV1PDPServiceServer()
 }
 
@@ -85,6 +137,18 @@ type UnimplementedV1PDPServiceServer struct{}
 func (UnimplementedV1PDPServiceServer) AuthorizationCheck(context.Context, *AuthorizationCheckRequest) (*AuthorizationCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthorizationCheck not implemented")
 }
+func (UnimplementedV1PDPServiceServer) SubjectSearch(context.Context, *SubjectSearchRequest) (*SubjectSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubjectSearch not implemented")
+}
+func (UnimplementedV1PDPServiceServer) ResourceSearch(context.Context, *ResourceSearchRequest) (*ResourceSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceSearch not implemented")
+}
+func (UnimplementedV1PDPServiceServer) ActionSearch(context.Context, *ActionSearchRequest) (*ActionSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionSearch not implemented")
+}
+func (UnimplementedV1PDPServiceServer) PartialEvaluation(context.Context, *PartialEvaluationRequest) (*PartialEvaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialEvaluation not implemented")
+}
 func (UnimplementedV1PDPServiceServer) mustEmbedUnimplementedV1PDPServiceServer() {}
 func (UnimplementedV1PDPServiceServer) testEmbeddedByValue()                      {}
 
@@ -124,6 +188,78 @@ func _V1PDPService_AuthorizationCheck_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _V1PDPService_SubjectSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PDPServiceServer).SubjectSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PDPService_SubjectSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PDPServiceServer).SubjectSearch(ctx, req.(*SubjectSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PDPService_ResourceSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PDPServiceServer).ResourceSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PDPService_ResourceSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PDPServiceServer).ResourceSearch(ctx, req.(*ResourceSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PDPService_ActionSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PDPServiceServer).ActionSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PDPService_ActionSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PDPServiceServer).ActionSearch(ctx, req.(*ActionSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1PDPService_PartialEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartialEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1PDPServiceServer).PartialEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1PDPService_PartialEvaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1PDPServiceServer).PartialEvaluation(ctx, req.(*PartialEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // V1PDPService_ServiceDesc is the grpc.ServiceDesc for V1PDPService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +271,22 @@ var V1PDPService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthorizationCheck",
 			Handler:    _V1PDPService_AuthorizationCheck_Handler,
 		},
+		{
+			MethodName: "SubjectSearch",
+			Handler:    _V1PDPService_SubjectSearch_Handler,
+		},
+		{
+			MethodName: "ResourceSearch",
+			Handler:    _V1PDPService_ResourceSearch_Handler,
+		},
+		{
+			MethodName: "ActionSearch",
+			Handler:    _V1PDPService_ActionSearch_Handler,
+		},
+		{
+			MethodName: "PartialEvaluation",
+			Handler:    _V1PDPService_PartialEvaluation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/agents/services/pdp/endpoints/api/v1/pdp.proto",
@@ -17,8 +17,11 @@
 package v1
 
 import (
+	"encoding/json"
+
 	"google.golang.org/protobuf/types/known/structpb"
 
+	azresiduals "github.com/permguard/permguard/pkg/authz/residuals"
 	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
 )
 
@@ -418,6 +421,11 @@ func MapGrpcAuthorizationCheckRequestToAgentAuthorizationCheckRequest(request *A
 	} else {
 		req.Evaluations = []azmodelspdp.EvaluationRequest{}
 	}
+	if request.Options != nil {
+		req.Options = &azmodelspdp.EvaluationsOptions{
+			EvaluationsSemantic: request.Options.GetEvaluationsSemantic(),
+		}
+	}
 	return req, nil
 }
 
@@ -482,6 +490,11 @@ func MapAgentAuthorizationCheckRequestToGrpcAuthorizationCheckRequest(request *a
 		}
 		req.Evaluations = evaluations
 	}
+	if request.Options != nil {
+		req.Options = &EvaluationsOptions{
+			EvaluationsSemantic: &request.Options.EvaluationsSemantic,
+		}
+	}
 	return req, nil
 }
 
@@ -656,3 +669,572 @@ func MapGrpcAuthorizationCheckResponseToAgentAuthorizationCheckResponse(response
 	}
 	return target, nil
 }
+
+// grpcSearchRequest is the gRPC search request.
+type grpcSearchRequest interface {
+	GetAuthorizationModel() *AuthorizationModelRequest
+	GetRequestID() string
+	GetSubject() *Subject
+	GetResource() *Resource
+	GetAction() *Action
+	GetContext() *structpb.Struct
+	GetPage() *SearchPageRequest
+}
+
+// grpcSearchRequestFields holds the gRPC fields shared by the search requests.
+type grpcSearchRequestFields struct {
+	authorizationModel *AuthorizationModelRequest
+	requestID          *string
+	subject            *Subject
+	resource           *Resource
+	action             *Action
+	context            *structpb.Struct
+	page               *SearchPageRequest
+}
+
+// mapGrpcSearchRequestToAgentSearchRequest maps the gRPC search request to the agent search request.
+func mapGrpcSearchRequestToAgentSearchRequest(request grpcSearchRequest) (*azmodelspdp.SearchRequest, error) {
+	req := &azmodelspdp.SearchRequest{}
+	if request.GetAuthorizationModel() != nil {
+		authzModel, err := MapGrpcAuthorizationModelRequestToAgentAuthorizationModelRequest(request.GetAuthorizationModel())
+		if err != nil {
+			return nil, err
+		}
+		req.AuthorizationModel = authzModel
+	}
+	req.RequestID = request.GetRequestID()
+	subject, err := MapGrpcSubjectToAgentSubject(request.GetSubject())
+	if err != nil {
+		return nil, err
+	}
+	req.Subject = subject
+	resource, err := MapGrpcResourceToAgentResource(request.GetResource())
+	if err != nil {
+		return nil, err
+	}
+	req.Resource = resource
+	action, err := MapGrpcActionToAgentAction(request.GetAction())
+	if err != nil {
+		return nil, err
+	}
+	req.Action = action
+	if request.GetContext() != nil {
+		req.Context = request.GetContext().AsMap()
+	}
+	if page := request.GetPage(); page != nil {
+		req.Page = &azmodelspdp.SearchPageRequest{
+			NextToken: page.GetNextToken(),
+			Size:      page.GetSize(),
+		}
+	}
+	return req, nil
+}
+
+// mapAgentSearchRequestToGrpcSearchRequestFields maps the agent search request to the gRPC search request fields.
+func mapAgentSearchRequestToGrpcSearchRequestFields(request *azmodelspdp.SearchRequest) (*grpcSearchRequestFields, error) {
+	fields := &grpcSearchRequestFields{}
+	if request.AuthorizationModel != nil {
+		authzModel, err := MapAgentAuthorizationModelRequestToGrpcAuthorizationModelRequest(request.AuthorizationModel)
+		if err != nil {
+			return nil, err
+		}
+		fields.authorizationModel = authzModel
+	}
+	if request.RequestID != "" {
+		fields.requestID = &request.RequestID
+	}
+	subject, err := MapAgentSubjectToGrpcSubject(request.Subject)
+	if err != nil {
+		return nil, err
+	}
+	fields.subject = subject
+	resource, err := MapAgentResourceToGrpcResource(request.Resource)
+	if err != nil {
+		return nil, err
+	}
+	fields.resource = resource
+	action, err := MapAgentActionToGrpcAction(request.Action)
+	if err != nil {
+		return nil, err
+	}
+	fields.action = action
+	if request.Context != nil {
+		data, err := structpb.NewStruct(request.Context)
+		if err != nil {
+			return nil, err
+		}
+		fields.context = data
+	}
+	if request.Page != nil {
+		fields.page = &SearchPageRequest{}
+		if request.Page.NextToken != "" {
+			fields.page.NextToken = &request.Page.NextToken
+		}
+		if request.Page.Size != 0 {
+			fields.page.Size = &request.Page.Size
+		}
+	}
+	return fields, nil
+}
+
+// mapGrpcSearchPageResponseToAgentSearchPageResponse maps the gRPC search page response to the agent search page response.
+func mapGrpcSearchPageResponseToAgentSearchPageResponse(page *SearchPageResponse) *azmodelspdp.SearchPageResponse {
+	if page == nil {
+		return nil
+	}
+	return &azmodelspdp.SearchPageResponse{
+		NextToken: page.GetNextToken(),
+	}
+}
+
+// mapAgentSearchPageResponseToGrpcSearchPageResponse maps the agent search page response to the gRPC search page response.
+func mapAgentSearchPageResponseToGrpcSearchPageResponse(page *azmodelspdp.SearchPageResponse) *SearchPageResponse {
+	if page == nil {
+		return nil
+	}
+	target := &SearchPageResponse{}
+	if page.NextToken != "" {
+		target.NextToken = &page.NextToken
+	}
+	return target
+}
+
+// MapGrpcSubjectSearchRequestToAgentSubjectSearchRequest maps the gRPC subject search request to the agent subject search request.
+func MapGrpcSubjectSearchRequestToAgentSubjectSearchRequest(request *SubjectSearchRequest) (*azmodelspdp.SubjectSearchRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	searchReq, err := mapGrpcSearchRequestToAgentSearchRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	req := &azmodelspdp.SubjectSearchRequest{SearchRequest: *searchReq}
+	for _, candidate := range request.Candidates {
+		subject, err := MapGrpcSubjectToAgentSubject(candidate)
+		if err != nil {
+			return nil, err
+		}
+		if subject != nil {
+			req.Candidates = append(req.Candidates, *subject)
+		}
+	}
+	return req, nil
+}
+
+// MapAgentSubjectSearchRequestToGrpcSubjectSearchRequest maps the agent subject search request to the gRPC subject search request.
+func MapAgentSubjectSearchRequestToGrpcSubjectSearchRequest(request *azmodelspdp.SubjectSearchRequest) (*SubjectSearchRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	fields, err := mapAgentSearchRequestToGrpcSearchRequestFields(&request.SearchRequest)
+	if err != nil {
+		return nil, err
+	}
+	req := &SubjectSearchRequest{
+		AuthorizationModel: fields.authorizationModel,
+		RequestID:          fields.requestID,
+		Subject:            fields.subject,
+		Resource:           fields.resource,
+		Action:             fields.action,
+		Context:            fields.context,
+		Page:               fields.page,
+	}
+	for _, candidate := range request.Candidates {
+		subject, err := MapAgentSubjectToGrpcSubject(&candidate)
+		if err != nil {
+			return nil, err
+		}
+		req.Candidates = append(req.Candidates, subject)
+	}
+	return req, nil
+}
+
+// MapAgentSubjectSearchResponseToGrpcSubjectSearchResponse maps the agent subject search response to the gRPC subject search response.
+func MapAgentSubjectSearchResponseToGrpcSubjectSearchResponse(response *azmodelspdp.SubjectSearchResponse) (*SubjectSearchResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+	target := &SubjectSearchResponse{}
+	target.RequestID = &response.RequestID
+	for _, result := range response.Results {
+		subject, err := MapAgentSubjectToGrpcSubject(&result)
+		if err != nil {
+			return nil, err
+		}
+		target.Results = append(target.Results, subject)
+	}
+	target.Page = mapAgentSearchPageResponseToGrpcSearchPageResponse(response.Page)
+	return target, nil
+}
+
+// MapGrpcSubjectSearchResponseToAgentSubjectSearchResponse maps the gRPC subject search response to the agent subject search response.
+func MapGrpcSubjectSearchResponseToAgentSubjectSearchResponse(response *SubjectSearchResponse) (*azmodelspdp.SubjectSearchResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+	target := &azmodelspdp.SubjectSearchResponse{}
+	target.RequestID = response.GetRequestID()
+	target.Results = []azmodelspdp.Subject{}
+	for _, result := range response.Results {
+		subject, err := MapGrpcSubjectToAgentSubject(result)
+		if err != nil {
+			return nil, err
+		}
+		if subject != nil {
+			target.Results = append(target.Results, *subject)
+		}
+	}
+	target.Page = mapGrpcSearchPageResponseToAgentSearchPageResponse(response.Page)
+	return target, nil
+}
+
+// MapGrpcResourceSearchRequestToAgentResourceSearchRequest maps the gRPC resource search request to the agent resource search request.
+func MapGrpcResourceSearchRequestToAgentResourceSearchRequest(request *ResourceSearchRequest) (*azmodelspdp.ResourceSearchRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	searchReq, err := mapGrpcSearchRequestToAgentSearchRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	req := &azmodelspdp.ResourceSearchRequest{SearchRequest: *searchReq}
+	for _, candidate := range request.Candidates {
+		resource, err := MapGrpcResourceToAgentResource(candidate)
+		if err != nil {
+			return nil, err
+		}
+		if resource != nil {
+			req.Candidates = append(req.Candidates, *resource)
+		}
+	}
+	return req, nil
+}
+
+// MapAgentResourceSearchRequestToGrpcResourceSearchRequest maps the agent resource search request to the gRPC resource search request.
+func MapAgentResourceSearchRequestToGrpcResourceSearchRequest(request *azmodelspdp.ResourceSearchRequest) (*ResourceSearchRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	fields, err := mapAgentSearchRequestToGrpcSearchRequestFields(&request.SearchRequest)
+	if err != nil {
+		return nil, err
+	}
+	req := &ResourceSearchRequest{
+		AuthorizationModel: fields.authorizationModel,
+		RequestID:          fields.requestID,
+		Subject:            fields.subject,
+		Resource:           fields.resource,
+		Action:             fields.action,
+		Context:            fields.context,
+		Page:               fields.page,
+	}
+	for _, candidate := range request.Candidates {
+		resource, err := MapAgentResourceToGrpcResource(&candidate)
+		if err != nil {
+			return nil, err
+		}
+		req.Candidates = append(req.Candidates, resource)
+	}
+	return req, nil
+}
+
+// MapAgentResourceSearchResponseToGrpcResourceSearchResponse maps the agent resource search response to the gRPC resource search response.
+func MapAgentResourceSearchResponseToGrpcResourceSearchResponse(response *azmodelspdp.ResourceSearchResponse) (*ResourceSearchResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+	target := &ResourceSearchResponse{}
+	target.RequestID = &response.RequestID
+	for _, result := range response.Results {
+		resource, err := MapAgentResourceToGrpcResource(&result)
+		if err != nil {
+			return nil, err
+		}
+		target.Results = append(target.Results, resource)
+	}
+	target.Page = mapAgentSearchPageResponseToGrpcSearchPageResponse(response.Page)
+	return target, nil
+}
+
+// MapGrpcResourceSearchResponseToAgentResourceSearchResponse maps the gRPC resource search response to the agent resource search response.
+func MapGrpcResourceSearchResponseToAgentResourceSearchResponse(response *ResourceSearchResponse) (*azmodelspdp.ResourceSearchResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+	target := &azmodelspdp.ResourceSearchResponse{}
+	target.RequestID = response.GetRequestID()
+	target.Results = []azmodelspdp.Resource{}
+	for _, result := range response.Results {
+		resource, err := MapGrpcResourceToAgentResource(result)
+		if err != nil {
+			return nil, err
+		}
+		if resource != nil {
+			target.Results = append(target.Results, *resource)
+		}
+	}
+	target.Page = mapGrpcSearchPageResponseToAgentSearchPageResponse(response.Page)
+	return target, nil
+}
+
+// MapGrpcActionSearchRequestToAgentActionSearchRequest maps the gRPC action search request to the agent action search request.
+func MapGrpcActionSearchRequestToAgentActionSearchRequest(request *ActionSearchRequest) (*azmodelspdp.ActionSearchRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	searchReq, err := mapGrpcSearchRequestToAgentSearchRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	req := &azmodelspdp.ActionSearchRequest{SearchRequest: *searchReq}
+	for _, candidate := range request.Candidates {
+		action, err := MapGrpcActionToAgentAction(candidate)
+		if err != nil {
+			return nil, err
+		}
+		if action != nil {
+			req.Candidates = append(req.Candidates, *action)
+		}
+	}
+	return req, nil
+}
+
+// MapAgentActionSearchRequestToGrpcActionSearchRequest maps the agent action search request to the gRPC action search request.
+func MapAgentActionSearchRequestToGrpcActionSearchRequest(request *azmodelspdp.ActionSearchRequest) (*ActionSearchRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	fields, err := mapAgentSearchRequestToGrpcSearchRequestFields(&request.SearchRequest)
+	if err != nil {
+		return nil, err
+	}
+	req := &ActionSearchRequest{
+		AuthorizationModel: fields.authorizationModel,
+		RequestID:          fields.requestID,
+		Subject:            fields.subject,
+		Resource:           fields.resource,
+		Action:             fields.action,
+		Context:            fields.context,
+		Page:               fields.page,
+	}
+	for _, candidate := range request.Candidates {
+		action, err := MapAgentActionToGrpcAction(&candidate)
+		if err != nil {
+			return nil, err
+		}
+		req.Candidates = append(req.Candidates, action)
+	}
+	return req, nil
+}
+
+// MapAgentActionSearchResponseToGrpcActionSearchResponse maps the agent action search response to the gRPC action search response.
+func MapAgentActionSearchResponseToGrpcActionSearchResponse(response *azmodelspdp.ActionSearchResponse) (*ActionSearchResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+	target := &ActionSearchResponse{}
+	target.RequestID = &response.RequestID
+	for _, result := range response.Results {
+		action, err := MapAgentActionToGrpcAction(&result)
+		if err != nil {
+			return nil, err
+		}
+		target.Results = append(target.Results, action)
+	}
+	target.Page = mapAgentSearchPageResponseToGrpcSearchPageResponse(response.Page)
+	return target, nil
+}
+
+// MapGrpcActionSearchResponseToAgentActionSearchResponse maps the gRPC action search response to the agent action search response.
+func MapGrpcActionSearchResponseToAgentActionSearchResponse(response *ActionSearchResponse) (*azmodelspdp.ActionSearchResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+	target := &azmodelspdp.ActionSearchResponse{}
+	target.RequestID = response.GetRequestID()
+	target.Results = []azmodelspdp.Action{}
+	for _, result := range response.Results {
+		action, err := MapGrpcActionToAgentAction(result)
+		if err != nil {
+			return nil, err
+		}
+		if action != nil {
+			target.Results = append(target.Results, *action)
+		}
+	}
+	target.Page = mapGrpcSearchPageResponseToAgentSearchPageResponse(response.Page)
+	return target, nil
+}
+
+// mapAgentResidualToGrpcResidual maps the agent residual expression to the gRPC residual.
+func mapAgentResidualToGrpcResidual(residual *azresiduals.Expression) (*structpb.Value, error) {
+	if residual == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(residual)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return structpb.NewValue(value)
+}
+
+// mapGrpcResidualToAgentResidual maps the gRPC residual to the agent residual expression.
+func mapGrpcResidualToAgentResidual(value *structpb.Value) (*azresiduals.Expression, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value.AsInterface())
+	if err != nil {
+		return nil, err
+	}
+	residual := &azresiduals.Expression{}
+	if err := json.Unmarshal(data, residual); err != nil {
+		return nil, err
+	}
+	return residual, nil
+}
+
+// MapGrpcPartialEvaluationRequestToAgentPartialEvaluationRequest maps the gRPC partial evaluation request to the agent partial evaluation request.
+func MapGrpcPartialEvaluationRequestToAgentPartialEvaluationRequest(request *PartialEvaluationRequest) (*azmodelspdp.PartialEvaluationRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	req := &azmodelspdp.PartialEvaluationRequest{}
+	if request.AuthorizationModel != nil {
+		authzModel, err := MapGrpcAuthorizationModelRequestToAgentAuthorizationModelRequest(request.AuthorizationModel)
+		if err != nil {
+			return nil, err
+		}
+		req.AuthorizationModel = authzModel
+	}
+	req.RequestID = request.GetRequestID()
+	subject, err := MapGrpcSubjectToAgentSubject(request.Subject)
+	if err != nil {
+		return nil, err
+	}
+	req.Subject = subject
+	resource, err := MapGrpcResourceToAgentResource(request.Resource)
+	if err != nil {
+		return nil, err
+	}
+	req.Resource = resource
+	action, err := MapGrpcActionToAgentAction(request.Action)
+	if err != nil {
+		return nil, err
+	}
+	req.Action = action
+	if request.Context != nil {
+		req.Context = request.Context.AsMap()
+	}
+	if request.SQL != nil {
+		req.SQL = &azmodelspdp.PartialEvaluationSQLOptions{
+			ResourceIDColumn: request.SQL.GetResourceIDColumn(),
+			Columns:          request.SQL.GetColumns(),
+		}
+	}
+	return req, nil
+}
+
+// MapAgentPartialEvaluationRequestToGrpcPartialEvaluationRequest maps the agent partial evaluation request to the gRPC partial evaluation request.
+func MapAgentPartialEvaluationRequestToGrpcPartialEvaluationRequest(request *azmodelspdp.PartialEvaluationRequest) (*PartialEvaluationRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	req := &PartialEvaluationRequest{}
+	if request.AuthorizationModel != nil {
+		authzModel, err := MapAgentAuthorizationModelRequestToGrpcAuthorizationModelRequest(request.AuthorizationModel)
+		if err != nil {
+			return nil, err
+		}
+		req.AuthorizationModel = authzModel
+	}
+	if request.RequestID != "" {
+		req.RequestID = &request.RequestID
+	}
+	subject, err := MapAgentSubjectToGrpcSubject(request.Subject)
+	if err != nil {
+		return nil, err
+	}
+	req.Subject = subject
+	resource, err := MapAgentResourceToGrpcResource(request.Resource)
+	if err != nil {
+		return nil, err
+	}
+	req.Resource = resource
+	action, err := MapAgentActionToGrpcAction(request.Action)
+	if err != nil {
+		return nil, err
+	}
+	req.Action = action
+	if request.Context != nil {
+		data, err := structpb.NewStruct(request.Context)
+		if err != nil {
+			return nil, err
+		}
+		req.Context = data
+	}
+	if request.SQL != nil {
+		req.SQL = &PartialEvaluationSQLOptions{
+			Columns: request.SQL.Columns,
+		}
+		if request.SQL.ResourceIDColumn != "" {
+			req.SQL.ResourceIDColumn = &request.SQL.ResourceIDColumn
+		}
+	}
+	return req, nil
+}
+
+// MapAgentPartialEvaluationResponseToGrpcPartialEvaluationResponse maps the agent partial evaluation response to the gRPC partial evaluation response.
+func MapAgentPartialEvaluationResponseToGrpcPartialEvaluationResponse(response *azmodelspdp.PartialEvaluationResponse) (*PartialEvaluationResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+	target := &PartialEvaluationResponse{}
+	target.RequestID = &response.RequestID
+	target.Decision = response.Decision
+	residual, err := mapAgentResidualToGrpcResidual(response.Residual)
+	if err != nil {
+		return nil, err
+	}
+	target.Residual = residual
+	if response.SQL != nil {
+		args, err := structpb.NewList(response.SQL.Args)
+		if err != nil {
+			return nil, err
+		}
+		target.SQL = &PartialEvaluationSQLResponse{
+			Where: response.SQL.Where,
+			Args:  args,
+		}
+	}
+	return target, nil
+}
+
+// MapGrpcPartialEvaluationResponseToAgentPartialEvaluationResponse maps the gRPC partial evaluation response to the agent partial evaluation response.
+func MapGrpcPartialEvaluationResponseToAgentPartialEvaluationResponse(response *PartialEvaluationResponse) (*azmodelspdp.PartialEvaluationResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+	target := &azmodelspdp.PartialEvaluationResponse{}
+	target.RequestID = response.GetRequestID()
+	target.Decision = response.GetDecision()
+	residual, err := mapGrpcResidualToAgentResidual(response.Residual)
+	if err != nil {
+		return nil, err
+	}
+	target.Residual = residual
+	if response.SQL != nil {
+		target.SQL = &azmodelspdp.PartialEvaluationSQLResponse{
+			Where: response.SQL.GetWhere(),
+			Args:  []any{},
+		}
+		if response.SQL.Args != nil {
+			target.SQL.Args = response.SQL.Args.AsSlice()
+		}
+	}
+	return target, nil
+}
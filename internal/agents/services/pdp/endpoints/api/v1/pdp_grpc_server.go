@@ -30,7 +30,15 @@ import (
 // PDPService is the service for the PDP.
 type PDPService interface {
 	// AuthorizationCheck checks the authorization.
-	AuthorizationCheck(request *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (*azmodelspdp.AuthorizationCheckResponse, error)
+	AuthorizationCheck(ctx context.Context, request *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (*azmodelspdp.AuthorizationCheckResponse, error)
+	// SubjectSearch searches the subjects allowed to perform the action on the resource.
+	SubjectSearch(ctx context.Context, request *azmodelspdp.SubjectSearchRequest) (*azmodelspdp.SubjectSearchResponse, error)
+	// ResourceSearch searches the resources on which the subject is allowed to perform the action.
+	ResourceSearch(ctx context.Context, request *azmodelspdp.ResourceSearchRequest) (*azmodelspdp.ResourceSearchResponse, error)
+	// ActionSearch searches the actions the subject is allowed to perform on the resource.
+	ActionSearch(ctx context.Context, request *azmodelspdp.ActionSearchRequest) (*azmodelspdp.ActionSearchResponse, error)
+	// PartialEvaluation partially evaluates the authorization for the resources of a type and returns the residual conditions.
+	PartialEvaluation(ctx context.Context, request *azmodelspdp.PartialEvaluationRequest) (*azmodelspdp.PartialEvaluationResponse, error)
 }
 
 // NewV1PDPServer creates a new PDP server.
@@ -63,7 +71,7 @@ func (s *V1PDPServer) AuthorizationCheck(ctx context.Context, request *Authoriza
 	if req == nil {
 		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "request cannot be nil", err)
 	}
-	authzResponse, err := s.service.AuthorizationCheck(req)
+	authzResponse, err := s.service.AuthorizationCheck(ctx, req)
 	if err != nil {
 		authzResponse = &azmodelspdp.AuthorizationCheckResponse{
 			RequestID: req.RequestID,
@@ -84,3 +92,55 @@ func (s *V1PDPServer) AuthorizationCheck(ctx context.Context, request *Authoriza
 	}
 	return MapAgentAuthorizationCheckResponseToGrpcAuthorizationCheckResponse(authzResponse)
 }
+
+// SubjectSearch searches the subjects allowed to perform the action on the resource.
+func (s *V1PDPServer) SubjectSearch(ctx context.Context, request *SubjectSearchRequest) (*SubjectSearchResponse, error) {
+	req, err := MapGrpcSubjectSearchRequestToAgentSubjectSearchRequest(request)
+	if req == nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "request cannot be nil", err)
+	}
+	searchResponse, err := s.service.SubjectSearch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentSubjectSearchResponseToGrpcSubjectSearchResponse(searchResponse)
+}
+
+// ResourceSearch searches the resources on which the subject is allowed to perform the action.
+func (s *V1PDPServer) ResourceSearch(ctx context.Context, request *ResourceSearchRequest) (*ResourceSearchResponse, error) {
+	req, err := MapGrpcResourceSearchRequestToAgentResourceSearchRequest(request)
+	if req == nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "request cannot be nil", err)
+	}
+	searchResponse, err := s.service.ResourceSearch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentResourceSearchResponseToGrpcResourceSearchResponse(searchResponse)
+}
+
+// ActionSearch searches the actions the subject is allowed to perform on the resource.
+func (s *V1PDPServer) ActionSearch(ctx context.Context, request *ActionSearchRequest) (*ActionSearchResponse, error) {
+	req, err := MapGrpcActionSearchRequestToAgentActionSearchRequest(request)
+	if req == nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "request cannot be nil", err)
+	}
+	searchResponse, err := s.service.ActionSearch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentActionSearchResponseToGrpcActionSearchResponse(searchResponse)
+}
+
+// PartialEvaluation partially evaluates the authorization for the resources of a type and returns the residual conditions.
+func (s *V1PDPServer) PartialEvaluation(ctx context.Context, request *PartialEvaluationRequest) (*PartialEvaluationResponse, error) {
+	req, err := MapGrpcPartialEvaluationRequestToAgentPartialEvaluationRequest(request)
+	if req == nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "request cannot be nil", err)
+	}
+	partialResponse, err := s.service.PartialEvaluation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentPartialEvaluationResponseToGrpcPartialEvaluationResponse(partialResponse)
+}
@@ -18,6 +18,7 @@ package pdp
 
 import (
 	"flag"
+	"runtime"
 
 	"github.com/spf13/viper"
 
@@ -35,6 +36,7 @@ const (
 	flagSuffixGrpcPort       = "grpc-port"
 	flagCentralEngine        = "engine-central"
 	flagDataFetchMaxPageSize = "data-fetch-maxpagesize"
+	flagEvaluationWorkers    = "evaluation-workers"
 )
 
 // PDPServiceConfig holds the configuration for the server.
@@ -56,6 +58,7 @@ func (c *PDPServiceConfig) AddFlags(flagSet *flag.FlagSet) error {
 	flagSet.Int(azoptions.FlagName(flagServerPDPPrefix, flagSuffixGrpcPort), 9094, "port to be used for exposing the pdp grpc services")
 	flagSet.String(azoptions.FlagName(flagStoragePDPPrefix, flagCentralEngine), "", "data storage engine to be used for central data; this overrides the --storage-engine-central option")
 	flagSet.Int(azoptions.FlagName(flagServerPDPPrefix, flagDataFetchMaxPageSize), 10000, "maximum number of items to fetch per request")
+	flagSet.Int(azoptions.FlagName(flagServerPDPPrefix, flagEvaluationWorkers), runtime.NumCPU(), "maximum number of evaluations to be processed concurrently for each authorization request")
 	return nil
 }
 
@@ -83,6 +86,13 @@ func (c *PDPServiceConfig) InitFromViper(v *viper.Viper) error {
 		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid data fetch max page size")
 	}
 	c.config[flagDataFetchMaxPageSize] = dataFetchMaxPageSize
+	// retrieve the evaluation workers
+	flagName = azoptions.FlagName(flagServerPDPPrefix, flagEvaluationWorkers)
+	evaluationWorkers := v.GetInt(flagName)
+	if evaluationWorkers <= 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid evaluation workers")
+	}
+	c.config[flagEvaluationWorkers] = evaluationWorkers
 	return nil
 }
 
@@ -106,6 +116,11 @@ func (c *PDPServiceConfig) GetDataFetchMaxPageSize() int {
 	return c.config[flagDataFetchMaxPageSize].(int)
 }
 
+// GetEvaluationWorkers returns the maximum number of evaluations to be processed concurrently.
+func (c *PDPServiceConfig) GetEvaluationWorkers() int {
+	return c.config[flagEvaluationWorkers].(int)
+}
+
 // GetService returns the service kind.
 func (c *PDPServiceConfig) GetService() azservices.ServiceKind {
 	return c.service
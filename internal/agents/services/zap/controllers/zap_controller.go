@@ -17,6 +17,7 @@
 package controllers
 
 import (
+	azwebhooks "github.com/permguard/permguard/internal/agents/services/zap/webhooks"
 	azservices "github.com/permguard/permguard/pkg/agents/services"
 	azStorage "github.com/permguard/permguard/pkg/agents/storage"
 	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
@@ -24,20 +25,27 @@ import (
 
 // ZAPController is the controller for the ZAP service.
 type ZAPController struct {
-	ctx     *azservices.ServiceContext
-	storage azStorage.ZAPCentralStorage
+	ctx         *azservices.ServiceContext
+	storage     azStorage.ZAPCentralStorage
+	maxPageSize int32
 }
 
-// Setup initializes the service.
+// Setup initializes the service and starts the webhook dispatcher.
 func (s ZAPController) Setup() error {
+	dispatcher, err := azwebhooks.NewDispatcher(s.storage, s.ctx.GetLogger(), s.maxPageSize)
+	if err != nil {
+		return err
+	}
+	go dispatcher.Run(s.ctx.GetContext(), azwebhooks.DefaultInterval)
 	return nil
 }
 
 // NewZAPController creates a new ZAP controller.
-func NewZAPController(serviceContext *azservices.ServiceContext, zapCentralStorage azStorage.ZAPCentralStorage) (*ZAPController, error) {
+func NewZAPController(serviceContext *azservices.ServiceContext, zapCentralStorage azStorage.ZAPCentralStorage, maxPageSize int32) (*ZAPController, error) {
 	service := ZAPController{
-		ctx:     serviceContext,
-		storage: zapCentralStorage,
+		ctx:         serviceContext,
+		storage:     zapCentralStorage,
+		maxPageSize: maxPageSize,
 	}
 	return &service, nil
 }
@@ -102,6 +110,16 @@ func (s ZAPController) FetchIdentities(page int32, pageSize int32, zoneID int64,
 	return s.storage.FetchIdentities(page, pageSize, zoneID, fields)
 }
 
+// CountIdentities counts the identities filtering by search criteria.
+func (s ZAPController) CountIdentities(zoneID int64, fields map[string]any) (int64, error) {
+	return s.storage.CountIdentities(zoneID, fields)
+}
+
+// UpsertIdentities creates or updates identities in bulk.
+func (s ZAPController) UpsertIdentities(options *azmodelszap.BulkUpsertOptions, identities []azmodelszap.Identity) (*azmodelszap.BulkUpsertReport, error) {
+	return s.storage.UpsertIdentities(options, identities)
+}
+
 // CreateTenant creates a new tenant.
 func (s ZAPController) CreateTenant(tenant *azmodelszap.Tenant) (*azmodelszap.Tenant, error) {
 	return s.storage.CreateTenant(tenant)
@@ -121,3 +139,38 @@ func (s ZAPController) DeleteTenant(zoneID int64, tenantID string) (*azmodelszap
 func (s ZAPController) FetchTenants(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Tenant, error) {
 	return s.storage.FetchTenants(page, pageSize, zoneID, fields)
 }
+
+// UpsertTenants creates or updates tenants in bulk.
+func (s ZAPController) UpsertTenants(options *azmodelszap.BulkUpsertOptions, tenants []azmodelszap.Tenant) (*azmodelszap.BulkUpsertReport, error) {
+	return s.storage.UpsertTenants(options, tenants)
+}
+
+// CreateWebhook creates a new webhook.
+func (s ZAPController) CreateWebhook(webhook *azmodelszap.Webhook) (*azmodelszap.Webhook, error) {
+	return s.storage.CreateWebhook(webhook)
+}
+
+// UpdateWebhook updates a webhook.
+func (s ZAPController) UpdateWebhook(webhook *azmodelszap.Webhook) (*azmodelszap.Webhook, error) {
+	return s.storage.UpdateWebhook(webhook)
+}
+
+// DeleteWebhook delete a webhook.
+func (s ZAPController) DeleteWebhook(zoneID int64, webhookID string) (*azmodelszap.Webhook, error) {
+	return s.storage.DeleteWebhook(zoneID, webhookID)
+}
+
+// FetchWebhooks returns all webhooks filtering by search criteria.
+func (s ZAPController) FetchWebhooks(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Webhook, error) {
+	return s.storage.FetchWebhooks(page, pageSize, zoneID, fields)
+}
+
+// FetchWebhookDeliveries returns the webhook deliveries filtering by search criteria.
+func (s ZAPController) FetchWebhookDeliveries(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.WebhookDelivery, error) {
+	return s.storage.FetchWebhookDeliveries(page, pageSize, zoneID, fields)
+}
+
+// RequeueWebhookDelivery requeues a dead webhook delivery.
+func (s ZAPController) RequeueWebhookDelivery(zoneID int64, deliveryID int64) (*azmodelszap.WebhookDelivery, error) {
+	return s.storage.RequeueWebhookDelivery(zoneID, deliveryID)
+}
@@ -38,6 +38,91 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Fetch query with the cursor pagination, sorting and filters.
+type FetchQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=Cursor,proto3,oneof" json:"Cursor,omitempty"`
+	SortBy        *string                `protobuf:"bytes,2,opt,name=SortBy,proto3,oneof" json:"SortBy,omitempty"`
+	SortOrder     *string                `protobuf:"bytes,3,opt,name=SortOrder,proto3,oneof" json:"SortOrder,omitempty"`
+	NamePrefix    *string                `protobuf:"bytes,4,opt,name=NamePrefix,proto3,oneof" json:"NamePrefix,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=CreatedAfter,proto3,oneof" json:"CreatedAfter,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedBefore,proto3,oneof" json:"CreatedBefore,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchQuery) Reset() {
+	*x = FetchQuery{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchQuery) ProtoMessage() {}
+
+func (x *FetchQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchQuery.ProtoReflect.Descriptor instead.
+func (*FetchQuery) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FetchQuery) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *FetchQuery) GetSortBy() string {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ""
+}
+
+func (x *FetchQuery) GetSortOrder() string {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return ""
+}
+
+func (x *FetchQuery) GetNamePrefix() string {
+	if x != nil && x.NamePrefix != nil {
+		return *x.NamePrefix
+	}
+	return ""
+}
+
+func (x *FetchQuery) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *FetchQuery) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
 // Zone get request.
 type ZoneFetchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -45,13 +130,14 @@ type ZoneFetchRequest struct {
 	PageSize      *int32                 `protobuf:"varint,2,opt,name=PageSize,proto3,oneof" json:"PageSize,omitempty"`
 	ZoneID        *int64                 `protobuf:"varint,3,opt,name=ZoneID,proto3,oneof" json:"ZoneID,omitempty"`
 	Name          *string                `protobuf:"bytes,4,opt,name=Name,proto3,oneof" json:"Name,omitempty"`
+	Query         *FetchQuery            `protobuf:"bytes,5,opt,name=Query,proto3,oneof" json:"Query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ZoneFetchRequest) Reset() {
 	*x = ZoneFetchRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[0]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -63,7 +149,7 @@ func (x *ZoneFetchRequest) String() string {
 func (*ZoneFetchRequest) ProtoMessage() {}
 
 func (x *ZoneFetchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[0]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -76,7 +162,7 @@ func (x *ZoneFetchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZoneFetchRequest.ProtoReflect.Descriptor instead.
 func (*ZoneFetchRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{0}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *ZoneFetchRequest) GetPage() int32 {
@@ -107,6 +193,13 @@ func (x *ZoneFetchRequest) GetName() string {
 	return ""
 }
 
+func (x *ZoneFetchRequest) GetQuery() *FetchQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
 // Zone create request.
 type ZoneCreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -117,7 +210,7 @@ type ZoneCreateRequest struct {
 
 func (x *ZoneCreateRequest) Reset() {
 	*x = ZoneCreateRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[1]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -129,7 +222,7 @@ func (x *ZoneCreateRequest) String() string {
 func (*ZoneCreateRequest) ProtoMessage() {}
 
 func (x *ZoneCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[1]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -142,7 +235,7 @@ func (x *ZoneCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZoneCreateRequest.ProtoReflect.Descriptor instead.
 func (*ZoneCreateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{1}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ZoneCreateRequest) GetName() string {
@@ -163,7 +256,7 @@ type ZoneUpdateRequest struct {
 
 func (x *ZoneUpdateRequest) Reset() {
 	*x = ZoneUpdateRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[2]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -175,7 +268,7 @@ func (x *ZoneUpdateRequest) String() string {
 func (*ZoneUpdateRequest) ProtoMessage() {}
 
 func (x *ZoneUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[2]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +281,7 @@ func (x *ZoneUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZoneUpdateRequest.ProtoReflect.Descriptor instead.
 func (*ZoneUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{2}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ZoneUpdateRequest) GetZoneID() int64 {
@@ -215,7 +308,7 @@ type ZoneDeleteRequest struct {
 
 func (x *ZoneDeleteRequest) Reset() {
 	*x = ZoneDeleteRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[3]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -227,7 +320,7 @@ func (x *ZoneDeleteRequest) String() string {
 func (*ZoneDeleteRequest) ProtoMessage() {}
 
 func (x *ZoneDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[3]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +333,7 @@ func (x *ZoneDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZoneDeleteRequest.ProtoReflect.Descriptor instead.
 func (*ZoneDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{3}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ZoneDeleteRequest) GetZoneID() int64 {
@@ -263,7 +356,7 @@ type ZoneResponse struct {
 
 func (x *ZoneResponse) Reset() {
 	*x = ZoneResponse{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[4]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -275,7 +368,7 @@ func (x *ZoneResponse) String() string {
 func (*ZoneResponse) ProtoMessage() {}
 
 func (x *ZoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[4]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +381,7 @@ func (x *ZoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZoneResponse.ProtoReflect.Descriptor instead.
 func (*ZoneResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{4}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ZoneResponse) GetZoneID() int64 {
@@ -327,13 +420,14 @@ type TenantFetchRequest struct {
 	ZoneID        int64                  `protobuf:"varint,3,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
 	TenantID      *string                `protobuf:"bytes,4,opt,name=TenantID,proto3,oneof" json:"TenantID,omitempty"`
 	Name          *string                `protobuf:"bytes,5,opt,name=Name,proto3,oneof" json:"Name,omitempty"`
+	Query         *FetchQuery            `protobuf:"bytes,6,opt,name=Query,proto3,oneof" json:"Query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TenantFetchRequest) Reset() {
 	*x = TenantFetchRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[5]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -345,7 +439,7 @@ func (x *TenantFetchRequest) String() string {
 func (*TenantFetchRequest) ProtoMessage() {}
 
 func (x *TenantFetchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[5]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +452,7 @@ func (x *TenantFetchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantFetchRequest.ProtoReflect.Descriptor instead.
 func (*TenantFetchRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{5}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TenantFetchRequest) GetPage() int32 {
@@ -396,6 +490,13 @@ func (x *TenantFetchRequest) GetName() string {
 	return ""
 }
 
+func (x *TenantFetchRequest) GetQuery() *FetchQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
 // Tenant create request.
 type TenantCreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -407,7 +508,7 @@ type TenantCreateRequest struct {
 
 func (x *TenantCreateRequest) Reset() {
 	*x = TenantCreateRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[6]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -419,7 +520,7 @@ func (x *TenantCreateRequest) String() string {
 func (*TenantCreateRequest) ProtoMessage() {}
 
 func (x *TenantCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[6]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,7 +533,7 @@ func (x *TenantCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantCreateRequest.ProtoReflect.Descriptor instead.
 func (*TenantCreateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{6}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TenantCreateRequest) GetZoneID() int64 {
@@ -461,7 +562,7 @@ type TenantUpdateRequest struct {
 
 func (x *TenantUpdateRequest) Reset() {
 	*x = TenantUpdateRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[7]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -473,7 +574,7 @@ func (x *TenantUpdateRequest) String() string {
 func (*TenantUpdateRequest) ProtoMessage() {}
 
 func (x *TenantUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[7]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,7 +587,7 @@ func (x *TenantUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantUpdateRequest.ProtoReflect.Descriptor instead.
 func (*TenantUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{7}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TenantUpdateRequest) GetZoneID() int64 {
@@ -521,7 +622,7 @@ type TenantDeleteRequest struct {
 
 func (x *TenantDeleteRequest) Reset() {
 	*x = TenantDeleteRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[8]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -533,7 +634,7 @@ func (x *TenantDeleteRequest) String() string {
 func (*TenantDeleteRequest) ProtoMessage() {}
 
 func (x *TenantDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[8]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +647,7 @@ func (x *TenantDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantDeleteRequest.ProtoReflect.Descriptor instead.
 func (*TenantDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{8}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TenantDeleteRequest) GetZoneID() int64 {
@@ -577,7 +678,7 @@ type TenantResponse struct {
 
 func (x *TenantResponse) Reset() {
 	*x = TenantResponse{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[9]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -589,7 +690,7 @@ func (x *TenantResponse) String() string {
 func (*TenantResponse) ProtoMessage() {}
 
 func (x *TenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[9]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +703,7 @@ func (x *TenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantResponse.ProtoReflect.Descriptor instead.
 func (*TenantResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{9}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TenantResponse) GetTenantID() string {
@@ -648,13 +749,14 @@ type IdentitySourceFetchRequest struct {
 	ZoneID           int64                  `protobuf:"varint,3,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
 	IdentitySourceID *string                `protobuf:"bytes,4,opt,name=IdentitySourceID,proto3,oneof" json:"IdentitySourceID,omitempty"`
 	Name             *string                `protobuf:"bytes,5,opt,name=Name,proto3,oneof" json:"Name,omitempty"`
+	Query            *FetchQuery            `protobuf:"bytes,6,opt,name=Query,proto3,oneof" json:"Query,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *IdentitySourceFetchRequest) Reset() {
 	*x = IdentitySourceFetchRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[10]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +768,7 @@ func (x *IdentitySourceFetchRequest) String() string {
 func (*IdentitySourceFetchRequest) ProtoMessage() {}
 
 func (x *IdentitySourceFetchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[10]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +781,7 @@ func (x *IdentitySourceFetchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentitySourceFetchRequest.ProtoReflect.Descriptor instead.
 func (*IdentitySourceFetchRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{10}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *IdentitySourceFetchRequest) GetPage() int32 {
@@ -717,6 +819,13 @@ func (x *IdentitySourceFetchRequest) GetName() string {
 	return ""
 }
 
+func (x *IdentitySourceFetchRequest) GetQuery() *FetchQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
 // IdentitySource create request.
 type IdentitySourceCreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -728,7 +837,7 @@ type IdentitySourceCreateRequest struct {
 
 func (x *IdentitySourceCreateRequest) Reset() {
 	*x = IdentitySourceCreateRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[11]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +849,7 @@ func (x *IdentitySourceCreateRequest) String() string {
 func (*IdentitySourceCreateRequest) ProtoMessage() {}
 
 func (x *IdentitySourceCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[11]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +862,7 @@ func (x *IdentitySourceCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentitySourceCreateRequest.ProtoReflect.Descriptor instead.
 func (*IdentitySourceCreateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{11}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *IdentitySourceCreateRequest) GetZoneID() int64 {
@@ -782,7 +891,7 @@ type IdentitySourceUpdateRequest struct {
 
 func (x *IdentitySourceUpdateRequest) Reset() {
 	*x = IdentitySourceUpdateRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[12]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -794,7 +903,7 @@ func (x *IdentitySourceUpdateRequest) String() string {
 func (*IdentitySourceUpdateRequest) ProtoMessage() {}
 
 func (x *IdentitySourceUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[12]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,7 +916,7 @@ func (x *IdentitySourceUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentitySourceUpdateRequest.ProtoReflect.Descriptor instead.
 func (*IdentitySourceUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{12}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *IdentitySourceUpdateRequest) GetZoneID() int64 {
@@ -842,7 +951,7 @@ type IdentitySourceDeleteRequest struct {
 
 func (x *IdentitySourceDeleteRequest) Reset() {
 	*x = IdentitySourceDeleteRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[13]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -854,7 +963,7 @@ func (x *IdentitySourceDeleteRequest) String() string {
 func (*IdentitySourceDeleteRequest) ProtoMessage() {}
 
 func (x *IdentitySourceDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[13]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +976,7 @@ func (x *IdentitySourceDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentitySourceDeleteRequest.ProtoReflect.Descriptor instead.
 func (*IdentitySourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{13}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *IdentitySourceDeleteRequest) GetZoneID() int64 {
@@ -898,7 +1007,7 @@ type IdentitySourceResponse struct {
 
 func (x *IdentitySourceResponse) Reset() {
 	*x = IdentitySourceResponse{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[14]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -910,7 +1019,7 @@ func (x *IdentitySourceResponse) String() string {
 func (*IdentitySourceResponse) ProtoMessage() {}
 
 func (x *IdentitySourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[14]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,7 +1032,7 @@ func (x *IdentitySourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentitySourceResponse.ProtoReflect.Descriptor instead.
 func (*IdentitySourceResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{14}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *IdentitySourceResponse) GetIdentitySourceID() string {
@@ -971,13 +1080,14 @@ type IdentityFetchRequest struct {
 	IdentitySourceID *string                `protobuf:"bytes,5,opt,name=IdentitySourceID,proto3,oneof" json:"IdentitySourceID,omitempty"`
 	Kind             *string                `protobuf:"bytes,6,opt,name=Kind,proto3,oneof" json:"Kind,omitempty"`
 	Name             *string                `protobuf:"bytes,7,opt,name=Name,proto3,oneof" json:"Name,omitempty"`
+	Query            *FetchQuery            `protobuf:"bytes,8,opt,name=Query,proto3,oneof" json:"Query,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *IdentityFetchRequest) Reset() {
 	*x = IdentityFetchRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[15]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -989,7 +1099,7 @@ func (x *IdentityFetchRequest) String() string {
 func (*IdentityFetchRequest) ProtoMessage() {}
 
 func (x *IdentityFetchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[15]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1112,7 @@ func (x *IdentityFetchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityFetchRequest.ProtoReflect.Descriptor instead.
 func (*IdentityFetchRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{15}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *IdentityFetchRequest) GetPage() int32 {
@@ -1054,6 +1164,13 @@ func (x *IdentityFetchRequest) GetName() string {
 	return ""
 }
 
+func (x *IdentityFetchRequest) GetQuery() *FetchQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
 // Identity create request.
 type IdentityCreateRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -1067,7 +1184,7 @@ type IdentityCreateRequest struct {
 
 func (x *IdentityCreateRequest) Reset() {
 	*x = IdentityCreateRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[16]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1079,7 +1196,7 @@ func (x *IdentityCreateRequest) String() string {
 func (*IdentityCreateRequest) ProtoMessage() {}
 
 func (x *IdentityCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[16]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,7 +1209,7 @@ func (x *IdentityCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityCreateRequest.ProtoReflect.Descriptor instead.
 func (*IdentityCreateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{16}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *IdentityCreateRequest) GetZoneID() int64 {
@@ -1136,7 +1253,7 @@ type IdentityUpdateRequest struct {
 
 func (x *IdentityUpdateRequest) Reset() {
 	*x = IdentityUpdateRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[17]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1148,7 +1265,7 @@ func (x *IdentityUpdateRequest) String() string {
 func (*IdentityUpdateRequest) ProtoMessage() {}
 
 func (x *IdentityUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[17]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,7 +1278,7 @@ func (x *IdentityUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityUpdateRequest.ProtoReflect.Descriptor instead.
 func (*IdentityUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{17}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *IdentityUpdateRequest) GetZoneID() int64 {
@@ -1203,7 +1320,7 @@ type IdentityDeleteRequest struct {
 
 func (x *IdentityDeleteRequest) Reset() {
 	*x = IdentityDeleteRequest{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[18]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1215,7 +1332,7 @@ func (x *IdentityDeleteRequest) String() string {
 func (*IdentityDeleteRequest) ProtoMessage() {}
 
 func (x *IdentityDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[18]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,7 +1345,7 @@ func (x *IdentityDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityDeleteRequest.ProtoReflect.Descriptor instead.
 func (*IdentityDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{18}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *IdentityDeleteRequest) GetZoneID() int64 {
@@ -1261,7 +1378,7 @@ type IdentityResponse struct {
 
 func (x *IdentityResponse) Reset() {
 	*x = IdentityResponse{}
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[19]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1273,7 +1390,7 @@ func (x *IdentityResponse) String() string {
 func (*IdentityResponse) ProtoMessage() {}
 
 func (x *IdentityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[19]
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,7 +1403,7 @@ func (x *IdentityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityResponse.ProtoReflect.Descriptor instead.
 func (*IdentityResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{19}
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *IdentityResponse) GetIdentityID() string {
@@ -1338,311 +1455,1687 @@ func (x *IdentityResponse) GetName() string {
 	return ""
 }
 
-var File_internal_agents_services_zap_endpoints_api_v1_zap_proto protoreflect.FileDescriptor
+// Webhook get request.
+type WebhookFetchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=Page,proto3,oneof" json:"Page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=PageSize,proto3,oneof" json:"PageSize,omitempty"`
+	ZoneID        int64                  `protobuf:"varint,3,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	WebhookID     *string                `protobuf:"bytes,4,opt,name=WebhookID,proto3,oneof" json:"WebhookID,omitempty"`
+	Name          *string                `protobuf:"bytes,5,opt,name=Name,proto3,oneof" json:"Name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDesc = string([]byte{
-	0x0a, 0x37, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7a, 0x61, 0x70, 0x2f, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x7a, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x7a, 0x6f, 0x6e, 0x65, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xac, 0x01, 0x0a, 0x10, 0x5a, 0x6f, 0x6e, 0x65, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x02, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
-	0x17, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67,
-	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x5a, 0x6f, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x11, 0x5a,
-	0x6f, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x11,
-	0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x22, 0xae, 0x01, 0x0a, 0x0c, 0x5a, 0x6f,
-	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f,
-	0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
-	0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x12, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x00, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08,
-	0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a,
-	0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e,
-	0x65, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
-	0x44, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x54, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x13,
-	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xcc, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a,
-	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x1a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
-	0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16,
-	0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x02, 0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x1b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x75, 0x0a, 0x1b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x61, 0x0a, 0x1b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a,
-	0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x22, 0xe4, 0x01, 0x0a, 0x16, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0xbc, 0x02, 0x0a, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x50, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x0a, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x02, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x88, 0x01, 0x01,
-	0x12, 0x2f, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x10, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x88, 0x01,
-	0x01, 0x12, 0x17, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x04, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09,
-	0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x4b, 0x69, 0x6e, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x83, 0x01, 0x0a, 0x15, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e,
-	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
-	0x44, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a,
-	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x77, 0x0a, 0x15, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4f,
-	0x0a, 0x15, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12,
-	0x1e, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x22,
-	0x92, 0x02, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x4b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x32, 0x84, 0x0e, 0x0a, 0x0c, 0x56, 0x31, 0x5a, 0x41, 0x50, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5a,
-	0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f,
-	0x6e, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0a, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x2e, 0x7a, 0x6f, 0x6e, 0x65,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-	0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62,
-	0x0a, 0x0a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x7a,
-	0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x7f, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x2e, 0x7a, 0x6f, 0x6e,
-	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2f, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x2e, 0x7a, 0x6f,
-	0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x2e, 0x7a,
-	0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x14, 0x46, 0x65, 0x74, 0x63, 0x68, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x33,
-	0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x2e, 0x7a, 0x6f, 0x6e,
-	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x7a, 0x6f, 0x6e,
-	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x2e, 0x7a, 0x6f, 0x6e, 0x65,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x7a, 0x6f, 0x6e, 0x65,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x67, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
-	0x2c, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x68, 0x0a, 0x0c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x73, 0x12, 0x2b, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x7a, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *WebhookFetchRequest) Reset() {
+	*x = WebhookFetchRequest{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookFetchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookFetchRequest) ProtoMessage() {}
+
+func (x *WebhookFetchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookFetchRequest.ProtoReflect.Descriptor instead.
+func (*WebhookFetchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WebhookFetchRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *WebhookFetchRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *WebhookFetchRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *WebhookFetchRequest) GetWebhookID() string {
+	if x != nil && x.WebhookID != nil {
+		return *x.WebhookID
+	}
+	return ""
+}
+
+func (x *WebhookFetchRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// Webhook create request.
+type WebhookCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	URL           string                 `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	Events        []string               `protobuf:"bytes,5,rep,name=Events,proto3" json:"Events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookCreateRequest) Reset() {
+	*x = WebhookCreateRequest{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookCreateRequest) ProtoMessage() {}
+
+func (x *WebhookCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookCreateRequest.ProtoReflect.Descriptor instead.
+func (*WebhookCreateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WebhookCreateRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *WebhookCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookCreateRequest) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *WebhookCreateRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookCreateRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Webhook update request.
+type WebhookUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	WebhookID     string                 `protobuf:"bytes,2,opt,name=WebhookID,proto3" json:"WebhookID,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	URL           string                 `protobuf:"bytes,4,opt,name=URL,proto3" json:"URL,omitempty"`
+	Secret        string                 `protobuf:"bytes,5,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	Events        []string               `protobuf:"bytes,6,rep,name=Events,proto3" json:"Events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookUpdateRequest) Reset() {
+	*x = WebhookUpdateRequest{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookUpdateRequest) ProtoMessage() {}
+
+func (x *WebhookUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookUpdateRequest.ProtoReflect.Descriptor instead.
+func (*WebhookUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WebhookUpdateRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *WebhookUpdateRequest) GetWebhookID() string {
+	if x != nil {
+		return x.WebhookID
+	}
+	return ""
+}
+
+func (x *WebhookUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookUpdateRequest) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *WebhookUpdateRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookUpdateRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Webhook delete request.
+type WebhookDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	WebhookID     string                 `protobuf:"bytes,2,opt,name=WebhookID,proto3" json:"WebhookID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeleteRequest) Reset() {
+	*x = WebhookDeleteRequest{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeleteRequest) ProtoMessage() {}
+
+func (x *WebhookDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeleteRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WebhookDeleteRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *WebhookDeleteRequest) GetWebhookID() string {
+	if x != nil {
+		return x.WebhookID
+	}
+	return ""
+}
+
+// Webhook response.
+type WebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookID     string                 `protobuf:"bytes,1,opt,name=WebhookID,proto3" json:"WebhookID,omitempty"`
+	ZoneID        int64                  `protobuf:"varint,2,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
+	URL           string                 `protobuf:"bytes,6,opt,name=URL,proto3" json:"URL,omitempty"`
+	Secret        string                 `protobuf:"bytes,7,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	Events        []string               `protobuf:"bytes,8,rep,name=Events,proto3" json:"Events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookResponse) Reset() {
+	*x = WebhookResponse{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookResponse) ProtoMessage() {}
+
+func (x *WebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookResponse.ProtoReflect.Descriptor instead.
+func (*WebhookResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WebhookResponse) GetWebhookID() string {
+	if x != nil {
+		return x.WebhookID
+	}
+	return ""
+}
+
+func (x *WebhookResponse) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *WebhookResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *WebhookResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookResponse) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *WebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookResponse) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Webhook delivery get request.
+type WebhookDeliveryFetchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=Page,proto3,oneof" json:"Page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=PageSize,proto3,oneof" json:"PageSize,omitempty"`
+	ZoneID        int64                  `protobuf:"varint,3,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	WebhookID     *string                `protobuf:"bytes,4,opt,name=WebhookID,proto3,oneof" json:"WebhookID,omitempty"`
+	Status        *string                `protobuf:"bytes,5,opt,name=Status,proto3,oneof" json:"Status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryFetchRequest) Reset() {
+	*x = WebhookDeliveryFetchRequest{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryFetchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryFetchRequest) ProtoMessage() {}
+
+func (x *WebhookDeliveryFetchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryFetchRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryFetchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WebhookDeliveryFetchRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryFetchRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryFetchRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryFetchRequest) GetWebhookID() string {
+	if x != nil && x.WebhookID != nil {
+		return *x.WebhookID
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryFetchRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// Webhook delivery requeue request.
+type WebhookDeliveryRequeueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	DeliveryID    int64                  `protobuf:"varint,2,opt,name=DeliveryID,proto3" json:"DeliveryID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryRequeueRequest) Reset() {
+	*x = WebhookDeliveryRequeueRequest{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryRequeueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryRequeueRequest) ProtoMessage() {}
+
+func (x *WebhookDeliveryRequeueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryRequeueRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryRequeueRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WebhookDeliveryRequeueRequest) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryRequeueRequest) GetDeliveryID() int64 {
+	if x != nil {
+		return x.DeliveryID
+	}
+	return 0
+}
+
+// Webhook delivery response.
+type WebhookDeliveryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryID     int64                  `protobuf:"varint,1,opt,name=DeliveryID,proto3" json:"DeliveryID,omitempty"`
+	ZoneID         int64                  `protobuf:"varint,2,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	WebhookID      string                 `protobuf:"bytes,5,opt,name=WebhookID,proto3" json:"WebhookID,omitempty"`
+	Event          string                 `protobuf:"bytes,6,opt,name=Event,proto3" json:"Event,omitempty"`
+	Payload        string                 `protobuf:"bytes,7,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	Status         string                 `protobuf:"bytes,8,opt,name=Status,proto3" json:"Status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,9,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=NextAttemptAt,proto3" json:"NextAttemptAt,omitempty"`
+	LastStatusCode int32                  `protobuf:"varint,11,opt,name=LastStatusCode,proto3" json:"LastStatusCode,omitempty"`
+	LastError      string                 `protobuf:"bytes,12,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryResponse) Reset() {
+	*x = WebhookDeliveryResponse{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *WebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WebhookDeliveryResponse) GetDeliveryID() int64 {
+	if x != nil {
+		return x.DeliveryID
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryResponse) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryResponse) GetWebhookID() string {
+	if x != nil {
+		return x.WebhookID
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryResponse) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryResponse) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryResponse) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryResponse) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryResponse) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryResponse) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// Bulk upsert options.
+type BulkUpsertOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID         int64                  `protobuf:"varint,1,opt,name=ZoneID,proto3" json:"ZoneID,omitempty"`
+	DryRun         bool                   `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	ConflictPolicy string                 `protobuf:"bytes,3,opt,name=ConflictPolicy,proto3" json:"ConflictPolicy,omitempty"`
+	FullReport     bool                   `protobuf:"varint,4,opt,name=FullReport,proto3" json:"FullReport,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkUpsertOptions) Reset() {
+	*x = BulkUpsertOptions{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertOptions) ProtoMessage() {}
+
+func (x *BulkUpsertOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertOptions.ProtoReflect.Descriptor instead.
+func (*BulkUpsertOptions) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BulkUpsertOptions) GetZoneID() int64 {
+	if x != nil {
+		return x.ZoneID
+	}
+	return 0
+}
+
+func (x *BulkUpsertOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkUpsertOptions) GetConflictPolicy() string {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ""
+}
+
+func (x *BulkUpsertOptions) GetFullReport() bool {
+	if x != nil {
+		return x.FullReport
+	}
+	return false
+}
+
+// Bulk upsert row result.
+type BulkRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=Row,proto3" json:"Row,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	ID            *string                `protobuf:"bytes,3,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`
+	Error         *string                `protobuf:"bytes,5,opt,name=Error,proto3,oneof" json:"Error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkRowResult) Reset() {
+	*x = BulkRowResult{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRowResult) ProtoMessage() {}
+
+func (x *BulkRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRowResult.ProtoReflect.Descriptor instead.
+func (*BulkRowResult) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BulkRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *BulkRowResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BulkRowResult) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+func (x *BulkRowResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkRowResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+// Bulk upsert response.
+type BulkUpsertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	Committed     bool                   `protobuf:"varint,2,opt,name=Committed,proto3" json:"Committed,omitempty"`
+	Created       int32                  `protobuf:"varint,3,opt,name=Created,proto3" json:"Created,omitempty"`
+	Updated       int32                  `protobuf:"varint,4,opt,name=Updated,proto3" json:"Updated,omitempty"`
+	Skipped       int32                  `protobuf:"varint,5,opt,name=Skipped,proto3" json:"Skipped,omitempty"`
+	Failed        int32                  `protobuf:"varint,6,opt,name=Failed,proto3" json:"Failed,omitempty"`
+	Rows          []*BulkRowResult       `protobuf:"bytes,7,rep,name=Rows,proto3" json:"Rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertResponse) Reset() {
+	*x = BulkUpsertResponse{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertResponse) ProtoMessage() {}
+
+func (x *BulkUpsertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpsertResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BulkUpsertResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkUpsertResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *BulkUpsertResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BulkUpsertResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BulkUpsertResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *BulkUpsertResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkUpsertResponse) GetRows() []*BulkRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// Identity bulk row.
+type IdentityBulkRow struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IdentitySourceID string                 `protobuf:"bytes,1,opt,name=IdentitySourceID,proto3" json:"IdentitySourceID,omitempty"`
+	Kind             string                 `protobuf:"bytes,2,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *IdentityBulkRow) Reset() {
+	*x = IdentityBulkRow{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityBulkRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityBulkRow) ProtoMessage() {}
+
+func (x *IdentityBulkRow) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityBulkRow.ProtoReflect.Descriptor instead.
+func (*IdentityBulkRow) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *IdentityBulkRow) GetIdentitySourceID() string {
+	if x != nil {
+		return x.IdentitySourceID
+	}
+	return ""
+}
+
+func (x *IdentityBulkRow) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *IdentityBulkRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Identity bulk upsert request, the options are read from the first message of the stream.
+type IdentityBulkUpsertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *BulkUpsertOptions     `protobuf:"bytes,1,opt,name=Options,proto3,oneof" json:"Options,omitempty"`
+	Identities    []*IdentityBulkRow     `protobuf:"bytes,2,rep,name=Identities,proto3" json:"Identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityBulkUpsertRequest) Reset() {
+	*x = IdentityBulkUpsertRequest{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityBulkUpsertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityBulkUpsertRequest) ProtoMessage() {}
+
+func (x *IdentityBulkUpsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityBulkUpsertRequest.ProtoReflect.Descriptor instead.
+func (*IdentityBulkUpsertRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *IdentityBulkUpsertRequest) GetOptions() *BulkUpsertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *IdentityBulkUpsertRequest) GetIdentities() []*IdentityBulkRow {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// Tenant bulk row.
+type TenantBulkRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantBulkRow) Reset() {
+	*x = TenantBulkRow{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantBulkRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantBulkRow) ProtoMessage() {}
+
+func (x *TenantBulkRow) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantBulkRow.ProtoReflect.Descriptor instead.
+func (*TenantBulkRow) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TenantBulkRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Tenant bulk upsert request, the options are read from the first message of the stream.
+type TenantBulkUpsertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *BulkUpsertOptions     `protobuf:"bytes,1,opt,name=Options,proto3,oneof" json:"Options,omitempty"`
+	Tenants       []*TenantBulkRow       `protobuf:"bytes,2,rep,name=Tenants,proto3" json:"Tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantBulkUpsertRequest) Reset() {
+	*x = TenantBulkUpsertRequest{}
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantBulkUpsertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantBulkUpsertRequest) ProtoMessage() {}
+
+func (x *TenantBulkUpsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantBulkUpsertRequest.ProtoReflect.Descriptor instead.
+func (*TenantBulkUpsertRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TenantBulkUpsertRequest) GetOptions() *BulkUpsertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *TenantBulkUpsertRequest) GetTenants() []*TenantBulkRow {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+var File_internal_agents_services_zap_endpoints_api_v1_zap_proto protoreflect.FileDescriptor
+
+var file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDesc = string([]byte{
+	0x0a, 0x37, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7a, 0x61, 0x70, 0x2f, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x7a, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x7a, 0x6f, 0x6e, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x02, 0x0a, 0x0a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x1b, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x10, 0x5a, 0x6f, 0x6e, 0x65, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x50,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x7a, 0x6f, 0x6e,
+	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48,
+	0x04, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x50, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22,
+	0x27, 0x0a, 0x11, 0x5a, 0x6f, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x11, 0x5a, 0x6f, 0x6e, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a,
+	0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x5a, 0x6f, 0x6e,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x22, 0xae, 0x01, 0x0a, 0x0c, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12,
+	0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x96, 0x02, 0x0a, 0x12, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04,
+	0x50, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44,
+	0x12, 0x1f, 0x0a, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x12, 0x17, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x7a, 0x6f, 0x6e, 0x65,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x04,
+	0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50,
+	0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x22, 0x41, 0x0a, 0x13, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f,
+	0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xcc, 0x01,
+	0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f,
+	0x6e, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb6, 0x02, 0x0a,
+	0x1a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x50,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2f, 0x0a,
+	0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x04, 0x52, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x49, 0x0a, 0x1b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x75, 0x0a, 0x1b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x61, 0x0a, 0x1b, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2a,
+	0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x22, 0xe4, 0x01, 0x0a, 0x16, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x86, 0x03, 0x0a, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x50, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x0a,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x10, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x06, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x4b, 0x69, 0x6e, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x77, 0x0a, 0x15, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x15, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x22, 0x92, 0x02, 0x0a, 0x10, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xd0, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x09, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a,
+	0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e,
+	0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x14, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x16,
+	0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4c,
+	0x0a, 0x14, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x22, 0x91, 0x02, 0x0a,
+	0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xde, 0x01, 0x0a, 0x1b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x50,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f,
+	0x6e, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x12, 0x21, 0x0a, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x57, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x22, 0xcf, 0x03, 0x0a, 0x17, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x38,
+	0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x4c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8b, 0x01, 0x0a,
+	0x11, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x75,
+	0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x42,
+	0x75, 0x6c, 0x6b, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x52, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x02, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x19, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49,
+	0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xec, 0x01, 0x0a, 0x12,
+	0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x53,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x7a,
+	0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x65, 0x0a, 0x0f, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x6f, 0x77, 0x12, 0x2a, 0x0a,
+	0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0xbc, 0x01, 0x0a, 0x19, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x75,
+	0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x49, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0a, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x6f, 0x77, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x23, 0x0a, 0x0d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x6f,
+	0x77, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x17, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x49, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x42, 0x75, 0x6c,
+	0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00,
+	0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x07,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x75,
+	0x6c, 0x6b, 0x52, 0x6f, 0x77, 0x52, 0x07, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xb0, 0x15, 0x0a, 0x0c, 0x56,
+	0x31, 0x5a, 0x41, 0x50, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x2e, 0x7a, 0x6f, 0x6e, 0x65,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+	0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61,
+	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x2e, 0x7a,
+	0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x61, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12,
+	0x2a, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x7a, 0x6f,
+	0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x5a, 0x6f, 0x6e,
+	0x65, 0x73, 0x12, 0x29, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e,
+	0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7f, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x34, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x34, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x34, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x14,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x7a, 0x6f, 0x6e, 0x65,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6d,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x2e, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a,
+	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x2e, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e,
+	0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x0f, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2d,
+	0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x77, 0x0a, 0x10,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x32, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x42,
+	0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x67, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67,
+	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2c,
+	0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x7a,
+	0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x68, 0x0a, 0x0c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x2b, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x0d, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x7a, 0x6f,
+	0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x6c, 0x6b,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x6a,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12,
+	0x2d, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x2d, 0x2e, 0x7a, 0x6f,
+	0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x7a, 0x6f, 0x6e,
+	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x2d, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x2c, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x84, 0x01, 0x0a, 0x16, 0x46, 0x65, 0x74, 0x63, 0x68, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x7a, 0x6f, 0x6e,
+	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x84, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x12, 0x36, 0x2e, 0x7a, 0x6f, 0x6e, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x7a, 0x6f, 0x6e, 0x65,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3a, 0x5a,
+	0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x72, 0x6d,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x7a, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 })
 
 var (
@@ -1657,76 +3150,124 @@ func file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescGZIP()
 	return file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDescData
 }
 
-var file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_internal_agents_services_zap_endpoints_api_v1_zap_proto_goTypes = []any{
-	(*ZoneFetchRequest)(nil),            // 0: zoneadministrationpoint.ZoneFetchRequest
-	(*ZoneCreateRequest)(nil),           // 1: zoneadministrationpoint.ZoneCreateRequest
-	(*ZoneUpdateRequest)(nil),           // 2: zoneadministrationpoint.ZoneUpdateRequest
-	(*ZoneDeleteRequest)(nil),           // 3: zoneadministrationpoint.ZoneDeleteRequest
-	(*ZoneResponse)(nil),                // 4: zoneadministrationpoint.ZoneResponse
-	(*TenantFetchRequest)(nil),          // 5: zoneadministrationpoint.TenantFetchRequest
-	(*TenantCreateRequest)(nil),         // 6: zoneadministrationpoint.TenantCreateRequest
-	(*TenantUpdateRequest)(nil),         // 7: zoneadministrationpoint.TenantUpdateRequest
-	(*TenantDeleteRequest)(nil),         // 8: zoneadministrationpoint.TenantDeleteRequest
-	(*TenantResponse)(nil),              // 9: zoneadministrationpoint.TenantResponse
-	(*IdentitySourceFetchRequest)(nil),  // 10: zoneadministrationpoint.IdentitySourceFetchRequest
-	(*IdentitySourceCreateRequest)(nil), // 11: zoneadministrationpoint.IdentitySourceCreateRequest
-	(*IdentitySourceUpdateRequest)(nil), // 12: zoneadministrationpoint.IdentitySourceUpdateRequest
-	(*IdentitySourceDeleteRequest)(nil), // 13: zoneadministrationpoint.IdentitySourceDeleteRequest
-	(*IdentitySourceResponse)(nil),      // 14: zoneadministrationpoint.IdentitySourceResponse
-	(*IdentityFetchRequest)(nil),        // 15: zoneadministrationpoint.IdentityFetchRequest
-	(*IdentityCreateRequest)(nil),       // 16: zoneadministrationpoint.IdentityCreateRequest
-	(*IdentityUpdateRequest)(nil),       // 17: zoneadministrationpoint.IdentityUpdateRequest
-	(*IdentityDeleteRequest)(nil),       // 18: zoneadministrationpoint.IdentityDeleteRequest
-	(*IdentityResponse)(nil),            // 19: zoneadministrationpoint.IdentityResponse
-	(*timestamppb.Timestamp)(nil),       // 20: google.protobuf.Timestamp
+	(*FetchQuery)(nil),                    // 0: zoneadministrationpoint.FetchQuery
+	(*ZoneFetchRequest)(nil),              // 1: zoneadministrationpoint.ZoneFetchRequest
+	(*ZoneCreateRequest)(nil),             // 2: zoneadministrationpoint.ZoneCreateRequest
+	(*ZoneUpdateRequest)(nil),             // 3: zoneadministrationpoint.ZoneUpdateRequest
+	(*ZoneDeleteRequest)(nil),             // 4: zoneadministrationpoint.ZoneDeleteRequest
+	(*ZoneResponse)(nil),                  // 5: zoneadministrationpoint.ZoneResponse
+	(*TenantFetchRequest)(nil),            // 6: zoneadministrationpoint.TenantFetchRequest
+	(*TenantCreateRequest)(nil),           // 7: zoneadministrationpoint.TenantCreateRequest
+	(*TenantUpdateRequest)(nil),           // 8: zoneadministrationpoint.TenantUpdateRequest
+	(*TenantDeleteRequest)(nil),           // 9: zoneadministrationpoint.TenantDeleteRequest
+	(*TenantResponse)(nil),                // 10: zoneadministrationpoint.TenantResponse
+	(*IdentitySourceFetchRequest)(nil),    // 11: zoneadministrationpoint.IdentitySourceFetchRequest
+	(*IdentitySourceCreateRequest)(nil),   // 12: zoneadministrationpoint.IdentitySourceCreateRequest
+	(*IdentitySourceUpdateRequest)(nil),   // 13: zoneadministrationpoint.IdentitySourceUpdateRequest
+	(*IdentitySourceDeleteRequest)(nil),   // 14: zoneadministrationpoint.IdentitySourceDeleteRequest
+	(*IdentitySourceResponse)(nil),        // 15: zoneadministrationpoint.IdentitySourceResponse
+	(*IdentityFetchRequest)(nil),          // 16: zoneadministrationpoint.IdentityFetchRequest
+	(*IdentityCreateRequest)(nil),         // 17: zoneadministrationpoint.IdentityCreateRequest
+	(*IdentityUpdateRequest)(nil),         // 18: zoneadministrationpoint.IdentityUpdateRequest
+	(*IdentityDeleteRequest)(nil),         // 19: zoneadministrationpoint.IdentityDeleteRequest
+	(*IdentityResponse)(nil),              // 20: zoneadministrationpoint.IdentityResponse
+	(*WebhookFetchRequest)(nil),           // 21: zoneadministrationpoint.WebhookFetchRequest
+	(*WebhookCreateRequest)(nil),          // 22: zoneadministrationpoint.WebhookCreateRequest
+	(*WebhookUpdateRequest)(nil),          // 23: zoneadministrationpoint.WebhookUpdateRequest
+	(*WebhookDeleteRequest)(nil),          // 24: zoneadministrationpoint.WebhookDeleteRequest
+	(*WebhookResponse)(nil),               // 25: zoneadministrationpoint.WebhookResponse
+	(*WebhookDeliveryFetchRequest)(nil),   // 26: zoneadministrationpoint.WebhookDeliveryFetchRequest
+	(*WebhookDeliveryRequeueRequest)(nil), // 27: zoneadministrationpoint.WebhookDeliveryRequeueRequest
+	(*WebhookDeliveryResponse)(nil),       // 28: zoneadministrationpoint.WebhookDeliveryResponse
+	(*BulkUpsertOptions)(nil),             // 29: zoneadministrationpoint.BulkUpsertOptions
+	(*BulkRowResult)(nil),                 // 30: zoneadministrationpoint.BulkRowResult
+	(*BulkUpsertResponse)(nil),            // 31: zoneadministrationpoint.BulkUpsertResponse
+	(*IdentityBulkRow)(nil),               // 32: zoneadministrationpoint.IdentityBulkRow
+	(*IdentityBulkUpsertRequest)(nil),     // 33: zoneadministrationpoint.IdentityBulkUpsertRequest
+	(*TenantBulkRow)(nil),                 // 34: zoneadministrationpoint.TenantBulkRow
+	(*TenantBulkUpsertRequest)(nil),       // 35: zoneadministrationpoint.TenantBulkUpsertRequest
+	(*timestamppb.Timestamp)(nil),         // 36: google.protobuf.Timestamp
 }
 var file_internal_agents_services_zap_endpoints_api_v1_zap_proto_depIdxs = []int32{
-	20, // 0: zoneadministrationpoint.ZoneResponse.CreatedAt:type_name -> google.protobuf.Timestamp
-	20, // 1: zoneadministrationpoint.ZoneResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
-	20, // 2: zoneadministrationpoint.TenantResponse.CreatedAt:type_name -> google.protobuf.Timestamp
-	20, // 3: zoneadministrationpoint.TenantResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
-	20, // 4: zoneadministrationpoint.IdentitySourceResponse.CreatedAt:type_name -> google.protobuf.Timestamp
-	20, // 5: zoneadministrationpoint.IdentitySourceResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
-	20, // 6: zoneadministrationpoint.IdentityResponse.CreatedAt:type_name -> google.protobuf.Timestamp
-	20, // 7: zoneadministrationpoint.IdentityResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
-	1,  // 8: zoneadministrationpoint.V1ZAPService.CreateZone:input_type -> zoneadministrationpoint.ZoneCreateRequest
-	2,  // 9: zoneadministrationpoint.V1ZAPService.UpdateZone:input_type -> zoneadministrationpoint.ZoneUpdateRequest
-	3,  // 10: zoneadministrationpoint.V1ZAPService.DeleteZone:input_type -> zoneadministrationpoint.ZoneDeleteRequest
-	0,  // 11: zoneadministrationpoint.V1ZAPService.FetchZones:input_type -> zoneadministrationpoint.ZoneFetchRequest
-	11, // 12: zoneadministrationpoint.V1ZAPService.CreateIdentitySource:input_type -> zoneadministrationpoint.IdentitySourceCreateRequest
-	12, // 13: zoneadministrationpoint.V1ZAPService.UpdateIdentitySource:input_type -> zoneadministrationpoint.IdentitySourceUpdateRequest
-	13, // 14: zoneadministrationpoint.V1ZAPService.DeleteIdentitySource:input_type -> zoneadministrationpoint.IdentitySourceDeleteRequest
-	10, // 15: zoneadministrationpoint.V1ZAPService.FetchIdentitySources:input_type -> zoneadministrationpoint.IdentitySourceFetchRequest
-	16, // 16: zoneadministrationpoint.V1ZAPService.CreateIdentity:input_type -> zoneadministrationpoint.IdentityCreateRequest
-	17, // 17: zoneadministrationpoint.V1ZAPService.UpdateIdentity:input_type -> zoneadministrationpoint.IdentityUpdateRequest
-	18, // 18: zoneadministrationpoint.V1ZAPService.DeleteIdentity:input_type -> zoneadministrationpoint.IdentityDeleteRequest
-	15, // 19: zoneadministrationpoint.V1ZAPService.FetchIdentities:input_type -> zoneadministrationpoint.IdentityFetchRequest
-	6,  // 20: zoneadministrationpoint.V1ZAPService.CreateTenant:input_type -> zoneadministrationpoint.TenantCreateRequest
-	7,  // 21: zoneadministrationpoint.V1ZAPService.UpdateTenant:input_type -> zoneadministrationpoint.TenantUpdateRequest
-	8,  // 22: zoneadministrationpoint.V1ZAPService.DeleteTenant:input_type -> zoneadministrationpoint.TenantDeleteRequest
-	5,  // 23: zoneadministrationpoint.V1ZAPService.FetchTenants:input_type -> zoneadministrationpoint.TenantFetchRequest
-	4,  // 24: zoneadministrationpoint.V1ZAPService.CreateZone:output_type -> zoneadministrationpoint.ZoneResponse
-	4,  // 25: zoneadministrationpoint.V1ZAPService.UpdateZone:output_type -> zoneadministrationpoint.ZoneResponse
-	4,  // 26: zoneadministrationpoint.V1ZAPService.DeleteZone:output_type -> zoneadministrationpoint.ZoneResponse
-	4,  // 27: zoneadministrationpoint.V1ZAPService.FetchZones:output_type -> zoneadministrationpoint.ZoneResponse
-	14, // 28: zoneadministrationpoint.V1ZAPService.CreateIdentitySource:output_type -> zoneadministrationpoint.IdentitySourceResponse
-	14, // 29: zoneadministrationpoint.V1ZAPService.UpdateIdentitySource:output_type -> zoneadministrationpoint.IdentitySourceResponse
-	14, // 30: zoneadministrationpoint.V1ZAPService.DeleteIdentitySource:output_type -> zoneadministrationpoint.IdentitySourceResponse
-	14, // 31: zoneadministrationpoint.V1ZAPService.FetchIdentitySources:output_type -> zoneadministrationpoint.IdentitySourceResponse
-	19, // 32: zoneadministrationpoint.V1ZAPService.CreateIdentity:output_type -> zoneadministrationpoint.IdentityResponse
-	19, // 33: zoneadministrationpoint.V1ZAPService.UpdateIdentity:output_type -> zoneadministrationpoint.IdentityResponse
-	19, // 34: zoneadministrationpoint.V1ZAPService.DeleteIdentity:output_type -> zoneadministrationpoint.IdentityResponse
-	19, // 35: zoneadministrationpoint.V1ZAPService.FetchIdentities:output_type -> zoneadministrationpoint.IdentityResponse
-	9,  // 36: zoneadministrationpoint.V1ZAPService.CreateTenant:output_type -> zoneadministrationpoint.TenantResponse
-	9,  // 37: zoneadministrationpoint.V1ZAPService.UpdateTenant:output_type -> zoneadministrationpoint.TenantResponse
-	9,  // 38: zoneadministrationpoint.V1ZAPService.DeleteTenant:output_type -> zoneadministrationpoint.TenantResponse
-	9,  // 39: zoneadministrationpoint.V1ZAPService.FetchTenants:output_type -> zoneadministrationpoint.TenantResponse
-	24, // [24:40] is the sub-list for method output_type
-	8,  // [8:24] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	36, // 0: zoneadministrationpoint.FetchQuery.CreatedAfter:type_name -> google.protobuf.Timestamp
+	36, // 1: zoneadministrationpoint.FetchQuery.CreatedBefore:type_name -> google.protobuf.Timestamp
+	0,  // 2: zoneadministrationpoint.ZoneFetchRequest.Query:type_name -> zoneadministrationpoint.FetchQuery
+	36, // 3: zoneadministrationpoint.ZoneResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	36, // 4: zoneadministrationpoint.ZoneResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 5: zoneadministrationpoint.TenantFetchRequest.Query:type_name -> zoneadministrationpoint.FetchQuery
+	36, // 6: zoneadministrationpoint.TenantResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	36, // 7: zoneadministrationpoint.TenantResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 8: zoneadministrationpoint.IdentitySourceFetchRequest.Query:type_name -> zoneadministrationpoint.FetchQuery
+	36, // 9: zoneadministrationpoint.IdentitySourceResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	36, // 10: zoneadministrationpoint.IdentitySourceResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 11: zoneadministrationpoint.IdentityFetchRequest.Query:type_name -> zoneadministrationpoint.FetchQuery
+	36, // 12: zoneadministrationpoint.IdentityResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	36, // 13: zoneadministrationpoint.IdentityResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	36, // 14: zoneadministrationpoint.WebhookResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	36, // 15: zoneadministrationpoint.WebhookResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	36, // 16: zoneadministrationpoint.WebhookDeliveryResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	36, // 17: zoneadministrationpoint.WebhookDeliveryResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	36, // 18: zoneadministrationpoint.WebhookDeliveryResponse.NextAttemptAt:type_name -> google.protobuf.Timestamp
+	30, // 19: zoneadministrationpoint.BulkUpsertResponse.Rows:type_name -> zoneadministrationpoint.BulkRowResult
+	29, // 20: zoneadministrationpoint.IdentityBulkUpsertRequest.Options:type_name -> zoneadministrationpoint.BulkUpsertOptions
+	32, // 21: zoneadministrationpoint.IdentityBulkUpsertRequest.Identities:type_name -> zoneadministrationpoint.IdentityBulkRow
+	29, // 22: zoneadministrationpoint.TenantBulkUpsertRequest.Options:type_name -> zoneadministrationpoint.BulkUpsertOptions
+	34, // 23: zoneadministrationpoint.TenantBulkUpsertRequest.Tenants:type_name -> zoneadministrationpoint.TenantBulkRow
+	2,  // 24: zoneadministrationpoint.V1ZAPService.CreateZone:input_type -> zoneadministrationpoint.ZoneCreateRequest
+	3,  // 25: zoneadministrationpoint.V1ZAPService.UpdateZone:input_type -> zoneadministrationpoint.ZoneUpdateRequest
+	4,  // 26: zoneadministrationpoint.V1ZAPService.DeleteZone:input_type -> zoneadministrationpoint.ZoneDeleteRequest
+	1,  // 27: zoneadministrationpoint.V1ZAPService.FetchZones:input_type -> zoneadministrationpoint.ZoneFetchRequest
+	12, // 28: zoneadministrationpoint.V1ZAPService.CreateIdentitySource:input_type -> zoneadministrationpoint.IdentitySourceCreateRequest
+	13, // 29: zoneadministrationpoint.V1ZAPService.UpdateIdentitySource:input_type -> zoneadministrationpoint.IdentitySourceUpdateRequest
+	14, // 30: zoneadministrationpoint.V1ZAPService.DeleteIdentitySource:input_type -> zoneadministrationpoint.IdentitySourceDeleteRequest
+	11, // 31: zoneadministrationpoint.V1ZAPService.FetchIdentitySources:input_type -> zoneadministrationpoint.IdentitySourceFetchRequest
+	17, // 32: zoneadministrationpoint.V1ZAPService.CreateIdentity:input_type -> zoneadministrationpoint.IdentityCreateRequest
+	18, // 33: zoneadministrationpoint.V1ZAPService.UpdateIdentity:input_type -> zoneadministrationpoint.IdentityUpdateRequest
+	19, // 34: zoneadministrationpoint.V1ZAPService.DeleteIdentity:input_type -> zoneadministrationpoint.IdentityDeleteRequest
+	16, // 35: zoneadministrationpoint.V1ZAPService.FetchIdentities:input_type -> zoneadministrationpoint.IdentityFetchRequest
+	33, // 36: zoneadministrationpoint.V1ZAPService.UpsertIdentities:input_type -> zoneadministrationpoint.IdentityBulkUpsertRequest
+	7,  // 37: zoneadministrationpoint.V1ZAPService.CreateTenant:input_type -> zoneadministrationpoint.TenantCreateRequest
+	8,  // 38: zoneadministrationpoint.V1ZAPService.UpdateTenant:input_type -> zoneadministrationpoint.TenantUpdateRequest
+	9,  // 39: zoneadministrationpoint.V1ZAPService.DeleteTenant:input_type -> zoneadministrationpoint.TenantDeleteRequest
+	6,  // 40: zoneadministrationpoint.V1ZAPService.FetchTenants:input_type -> zoneadministrationpoint.TenantFetchRequest
+	35, // 41: zoneadministrationpoint.V1ZAPService.UpsertTenants:input_type -> zoneadministrationpoint.TenantBulkUpsertRequest
+	22, // 42: zoneadministrationpoint.V1ZAPService.CreateWebhook:input_type -> zoneadministrationpoint.WebhookCreateRequest
+	23, // 43: zoneadministrationpoint.V1ZAPService.UpdateWebhook:input_type -> zoneadministrationpoint.WebhookUpdateRequest
+	24, // 44: zoneadministrationpoint.V1ZAPService.DeleteWebhook:input_type -> zoneadministrationpoint.WebhookDeleteRequest
+	21, // 45: zoneadministrationpoint.V1ZAPService.FetchWebhooks:input_type -> zoneadministrationpoint.WebhookFetchRequest
+	26, // 46: zoneadministrationpoint.V1ZAPService.FetchWebhookDeliveries:input_type -> zoneadministrationpoint.WebhookDeliveryFetchRequest
+	27, // 47: zoneadministrationpoint.V1ZAPService.RequeueWebhookDelivery:input_type -> zoneadministrationpoint.WebhookDeliveryRequeueRequest
+	5,  // 48: zoneadministrationpoint.V1ZAPService.CreateZone:output_type -> zoneadministrationpoint.ZoneResponse
+	5,  // 49: zoneadministrationpoint.V1ZAPService.UpdateZone:output_type -> zoneadministrationpoint.ZoneResponse
+	5,  // 50: zoneadministrationpoint.V1ZAPService.DeleteZone:output_type -> zoneadministrationpoint.ZoneResponse
+	5,  // 51: zoneadministrationpoint.V1ZAPService.FetchZones:output_type -> zoneadministrationpoint.ZoneResponse
+	15, // 52: zoneadministrationpoint.V1ZAPService.CreateIdentitySource:output_type -> zoneadministrationpoint.IdentitySourceResponse
+	15, // 53: zoneadministrationpoint.V1ZAPService.UpdateIdentitySource:output_type -> zoneadministrationpoint.IdentitySourceResponse
+	15, // 54: zoneadministrationpoint.V1ZAPService.DeleteIdentitySource:output_type -> zoneadministrationpoint.IdentitySourceResponse
+	15, // 55: zoneadministrationpoint.V1ZAPService.FetchIdentitySources:output_type -> zoneadministrationpoint.IdentitySourceResponse
+	20, // 56: zoneadministrationpoint.V1ZAPService.CreateIdentity:output_type -> zoneadministrationpoint.IdentityResponse
+	20, // 57: zoneadministrationpoint.V1ZAPService.UpdateIdentity:output_type -> zoneadministrationpoint.IdentityResponse
+	20, // 58: zoneadministrationpoint.V1ZAPService.DeleteIdentity:output_type -> zoneadministrationpoint.IdentityResponse
+	20, // 59: zoneadministrationpoint.V1ZAPService.FetchIdentities:output_type -> zoneadministrationpoint.IdentityResponse
+	31, // 60: zoneadministrationpoint.V1ZAPService.UpsertIdentities:output_type -> zoneadministrationpoint.BulkUpsertResponse
+	10, // 61: zoneadministrationpoint.V1ZAPService.CreateTenant:output_type -> zoneadministrationpoint.TenantResponse
+	10, // 62: zoneadministrationpoint.V1ZAPService.UpdateTenant:output_type -> zoneadministrationpoint.TenantResponse
+	10, // 63: zoneadministrationpoint.V1ZAPService.DeleteTenant:output_type -> zoneadministrationpoint.TenantResponse
+	10, // 64: zoneadministrationpoint.V1ZAPService.FetchTenants:output_type -> zoneadministrationpoint.TenantResponse
+	31, // 65: zoneadministrationpoint.V1ZAPService.UpsertTenants:output_type -> zoneadministrationpoint.BulkUpsertResponse
+	25, // 66: zoneadministrationpoint.V1ZAPService.CreateWebhook:output_type -> zoneadministrationpoint.WebhookResponse
+	25, // 67: zoneadministrationpoint.V1ZAPService.UpdateWebhook:output_type -> zoneadministrationpoint.WebhookResponse
+	25, // 68: zoneadministrationpoint.V1ZAPService.DeleteWebhook:output_type -> zoneadministrationpoint.WebhookResponse
+	25, // 69: zoneadministrationpoint.V1ZAPService.FetchWebhooks:output_type -> zoneadministrationpoint.WebhookResponse
+	28, // 70: zoneadministrationpoint.V1ZAPService.FetchWebhookDeliveries:output_type -> zoneadministrationpoint.WebhookDeliveryResponse
+	28, // 71: zoneadministrationpoint.V1ZAPService.RequeueWebhookDelivery:output_type -> zoneadministrationpoint.WebhookDeliveryResponse
+	48, // [48:72] is the sub-list for method output_type
+	24, // [24:48] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_internal_agents_services_zap_endpoints_api_v1_zap_proto_init() }
@@ -1735,16 +3276,22 @@ func file_internal_agents_services_zap_endpoints_api_v1_zap_proto_init() {
 		return
 	}
 	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[0].OneofWrappers = []any{}
-	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[5].OneofWrappers = []any{}
-	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[10].OneofWrappers = []any{}
-	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[15].OneofWrappers = []any{}
+	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[1].OneofWrappers = []any{}
+	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[6].OneofWrappers = []any{}
+	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[11].OneofWrappers = []any{}
+	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[16].OneofWrappers = []any{}
+	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[21].OneofWrappers = []any{}
+	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[26].OneofWrappers = []any{}
+	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[30].OneofWrappers = []any{}
+	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[33].OneofWrappers = []any{}
+	file_internal_agents_services_zap_endpoints_api_v1_zap_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDesc), len(file_internal_agents_services_zap_endpoints_api_v1_zap_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,29 +35,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	V1ZAPService_CreateZone_FullMethodName           = "/zoneadministrationpoint.V1ZAPService/CreateZone"
-	V1ZAPService_UpdateZone_FullMethodName           = "/zoneadministrationpoint.V1ZAPService/UpdateZone"
-	V1ZAPService_DeleteZone_FullMethodName           = "/zoneadministrationpoint.V1ZAPService/DeleteZone"
-	V1ZAPService_FetchZones_FullMethodName           = "/zoneadministrationpoint.V1ZAPService/FetchZones"
-	V1ZAPService_CreateIdentitySource_FullMethodName = "/zoneadministrationpoint.V1ZAPService/CreateIdentitySource"
-	V1ZAPService_UpdateIdentitySource_FullMethodName = "/zoneadministrationpoint.V1ZAPService/UpdateIdentitySource"
-	V1ZAPService_DeleteIdentitySource_FullMethodName = "/zoneadministrationpoint.V1ZAPService/DeleteIdentitySource"
-	V1ZAPService_FetchIdentitySources_FullMethodName = "/zoneadministrationpoint.V1ZAPService/FetchIdentitySources"
-	V1ZAPService_CreateIdentity_FullMethodName       = "/zoneadministrationpoint.V1ZAPService/CreateIdentity"
-	V1ZAPService_UpdateIdentity_FullMethodName       = "/zoneadministrationpoint.V1ZAPService/UpdateIdentity"
-	V1ZAPService_DeleteIdentity_FullMethodName       = "/zoneadministrationpoint.V1ZAPService/DeleteIdentity"
-	V1ZAPService_FetchIdentities_FullMethodName      = "/zoneadministrationpoint.V1ZAPService/FetchIdentities"
-	V1ZAPService_CreateTenant_FullMethodName         = "/zoneadministrationpoint.V1ZAPService/CreateTenant"
-	V1ZAPService_UpdateTenant_FullMethodName         = "/zoneadministrationpoint.V1ZAPService/UpdateTenant"
-	V1ZAPService_DeleteTenant_FullMethodName         = "/zoneadministrationpoint.V1ZAPService/DeleteTenant"
-	V1ZAPService_FetchTenants_FullMethodName         = "/zoneadministrationpoint.V1ZAPService/FetchTenants"
+	V1ZAPService_CreateZone_FullMethodName             = "/zoneadministrationpoint.V1ZAPService/CreateZone"
+	V1ZAPService_UpdateZone_FullMethodName             = "/zoneadministrationpoint.V1ZAPService/UpdateZone"
+	V1ZAPService_DeleteZone_FullMethodName             = "/zoneadministrationpoint.V1ZAPService/DeleteZone"
+	V1ZAPService_FetchZones_FullMethodName             = "/zoneadministrationpoint.V1ZAPService/FetchZones"
+	V1ZAPService_CreateIdentitySource_FullMethodName   = "/zoneadministrationpoint.V1ZAPService/CreateIdentitySource"
+	V1ZAPService_UpdateIdentitySource_FullMethodName   = "/zoneadministrationpoint.V1ZAPService/UpdateIdentitySource"
+	V1ZAPService_DeleteIdentitySource_FullMethodName   = "/zoneadministrationpoint.V1ZAPService/DeleteIdentitySource"
+	V1ZAPService_FetchIdentitySources_FullMethodName   = "/zoneadministrationpoint.V1ZAPService/FetchIdentitySources"
+	V1ZAPService_CreateIdentity_FullMethodName         = "/zoneadministrationpoint.V1ZAPService/CreateIdentity"
+	V1ZAPService_UpdateIdentity_FullMethodName         = "/zoneadministrationpoint.V1ZAPService/UpdateIdentity"
+	V1ZAPService_DeleteIdentity_FullMethodName         = "/zoneadministrationpoint.V1ZAPService/DeleteIdentity"
+	V1ZAPService_FetchIdentities_FullMethodName        = "/zoneadministrationpoint.V1ZAPService/FetchIdentities"
+	V1ZAPService_UpsertIdentities_FullMethodName       = "/zoneadministrationpoint.V1ZAPService/UpsertIdentities"
+	V1ZAPService_CreateTenant_FullMethodName           = "/zoneadministrationpoint.V1ZAPService/CreateTenant"
+	V1ZAPService_UpdateTenant_FullMethodName           = "/zoneadministrationpoint.V1ZAPService/UpdateTenant"
+	V1ZAPService_DeleteTenant_FullMethodName           = "/zoneadministrationpoint.V1ZAPService/DeleteTenant"
+	V1ZAPService_FetchTenants_FullMethodName           = "/zoneadministrationpoint.V1ZAPService/FetchTenants"
+	V1ZAPService_UpsertTenants_FullMethodName          = "/zoneadministrationpoint.V1ZAPService/UpsertTenants"
+	V1ZAPService_CreateWebhook_FullMethodName          = "/zoneadministrationpoint.V1ZAPService/CreateWebhook"
+	V1ZAPService_UpdateWebhook_FullMethodName          = "/zoneadministrationpoint.V1ZAPService/UpdateWebhook"
+	V1ZAPService_DeleteWebhook_FullMethodName          = "/zoneadministrationpoint.V1ZAPService/DeleteWebhook"
+	V1ZAPService_FetchWebhooks_FullMethodName          = "/zoneadministrationpoint.V1ZAPService/FetchWebhooks"
+	V1ZAPService_FetchWebhookDeliveries_FullMethodName = "/zoneadministrationpoint.V1ZAPService/FetchWebhookDeliveries"
+	V1ZAPService_RequeueWebhookDelivery_FullMethodName = "/zoneadministrationpoint.V1ZAPService/RequeueWebhookDelivery"
 )
 
 // V1ZAPServiceClient is the client API for V1ZAPService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// V1ZAPService is the service for the Zone Administration Point.
 type V1ZAPServiceClient interface {
 	// Create a zone.
 	CreateZone(ctx context.Context, in *ZoneCreateRequest, opts ...grpc.CallOption) (*ZoneResponse, error)
@@ -83,6 +89,8 @@ type V1ZAPServiceClient interface {
 	DeleteIdentity(ctx context.Context, in *IdentityDeleteRequest, opts ...grpc.CallOption) (*IdentityResponse, error)
 	// Fetch Identities.
 	FetchIdentities(ctx context.Context, in *IdentityFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IdentityResponse], error)
+	// Create or update identities in bulk.
+	UpsertIdentities(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IdentityBulkUpsertRequest, BulkUpsertResponse], error)
 	// Create an tenant.
 	CreateTenant(ctx context.Context, in *TenantCreateRequest, opts ...grpc.CallOption) (*TenantResponse, error)
 	// Update an tenant.
@@ -91,6 +99,20 @@ type V1ZAPServiceClient interface {
 	DeleteTenant(ctx context.Context, in *TenantDeleteRequest, opts ...grpc.CallOption) (*TenantResponse, error)
 	// Fetch Tenants.
 	FetchTenants(ctx context.Context, in *TenantFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TenantResponse], error)
+	// Create or update tenants in bulk.
+	UpsertTenants(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[TenantBulkUpsertRequest, BulkUpsertResponse], error)
+	// Create a webhook.
+	CreateWebhook(ctx context.Context, in *WebhookCreateRequest, opts ...grpc.CallOption) (*WebhookResponse, error)
+	// Update a webhook.
+	UpdateWebhook(ctx context.Context, in *WebhookUpdateRequest, opts ...grpc.CallOption) (*WebhookResponse, error)
+	// Delete a webhook.
+	DeleteWebhook(ctx context.Context, in *WebhookDeleteRequest, opts ...grpc.CallOption) (*WebhookResponse, error)
+	// Fetch webhooks.
+	FetchWebhooks(ctx context.Context, in *WebhookFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookResponse], error)
+	// Fetch webhook deliveries.
+	FetchWebhookDeliveries(ctx context.Context, in *WebhookDeliveryFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookDeliveryResponse], error)
+	// Requeue a dead webhook delivery.
+	RequeueWebhookDelivery(ctx context.Context, in *WebhookDeliveryRequeueRequest, opts ...grpc.CallOption) (*WebhookDeliveryResponse, error)
 }
 
 type v1ZAPServiceClient struct {
@@ -248,6 +270,19 @@ func (c *v1ZAPServiceClient) FetchIdentities(ctx context.Context, in *IdentityFe
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type V1ZAPService_FetchIdentitiesClient = grpc.ServerStreamingClient[IdentityResponse]
 
+func (c *v1ZAPServiceClient) UpsertIdentities(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IdentityBulkUpsertRequest, BulkUpsertResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &V1ZAPService_ServiceDesc.Streams[3], V1ZAPService_UpsertIdentities_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IdentityBulkUpsertRequest, BulkUpsertResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1ZAPService_UpsertIdentitiesClient = grpc.ClientStreamingClient[IdentityBulkUpsertRequest, BulkUpsertResponse]
+
 func (c *v1ZAPServiceClient) CreateTenant(ctx context.Context, in *TenantCreateRequest, opts ...grpc.CallOption) (*TenantResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TenantResponse)
@@ -280,7 +315,7 @@ func (c *v1ZAPServiceClient) DeleteTenant(ctx context.Context, in *TenantDeleteR
 
 func (c *v1ZAPServiceClient) FetchTenants(ctx context.Context, in *TenantFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TenantResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &V1ZAPService_ServiceDesc.Streams[3], V1ZAPService_FetchTenants_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &V1ZAPService_ServiceDesc.Streams[4], V1ZAPService_FetchTenants_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -297,11 +332,100 @@ func (c *v1ZAPServiceClient) FetchTenants(ctx context.Context, in *TenantFetchRe
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type V1ZAPService_FetchTenantsClient = grpc.ServerStreamingClient[TenantResponse]
 
+func (c *v1ZAPServiceClient) UpsertTenants(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[TenantBulkUpsertRequest, BulkUpsertResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &V1ZAPService_ServiceDesc.Streams[5], V1ZAPService_UpsertTenants_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TenantBulkUpsertRequest, BulkUpsertResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1ZAPService_UpsertTenantsClient = grpc.ClientStreamingClient[TenantBulkUpsertRequest, BulkUpsertResponse]
+
+func (c *v1ZAPServiceClient) CreateWebhook(ctx context.Context, in *WebhookCreateRequest, opts ...grpc.CallOption) (*WebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookResponse)
+	err := c.cc.Invoke(ctx, V1ZAPService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1ZAPServiceClient) UpdateWebhook(ctx context.Context, in *WebhookUpdateRequest, opts ...grpc.CallOption) (*WebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookResponse)
+	err := c.cc.Invoke(ctx, V1ZAPService_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1ZAPServiceClient) DeleteWebhook(ctx context.Context, in *WebhookDeleteRequest, opts ...grpc.CallOption) (*WebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookResponse)
+	err := c.cc.Invoke(ctx, V1ZAPService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1ZAPServiceClient) FetchWebhooks(ctx context.Context, in *WebhookFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &V1ZAPService_ServiceDesc.Streams[6], V1ZAPService_FetchWebhooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WebhookFetchRequest, WebhookResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1ZAPService_FetchWebhooksClient = grpc.ServerStreamingClient[WebhookResponse]
+
+func (c *v1ZAPServiceClient) FetchWebhookDeliveries(ctx context.Context, in *WebhookDeliveryFetchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookDeliveryResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &V1ZAPService_ServiceDesc.Streams[7], V1ZAPService_FetchWebhookDeliveries_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WebhookDeliveryFetchRequest, WebhookDeliveryResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1ZAPService_FetchWebhookDeliveriesClient = grpc.ServerStreamingClient[WebhookDeliveryResponse]
+
+func (c *v1ZAPServiceClient) RequeueWebhookDelivery(ctx context.Context, in *WebhookDeliveryRequeueRequest, opts ...grpc.CallOption) (*WebhookDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, V1ZAPService_RequeueWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // V1ZAPServiceServer is the server API for V1ZAPService service.
 // All implementations must embed UnimplementedV1ZAPServiceServer
 // for forward compatibility.
-//
-// V1ZAPService is the service for the Zone Administration Point.
 type V1ZAPServiceServer interface {
 	// Create a zone.
 	CreateZone(context.Context, *ZoneCreateRequest) (*ZoneResponse, error)
@@ -327,6 +451,8 @@ type V1ZAPServiceServer interface {
 	DeleteIdentity(context.Context, *IdentityDeleteRequest) (*IdentityResponse, error)
 	// Fetch Identities.
 	FetchIdentities(*IdentityFetchRequest, grpc.ServerStreamingServer[IdentityResponse]) error
+	// Create or update identities in bulk.
+	UpsertIdentities(grpc.ClientStreamingServer[IdentityBulkUpsertRequest, BulkUpsertResponse]) error
 	// Create an tenant.
 	CreateTenant(context.Context, *TenantCreateRequest) (*TenantResponse, error)
 	// Update an tenant.
@@ -335,6 +461,20 @@ type V1ZAPServiceServer interface {
 	DeleteTenant(context.Context, *TenantDeleteRequest) (*TenantResponse, error)
 	// Fetch Tenants.
 	FetchTenants(*TenantFetchRequest, grpc.ServerStreamingServer[TenantResponse]) error
+	// Create or update tenants in bulk.
+	UpsertTenants(grpc.ClientStreamingServer[TenantBulkUpsertRequest, BulkUpsertResponse]) error
+	// Create a webhook.
+	CreateWebhook(context.Context, *WebhookCreateRequest) (*WebhookResponse, error)
+	// Update a webhook.
+	UpdateWebhook(context.Context, *WebhookUpdateRequest) (*WebhookResponse, error)
+	// Delete a webhook.
+	DeleteWebhook(context.Context, *WebhookDeleteRequest) (*WebhookResponse, error)
+	// Fetch webhooks.
+	FetchWebhooks(*WebhookFetchRequest, grpc.ServerStreamingServer[WebhookResponse]) error
+	// Fetch webhook deliveries.
+	FetchWebhookDeliveries(*WebhookDeliveryFetchRequest, grpc.ServerStreamingServer[WebhookDeliveryResponse]) error
+	// Requeue a dead webhook delivery.
+	RequeueWebhookDelivery(context.Context, *WebhookDeliveryRequeueRequest) (*WebhookDeliveryResponse, error)
 	mustEmbedUnimplementedV1ZAPServiceServer()
 }
 
@@ -381,6 +521,9 @@ func (UnimplementedV1ZAPServiceServer) DeleteIdentity(context.Context, *Identity
 func (UnimplementedV1ZAPServiceServer) FetchIdentities(*IdentityFetchRequest, grpc.ServerStreamingServer[IdentityResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method FetchIdentities not implemented")
 }
+func (UnimplementedV1ZAPServiceServer) UpsertIdentities(grpc.ClientStreamingServer[IdentityBulkUpsertRequest, BulkUpsertResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UpsertIdentities not implemented")
+}
 func (UnimplementedV1ZAPServiceServer) CreateTenant(context.Context, *TenantCreateRequest) (*TenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
 }
@@ -393,6 +536,27 @@ func (UnimplementedV1ZAPServiceServer) DeleteTenant(context.Context, *TenantDele
 func (UnimplementedV1ZAPServiceServer) FetchTenants(*TenantFetchRequest, grpc.ServerStreamingServer[TenantResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method FetchTenants not implemented")
 }
+func (UnimplementedV1ZAPServiceServer) UpsertTenants(grpc.ClientStreamingServer[TenantBulkUpsertRequest, BulkUpsertResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UpsertTenants not implemented")
+}
+func (UnimplementedV1ZAPServiceServer) CreateWebhook(context.Context, *WebhookCreateRequest) (*WebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedV1ZAPServiceServer) UpdateWebhook(context.Context, *WebhookUpdateRequest) (*WebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedV1ZAPServiceServer) DeleteWebhook(context.Context, *WebhookDeleteRequest) (*WebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedV1ZAPServiceServer) FetchWebhooks(*WebhookFetchRequest, grpc.ServerStreamingServer[WebhookResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method FetchWebhooks not implemented")
+}
+func (UnimplementedV1ZAPServiceServer) FetchWebhookDeliveries(*WebhookDeliveryFetchRequest, grpc.ServerStreamingServer[WebhookDeliveryResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method FetchWebhookDeliveries not implemented")
+}
+func (UnimplementedV1ZAPServiceServer) RequeueWebhookDelivery(context.Context, *WebhookDeliveryRequeueRequest) (*WebhookDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueWebhookDelivery not implemented")
+}
 func (UnimplementedV1ZAPServiceServer) mustEmbedUnimplementedV1ZAPServiceServer() {}
 func (UnimplementedV1ZAPServiceServer) testEmbeddedByValue()                      {}
 
@@ -609,6 +773,13 @@ func _V1ZAPService_FetchIdentities_Handler(srv interface{}, stream grpc.ServerSt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type V1ZAPService_FetchIdentitiesServer = grpc.ServerStreamingServer[IdentityResponse]
 
+func _V1ZAPService_UpsertIdentities_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(V1ZAPServiceServer).UpsertIdentities(&grpc.GenericServerStream[IdentityBulkUpsertRequest, BulkUpsertResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1ZAPService_UpsertIdentitiesServer = grpc.ClientStreamingServer[IdentityBulkUpsertRequest, BulkUpsertResponse]
+
 func _V1ZAPService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TenantCreateRequest)
 	if err := dec(in); err != nil {
@@ -674,6 +845,107 @@ func _V1ZAPService_FetchTenants_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type V1ZAPService_FetchTenantsServer = grpc.ServerStreamingServer[TenantResponse]
 
+func _V1ZAPService_UpsertTenants_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(V1ZAPServiceServer).UpsertTenants(&grpc.GenericServerStream[TenantBulkUpsertRequest, BulkUpsertResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1ZAPService_UpsertTenantsServer = grpc.ClientStreamingServer[TenantBulkUpsertRequest, BulkUpsertResponse]
+
+func _V1ZAPService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1ZAPServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1ZAPService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1ZAPServiceServer).CreateWebhook(ctx, req.(*WebhookCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1ZAPService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1ZAPServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1ZAPService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1ZAPServiceServer).UpdateWebhook(ctx, req.(*WebhookUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1ZAPService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1ZAPServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1ZAPService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1ZAPServiceServer).DeleteWebhook(ctx, req.(*WebhookDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1ZAPService_FetchWebhooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WebhookFetchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(V1ZAPServiceServer).FetchWebhooks(m, &grpc.GenericServerStream[WebhookFetchRequest, WebhookResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1ZAPService_FetchWebhooksServer = grpc.ServerStreamingServer[WebhookResponse]
+
+func _V1ZAPService_FetchWebhookDeliveries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WebhookDeliveryFetchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(V1ZAPServiceServer).FetchWebhookDeliveries(m, &grpc.GenericServerStream[WebhookDeliveryFetchRequest, WebhookDeliveryResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type V1ZAPService_FetchWebhookDeliveriesServer = grpc.ServerStreamingServer[WebhookDeliveryResponse]
+
+func _V1ZAPService_RequeueWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveryRequeueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1ZAPServiceServer).RequeueWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V1ZAPService_RequeueWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1ZAPServiceServer).RequeueWebhookDelivery(ctx, req.(*WebhookDeliveryRequeueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // V1ZAPService_ServiceDesc is the grpc.ServiceDesc for V1ZAPService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -729,6 +1001,22 @@ var V1ZAPService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTenant",
 			Handler:    _V1ZAPService_DeleteTenant_Handler,
 		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _V1ZAPService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _V1ZAPService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _V1ZAPService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "RequeueWebhookDelivery",
+			Handler:    _V1ZAPService_RequeueWebhookDelivery_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -746,11 +1034,31 @@ var V1ZAPService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _V1ZAPService_FetchIdentities_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "UpsertIdentities",
+			Handler:       _V1ZAPService_UpsertIdentities_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "FetchTenants",
 			Handler:       _V1ZAPService_FetchTenants_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "UpsertTenants",
+			Handler:       _V1ZAPService_UpsertTenants_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "FetchWebhooks",
+			Handler:       _V1ZAPService_FetchWebhooks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FetchWebhookDeliveries",
+			Handler:       _V1ZAPService_FetchWebhookDeliveries_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "internal/agents/services/zap/endpoints/api/v1/zap.proto",
 }
@@ -19,6 +19,7 @@ package v1
 import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
@@ -120,3 +121,203 @@ func MapAgentIdentityToGrpcIdentityResponse(identity *azmodelszap.Identity) (*Id
 		Name:             identity.Name,
 	}, nil
 }
+
+// MapGrpcWebhookResponseToAgentWebhook maps the gRPC webhook to the agent webhook.
+func MapGrpcWebhookResponseToAgentWebhook(webhook *WebhookResponse) (*azmodelszap.Webhook, error) {
+	return &azmodelszap.Webhook{
+		WebhookID: webhook.WebhookID,
+		CreatedAt: webhook.CreatedAt.AsTime(),
+		UpdatedAt: webhook.UpdatedAt.AsTime(),
+		ZoneID:    webhook.ZoneID,
+		Name:      webhook.Name,
+		URL:       webhook.URL,
+		Secret:    webhook.Secret,
+		Events:    webhook.Events,
+	}, nil
+}
+
+// MapAgentWebhookToGrpcWebhookResponse maps the agent webhook to the gRPC webhook.
+func MapAgentWebhookToGrpcWebhookResponse(webhook *azmodelszap.Webhook) (*WebhookResponse, error) {
+	return &WebhookResponse{
+		WebhookID: webhook.WebhookID,
+		CreatedAt: timestamppb.New(webhook.CreatedAt),
+		UpdatedAt: timestamppb.New(webhook.UpdatedAt),
+		ZoneID:    webhook.ZoneID,
+		Name:      webhook.Name,
+		URL:       webhook.URL,
+		Secret:    webhook.Secret,
+		Events:    webhook.Events,
+	}, nil
+}
+
+// MapGrpcWebhookDeliveryResponseToAgentWebhookDelivery maps the gRPC webhook delivery to the agent webhook delivery.
+func MapGrpcWebhookDeliveryResponseToAgentWebhookDelivery(delivery *WebhookDeliveryResponse) (*azmodelszap.WebhookDelivery, error) {
+	return &azmodelszap.WebhookDelivery{
+		DeliveryID:     delivery.DeliveryID,
+		CreatedAt:      delivery.CreatedAt.AsTime(),
+		UpdatedAt:      delivery.UpdatedAt.AsTime(),
+		ZoneID:         delivery.ZoneID,
+		WebhookID:      delivery.WebhookID,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt.AsTime(),
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+	}, nil
+}
+
+// MapAgentWebhookDeliveryToGrpcWebhookDeliveryResponse maps the agent webhook delivery to the gRPC webhook delivery.
+func MapAgentWebhookDeliveryToGrpcWebhookDeliveryResponse(delivery *azmodelszap.WebhookDelivery) (*WebhookDeliveryResponse, error) {
+	return &WebhookDeliveryResponse{
+		DeliveryID:     delivery.DeliveryID,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+		UpdatedAt:      timestamppb.New(delivery.UpdatedAt),
+		ZoneID:         delivery.ZoneID,
+		WebhookID:      delivery.WebhookID,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+	}, nil
+}
+
+// MapGrpcFetchQueryToAgentFields maps the grpc fetch query to the agent fetch fields.
+func MapGrpcFetchQueryToAgentFields(query *FetchQuery, fields map[string]any) {
+	if query == nil {
+		return
+	}
+	if query.Cursor != nil {
+		fields[azmodels.FieldFetchCursor] = *query.Cursor
+	}
+	if query.SortBy != nil {
+		fields[azmodels.FieldFetchSortBy] = *query.SortBy
+	}
+	if query.SortOrder != nil {
+		fields[azmodels.FieldFetchSortOrder] = *query.SortOrder
+	}
+	if query.NamePrefix != nil {
+		fields[azmodels.FieldFetchNamePrefix] = *query.NamePrefix
+	}
+	if query.CreatedAfter != nil {
+		fields[azmodels.FieldFetchCreatedAfter] = query.CreatedAfter.AsTime()
+	}
+	if query.CreatedBefore != nil {
+		fields[azmodels.FieldFetchCreatedBefore] = query.CreatedBefore.AsTime()
+	}
+}
+
+// MapAgentFetchQueryToGrpcFetchQuery maps the agent fetch query to the grpc fetch query.
+func MapAgentFetchQueryToGrpcFetchQuery(query *azmodels.FetchQuery) *FetchQuery {
+	if query == nil {
+		return nil
+	}
+	grpcQuery := &FetchQuery{}
+	if query.Cursor != "" {
+		grpcQuery.Cursor = &query.Cursor
+	}
+	if query.SortBy != "" {
+		grpcQuery.SortBy = &query.SortBy
+	}
+	if query.SortOrder != "" {
+		grpcQuery.SortOrder = &query.SortOrder
+	}
+	if query.NamePrefix != "" {
+		grpcQuery.NamePrefix = &query.NamePrefix
+	}
+	if query.CreatedAfter != nil {
+		grpcQuery.CreatedAfter = timestamppb.New(*query.CreatedAfter)
+	}
+	if query.CreatedBefore != nil {
+		grpcQuery.CreatedBefore = timestamppb.New(*query.CreatedBefore)
+	}
+	return grpcQuery
+}
+
+// MapGrpcFetchQueryToAgentFetchQuery maps the grpc fetch query to the agent fetch query.
+func MapGrpcFetchQueryToAgentFetchQuery(query *FetchQuery) *azmodels.FetchQuery {
+	agentQuery := &azmodels.FetchQuery{}
+	if query == nil {
+		return agentQuery
+	}
+	agentQuery.SortBy = MapPointerStringToString(query.SortBy)
+	agentQuery.SortOrder = MapPointerStringToString(query.SortOrder)
+	return agentQuery
+}
+
+// MapGrpcBulkUpsertOptionsToAgentBulkUpsertOptions maps the gRPC bulk upsert options to the agent bulk upsert options.
+func MapGrpcBulkUpsertOptionsToAgentBulkUpsertOptions(options *BulkUpsertOptions) *azmodelszap.BulkUpsertOptions {
+	if options == nil {
+		return nil
+	}
+	return &azmodelszap.BulkUpsertOptions{
+		ZoneID:         options.ZoneID,
+		DryRun:         options.DryRun,
+		ConflictPolicy: options.ConflictPolicy,
+		FullReport:     options.FullReport,
+	}
+}
+
+// MapAgentBulkUpsertOptionsToGrpcBulkUpsertOptions maps the agent bulk upsert options to the gRPC bulk upsert options.
+func MapAgentBulkUpsertOptionsToGrpcBulkUpsertOptions(options *azmodelszap.BulkUpsertOptions) *BulkUpsertOptions {
+	if options == nil {
+		return nil
+	}
+	return &BulkUpsertOptions{
+		ZoneID:         options.ZoneID,
+		DryRun:         options.DryRun,
+		ConflictPolicy: options.ConflictPolicy,
+		FullReport:     options.FullReport,
+	}
+}
+
+// MapAgentBulkUpsertReportToGrpcBulkUpsertResponse maps the agent bulk upsert report to the gRPC bulk upsert response.
+func MapAgentBulkUpsertReportToGrpcBulkUpsertResponse(report *azmodelszap.BulkUpsertReport) *BulkUpsertResponse {
+	response := &BulkUpsertResponse{
+		DryRun:    report.DryRun,
+		Committed: report.Committed,
+		Created:   report.Created,
+		Updated:   report.Updated,
+		Skipped:   report.Skipped,
+		Failed:    report.Failed,
+		Rows:      make([]*BulkRowResult, len(report.Rows)),
+	}
+	for i, row := range report.Rows {
+		grpcRow := &BulkRowResult{Row: row.Row, Name: row.Name, Status: row.Status}
+		if row.ID != "" {
+			grpcRow.ID = &row.ID
+		}
+		if row.Error != "" {
+			grpcRow.Error = &row.Error
+		}
+		response.Rows[i] = grpcRow
+	}
+	return response
+}
+
+// MapGrpcBulkUpsertResponseToAgentBulkUpsertReport maps the gRPC bulk upsert response to the agent bulk upsert report.
+func MapGrpcBulkUpsertResponseToAgentBulkUpsertReport(response *BulkUpsertResponse) *azmodelszap.BulkUpsertReport {
+	report := &azmodelszap.BulkUpsertReport{
+		DryRun:    response.DryRun,
+		Committed: response.Committed,
+		Created:   response.Created,
+		Updated:   response.Updated,
+		Skipped:   response.Skipped,
+		Failed:    response.Failed,
+		Rows:      make([]azmodelszap.BulkRowResult, len(response.Rows)),
+	}
+	for i, row := range response.Rows {
+		report.Rows[i] = azmodelszap.BulkRowResult{
+			Row:    row.Row,
+			Name:   row.Name,
+			ID:     MapPointerStringToString(row.ID),
+			Status: row.Status,
+			Error:  MapPointerStringToString(row.Error),
+		}
+	}
+	return report
+}
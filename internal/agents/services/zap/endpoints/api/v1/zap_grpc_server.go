@@ -18,10 +18,16 @@ package v1
 
 import (
 	"context"
+	"fmt"
+	"io"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	azservices "github.com/permguard/permguard/pkg/agents/services"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
-	grpc "google.golang.org/grpc"
 )
 
 // ZAPService is the service for the ZAP.
@@ -54,6 +60,8 @@ type ZAPService interface {
 	DeleteIdentity(zoneID int64, identityID string) (*azmodelszap.Identity, error)
 	// FetchIdentities returns all identities.
 	FetchIdentities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Identity, error)
+	// UpsertIdentities creates or updates identities in bulk.
+	UpsertIdentities(options *azmodelszap.BulkUpsertOptions, identities []azmodelszap.Identity) (*azmodelszap.BulkUpsertReport, error)
 
 	// CreateTenant creates a new tenant.
 	CreateTenant(tenant *azmodelszap.Tenant) (*azmodelszap.Tenant, error)
@@ -63,21 +71,53 @@ type ZAPService interface {
 	DeleteTenant(zoneID int64, tenantID string) (*azmodelszap.Tenant, error)
 	// FetchTenants returns all tenants.
 	FetchTenants(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Tenant, error)
+	// UpsertTenants creates or updates tenants in bulk.
+	UpsertTenants(options *azmodelszap.BulkUpsertOptions, tenants []azmodelszap.Tenant) (*azmodelszap.BulkUpsertReport, error)
+	// CreateWebhook creates a new webhook.
+	CreateWebhook(webhook *azmodelszap.Webhook) (*azmodelszap.Webhook, error)
+	// UpdateWebhook updates a webhook.
+	UpdateWebhook(webhook *azmodelszap.Webhook) (*azmodelszap.Webhook, error)
+	// DeleteWebhook deletes a webhook.
+	DeleteWebhook(zoneID int64, webhookID string) (*azmodelszap.Webhook, error)
+	// FetchWebhooks returns all webhooks.
+	FetchWebhooks(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Webhook, error)
+	// FetchWebhookDeliveries returns the delivery history of the webhooks.
+	FetchWebhookDeliveries(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.WebhookDelivery, error)
+	// RequeueWebhookDelivery requeues a dead webhook delivery.
+	RequeueWebhookDelivery(zoneID int64, deliveryID int64) (*azmodelszap.WebhookDelivery, error)
 }
 
 // NewV1ZAPServer creates a new ZAP server.
-func NewV1ZAPServer(endpointCtx *azservices.EndpointContext, Service ZAPService) (*V1ZAPServer, error) {
+func NewV1ZAPServer(endpointCtx *azservices.EndpointContext, Service ZAPService, bulkMaxRows int) (*V1ZAPServer, error) {
 	return &V1ZAPServer{
-		ctx:     endpointCtx,
-		service: Service,
+		ctx:         endpointCtx,
+		service:     Service,
+		bulkMaxRows: bulkMaxRows,
 	}, nil
 }
 
 // V1ZAPServer is the gRPC server for the ZAP.
 type V1ZAPServer struct {
 	UnimplementedV1ZAPServiceServer
-	ctx     *azservices.EndpointContext
-	service ZAPService
+	ctx         *azservices.EndpointContext
+	service     ZAPService
+	bulkMaxRows int
+}
+
+// checkBulkRows checks that a bulk upsert does not exceed the maximum number of rows per call.
+func (s *V1ZAPServer) checkBulkRows(rows int) error {
+	if s.bulkMaxRows > 0 && rows > s.bulkMaxRows {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - the bulk upsert exceeds the maximum of %d rows per call", s.bulkMaxRows))
+	}
+	return nil
+}
+
+// bulkUpsertResponse maps the report of a bulk upsert, only the failed and skipped rows are returned unless the full report is requested.
+func bulkUpsertResponse(options *azmodelszap.BulkUpsertOptions, report *azmodelszap.BulkUpsertReport) *BulkUpsertResponse {
+	if options == nil || !options.FullReport {
+		report.TrimSucceededRows()
+	}
+	return MapAgentBulkUpsertReportToGrpcBulkUpsertResponse(report)
 }
 
 // CreateZone creates a new zone.
@@ -117,6 +157,7 @@ func (s *V1ZAPServer) FetchZones(zoneRequest *ZoneFetchRequest, stream grpc.Serv
 		fields[azmodelszap.FieldZoneName] = *zoneRequest.Name
 
 	}
+	MapGrpcFetchQueryToAgentFields(zoneRequest.Query, fields)
 	page := int32(0)
 	if zoneRequest.Page != nil {
 		page = int32(*zoneRequest.Page)
@@ -136,7 +177,11 @@ func (s *V1ZAPServer) FetchZones(zoneRequest *ZoneFetchRequest, stream grpc.Serv
 		}
 		stream.SendMsg(cvtedZone)
 	}
-	return nil
+	sortBy, sortOrder := MapGrpcFetchQueryToAgentFetchQuery(zoneRequest.Query).NormalizedSort()
+	return setFetchNextCursorTrailer(stream, len(zones), pageSize, func() (string, error) {
+		last := zones[len(zones)-1]
+		return azmodels.NewFetchCursorForCodeID(sortBy, sortOrder, last.ZoneID, last.Name, last.CreatedAt)
+	})
 }
 
 // CreateIdentitySource creates a new identity source.
@@ -176,6 +221,7 @@ func (s *V1ZAPServer) FetchIdentitySources(identitySourceRequest *IdentitySource
 	if identitySourceRequest.IdentitySourceID != nil {
 		fields[azmodelszap.FieldIdentitySourceIdentitySourceID] = *identitySourceRequest.IdentitySourceID
 	}
+	MapGrpcFetchQueryToAgentFields(identitySourceRequest.Query, fields)
 	page := int32(0)
 	if identitySourceRequest.Page != nil {
 		page = int32(*identitySourceRequest.Page)
@@ -195,7 +241,11 @@ func (s *V1ZAPServer) FetchIdentitySources(identitySourceRequest *IdentitySource
 		}
 		stream.SendMsg(cvtedIdentitySource)
 	}
-	return nil
+	sortBy, sortOrder := MapGrpcFetchQueryToAgentFetchQuery(identitySourceRequest.Query).NormalizedSort()
+	return setFetchNextCursorTrailer(stream, len(identitySources), pageSize, func() (string, error) {
+		last := identitySources[len(identitySources)-1]
+		return azmodels.NewFetchCursor(sortBy, sortOrder, last.IdentitySourceID, last.Name, last.CreatedAt)
+	})
 }
 
 // CreateIdentity creates a new identity.
@@ -241,6 +291,7 @@ func (s *V1ZAPServer) FetchIdentities(identityRequest *IdentityFetchRequest, str
 	if identityRequest.Name != nil {
 		fields[azmodelszap.FieldIdentityName] = *identityRequest.Name
 	}
+	MapGrpcFetchQueryToAgentFields(identityRequest.Query, fields)
 	page := int32(0)
 	if identityRequest.Page != nil {
 		page = int32(*identityRequest.Page)
@@ -260,7 +311,40 @@ func (s *V1ZAPServer) FetchIdentities(identityRequest *IdentityFetchRequest, str
 		}
 		stream.SendMsg(cvtedIdentity)
 	}
-	return nil
+	sortBy, sortOrder := MapGrpcFetchQueryToAgentFetchQuery(identityRequest.Query).NormalizedSort()
+	return setFetchNextCursorTrailer(stream, len(identities), pageSize, func() (string, error) {
+		last := identities[len(identities)-1]
+		return azmodels.NewFetchCursor(sortBy, sortOrder, last.IdentityID, last.Name, last.CreatedAt)
+	})
+}
+
+// UpsertIdentities creates or updates identities in bulk.
+func (s *V1ZAPServer) UpsertIdentities(stream grpc.ClientStreamingServer[IdentityBulkUpsertRequest, BulkUpsertResponse]) error {
+	var options *azmodelszap.BulkUpsertOptions
+	identities := []azmodelszap.Identity{}
+	for {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if options == nil {
+			options = MapGrpcBulkUpsertOptionsToAgentBulkUpsertOptions(request.Options)
+		}
+		if err := s.checkBulkRows(len(identities) + len(request.Identities)); err != nil {
+			return err
+		}
+		for _, row := range request.Identities {
+			identities = append(identities, azmodelszap.Identity{IdentitySourceID: row.IdentitySourceID, Kind: row.Kind, Name: row.Name})
+		}
+	}
+	report, err := s.service.UpsertIdentities(options, identities)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(bulkUpsertResponse(options, report))
 }
 
 // CreateTenant creates a new tenant.
@@ -300,6 +384,7 @@ func (s *V1ZAPServer) FetchTenants(tenantRequest *TenantFetchRequest, stream grp
 	if tenantRequest.TenantID != nil {
 		fields[azmodelszap.FieldTenantTenantID] = *tenantRequest.TenantID
 	}
+	MapGrpcFetchQueryToAgentFields(tenantRequest.Query, fields)
 	page := int32(0)
 	if tenantRequest.Page != nil {
 		page = int32(*tenantRequest.Page)
@@ -319,5 +404,149 @@ func (s *V1ZAPServer) FetchTenants(tenantRequest *TenantFetchRequest, stream grp
 		}
 		stream.SendMsg(cvtedTenant)
 	}
+	sortBy, sortOrder := MapGrpcFetchQueryToAgentFetchQuery(tenantRequest.Query).NormalizedSort()
+	return setFetchNextCursorTrailer(stream, len(tenants), pageSize, func() (string, error) {
+		last := tenants[len(tenants)-1]
+		return azmodels.NewFetchCursor(sortBy, sortOrder, last.TenantID, last.Name, last.CreatedAt)
+	})
+}
+
+// UpsertTenants creates or updates tenants in bulk.
+func (s *V1ZAPServer) UpsertTenants(stream grpc.ClientStreamingServer[TenantBulkUpsertRequest, BulkUpsertResponse]) error {
+	var options *azmodelszap.BulkUpsertOptions
+	tenants := []azmodelszap.Tenant{}
+	for {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if options == nil {
+			options = MapGrpcBulkUpsertOptionsToAgentBulkUpsertOptions(request.Options)
+		}
+		if err := s.checkBulkRows(len(tenants) + len(request.Tenants)); err != nil {
+			return err
+		}
+		for _, row := range request.Tenants {
+			tenants = append(tenants, azmodelszap.Tenant{Name: row.Name})
+		}
+	}
+	report, err := s.service.UpsertTenants(options, tenants)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(bulkUpsertResponse(options, report))
+}
+
+// CreateWebhook creates a new webhook.
+func (s *V1ZAPServer) CreateWebhook(ctx context.Context, webhookRequest *WebhookCreateRequest) (*WebhookResponse, error) {
+	webhook, err := s.service.CreateWebhook(&azmodelszap.Webhook{ZoneID: webhookRequest.ZoneID, Name: webhookRequest.Name, URL: webhookRequest.URL, Secret: webhookRequest.Secret, Events: webhookRequest.Events})
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentWebhookToGrpcWebhookResponse(webhook)
+}
+
+// UpdateWebhook updates a webhook.
+func (s *V1ZAPServer) UpdateWebhook(ctx context.Context, webhookRequest *WebhookUpdateRequest) (*WebhookResponse, error) {
+	webhook, err := s.service.UpdateWebhook(&azmodelszap.Webhook{WebhookID: webhookRequest.WebhookID, ZoneID: webhookRequest.ZoneID, Name: webhookRequest.Name, URL: webhookRequest.URL, Secret: webhookRequest.Secret, Events: webhookRequest.Events})
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentWebhookToGrpcWebhookResponse(webhook)
+}
+
+// DeleteWebhook deletes a webhook.
+func (s *V1ZAPServer) DeleteWebhook(ctx context.Context, webhookRequest *WebhookDeleteRequest) (*WebhookResponse, error) {
+	webhook, err := s.service.DeleteWebhook(webhookRequest.ZoneID, webhookRequest.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentWebhookToGrpcWebhookResponse(webhook)
+}
+
+// FetchWebhooks returns all webhooks.
+func (s *V1ZAPServer) FetchWebhooks(webhookRequest *WebhookFetchRequest, stream grpc.ServerStreamingServer[WebhookResponse]) error {
+	fields := map[string]any{}
+	if webhookRequest.Name != nil {
+		fields[azmodelszap.FieldWebhookName] = *webhookRequest.Name
+	}
+	if webhookRequest.WebhookID != nil {
+		fields[azmodelszap.FieldWebhookWebhookID] = *webhookRequest.WebhookID
+	}
+	page := int32(0)
+	if webhookRequest.Page != nil {
+		page = int32(*webhookRequest.Page)
+	}
+	pageSize := int32(0)
+	if webhookRequest.PageSize != nil {
+		pageSize = int32(*webhookRequest.PageSize)
+	}
+	webhooks, err := s.service.FetchWebhooks(page, pageSize, webhookRequest.ZoneID, fields)
+	if err != nil {
+		return err
+	}
+	for _, webhook := range webhooks {
+		cvtedWebhook, err := MapAgentWebhookToGrpcWebhookResponse(&webhook)
+		if err != nil {
+			return err
+		}
+		stream.SendMsg(cvtedWebhook)
+	}
+	return nil
+}
+
+// FetchWebhookDeliveries returns the delivery history of the webhooks.
+func (s *V1ZAPServer) FetchWebhookDeliveries(deliveryRequest *WebhookDeliveryFetchRequest, stream grpc.ServerStreamingServer[WebhookDeliveryResponse]) error {
+	fields := map[string]any{}
+	if deliveryRequest.WebhookID != nil {
+		fields[azmodelszap.FieldWebhookDeliveryWebhookID] = *deliveryRequest.WebhookID
+	}
+	if deliveryRequest.Status != nil {
+		fields[azmodelszap.FieldWebhookDeliveryStatus] = *deliveryRequest.Status
+	}
+	page := int32(0)
+	if deliveryRequest.Page != nil {
+		page = int32(*deliveryRequest.Page)
+	}
+	pageSize := int32(0)
+	if deliveryRequest.PageSize != nil {
+		pageSize = int32(*deliveryRequest.PageSize)
+	}
+	deliveries, err := s.service.FetchWebhookDeliveries(page, pageSize, deliveryRequest.ZoneID, fields)
+	if err != nil {
+		return err
+	}
+	for _, delivery := range deliveries {
+		cvtedDelivery, err := MapAgentWebhookDeliveryToGrpcWebhookDeliveryResponse(&delivery)
+		if err != nil {
+			return err
+		}
+		stream.SendMsg(cvtedDelivery)
+	}
+	return nil
+}
+
+// RequeueWebhookDelivery requeues a dead webhook delivery.
+func (s *V1ZAPServer) RequeueWebhookDelivery(ctx context.Context, deliveryRequest *WebhookDeliveryRequeueRequest) (*WebhookDeliveryResponse, error) {
+	delivery, err := s.service.RequeueWebhookDelivery(deliveryRequest.ZoneID, deliveryRequest.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+	return MapAgentWebhookDeliveryToGrpcWebhookDeliveryResponse(delivery)
+}
+
+// setFetchNextCursorTrailer sets the cursor of the next page as trailer of the stream when the page is full.
+func setFetchNextCursorTrailer(stream grpc.ServerStream, count int, pageSize int32, nextCursor func() (string, error)) error {
+	if count == 0 || int32(count) < pageSize {
+		return nil
+	}
+	cursor, err := nextCursor()
+	if err != nil {
+		return err
+	}
+	stream.SetTrailer(metadata.Pairs(azmodels.NextCursorMetadataKey, cursor))
 	return nil
 }
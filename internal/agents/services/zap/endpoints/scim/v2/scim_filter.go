@@ -0,0 +1,74 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// FilterAttributeUserName is the user name attribute of a filter.
+	FilterAttributeUserName = "username"
+	// FilterAttributeID is the id attribute of a filter.
+	FilterAttributeID = "id"
+	// FilterOperatorEqual is the equal operator of a filter.
+	FilterOperatorEqual = "eq"
+	// FilterOperatorStartsWith is the starts with operator of a filter.
+	FilterOperatorStartsWith = "sw"
+)
+
+// filterRegex matches a filter made of an attribute, an operator and a quoted value.
+var filterRegex = regexp.MustCompile(`^\s*([A-Za-z][A-Za-z0-9\.:_-]*)\s+([A-Za-z]{2})\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// Filter is a scim filter made of a single attribute expression.
+type Filter struct {
+	Attribute string
+	Operator  string
+	Value     string
+}
+
+// ParseFilter parses a scim filter, only the userName eq and sw and the id eq expressions are supported.
+func ParseFilter(filter string) (*Filter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	matches := filterRegex.FindStringSubmatch(filter)
+	if matches == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - filter %q is not supported", filter))
+	}
+	value, err := strconv.Unquote(`"` + matches[3] + `"`)
+	if err != nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - filter value %q is not valid", matches[3]))
+	}
+	parsed := &Filter{
+		Attribute: strings.ToLower(matches[1]),
+		Operator:  strings.ToLower(matches[2]),
+		Value:     value,
+	}
+	switch {
+	case parsed.Attribute == FilterAttributeUserName && (parsed.Operator == FilterOperatorEqual || parsed.Operator == FilterOperatorStartsWith):
+	case parsed.Attribute == FilterAttributeID && parsed.Operator == FilterOperatorEqual:
+	default:
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - filter %q is not supported", filter))
+	}
+	return parsed, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseFilter tests the parsing of the scim filters.
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		Filter   string
+		Expected *Filter
+		HasError bool
+	}{
+		{Filter: "", Expected: nil},
+		{Filter: `userName eq "nicola.gallo"`, Expected: &Filter{Attribute: FilterAttributeUserName, Operator: FilterOperatorEqual, Value: "nicola.gallo"}},
+		{Filter: ` USERNAME Sw "nic\"ola" `, Expected: &Filter{Attribute: FilterAttributeUserName, Operator: FilterOperatorStartsWith, Value: `nic"ola`}},
+		{Filter: `id eq "c3160a533ab24fbcb1eab7a09fd85f36"`, Expected: &Filter{Attribute: FilterAttributeID, Operator: FilterOperatorEqual, Value: "c3160a533ab24fbcb1eab7a09fd85f36"}},
+		{Filter: `id sw "c3160a"`, HasError: true},
+		{Filter: `userName co "nicola"`, HasError: true},
+		{Filter: `userName eq "nicola" and id eq "x"`, HasError: true},
+		{Filter: `userName eq nicola`, HasError: true},
+	}
+	for _, test := range tests {
+		assert := assert.New(t)
+		filter, err := ParseFilter(test.Filter)
+		if test.HasError {
+			assert.NotNil(err, test.Filter)
+			continue
+		}
+		assert.Nil(err, test.Filter)
+		assert.Equal(test.Expected, filter, test.Filter)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"time"
+)
+
+const (
+	// SchemaUser is the schema of the scim user resource.
+	SchemaUser = "urn:ietf:params:scim:schemas:core:2.0:User"
+	// SchemaListResponse is the schema of the scim list response.
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	// SchemaPatchOp is the schema of the scim patch request.
+	SchemaPatchOp = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	// SchemaError is the schema of the scim error response.
+	SchemaError = "urn:ietf:params:scim:api:messages:2.0:Error"
+	// SchemaServiceProviderConfig is the schema of the scim service provider configuration.
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	// ResourceTypeUser is the resource type of the scim user.
+	ResourceTypeUser = "User"
+
+	// ScimTypeInvalidFilter is the scim error type of an invalid filter.
+	ScimTypeInvalidFilter = "invalidFilter"
+	// ScimTypeInvalidValue is the scim error type of an invalid value.
+	ScimTypeInvalidValue = "invalidValue"
+	// ScimTypeInvalidSyntax is the scim error type of a malformed request.
+	ScimTypeInvalidSyntax = "invalidSyntax"
+	// ScimTypeInvalidPath is the scim error type of an invalid patch path.
+	ScimTypeInvalidPath = "invalidPath"
+	// ScimTypeMutability is the scim error type of a change to an immutable attribute.
+	ScimTypeMutability = "mutability"
+	// ScimTypeUniqueness is the scim error type of a duplicated resource.
+	ScimTypeUniqueness = "uniqueness"
+
+	// PatchOpAdd is the scim patch operation to add a value.
+	PatchOpAdd = "add"
+	// PatchOpReplace is the scim patch operation to replace a value.
+	PatchOpReplace = "replace"
+	// PatchOpRemove is the scim patch operation to remove a value.
+	PatchOpRemove = "remove"
+)
+
+// Meta is the metadata of a scim resource.
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// User is the scim user resource, it is mapped onto an identity of kind user.
+type User struct {
+	Schemas  []string `json:"schemas"`
+	ID       string   `json:"id,omitempty"`
+	UserName string   `json:"userName"`
+	Active   *bool    `json:"active,omitempty"`
+	Meta     *Meta    `json:"meta,omitempty"`
+}
+
+// ListResponse is the scim response of a query.
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []User   `json:"Resources"`
+}
+
+// PatchOperation is an operation of a scim patch request.
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// PatchRequest is the scim patch request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// Error is the scim error response.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+	Status   string   `json:"status"`
+}
+
+// Supported tells if a feature of the service provider is supported.
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+// FilterSupported describes the filter support of the service provider.
+type FilterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+// AuthenticationScheme describes an authentication scheme of the service provider.
+type AuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ServiceProviderConfig is the scim service provider configuration.
+type ServiceProviderConfig struct {
+	Schemas               []string               `json:"schemas"`
+	Patch                 Supported              `json:"patch"`
+	Bulk                  Supported              `json:"bulk"`
+	Filter                FilterSupported        `json:"filter"`
+	ChangePassword        Supported              `json:"changePassword"`
+	Sort                  Supported              `json:"sort"`
+	ETag                  Supported              `json:"etag"`
+	AuthenticationSchemes []AuthenticationScheme `json:"authenticationSchemes"`
+}
@@ -0,0 +1,621 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	azservices "github.com/permguard/permguard/pkg/agents/services"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azvalidators "github.com/permguard/permguard/pkg/core/validators"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// DefaultCount is the default number of resources returned by a query.
+	DefaultCount = 100
+	// MaxCount is the maximum number of resources returned by a query.
+	MaxCount = 1000
+
+	// scimContentType is the content type of the scim responses.
+	scimContentType = "application/scim+json"
+	// scimBasePath is the base path of the scim endpoint, scoped by zone and identity source.
+	scimBasePath = "/scim/v2/zones/{zoneID}/identitysources/{identitySourceID}"
+	// scimMaxBodySize is the maximum size of a scim request body.
+	scimMaxBodySize = 1 << 20
+	// scimReadHeaderTimeout is the timeout for reading the headers of the scim requests.
+	scimReadHeaderTimeout = 5 * time.Second
+	// scimShutdownTimeout is the timeout of the graceful shutdown of the scim server.
+	scimShutdownTimeout = 5 * time.Second
+	// identityKindUser is the identity kind the scim users are mapped onto.
+	identityKindUser = "user"
+	// errorMessageInactiveUser is the error detail of a user set as inactive.
+	errorMessageInactiveUser = "inactive users are not supported, identities are deprovisioned by deleting them"
+)
+
+// SCIMService is the service backing the scim endpoint.
+type SCIMService interface {
+	// FetchIdentitySources returns all identity sources.
+	FetchIdentitySources(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.IdentitySource, error)
+	// CreateIdentity creates a new identity.
+	CreateIdentity(identity *azmodelszap.Identity) (*azmodelszap.Identity, error)
+	// UpdateIdentity updates an identity.
+	UpdateIdentity(identity *azmodelszap.Identity) (*azmodelszap.Identity, error)
+	// DeleteIdentity deletes an identity.
+	DeleteIdentity(zoneID int64, identityID string) (*azmodelszap.Identity, error)
+	// FetchIdentities returns all identities.
+	FetchIdentities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Identity, error)
+	// CountIdentities counts the identities.
+	CountIdentities(zoneID int64, fields map[string]any) (int64, error)
+}
+
+// scimScope is the zone and the identity source a scim request is scoped to.
+type scimScope struct {
+	zoneID           int64
+	identitySourceID string
+	basePath         string
+}
+
+// TokenKey returns the key of the bearer token of a zone and an identity source.
+func TokenKey(zoneID int64, identitySourceID string) string {
+	return fmt.Sprintf("%d/%s", zoneID, identitySourceID)
+}
+
+// NewV2SCIMServer creates a new scim server, the tokens are keyed by zone and identity source and the identities are
+// fetched with pages of at most maxPageSize items.
+func NewV2SCIMServer(endpointCtx *azservices.EndpointContext, service SCIMService, tokens map[string]string, maxPageSize int) (*V2SCIMServer, error) {
+	if len(tokens) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrConfigurationGeneric, "the scim bearer tokens are required")
+	}
+	for key, token := range tokens {
+		if token == "" {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrConfigurationGeneric, fmt.Sprintf("the scim bearer token of %s is empty", key))
+		}
+	}
+	pageSize := MaxCount
+	if maxPageSize > 0 {
+		pageSize = min(pageSize, maxPageSize)
+	}
+	server := &V2SCIMServer{
+		ctx:      endpointCtx,
+		service:  service,
+		tokens:   tokens,
+		pageSize: pageSize,
+		mux:      http.NewServeMux(),
+	}
+	server.mux.HandleFunc("GET "+scimBasePath+"/ServiceProviderConfig", server.scoped(server.getServiceProviderConfig))
+	server.mux.HandleFunc("GET "+scimBasePath+"/Users", server.scoped(server.listUsers))
+	server.mux.HandleFunc("POST "+scimBasePath+"/Users", server.scoped(server.createUser))
+	server.mux.HandleFunc("GET "+scimBasePath+"/Users/{id}", server.scoped(server.getUser))
+	server.mux.HandleFunc("PUT "+scimBasePath+"/Users/{id}", server.scoped(server.replaceUser))
+	server.mux.HandleFunc("PATCH "+scimBasePath+"/Users/{id}", server.scoped(server.patchUser))
+	server.mux.HandleFunc("DELETE "+scimBasePath+"/Users/{id}", server.scoped(server.deleteUser))
+	server.mux.HandleFunc(scimBasePath+"/Groups", server.scoped(server.groupsNotSupported))
+	server.mux.HandleFunc(scimBasePath+"/Groups/{id}", server.scoped(server.groupsNotSupported))
+	server.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "", "resource not found")
+	})
+	return server, nil
+}
+
+// V2SCIMServer is the scim 2.0 server for the identities of the ZAP.
+type V2SCIMServer struct {
+	ctx      *azservices.EndpointContext
+	service  SCIMService
+	tokens   map[string]string
+	pageSize int
+	mux      *http.ServeMux
+}
+
+// Serve starts the scim server on the input port, it is stopped when the endpoint context is done.
+func (s *V2SCIMServer) Serve(port int) error {
+	logger := s.ctx.GetLogger()
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           s,
+		ReadHeaderTimeout: scimReadHeaderTimeout,
+	}
+	lis, err := net.Listen("tcp", httpServer.Addr)
+	if err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerInfrastructure, fmt.Sprintf("scim server cannot listen on port %d", port), err)
+	}
+	go func() {
+		logger.Info(fmt.Sprintf("SCIM server is serving on port: %d", port))
+		if err := httpServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(fmt.Sprintf("SCIM server failed to serve on port: %d", port), zap.Error(err))
+		}
+	}()
+	go func() {
+		<-s.ctx.GetContext().Done()
+		ctx, cancel := context.WithTimeout(context.Background(), scimShutdownTimeout)
+		defer cancel()
+		httpServer.Shutdown(ctx)
+	}()
+	return nil
+}
+
+// bearerToken returns the bearer token of the request.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// writeUnauthorized writes the error of a request without a valid bearer token.
+func writeUnauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="permguard-scim"`)
+	writeError(w, http.StatusUnauthorized, "", "the bearer token is missing or not valid")
+}
+
+// ServeHTTP requires a bearer token and serves the request, the token is verified against the scope of the request.
+func (s *V2SCIMServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if _, ok := bearerToken(r); !ok {
+		writeUnauthorized(w)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, scimMaxBodySize)
+	s.mux.ServeHTTP(w, r)
+}
+
+// scoped verifies the bearer token of the zone and the identity source of the request and resolves them before
+// calling the handler.
+func (s *V2SCIMServer) scoped(handler func(http.ResponseWriter, *http.Request, *scimScope)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, _ := bearerToken(r)
+		expected, ok := s.tokens[r.PathValue("zoneID")+"/"+r.PathValue("identitySourceID")]
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+			writeUnauthorized(w)
+			return
+		}
+		zoneID, err := strconv.ParseInt(r.PathValue("zoneID"), 10, 64)
+		if err != nil || azvalidators.ValidateCodeID("zone", zoneID) != nil {
+			writeError(w, http.StatusNotFound, "", "zone not found")
+			return
+		}
+		identitySourceID := r.PathValue("identitySourceID")
+		if azvalidators.ValidateUUID("identity source", identitySourceID) != nil {
+			writeError(w, http.StatusNotFound, "", "identity source not found")
+			return
+		}
+		fields := map[string]any{azmodelszap.FieldIdentitySourceIdentitySourceID: identitySourceID}
+		identitySources, err := s.service.FetchIdentitySources(1, 1, zoneID, fields)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		if len(identitySources) == 0 {
+			writeError(w, http.StatusNotFound, "", "identity source not found")
+			return
+		}
+		handler(w, r, &scimScope{
+			zoneID:           zoneID,
+			identitySourceID: identitySourceID,
+			basePath:         fmt.Sprintf("/scim/v2/zones/%d/identitysources/%s", zoneID, identitySourceID),
+		})
+	}
+}
+
+// getServiceProviderConfig returns the service provider configuration.
+func (s *V2SCIMServer) getServiceProviderConfig(w http.ResponseWriter, r *http.Request, scope *scimScope) {
+	writeJSON(w, http.StatusOK, &ServiceProviderConfig{
+		Schemas: []string{SchemaServiceProviderConfig},
+		Patch:   Supported{Supported: true},
+		Filter:  FilterSupported{Supported: true, MaxResults: MaxCount},
+		AuthenticationSchemes: []AuthenticationScheme{
+			{Type: "oauthbearertoken", Name: "Bearer Token", Description: "Authentication with the bearer token configured on the ZAP server for the zone and the identity source"},
+		},
+	})
+}
+
+// groupsNotSupported replies that groups are not supported.
+func (s *V2SCIMServer) groupsNotSupported(w http.ResponseWriter, r *http.Request, scope *scimScope) {
+	writeError(w, http.StatusNotImplemented, "", "groups are not supported")
+}
+
+// listUsers returns the users matching the filter.
+func (s *V2SCIMServer) listUsers(w http.ResponseWriter, r *http.Request, scope *scimScope) {
+	query := r.URL.Query()
+	filter, err := ParseFilter(query.Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidFilter, err.Error())
+		return
+	}
+	startIndex := 1
+	if value := query.Get("startIndex"); value != "" {
+		if startIndex, err = strconv.Atoi(value); err != nil {
+			writeError(w, http.StatusBadRequest, ScimTypeInvalidValue, "startIndex is not valid")
+			return
+		}
+		startIndex = max(startIndex, 1)
+	}
+	count := DefaultCount
+	if value := query.Get("count"); value != "" {
+		if count, err = strconv.Atoi(value); err != nil {
+			writeError(w, http.StatusBadRequest, ScimTypeInvalidValue, "count is not valid")
+			return
+		}
+		count = min(max(count, 0), MaxCount)
+	}
+	fields := s.userFields(scope)
+	var identities []azmodelszap.Identity
+	totalResults := 0
+	if filter != nil && filter.Operator == FilterOperatorEqual {
+		// the equality filters match at most one user, so all the matches are loaded and paginated in memory.
+		matches, err := s.findUsers(scope, filter)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		totalResults = len(matches)
+		if startIndex <= len(matches) {
+			identities = matches[startIndex-1 : min(startIndex-1+count, len(matches))]
+		}
+	} else {
+		if filter != nil {
+			fields[azmodels.FieldFetchNamePrefix] = strings.ToLower(filter.Value)
+		}
+		total, err := s.service.CountIdentities(scope.zoneID, fields)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		totalResults = int(total)
+		if count > 0 && startIndex <= totalResults {
+			if identities, err = s.fetchUsers(scope, fields, startIndex-1, count); err != nil {
+				writeServiceError(w, err)
+				return
+			}
+		}
+	}
+	response := &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(identities),
+		Resources:    make([]User, len(identities)),
+	}
+	for i := range identities {
+		response.Resources[i] = *mapIdentityToUser(&identities[i], scope)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// createUser creates an identity for the user.
+func (s *V2SCIMServer) createUser(w http.ResponseWriter, r *http.Request, scope *scimScope) {
+	user := &User{}
+	if err := json.NewDecoder(r.Body).Decode(user); err != nil {
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidSyntax, "the request body is not a valid user")
+		return
+	}
+	if strings.TrimSpace(user.UserName) == "" {
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidValue, "userName is required")
+		return
+	}
+	if user.Active != nil && !*user.Active {
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidValue, errorMessageInactiveUser)
+		return
+	}
+	identity, err := s.service.CreateIdentity(&azmodelszap.Identity{
+		ZoneID:           scope.zoneID,
+		IdentitySourceID: scope.identitySourceID,
+		Kind:             identityKindUser,
+		Name:             strings.TrimSpace(user.UserName),
+	})
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	created := mapIdentityToUser(identity, scope)
+	w.Header().Set("Location", created.Meta.Location)
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// getUser returns a user.
+func (s *V2SCIMServer) getUser(w http.ResponseWriter, r *http.Request, scope *scimScope) {
+	identity, ok := s.readUser(w, r, scope)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapIdentityToUser(identity, scope))
+}
+
+// replaceUser replaces a user, users cannot be set as inactive.
+func (s *V2SCIMServer) replaceUser(w http.ResponseWriter, r *http.Request, scope *scimScope) {
+	identity, ok := s.readUser(w, r, scope)
+	if !ok {
+		return
+	}
+	user := &User{}
+	if err := json.NewDecoder(r.Body).Decode(user); err != nil {
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidSyntax, "the request body is not a valid user")
+		return
+	}
+	if strings.TrimSpace(user.UserName) == "" {
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidValue, "userName is required")
+		return
+	}
+	active := user.Active == nil || *user.Active
+	s.applyUser(w, identity, strings.TrimSpace(user.UserName), active, scope)
+}
+
+// patchUser applies the patch operations to a user, users cannot be set as inactive.
+func (s *V2SCIMServer) patchUser(w http.ResponseWriter, r *http.Request, scope *scimScope) {
+	identity, ok := s.readUser(w, r, scope)
+	if !ok {
+		return
+	}
+	patch := &PatchRequest{}
+	if err := json.NewDecoder(r.Body).Decode(patch); err != nil {
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidSyntax, "the request body is not a valid patch request")
+		return
+	}
+	hasSchema := false
+	for _, schema := range patch.Schemas {
+		hasSchema = hasSchema || schema == SchemaPatchOp
+	}
+	if !hasSchema {
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidSyntax, fmt.Sprintf("the patch request must have the %s schema", SchemaPatchOp))
+		return
+	}
+	userName, active := identity.Name, true
+	for _, operation := range patch.Operations {
+		var err *patchError
+		userName, active, err = applyPatchOperation(operation, userName, active)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.scimType, err.detail)
+			return
+		}
+	}
+	s.applyUser(w, identity, userName, active, scope)
+}
+
+// deleteUser deletes the identity of a user.
+func (s *V2SCIMServer) deleteUser(w http.ResponseWriter, r *http.Request, scope *scimScope) {
+	identity, ok := s.readUser(w, r, scope)
+	if !ok {
+		return
+	}
+	if _, err := s.service.DeleteIdentity(scope.zoneID, identity.IdentityID); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// applyUser updates the identity of a user, the identities have no active flag so inactive users are rejected.
+func (s *V2SCIMServer) applyUser(w http.ResponseWriter, identity *azmodelszap.Identity, userName string, active bool, scope *scimScope) {
+	if !active {
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidValue, errorMessageInactiveUser)
+		return
+	}
+	if !strings.EqualFold(userName, identity.Name) {
+		updated, err := s.service.UpdateIdentity(&azmodelszap.Identity{
+			IdentityID:       identity.IdentityID,
+			ZoneID:           scope.zoneID,
+			IdentitySourceID: scope.identitySourceID,
+			Kind:             identityKindUser,
+			Name:             userName,
+		})
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		identity = updated
+	}
+	writeJSON(w, http.StatusOK, mapIdentityToUser(identity, scope))
+}
+
+// userFields returns the fetch fields of the users of the scope.
+func (s *V2SCIMServer) userFields(scope *scimScope) map[string]any {
+	return map[string]any{
+		azmodelszap.FieldIdentityIdentitySourceID: scope.identitySourceID,
+		azmodelszap.FieldIdentityKind:             identityKindUser,
+	}
+}
+
+// fetchUsers returns up to limit users from the offset, the storage is paged with pages of at most pageSize items.
+func (s *V2SCIMServer) fetchUsers(scope *scimScope, fields map[string]any, offset int, limit int) ([]azmodelszap.Identity, error) {
+	page, skip := offset/s.pageSize+1, offset%s.pageSize
+	users := []azmodelszap.Identity{}
+	for len(users) < limit {
+		identities, err := s.service.FetchIdentities(int32(page), int32(s.pageSize), scope.zoneID, fields)
+		if err != nil {
+			return nil, err
+		}
+		if skip < len(identities) {
+			users = append(users, identities[skip:min(len(identities), skip+limit-len(users))]...)
+		}
+		if len(identities) < s.pageSize {
+			break
+		}
+		page, skip = page+1, 0
+	}
+	return users, nil
+}
+
+// findUsers returns all the users matching an equality filter.
+func (s *V2SCIMServer) findUsers(scope *scimScope, filter *Filter) ([]azmodelszap.Identity, error) {
+	fields := s.userFields(scope)
+	switch filter.Attribute {
+	case FilterAttributeID:
+		if azvalidators.ValidateUUID("identity", filter.Value) != nil {
+			return nil, nil
+		}
+		fields[azmodelszap.FieldIdentityIdentityID] = filter.Value
+	case FilterAttributeUserName:
+		if azvalidators.ValidateIdentityUserName("identity", strings.ToLower(filter.Value)) != nil {
+			return nil, nil
+		}
+		fields[azmodelszap.FieldIdentityName] = strings.ToLower(filter.Value)
+	}
+	identities, err := s.fetchUsers(scope, fields, 0, math.MaxInt)
+	if err != nil {
+		return nil, err
+	}
+	matches := []azmodelszap.Identity{}
+	for _, identity := range identities {
+		// the name filter of the storage matches substrings, so the user names are compared here.
+		if filter.Attribute == FilterAttributeUserName && !strings.EqualFold(identity.Name, filter.Value) {
+			continue
+		}
+		matches = append(matches, identity)
+	}
+	return matches, nil
+}
+
+// readUser reads the user of the request path, a not found error is written if it does not belong to the scope.
+func (s *V2SCIMServer) readUser(w http.ResponseWriter, r *http.Request, scope *scimScope) (*azmodelszap.Identity, bool) {
+	matches, err := s.findUsers(scope, &Filter{Attribute: FilterAttributeID, Operator: FilterOperatorEqual, Value: r.PathValue("id")})
+	if err != nil {
+		writeServiceError(w, err)
+		return nil, false
+	}
+	if len(matches) == 0 {
+		writeError(w, http.StatusNotFound, "", "user not found")
+		return nil, false
+	}
+	return &matches[0], true
+}
+
+// patchError is the error of a patch operation that cannot be applied.
+type patchError struct {
+	scimType string
+	detail   string
+}
+
+// newPatchError creates a new patch error.
+func newPatchError(scimType string, detail string) *patchError {
+	return &patchError{scimType: scimType, detail: detail}
+}
+
+// applyPatchOperation applies a patch operation to the user name and the active flag, the other attributes are ignored.
+func applyPatchOperation(operation PatchOperation, userName string, active bool) (string, bool, *patchError) {
+	op := strings.ToLower(operation.Op)
+	if op != PatchOpAdd && op != PatchOpReplace && op != PatchOpRemove {
+		return "", false, newPatchError(ScimTypeInvalidSyntax, fmt.Sprintf("patch operation %q is not supported", operation.Op))
+	}
+	values := map[string]any{}
+	switch {
+	case operation.Path != "":
+		values[strings.ToLower(operation.Path)] = operation.Value
+	case op == PatchOpRemove:
+		return "", false, newPatchError(ScimTypeInvalidPath, "the path of a remove operation is required")
+	default:
+		object, ok := operation.Value.(map[string]any)
+		if !ok {
+			return "", false, newPatchError(ScimTypeInvalidValue, "the value of a patch operation without path must be an object")
+		}
+		for key, value := range object {
+			values[strings.ToLower(key)] = value
+		}
+	}
+	for key, value := range values {
+		switch key {
+		case "username":
+			if op == PatchOpRemove {
+				return "", false, newPatchError(ScimTypeMutability, "userName is required and cannot be removed")
+			}
+			text, ok := value.(string)
+			if !ok || strings.TrimSpace(text) == "" {
+				return "", false, newPatchError(ScimTypeInvalidValue, "userName must be a non empty string")
+			}
+			userName = strings.TrimSpace(text)
+		case "active":
+			if op == PatchOpRemove {
+				continue
+			}
+			switch flag := value.(type) {
+			case bool:
+				active = flag
+			case string:
+				parsed, err := strconv.ParseBool(flag)
+				if err != nil {
+					return "", false, newPatchError(ScimTypeInvalidValue, "active must be a boolean")
+				}
+				active = parsed
+			default:
+				return "", false, newPatchError(ScimTypeInvalidValue, "active must be a boolean")
+			}
+		}
+	}
+	return userName, active, nil
+}
+
+// mapIdentityToUser maps an identity onto a scim user.
+func mapIdentityToUser(identity *azmodelszap.Identity, scope *scimScope) *User {
+	active := true
+	created, lastModified := identity.CreatedAt, identity.UpdatedAt
+	return &User{
+		Schemas:  []string{SchemaUser},
+		ID:       identity.IdentityID,
+		UserName: identity.Name,
+		Active:   &active,
+		Meta: &Meta{
+			ResourceType: ResourceTypeUser,
+			Created:      &created,
+			LastModified: &lastModified,
+			Location:     fmt.Sprintf("%s/Users/%s", scope.basePath, identity.IdentityID),
+		},
+	}
+}
+
+// writeJSON writes a scim response.
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes a scim error response.
+func writeError(w http.ResponseWriter, statusCode int, scimType string, detail string) {
+	writeJSON(w, statusCode, &Error{
+		Schemas:  []string{SchemaError},
+		ScimType: scimType,
+		Detail:   detail,
+		Status:   strconv.Itoa(statusCode),
+	})
+}
+
+// writeServiceError writes the scim error response of a service error.
+func writeServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case azerrors.AreErrorsEqual(err, azerrors.ErrStorageConstraintUnique):
+		writeError(w, http.StatusConflict, ScimTypeUniqueness, err.Error())
+	case azerrors.AreErrorsEqual(err, azerrors.ErrClientNotFound), azerrors.AreErrorsEqual(err, azerrors.ErrStorageNotFound):
+		writeError(w, http.StatusNotFound, "", err.Error())
+	case azerrors.IsErrorInClass(err, azerrors.ErrorCodeMaskClient), azerrors.AreErrorsEqual(err, azerrors.ErrStorageConstraintForeignKey):
+		writeError(w, http.StatusBadRequest, ScimTypeInvalidValue, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "", "the request could not be processed")
+	}
+}
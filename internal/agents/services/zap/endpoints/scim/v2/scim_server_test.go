@@ -0,0 +1,375 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	testZoneID           = 273165098782
+	testIdentitySourceID = "1da1d9094501425085859c60429163c2"
+	testToken            = "0f8e1d2c3b4a"
+	// testOtherIdentitySourceID is an identity source with a token which does not exist.
+	testOtherIdentitySourceID = "5e3b1f0c2a6d4e8f9a0b1c2d3e4f5a6b"
+	testOtherToken            = "9a8b7c6d5e4f"
+)
+
+// memoryService is an in memory store of identities.
+type memoryService struct {
+	identities  []azmodelszap.Identity
+	maxPageSize int32
+}
+
+// FetchIdentitySources returns all identity sources.
+func (m *memoryService) FetchIdentitySources(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.IdentitySource, error) {
+	if zoneID != testZoneID || fields[azmodelszap.FieldIdentitySourceIdentitySourceID] != testIdentitySourceID {
+		return nil, nil
+	}
+	return []azmodelszap.IdentitySource{{IdentitySourceID: testIdentitySourceID, ZoneID: zoneID, Name: "default"}}, nil
+}
+
+// CreateIdentity creates a new identity.
+func (m *memoryService) CreateIdentity(identity *azmodelszap.Identity) (*azmodelszap.Identity, error) {
+	for _, existing := range m.identities {
+		if existing.Name == strings.ToLower(identity.Name) {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrStorageConstraintUnique, "identity already exists")
+		}
+	}
+	created := *identity
+	created.IdentityID = strings.ReplaceAll(uuid.New().String(), "-", "")
+	created.Name = strings.ToLower(identity.Name)
+	created.CreatedAt = time.Now()
+	created.UpdatedAt = created.CreatedAt
+	m.identities = append(m.identities, created)
+	return &created, nil
+}
+
+// UpdateIdentity updates an identity.
+func (m *memoryService) UpdateIdentity(identity *azmodelszap.Identity) (*azmodelszap.Identity, error) {
+	for i, existing := range m.identities {
+		if existing.IdentityID == identity.IdentityID {
+			m.identities[i].Name = strings.ToLower(identity.Name)
+			m.identities[i].UpdatedAt = time.Now()
+			updated := m.identities[i]
+			return &updated, nil
+		}
+	}
+	return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientNotFound, "identity not found")
+}
+
+// DeleteIdentity deletes an identity.
+func (m *memoryService) DeleteIdentity(zoneID int64, identityID string) (*azmodelszap.Identity, error) {
+	for i, existing := range m.identities {
+		if existing.IdentityID == identityID {
+			m.identities = append(m.identities[:i], m.identities[i+1:]...)
+			return &existing, nil
+		}
+	}
+	return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientNotFound, "identity not found")
+}
+
+// matchIdentities returns the identities matching the fields.
+func (m *memoryService) matchIdentities(fields map[string]any) []azmodelszap.Identity {
+	matches := []azmodelszap.Identity{}
+	for _, identity := range m.identities {
+		if value, ok := fields[azmodelszap.FieldIdentityIdentitySourceID]; ok && identity.IdentitySourceID != value {
+			continue
+		}
+		if value, ok := fields[azmodelszap.FieldIdentityKind]; ok && identity.Kind != value {
+			continue
+		}
+		if value, ok := fields[azmodelszap.FieldIdentityIdentityID]; ok && identity.IdentityID != value {
+			continue
+		}
+		if value, ok := fields[azmodelszap.FieldIdentityName]; ok && !strings.Contains(identity.Name, value.(string)) {
+			continue
+		}
+		if value, ok := fields[azmodels.FieldFetchNamePrefix]; ok && !strings.HasPrefix(identity.Name, value.(string)) {
+			continue
+		}
+		matches = append(matches, identity)
+	}
+	return matches
+}
+
+// FetchIdentities returns all identities.
+func (m *memoryService) FetchIdentities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Identity, error) {
+	if m.maxPageSize > 0 && pageSize > m.maxPageSize {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, "invalid page size")
+	}
+	matches := m.matchIdentities(fields)
+	start := int((page - 1) * pageSize)
+	if start >= len(matches) {
+		return []azmodelszap.Identity{}, nil
+	}
+	return matches[start:min(start+int(pageSize), len(matches))], nil
+}
+
+// CountIdentities counts the identities.
+func (m *memoryService) CountIdentities(zoneID int64, fields map[string]any) (int64, error) {
+	return int64(len(m.matchIdentities(fields))), nil
+}
+
+// newTestServer creates a scim server backed by an in memory service with the given maximum page size.
+func newTestServer(t *testing.T, maxPageSize int) (*httptest.Server, *memoryService) {
+	service := &memoryService{maxPageSize: int32(maxPageSize)}
+	tokens := map[string]string{
+		TokenKey(testZoneID, testIdentitySourceID):      testToken,
+		TokenKey(testZoneID, testOtherIdentitySourceID): testOtherToken,
+	}
+	scimServer, err := NewV2SCIMServer(nil, service, tokens, maxPageSize)
+	assert.Nil(t, err)
+	server := httptest.NewServer(scimServer)
+	t.Cleanup(server.Close)
+	return server, service
+}
+
+// doRequest sends a scim request with the test token and decodes the response body.
+func doRequest(t *testing.T, server *httptest.Server, method string, path string, body any, out any) int {
+	return doRequestWithToken(t, server, testToken, method, path, body, out)
+}
+
+// doRequestWithToken sends a scim request with the given token and decodes the response body.
+func doRequestWithToken(t *testing.T, server *httptest.Server, token string, method string, path string, body any, out any) int {
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		assert.Nil(t, err)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	request, err := http.NewRequest(method, server.URL+path, reader)
+	assert.Nil(t, err)
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("Content-Type", scimContentType)
+	response, err := server.Client().Do(request)
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	if out != nil && response.StatusCode != http.StatusNoContent {
+		assert.Nil(t, json.NewDecoder(response.Body).Decode(out))
+	}
+	return response.StatusCode
+}
+
+// usersPath returns the path of the users of the test scope.
+func usersPath(suffix string) string {
+	return fmt.Sprintf("/scim/v2/zones/%d/identitysources/%s/Users%s", testZoneID, testIdentitySourceID, suffix)
+}
+
+// TestNewV2SCIMServerWithoutToken tests that the tokens are required.
+func TestNewV2SCIMServerWithoutToken(t *testing.T) {
+	assert := assert.New(t)
+	for _, tokens := range []map[string]string{nil, {TokenKey(testZoneID, testIdentitySourceID): ""}} {
+		server, err := NewV2SCIMServer(nil, &memoryService{}, tokens, 0)
+		assert.Nil(server)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrConfigurationGeneric, err))
+	}
+}
+
+// TestSCIMAuthentication tests the bearer token authentication.
+func TestSCIMAuthentication(t *testing.T) {
+	assert := assert.New(t)
+	server, _ := newTestServer(t, 0)
+	for _, header := range []string{"", "Bearer wrong", "Basic " + testToken, "Bearer " + testOtherToken} {
+		request, _ := http.NewRequest(http.MethodGet, server.URL+usersPath(""), nil)
+		if header != "" {
+			request.Header.Set("Authorization", header)
+		}
+		response, err := server.Client().Do(request)
+		assert.Nil(err)
+		response.Body.Close()
+		assert.Equal(http.StatusUnauthorized, response.StatusCode)
+		assert.NotEmpty(response.Header.Get("WWW-Authenticate"))
+	}
+}
+
+// TestSCIMScope tests that the requests are scoped to an existing identity source.
+func TestSCIMScope(t *testing.T) {
+	assert := assert.New(t)
+	server, _ := newTestServer(t, 0)
+	scimErr := &Error{}
+	status := doRequestWithToken(t, server, testOtherToken, http.MethodGet, fmt.Sprintf("/scim/v2/zones/%d/identitysources/%s/Users", testZoneID, testOtherIdentitySourceID), nil, scimErr)
+	assert.Equal(http.StatusNotFound, status)
+	assert.Equal("404", scimErr.Status)
+	status = doRequest(t, server, http.MethodGet, fmt.Sprintf("/scim/v2/zones/%d/identitysources/%s/Users", testZoneID, testOtherIdentitySourceID), nil, scimErr)
+	assert.Equal(http.StatusUnauthorized, status, "the token of an identity source should not grant access to another one")
+	status = doRequest(t, server, http.MethodGet, fmt.Sprintf("/scim/v2/zones/%s/identitysources/%s/Users", "abc", testIdentitySourceID), nil, scimErr)
+	assert.Equal(http.StatusUnauthorized, status)
+	status = doRequest(t, server, http.MethodGet, fmt.Sprintf("/scim/v2/zones/%d/identitysources/%s/Groups", testZoneID, testIdentitySourceID), nil, scimErr)
+	assert.Equal(http.StatusNotImplemented, status)
+	config := &ServiceProviderConfig{}
+	status = doRequest(t, server, http.MethodGet, fmt.Sprintf("/scim/v2/zones/%d/identitysources/%s/ServiceProviderConfig", testZoneID, testIdentitySourceID), nil, config)
+	assert.Equal(http.StatusOK, status)
+	assert.True(config.Patch.Supported)
+	assert.Equal(MaxCount, config.Filter.MaxResults)
+}
+
+// TestSCIMUserLifecycle tests the provisioning of a user from its creation to its deprovisioning.
+func TestSCIMUserLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	server, service := newTestServer(t, 0)
+
+	created := &User{}
+	status := doRequest(t, server, http.MethodPost, usersPath(""), map[string]any{"schemas": []string{SchemaUser}, "userName": "Nicola.Gallo@example.com", "name": map[string]any{"givenName": "Nicola"}}, created)
+	assert.Equal(http.StatusCreated, status)
+	assert.Equal("nicola.gallo@example.com", created.UserName)
+	assert.True(*created.Active)
+	assert.Equal(usersPath("/"+created.ID), created.Meta.Location)
+	assert.Equal(identityKindUser, service.identities[0].Kind)
+	assert.Equal(testIdentitySourceID, service.identities[0].IdentitySourceID)
+
+	scimErr := &Error{}
+	status = doRequest(t, server, http.MethodPost, usersPath(""), map[string]any{"userName": "nicola.gallo@example.com"}, scimErr)
+	assert.Equal(http.StatusConflict, status)
+	assert.Equal(ScimTypeUniqueness, scimErr.ScimType)
+	status = doRequest(t, server, http.MethodPost, usersPath(""), map[string]any{"userName": ""}, scimErr)
+	assert.Equal(http.StatusBadRequest, status)
+
+	user := &User{}
+	status = doRequest(t, server, http.MethodGet, usersPath("/"+created.ID), nil, user)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(created.ID, user.ID)
+
+	patch := map[string]any{
+		"schemas":    []string{SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "replace", "path": "userName", "value": "nicola@example.com"}},
+	}
+	status = doRequest(t, server, http.MethodPatch, usersPath("/"+created.ID), patch, user)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal("nicola@example.com", user.UserName)
+
+	status = doRequest(t, server, http.MethodPut, usersPath("/"+created.ID), map[string]any{"userName": "nicola.gallo@example.com", "active": true}, user)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal("nicola.gallo@example.com", user.UserName)
+
+	patch["Operations"] = []map[string]any{{"op": "remove", "path": "userName"}}
+	status = doRequest(t, server, http.MethodPatch, usersPath("/"+created.ID), patch, scimErr)
+	assert.Equal(http.StatusBadRequest, status)
+	assert.Equal(ScimTypeMutability, scimErr.ScimType)
+
+	patch["Operations"] = []map[string]any{{"op": "Replace", "value": map[string]any{"active": "False"}}}
+	status = doRequest(t, server, http.MethodPatch, usersPath("/"+created.ID), patch, scimErr)
+	assert.Equal(http.StatusBadRequest, status, "a user should not be deactivated")
+	assert.Equal(ScimTypeInvalidValue, scimErr.ScimType)
+	status = doRequest(t, server, http.MethodPut, usersPath("/"+created.ID), map[string]any{"userName": "nicola.gallo@example.com", "active": false}, scimErr)
+	assert.Equal(http.StatusBadRequest, status, "a user should not be deactivated")
+	assert.Len(service.identities, 1, "a deactivation should not delete the identity")
+
+	status = doRequest(t, server, http.MethodDelete, usersPath("/"+created.ID), nil, nil)
+	assert.Equal(http.StatusNoContent, status)
+	assert.Empty(service.identities)
+
+	status = doRequest(t, server, http.MethodDelete, usersPath("/"+created.ID), nil, scimErr)
+	assert.Equal(http.StatusNotFound, status)
+}
+
+// TestSCIMListUsers tests the filtering and the pagination of the users.
+func TestSCIMListUsers(t *testing.T) {
+	assert := assert.New(t)
+	server, service := newTestServer(t, 0)
+	for _, name := range []string{"alice", "bob", "branch-manager", "carol", "dave"} {
+		doRequest(t, server, http.MethodPost, usersPath(""), map[string]any{"userName": name}, nil)
+	}
+	service.identities = append(service.identities, azmodelszap.Identity{IdentityID: "ffffffffffffffffffffffffffffffff", IdentitySourceID: testIdentitySourceID, Kind: "role-actor", Name: "bobby"})
+
+	list := &ListResponse{}
+	status := doRequest(t, server, http.MethodGet, usersPath("?filter="+strings.ReplaceAll(`userName eq "BOB"`, " ", "%20")), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(1, list.TotalResults)
+	assert.Equal("bob", list.Resources[0].UserName)
+
+	status = doRequest(t, server, http.MethodGet, usersPath("?filter="+strings.ReplaceAll(`userName sw "b"`, " ", "%20")+"&count=1"), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(1, list.ItemsPerPage)
+	assert.Equal(2, list.TotalResults)
+
+	status = doRequest(t, server, http.MethodGet, usersPath("?filter="+strings.ReplaceAll(`id eq "`+service.identities[0].IdentityID+`"`, " ", "%20")), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal("alice", list.Resources[0].UserName)
+
+	status = doRequest(t, server, http.MethodGet, usersPath("?startIndex=1&count=2"), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(2, list.ItemsPerPage)
+	assert.Equal(5, list.TotalResults)
+
+	status = doRequest(t, server, http.MethodGet, usersPath("?count=0"), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(0, list.ItemsPerPage)
+	assert.Equal(5, list.TotalResults)
+
+	status = doRequest(t, server, http.MethodGet, usersPath("?startIndex=7&count=2"), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(0, list.ItemsPerPage)
+	assert.Equal(5, list.TotalResults)
+
+	status = doRequest(t, server, http.MethodGet, usersPath("?startIndex=4&count=2"), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal([]string{"carol", "dave"}, []string{list.Resources[0].UserName, list.Resources[1].UserName})
+
+	status = doRequest(t, server, http.MethodGet, usersPath("?startIndex=5&count=2"), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(1, list.ItemsPerPage)
+	assert.Equal(5, list.TotalResults)
+
+	scimErr := &Error{}
+	status = doRequest(t, server, http.MethodGet, usersPath("?filter="+strings.ReplaceAll(`emails co "x"`, " ", "%20")), nil, scimErr)
+	assert.Equal(http.StatusBadRequest, status)
+	assert.Equal(ScimTypeInvalidFilter, scimErr.ScimType)
+}
+
+// TestSCIMListUsersWithMaxPageSize tests that the users are paged internally within the maximum page size of the storage.
+func TestSCIMListUsersWithMaxPageSize(t *testing.T) {
+	assert := assert.New(t)
+	server, _ := newTestServer(t, 2)
+	names := []string{"alice", "bob", "branch-manager", "carol", "dave"}
+	for _, name := range names {
+		doRequest(t, server, http.MethodPost, usersPath(""), map[string]any{"userName": name}, nil)
+	}
+
+	list := &ListResponse{}
+	status := doRequest(t, server, http.MethodGet, usersPath("?startIndex=2&count=3"), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(3, list.ItemsPerPage)
+	assert.Equal(5, list.TotalResults)
+	assert.Equal([]string{"bob", "branch-manager", "carol"}, []string{list.Resources[0].UserName, list.Resources[1].UserName, list.Resources[2].UserName})
+
+	status = doRequest(t, server, http.MethodGet, usersPath(""), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(len(names), list.ItemsPerPage)
+	assert.Equal(len(names), list.TotalResults)
+
+	status = doRequest(t, server, http.MethodGet, usersPath("?filter="+strings.ReplaceAll(`userName eq "dave"`, " ", "%20")), nil, list)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(1, list.TotalResults)
+	assert.Equal("dave", list.Resources[0].UserName)
+}
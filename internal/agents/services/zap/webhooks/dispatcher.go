@@ -0,0 +1,279 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// HeaderEvent is the header carrying the event of the delivery.
+	HeaderEvent = "X-Permguard-Event"
+	// HeaderDelivery is the header carrying the identifier of the delivery.
+	HeaderDelivery = "X-Permguard-Delivery"
+	// HeaderTimestamp is the header carrying the unix timestamp used to sign the delivery.
+	HeaderTimestamp = "X-Permguard-Timestamp"
+	// HeaderSignature is the header carrying the signature of the delivery.
+	HeaderSignature = "X-Permguard-Signature"
+	// SignaturePrefix is the prefix of the signature header value.
+	SignaturePrefix = "sha256="
+
+	// DefaultInterval is the default interval between two dispatch passes.
+	DefaultInterval = 5 * time.Second
+	// DefaultBatchSize is the default number of deliveries sent in a dispatch pass.
+	DefaultBatchSize = int32(100)
+	// DefaultMaxAttempts is the default number of attempts before a delivery is moved to the dead-letter list.
+	DefaultMaxAttempts = int32(8)
+	// DefaultTimeout is the default timeout of a delivery request.
+	DefaultTimeout = 10 * time.Second
+	// DefaultMaxConcurrency is the default number of requests in flight per webhook.
+	DefaultMaxConcurrency = 4
+	// RetryBaseDelay is the delay before the first retry, doubled on every following one.
+	RetryBaseDelay = 10 * time.Second
+	// RetryMaxDelay is the maximum delay between two retries.
+	RetryMaxDelay = time.Hour
+
+	// maxErrorLength is the maximum length of the error recorded for a failed attempt.
+	maxErrorLength = 512
+)
+
+// DeliveryStorage is the storage of the webhook outbox.
+type DeliveryStorage interface {
+	// FetchDueWebhookDeliveries gets the pending webhook deliveries which are due.
+	FetchDueWebhookDeliveries(limit int32) ([]azmodelszap.WebhookDispatch, error)
+	// RecordWebhookDeliveryAttempt records the outcome of a webhook delivery attempt.
+	RecordWebhookDeliveryAttempt(deliveryID int64, status string, statusCode int32, lastError string, retryDelaySeconds int64) (*azmodelszap.WebhookDelivery, error)
+}
+
+// Envelope is the body posted to the webhook endpoint.
+type Envelope struct {
+	DeliveryID int64           `json:"delivery_id"`
+	Event      string          `json:"event"`
+	ZoneID     int64           `json:"zone_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Dispatcher sends the pending webhook deliveries of the outbox.
+type Dispatcher struct {
+	storage        DeliveryStorage
+	logger         *zap.Logger
+	client         *http.Client
+	batchSize      int32
+	maxAttempts    int32
+	maxConcurrency int
+	now            func() time.Time
+}
+
+// NewDispatcher creates a new webhook dispatcher, the batch size is clamped to the maximum page size of the storage.
+func NewDispatcher(storage DeliveryStorage, logger *zap.Logger, maxPageSize int32) (*Dispatcher, error) {
+	if storage == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "webhooks: storage cannot be nil")
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	batchSize := DefaultBatchSize
+	if maxPageSize > 0 {
+		batchSize = min(batchSize, maxPageSize)
+	}
+	return &Dispatcher{
+		storage:        storage,
+		logger:         logger,
+		client:         &http.Client{Timeout: DefaultTimeout},
+		batchSize:      batchSize,
+		maxAttempts:    DefaultMaxAttempts,
+		maxConcurrency: DefaultMaxConcurrency,
+		now:            time.Now,
+	}, nil
+}
+
+// Sign returns the signature of a delivery body for the given secret and timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// RetryDelay returns the delay before the next attempt given the attempts already made.
+func RetryDelay(attempts int32) time.Duration {
+	delay := RetryBaseDelay
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= RetryMaxDelay {
+			return RetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// buildEnvelope builds the body of a delivery.
+func buildEnvelope(delivery *azmodelszap.WebhookDelivery) ([]byte, error) {
+	data := json.RawMessage(delivery.Payload)
+	if !json.Valid(data) {
+		encoded, err := json.Marshal(delivery.Payload)
+		if err != nil {
+			return nil, err
+		}
+		data = encoded
+	}
+	return json.Marshal(Envelope{
+		DeliveryID: delivery.DeliveryID,
+		Event:      delivery.Event,
+		ZoneID:     delivery.ZoneID,
+		CreatedAt:  delivery.CreatedAt,
+		Data:       data,
+	})
+}
+
+// send posts a delivery to the webhook endpoint and returns the response status code.
+func (d *Dispatcher) send(ctx context.Context, dispatch *azmodelszap.WebhookDispatch) (int, error) {
+	body, err := buildEnvelope(&dispatch.Delivery)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dispatch.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, dispatch.Delivery.Event)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(dispatch.Delivery.DeliveryID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(dispatch.Secret, timestamp, body))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// sendResult is the outcome of a delivery request.
+type sendResult struct {
+	statusCode int
+	err        error
+}
+
+// sendAll sends the deliveries in parallel, with at most maxConcurrency requests in flight per webhook.
+func (d *Dispatcher) sendAll(ctx context.Context, dispatches []azmodelszap.WebhookDispatch) []sendResult {
+	results := make([]sendResult, len(dispatches))
+	semaphores := map[string]chan struct{}{}
+	var wg sync.WaitGroup
+	for i := range dispatches {
+		semaphore, ok := semaphores[dispatches[i].Delivery.WebhookID]
+		if !ok {
+			semaphore = make(chan struct{}, d.maxConcurrency)
+			semaphores[dispatches[i].Delivery.WebhookID] = semaphore
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			statusCode, err := d.send(ctx, &dispatches[i])
+			results[i] = sendResult{statusCode: statusCode, err: err}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// record records the outcome of a delivery attempt.
+func (d *Dispatcher) record(dispatch *azmodelszap.WebhookDispatch, statusCode int, err error) error {
+	status := azmodelszap.WebhookDeliveryStatusDelivered
+	lastError := ""
+	retryDelay := time.Duration(0)
+	if err != nil {
+		lastError = err.Error()
+		if len(lastError) > maxErrorLength {
+			lastError = lastError[:maxErrorLength]
+		}
+		attempts := dispatch.Delivery.Attempts + 1
+		if attempts >= d.maxAttempts {
+			status = azmodelszap.WebhookDeliveryStatusDead
+		} else {
+			status = azmodelszap.WebhookDeliveryStatusPending
+			retryDelay = RetryDelay(attempts)
+		}
+		d.logger.Debug("Webhook delivery failed", zap.Int64("delivery_id", dispatch.Delivery.DeliveryID), zap.Int32("attempts", attempts), zap.String("status", status), zap.Error(err))
+	}
+	_, err = d.storage.RecordWebhookDeliveryAttempt(dispatch.Delivery.DeliveryID, status, int32(statusCode), lastError, int64(retryDelay/time.Second))
+	return err
+}
+
+// DispatchDue sends the deliveries which are due and returns the number of attempts made.
+func (d *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	dispatches, err := d.storage.FetchDueWebhookDeliveries(d.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	results := d.sendAll(ctx, dispatches)
+	count := 0
+	for i := range dispatches {
+		if results[i].err != nil && ctx.Err() != nil {
+			continue
+		}
+		if err := d.record(&dispatches[i], results[i].statusCode, results[i].err); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, ctx.Err()
+}
+
+// Run dispatches the due deliveries at every interval until the context is done.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.DispatchDue(ctx); err != nil && ctx.Err() == nil {
+				d.logger.Warn("Webhook dispatch failed", zap.Error(err))
+			}
+		}
+	}
+}
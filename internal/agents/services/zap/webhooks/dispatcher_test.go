@@ -0,0 +1,232 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// attempt is a recorded delivery attempt.
+type attempt struct {
+	deliveryID int64
+	status     string
+	statusCode int32
+	lastError  string
+	retryDelay int64
+}
+
+// memoryStorage is an in memory outbox.
+type memoryStorage struct {
+	dispatches []azmodelszap.WebhookDispatch
+	attempts   []attempt
+	limit      int32
+}
+
+// FetchDueWebhookDeliveries gets the pending webhook deliveries which are due.
+func (m *memoryStorage) FetchDueWebhookDeliveries(limit int32) ([]azmodelszap.WebhookDispatch, error) {
+	m.limit = limit
+	return m.dispatches, nil
+}
+
+// RecordWebhookDeliveryAttempt records the outcome of a webhook delivery attempt.
+func (m *memoryStorage) RecordWebhookDeliveryAttempt(deliveryID int64, status string, statusCode int32, lastError string, retryDelaySeconds int64) (*azmodelszap.WebhookDelivery, error) {
+	m.attempts = append(m.attempts, attempt{deliveryID: deliveryID, status: status, statusCode: statusCode, lastError: lastError, retryDelay: retryDelaySeconds})
+	return &azmodelszap.WebhookDelivery{DeliveryID: deliveryID, Status: status}, nil
+}
+
+// newDispatch creates a dispatch for the given url.
+func newDispatch(url string, attempts int32) azmodelszap.WebhookDispatch {
+	return azmodelszap.WebhookDispatch{
+		Delivery: azmodelszap.WebhookDelivery{
+			DeliveryID: 7,
+			ZoneID:     273165098782,
+			WebhookID:  "2f1c9e0a7b3d4c5e8f6a1b2c3d4e5f60",
+			Event:      azmodelszap.WebhookEventLedgerRefUpdated,
+			Payload:    `{"ledger_id":"c3160a533ab24fbcb1eab7a09fd85f36","old_ref":"0","new_ref":"1"}`,
+			Status:     azmodelszap.WebhookDeliveryStatusPending,
+			Attempts:   attempts,
+		},
+		URL:    url,
+		Secret: "5e3b1f0c2a",
+	}
+}
+
+// TestRetryDelay tests the exponential backoff of the retries.
+func TestRetryDelay(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(10*time.Second, RetryDelay(1))
+	assert.Equal(20*time.Second, RetryDelay(2))
+	assert.Equal(80*time.Second, RetryDelay(4))
+	assert.Equal(RetryMaxDelay, RetryDelay(20))
+}
+
+// TestDispatchDueWithSuccess tests a delivery acknowledged by the receiver.
+func TestDispatchDueWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	var headers http.Header
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	storage := &memoryStorage{dispatches: []azmodelszap.WebhookDispatch{newDispatch(receiver.URL, 0)}}
+	dispatcher, err := NewDispatcher(storage, nil, 0)
+	assert.Nil(err, "error should be nil")
+
+	count, err := dispatcher.DispatchDue(context.Background())
+	assert.Nil(err, "error should be nil")
+	assert.Equal(1, count, "one delivery should be dispatched")
+
+	assert.Equal(azmodelszap.WebhookEventLedgerRefUpdated, headers.Get(HeaderEvent))
+	assert.Equal("7", headers.Get(HeaderDelivery))
+	timestamp, err := strconv.ParseInt(headers.Get(HeaderTimestamp), 10, 64)
+	assert.Nil(err, "timestamp should be valid")
+	assert.Equal(Sign("5e3b1f0c2a", timestamp, body), headers.Get(HeaderSignature), "signature should match")
+
+	var envelope Envelope
+	assert.Nil(json.Unmarshal(body, &envelope), "body should be valid json")
+	assert.Equal(int64(273165098782), envelope.ZoneID)
+	assert.JSONEq(storage.dispatches[0].Delivery.Payload, string(envelope.Data))
+
+	assert.Equal([]attempt{{deliveryID: 7, status: azmodelszap.WebhookDeliveryStatusDelivered, statusCode: http.StatusNoContent}}, storage.attempts)
+}
+
+// TestDispatchDueWithFailures tests the retries and the dead-letter of a failing delivery.
+func TestDispatchDueWithFailures(t *testing.T) {
+	assert := assert.New(t)
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	{ // Test with a retry
+		storage := &memoryStorage{dispatches: []azmodelszap.WebhookDispatch{newDispatch(receiver.URL, 1)}}
+		dispatcher, _ := NewDispatcher(storage, nil, 0)
+		_, err := dispatcher.DispatchDue(context.Background())
+		assert.Nil(err, "error should be nil")
+		assert.Len(storage.attempts, 1)
+		assert.Equal(azmodelszap.WebhookDeliveryStatusPending, storage.attempts[0].status)
+		assert.Equal(int32(http.StatusInternalServerError), storage.attempts[0].statusCode)
+		assert.Equal(int64(20), storage.attempts[0].retryDelay)
+		assert.NotEmpty(storage.attempts[0].lastError)
+	}
+
+	{ // Test with the last attempt
+		storage := &memoryStorage{dispatches: []azmodelszap.WebhookDispatch{newDispatch(receiver.URL, DefaultMaxAttempts-1)}}
+		dispatcher, _ := NewDispatcher(storage, nil, 0)
+		_, err := dispatcher.DispatchDue(context.Background())
+		assert.Nil(err, "error should be nil")
+		assert.Len(storage.attempts, 1)
+		assert.Equal(azmodelszap.WebhookDeliveryStatusDead, storage.attempts[0].status)
+		assert.Equal(int64(0), storage.attempts[0].retryDelay)
+	}
+
+	{ // Test with an unreachable receiver
+		storage := &memoryStorage{dispatches: []azmodelszap.WebhookDispatch{newDispatch("http://127.0.0.1:1/permguard", 0)}}
+		dispatcher, _ := NewDispatcher(storage, nil, 0)
+		_, err := dispatcher.DispatchDue(context.Background())
+		assert.Nil(err, "error should be nil")
+		assert.Len(storage.attempts, 1)
+		assert.Equal(azmodelszap.WebhookDeliveryStatusPending, storage.attempts[0].status)
+		assert.Equal(int32(0), storage.attempts[0].statusCode)
+	}
+}
+
+// TestNewDispatcherBatchSize tests that the batch size is clamped to the maximum page size.
+func TestNewDispatcherBatchSize(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		maxPageSize int32
+		expected    int32
+	}{
+		{0, DefaultBatchSize},
+		{10000, DefaultBatchSize},
+		{10, 10},
+	}
+	for _, test := range tests {
+		storage := &memoryStorage{}
+		dispatcher, err := NewDispatcher(storage, nil, test.maxPageSize)
+		assert.Nil(err, "error should be nil")
+		_, err = dispatcher.DispatchDue(context.Background())
+		assert.Nil(err, "error should be nil")
+		assert.Equal(test.expected, storage.limit, "batch size should be clamped")
+	}
+}
+
+// TestDispatchDueInParallel tests that the deliveries are sent in parallel with bounded requests in flight per webhook.
+func TestDispatchDueInParallel(t *testing.T) {
+	assert := assert.New(t)
+
+	var mutex sync.Mutex
+	inFlight := map[string]int{}
+	maxInFlight := map[string]int{}
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		inFlight[r.URL.Path]++
+		maxInFlight[r.URL.Path] = max(maxInFlight[r.URL.Path], inFlight[r.URL.Path])
+		mutex.Unlock()
+		time.Sleep(50 * time.Millisecond)
+		mutex.Lock()
+		inFlight[r.URL.Path]--
+		mutex.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	storage := &memoryStorage{}
+	for i, webhookID := range []string{"audit", "deployments"} {
+		for j := range 2 * DefaultMaxConcurrency {
+			dispatch := newDispatch(receiver.URL+"/"+webhookID, 0)
+			dispatch.Delivery.DeliveryID = int64(i*100 + j)
+			dispatch.Delivery.WebhookID = webhookID
+			storage.dispatches = append(storage.dispatches, dispatch)
+		}
+	}
+	dispatcher, err := NewDispatcher(storage, nil, 0)
+	assert.Nil(err, "error should be nil")
+
+	count, err := dispatcher.DispatchDue(context.Background())
+	assert.Nil(err, "error should be nil")
+	assert.Equal(len(storage.dispatches), count, "all the deliveries should be dispatched")
+	assert.Len(storage.attempts, len(storage.dispatches))
+	for i, attempt := range storage.attempts {
+		assert.Equal(storage.dispatches[i].Delivery.DeliveryID, attempt.deliveryID, "attempts should be recorded in order")
+		assert.Equal(azmodelszap.WebhookDeliveryStatusDelivered, attempt.status)
+	}
+	for _, webhookID := range []string{"/audit", "/deployments"} {
+		assert.Greater(maxInFlight[webhookID], 1, "deliveries should be sent in parallel")
+		assert.LessOrEqual(maxInFlight[webhookID], DefaultMaxConcurrency, "requests in flight should be bounded per webhook")
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	azctrlzap "github.com/permguard/permguard/internal/agents/services/zap/controllers"
 	azapiv1zap "github.com/permguard/permguard/internal/agents/services/zap/endpoints/api/v1"
+	azscimv2zap "github.com/permguard/permguard/internal/agents/services/zap/endpoints/scim/v2"
 	azruntime "github.com/permguard/permguard/pkg/agents/runtime"
 	azservices "github.com/permguard/permguard/pkg/agents/services"
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
@@ -64,7 +65,7 @@ func (f *ZAPService) GetEndpoints() ([]azservices.EndpointInitializer, error) {
 			if err != nil {
 				return err
 			}
-			controller, err := azctrlzap.NewZAPController(srvCtx, zapCentralStorage)
+			controller, err := azctrlzap.NewZAPController(srvCtx, zapCentralStorage, int32(f.config.GetDataFetchMaxPageSize()))
 			if err != nil {
 				return nil
 			}
@@ -72,7 +73,16 @@ func (f *ZAPService) GetEndpoints() ([]azservices.EndpointInitializer, error) {
 			if err != nil {
 				return err
 			}
-			zapServer, err := azapiv1zap.NewV1ZAPServer(endptCtx, controller)
+			if scimPort := f.config.GetSCIMPort(); scimPort > 0 {
+				scimServer, err := azscimv2zap.NewV2SCIMServer(endptCtx, controller, f.config.GetSCIMTokens(), f.config.GetDataFetchMaxPageSize())
+				if err != nil {
+					return err
+				}
+				if err := scimServer.Serve(scimPort); err != nil {
+					return err
+				}
+			}
+			zapServer, err := azapiv1zap.NewV1ZAPServer(endptCtx, controller, f.config.GetDataBulkMaxRows())
 			azapiv1zap.RegisterV1ZAPServiceServer(grpcServer, zapServer)
 			return err
 		})
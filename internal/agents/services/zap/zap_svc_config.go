@@ -18,15 +18,20 @@ package zap
 
 import (
 	"flag"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/viper"
 
 	azcopier "github.com/permguard/permguard-common/pkg/extensions/copier"
 	azvalidators "github.com/permguard/permguard-common/pkg/extensions/validators"
+	azscimv2zap "github.com/permguard/permguard/internal/agents/services/zap/endpoints/scim/v2"
 	azservices "github.com/permguard/permguard/pkg/agents/services"
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azcorevalidators "github.com/permguard/permguard/pkg/core/validators"
 )
 
 const (
@@ -35,7 +40,10 @@ const (
 	flagSuffixGrpcPort        = "grpc-port"
 	flagCentralEngine         = "engine-central"
 	flagDataFetchMaxPageSize  = "data-fetch-maxpagesize"
+	flagDataBulkMaxRows       = "data-bulk-maxrows"
 	flagEnableDefaultCreation = "data-enable-default-creation"
+	flagSuffixSCIMPort        = "scim-port"
+	flagSuffixSCIMTokens      = "scim-tokens"
 )
 
 // ZAPServiceConfig holds the configuration for the server.
@@ -57,7 +65,10 @@ func (c *ZAPServiceConfig) AddFlags(flagSet *flag.FlagSet) error {
 	flagSet.Int(azoptions.FlagName(flagServerZAPPrefix, flagSuffixGrpcPort), 9091, "port to be used for exposing the zap grpc services")
 	flagSet.String(azoptions.FlagName(flagStorageZAPPrefix, flagCentralEngine), "", "data storage engine to be used for central data; this overrides the --storage-engine-central option")
 	flagSet.Int(azoptions.FlagName(flagServerZAPPrefix, flagDataFetchMaxPageSize), 10000, "maximum number of items to fetch per request")
+	flagSet.Int(azoptions.FlagName(flagServerZAPPrefix, flagDataBulkMaxRows), 10000, "maximum number of rows to upsert per bulk request")
 	flagSet.Bool(azoptions.FlagName(flagServerZAPPrefix, flagEnableDefaultCreation), false, "the creation of default entities (e.g., tenants, identity sources) during data creation")
+	flagSet.Int(azoptions.FlagName(flagServerZAPPrefix, flagSuffixSCIMPort), 0, "port to be used for exposing the zap scim 2.0 http server; 0 disables it")
+	flagSet.String(azoptions.FlagName(flagServerZAPPrefix, flagSuffixSCIMTokens), "", "comma separated list of <zone-id>/<identity-source-id>=<token> bearer tokens required by the zap scim 2.0 http server")
 	return nil
 }
 
@@ -85,13 +96,60 @@ func (c *ZAPServiceConfig) InitFromViper(v *viper.Viper) error {
 		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid data fetch max page size")
 	}
 	c.config[flagDataFetchMaxPageSize] = dataFetchMaxPageSize
+	// retrieve the data bulk max rows
+	flagName = azoptions.FlagName(flagServerZAPPrefix, flagDataBulkMaxRows)
+	dataBulkMaxRows := v.GetInt(flagName)
+	if dataBulkMaxRows <= 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid data bulk max rows")
+	}
+	c.config[flagDataBulkMaxRows] = dataBulkMaxRows
 	// retrieve the enable default creation
 	flagName = azoptions.FlagName(flagServerZAPPrefix, flagEnableDefaultCreation)
 	enableDefaultCreation := v.GetBool(flagName)
 	c.config[flagEnableDefaultCreation] = enableDefaultCreation
+	// retrieve the scim port and tokens
+	flagName = azoptions.FlagName(flagServerZAPPrefix, flagSuffixSCIMPort)
+	scimPort := v.GetInt(flagName)
+	if scimPort != 0 && !azvalidators.IsValidPort(scimPort) {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid scim port")
+	}
+	c.config[flagSuffixSCIMPort] = scimPort
+	flagName = azoptions.FlagName(flagServerZAPPrefix, flagSuffixSCIMTokens)
+	scimTokens, err := parseSCIMTokens(v.GetString(flagName))
+	if err != nil {
+		return err
+	}
+	if scimPort != 0 && len(scimTokens) == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "the scim tokens are required when the scim server is enabled")
+	}
+	c.config[flagSuffixSCIMTokens] = scimTokens
 	return nil
 }
 
+// parseSCIMTokens parses the comma separated list of <zone-id>/<identity-source-id>=<token> scim tokens.
+func parseSCIMTokens(value string) (map[string]string, error) {
+	tokens := map[string]string{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		scope, token, found := strings.Cut(entry, "=")
+		zone, identitySourceID, scoped := strings.Cut(scope, "/")
+		zoneID, err := strconv.ParseInt(zone, 10, 64)
+		if !found || !scoped || err != nil || azcorevalidators.ValidateCodeID("zone", zoneID) != nil ||
+			azcorevalidators.ValidateUUID("identity source", identitySourceID) != nil || token == "" {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("invalid scim token for %q, expected <zone-id>/<identity-source-id>=<token>", scope))
+		}
+		key := azscimv2zap.TokenKey(zoneID, identitySourceID)
+		if _, exists := tokens[key]; exists {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("duplicate scim token for %s", key))
+		}
+		tokens[key] = token
+	}
+	return tokens, nil
+}
+
 // GetConfigData returns the configuration data.
 func (c *ZAPServiceConfig) GetConfigData() map[string]any {
 	return azcopier.CopyMap(c.config)
@@ -112,11 +170,26 @@ func (c *ZAPServiceConfig) GetDataFetchMaxPageSize() int {
 	return c.config[flagDataFetchMaxPageSize].(int)
 }
 
+// GetDataBulkMaxRows returns the maximum number of rows to upsert per bulk request.
+func (c *ZAPServiceConfig) GetDataBulkMaxRows() int {
+	return c.config[flagDataBulkMaxRows].(int)
+}
+
 // GetEnabledDefaultCreation return if the default creation is enabled.
 func (c *ZAPServiceConfig) GetEnabledDefaultCreation() bool {
 	return c.config[flagEnableDefaultCreation].(bool)
 }
 
+// GetSCIMPort returns the port of the scim server, 0 if it is disabled.
+func (c *ZAPServiceConfig) GetSCIMPort() int {
+	return c.config[flagSuffixSCIMPort].(int)
+}
+
+// GetSCIMTokens returns the bearer tokens of the scim server keyed by zone and identity source.
+func (c *ZAPServiceConfig) GetSCIMTokens() map[string]string {
+	return c.config[flagSuffixSCIMTokens].(map[string]string)
+}
+
 // GetService returns the service kind.
 func (c *ZAPServiceConfig) GetService() azservices.ServiceKind {
 	return c.serviceKind
@@ -24,10 +24,13 @@ import (
 	azicliauthn "github.com/permguard/permguard/internal/cli/porcelaincommands/authn"
 	azicliauthz "github.com/permguard/permguard/internal/cli/porcelaincommands/authz"
 	azicliconfigs "github.com/permguard/permguard/internal/cli/porcelaincommands/configs"
+	azicliwebhooks "github.com/permguard/permguard/internal/cli/porcelaincommands/webhooks"
 	azicliwks "github.com/permguard/permguard/internal/cli/porcelaincommands/workspace"
 	aziclizones "github.com/permguard/permguard/internal/cli/porcelaincommands/zones"
 	azlang "github.com/permguard/permguard/pkg/authz/languages"
 	azcli "github.com/permguard/permguard/pkg/cli"
+	azplugincedar "github.com/permguard/permguard/plugin/languages/cedar"
+	azpluginrbacyaml "github.com/permguard/permguard/plugin/languages/rbacyaml"
 )
 
 // CommunityCliInitializer  is the community cli initializer.
@@ -54,16 +57,25 @@ func (s *CommunityCliInitializer) GetCliCommands(deps azcli.CliDependenciesProvi
 	authnCmd := azicliauthn.CreateCommandForAuthN(deps, v)
 	authzCmd := azicliauthz.CreateCommandForAuthZ(deps, v)
 	configCmd := azicliconfigs.CreateCommandForConfig(deps, v)
+	webhooksCmd := azicliwebhooks.CreateCommandForWebhooks(deps, v)
 	wksCmds := azicliwks.CreateCommandsForWorkspace(deps, v)
 	return append([]*cobra.Command{
 		zonesCmd,
 		authnCmd,
 		authzCmd,
+		webhooksCmd,
 		configCmd,
 	}, wksCmds...), nil
 }
 
 // GetLanguageFactory returns the language factory.
 func (s *CommunityCliInitializer) GetLanguageFactory() (azlang.LanguageFactory, error) {
-	return NewCommunityLanguageFactory()
+	registry := azlang.DefaultLanguageRegistry()
+	if err := azplugincedar.RegisterCedarLanguage(registry); err != nil {
+		return nil, err
+	}
+	if err := azpluginrbacyaml.RegisterRBACYAMLLanguage(registry); err != nil {
+		return nil, err
+	}
+	return registry, nil
 }
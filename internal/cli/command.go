@@ -58,7 +58,7 @@ func Run(cliInitializer azcli.CliInitializer) {
 	if err != nil {
 		os.Exit(1)
 	}
-	depsProvider, err := aziclicommon.NewCliDependenciesProvider(langFct)
+	depsProvider, err := aziclicommon.NewCliDependenciesProvider(langFct, v)
 	if err != nil {
 		os.Exit(1)
 	}
@@ -79,8 +79,10 @@ func Run(cliInitializer azcli.CliInitializer) {
 	}
 
 	command.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "workdir")
-	command.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, "terminal", "output format")
+	command.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, "terminal", "output format: terminal, json, yaml, table or template=<go template>")
 	command.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, false, "true for verbose output")
+	command.PersistentFlags().String(aziclicommon.FlagContext, "", "cli context to use instead of the active one")
+	v.BindPFlag(aziclicommon.ConfigKeyContext, command.PersistentFlags().Lookup(aziclicommon.FlagContext))
 
 	command.AddCommand(azclicommons.CreateCommandForVersion(depsProvider, v))
 
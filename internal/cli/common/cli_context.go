@@ -18,12 +18,14 @@ package common
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	azvalidators "github.com/permguard/permguard-common/pkg/extensions/validators"
+	aziclients "github.com/permguard/permguard/internal/transport/clients"
 	azcli "github.com/permguard/permguard/pkg/cli"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
@@ -41,7 +43,7 @@ func CreateContextAndPrinter(deps azcli.CliDependenciesProvider, cmd *cobra.Comm
 	if err != nil {
 		return nil, nil, err
 	}
-	printer, err := deps.CreatePrinter(ctx.IsVerbose(), ctx.GetOutput())
+	printer, err := deps.CreatePrinter(ctx.IsVerbose(), ctx.GetOutputSpec())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -50,10 +52,12 @@ func CreateContextAndPrinter(deps azcli.CliDependenciesProvider, cmd *cobra.Comm
 
 // CliCommandContext is the context for the Cli.
 type CliCommandContext struct {
-	v       *viper.Viper
-	workDir string
-	verbose bool
-	output  string
+	v          *viper.Viper
+	workDir    string
+	verbose    bool
+	output     string
+	outputSpec string
+	cliContext *CliContextProfile
 }
 
 // newCliContext creates a new CliContext.
@@ -73,8 +77,9 @@ func newCliContext(cmd *cobra.Command, v *viper.Viper) (*CliCommandContext, erro
 	if err != nil {
 		return nil, err
 	}
-	ctx.output = strings.ToUpper(strings.TrimSpace(output))
-	if ctx.output != azcli.OutputTerminal && ctx.output != azcli.OutputJSON {
+	ctx.outputSpec = strings.TrimSpace(output)
+	ctx.output, _, err = azcli.ParseOutput(ctx.outputSpec)
+	if err != nil {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliDirectoryOperation, fmt.Sprintf("%s is an invalid output", output))
 	}
 	verbose, err := cmd.Flags().GetBool(FlagVerbose)
@@ -82,9 +87,31 @@ func newCliContext(cmd *cobra.Command, v *viper.Viper) (*CliCommandContext, erro
 		return nil, err
 	}
 	ctx.verbose = verbose
+	cliContext, err := ReadActiveCliContextProfile(v)
+	if err != nil {
+		return nil, err
+	}
+	ctx.cliContext = cliContext
+	if cliContext != nil && cliContext.ZoneID > 0 && !isZonesCommand(cmd) {
+		if flag := cmd.Flags().Lookup(FlagCommonZoneID); flag != nil && !flag.Changed {
+			if err := cmd.Flags().Set(FlagCommonZoneID, strconv.FormatInt(cliContext.ZoneID, 10)); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return ctx, nil
 }
 
+// isZonesCommand returns true if the command manages zones, the zone id of these commands is never defaulted.
+func isZonesCommand(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c.Name() == "zones" {
+			return true
+		}
+	}
+	return false
+}
+
 // GetClientVersion returns the client version.
 func (c *CliCommandContext) GetClientVersion() (string, map[string]any) {
 	if Version == "" {
@@ -119,7 +146,12 @@ func (c *CliCommandContext) GetOutput() string {
 	return c.output
 }
 
-// IsTerminalOutput returns true if the output is json.
+// GetOutputSpec returns the output specification including the template, if any.
+func (c *CliCommandContext) GetOutputSpec() string {
+	return c.outputSpec
+}
+
+// IsTerminalOutput returns true if the output is terminal.
 func (c *CliCommandContext) IsTerminalOutput() bool {
 	return c.GetOutput() == azcli.OutputTerminal
 }
@@ -134,9 +166,9 @@ func (c *CliCommandContext) IsVerboseTerminalOutput() bool {
 	return c.IsTerminalOutput() && c.IsVerbose()
 }
 
-// IsJSONOutput returns true if the output is json.
+// IsJSONOutput returns true if the output is rendered from the json document (json, yaml, table or template).
 func (c *CliCommandContext) IsJSONOutput() bool {
-	return c.GetOutput() == azcli.OutputJSON
+	return azcli.IsStructuredOutput(c.GetOutput())
 }
 
 // IsVerboseJSONOutput returns true if the output is json and verbosity is enabled.
@@ -149,8 +181,29 @@ func (c *CliCommandContext) GetWorkDir() string {
 	return c.workDir
 }
 
+// GetCliContextName returns the name of the active cli context, it is empty when no context is active.
+func (c *CliCommandContext) GetCliContextName() string {
+	if c.cliContext == nil {
+		return ""
+	}
+	return c.cliContext.Name
+}
+
+// GetCliContextProfile returns the active cli context, it is nil when no context is active.
+func (c *CliCommandContext) GetCliContextProfile() *CliContextProfile {
+	return c.cliContext
+}
+
+// GetGrpcClientConfig returns the transport configuration of the gRPC clients of the active cli context.
+func (c *CliCommandContext) GetGrpcClientConfig() *aziclients.GrpcClientConfig {
+	return c.cliContext.GrpcClientConfig()
+}
+
 // GetZAPTarget returns the zap target.
 func (c *CliCommandContext) GetZAPTarget() (string, error) {
+	if c.cliContext != nil && c.cliContext.ZAPTarget != "" {
+		return c.cliContext.ZAPTarget, nil
+	}
 	target := c.v.Get(azoptions.FlagName(FlagPrefixZAP, FlagSuffixZAPTarget))
 	if target == nil {
 		return "", azerrors.WrapHandledSysError(azerrors.ErrCliConfiguration, fmt.Errorf("zap target is not set"))
@@ -160,6 +213,9 @@ func (c *CliCommandContext) GetZAPTarget() (string, error) {
 
 // GetPAPTarget returns the pap target.
 func (c *CliCommandContext) GetPAPTarget() (string, error) {
+	if c.cliContext != nil && c.cliContext.PAPTarget != "" {
+		return c.cliContext.PAPTarget, nil
+	}
 	target := c.v.Get(azoptions.FlagName(FlagPrefixPAP, FlagSuffixPAPTarget))
 	if target == nil {
 		return "", azerrors.WrapHandledSysError(azerrors.ErrCliConfiguration, fmt.Errorf("pap target is not set"))
@@ -169,6 +225,9 @@ func (c *CliCommandContext) GetPAPTarget() (string, error) {
 
 // GetPDPTarget returns the pdp target.
 func (c *CliCommandContext) GetPDPTarget() (string, error) {
+	if c.cliContext != nil && c.cliContext.PDPTarget != "" {
+		return c.cliContext.PDPTarget, nil
+	}
 	target := c.v.Get(azoptions.FlagName(FlagPrefixPDP, FlagSuffixPDPTarget))
 	if target == nil {
 		return "", azerrors.WrapHandledSysError(azerrors.ErrCliConfiguration, fmt.Errorf("pdp target is not set"))
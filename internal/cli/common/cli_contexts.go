@@ -0,0 +1,210 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	aziclients "github.com/permguard/permguard/internal/transport/clients"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azvalidators "github.com/permguard/permguard/pkg/core/validators"
+)
+
+const (
+	// ConfigKeyContext is the config key of the cli context selected with the context flag.
+	ConfigKeyContext = "context"
+	// configKeyActiveContext is the config key of the active cli context stored in the config file.
+	configKeyActiveContext = "active-context"
+	// configKeyContexts is the config key of the cli contexts.
+	configKeyContexts = "contexts"
+	// cliContextTokenFileMode is the mode of the config file storing the token of a cli context.
+	cliContextTokenFileMode os.FileMode = 0o600
+)
+
+// CliContextProfile is a named set of cli settings for an environment.
+type CliContextProfile struct {
+	Name          string `json:"name" mapstructure:"-"`
+	ZAPTarget     string `json:"zap_target,omitempty" mapstructure:"zap-target"`
+	PAPTarget     string `json:"pap_target,omitempty" mapstructure:"pap-target"`
+	PDPTarget     string `json:"pdp_target,omitempty" mapstructure:"pdp-target"`
+	ZoneID        int64  `json:"zone_id,omitempty" mapstructure:"zone-id"`
+	TLS           bool   `json:"tls" mapstructure:"tls"`
+	TLSCAFile     string `json:"tls_ca_file,omitempty" mapstructure:"tls-ca-file"`
+	TLSSkipVerify bool   `json:"tls_skip_verify,omitempty" mapstructure:"tls-skip-verify"`
+	Token         string `json:"-" mapstructure:"token"`
+}
+
+// HasToken returns true if the cli context has a token.
+func (p *CliContextProfile) HasToken() bool {
+	return p != nil && p.Token != ""
+}
+
+// GrpcClientConfig returns the transport configuration of the gRPC clients, nil is returned for a nil cli context.
+func (p *CliContextProfile) GrpcClientConfig() *aziclients.GrpcClientConfig {
+	if p == nil {
+		return nil
+	}
+	return &aziclients.GrpcClientConfig{
+		TLS:           p.TLS,
+		TLSCAFile:     p.TLSCAFile,
+		TLSSkipVerify: p.TLSSkipVerify,
+		Token:         p.Token,
+	}
+}
+
+// settings returns the config settings of the cli context.
+func (p *CliContextProfile) settings() map[string]any {
+	settings := map[string]any{
+		"tls":             p.TLS,
+		"tls-skip-verify": p.TLSSkipVerify,
+	}
+	for key, value := range map[string]string{
+		"zap-target":  p.ZAPTarget,
+		"pap-target":  p.PAPTarget,
+		"pdp-target":  p.PDPTarget,
+		"tls-ca-file": p.TLSCAFile,
+		"token":       p.Token,
+	} {
+		if value != "" {
+			settings[key] = value
+		}
+	}
+	if p.ZoneID > 0 {
+		settings["zone-id"] = p.ZoneID
+	}
+	return settings
+}
+
+// CliContextConfigKey returns the config key of a cli context or of one of its settings.
+func CliContextConfigKey(name string, keys ...string) string {
+	return strings.Join(append([]string{configKeyContexts, name}, keys...), ".")
+}
+
+// ValidateCliContextName validates the name of a cli context.
+func ValidateCliContextName(name string) error {
+	if err := azvalidators.ValidateName("context", name); err != nil {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("cli: invalid context name %q", name))
+	}
+	if strings.Contains(name, ".") {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("cli: the context name %q cannot contain dots", name))
+	}
+	return nil
+}
+
+// GetActiveCliContextName returns the name of the active cli context, the context flag overrides the one stored in the config.
+func GetActiveCliContextName(v *viper.Viper) string {
+	if v == nil {
+		return ""
+	}
+	if name := strings.TrimSpace(v.GetString(ConfigKeyContext)); name != "" {
+		return name
+	}
+	return getStoredActiveCliContextName(v)
+}
+
+// getStoredActiveCliContextName returns the name of the active cli context stored in the config, ignoring the context flag.
+func getStoredActiveCliContextName(v *viper.Viper) string {
+	return strings.TrimSpace(v.GetString(configKeyActiveContext))
+}
+
+// ReadCliContextProfile reads a cli context from the config.
+func ReadCliContextProfile(v *viper.Viper, name string) (*CliContextProfile, error) {
+	if _, ok := v.GetStringMap(configKeyContexts)[name]; !ok {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliRecordNotFound, fmt.Sprintf("cli: the context %s does not exist", name))
+	}
+	profile := &CliContextProfile{}
+	if err := v.UnmarshalKey(CliContextConfigKey(name), profile); err != nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliConfiguration, fmt.Sprintf("cli: the context %s is malformed", name))
+	}
+	profile.Name = name
+	return profile, nil
+}
+
+// ReadCliContextProfiles reads all the cli contexts from the config sorted by name.
+func ReadCliContextProfiles(v *viper.Viper) ([]*CliContextProfile, error) {
+	names := []string{}
+	for name := range v.GetStringMap(configKeyContexts) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	profiles := make([]*CliContextProfile, 0, len(names))
+	for _, name := range names {
+		profile, err := ReadCliContextProfile(v, name)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// ReadActiveCliContextProfile reads the active cli context, nil is returned when no context is active.
+func ReadActiveCliContextProfile(v *viper.Viper) (*CliContextProfile, error) {
+	name := GetActiveCliContextName(v)
+	if name == "" {
+		return nil, nil
+	}
+	return ReadCliContextProfile(v, name)
+}
+
+// WriteCliContextProfile writes a cli context to the config, the config file is made readable by the owner only when the context has a token.
+func WriteCliContextProfile(v *viper.Viper, profile *CliContextProfile) error {
+	if err := ValidateCliContextName(profile.Name); err != nil {
+		return err
+	}
+	if err := azoptions.OverrideViperFromConfig(v, map[string]any{CliContextConfigKey(profile.Name): profile.settings()}); err != nil {
+		return err
+	}
+	if !profile.HasToken() {
+		return nil
+	}
+	if err := os.Chmod(v.ConfigFileUsed(), cliContextTokenFileMode); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, fmt.Sprintf("cli: the permissions of the config file %s cannot be restricted", v.ConfigFileUsed()), err)
+	}
+	return nil
+}
+
+// SetActiveCliContextProfile stores the name of the active cli context in the config.
+func SetActiveCliContextProfile(v *viper.Viper, name string) error {
+	return azoptions.OverrideViperFromConfig(v, map[string]any{configKeyActiveContext: name})
+}
+
+// UseCliContextProfile sets the active cli context in the config.
+func UseCliContextProfile(v *viper.Viper, name string) error {
+	if _, err := ReadCliContextProfile(v, name); err != nil {
+		return err
+	}
+	return SetActiveCliContextProfile(v, name)
+}
+
+// DeleteCliContextProfile deletes a cli context from the config, the context is deactivated when it is the active one.
+func DeleteCliContextProfile(v *viper.Viper, name string) error {
+	if _, err := ReadCliContextProfile(v, name); err != nil {
+		return err
+	}
+	keys := []string{CliContextConfigKey(name)}
+	if getStoredActiveCliContextName(v) == name {
+		keys = append(keys, configKeyActiveContext)
+	}
+	return azoptions.DeleteViperConfigKeys(v, keys...)
+}
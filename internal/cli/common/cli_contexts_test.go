@@ -0,0 +1,179 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+)
+
+// newCliContextTestViper creates a viper backed by a config file in a temporary home directory.
+func newCliContextTestViper(t *testing.T) *viper.Viper {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	v, err := azoptions.NewViperFromConfig(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(home, ".permguard", "config.toml"), v.ConfigFileUsed())
+	return v
+}
+
+// reloadCliContextTestViper reloads the viper from the config file.
+func reloadCliContextTestViper(t *testing.T) *viper.Viper {
+	v, err := azoptions.NewViperFromConfig(nil)
+	assert.Nil(t, err)
+	return v
+}
+
+// TestCliContextProfiles tests the lifecycle of the cli contexts.
+func TestCliContextProfiles(t *testing.T) {
+	assert := assert.New(t)
+	v := newCliContextTestViper(t)
+	assert.Nil(azoptions.OverrideViperFromConfig(v, map[string]any{"zap-target": "localhost:9091"}))
+
+	dev := &CliContextProfile{Name: "dev", ZAPTarget: "localhost:9091", PAPTarget: "localhost:9092", PDPTarget: "localhost:9094"}
+	prod := &CliContextProfile{Name: "prod", ZAPTarget: "zap.example.com:443", ZoneID: 273165098782, TLS: true, Token: "secret"}
+	assert.Nil(WriteCliContextProfile(v, dev))
+	assert.Nil(WriteCliContextProfile(reloadCliContextTestViper(t), prod))
+	assert.NotNil(WriteCliContextProfile(v, &CliContextProfile{Name: "my.context"}))
+
+	v = reloadCliContextTestViper(t)
+	profiles, err := ReadCliContextProfiles(v)
+	assert.Nil(err)
+	assert.Equal([]*CliContextProfile{dev, prod}, profiles)
+	assert.Equal("localhost:9091", v.GetString("zap-target"))
+
+	active, err := ReadActiveCliContextProfile(v)
+	assert.Nil(err)
+	assert.Nil(active)
+	assert.NotNil(UseCliContextProfile(v, "staging"))
+	assert.Nil(UseCliContextProfile(v, "prod"))
+
+	v = reloadCliContextTestViper(t)
+	active, err = ReadActiveCliContextProfile(v)
+	assert.Nil(err)
+	assert.Equal(prod, active)
+	assert.True(active.HasToken())
+	assert.Equal("secret", active.GrpcClientConfig().Token)
+
+	assert.Nil(DeleteCliContextProfile(v, "prod"))
+	v = reloadCliContextTestViper(t)
+	assert.Equal("", GetActiveCliContextName(v))
+	profiles, err = ReadCliContextProfiles(v)
+	assert.Nil(err)
+	assert.Equal([]*CliContextProfile{dev}, profiles)
+	assert.NotNil(DeleteCliContextProfile(v, "prod"))
+}
+
+// TestCliContextProfileWithTokenFileMode tests that the config file is readable by the owner only once a context has a token.
+func TestCliContextProfileWithTokenFileMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the file modes are not supported on windows")
+	}
+	assert := assert.New(t)
+	v := newCliContextTestViper(t)
+
+	assert.Nil(WriteCliContextProfile(v, &CliContextProfile{Name: "dev", ZAPTarget: "localhost:9091"}))
+	assert.Nil(os.Chmod(v.ConfigFileUsed(), 0o644))
+
+	assert.Nil(WriteCliContextProfile(v, &CliContextProfile{Name: "prod", ZAPTarget: "zap.example.com:443", Token: "secret"}))
+	info, err := os.Stat(v.ConfigFileUsed())
+	assert.Nil(err)
+	assert.Equal(os.FileMode(0o600), info.Mode().Perm())
+
+	assert.Nil(UseCliContextProfile(reloadCliContextTestViper(t), "prod"))
+	info, err = os.Stat(v.ConfigFileUsed())
+	assert.Nil(err)
+	assert.Equal(os.FileMode(0o600), info.Mode().Perm(), "the mode should be kept when the config file is rewritten")
+}
+
+// TestCliCommandContextWithCliContext tests that the active cli context overrides the targets and defaults the zone id.
+func TestCliCommandContextWithCliContext(t *testing.T) {
+	assert := assert.New(t)
+	v := viper.New()
+	v.Set("pap-target", "localhost:9092")
+	v.Set(ConfigKeyContext, "prod")
+	v.Set(CliContextConfigKey("prod"), map[string]any{"zap-target": "zap.example.com:443", "zone-id": 273165098782})
+
+	newCommand := func(name string) *cobra.Command {
+		cmd := &cobra.Command{Use: name}
+		cmd.Flags().String(FlagWorkingDirectory, os.TempDir(), "")
+		cmd.Flags().String(FlagOutput, "terminal", "")
+		cmd.Flags().Bool(FlagVerbose, false, "")
+		cmd.Flags().Int64(FlagCommonZoneID, 0, "")
+		return cmd
+	}
+	cmd := newCommand("tenants")
+	ctx, err := newCliContext(cmd, v)
+	assert.Nil(err)
+	assert.Equal("prod", ctx.GetCliContextName())
+	zapTarget, _ := ctx.GetZAPTarget()
+	assert.Equal("zap.example.com:443", zapTarget)
+	papTarget, _ := ctx.GetPAPTarget()
+	assert.Equal("localhost:9092", papTarget)
+	zoneID, _ := cmd.Flags().GetInt64(FlagCommonZoneID)
+	assert.Equal(int64(273165098782), zoneID)
+
+	zones := newCommand("zones")
+	_, err = newCliContext(zones, v)
+	assert.Nil(err)
+	zoneID, _ = zones.Flags().GetInt64(FlagCommonZoneID)
+	assert.Equal(int64(0), zoneID)
+
+	v.Set(ConfigKeyContext, "staging")
+	_, err = newCliContext(newCommand("tenants"), v)
+	assert.NotNil(err)
+}
+
+// TestCliContextProfilesWithContextFlag tests that the context flag does not change the active cli context stored in the config.
+func TestCliContextProfilesWithContextFlag(t *testing.T) {
+	assert := assert.New(t)
+	v := newCliContextTestViper(t)
+	assert.Nil(WriteCliContextProfile(v, &CliContextProfile{Name: "dev", ZAPTarget: "localhost:9091"}))
+	assert.Nil(WriteCliContextProfile(reloadCliContextTestViper(t), &CliContextProfile{Name: "prod", ZAPTarget: "zap.example.com:443"}))
+	assert.Nil(UseCliContextProfile(reloadCliContextTestViper(t), "prod"))
+
+	withContextFlag := func(name string) *viper.Viper {
+		v := reloadCliContextTestViper(t)
+		command := &cobra.Command{Use: "permguard"}
+		command.PersistentFlags().String(FlagContext, "", "")
+		assert.Nil(command.PersistentFlags().Set(FlagContext, name))
+		assert.Nil(v.BindPFlag(ConfigKeyContext, command.PersistentFlags().Lookup(FlagContext)))
+		return v
+	}
+
+	v = withContextFlag("dev")
+	assert.Equal("dev", GetActiveCliContextName(v), "the context flag should override the active cli context")
+	assert.Nil(DeleteCliContextProfile(v, "dev"))
+	assert.Equal("prod", GetActiveCliContextName(reloadCliContextTestViper(t)), "deleting the flagged context should keep the active one")
+
+	assert.Nil(WriteCliContextProfile(reloadCliContextTestViper(t), &CliContextProfile{Name: "dev", ZAPTarget: "localhost:9091"}))
+	v = withContextFlag("dev")
+	assert.Nil(DeleteCliContextProfile(v, "prod"))
+	assert.Equal("", GetActiveCliContextName(reloadCliContextTestViper(t)), "deleting the active context should deactivate it")
+
+	v = withContextFlag("prod")
+	assert.Nil(UseCliContextProfile(v, "dev"))
+	assert.Equal("dev", GetActiveCliContextName(reloadCliContextTestViper(t)), "the context flag should not be stored as the active context")
+}
@@ -17,6 +17,8 @@
 package common
 
 import (
+	"github.com/spf13/viper"
+
 	aziclients "github.com/permguard/permguard/internal/transport/clients"
 	azlang "github.com/permguard/permguard/pkg/authz/languages"
 	azcli "github.com/permguard/permguard/pkg/cli"
@@ -26,6 +28,7 @@ import (
 // cliDependencies implements the Cli dependencies.
 type cliDependencies struct {
 	langFactory azlang.LanguageFactory
+	v           *viper.Viper
 }
 
 // CreatePrinter creates a new printer.
@@ -36,17 +39,29 @@ func (c *cliDependencies) CreatePrinter(verbose bool, output string) (azcli.CliP
 
 // CreateGrpcZAPClient creates a new gRPC client for the ZAP service.
 func (c *cliDependencies) CreateGrpcZAPClient(zapTarget string) (azclients.GrpcZAPClient, error) {
-	return aziclients.NewGrpcZAPClient(zapTarget)
+	cliContext, err := ReadActiveCliContextProfile(c.v)
+	if err != nil {
+		return nil, err
+	}
+	return aziclients.NewGrpcZAPClientWithConfig(zapTarget, cliContext.GrpcClientConfig())
 }
 
 // CreateGrpcPAPClient creates a new gRPC client for the PAP service.
 func (c *cliDependencies) CreateGrpcPAPClient(zapTarget string) (azclients.GrpcPAPClient, error) {
-	return aziclients.NewGrpcPAPClient(zapTarget)
+	cliContext, err := ReadActiveCliContextProfile(c.v)
+	if err != nil {
+		return nil, err
+	}
+	return aziclients.NewGrpcPAPClientWithConfig(zapTarget, cliContext.GrpcClientConfig())
 }
 
 // CreateGrpcPDPClient creates a new gRPC client for the PDP service.
 func (c *cliDependencies) CreateGrpcPDPClient(zapTarget string) (azclients.GrpcPDPClient, error) {
-	return aziclients.NewGrpcPDPClient(zapTarget)
+	cliContext, err := ReadActiveCliContextProfile(c.v)
+	if err != nil {
+		return nil, err
+	}
+	return aziclients.NewGrpcPDPClientWithConfig(zapTarget, cliContext.GrpcClientConfig())
 }
 
 // CreateGrpcPAPClient creates a new gRPC client for the PAP service.
@@ -54,9 +69,10 @@ func (c *cliDependencies) GetLanguageFactory() (azlang.LanguageFactory, error) {
 	return c.langFactory, nil
 }
 
-// NewCliDependenciesProvider creates a new CliDependenciesProvider.
-func NewCliDependenciesProvider(langFactory azlang.LanguageFactory) (azcli.CliDependenciesProvider, error) {
+// NewCliDependenciesProvider creates a new CliDependenciesProvider, the viper is used to read the transport settings of the active cli context.
+func NewCliDependenciesProvider(langFactory azlang.LanguageFactory, v *viper.Viper) (azcli.CliDependenciesProvider, error) {
 	return &cliDependencies{
 		langFactory: langFactory,
+		v:           v,
 	}, nil
 }
@@ -31,6 +31,7 @@ const (
 	FlagOutputShort           = "o"
 	FlagVerbose               = "verbose"
 	FlagVerboseShort          = "v"
+	FlagContext               = "context"
 	FlagCommonPage            = "page"
 	FlagCommonPageShort       = "p"
 	FlagCommonPageSize        = "size"
@@ -41,6 +42,13 @@ const (
 	FlagCommonDescription     = "description"
 	FlagCommonFile            = "file"
 	FlagCommonFileShort       = "f"
+	FlagCommonCursor          = "cursor"
+	FlagCommonSortBy          = "sort-by"
+	FlagCommonSortOrder       = "sort-order"
+	FlagCommonNamePrefix      = "name-prefix"
+	FlagCommonCreatedAfter    = "created-after"
+	FlagCommonCreatedBefore   = "created-before"
+	FlagCommonAll             = "all"
 	FlagPrefixZAP             = "zap"
 	FlagSuffixZAPTarget       = "target"
 	FlagPrefixPAP             = "pap"
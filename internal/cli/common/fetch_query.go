@@ -0,0 +1,109 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+)
+
+// fetchQueryTimeLayouts are the layouts accepted by the creation time filters.
+var fetchQueryTimeLayouts = []string{time.RFC3339Nano, "2006-01-02"}
+
+// AddFetchQueryFlags adds the cursor pagination, sorting and filtering flags to a list command.
+func AddFetchQueryFlags(command *cobra.Command, v *viper.Viper, commandName string, entity string) {
+	command.Flags().String(FlagCommonCursor, "", "continue the results from the cursor of a previous page")
+	v.BindPFlag(azoptions.FlagName(commandName, FlagCommonCursor), command.Flags().Lookup(FlagCommonCursor))
+	command.Flags().String(FlagCommonSortBy, "", fmt.Sprintf("sort results by %s, %s or %s", azmodels.SortByID, azmodels.SortByName, azmodels.SortByCreatedAt))
+	v.BindPFlag(azoptions.FlagName(commandName, FlagCommonSortBy), command.Flags().Lookup(FlagCommonSortBy))
+	command.Flags().String(FlagCommonSortOrder, "", fmt.Sprintf("sort results in %s or %s order", azmodels.SortOrderAsc, azmodels.SortOrderDesc))
+	v.BindPFlag(azoptions.FlagName(commandName, FlagCommonSortOrder), command.Flags().Lookup(FlagCommonSortOrder))
+	command.Flags().String(FlagCommonNamePrefix, "", fmt.Sprintf("filter results by %s name prefix", entity))
+	v.BindPFlag(azoptions.FlagName(commandName, FlagCommonNamePrefix), command.Flags().Lookup(FlagCommonNamePrefix))
+	command.Flags().String(FlagCommonCreatedAfter, "", fmt.Sprintf("filter results by %s created at or after a time (RFC3339 or YYYY-MM-DD)", entity))
+	v.BindPFlag(azoptions.FlagName(commandName, FlagCommonCreatedAfter), command.Flags().Lookup(FlagCommonCreatedAfter))
+	command.Flags().String(FlagCommonCreatedBefore, "", fmt.Sprintf("filter results by %s created before a time (RFC3339 or YYYY-MM-DD)", entity))
+	v.BindPFlag(azoptions.FlagName(commandName, FlagCommonCreatedBefore), command.Flags().Lookup(FlagCommonCreatedBefore))
+	command.Flags().Bool(FlagCommonAll, false, "fetch all the pages following the cursors")
+	v.BindPFlag(azoptions.FlagName(commandName, FlagCommonAll), command.Flags().Lookup(FlagCommonAll))
+}
+
+// parseFetchQueryTime parses a creation time filter.
+func parseFetchQueryTime(flag string, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range fetchQueryTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("invalid %s time %s", flag, value))
+}
+
+// BuildFetchQuery builds the fetch query from the flags of a list command and returns whether all the pages have to be fetched.
+func BuildFetchQuery(v *viper.Viper, commandName string) (*azmodels.FetchQuery, bool, error) {
+	query := &azmodels.FetchQuery{
+		Cursor:     v.GetString(azoptions.FlagName(commandName, FlagCommonCursor)),
+		SortBy:     v.GetString(azoptions.FlagName(commandName, FlagCommonSortBy)),
+		SortOrder:  v.GetString(azoptions.FlagName(commandName, FlagCommonSortOrder)),
+		NamePrefix: v.GetString(azoptions.FlagName(commandName, FlagCommonNamePrefix)),
+	}
+	if query.SortBy != "" && !azmodels.IsValidSortBy(query.SortBy) {
+		return nil, false, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("invalid sort key %s", query.SortBy))
+	}
+	if query.SortOrder != "" && !azmodels.IsValidSortOrder(query.SortOrder) {
+		return nil, false, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("invalid sort order %s", query.SortOrder))
+	}
+	var err error
+	query.CreatedAfter, err = parseFetchQueryTime(FlagCommonCreatedAfter, v.GetString(azoptions.FlagName(commandName, FlagCommonCreatedAfter)))
+	if err != nil {
+		return nil, false, err
+	}
+	query.CreatedBefore, err = parseFetchQueryTime(FlagCommonCreatedBefore, v.GetString(azoptions.FlagName(commandName, FlagCommonCreatedBefore)))
+	if err != nil {
+		return nil, false, err
+	}
+	all := v.GetBool(azoptions.FlagName(commandName, FlagCommonAll))
+	return query, all, nil
+}
+
+// FetchPages fetches a page of results, or all the pages following the cursors if all is set, and returns the cursor of the next page.
+func FetchPages[T any](query *azmodels.FetchQuery, all bool, fetch func(query *azmodels.FetchQuery) ([]T, string, error)) ([]T, string, error) {
+	items, nextCursor, err := fetch(query)
+	if err != nil || !all {
+		return items, nextCursor, err
+	}
+	pageQuery := *query
+	for nextCursor != "" {
+		pageQuery.Cursor = nextCursor
+		var pageItems []T
+		pageItems, nextCursor, err = fetch(&pageQuery)
+		if err != nil {
+			return nil, "", err
+		}
+		items = append(items, pageItems...)
+	}
+	return items, "", nil
+}
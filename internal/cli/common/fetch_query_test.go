@@ -0,0 +1,89 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+)
+
+// TestBuildFetchQuery tests the BuildFetchQuery function.
+func TestBuildFetchQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	v := viper.New()
+	v.Set(azoptions.FlagName("list", FlagCommonSortBy), azmodels.SortByName)
+	v.Set(azoptions.FlagName("list", FlagCommonSortOrder), azmodels.SortOrderDesc)
+	v.Set(azoptions.FlagName("list", FlagCommonNamePrefix), "rent")
+	v.Set(azoptions.FlagName("list", FlagCommonCreatedAfter), "2024-05-01")
+	v.Set(azoptions.FlagName("list", FlagCommonAll), true)
+	query, all, err := BuildFetchQuery(v, "list")
+	assert.Nil(err, "error should be nil")
+	assert.True(all, "all should be true")
+	assert.Equal(azmodels.SortByName, query.SortBy, "sort by is not correct")
+	assert.Equal(azmodels.SortOrderDesc, query.SortOrder, "sort order is not correct")
+	assert.Equal("rent", query.NamePrefix, "name prefix is not correct")
+	assert.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), *query.CreatedAfter, "created after is not correct")
+	assert.Nil(query.CreatedBefore, "created before should be nil")
+
+	for _, flag := range []string{FlagCommonSortBy, FlagCommonSortOrder, FlagCommonCreatedBefore} {
+		v := viper.New()
+		v.Set(azoptions.FlagName("list", flag), "not-valid")
+		_, _, err := BuildFetchQuery(v, "list")
+		assert.NotNil(err, "error should not be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrCliArguments, err), "error should be ErrCliArguments")
+	}
+}
+
+// TestFetchPages tests the FetchPages function.
+func TestFetchPages(t *testing.T) {
+	assert := assert.New(t)
+
+	pages := map[string][]int{"": {1, 2}, "c1": {3, 4}, "c2": {5}}
+	cursors := map[string]string{"": "c1", "c1": "c2", "c2": ""}
+	fetch := func(query *azmodels.FetchQuery) ([]int, string, error) {
+		return pages[query.Cursor], cursors[query.Cursor], nil
+	}
+
+	items, nextCursor, err := FetchPages(&azmodels.FetchQuery{}, false, fetch)
+	assert.Nil(err, "error should be nil")
+	assert.Equal([]int{1, 2}, items, "items are not correct")
+	assert.Equal("c1", nextCursor, "next cursor is not correct")
+
+	items, nextCursor, err = FetchPages(&azmodels.FetchQuery{}, true, fetch)
+	assert.Nil(err, "error should be nil")
+	assert.Equal([]int{1, 2, 3, 4, 5}, items, "items are not correct")
+	assert.Equal("", nextCursor, "next cursor should be empty")
+
+	failing := func(query *azmodels.FetchQuery) ([]int, string, error) {
+		if query.Cursor == "c1" {
+			return nil, "", errors.New("fetch failed")
+		}
+		return fetch(query)
+	}
+	items, _, err = FetchPages(&azmodels.FetchQuery{}, true, failing)
+	assert.NotNil(err, "error should not be nil")
+	assert.Nil(items, "items should be nil")
+}
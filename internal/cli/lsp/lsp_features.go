@@ -0,0 +1,188 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"fmt"
+	"strings"
+
+	azicliwkscosp "github.com/permguard/permguard/internal/cli/workspace/cosp"
+	azlint "github.com/permguard/permguard/pkg/authz/lint"
+)
+
+const (
+	// missingPolicyIDMessage is the error message of the policies without an id.
+	missingPolicyIDMessage = "missing the policy id"
+)
+
+// severityOf returns the diagnostic severity of a lint severity.
+func severityOf(severity azlint.Severity) int {
+	switch severity {
+	case azlint.SeverityError:
+		return DiagnosticSeverityError
+	case azlint.SeverityWarning:
+		return DiagnosticSeverityWarning
+	}
+	return DiagnosticSeverityInformation
+}
+
+// buildDiagnostics builds the diagnostics of the last analysis indexed by path relative to the root directory.
+func (s *Server) buildDiagnostics() map[string][]Diagnostic {
+	diagnostics := map[string][]Diagnostic{}
+	if s.analysis == nil {
+		return diagnostics
+	}
+	unnamedPolicies := map[string][]Range{}
+	for _, codeFile := range s.analysis.CodeFiles {
+		if !codeFile.HasErrors {
+			continue
+		}
+		text := s.text(codeFile.Path)
+		var diagnosticRange Range
+		if line, column, ok := messagePosition(codeFile.ErrorMessage); ok {
+			diagnosticRange = lineRange(text, line, column)
+		} else if policyRange, ok := locatePolicy(text, codeFile.OName); ok {
+			diagnosticRange = policyRange
+		} else if strings.Contains(codeFile.ErrorMessage, missingPolicyIDMessage) {
+			ranges, ok := unnamedPolicies[codeFile.Path]
+			if !ok {
+				ranges = locateUnnamedPolicies(text)
+			}
+			if len(ranges) > 0 {
+				diagnosticRange = ranges[0]
+				ranges = ranges[1:]
+			}
+			unnamedPolicies[codeFile.Path] = ranges
+		} else {
+			diagnosticRange = lineRange(text, 1, 1)
+		}
+		diagnostics[codeFile.Path] = append(diagnostics[codeFile.Path], Diagnostic{
+			Range:    diagnosticRange,
+			Severity: DiagnosticSeverityError,
+			Source:   diagnosticSource,
+			Message:  codeFile.ErrorMessage,
+		})
+	}
+	for _, finding := range s.analysis.Findings {
+		text := s.text(finding.Path)
+		diagnosticRange := lineRange(text, 1, 1)
+		if finding.Line > 0 {
+			diagnosticRange = lineRange(text, finding.Line, finding.Column)
+		} else if policyRange, ok := locatePolicy(text, finding.PolicyID); ok {
+			diagnosticRange = policyRange
+		}
+		diagnostics[finding.Path] = append(diagnostics[finding.Path], Diagnostic{
+			Range:    diagnosticRange,
+			Severity: severityOf(finding.Severity),
+			Code:     finding.RuleID,
+			Source:   diagnosticSource,
+			Message:  finding.Message,
+		})
+	}
+	return diagnostics
+}
+
+// completion returns the entity types, the actions and the attributes declared in the schema.
+func (s *Server) completion(params *textDocumentPositionParams) *CompletionList {
+	list := &CompletionList{Items: []CompletionItem{}}
+	if s.analysis == nil || s.analysis.Schema == nil {
+		return list
+	}
+	symbols, err := parseSchemaSymbols(s.analysis.Schema.Data)
+	if err != nil {
+		return list
+	}
+	memberAccess := false
+	text := s.documentText(params.TextDocument.URI)
+	if offset, ok := positionToOffset(text, params.Position); ok {
+		for offset > 0 && isWordChar(text[offset-1]) && text[offset-1] != '.' {
+			offset--
+		}
+		memberAccess = offset > 0 && text[offset-1] == '.'
+	}
+	list.Items = symbols.completionItems(memberAccess)
+	return list
+}
+
+// findCodeFiles finds the code files of the policies with the given name.
+func (s *Server) findCodeFiles(name string) []azicliwkscosp.CodeFile {
+	codeFiles := []azicliwkscosp.CodeFile{}
+	if s.analysis == nil {
+		return codeFiles
+	}
+	for _, codeFile := range s.analysis.CodeFiles {
+		if codeFile.Kind == azicliwkscosp.CodeFileTypeOfCodeType && len(codeFile.OName) > 0 && codeFile.OName == name {
+			codeFiles = append(codeFiles, codeFile)
+		}
+	}
+	return codeFiles
+}
+
+// definition returns the locations of the policies with the id at the position.
+func (s *Server) definition(params *textDocumentPositionParams) []Location {
+	word, _, ok := wordAt(s.documentText(params.TextDocument.URI), params.Position)
+	if !ok {
+		return nil
+	}
+	locations := []Location{}
+	seen := map[string]struct{}{}
+	for _, codeFile := range s.findCodeFiles(word) {
+		if _, ok := seen[codeFile.Path]; ok {
+			continue
+		}
+		seen[codeFile.Path] = struct{}{}
+		if policyRange, ok := locatePolicy(s.text(codeFile.Path), word); ok {
+			locations = append(locations, Location{URI: s.uriOf(codeFile.Path), Range: policyRange})
+		}
+	}
+	if len(locations) == 0 {
+		return nil
+	}
+	return locations
+}
+
+// remoteStateText returns the text describing the state of the object against the remote ledger.
+func (s *Server) remoteStateText(name string) string {
+	if len(s.analysis.Ref) == 0 {
+		return "no remote ledger checked out"
+	}
+	switch s.analysis.RemoteStates[name].State {
+	case azicliwkscosp.CodeObjectStateUnchanged:
+		return fmt.Sprintf("unchanged, in sync with `%s`", s.analysis.LedgerURI)
+	case azicliwkscosp.CodeObjectStateModify:
+		return fmt.Sprintf("modified, differs from `%s`", s.analysis.LedgerURI)
+	case azicliwkscosp.CodeObjectStateCreate:
+		return fmt.Sprintf("new, not yet applied to `%s`", s.analysis.LedgerURI)
+	}
+	return "unknown"
+}
+
+// hover returns the object id and the remote state of the policy with the id at the position.
+func (s *Server) hover(params *textDocumentPositionParams) *Hover {
+	word, wordRange, ok := wordAt(s.documentText(params.TextDocument.URI), params.Position)
+	if !ok {
+		return nil
+	}
+	for _, codeFile := range s.findCodeFiles(word) {
+		if codeFile.HasErrors {
+			continue
+		}
+		value := fmt.Sprintf("**%s** `%s`\n\n- oid: `%s`\n- path: `%s`\n- remote: %s", codeFile.OType, codeFile.OName, codeFile.OID, codeFile.Path, s.remoteStateText(codeFile.OName))
+		return &Hover{Contents: MarkupContent{Kind: "markdown", Value: value}, Range: &wordRange}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// jsonrpcVersion is the version of the json-rpc protocol.
+	jsonrpcVersion = "2.0"
+	// headerContentLength is the header carrying the length of the message content.
+	headerContentLength = "Content-Length"
+
+	// errorCodeParseError is the error code of an invalid json message.
+	errorCodeParseError = -32700
+	// errorCodeInvalidRequest is the error code of an invalid request.
+	errorCodeInvalidRequest = -32600
+	// errorCodeMethodNotFound is the error code of a method not supported.
+	errorCodeMethodNotFound = -32601
+	// errorCodeInvalidParams is the error code of invalid params.
+	errorCodeInvalidParams = -32602
+	// errorCodeServerNotInitialized is the error code of a request received before the initialization.
+	errorCodeServerNotInitialized = -32002
+)
+
+// rpcMessage is a json-rpc message received from the client.
+type rpcMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isRequest returns true if the message is a request expecting a response.
+func (m *rpcMessage) isRequest() bool {
+	return len(m.ID) > 0 && len(m.Method) > 0
+}
+
+// rpcError is the error of a json-rpc response.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// rpcResponse is a json-rpc response sent to the client.
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcNotification is a json-rpc notification sent to the client.
+type rpcNotification struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+// rpcConn is a json-rpc connection framing the messages with the language server protocol headers.
+type rpcConn struct {
+	reader *textproto.Reader
+	writer io.Writer
+	mu     sync.Mutex
+}
+
+// newRPCConn creates a new json-rpc connection.
+func newRPCConn(in io.Reader, out io.Writer) *rpcConn {
+	return &rpcConn{
+		reader: textproto.NewReader(bufio.NewReader(in)),
+		writer: out,
+	}
+}
+
+// read reads the content of the next message.
+func (c *rpcConn) read() ([]byte, error) {
+	headers, err := c.reader.ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliOperation, "invalid message header", err)
+	}
+	lengthValue := strings.TrimSpace(headers.Get(headerContentLength))
+	length, err := strconv.Atoi(lengthValue)
+	if err != nil || length < 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliOperation, fmt.Sprintf("invalid content length %q", lengthValue))
+	}
+	content := make([]byte, length)
+	if _, err := io.ReadFull(c.reader.R, content); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliOperation, "truncated message content", err)
+	}
+	return content, nil
+}
+
+// write writes a message.
+func (c *rpcConn) write(message any) error {
+	content, err := json.Marshal(message)
+	if err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliOperation, "message cannot be encoded", err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := fmt.Fprintf(c.writer, "%s: %d\r\n\r\n", headerContentLength, len(content)); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliOperation, "message cannot be written", err)
+	}
+	if _, err := c.writer.Write(content); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliOperation, "message cannot be written", err)
+	}
+	return nil
+}
+
+// reply writes the response of a request.
+func (c *rpcConn) reply(id json.RawMessage, result any, rpcErr *rpcError) error {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	response := &rpcResponse{JSONRPC: jsonrpcVersion, ID: id, Error: rpcErr}
+	if rpcErr == nil {
+		content, err := json.Marshal(result)
+		if err != nil {
+			return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliOperation, "result cannot be encoded", err)
+		}
+		response.Result = content
+	}
+	return c.write(response)
+}
+
+// notify writes a notification.
+func (c *rpcConn) notify(method string, params any) error {
+	return c.write(&rpcNotification{JSONRPC: jsonrpcVersion, Method: method, Params: params})
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRPCConnWriteAndRead tests the framing of the messages.
+func TestRPCConnWriteAndRead(t *testing.T) {
+	assert := assert.New(t)
+	buffer := &bytes.Buffer{}
+	writer := newRPCConn(nil, buffer)
+	assert.Nil(writer.notify(methodLogMessage, &logMessageParams{Type: messageTypeError, Message: "èrror"}))
+	assert.Nil(writer.reply([]byte("1"), nil, nil))
+	assert.Nil(writer.reply(nil, nil, &rpcError{Code: errorCodeParseError, Message: "invalid json message"}))
+
+	reader := newRPCConn(bytes.NewReader(buffer.Bytes()), nil)
+	content, err := reader.read()
+	assert.Nil(err)
+	assert.JSONEq(`{"jsonrpc":"2.0","method":"window/logMessage","params":{"type":1,"message":"èrror"}}`, string(content))
+	content, err = reader.read()
+	assert.Nil(err)
+	assert.JSONEq(`{"jsonrpc":"2.0","id":1,"result":null}`, string(content))
+	content, err = reader.read()
+	assert.Nil(err)
+	assert.JSONEq(`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"invalid json message"}}`, string(content))
+	_, err = reader.read()
+	assert.Equal(io.EOF, err)
+}
+
+// TestRPCConnReadInvalidMessages tests the messages with invalid headers.
+func TestRPCConnReadInvalidMessages(t *testing.T) {
+	tests := []string{
+		"Content-Length: abc\r\n\r\n{}",
+		"Content-Type: application/vscode-jsonrpc\r\n\r\n{}",
+		"Content-Length: 10\r\n\r\n{}",
+	}
+	for _, test := range tests {
+		reader := newRPCConn(strings.NewReader(test), nil)
+		_, err := reader.read()
+		assert.NotNil(t, err, test)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+const (
+	// methodInitialize is the method initializing the server.
+	methodInitialize = "initialize"
+	// methodInitialized is the notification sent once the client received the initialize result.
+	methodInitialized = "initialized"
+	// methodShutdown is the method asking the server to shut down.
+	methodShutdown = "shutdown"
+	// methodExit is the notification asking the server to exit.
+	methodExit = "exit"
+	// methodDidOpen is the notification of a document opened.
+	methodDidOpen = "textDocument/didOpen"
+	// methodDidChange is the notification of a document changed.
+	methodDidChange = "textDocument/didChange"
+	// methodDidSave is the notification of a document saved.
+	methodDidSave = "textDocument/didSave"
+	// methodDidClose is the notification of a document closed.
+	methodDidClose = "textDocument/didClose"
+	// methodCompletion is the method requesting the completion items.
+	methodCompletion = "textDocument/completion"
+	// methodDefinition is the method requesting the definition of a symbol.
+	methodDefinition = "textDocument/definition"
+	// methodHover is the method requesting the hover of a symbol.
+	methodHover = "textDocument/hover"
+	// methodPublishDiagnostics is the notification publishing the diagnostics of a document.
+	methodPublishDiagnostics = "textDocument/publishDiagnostics"
+	// methodLogMessage is the notification logging a message in the client.
+	methodLogMessage = "window/logMessage"
+
+	// textDocumentSyncFull is the synchronization sending the full content of the document.
+	textDocumentSyncFull = 1
+
+	// DiagnosticSeverityError is the error severity.
+	DiagnosticSeverityError = 1
+	// DiagnosticSeverityWarning is the warning severity.
+	DiagnosticSeverityWarning = 2
+	// DiagnosticSeverityInformation is the information severity.
+	DiagnosticSeverityInformation = 3
+
+	// CompletionItemKindField is the kind of the attributes.
+	CompletionItemKindField = 5
+	// CompletionItemKindClass is the kind of the entity types.
+	CompletionItemKindClass = 7
+	// CompletionItemKindEvent is the kind of the actions.
+	CompletionItemKindEvent = 23
+
+	// messageTypeError is the error type of the log messages.
+	messageTypeError = 1
+
+	// diagnosticSource is the source of the diagnostics.
+	diagnosticSource = "permguard"
+)
+
+// Position is a zero based position in a document, the character is counted in utf-16 code units.
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// Range is a range in a document.
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// Location is a range in a document identified by uri.
+type Location struct {
+	URI   string `json:"uri"`
+	Range Range  `json:"range"`
+}
+
+// Diagnostic is a diagnostic of a document.
+type Diagnostic struct {
+	Range    Range  `json:"range"`
+	Severity int    `json:"severity"`
+	Code     string `json:"code,omitempty"`
+	Source   string `json:"source"`
+	Message  string `json:"message"`
+}
+
+// PublishDiagnosticsParams are the params of the publish diagnostics notification.
+type PublishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// CompletionItem is a completion item.
+type CompletionItem struct {
+	Label  string `json:"label"`
+	Kind   int    `json:"kind"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// CompletionList is the list of the completion items.
+type CompletionList struct {
+	IsIncomplete bool             `json:"isIncomplete"`
+	Items        []CompletionItem `json:"items"`
+}
+
+// MarkupContent is a markdown content.
+type MarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+// Hover is the hover of a symbol.
+type Hover struct {
+	Contents MarkupContent `json:"contents"`
+	Range    *Range        `json:"range,omitempty"`
+}
+
+// textDocumentIdentifier identifies a document.
+type textDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+// textDocumentItem is a document opened in the client.
+type textDocumentItem struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+// textDocumentPositionParams are the params of the requests on a position of a document.
+type textDocumentPositionParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+	Position     Position               `json:"position"`
+}
+
+// initializeParams are the params of the initialize request.
+type initializeParams struct {
+	RootURI  string `json:"rootUri"`
+	RootPath string `json:"rootPath"`
+}
+
+// didOpenParams are the params of the did open notification.
+type didOpenParams struct {
+	TextDocument textDocumentItem `json:"textDocument"`
+}
+
+// didChangeParams are the params of the did change notification.
+type didChangeParams struct {
+	TextDocument   textDocumentIdentifier `json:"textDocument"`
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+}
+
+// didCloseParams are the params of the did save and did close notifications.
+type didCloseParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+}
+
+// logMessageParams are the params of the log message notification.
+type logMessageParams struct {
+	Type    int    `json:"type"`
+	Message string `json:"message"`
+}
+
+// serverCapabilities are the capabilities of the server.
+type serverCapabilities struct {
+	TextDocumentSync   textDocumentSyncOptions `json:"textDocumentSync"`
+	CompletionProvider completionOptions       `json:"completionProvider"`
+	DefinitionProvider bool                    `json:"definitionProvider"`
+	HoverProvider      bool                    `json:"hoverProvider"`
+}
+
+// textDocumentSyncOptions are the options of the document synchronization.
+type textDocumentSyncOptions struct {
+	OpenClose bool        `json:"openClose"`
+	Change    int         `json:"change"`
+	Save      saveOptions `json:"save"`
+}
+
+// saveOptions are the options of the save notifications.
+type saveOptions struct {
+	IncludeText bool `json:"includeText"`
+}
+
+// completionOptions are the options of the completion.
+type completionOptions struct {
+	TriggerCharacters []string `json:"triggerCharacters"`
+}
+
+// serverInfo describes the server.
+type serverInfo struct {
+	Name string `json:"name"`
+}
+
+// initializeResult is the result of the initialize request.
+type initializeResult struct {
+	Capabilities serverCapabilities `json:"capabilities"`
+	ServerInfo   serverInfo         `json:"serverInfo"`
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// schemaNamespace is a namespace of the schema.
+type schemaNamespace struct {
+	EntityTypes map[string]struct {
+		Shape struct {
+			Attributes map[string]json.RawMessage `json:"attributes"`
+		} `json:"shape"`
+	} `json:"entityTypes"`
+	Actions map[string]json.RawMessage `json:"actions"`
+}
+
+// schemaSymbols are the symbols declared in the schema.
+type schemaSymbols struct {
+	entityTypes []CompletionItem
+	actions     []CompletionItem
+	attributes  []CompletionItem
+}
+
+// qualifiedName returns the name qualified with the namespace.
+func qualifiedName(namespace string, name string) string {
+	if len(namespace) == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s::%s", namespace, name)
+}
+
+// parseSchemaSymbols parses the symbols declared in a json schema.
+func parseSchemaSymbols(data []byte) (*schemaSymbols, error) {
+	namespaces := map[string]schemaNamespace{}
+	if err := json.Unmarshal(data, &namespaces); err != nil {
+		return nil, err
+	}
+	symbols := &schemaSymbols{
+		entityTypes: []CompletionItem{},
+		actions:     []CompletionItem{},
+		attributes:  []CompletionItem{},
+	}
+	for namespace, ns := range namespaces {
+		for entityType, entity := range ns.EntityTypes {
+			entityName := qualifiedName(namespace, entityType)
+			symbols.entityTypes = append(symbols.entityTypes, CompletionItem{Label: entityName, Kind: CompletionItemKindClass, Detail: "entity type"})
+			for attribute := range entity.Shape.Attributes {
+				symbols.attributes = append(symbols.attributes, CompletionItem{Label: attribute, Kind: CompletionItemKindField, Detail: fmt.Sprintf("attribute of %s", entityName)})
+			}
+		}
+		for action := range ns.Actions {
+			actionName := fmt.Sprintf("%s::%q", qualifiedName(namespace, "Action"), action)
+			symbols.actions = append(symbols.actions, CompletionItem{Label: actionName, Kind: CompletionItemKindEvent, Detail: "action"})
+		}
+	}
+	for _, items := range [][]CompletionItem{symbols.entityTypes, symbols.actions, symbols.attributes} {
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].Label == items[j].Label {
+				return items[i].Detail < items[j].Detail
+			}
+			return items[i].Label < items[j].Label
+		})
+	}
+	return symbols, nil
+}
+
+// completionItems returns the completion items, only the attributes are returned after a member access.
+func (s *schemaSymbols) completionItems(memberAccess bool) []CompletionItem {
+	if memberAccess {
+		return s.attributes
+	}
+	items := append([]CompletionItem{}, s.entityTypes...)
+	return append(items, s.actions...)
+}
@@ -0,0 +1,291 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	azicliwksmanager "github.com/permguard/permguard/internal/cli/workspace"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// WorkspaceAnalyzer analyses the source files of a workspace.
+type WorkspaceAnalyzer interface {
+	// AnalyzeWorkspace analyses the source files of the workspace, documents override the files on disk and are indexed by relative path.
+	AnalyzeWorkspace(documents map[string][]byte) (*azicliwksmanager.WorkspaceAnalysis, error)
+}
+
+// AnalyzerFactory creates the analyzer of the workspace in the root directory.
+type AnalyzerFactory func(rootDir string) (WorkspaceAnalyzer, error)
+
+// Server is the language server of the permguard workspaces.
+type Server struct {
+	newAnalyzer AnalyzerFactory
+	rootDir     string
+	analyzer    WorkspaceAnalyzer
+	analysis    *azicliwksmanager.WorkspaceAnalysis
+	documents   map[string]string
+	published   map[string]struct{}
+	conn        *rpcConn
+	initialized bool
+	shutdown    bool
+}
+
+// NewServer creates a new language server for the workspace in the root directory, the root directory is replaced by the one sent by the client if any.
+func NewServer(rootDir string, newAnalyzer AnalyzerFactory) (*Server, error) {
+	if newAnalyzer == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "analyzer factory cannot be nil")
+	}
+	absRootDir, err := filepath.Abs(rootDir)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliDirectoryOperation, "invalid root directory", err)
+	}
+	return &Server{
+		newAnalyzer: newAnalyzer,
+		rootDir:     absRootDir,
+		documents:   map[string]string{},
+		published:   map[string]struct{}{},
+	}, nil
+}
+
+// Serve serves the client until it exits or closes the input.
+func (s *Server) Serve(in io.Reader, out io.Writer) error {
+	s.conn = newRPCConn(in, out)
+	for {
+		content, err := s.conn.read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		msg := &rpcMessage{}
+		if err := json.Unmarshal(content, msg); err != nil {
+			if err := s.conn.reply(nil, nil, &rpcError{Code: errorCodeParseError, Message: "invalid json message"}); err != nil {
+				return err
+			}
+			continue
+		}
+		if msg.Method == methodExit {
+			if !s.shutdown {
+				return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliOperation, "the client exited without shutting down the language server")
+			}
+			return nil
+		}
+		if msg.isRequest() {
+			result, rpcErr := s.handleRequest(msg)
+			if err := s.conn.reply(msg.ID, result, rpcErr); err != nil {
+				return err
+			}
+		} else if len(msg.Method) > 0 && s.initialized && !s.shutdown {
+			if err := s.handleNotification(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// handleRequest handles a request returning its result.
+func (s *Server) handleRequest(msg *rpcMessage) (any, *rpcError) {
+	if msg.Method == methodInitialize {
+		return s.initialize(msg.Params)
+	}
+	if !s.initialized {
+		return nil, &rpcError{Code: errorCodeServerNotInitialized, Message: "the language server is not initialized"}
+	}
+	if s.shutdown {
+		return nil, &rpcError{Code: errorCodeInvalidRequest, Message: "the language server is shutting down"}
+	}
+	switch msg.Method {
+	case methodShutdown:
+		s.shutdown = true
+		return nil, nil
+	case methodCompletion, methodDefinition, methodHover:
+		params := &textDocumentPositionParams{}
+		if err := json.Unmarshal(msg.Params, params); err != nil {
+			return nil, &rpcError{Code: errorCodeInvalidParams, Message: "invalid text document position params"}
+		}
+		switch msg.Method {
+		case methodCompletion:
+			return s.completion(params), nil
+		case methodDefinition:
+			return s.definition(params), nil
+		default:
+			return s.hover(params), nil
+		}
+	}
+	return nil, &rpcError{Code: errorCodeMethodNotFound, Message: "method not supported: " + msg.Method}
+}
+
+// handleNotification handles a notification.
+func (s *Server) handleNotification(msg *rpcMessage) error {
+	switch msg.Method {
+	case methodInitialized, methodDidSave:
+	case methodDidOpen:
+		params := &didOpenParams{}
+		if err := json.Unmarshal(msg.Params, params); err != nil {
+			return nil
+		}
+		s.documents[s.canonicalURI(params.TextDocument.URI)] = params.TextDocument.Text
+	case methodDidChange:
+		params := &didChangeParams{}
+		if err := json.Unmarshal(msg.Params, params); err != nil || len(params.ContentChanges) == 0 {
+			return nil
+		}
+		s.documents[s.canonicalURI(params.TextDocument.URI)] = params.ContentChanges[len(params.ContentChanges)-1].Text
+	case methodDidClose:
+		params := &didCloseParams{}
+		if err := json.Unmarshal(msg.Params, params); err != nil {
+			return nil
+		}
+		delete(s.documents, s.canonicalURI(params.TextDocument.URI))
+	default:
+		return nil
+	}
+	return s.refresh()
+}
+
+// initialize initializes the server for the root directory of the client.
+func (s *Server) initialize(rawParams json.RawMessage) (any, *rpcError) {
+	params := &initializeParams{}
+	if len(rawParams) > 0 {
+		if err := json.Unmarshal(rawParams, params); err != nil {
+			return nil, &rpcError{Code: errorCodeInvalidParams, Message: "invalid initialize params"}
+		}
+	}
+	if rootDir, ok := uriToPath(params.RootURI); ok {
+		s.rootDir = rootDir
+	} else if len(params.RootPath) > 0 {
+		s.rootDir = params.RootPath
+	}
+	analyzer, err := s.newAnalyzer(s.rootDir)
+	if err != nil {
+		return nil, &rpcError{Code: errorCodeInvalidRequest, Message: err.Error()}
+	}
+	s.analyzer = analyzer
+	s.initialized = true
+	return &initializeResult{
+		Capabilities: serverCapabilities{
+			TextDocumentSync:   textDocumentSyncOptions{OpenClose: true, Change: textDocumentSyncFull, Save: saveOptions{}},
+			CompletionProvider: completionOptions{TriggerCharacters: []string{":", "."}},
+			DefinitionProvider: true,
+			HoverProvider:      true,
+		},
+		ServerInfo: serverInfo{Name: "permguard"},
+	}, nil
+}
+
+// relativePath returns the path of the document relative to the root directory, false if it is outside of it.
+func (s *Server) relativePath(uri string) (string, bool) {
+	path, ok := uriToPath(uri)
+	if !ok {
+		return "", false
+	}
+	relPath, err := filepath.Rel(s.rootDir, path)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return relPath, true
+}
+
+// uriOf returns the uri of a path relative to the root directory.
+func (s *Server) uriOf(relPath string) string {
+	return pathToURI(filepath.Join(s.rootDir, relPath))
+}
+
+// canonicalURI returns the uri of the document built from its path relative to the root directory.
+func (s *Server) canonicalURI(uri string) string {
+	if relPath, ok := s.relativePath(uri); ok {
+		return s.uriOf(relPath)
+	}
+	return uri
+}
+
+// text returns the text of a path relative to the root directory, preferring the content of the open documents.
+func (s *Server) text(relPath string) string {
+	if text, ok := s.documents[s.uriOf(relPath)]; ok {
+		return text
+	}
+	data, err := os.ReadFile(filepath.Join(s.rootDir, relPath))
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// documentText returns the text of a document.
+func (s *Server) documentText(uri string) string {
+	if text, ok := s.documents[s.canonicalURI(uri)]; ok {
+		return text
+	}
+	if relPath, ok := s.relativePath(uri); ok {
+		return s.text(relPath)
+	}
+	return ""
+}
+
+// refresh analyses the workspace again and publishes the diagnostics.
+func (s *Server) refresh() error {
+	overlay := map[string][]byte{}
+	for uri, text := range s.documents {
+		if relPath, ok := s.relativePath(uri); ok {
+			overlay[relPath] = []byte(text)
+		}
+	}
+	analysis, err := s.analyzer.AnalyzeWorkspace(overlay)
+	if err != nil {
+		s.analysis = nil
+		if err := s.conn.notify(methodLogMessage, &logMessageParams{Type: messageTypeError, Message: err.Error()}); err != nil {
+			return err
+		}
+	} else {
+		s.analysis = analysis
+	}
+	return s.publishDiagnostics(s.buildDiagnostics())
+}
+
+// publishDiagnostics publishes the diagnostics, clearing the ones of the documents without diagnostics anymore.
+func (s *Server) publishDiagnostics(diagnostics map[string][]Diagnostic) error {
+	uris := []string{}
+	current := map[string]struct{}{}
+	for relPath := range diagnostics {
+		uri := s.uriOf(relPath)
+		current[uri] = struct{}{}
+		uris = append(uris, uri)
+	}
+	for uri := range s.published {
+		if _, ok := current[uri]; !ok {
+			uris = append(uris, uri)
+		}
+	}
+	sort.Strings(uris)
+	for _, uri := range uris {
+		params := &PublishDiagnosticsParams{URI: uri, Diagnostics: []Diagnostic{}}
+		if relPath, ok := s.relativePath(uri); ok && diagnostics[relPath] != nil {
+			params.Diagnostics = diagnostics[relPath]
+		}
+		if err := s.conn.notify(methodPublishDiagnostics, params); err != nil {
+			return err
+		}
+	}
+	s.published = current
+	return nil
+}
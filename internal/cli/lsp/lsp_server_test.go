@@ -0,0 +1,180 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azicliwksmanager "github.com/permguard/permguard/internal/cli/workspace"
+	azicliwkscosp "github.com/permguard/permguard/internal/cli/workspace/cosp"
+	azlint "github.com/permguard/permguard/pkg/authz/lint"
+)
+
+// testSchema is the schema used by the server tests.
+const testSchema = `{
+  "Platform": {
+    "entityTypes": {
+      "BranchAdmin": {"shape": {"type": "Record", "attributes": {"active": {"type": "Boolean"}}}},
+      "Subscription": {}
+    },
+    "actions": {"create": {}, "view": {}}
+  }
+}`
+
+// testAnalyzer is the analyzer used by the server tests.
+type testAnalyzer struct {
+	documents map[string][]byte
+}
+
+// AnalyzeWorkspace returns a fixed analysis recording the documents.
+func (a *testAnalyzer) AnalyzeWorkspace(documents map[string][]byte) (*azicliwksmanager.WorkspaceAnalysis, error) {
+	a.documents = documents
+	return &azicliwksmanager.WorkspaceAnalysis{
+		CodeFiles: []azicliwkscosp.CodeFile{
+			{Kind: azicliwkscosp.CodeFileTypeOfCodeType, Path: "platform.cedar", OType: "policy", OName: "platform-creator", OID: "a1b2c3"},
+			{Kind: azicliwkscosp.CodeFileTypeOfCodeType, Path: "platform.cedar", HasErrors: true, ErrorMessage: "[cedar] missing the policy id"},
+			{Kind: azicliwkscosp.CodeFileOfSchemaType, Path: "schema.json", OType: "schema", OName: "schema", OID: "d4e5f6"},
+		},
+		Findings: []azlint.Finding{
+			{RuleID: azlint.RuleUnknownAction, Severity: azlint.SeverityError, Message: "unknown action", Path: "platform.cedar", Line: 4, Column: 13},
+		},
+		Schema:       &azlint.Source{Path: "schema.json", Data: []byte(testSchema)},
+		Ref:          "refs/remotes/origin/273165098782/magicfarmacia",
+		LedgerURI:    "permguard@localhost/273165098782/magicfarmacia",
+		RemoteStates: map[string]azicliwkscosp.CodeObjectState{"platform-creator": {State: azicliwkscosp.CodeObjectStateModify}},
+	}, nil
+}
+
+// testSession runs a session of the server returning the messages sent to the client.
+func testSession(t *testing.T, rootDir string, analyzer WorkspaceAnalyzer, messages []map[string]any) ([]map[string]any, error) {
+	input := &bytes.Buffer{}
+	writer := newRPCConn(nil, input)
+	for _, message := range messages {
+		message["jsonrpc"] = jsonrpcVersion
+		assert.Nil(t, writer.write(message))
+	}
+	server, err := NewServer(rootDir, func(string) (WorkspaceAnalyzer, error) { return analyzer, nil })
+	assert.Nil(t, err)
+	output := &bytes.Buffer{}
+	serveErr := server.Serve(input, output)
+	responses := []map[string]any{}
+	reader := newRPCConn(output, nil)
+	for {
+		content, err := reader.read()
+		if err != nil {
+			break
+		}
+		response := map[string]any{}
+		assert.Nil(t, json.Unmarshal(content, &response))
+		responses = append(responses, response)
+	}
+	return responses, serveErr
+}
+
+// textDocumentPosition returns the params of a request on a position of a document.
+func textDocumentPosition(uri string, line int, character int) map[string]any {
+	return map[string]any{"textDocument": map[string]any{"uri": uri}, "position": map[string]any{"line": line, "character": character}}
+}
+
+// TestServerSession tests a session of the language server.
+func TestServerSession(t *testing.T) {
+	assert := assert.New(t)
+	rootDir := t.TempDir()
+	assert.Nil(os.WriteFile(filepath.Join(rootDir, "schema.json"), []byte(testSchema), 0o644))
+	uri := pathToURI(filepath.Join(rootDir, "platform.cedar"))
+	analyzer := &testAnalyzer{}
+	responses, err := testSession(t, rootDir, analyzer, []map[string]any{
+		{"id": 1, "method": methodHover, "params": textDocumentPosition(uri, 0, 0)},
+		{"id": 2, "method": methodInitialize, "params": map[string]any{"rootUri": pathToURI(rootDir)}},
+		{"method": methodInitialized, "params": map[string]any{}},
+		{"method": methodDidOpen, "params": map[string]any{"textDocument": map[string]any{"uri": uri, "languageId": "cedar", "version": 1, "text": testPolicies}}},
+		{"id": 3, "method": methodHover, "params": textDocumentPosition(uri, 0, 10)},
+		{"id": 4, "method": methodDefinition, "params": textDocumentPosition(uri, 0, 10)},
+		{"id": 5, "method": methodCompletion, "params": textDocumentPosition(uri, 3, 12)},
+		{"id": 6, "method": "textDocument/formatting", "params": map[string]any{}},
+		{"id": 7, "method": methodShutdown},
+		{"method": methodExit},
+	})
+	assert.Nil(err)
+	assert.Equal(map[string][]byte{"platform.cedar": []byte(testPolicies)}, analyzer.documents)
+
+	byID := map[float64]map[string]any{}
+	notifications := []map[string]any{}
+	for _, response := range responses {
+		if id, ok := response["id"].(float64); ok {
+			byID[id] = response
+		} else {
+			notifications = append(notifications, response)
+		}
+	}
+	assert.Equal(float64(errorCodeServerNotInitialized), byID[1]["error"].(map[string]any)["code"])
+	assert.Equal(true, byID[2]["result"].(map[string]any)["capabilities"].(map[string]any)["hoverProvider"])
+
+	assert.Len(notifications, 2)
+	params := notifications[1]["params"].(map[string]any)
+	assert.Equal(methodPublishDiagnostics, notifications[1]["method"])
+	assert.Equal(uri, params["uri"])
+	diagnostics := params["diagnostics"].([]any)
+	assert.Len(diagnostics, 2)
+	assert.Equal("[cedar] missing the policy id", diagnostics[0].(map[string]any)["message"])
+	assert.Equal(map[string]any{"line": float64(7), "character": float64(0)}, diagnostics[0].(map[string]any)["range"].(map[string]any)["start"])
+	assert.Equal(azlint.RuleUnknownAction, diagnostics[1].(map[string]any)["code"])
+	assert.Equal(map[string]any{"line": float64(3), "character": float64(12)}, diagnostics[1].(map[string]any)["range"].(map[string]any)["start"])
+
+	hover := byID[3]["result"].(map[string]any)["contents"].(map[string]any)["value"].(string)
+	assert.Contains(hover, "`a1b2c3`")
+	assert.Contains(hover, "modified, differs from `permguard@localhost/273165098782/magicfarmacia`")
+
+	locations := byID[4]["result"].([]any)
+	assert.Len(locations, 1)
+	assert.Equal(uri, locations[0].(map[string]any)["uri"])
+
+	labels := []string{}
+	for _, item := range byID[5]["result"].(map[string]any)["items"].([]any) {
+		labels = append(labels, item.(map[string]any)["label"].(string))
+	}
+	assert.Equal([]string{"Platform::BranchAdmin", "Platform::Subscription", `Platform::Action::"create"`, `Platform::Action::"view"`}, labels)
+
+	assert.Equal(float64(errorCodeMethodNotFound), byID[6]["error"].(map[string]any)["code"])
+	assert.Nil(byID[7]["result"])
+}
+
+// TestServerExitWithoutShutdown tests the exit of the server without a shutdown.
+func TestServerExitWithoutShutdown(t *testing.T) {
+	_, err := testSession(t, t.TempDir(), &testAnalyzer{}, []map[string]any{
+		{"id": 1, "method": methodInitialize, "params": map[string]any{}},
+		{"method": methodExit},
+	})
+	assert.NotNil(t, err)
+}
+
+// TestSchemaCompletionItems tests the completion items of the schema.
+func TestSchemaCompletionItems(t *testing.T) {
+	assert := assert.New(t)
+	symbols, err := parseSchemaSymbols([]byte(testSchema))
+	assert.Nil(err)
+	assert.Equal([]CompletionItem{{Label: "active", Kind: CompletionItemKindField, Detail: "attribute of Platform::BranchAdmin"}}, symbols.completionItems(true))
+	assert.Len(symbols.completionItems(false), 4)
+	_, err = parseSchemaSymbols([]byte("not a schema"))
+	assert.NotNil(err)
+}
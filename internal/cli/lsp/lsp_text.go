@@ -0,0 +1,211 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var (
+	// messagePositionRegex matches the line and column reported in the error messages.
+	messagePositionRegex = regexp.MustCompile(`:(\d+):(\d+)`)
+	// policyStatementRegex matches the start of the policy statements.
+	policyStatementRegex = regexp.MustCompile(`(?m)^[ \t]*(permit|forbid)[ \t]*\(`)
+	// windowsDriveRegex matches the drive of the windows paths of the uris.
+	windowsDriveRegex = regexp.MustCompile(`^/[A-Za-z]:`)
+)
+
+// pathToURI converts an absolute path to a file uri.
+func pathToURI(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
+
+// uriToPath converts a file uri to a path, returning false if the uri is not a file uri.
+func uriToPath(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	path := u.Path
+	if windowsDriveRegex.MatchString(path) {
+		path = path[1:]
+	}
+	return filepath.FromSlash(path), true
+}
+
+// isWordChar returns true if the character is part of an identifier of a policy.
+func isWordChar(c byte) bool {
+	return c == '-' || c == '_' || c == '.' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// utf16Len returns the length of the text in utf-16 code units.
+func utf16Len(text string) int {
+	length := 0
+	for _, r := range text {
+		length += len(utf16.Encode([]rune{r}))
+	}
+	return length
+}
+
+// lineBounds returns the byte offsets of the start and the end of the line, false if the line does not exist.
+func lineBounds(text string, line int) (int, int, bool) {
+	if line < 0 {
+		return 0, 0, false
+	}
+	start := 0
+	for i := 0; i < line; i++ {
+		idx := strings.IndexByte(text[start:], '\n')
+		if idx < 0 {
+			return 0, 0, false
+		}
+		start += idx + 1
+	}
+	end := strings.IndexByte(text[start:], '\n')
+	if end < 0 {
+		end = len(text)
+	} else {
+		end += start
+	}
+	if end > start && text[end-1] == '\r' {
+		end--
+	}
+	return start, end, true
+}
+
+// offsetToPosition converts a byte offset of the text to a position.
+func offsetToPosition(text string, offset int) Position {
+	if offset > len(text) {
+		offset = len(text)
+	}
+	line := strings.Count(text[:offset], "\n")
+	lineStart := strings.LastIndexByte(text[:offset], '\n') + 1
+	return Position{Line: line, Character: utf16Len(text[lineStart:offset])}
+}
+
+// positionToOffset converts a position to a byte offset of the text, returning false if the position is outside the text.
+func positionToOffset(text string, pos Position) (int, bool) {
+	start, end, ok := lineBounds(text, pos.Line)
+	if !ok {
+		return 0, false
+	}
+	units := 0
+	offset := start
+	for offset < end && units < pos.Character {
+		r, size := utf8.DecodeRuneInString(text[offset:end])
+		units += len(utf16.Encode([]rune{r}))
+		offset += size
+	}
+	return offset, true
+}
+
+// rangeOf returns the range of the bytes of the text between start and end.
+func rangeOf(text string, start int, end int) Range {
+	return Range{Start: offsetToPosition(text, start), End: offsetToPosition(text, end)}
+}
+
+// lineRange returns the range from the column to the end of the line, both one based as reported by the parsers.
+func lineRange(text string, line int, column int) Range {
+	start, end, ok := lineBounds(text, line-1)
+	if !ok {
+		return Range{}
+	}
+	offset := start
+	if column > 1 {
+		offset = min(start+column-1, end)
+	}
+	return rangeOf(text, offset, end)
+}
+
+// wordAt returns the identifier at the position and its range.
+func wordAt(text string, pos Position) (string, Range, bool) {
+	offset, ok := positionToOffset(text, pos)
+	if !ok {
+		return "", Range{}, false
+	}
+	start, end := offset, offset
+	for start > 0 && isWordChar(text[start-1]) {
+		start--
+	}
+	for end < len(text) && isWordChar(text[end]) {
+		end++
+	}
+	if start == end {
+		return "", Range{}, false
+	}
+	return text[start:end], rangeOf(text, start, end), true
+}
+
+// locatePolicy locates the name of a policy in the text, looking for its id annotation first.
+func locatePolicy(text string, name string) (Range, bool) {
+	if len(name) == 0 {
+		return Range{}, false
+	}
+	annotationRegex := regexp.MustCompile(`@id\(\s*"(` + regexp.QuoteMeta(name) + `)"\s*\)`)
+	if loc := annotationRegex.FindStringSubmatchIndex(text); loc != nil {
+		return rangeOf(text, loc[2], loc[3]), true
+	}
+	for offset := 0; offset < len(text); {
+		idx := strings.Index(text[offset:], name)
+		if idx < 0 {
+			break
+		}
+		start := offset + idx
+		end := start + len(name)
+		if (start == 0 || !isWordChar(text[start-1])) && (end == len(text) || !isWordChar(text[end])) {
+			return rangeOf(text, start, end), true
+		}
+		offset = end
+	}
+	return Range{}, false
+}
+
+// locateUnnamedPolicies locates the policy statements not preceded by an id annotation.
+func locateUnnamedPolicies(text string) []Range {
+	ranges := []Range{}
+	previous := 0
+	for _, loc := range policyStatementRegex.FindAllStringSubmatchIndex(text, -1) {
+		if !strings.Contains(text[previous:loc[0]], "@id(") {
+			ranges = append(ranges, rangeOf(text, loc[2], loc[3]))
+		}
+		previous = loc[1]
+	}
+	return ranges
+}
+
+// messagePosition returns the one based line and column reported in an error message.
+func messagePosition(message string) (int, int, bool) {
+	match := messagePositionRegex.FindStringSubmatch(message)
+	if match == nil {
+		return 0, 0, false
+	}
+	line, err := strconv.Atoi(match[1])
+	if err != nil || line < 1 {
+		return 0, 0, false
+	}
+	column, _ := strconv.Atoi(match[2])
+	return line, column, true
+}
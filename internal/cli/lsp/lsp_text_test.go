@@ -0,0 +1,97 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testPolicies is the source of the policies used by the text tests.
+const testPolicies = `@id("platform-creator")
+permit(
+  principal,
+  action == Platform::Action::"create",
+  resource
+);
+
+permit(principal, action, resource);
+`
+
+// TestLocatePolicy tests the location of the policies by name.
+func TestLocatePolicy(t *testing.T) {
+	assert := assert.New(t)
+	policyRange, ok := locatePolicy(testPolicies, "platform-creator")
+	assert.True(ok)
+	assert.Equal(Range{Start: Position{Line: 0, Character: 5}, End: Position{Line: 0, Character: 21}}, policyRange)
+	policyRange, ok = locatePolicy("permit(principal, action, resource) when { context.id == \"platform\" };", "platform")
+	assert.True(ok)
+	assert.Equal(Position{Line: 0, Character: 58}, policyRange.Start)
+	_, ok = locatePolicy(testPolicies, "platform")
+	assert.False(ok)
+	_, ok = locatePolicy(testPolicies, "")
+	assert.False(ok)
+}
+
+// TestLocateUnnamedPolicies tests the location of the policies without an id.
+func TestLocateUnnamedPolicies(t *testing.T) {
+	assert := assert.New(t)
+	ranges := locateUnnamedPolicies(testPolicies)
+	assert.Equal([]Range{{Start: Position{Line: 7, Character: 0}, End: Position{Line: 7, Character: 6}}}, ranges)
+}
+
+// TestMessagePosition tests the positions reported in the error messages.
+func TestMessagePosition(t *testing.T) {
+	assert := assert.New(t)
+	line, column, ok := messagePosition(`[cedar] failed to parse the policy: parse error at <input>:3:12 "==": exact got ==`)
+	assert.True(ok)
+	assert.Equal(3, line)
+	assert.Equal(12, column)
+	_, _, ok = messagePosition("[cedar] missing the policy id")
+	assert.False(ok)
+	assert.Equal(Range{Start: Position{Line: 2, Character: 11}, End: Position{Line: 2, Character: 12}}, lineRange(testPolicies, 3, 12))
+	assert.Equal(Range{}, lineRange(testPolicies, 20, 1))
+}
+
+// TestWordAt tests the identifiers at the positions, counting the characters in utf-16 code units.
+func TestWordAt(t *testing.T) {
+	assert := assert.New(t)
+	word, wordRange, ok := wordAt(testPolicies, Position{Line: 0, Character: 10})
+	assert.True(ok)
+	assert.Equal("platform-creator", word)
+	assert.Equal(Position{Line: 0, Character: 5}, wordRange.Start)
+	word, _, ok = wordAt(`@note("😀") @id("pharmacist")`, Position{Line: 0, Character: 19})
+	assert.True(ok)
+	assert.Equal("pharmacist", word)
+	_, _, ok = wordAt(testPolicies, Position{Line: 5, Character: 0})
+	assert.False(ok)
+	_, _, ok = wordAt(testPolicies, Position{Line: 99, Character: 0})
+	assert.False(ok)
+}
+
+// TestURIConversion tests the conversion between paths and uris.
+func TestURIConversion(t *testing.T) {
+	assert := assert.New(t)
+	uri := pathToURI("/home/nicola/magic farmacia/platform.cedar")
+	assert.Equal("file:///home/nicola/magic%20farmacia/platform.cedar", uri)
+	path, ok := uriToPath(uri)
+	assert.True(ok)
+	assert.Equal("/home/nicola/magic farmacia/platform.cedar", path)
+	_, ok = uriToPath("untitled:Untitled-1")
+	assert.False(ok)
+}
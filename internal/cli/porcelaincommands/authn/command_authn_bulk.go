@@ -0,0 +1,262 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azfiles "github.com/permguard/permguard/pkg/core/files"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// flagBulkFormat is the flag for the format of the bulk file.
+	flagBulkFormat = "format"
+	// flagBulkDryRun is the flag to validate a bulk import without applying it.
+	flagBulkDryRun = "dry-run"
+	// flagBulkOnConflict is the flag for the conflict policy of a bulk import.
+	flagBulkOnConflict = "on-conflict"
+	// flagBulkFullReport is the flag to report all the rows of a bulk import instead of the failed and skipped ones only.
+	flagBulkFullReport = "full-report"
+	// bulkFormatCSV is the csv format of a bulk file.
+	bulkFormatCSV = "csv"
+	// bulkFormatJSON is the json format of a bulk file, an array of objects.
+	bulkFormatJSON = "json"
+	// bulkFormatJSONL is the json lines format of a bulk file, an object per line.
+	bulkFormatJSONL = "jsonl"
+)
+
+// addBulkFileFlags adds the flags of the bulk file.
+func addBulkFileFlags(command *cobra.Command, v *viper.Viper, commandName string) {
+	command.Flags().StringP(aziclicommon.FlagCommonFile, aziclicommon.FlagCommonFileShort, "", "specify the path of the file")
+	v.BindPFlag(azoptions.FlagName(commandName, aziclicommon.FlagCommonFile), command.Flags().Lookup(aziclicommon.FlagCommonFile))
+	command.Flags().String(flagBulkFormat, "", "specify the format of the file: csv, json or jsonl (default from the file extension)")
+	v.BindPFlag(azoptions.FlagName(commandName, flagBulkFormat), command.Flags().Lookup(flagBulkFormat))
+}
+
+// addBulkImportFlags adds the flags of the bulk import.
+func addBulkImportFlags(command *cobra.Command, v *viper.Viper, commandName string) {
+	addBulkFileFlags(command, v, commandName)
+	command.Flags().Bool(flagBulkDryRun, false, "validate the import and report the outcome without applying it")
+	v.BindPFlag(azoptions.FlagName(commandName, flagBulkDryRun), command.Flags().Lookup(flagBulkDryRun))
+	command.Flags().String(flagBulkOnConflict, azmodelzap.BulkConflictSkip, "specify the policy when an item with the same name exists: skip, update or fail")
+	v.BindPFlag(azoptions.FlagName(commandName, flagBulkOnConflict), command.Flags().Lookup(flagBulkOnConflict))
+	command.Flags().Bool(flagBulkFullReport, false, "report all the rows instead of the failed and skipped ones only")
+	v.BindPFlag(azoptions.FlagName(commandName, flagBulkFullReport), command.Flags().Lookup(flagBulkFullReport))
+}
+
+// readBulkFileFlags reads the path and the format of the bulk file.
+func readBulkFileFlags(v *viper.Viper, commandName string) (string, string, error) {
+	file := v.GetString(azoptions.FlagName(commandName, aziclicommon.FlagCommonFile))
+	if file == "" {
+		return "", "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "cli: the file is required")
+	}
+	format := strings.ToLower(v.GetString(azoptions.FlagName(commandName, flagBulkFormat)))
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".csv":
+			format = bulkFormatCSV
+		case ".json":
+			format = bulkFormatJSON
+		case ".jsonl", ".ndjson":
+			format = bulkFormatJSONL
+		}
+	}
+	switch format {
+	case bulkFormatCSV, bulkFormatJSON, bulkFormatJSONL:
+		return file, format, nil
+	}
+	return "", "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("cli: invalid file format %q", format))
+}
+
+// readBulkImportOptions reads the options of the bulk import.
+func readBulkImportOptions(v *viper.Viper, commandName string, zoneID int64) (*azmodelzap.BulkUpsertOptions, error) {
+	policy := strings.ToLower(v.GetString(azoptions.FlagName(commandName, flagBulkOnConflict)))
+	if !azmodelzap.IsValidBulkConflictPolicy(policy) {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("cli: invalid conflict policy %q", policy))
+	}
+	return &azmodelzap.BulkUpsertOptions{
+		ZoneID:         zoneID,
+		DryRun:         v.GetBool(azoptions.FlagName(commandName, flagBulkDryRun)),
+		ConflictPolicy: policy,
+		FullReport:     v.GetBool(azoptions.FlagName(commandName, flagBulkFullReport)),
+	}, nil
+}
+
+// bulkRecordFromJSON converts a json object into a bulk record.
+func bulkRecordFromJSON(object map[string]any) map[string]string {
+	record := map[string]string{}
+	for key, value := range object {
+		if value == nil {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		if text, ok := value.(string); ok {
+			record[key] = strings.TrimSpace(text)
+		} else {
+			record[key] = fmt.Sprint(value)
+		}
+	}
+	return record
+}
+
+// decodeBulkJSON decodes json keeping the numbers in their textual form.
+func decodeBulkJSON(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// readBulkRecords reads the records of a bulk file, the first row of a csv file is the header.
+func readBulkRecords(file string, format string) ([]map[string]string, error) {
+	records := []map[string]string{}
+	switch format {
+	case bulkFormatCSV:
+		var header []string
+		err := azfiles.ReadCSVStream(file, nil, func(row []string) error {
+			if header == nil {
+				header = make([]string, len(row))
+				for i, column := range row {
+					header[i] = strings.ToLower(strings.TrimSpace(column))
+				}
+				return nil
+			}
+			record := map[string]string{}
+			for i, value := range row {
+				if i < len(header) {
+					record[header[i]] = strings.TrimSpace(value)
+				}
+			}
+			records = append(records, record)
+			return nil
+		}, false)
+		if err != nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliFileSystem, fmt.Sprintf("cli: failed to read the csv file %s: %s", file, err))
+		}
+	case bulkFormatJSON:
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliFileSystem, fmt.Sprintf("cli: failed to read the file %s", file))
+		}
+		objects := []map[string]any{}
+		if err := decodeBulkJSON(data, &objects); err != nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput, fmt.Sprintf("cli: the file %s is not a json array of objects", file))
+		}
+		for _, object := range objects {
+			records = append(records, bulkRecordFromJSON(object))
+		}
+	case bulkFormatJSONL:
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliFileSystem, fmt.Sprintf("cli: failed to read the file %s", file))
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		line := 0
+		for scanner.Scan() {
+			line++
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+			object := map[string]any{}
+			if err := decodeBulkJSON([]byte(text), &object); err != nil {
+				return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput, fmt.Sprintf("cli: the line %d of the file %s is not a json object", line, file))
+			}
+			records = append(records, bulkRecordFromJSON(object))
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliFileSystem, fmt.Sprintf("cli: failed to read the file %s", file))
+		}
+	}
+	return records, nil
+}
+
+// writeBulkRecords writes the records to a bulk file using the columns of the header.
+func writeBulkRecords(file string, format string, header []string, records []map[string]string) error {
+	var data []byte
+	switch format {
+	case bulkFormatCSV:
+		err := azfiles.WriteCSVStream(file, header, records, func(record any) []string {
+			values := record.(map[string]string)
+			row := make([]string, len(header))
+			for i, column := range header {
+				row[i] = values[column]
+			}
+			return row
+		}, false)
+		if err != nil {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliFileSystem, fmt.Sprintf("cli: failed to write the csv file %s: %s", file, err))
+		}
+		return nil
+	case bulkFormatJSON:
+		var err error
+		data, err = json.MarshalIndent(records, "", "  ")
+		if err != nil {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliGeneric, "cli: failed to marshal the records")
+		}
+		data = append(data, '\n')
+	case bulkFormatJSONL:
+		var buffer bytes.Buffer
+		for _, record := range records {
+			line, err := json.Marshal(record)
+			if err != nil {
+				return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliGeneric, "cli: failed to marshal the records")
+			}
+			buffer.Write(line)
+			buffer.WriteByte('\n')
+		}
+		data = buffer.Bytes()
+	}
+	if _, err := azfiles.WriteFile(file, data, 0644, false); err != nil {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliFileSystem, fmt.Sprintf("cli: failed to write the file %s", file))
+	}
+	return nil
+}
+
+// buildBulkUpsertReportOutput builds the output of a bulk upsert report.
+func buildBulkUpsertReportOutput(ctx *aziclicommon.CliCommandContext, report *azmodelzap.BulkUpsertReport) map[string]any {
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		output["dry_run"] = report.DryRun
+		output["committed"] = report.Committed
+		output["created"] = report.Created
+		output["updated"] = report.Updated
+		output["skipped"] = report.Skipped
+		output["failed"] = report.Failed
+		for _, row := range report.Rows {
+			if row.Status == azmodelzap.BulkRowStatusFailed {
+				output[fmt.Sprintf("row %d", row.Row)] = fmt.Sprintf("%s: %s", row.Name, row.Error)
+			}
+		}
+	} else if ctx.IsJSONOutput() {
+		output["report"] = report
+	}
+	return output
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+)
+
+// TestReadBulkFileFlags tests the format detection of the bulk file.
+func TestReadBulkFileFlags(t *testing.T) {
+	tests := []struct {
+		File     string
+		Format   string
+		Expected string
+		HasError bool
+	}{
+		{File: "", HasError: true},
+		{File: "identities.csv", Expected: bulkFormatCSV},
+		{File: "identities.JSON", Expected: bulkFormatJSON},
+		{File: "identities.ndjson", Expected: bulkFormatJSONL},
+		{File: "identities.txt", Format: "jsonl", Expected: bulkFormatJSONL},
+		{File: "identities.txt", HasError: true},
+		{File: "identities.csv", Format: "xml", HasError: true},
+	}
+	for _, test := range tests {
+		assert := assert.New(t)
+		v := viper.New()
+		v.Set(azoptions.FlagName("bulk", aziclicommon.FlagCommonFile), test.File)
+		v.Set(azoptions.FlagName("bulk", flagBulkFormat), test.Format)
+		file, format, err := readBulkFileFlags(v, "bulk")
+		if test.HasError {
+			assert.NotNil(err)
+			continue
+		}
+		assert.Nil(err)
+		assert.Equal(test.File, file)
+		assert.Equal(test.Expected, format)
+	}
+}
+
+// TestWriteAndReadBulkRecords tests the round trip of the bulk records in all the formats.
+func TestWriteAndReadBulkRecords(t *testing.T) {
+	header := []string{"name", "kind"}
+	records := []map[string]string{
+		{"name": "nicola.gallo", "kind": "user"},
+		{"name": "branch-manager", "kind": "actor"},
+	}
+	for _, format := range []string{bulkFormatCSV, bulkFormatJSON, bulkFormatJSONL} {
+		assert := assert.New(t)
+		file := filepath.Join(t.TempDir(), "identities."+format)
+		assert.Nil(writeBulkRecords(file, format, header, records))
+		readRecords, err := readBulkRecords(file, format)
+		assert.Nil(err)
+		assert.Equal(records, readRecords)
+	}
+}
+
+// TestReadBulkRecordsWithJSONValues tests that the json values are read as text.
+func TestReadBulkRecordsWithJSONValues(t *testing.T) {
+	assert := assert.New(t)
+	file := filepath.Join(t.TempDir(), "tenants.jsonl")
+	os.WriteFile(file, []byte("{\"Name\": \" matera-branch \", \"zone_id\": 581616507495, \"tenant_id\": null}\n\n"), 0644)
+	records, err := readBulkRecords(file, bulkFormatJSONL)
+	assert.Nil(err)
+	assert.Equal([]map[string]string{{"name": "matera-branch", "zone_id": "581616507495"}}, records)
+
+	os.WriteFile(file, []byte("not json\n"), 0644)
+	_, err = readBulkRecords(file, bulkFormatJSONL)
+	assert.NotNil(err)
+}
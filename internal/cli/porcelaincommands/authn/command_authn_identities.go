@@ -134,5 +134,7 @@ func createCommandForIdentities(deps azcli.CliDependenciesProvider, v *viper.Vip
 	command.AddCommand(createCommandForIdentityUpdate(deps, v))
 	command.AddCommand(createCommandForIdentityDelete(deps, v))
 	command.AddCommand(createCommandForIdentityList(deps, v))
+	command.AddCommand(createCommandForIdentityImport(deps, v))
+	command.AddCommand(createCommandForIdentityExport(deps, v))
 	return command
 }
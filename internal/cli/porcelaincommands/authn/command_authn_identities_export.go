@@ -0,0 +1,126 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// commandNameForIdentitiesExport is the command name for identities export.
+	commandNameForIdentitiesExport = "identities-export"
+	// bulkExportPageSize is the page size used to export all the items.
+	bulkExportPageSize = 1000
+)
+
+// runECommandForExportIdentities runs the command for exporting identities.
+func runECommandForExportIdentities(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	printErr := func(errCode error, err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to export identities.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, "failed to export identities", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForIdentity, aziclicommon.FlagCommonZoneID))
+	identitySourceID := v.GetString(azoptions.FlagName(commandNameForIdentitiesExport, flagIdentitySourceID))
+	kind := v.GetString(azoptions.FlagName(commandNameForIdentitiesExport, flagIdentityKind))
+	file, format, err := readBulkFileFlags(v, commandNameForIdentitiesExport)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	client, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	identities, _, err := aziclicommon.FetchPages(&azmodels.FetchQuery{}, true, func(query *azmodels.FetchQuery) ([]azmodelzap.Identity, string, error) {
+		return client.FetchIdentitiesByQuery(1, bulkExportPageSize, zoneID, identitySourceID, "", kind, "", query)
+	})
+	if err != nil {
+		return printErr(azerrors.ErrCliOperation, err)
+	}
+	header := []string{azmodelzap.FieldIdentityIdentityID, azmodelzap.FieldIdentityIdentitySourceID, azmodelzap.FieldIdentityKind, azmodelzap.FieldIdentityName}
+	records := make([]map[string]string, len(identities))
+	for i, identity := range identities {
+		records[i] = map[string]string{
+			azmodelzap.FieldIdentityIdentityID:       identity.IdentityID,
+			azmodelzap.FieldIdentityIdentitySourceID: identity.IdentitySourceID,
+			azmodelzap.FieldIdentityKind:             identity.Kind,
+			azmodelzap.FieldIdentityName:             identity.Name,
+		}
+	}
+	if err := writeBulkRecords(file, format, header, records); err != nil {
+		return printErr(azerrors.ErrCliFileSystem, err)
+	}
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		output[file] = len(identities)
+	} else if ctx.IsJSONOutput() {
+		output["file"] = file
+		output["identities"] = len(identities)
+	}
+	printer.PrintlnMap(output)
+	return nil
+}
+
+// createCommandForIdentityExport creates a command for exporting identities.
+func createCommandForIdentityExport(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Export remote identities to a file",
+		Long: aziclicommon.BuildCliLongTemplate(`This command exports remote identities to a csv, json or jsonl file.
+
+Examples:
+  # export all the identities to a csv file
+  permguard authn identities export --zone-id 273165098782 --file identities.csv
+  # export the identities of an identity source to a jsonl file
+  permguard authn identities export --zone-id 273165098782 --identitysource-id 1da1d9094501425085859c60429163c2 --file identities.jsonl
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForExportIdentities(deps, cmd, v)
+		},
+	}
+	command.Flags().String(flagIdentitySourceID, "", "filter results by identity source id")
+	v.BindPFlag(azoptions.FlagName(commandNameForIdentitiesExport, flagIdentitySourceID), command.Flags().Lookup(flagIdentitySourceID))
+	command.Flags().String(flagIdentityKind, "", "filter results by identity kind")
+	v.BindPFlag(azoptions.FlagName(commandNameForIdentitiesExport, flagIdentityKind), command.Flags().Lookup(flagIdentityKind))
+	addBulkFileFlags(command, v, commandNameForIdentitiesExport)
+	return command
+}
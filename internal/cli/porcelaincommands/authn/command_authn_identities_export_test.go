@@ -0,0 +1,149 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azconfigs "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// TestExportCommandForIdentitiesExport tests the createCommandForIdentityExport function.
+func TestExportCommandForIdentitiesExport(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command exports remote identities to a csv, json or jsonl file."}
+	aztestutils.BaseCommandTest(t, createCommandForIdentityExport, args, false, outputs)
+}
+
+// TestCliIdentitiesExportWithError tests the command for exporting identities with an error.
+func TestCliIdentitiesExportWithError(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "identities.csv")
+	tests := []struct {
+		OutputType string
+		HasError   bool
+	}{
+		{
+			OutputType: "terminal",
+			HasError:   true,
+		},
+		{
+			OutputType: "json",
+			HasError:   true,
+		},
+	}
+	for _, test := range tests {
+		args := []string{"identities", "export", "--file", file, "--output", test.OutputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForIdentityExport(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, test.OutputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		zapClient := azmocks.NewGrpcZAPClientMock()
+		zapClient.On("FetchIdentitiesByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, "", azerrors.ErrClientParameter)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+		printerMock.On("Error", mock.Anything).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, true, outputs)
+		if test.HasError {
+			printerMock.AssertCalled(t, "Error", mock.Anything)
+		} else {
+			printerMock.AssertNotCalled(t, "Error", mock.Anything)
+		}
+	}
+}
+
+// TestCliIdentitiesExportWithSuccess tests the command for exporting identities.
+func TestCliIdentitiesExportWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	file := filepath.Join(t.TempDir(), "identities.csv")
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"identities", "export", "--file", file, "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set("output", outputType)
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForIdentityExport(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		zapClient := azmocks.NewGrpcZAPClientMock()
+		identities := []azmodelszap.Identity{
+			{
+				IdentityID:       "c3160a533ab24fbcb1eab7a09fd85f36",
+				ZoneID:           581616507495,
+				IdentitySourceID: "1da1d9094501425085859c60429163c2",
+				Kind:             "user",
+				Name:             "nicola.gallo",
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
+			},
+		}
+		zapClient.On("FetchIdentitiesByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(identities, "", nil)
+
+		printerMock := azmocks.NewPrinterMock()
+		outputPrinter := map[string]any{}
+		if outputType == "terminal" {
+			outputPrinter[file] = 1
+		} else {
+			outputPrinter["file"] = file
+			outputPrinter["identities"] = 1
+		}
+		printerMock.On("PrintlnMap", outputPrinter).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, false, outputs)
+		printerMock.AssertCalled(t, "PrintlnMap", outputPrinter)
+
+		data, err := os.ReadFile(file)
+		assert.Nil(err)
+		assert.Equal("identity_id,identity_source_id,kind,name\nc3160a533ab24fbcb1eab7a09fd85f36,1da1d9094501425085859c60429163c2,user,nicola.gallo\n", string(data))
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// commandNameForIdentitiesImport is the command name for identities import.
+	commandNameForIdentitiesImport = "identities-import"
+	// identityKindUser is the default kind of an imported identity.
+	identityKindUser = "user"
+)
+
+// runECommandForImportIdentities runs the command for importing identities.
+func runECommandForImportIdentities(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	printErr := func(errCode error, err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to import identities.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, "failed to import identities", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForIdentity, aziclicommon.FlagCommonZoneID))
+	identitySourceID := v.GetString(azoptions.FlagName(commandNameForIdentitiesImport, flagIdentitySourceID))
+	file, format, err := readBulkFileFlags(v, commandNameForIdentitiesImport)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	options, err := readBulkImportOptions(v, commandNameForIdentitiesImport, zoneID)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	records, err := readBulkRecords(file, format)
+	if err != nil {
+		return printErr(azerrors.ErrCliInput, err)
+	}
+	identities := make([]azmodelzap.Identity, len(records))
+	for i, record := range records {
+		identity := azmodelzap.Identity{
+			ZoneID:           zoneID,
+			IdentitySourceID: record[azmodelzap.FieldIdentityIdentitySourceID],
+			Kind:             record[azmodelzap.FieldIdentityKind],
+			Name:             record[azmodelzap.FieldIdentityName],
+		}
+		if identity.IdentitySourceID == "" {
+			identity.IdentitySourceID = identitySourceID
+		}
+		if identity.Kind == "" {
+			identity.Kind = identityKindUser
+		}
+		identities[i] = identity
+	}
+	client, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	report, err := client.UpsertIdentities(options, identities)
+	if err != nil {
+		return printErr(azerrors.ErrCliOperation, err)
+	}
+	printer.PrintlnMap(buildBulkUpsertReportOutput(ctx, report))
+	if report.Failed > 0 {
+		return aziclicommon.ErrCommandSilent
+	}
+	return nil
+}
+
+// createCommandForIdentityImport creates a command for importing identities.
+func createCommandForIdentityImport(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "import",
+		Short: "Import remote identities from a file",
+		Long: aziclicommon.BuildCliLongTemplate(`This command imports remote identities from a csv, json or jsonl file.
+
+The file has a name, a kind and an identity_source_id column, the kind defaults to user and the identity source id to the --identitysource-id flag.
+The import is applied in a single transaction and it is rolled back if any row fails.
+
+Examples:
+  # import the identities of a csv file and output the report in json format
+  permguard authn identities import --zone-id 273165098782 --file identities.csv --identitysource-id 1da1d9094501425085859c60429163c2 --output json
+  # validate the import of a jsonl file without applying it
+  permguard authn identities import --zone-id 273165098782 --file identities.jsonl --dry-run
+  # import the identities of a json file updating the existing ones
+  permguard authn identities import --zone-id 273165098782 --file identities.json --on-conflict update
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForImportIdentities(deps, cmd, v)
+		},
+	}
+	command.Flags().String(flagIdentitySourceID, "", "specify the identity source id of the rows without one")
+	v.BindPFlag(azoptions.FlagName(commandNameForIdentitiesImport, flagIdentitySourceID), command.Flags().Lookup(flagIdentitySourceID))
+	addBulkImportFlags(command, v, commandNameForIdentitiesImport)
+	return command
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azconfigs "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// TestImportCommandForIdentitiesImport tests the createCommandForIdentityImport function.
+func TestImportCommandForIdentitiesImport(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command imports remote identities from a csv, json or jsonl file."}
+	aztestutils.BaseCommandTest(t, createCommandForIdentityImport, args, false, outputs)
+}
+
+// TestCliIdentitiesImportWithError tests the command for importing identities with an error.
+func TestCliIdentitiesImportWithError(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "identities.csv")
+	os.WriteFile(file, []byte("name,kind\nnicola.gallo,user\n"), 0644)
+	tests := []struct {
+		OutputType string
+		HasError   bool
+	}{
+		{
+			OutputType: "terminal",
+			HasError:   true,
+		},
+		{
+			OutputType: "json",
+			HasError:   true,
+		},
+	}
+	for _, test := range tests {
+		args := []string{"identities", "import", "--file", file, "--output", test.OutputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForIdentityImport(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, test.OutputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		zapClient := azmocks.NewGrpcZAPClientMock()
+		zapClient.On("UpsertIdentities", mock.Anything, mock.Anything).Return(nil, azerrors.ErrClientParameter)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+		printerMock.On("Error", mock.Anything).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, true, outputs)
+		if test.HasError {
+			printerMock.AssertCalled(t, "Error", mock.Anything)
+		} else {
+			printerMock.AssertNotCalled(t, "Error", mock.Anything)
+		}
+	}
+}
+
+// TestCliIdentitiesImportWithSuccess tests the command for importing identities.
+func TestCliIdentitiesImportWithSuccess(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "identities.jsonl")
+	os.WriteFile(file, []byte("{\"name\":\"nicola.gallo\"}\n{\"name\":\"branch-manager\",\"kind\":\"actor\"}\n"), 0644)
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"identities", "import", "--file", file, "--identitysource-id", "1da1d9094501425085859c60429163c2", "--dry-run", "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set("output", outputType)
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForIdentityImport(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		identities := []azmodelszap.Identity{
+			{IdentitySourceID: "1da1d9094501425085859c60429163c2", Kind: "user", Name: "nicola.gallo"},
+			{IdentitySourceID: "1da1d9094501425085859c60429163c2", Kind: "actor", Name: "branch-manager"},
+		}
+		options := &azmodelszap.BulkUpsertOptions{DryRun: true, ConflictPolicy: azmodelszap.BulkConflictSkip}
+		report := &azmodelszap.BulkUpsertReport{DryRun: true}
+		report.AddRow(azmodelszap.BulkRowResult{Row: 1, Name: "nicola.gallo", Status: azmodelszap.BulkRowStatusCreated})
+		report.AddRow(azmodelszap.BulkRowResult{Row: 2, Name: "branch-manager", Status: azmodelszap.BulkRowStatusCreated})
+		zapClient := azmocks.NewGrpcZAPClientMock()
+		zapClient.On("UpsertIdentities", options, identities).Return(report, nil)
+
+		printerMock := azmocks.NewPrinterMock()
+		outputPrinter := map[string]any{}
+		if outputType == "terminal" {
+			outputPrinter["dry_run"] = true
+			outputPrinter["committed"] = false
+			outputPrinter["created"] = int32(2)
+			outputPrinter["updated"] = int32(0)
+			outputPrinter["skipped"] = int32(0)
+			outputPrinter["failed"] = int32(0)
+		} else {
+			outputPrinter["report"] = report
+		}
+		printerMock.On("PrintlnMap", outputPrinter).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, false, outputs)
+		printerMock.AssertCalled(t, "PrintlnMap", outputPrinter)
+	}
+}
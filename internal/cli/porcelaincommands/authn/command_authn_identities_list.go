@@ -27,6 +27,8 @@ import (
 	azcli "github.com/permguard/permguard/pkg/cli"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
 const (
@@ -70,7 +72,17 @@ func runECommandForListIdentities(deps azcli.CliDependenciesProvider, cmd *cobra
 	identityID := v.GetString(azoptions.FlagName(commandNameForIdentitiesList, flagIdentityID))
 	kind := v.GetString(azoptions.FlagName(commandNameForIdentitiesList, flagIdentityKind))
 	name := v.GetString(azoptions.FlagName(commandNameForIdentitiesList, aziclicommon.FlagCommonName))
-	identities, err := client.FetchIdentitiesBy(page, pageSize, zoneID, identitySourceID, identityID, kind, name)
+	query, all, err := aziclicommon.BuildFetchQuery(v, commandNameForIdentitiesList)
+	if err != nil {
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to list identities", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	identities, nextCursor, err := aziclicommon.FetchPages(query, all, func(query *azmodels.FetchQuery) ([]azmodelzap.Identity, string, error) {
+		return client.FetchIdentitiesByQuery(page, pageSize, zoneID, identitySourceID, identityID, kind, name, query)
+	})
 	if err != nil {
 		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
 			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliOperation, "failed to list identities", err)
@@ -88,6 +100,9 @@ func runECommandForListIdentities(deps azcli.CliDependenciesProvider, cmd *cobra
 	} else if ctx.IsJSONOutput() {
 		output["identities"] = identities
 	}
+	if nextCursor != "" {
+		output["next_cursor"] = nextCursor
+	}
 	printer.PrintlnMap(output)
 	return nil
 }
@@ -106,6 +121,8 @@ Examples:
   permguard authn identities list --zone-id 273165098782 --name branch
   # list all identities and apply filter by identity source id
   permguard authn identities list --zone-id 273165098782 --identity-id 1da1d9094501425085859c60429163c2
+  # list all the identities with a name prefix, newest first, following all the pages
+  permguard authn identities list --zone-id 273165098782 --name-prefix branch --sort-by created_at --sort-order desc --all
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runECommandForListIdentities(deps, cmd, v)
@@ -126,5 +143,6 @@ Examples:
 
 	command.Flags().String(aziclicommon.FlagCommonName, "", "filter results by identity name")
 	v.BindPFlag(azoptions.FlagName(commandNameForIdentitiesList, aziclicommon.FlagCommonName), command.Flags().Lookup(aziclicommon.FlagCommonName))
+	aziclicommon.AddFetchQueryFlags(command, v, commandNameForIdentitiesList, "identity")
 	return command
 }
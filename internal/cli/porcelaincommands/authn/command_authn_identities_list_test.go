@@ -67,7 +67,7 @@ func TestCliIdentitiesListWithError(t *testing.T) {
 		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
 
 		zapClient := azmocks.NewGrpcZAPClientMock()
-		zapClient.On("FetchIdentitiesBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrClientParameter)
+		zapClient.On("FetchIdentitiesByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, "", azerrors.ErrClientParameter)
 
 		printerMock := azmocks.NewPrinterMock()
 		printerMock.On("Println", mock.Anything).Return()
@@ -123,7 +123,7 @@ func TestCliIdentitiesListWithSuccess(t *testing.T) {
 				UpdatedAt:  time.Now(),
 			},
 		}
-		zapClient.On("FetchIdentitiesBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(identities, nil)
+		zapClient.On("FetchIdentitiesByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(identities, "", nil)
 
 		printerMock := azmocks.NewPrinterMock()
 		outputPrinter := map[string]any{}
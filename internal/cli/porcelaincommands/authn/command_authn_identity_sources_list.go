@@ -27,6 +27,8 @@ import (
 	azcli "github.com/permguard/permguard/pkg/cli"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
 const (
@@ -68,7 +70,20 @@ func runECommandForListIdentitySources(deps azcli.CliDependenciesProvider, cmd *
 	zoneID := v.GetInt64(azoptions.FlagName(commandNameForIdentitySource, aziclicommon.FlagCommonZoneID))
 	identitySourceID := v.GetString(azoptions.FlagName(commandNameForIdentitySourcesList, flagIdentitySourceID))
 	name := v.GetString(azoptions.FlagName(commandNameForIdentitySourcesList, aziclicommon.FlagCommonName))
-	identitySources, err := client.FetchIdentitySourcesBy(page, pageSize, zoneID, identitySourceID, name)
+	query, all, err := aziclicommon.BuildFetchQuery(v, commandNameForIdentitySourcesList)
+	if err != nil {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to list identity sources.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to list identity sources", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	identitySources, nextCursor, err := aziclicommon.FetchPages(query, all, func(query *azmodels.FetchQuery) ([]azmodelzap.IdentitySource, string, error) {
+		return client.FetchIdentitySourcesByQuery(page, pageSize, zoneID, identitySourceID, name, query)
+	})
 	if err != nil {
 		if ctx.IsNotVerboseTerminalOutput() {
 			printer.Println("Failed to list identity sources.")
@@ -89,6 +104,9 @@ func runECommandForListIdentitySources(deps azcli.CliDependenciesProvider, cmd *
 	} else if ctx.IsJSONOutput() {
 		output["identity_sources"] = identitySources
 	}
+	if nextCursor != "" {
+		output["next_cursor"] = nextCursor
+	}
 	printer.PrintlnMap(output)
 	return nil
 }
@@ -107,6 +125,8 @@ Examples:
   permguard authn identitysources list --zone-id 273165098782 --name google
   # list all identity sources and filter by identity source id
   permguard authn identitysources list --zone-id 273165098782 --identitysource-id 1da1d9094501425085859c60429163c2
+  # list all the identity sources with a name prefix, newest first, following all the pages
+  permguard authn identitysources list --zone-id 273165098782 --name-prefix google --sort-by created_at --sort-order desc --all
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runECommandForListIdentitySources(deps, cmd, v)
@@ -120,5 +140,6 @@ Examples:
 	v.BindPFlag(azoptions.FlagName(commandNameForIdentitySourcesList, flagIdentitySourceID), command.Flags().Lookup(flagIdentitySourceID))
 	command.Flags().String(aziclicommon.FlagCommonName, "", "filter results by identity source name")
 	v.BindPFlag(azoptions.FlagName(commandNameForIdentitySourcesList, aziclicommon.FlagCommonName), command.Flags().Lookup(aziclicommon.FlagCommonName))
+	aziclicommon.AddFetchQueryFlags(command, v, commandNameForIdentitySourcesList, "identity source")
 	return command
 }
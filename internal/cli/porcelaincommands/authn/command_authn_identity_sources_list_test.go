@@ -67,7 +67,7 @@ func TestCliIdentitySourcesListWithError(t *testing.T) {
 		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
 
 		zapClient := azmocks.NewGrpcZAPClientMock()
-		zapClient.On("FetchIdentitySourcesBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrClientParameter)
+		zapClient.On("FetchIdentitySourcesByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, "", azerrors.ErrClientParameter)
 
 		printerMock := azmocks.NewPrinterMock()
 		printerMock.On("Println", mock.Anything).Return()
@@ -123,7 +123,7 @@ func TestCliIdentitySourcesListWithSuccess(t *testing.T) {
 				UpdatedAt:        time.Now(),
 			},
 		}
-		zapClient.On("FetchIdentitySourcesBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(identitysources, nil)
+		zapClient.On("FetchIdentitySourcesByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(identitysources, "", nil)
 
 		printerMock := azmocks.NewPrinterMock()
 		outputPrinter := map[string]any{}
@@ -129,5 +129,7 @@ func createCommandForTenants(deps azcli.CliDependenciesProvider, v *viper.Viper)
 	command.AddCommand(createCommandForTenantUpdate(deps, v))
 	command.AddCommand(createCommandForTenantDelete(deps, v))
 	command.AddCommand(createCommandForTenantList(deps, v))
+	command.AddCommand(createCommandForTenantImport(deps, v))
+	command.AddCommand(createCommandForTenantExport(deps, v))
 	return command
 }
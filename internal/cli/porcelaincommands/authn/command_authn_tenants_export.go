@@ -0,0 +1,116 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// commandNameForTenantsExport is the command name for tenants export.
+	commandNameForTenantsExport = "tenants-export"
+)
+
+// runECommandForExportTenants runs the command for exporting tenants.
+func runECommandForExportTenants(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	printErr := func(errCode error, err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to export tenants.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, "failed to export tenants", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForTenant, aziclicommon.FlagCommonZoneID))
+	file, format, err := readBulkFileFlags(v, commandNameForTenantsExport)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	client, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	tenants, _, err := aziclicommon.FetchPages(&azmodels.FetchQuery{}, true, func(query *azmodels.FetchQuery) ([]azmodelzap.Tenant, string, error) {
+		return client.FetchTenantsByQuery(1, bulkExportPageSize, zoneID, "", "", query)
+	})
+	if err != nil {
+		return printErr(azerrors.ErrCliOperation, err)
+	}
+	header := []string{azmodelzap.FieldTenantTenantID, azmodelzap.FieldTenantName}
+	records := make([]map[string]string, len(tenants))
+	for i, tenant := range tenants {
+		records[i] = map[string]string{
+			azmodelzap.FieldTenantTenantID: tenant.TenantID,
+			azmodelzap.FieldTenantName:     tenant.Name,
+		}
+	}
+	if err := writeBulkRecords(file, format, header, records); err != nil {
+		return printErr(azerrors.ErrCliFileSystem, err)
+	}
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		output[file] = len(tenants)
+	} else if ctx.IsJSONOutput() {
+		output["file"] = file
+		output["tenants"] = len(tenants)
+	}
+	printer.PrintlnMap(output)
+	return nil
+}
+
+// createCommandForTenantExport creates a command for exporting tenants.
+func createCommandForTenantExport(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Export remote tenants to a file",
+		Long: aziclicommon.BuildCliLongTemplate(`This command exports remote tenants to a csv, json or jsonl file.
+
+Examples:
+  # export all the tenants to a csv file
+  permguard authn tenants export --zone-id 273165098782 --file tenants.csv
+  # export all the tenants to a json file
+  permguard authn tenants export --zone-id 273165098782 --file tenants.json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForExportTenants(deps, cmd, v)
+		},
+	}
+	addBulkFileFlags(command, v, commandNameForTenantsExport)
+	return command
+}
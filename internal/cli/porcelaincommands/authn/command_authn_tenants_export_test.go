@@ -0,0 +1,147 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azconfigs "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// TestExportCommandForTenantsExport tests the createCommandForTenantExport function.
+func TestExportCommandForTenantsExport(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command exports remote tenants to a csv, json or jsonl file."}
+	aztestutils.BaseCommandTest(t, createCommandForTenantExport, args, false, outputs)
+}
+
+// TestCliTenantsExportWithError tests the command for exporting tenants with an error.
+func TestCliTenantsExportWithError(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tenants.csv")
+	tests := []struct {
+		OutputType string
+		HasError   bool
+	}{
+		{
+			OutputType: "terminal",
+			HasError:   true,
+		},
+		{
+			OutputType: "json",
+			HasError:   true,
+		},
+	}
+	for _, test := range tests {
+		args := []string{"tenants", "export", "--file", file, "--output", test.OutputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForTenantExport(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, test.OutputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		zapClient := azmocks.NewGrpcZAPClientMock()
+		zapClient.On("FetchTenantsByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, "", azerrors.ErrClientParameter)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+		printerMock.On("Error", mock.Anything).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, true, outputs)
+		if test.HasError {
+			printerMock.AssertCalled(t, "Error", mock.Anything)
+		} else {
+			printerMock.AssertNotCalled(t, "Error", mock.Anything)
+		}
+	}
+}
+
+// TestCliTenantsExportWithSuccess tests the command for exporting tenants.
+func TestCliTenantsExportWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	file := filepath.Join(t.TempDir(), "tenants.json")
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"tenants", "export", "--file", file, "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set("output", outputType)
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForTenantExport(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		zapClient := azmocks.NewGrpcZAPClientMock()
+		tenants := []azmodelszap.Tenant{
+			{
+				TenantID:  "c3160a533ab24fbcb1eab7a09fd85f36",
+				ZoneID:    581616507495,
+				Name:      "matera-branch",
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		}
+		zapClient.On("FetchTenantsByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tenants, "", nil)
+
+		printerMock := azmocks.NewPrinterMock()
+		outputPrinter := map[string]any{}
+		if outputType == "terminal" {
+			outputPrinter[file] = 1
+		} else {
+			outputPrinter["file"] = file
+			outputPrinter["tenants"] = 1
+		}
+		printerMock.On("PrintlnMap", outputPrinter).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, false, outputs)
+		printerMock.AssertCalled(t, "PrintlnMap", outputPrinter)
+
+		data, err := os.ReadFile(file)
+		assert.Nil(err)
+		assert.Equal("[\n  {\n    \"name\": \"matera-branch\",\n    \"tenant_id\": \"c3160a533ab24fbcb1eab7a09fd85f36\"\n  }\n]\n", string(data))
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// commandNameForTenantsImport is the command name for tenants import.
+	commandNameForTenantsImport = "tenants-import"
+)
+
+// runECommandForImportTenants runs the command for importing tenants.
+func runECommandForImportTenants(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	printErr := func(errCode error, err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to import tenants.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, "failed to import tenants", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForTenant, aziclicommon.FlagCommonZoneID))
+	file, format, err := readBulkFileFlags(v, commandNameForTenantsImport)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	options, err := readBulkImportOptions(v, commandNameForTenantsImport, zoneID)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	records, err := readBulkRecords(file, format)
+	if err != nil {
+		return printErr(azerrors.ErrCliInput, err)
+	}
+	tenants := make([]azmodelzap.Tenant, len(records))
+	for i, record := range records {
+		tenants[i] = azmodelzap.Tenant{
+			ZoneID: zoneID,
+			Name:   record[azmodelzap.FieldTenantName],
+		}
+	}
+	client, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	report, err := client.UpsertTenants(options, tenants)
+	if err != nil {
+		return printErr(azerrors.ErrCliOperation, err)
+	}
+	printer.PrintlnMap(buildBulkUpsertReportOutput(ctx, report))
+	if report.Failed > 0 {
+		return aziclicommon.ErrCommandSilent
+	}
+	return nil
+}
+
+// createCommandForTenantImport creates a command for importing tenants.
+func createCommandForTenantImport(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "import",
+		Short: "Import remote tenants from a file",
+		Long: aziclicommon.BuildCliLongTemplate(`This command imports remote tenants from a csv, json or jsonl file.
+
+The file has a name column, the import is applied in a single transaction and it is rolled back if any row fails.
+
+Examples:
+  # import the tenants of a csv file and output the report in json format
+  permguard authn tenants import --zone-id 273165098782 --file tenants.csv --output json
+  # validate the import of a jsonl file without applying it
+  permguard authn tenants import --zone-id 273165098782 --file tenants.jsonl --dry-run
+  # import the tenants of a json file failing if any of them already exists
+  permguard authn tenants import --zone-id 273165098782 --file tenants.json --on-conflict fail
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForImportTenants(deps, cmd, v)
+		},
+	}
+	addBulkImportFlags(command, v, commandNameForTenantsImport)
+	return command
+}
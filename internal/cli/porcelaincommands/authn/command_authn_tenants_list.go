@@ -27,6 +27,8 @@ import (
 	azcli "github.com/permguard/permguard/pkg/cli"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
 const (
@@ -68,7 +70,20 @@ func runECommandForListTenants(deps azcli.CliDependenciesProvider, cmd *cobra.Co
 	zoneID := v.GetInt64(azoptions.FlagName(commandNameForTenant, aziclicommon.FlagCommonZoneID))
 	tenantID := v.GetString(azoptions.FlagName(commandNameForTenantsList, flagTenantID))
 	name := v.GetString(azoptions.FlagName(commandNameForTenantsList, aziclicommon.FlagCommonName))
-	tenants, err := client.FetchTenantsBy(page, pageSize, zoneID, tenantID, name)
+	query, all, err := aziclicommon.BuildFetchQuery(v, commandNameForTenantsList)
+	if err != nil {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to list tenants.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to list tenants", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	tenants, nextCursor, err := aziclicommon.FetchPages(query, all, func(query *azmodels.FetchQuery) ([]azmodelzap.Tenant, string, error) {
+		return client.FetchTenantsByQuery(page, pageSize, zoneID, tenantID, name, query)
+	})
 	if err != nil {
 		if ctx.IsNotVerboseTerminalOutput() {
 			printer.Println("Failed to list tenants.")
@@ -89,6 +104,9 @@ func runECommandForListTenants(deps azcli.CliDependenciesProvider, cmd *cobra.Co
 	} else if ctx.IsJSONOutput() {
 		output["tenants"] = tenants
 	}
+	if nextCursor != "" {
+		output["next_cursor"] = nextCursor
+	}
 	printer.PrintlnMap(output)
 	return nil
 }
@@ -107,6 +125,8 @@ Examples:
   permguard authn tenants list --zone-id 273165098782 --name matera
   # list all tenants and filter by tenant id
   permguard authn tenants list --zone-id 273165098782 --tenant-id 2e190ee712494838bb54d67e2a0c496a
+  # list all the tenants with a name prefix, newest first, following all the pages
+  permguard authn tenants list --zone-id 273165098782 --name-prefix mat --sort-by created_at --sort-order desc --all
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runECommandForListTenants(deps, cmd, v)
@@ -120,5 +140,6 @@ Examples:
 	v.BindPFlag(azoptions.FlagName(commandNameForTenantsList, flagTenantID), command.Flags().Lookup(flagTenantID))
 	command.Flags().String(aziclicommon.FlagCommonName, "", "filter results by tenant name")
 	v.BindPFlag(azoptions.FlagName(commandNameForTenantsList, aziclicommon.FlagCommonName), command.Flags().Lookup(aziclicommon.FlagCommonName))
+	aziclicommon.AddFetchQueryFlags(command, v, commandNameForTenantsList, "tenant")
 	return command
 }
@@ -67,7 +67,7 @@ func TestCliTenantsListWithError(t *testing.T) {
 		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
 
 		zapClient := azmocks.NewGrpcZAPClientMock()
-		zapClient.On("FetchTenantsBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrClientParameter)
+		zapClient.On("FetchTenantsByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, "", azerrors.ErrClientParameter)
 
 		printerMock := azmocks.NewPrinterMock()
 		printerMock.On("Println", mock.Anything).Return()
@@ -123,7 +123,7 @@ func TestCliTenantsListWithSuccess(t *testing.T) {
 				UpdatedAt: time.Now(),
 			},
 		}
-		zapClient.On("FetchTenantsBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tenants, nil)
+		zapClient.On("FetchTenantsByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tenants, "", nil)
 
 		printerMock := azmocks.NewPrinterMock()
 		outputPrinter := map[string]any{}
@@ -40,6 +40,9 @@ func CreateCommandForAuthZ(deps azcli.CliDependenciesProvider, v *viper.Viper) *
 		},
 	}
 	command.AddCommand(createCommandForLedgers(deps, v))
+	command.AddCommand(createCommandForTemplateLinks(deps, v))
+	command.AddCommand(createCommandForChangeRequests(deps, v))
 	command.AddCommand(createCommandForCheck(deps, v))
+	command.AddCommand(createCommandForSearch(deps, v))
 	return command
 }
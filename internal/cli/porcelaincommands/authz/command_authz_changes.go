@@ -0,0 +1,137 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+const (
+	// commandNameForChangeRequest is the command name for change request.
+	commandNameForChangeRequest = "changerequest"
+	// flagChangeRequestID is the flag for change request id.
+	flagChangeRequestID = "change-request-id"
+	// flagChangeRequestStatus is the flag for change request status.
+	flagChangeRequestStatus = "status"
+	// flagComment is the flag for the comment of a review.
+	flagComment = "comment"
+)
+
+// buildChangeRequestsOutput builds the output of the change requests.
+func buildChangeRequestsOutput(ctx *aziclicommon.CliCommandContext, changeRequests []azmodelspap.ChangeRequest) map[string]any {
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		for _, changeRequest := range changeRequests {
+			output[changeRequest.ChangeRequestID] = fmt.Sprintf("%s, commit %s", changeRequest.Status, changeRequest.CommitID)
+		}
+	} else if ctx.IsJSONOutput() {
+		output["changes"] = changeRequests
+	}
+	return output
+}
+
+// runECommandForReviewChangeRequest runs the command for approving or rejecting a change request.
+func runECommandForReviewChangeRequest(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, flagPrefix string, isApprove bool) error {
+	opErroMessage := "Failed to reject the change request"
+	if isApprove {
+		opErroMessage = "Failed to approve the change request"
+	}
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println(fmt.Sprintf("%s.", opErroMessage))
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, strings.ToLower(opErroMessage), err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	papTarget, err := ctx.GetPAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcPAPClient(papTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForChangeRequest, aziclicommon.FlagCommonZoneID))
+	ledgerID := v.GetString(azoptions.FlagName(commandNameForChangeRequest, flagLedgerID))
+	changeRequestID := v.GetString(azoptions.FlagName(flagPrefix, flagChangeRequestID))
+	comment := v.GetString(azoptions.FlagName(flagPrefix, flagComment))
+	var changeRequest *azmodelspap.ChangeRequest
+	if isApprove {
+		changeRequest, err = client.ApproveChangeRequest(zoneID, ledgerID, changeRequestID, comment)
+	} else {
+		changeRequest, err = client.RejectChangeRequest(zoneID, ledgerID, changeRequestID, comment)
+	}
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildChangeRequestsOutput(ctx, []azmodelspap.ChangeRequest{*changeRequest}))
+	return nil
+}
+
+// addReviewFlags adds the flags used to review a change request.
+func addReviewFlags(command *cobra.Command, v *viper.Viper, flagPrefix string) {
+	command.Flags().String(flagChangeRequestID, "", "specify the id of the change request to review")
+	v.BindPFlag(azoptions.FlagName(flagPrefix, flagChangeRequestID), command.Flags().Lookup(flagChangeRequestID))
+
+	command.Flags().String(flagComment, "", "specify an optional comment for the review")
+	v.BindPFlag(azoptions.FlagName(flagPrefix, flagComment), command.Flags().Lookup(flagComment))
+}
+
+// runECommandForChangeRequests runs the command for managing change requests.
+func runECommandForChangeRequests(cmd *cobra.Command, args []string) error {
+	return cmd.Help()
+}
+
+// createCommandForChangeRequests creates a command for managing change requests.
+func createCommandForChangeRequests(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "changes",
+		Short: "Manage change requests of protected ledgers on the remote server",
+		Long:  aziclicommon.BuildCliLongTemplate(`This command manages change requests of protected ledgers on the remote server.`),
+		RunE:  runECommandForChangeRequests,
+	}
+
+	command.PersistentFlags().Int64(aziclicommon.FlagCommonZoneID, 0, "zone id")
+	v.BindPFlag(azoptions.FlagName(commandNameForChangeRequest, aziclicommon.FlagCommonZoneID), command.PersistentFlags().Lookup(aziclicommon.FlagCommonZoneID))
+
+	command.PersistentFlags().String(flagLedgerID, "", "ledger id")
+	v.BindPFlag(azoptions.FlagName(commandNameForChangeRequest, flagLedgerID), command.PersistentFlags().Lookup(flagLedgerID))
+
+	command.AddCommand(createCommandForChangeRequestList(deps, v))
+	command.AddCommand(createCommandForChangeRequestApprove(deps, v))
+	command.AddCommand(createCommandForChangeRequestReject(deps, v))
+	return command
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// commandNameForChangeRequestsList is the command name for change requests list.
+	commandNameForChangeRequestsList = "changes-list"
+)
+
+// runECommandForListChangeRequests runs the command for listing the change requests.
+func runECommandForListChangeRequests(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to list change requests.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to list change requests", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	papTarget, err := ctx.GetPAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcPAPClient(papTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	page := v.GetInt32(azoptions.FlagName(commandNameForChangeRequestsList, aziclicommon.FlagCommonPage))
+	pageSize := v.GetInt32(azoptions.FlagName(commandNameForChangeRequestsList, aziclicommon.FlagCommonPageSize))
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForChangeRequest, aziclicommon.FlagCommonZoneID))
+	ledgerID := v.GetString(azoptions.FlagName(commandNameForChangeRequest, flagLedgerID))
+	changeRequestID := v.GetString(azoptions.FlagName(commandNameForChangeRequestsList, flagChangeRequestID))
+	status := v.GetString(azoptions.FlagName(commandNameForChangeRequestsList, flagChangeRequestStatus))
+	changeRequests, err := client.FetchChangeRequestsBy(page, pageSize, zoneID, ledgerID, changeRequestID, status)
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildChangeRequestsOutput(ctx, changeRequests))
+	return nil
+}
+
+// createCommandForChangeRequestList creates a command for listing the change requests.
+func createCommandForChangeRequestList(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List remote change requests",
+		Long: aziclicommon.BuildCliLongTemplate(`This command lists all remote change requests of a ledger.
+
+Examples:
+  # list all change requests of a ledger and output in json format
+  permguard authz changes list --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --output json
+  # list all pending change requests of a ledger
+  permguard authz changes list --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --status pending
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForListChangeRequests(deps, cmd, v)
+		},
+	}
+
+	command.Flags().Int32P(aziclicommon.FlagCommonPage, aziclicommon.FlagCommonPageShort, 1, "specify the page number for paginated results")
+	v.BindPFlag(azoptions.FlagName(commandNameForChangeRequestsList, aziclicommon.FlagCommonPage), command.Flags().Lookup(aziclicommon.FlagCommonPage))
+
+	command.Flags().Int32P(aziclicommon.FlagCommonPageSize, aziclicommon.FlagCommonPageSizeShort, 1000, "specify the number of results per page")
+	v.BindPFlag(azoptions.FlagName(commandNameForChangeRequestsList, aziclicommon.FlagCommonPageSize), command.Flags().Lookup(aziclicommon.FlagCommonPageSize))
+
+	command.Flags().String(flagChangeRequestID, "", "filter results by change request id")
+	v.BindPFlag(azoptions.FlagName(commandNameForChangeRequestsList, flagChangeRequestID), command.Flags().Lookup(flagChangeRequestID))
+
+	command.Flags().String(flagChangeRequestStatus, "", "filter results by status (pending, rejected or applied)")
+	v.BindPFlag(azoptions.FlagName(commandNameForChangeRequestsList, flagChangeRequestStatus), command.Flags().Lookup(flagChangeRequestStatus))
+	return command
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azconfigs "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+// TestCreateCommandForChangeRequestList tests the createCommandForChangeRequestList function.
+func TestCreateCommandForChangeRequestList(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command lists all remote change requests of a ledger."}
+	aztestutils.BaseCommandTest(t, createCommandForChangeRequestList, args, false, outputs)
+}
+
+// TestCliChangeRequestsListWithError tests the command for listing change requests with an error.
+func TestCliChangeRequestsListWithError(t *testing.T) {
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"changes", "list", "--status", "pending", "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForChangeRequestList(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		papClient := azmocks.NewGrpcPAPClientMock()
+		papClient.On("FetchChangeRequestsBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrClientParameter)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+		printerMock.On("Error", mock.Anything).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcPAPClient", mock.Anything).Return(papClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, true, outputs)
+		printerMock.AssertCalled(t, "Error", mock.Anything)
+	}
+}
+
+// TestCliChangeRequestsListWithSuccess tests the command for listing change requests with success.
+func TestCliChangeRequestsListWithSuccess(t *testing.T) {
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"changes", "list", "--status", "pending", "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set("output", outputType)
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForChangeRequestList(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		papClient := azmocks.NewGrpcPAPClientMock()
+		changeRequests := []azmodelspap.ChangeRequest{
+			{
+				ChangeRequestID:   "2a5bf6d2c4e34bd1a7a5d4b9f2d0e6a1",
+				ZoneID:            581616507495,
+				LedgerID:          "c3160a533ab24fbcb1eab7a09fd85f36",
+				BaseRef:           "0000000000000000000000000000000000000000000000000000000000000000",
+				CommitID:          "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80",
+				Status:            azmodelspap.ChangeRequestStatusPending,
+				RequiredApprovals: 1,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			},
+		}
+		papClient.On("FetchChangeRequestsBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(changeRequests, nil)
+
+		printerMock := azmocks.NewPrinterMock()
+		outputPrinter := map[string]any{}
+
+		if outputType == "terminal" {
+			outputPrinter[changeRequests[0].ChangeRequestID] = fmt.Sprintf("%s, commit %s", changeRequests[0].Status, changeRequests[0].CommitID)
+		} else {
+			outputPrinter["changes"] = changeRequests
+		}
+		printerMock.On("PrintMap", outputPrinter).Return()
+		printerMock.On("PrintlnMap", outputPrinter).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcPAPClient", mock.Anything).Return(papClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, false, outputs)
+		printerMock.AssertCalled(t, "PrintlnMap", outputPrinter)
+	}
+}
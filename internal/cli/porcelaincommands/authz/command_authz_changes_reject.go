@@ -0,0 +1,57 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+)
+
+const (
+	// commandNameForChangeRequestsReject is the command name for change requests reject.
+	commandNameForChangeRequestsReject = "changes-reject"
+)
+
+// runECommandForChangeRequestReject runs the command for rejecting a change request.
+func runECommandForChangeRequestReject(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	return runECommandForReviewChangeRequest(deps, cmd, v, commandNameForChangeRequestsReject, false)
+}
+
+// createCommandForChangeRequestReject creates a command for rejecting a change request.
+func createCommandForChangeRequestReject(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "reject",
+		Short: "Reject a remote change request",
+		Long: aziclicommon.BuildCliLongTemplate(`This command rejects a remote change request.
+
+A rejected change request can no longer be approved.
+The reviewer is the identity authenticated by the token of the context, it must differ from the author of the change request.
+
+Examples:
+  # reject a change request with a comment
+  permguard authz changes reject --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --change-request-id 2a5bf6d2c4e34bd1a7a5d4b9f2d0e6a1 --comment "too broad"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForChangeRequestReject(deps, cmd, v)
+		},
+	}
+	addReviewFlags(command, v, commandNameForChangeRequestsReject)
+	return command
+}
@@ -20,6 +20,7 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -32,90 +33,208 @@ import (
 	azcli "github.com/permguard/permguard/pkg/cli"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azclients "github.com/permguard/permguard/pkg/transport/clients"
 	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
 )
 
 const (
 	// commandNameForCheck is the command name for check.
 	commandNameForCheck = "check"
+	// checkBatchInitialBufferSize is the initial size of the buffer used to read the batch lines.
+	checkBatchInitialBufferSize = 64 * 1024
+	// checkBatchMaxLineSize is the maximum size of a batch line.
+	checkBatchMaxLineSize = 16 * 1024 * 1024
 )
 
-// runECommandForCheck runs the command for executing check.
-func runECommandForCheck(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, args []string) error {
-	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
-	if err != nil {
-		color.Red(fmt.Sprintf("%s", err))
-		return aziclicommon.ErrCommandSilent
-	}
-	handleInputError := func(ctx *aziclicommon.CliCommandContext, printer azcli.CliPrinter, err error, message string) error {
-		if ctx.IsNotVerboseTerminalOutput() {
-			printer.Println("Failed to check the authorization request.")
-		}
-		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
-			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliOperation, message, err)
-			printer.Error(sysErr)
-		}
-		return aziclicommon.ErrCommandSilent
-	}
+// readAuthzInput reads the json input from the file in the arguments or from the standard input.
+func readAuthzInput(ctx *aziclicommon.CliCommandContext, args []string) (string, error) {
 	var input *os.File
 	if len(args) > 0 {
 		jsonPath := filepath.Join(ctx.GetWorkDir(), args[0])
-		input, err = os.Open(jsonPath)
+		file, err := os.Open(jsonPath)
 		if err != nil {
-			return handleInputError(ctx, printer, err, "invalid input for the authz check.")
+			return "", err
 		}
-		defer input.Close()
+		defer file.Close()
+		input = file
 	} else {
 		input = os.Stdin
 	}
-
 	scanner := bufio.NewScanner(input)
 	var builder strings.Builder
 	for scanner.Scan() {
 		builder.WriteString(scanner.Text())
 	}
 	if err := scanner.Err(); err != nil {
-		return handleInputError(ctx, printer, err, "Invalid input for the authz check.")
+		return "", err
 	}
-	jsonString := builder.String()
-	var authzReq azmodelspdp.AuthorizationCheckWithDefaultsRequest
-	err = json.Unmarshal([]byte(jsonString), &authzReq)
-	if err != nil {
-		return handleInputError(ctx, printer, err, "Invalid input for the authz check.")
+	return builder.String(), nil
+}
+
+// openCheckBatchInput opens the batch input, the `-` path reads from the standard input.
+func openCheckBatchInput(ctx *aziclicommon.CliCommandContext, path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(ctx.GetWorkDir(), path)
 	}
+	return os.Open(path)
+}
 
-	pdpTarget, err := ctx.GetPDPTarget()
+// runCheckBatch checks the authorization requests of a jsonl input streaming one decision per line.
+// The json output streams one document per line, the other structured outputs render a single document with the checks and the summary.
+func runCheckBatch(ctx *aziclicommon.CliCommandContext, printer azcli.CliPrinter, client azclients.GrpcPDPClient, flags *checkRequestFlags, input io.Reader, expect string) (bool, error) {
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 0, checkBatchInitialBufferSize), checkBatchMaxLineSize)
+	stream := ctx.GetOutput() == azcli.OutputJSON
+	checks := []map[string]any{}
+	printCheck := func(check map[string]any) {
+		if stream {
+			printer.PrintlnMap(check)
+		} else {
+			checks = append(checks, check)
+		}
+	}
+	total, allowed, denied, failed, unexpected := 0, 0, 0, 0, 0
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if len(text) == 0 {
+			continue
+		}
+		total++
+		var authzReq azmodelspdp.AuthorizationCheckWithDefaultsRequest
+		err := json.Unmarshal([]byte(text), &authzReq)
+		if err == nil {
+			err = applyCheckRequestFlags(&authzReq, flags, ctx.GetWorkDir())
+		}
+		var authzResp *azmodelspdp.AuthorizationCheckResponse
+		if err == nil {
+			authzResp, err = client.AuthorizationCheck(&authzReq)
+		}
+		if err != nil {
+			failed++
+			if ctx.IsTerminalOutput() {
+				printer.Println(fmt.Sprintf("%s %d: %s", aziclicommon.KeywordText("Line"), line, aziclicommon.DeleteText(fmt.Sprintf("error - %s", err.Error()))))
+			} else if ctx.IsJSONOutput() {
+				printCheck(map[string]any{"line": line, "request_id": authzReq.RequestID, "error": err.Error()})
+			}
+			continue
+		}
+		decision := checkDecisionText(authzResp.Decision)
+		if authzResp.Decision {
+			allowed++
+		} else {
+			denied++
+		}
+		if len(expect) > 0 && decision != expect {
+			unexpected++
+		}
+		if ctx.IsTerminalOutput() {
+			requestID := authzReq.RequestID
+			if len(requestID) == 0 {
+				requestID = "none"
+			}
+			printer.Println(fmt.Sprintf("%s %d: %s, %s: %s", aziclicommon.KeywordText("Line"), line, aziclicommon.BoolText(authzResp.Decision), aziclicommon.KeywordText("Request ID"), aziclicommon.CreateText(requestID)))
+		} else if ctx.IsJSONOutput() {
+			printCheck(map[string]any{"line": line, "request_id": authzReq.RequestID, "decision": decision})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, err
+	}
+	if ctx.IsTerminalOutput() {
+		printer.Println(fmt.Sprintf("%s: %s checks, %s allowed, %s denied, %s failed.", aziclicommon.KeywordText("Summary"),
+			aziclicommon.NumberText(total), aziclicommon.NumberText(allowed), aziclicommon.NumberText(denied), aziclicommon.NumberText(failed)))
+		if unexpected > 0 {
+			printer.Println(fmt.Sprintf("%s decisions do not match the expected decision %s.", aziclicommon.NumberText(unexpected), aziclicommon.KeywordText(expect)))
+		}
+	} else if ctx.IsJSONOutput() {
+		summary := map[string]any{"total": total, "allowed": allowed, "denied": denied, "failed": failed}
+		if len(expect) > 0 {
+			summary["expect"] = expect
+			summary["unexpected"] = unexpected
+		}
+		if stream {
+			printer.PrintlnMap(map[string]any{"summary": summary})
+		} else {
+			printer.PrintlnMap(map[string]any{"checks": checks, "summary": summary})
+		}
+	}
+	return failed == 0 && unexpected == 0, nil
+}
+
+// runECommandForCheck runs the command for executing check.
+func runECommandForCheck(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, args []string) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
 	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error, errCode error, message string) error {
 		if ctx.IsNotVerboseTerminalOutput() {
 			printer.Println("Failed to check the authorization request.")
 		}
 		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
-			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to check the authorization request.", err)
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, message, err)
 			printer.Error(sysErr)
 		}
 		return aziclicommon.ErrCommandSilent
 	}
-	client, err := deps.CreateGrpcPDPClient(pdpTarget)
+	flags, err := readCheckRequestFlags(cmd, v)
 	if err != nil {
-		if ctx.IsNotVerboseTerminalOutput() {
-			printer.Println("Failed to check the authorization request.")
+		return handleError(err, azerrors.ErrCliArguments, "Invalid input for the authz check.")
+	}
+	expect := strings.ToLower(strings.TrimSpace(v.GetString(azoptions.FlagName(commandNameForCheck, flagCheckExpect))))
+	if len(expect) > 0 && expect != checkDecisionAllow && expect != checkDecisionDeny {
+		return handleError(fmt.Errorf("invalid expected decision %s", expect), azerrors.ErrCliArguments, "The expected decision must be either allow or deny.")
+	}
+	batch := strings.TrimSpace(v.GetString(azoptions.FlagName(commandNameForCheck, flagCheckBatch)))
+
+	var authzReq azmodelspdp.AuthorizationCheckWithDefaultsRequest
+	if len(batch) == 0 {
+		if len(args) > 0 || !flags.hasEvaluation() {
+			jsonString, err := readAuthzInput(ctx, args)
+			if err != nil {
+				return handleError(err, azerrors.ErrCliOperation, "Invalid input for the authz check.")
+			}
+			if err := json.Unmarshal([]byte(jsonString), &authzReq); err != nil {
+				return handleError(err, azerrors.ErrCliOperation, "Invalid input for the authz check.")
+			}
 		}
-		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
-			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to check the authorization request.", err)
-			printer.Error(sysErr)
+		if err := applyCheckRequestFlags(&authzReq, flags, ctx.GetWorkDir()); err != nil {
+			return handleError(err, azerrors.ErrCliArguments, "Invalid input for the authz check.")
 		}
-		return aziclicommon.ErrCommandSilent
 	}
-	authzResp, err := client.AuthorizationCheck(&authzReq)
+
+	pdpTarget, err := ctx.GetPDPTarget()
 	if err != nil {
-		if ctx.IsNotVerboseTerminalOutput() {
-			printer.Println("Failed to check the authorization request.")
+		return handleError(err, azerrors.ErrCliArguments, "failed to check the authorization request.")
+	}
+	client, err := deps.CreateGrpcPDPClient(pdpTarget)
+	if err != nil {
+		return handleError(err, azerrors.ErrCliArguments, "failed to check the authorization request.")
+	}
+	if len(batch) > 0 {
+		input, err := openCheckBatchInput(ctx, batch)
+		if err != nil {
+			return handleError(err, azerrors.ErrCliOperation, "Invalid input for the authz check.")
 		}
-		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
-			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to check the authorization request.", err)
-			printer.Error(sysErr)
+		defer input.Close()
+		succeeded, err := runCheckBatch(ctx, printer, client, flags, input, expect)
+		if err != nil {
+			return handleError(err, azerrors.ErrCliOperation, "Invalid input for the authz check.")
 		}
-		return aziclicommon.ErrCommandSilent
+		if !succeeded {
+			return aziclicommon.ErrCommandSilent
+		}
+		return nil
+	}
+	authzResp, err := client.AuthorizationCheck(&authzReq)
+	if err != nil {
+		return handleError(err, azerrors.ErrCliArguments, "failed to check the authorization request.")
 	}
 	if ctx.IsTerminalOutput() {
 		decision := authzResp.Decision
@@ -165,6 +284,12 @@ func runECommandForCheck(deps azcli.CliDependenciesProvider, cmd *cobra.Command,
 		output["authorization_check"] = authzResp
 		printer.PrintlnMap(output)
 	}
+	if len(expect) > 0 && checkDecisionText(authzResp.Decision) != expect {
+		if ctx.IsTerminalOutput() {
+			printer.Println(fmt.Sprintf("The decision does not match the expected decision %s.", aziclicommon.KeywordText(expect)))
+		}
+		return aziclicommon.ErrCommandSilent
+	}
 	return nil
 }
 
@@ -178,6 +303,10 @@ func createCommandForCheck(deps azcli.CliDependenciesProvider, v *viper.Viper) *
 Examples:
   # check an authorization request
   permguard authz check --zone-id 273165098782 /path/to/authorization_request.json
+  # check an authorization request built from flags
+  permguard authz check --zone-id 273165098782 --ledger 809257ed202e40cab7e958218eecad20 --principal amy.smith@acmecorp.com --subject user::amy.smith@acmecorp.com --resource MagicFarmacia::Platform::Subscription::e3a786fd07e24bfa95ba4341d3695ae8 --action MagicFarmacia::Platform::Action::view --context-value isSubscriptionActive=true
+  # check the authorization requests of a jsonl file expecting every request to be denied
+  permguard authz check --zone-id 273165098782 --batch /path/to/authorization_requests.jsonl --expect deny
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runECommandForCheck(deps, cmd, v, args)
@@ -187,5 +316,34 @@ Examples:
 	command.PersistentFlags().Int64(aziclicommon.FlagCommonZoneID, 0, "zone id")
 	v.BindPFlag(azoptions.FlagName(commandNameForCheck, aziclicommon.FlagCommonZoneID), command.PersistentFlags().Lookup(aziclicommon.FlagCommonZoneID))
 
+	command.Flags().String(flagCheckLedger, "", "specify the ledger id of the policy store")
+	v.BindPFlag(azoptions.FlagName(commandNameForCheck, flagCheckLedger), command.Flags().Lookup(flagCheckLedger))
+
+	command.Flags().String(flagCheckPrincipal, "", "specify the principal as Type::id, the type defaults to user")
+	v.BindPFlag(azoptions.FlagName(commandNameForCheck, flagCheckPrincipal), command.Flags().Lookup(flagCheckPrincipal))
+
+	command.Flags().String(flagCheckSource, "", "specify the identity source of the principal and of the subject")
+	v.BindPFlag(azoptions.FlagName(commandNameForCheck, flagCheckSource), command.Flags().Lookup(flagCheckSource))
+
+	command.Flags().String(flagCheckSubject, "", "specify the subject as Type::id, the type defaults to user")
+	v.BindPFlag(azoptions.FlagName(commandNameForCheck, flagCheckSubject), command.Flags().Lookup(flagCheckSubject))
+
+	command.Flags().String(flagCheckResource, "", "specify the resource as Type::id")
+	v.BindPFlag(azoptions.FlagName(commandNameForCheck, flagCheckResource), command.Flags().Lookup(flagCheckResource))
+
+	command.Flags().String(flagCheckAction, "", "specify the action name")
+	v.BindPFlag(azoptions.FlagName(commandNameForCheck, flagCheckAction), command.Flags().Lookup(flagCheckAction))
+
+	command.Flags().StringArray(flagCheckContext, []string{}, "specify a context value as key=value, values are decoded as json when valid")
+
+	command.Flags().String(flagCheckEntities, "", "specify the json file of the entities")
+	v.BindPFlag(azoptions.FlagName(commandNameForCheck, flagCheckEntities), command.Flags().Lookup(flagCheckEntities))
+
+	command.Flags().String(flagCheckBatch, "", "specify the jsonl file of the requests to be checked, - reads from the standard input")
+	v.BindPFlag(azoptions.FlagName(commandNameForCheck, flagCheckBatch), command.Flags().Lookup(flagCheckBatch))
+
+	command.Flags().String(flagCheckExpect, "", "specify the expected decision (allow or deny), a different decision exits with an error")
+	v.BindPFlag(azoptions.FlagName(commandNameForCheck, flagCheckExpect), command.Flags().Lookup(flagCheckExpect))
+
 	return command
 }
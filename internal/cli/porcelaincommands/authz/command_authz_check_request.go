@@ -0,0 +1,231 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+const (
+	// flagCheckLedger is the flag for the ledger id of the policy store.
+	flagCheckLedger = "ledger"
+	// flagCheckPrincipal is the flag for the principal.
+	flagCheckPrincipal = "principal"
+	// flagCheckSource is the flag for the identity source of the principal and of the subject.
+	flagCheckSource = "source"
+	// flagCheckSubject is the flag for the subject.
+	flagCheckSubject = "subject"
+	// flagCheckResource is the flag for the resource.
+	flagCheckResource = "resource"
+	// flagCheckAction is the flag for the action.
+	flagCheckAction = "action"
+	// flagCheckContext is the flag for the context values.
+	flagCheckContext = "context-value"
+	// flagCheckEntities is the flag for the entities file.
+	flagCheckEntities = "entities"
+	// flagCheckBatch is the flag for the batch file.
+	flagCheckBatch = "batch"
+	// flagCheckExpect is the flag for the expected decision.
+	flagCheckExpect = "expect"
+	// checkDecisionAllow is the allow decision.
+	checkDecisionAllow = "allow"
+	// checkDecisionDeny is the deny decision.
+	checkDecisionDeny = "deny"
+	// checkTypedIDSeparator is the separator between the type and the id of a reference.
+	checkTypedIDSeparator = "::"
+	// checkPolicyStoreKind is the kind of the policy store.
+	checkPolicyStoreKind = "ledger"
+	// checkDefaultIdentityType is the default type of the principal and of the subject.
+	checkDefaultIdentityType = "user"
+	// checkDefaultEntitiesSchema is the default schema of the entities.
+	checkDefaultEntitiesSchema = "cedar"
+)
+
+// checkRequestFlags represents the flags used to build or complete an authorization check request.
+type checkRequestFlags struct {
+	ZoneID    int64
+	Ledger    string
+	Principal string
+	Source    string
+	Subject   string
+	Resource  string
+	Action    string
+	Context   []string
+	Entities  string
+}
+
+// readCheckRequestFlags reads the request flags of the check command.
+func readCheckRequestFlags(cmd *cobra.Command, v *viper.Viper) (*checkRequestFlags, error) {
+	// The context values are read from the flag set as viper would split them on commas.
+	contextValues, err := cmd.Flags().GetStringArray(flagCheckContext)
+	if err != nil {
+		return nil, err
+	}
+	flagName := func(name string) string {
+		return azoptions.FlagName(commandNameForCheck, name)
+	}
+	return &checkRequestFlags{
+		ZoneID:    v.GetInt64(flagName(aziclicommon.FlagCommonZoneID)),
+		Ledger:    strings.TrimSpace(v.GetString(flagName(flagCheckLedger))),
+		Principal: strings.TrimSpace(v.GetString(flagName(flagCheckPrincipal))),
+		Source:    strings.TrimSpace(v.GetString(flagName(flagCheckSource))),
+		Subject:   strings.TrimSpace(v.GetString(flagName(flagCheckSubject))),
+		Resource:  strings.TrimSpace(v.GetString(flagName(flagCheckResource))),
+		Action:    strings.TrimSpace(v.GetString(flagName(flagCheckAction))),
+		Context:   contextValues,
+		Entities:  strings.TrimSpace(v.GetString(flagName(flagCheckEntities))),
+	}, nil
+}
+
+// hasEvaluation returns true if the flags describe the subject, the resource or the action of the request.
+func (f *checkRequestFlags) hasEvaluation() bool {
+	return len(f.Subject) > 0 || len(f.Resource) > 0 || len(f.Action) > 0
+}
+
+// parseCheckTypedID parses a reference in the `Type::id` format, the type is split on the last separator.
+func parseCheckTypedID(value string, defaultType string) (string, string, error) {
+	index := strings.LastIndex(value, checkTypedIDSeparator)
+	if index < 0 {
+		if len(defaultType) == 0 || len(value) == 0 {
+			return "", "", fmt.Errorf("invalid reference %s, expected the Type::id format", value)
+		}
+		return defaultType, value, nil
+	}
+	refType, refID := value[:index], value[index+len(checkTypedIDSeparator):]
+	if len(refType) == 0 || len(refID) == 0 {
+		return "", "", fmt.Errorf("invalid reference %s, expected the Type::id format", value)
+	}
+	return refType, refID, nil
+}
+
+// parseCheckContextValues parses the context values in the `key=value` format, values are decoded as json when valid.
+func parseCheckContextValues(values []string) (map[string]any, error) {
+	context := map[string]any{}
+	for _, value := range values {
+		key, raw, found := strings.Cut(value, "=")
+		key = strings.TrimSpace(key)
+		if !found || len(key) == 0 {
+			return nil, fmt.Errorf("invalid context value %s, expected the key=value format", value)
+		}
+		var decoded any
+		if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+			decoded = raw
+		}
+		context[key] = decoded
+	}
+	return context, nil
+}
+
+// readCheckEntities reads the entities from a json file containing either the entities object or the list of its items.
+func readCheckEntities(workDir string, path string) (*azmodelspdp.Entities, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workDir, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var items []map[string]any
+	if err := json.Unmarshal(data, &items); err == nil {
+		return &azmodelspdp.Entities{Schema: checkDefaultEntitiesSchema, Items: items}, nil
+	}
+	var entities azmodelspdp.Entities
+	if err := json.Unmarshal(data, &entities); err != nil {
+		return nil, fmt.Errorf("invalid entities file %s: %w", path, err)
+	}
+	if len(entities.Schema) == 0 {
+		entities.Schema = checkDefaultEntitiesSchema
+	}
+	return &entities, nil
+}
+
+// applyCheckRequestFlags applies the flags to the request, the values of the flags override the ones of the request.
+func applyCheckRequestFlags(req *azmodelspdp.AuthorizationCheckWithDefaultsRequest, flags *checkRequestFlags, workDir string) error {
+	if req.AuthorizationModel == nil {
+		req.AuthorizationModel = &azmodelspdp.AuthorizationModelRequest{}
+	}
+	model := req.AuthorizationModel
+	if flags.ZoneID > 0 {
+		model.ZoneID = flags.ZoneID
+	}
+	if len(flags.Ledger) > 0 {
+		model.PolicyStore = &azmodelspdp.PolicyStore{Kind: checkPolicyStoreKind, ID: flags.Ledger}
+	}
+	if len(flags.Principal) > 0 {
+		principalType, principalID, err := parseCheckTypedID(flags.Principal, checkDefaultIdentityType)
+		if err != nil {
+			return err
+		}
+		model.Principal = &azmodelspdp.Principal{Type: principalType, ID: principalID, Source: flags.Source}
+	}
+	if len(flags.Entities) > 0 {
+		entities, err := readCheckEntities(workDir, flags.Entities)
+		if err != nil {
+			return err
+		}
+		model.Entities = entities
+	}
+	if len(flags.Subject) > 0 {
+		subjectType, subjectID, err := parseCheckTypedID(flags.Subject, checkDefaultIdentityType)
+		if err != nil {
+			return err
+		}
+		req.Subject = &azmodelspdp.Subject{Type: subjectType, ID: subjectID, Source: flags.Source}
+	}
+	if len(flags.Resource) > 0 {
+		resourceType, resourceID, err := parseCheckTypedID(flags.Resource, "")
+		if err != nil {
+			return err
+		}
+		req.Resource = &azmodelspdp.Resource{Type: resourceType, ID: resourceID}
+	}
+	if len(flags.Action) > 0 {
+		req.Action = &azmodelspdp.Action{Name: flags.Action}
+	}
+	if len(flags.Context) > 0 {
+		context, err := parseCheckContextValues(flags.Context)
+		if err != nil {
+			return err
+		}
+		if req.Context == nil {
+			req.Context = map[string]any{}
+		}
+		for key, value := range context {
+			req.Context[key] = value
+		}
+	}
+	return nil
+}
+
+// checkDecisionText returns the text of the decision.
+func checkDecisionText(decision bool) string {
+	if decision {
+		return checkDecisionAllow
+	}
+	return checkDecisionDeny
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// TestParseCheckTypedID tests the parsing of the typed references.
+func TestParseCheckTypedID(t *testing.T) {
+	assert := assert.New(t)
+
+	refType, refID, err := parseCheckTypedID("MagicFarmacia::Platform::Subscription::e3a786fd", "")
+	assert.Nil(err)
+	assert.Equal("MagicFarmacia::Platform::Subscription", refType)
+	assert.Equal("e3a786fd", refID)
+
+	refType, refID, err = parseCheckTypedID("amy.smith@acmecorp.com", checkDefaultIdentityType)
+	assert.Nil(err)
+	assert.Equal("user", refType)
+	assert.Equal("amy.smith@acmecorp.com", refID)
+
+	for _, value := range []string{"e3a786fd", "Subscription::", "::e3a786fd"} {
+		_, _, err = parseCheckTypedID(value, "")
+		assert.NotNil(err, value)
+	}
+}
+
+// TestParseCheckContextValues tests the parsing of the context values.
+func TestParseCheckContextValues(t *testing.T) {
+	assert := assert.New(t)
+
+	context, err := parseCheckContextValues([]string{"active=true", "count=3", "time=2025-01-23T16:17:46+00:00", `roles=["a","b"]`})
+	assert.Nil(err)
+	assert.Equal(true, context["active"])
+	assert.Equal(float64(3), context["count"])
+	assert.Equal("2025-01-23T16:17:46+00:00", context["time"])
+	assert.Equal([]any{"a", "b"}, context["roles"])
+
+	_, err = parseCheckContextValues([]string{"active"})
+	assert.NotNil(err)
+}
+
+// TestApplyCheckRequestFlags tests the application of the flags to the request.
+func TestApplyCheckRequestFlags(t *testing.T) {
+	assert := assert.New(t)
+	workDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(workDir, "entities.json"), []byte(`[{"uid":{"type":"Platform::BranchInfo","id":"subscription"}}]`), 0o600)
+	assert.Nil(err)
+
+	req := &azmodelspdp.AuthorizationCheckWithDefaultsRequest{
+		RequestID: "abc1",
+		Action:    &azmodelspdp.Action{Name: "Platform::Action::create"},
+		Context:   map[string]any{"active": false, "region": "eu"},
+	}
+	flags := &checkRequestFlags{
+		ZoneID:    273165098782,
+		Ledger:    "809257ed202e40cab7e958218eecad20",
+		Principal: "amy.smith@acmecorp.com",
+		Source:    "keycloak",
+		Subject:   "role-actor::platform-creator",
+		Resource:  "Platform::Subscription::e3a786fd",
+		Context:   []string{"active=true"},
+		Entities:  "entities.json",
+	}
+	err = applyCheckRequestFlags(req, flags, workDir)
+	assert.Nil(err)
+	assert.Equal(int64(273165098782), req.AuthorizationModel.ZoneID)
+	assert.Equal(&azmodelspdp.PolicyStore{Kind: "ledger", ID: "809257ed202e40cab7e958218eecad20"}, req.AuthorizationModel.PolicyStore)
+	assert.Equal(&azmodelspdp.Principal{Type: "user", ID: "amy.smith@acmecorp.com", Source: "keycloak"}, req.AuthorizationModel.Principal)
+	assert.Equal("cedar", req.AuthorizationModel.Entities.Schema)
+	assert.Len(req.AuthorizationModel.Entities.Items, 1)
+	assert.Equal(&azmodelspdp.Subject{Type: "role-actor", ID: "platform-creator", Source: "keycloak"}, req.Subject)
+	assert.Equal(&azmodelspdp.Resource{Type: "Platform::Subscription", ID: "e3a786fd"}, req.Resource)
+	assert.Equal("Platform::Action::create", req.Action.Name)
+	assert.Equal(map[string]any{"active": true, "region": "eu"}, req.Context)
+	assert.Equal("abc1", req.RequestID)
+
+	err = applyCheckRequestFlags(req, &checkRequestFlags{Entities: "missing.json"}, workDir)
+	assert.NotNil(err)
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azconfigs "github.com/permguard/permguard/pkg/cli/options"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// TestCreateCommandForCheck tests the createCommandForCheck function.
+func TestCreateCommandForCheck(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command checks an authorization request.", "--batch", "--expect"}
+	aztestutils.BaseCommandTest(t, createCommandForCheck, args, false, outputs)
+}
+
+// newCheckTestCommand creates the check command with the mocked dependencies.
+func newCheckTestCommand(outputType string, pdpClient *azmocks.GrpcPDPClientMock, printerMock *azmocks.PrinterMock) (*viper.Viper, *cobra.Command) {
+	v := viper.New()
+	v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixPDP, aziclicommon.FlagSuffixPDPTarget), "localhost:9094")
+
+	depsMocks := azmocks.NewCliDependenciesMock()
+	cmd := createCommandForCheck(depsMocks, v)
+	cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+	cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+	cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+	depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+	depsMocks.On("CreateGrpcPDPClient", mock.Anything).Return(pdpClient, nil)
+	return v, cmd
+}
+
+// TestCliCheckWithFlags tests the command for checking an authorization request built from flags.
+func TestCliCheckWithFlags(t *testing.T) {
+	tests := []struct {
+		OutputType string
+		Expect     string
+		HasError   bool
+	}{
+		{OutputType: "terminal", Expect: "allow", HasError: false},
+		{OutputType: "json", Expect: "allow", HasError: false},
+		{OutputType: "terminal", Expect: "deny", HasError: true},
+		{OutputType: "json", Expect: "deny", HasError: true},
+	}
+	for _, test := range tests {
+		args := []string{"--zone-id", "273165098782", "--ledger", "809257ed202e40cab7e958218eecad20", "--principal", "amy.smith@acmecorp.com",
+			"--subject", "user::amy.smith@acmecorp.com", "--resource", "Platform::Subscription::e3a786fd", "--action", "Platform::Action::view",
+			"--context-value", "active=true", "--expect", test.Expect, "--output", test.OutputType}
+		outputs := []string{""}
+
+		pdpClient := azmocks.NewGrpcPDPClientMock()
+		isExpectedRequest := func(req *azmodelspdp.AuthorizationCheckWithDefaultsRequest) bool {
+			return req.AuthorizationModel.ZoneID == 273165098782 && req.AuthorizationModel.PolicyStore.ID == "809257ed202e40cab7e958218eecad20" &&
+				req.Subject.ID == "amy.smith@acmecorp.com" && req.Resource.Type == "Platform::Subscription" && req.Action.Name == "Platform::Action::view" &&
+				req.Context["active"] == true
+		}
+		pdpClient.On("AuthorizationCheck", mock.MatchedBy(isExpectedRequest)).Return(&azmodelspdp.AuthorizationCheckResponse{Decision: true}, nil)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+		printerMock.On("Error", mock.Anything).Return()
+
+		v, cmd := newCheckTestCommand(test.OutputType, pdpClient, printerMock)
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, test.HasError, outputs)
+		pdpClient.AssertNumberOfCalls(t, "AuthorizationCheck", 1)
+		printerMock.AssertNotCalled(t, "Error", mock.Anything)
+	}
+}
+
+// TestCliCheckBatch tests the command for checking the authorization requests of a jsonl file.
+func TestCliCheckBatch(t *testing.T) {
+	tests := []struct {
+		OutputType string
+		Expect     string
+		HasError   bool
+	}{
+		{OutputType: "terminal", Expect: "", HasError: false},
+		{OutputType: "json", Expect: "", HasError: false},
+		{OutputType: "terminal", Expect: "allow", HasError: true},
+		{OutputType: "json", Expect: "allow", HasError: true},
+		{OutputType: "yaml", Expect: "", HasError: false},
+		{OutputType: "table", Expect: "deny", HasError: true},
+		{OutputType: "template={{.request_id}}", Expect: "", HasError: false},
+	}
+	batchFile := filepath.Join(t.TempDir(), "requests.jsonl")
+	lines := `{"request_id":"abc1","action":{"name":"view"}}
+
+{"request_id":"abc2","action":{"name":"delete"}}
+`
+	if err := os.WriteFile(batchFile, []byte(lines), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		args := []string{"--zone-id", "273165098782", "--batch", batchFile, "--output", test.OutputType}
+		if len(test.Expect) > 0 {
+			args = append(args, "--expect", test.Expect)
+		}
+		outputs := []string{""}
+
+		pdpClient := azmocks.NewGrpcPDPClientMock()
+		isAction := func(name string) func(req *azmodelspdp.AuthorizationCheckWithDefaultsRequest) bool {
+			return func(req *azmodelspdp.AuthorizationCheckWithDefaultsRequest) bool {
+				return req.AuthorizationModel.ZoneID == 273165098782 && req.Action.Name == name
+			}
+		}
+		pdpClient.On("AuthorizationCheck", mock.MatchedBy(isAction("view"))).Return(&azmodelspdp.AuthorizationCheckResponse{Decision: true}, nil)
+		pdpClient.On("AuthorizationCheck", mock.MatchedBy(isAction("delete"))).Return(&azmodelspdp.AuthorizationCheckResponse{Decision: false}, nil)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+		printerMock.On("Error", mock.Anything).Return()
+
+		v, cmd := newCheckTestCommand(test.OutputType, pdpClient, printerMock)
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, test.HasError, outputs)
+		pdpClient.AssertNumberOfCalls(t, "AuthorizationCheck", 2)
+		if test.OutputType == "json" {
+			printerMock.AssertCalled(t, "PrintlnMap", map[string]any{"line": 1, "request_id": "abc1", "decision": "allow"})
+			printerMock.AssertCalled(t, "PrintlnMap", map[string]any{"line": 3, "request_id": "abc2", "decision": "deny"})
+			printerMock.AssertNumberOfCalls(t, "PrintlnMap", 3)
+		} else if test.OutputType != "terminal" {
+			summary := map[string]any{"total": 2, "allowed": 1, "denied": 1, "failed": 0}
+			if len(test.Expect) > 0 {
+				summary["expect"] = test.Expect
+				summary["unexpected"] = 1
+			}
+			printerMock.AssertCalled(t, "PrintlnMap", map[string]any{
+				"checks": []map[string]any{
+					{"line": 1, "request_id": "abc1", "decision": "allow"},
+					{"line": 3, "request_id": "abc2", "decision": "deny"},
+				},
+				"summary": summary,
+			})
+			printerMock.AssertNumberOfCalls(t, "PrintlnMap", 1)
+		}
+		printerMock.AssertNotCalled(t, "Error", mock.Anything)
+	}
+}
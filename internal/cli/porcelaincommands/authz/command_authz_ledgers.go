@@ -38,6 +38,8 @@ const (
 	flagLedgerID = "ledger-id"
 	// flagLedgerKind is the flag for ledger kind.
 	flagLedgerKind = "kind"
+	// flagLedgerRef is the flag for ledger ref.
+	flagLedgerRef = "ref"
 )
 
 // runECommandForCreateLedger runs the command for creating a ledger.
@@ -110,6 +112,68 @@ func runECommandForUpsertLedger(deps azcli.CliDependenciesProvider, cmd *cobra.C
 	return nil
 }
 
+// runECommandForLedgerCandidate runs the command for updating or promoting the candidate ref of a ledger.
+func runECommandForLedgerCandidate(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, flagPrefix string, isPromote bool) error {
+	opErroMessage := "Failed to update the ledger candidate"
+	if isPromote {
+		opErroMessage = "Failed to promote the ledger candidate"
+	}
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	papTarget, err := ctx.GetPAPTarget()
+	if err != nil {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println(fmt.Sprintf("%s.", opErroMessage))
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, strings.ToLower(opErroMessage), err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	client, err := deps.CreateGrpcPAPClient(papTarget)
+	if err != nil {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println(fmt.Sprintf("%s.", opErroMessage))
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, strings.ToLower(opErroMessage), err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForLedger, aziclicommon.FlagCommonZoneID))
+	ledgerID := v.GetString(azoptions.FlagName(flagPrefix, flagLedgerID))
+	var ledger *azmodelspap.Ledger
+	if isPromote {
+		ledger, err = client.PromoteLedgerCandidate(zoneID, ledgerID)
+	} else {
+		candidateRef := v.GetString(azoptions.FlagName(flagPrefix, flagLedgerRef))
+		ledger, err = client.UpdateLedgerCandidate(zoneID, ledgerID, candidateRef)
+	}
+	if err != nil {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println(fmt.Sprintf("%s.", opErroMessage))
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, strings.ToLower(opErroMessage), err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		output[ledger.LedgerID] = fmt.Sprintf("ref %s, candidate ref %s", ledger.Ref, ledger.CandidateRef)
+	} else if ctx.IsJSONOutput() {
+		output["ledgers"] = []*azmodelspap.Ledger{ledger}
+	}
+	printer.PrintlnMap(output)
+	return nil
+}
+
 // runECommandForLedgers runs the command for managing ledgers.
 func runECommandForLedgers(cmd *cobra.Command, args []string) error {
 	return cmd.Help()
@@ -130,6 +194,9 @@ func createCommandForLedgers(deps azcli.CliDependenciesProvider, v *viper.Viper)
 	command.AddCommand(createCommandForLedgerCreate(deps, v))
 	command.AddCommand(createCommandForLedgerUpdate(deps, v))
 	command.AddCommand(createCommandForLedgerDelete(deps, v))
+	command.AddCommand(createCommandForLedgerCandidate(deps, v))
+	command.AddCommand(createCommandForLedgerPromote(deps, v))
+	command.AddCommand(createCommandForLedgerProtect(deps, v))
 	command.AddCommand(createCommandForLedgerList(deps, v))
 	return command
 }
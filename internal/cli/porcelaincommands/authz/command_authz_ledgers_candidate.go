@@ -0,0 +1,60 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+)
+
+const (
+	// commandNameForLedgersCandidate is the command name for ledgers candidate.
+	commandNameForLedgersCandidate = "ledgers-candidate"
+)
+
+// runECommandForLedgerCandidateUpdate runs the command for updating the candidate ref of a ledger.
+func runECommandForLedgerCandidateUpdate(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	return runECommandForLedgerCandidate(deps, cmd, v, commandNameForLedgersCandidate, false)
+}
+
+// createCommandForLedgerCandidate creates a command for managing the ledger candidate.
+func createCommandForLedgerCandidate(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "candidate",
+		Short: "Set the candidate ref of a remote ledger evaluated in shadow mode",
+		Long: aziclicommon.BuildCliLongTemplate(`This command sets the candidate ref of a remote ledger evaluated in shadow mode.
+
+Examples:
+  # set the candidate ref of a ledger and output the result in json format
+  permguard authz ledgers candidate --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --ref 8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80 --output json
+  # clear the candidate ref of a ledger
+  permguard authz ledgers candidate --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --ref 0000000000000000000000000000000000000000000000000000000000000000
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForLedgerCandidateUpdate(deps, cmd, v)
+		},
+	}
+	command.Flags().String(flagLedgerID, "", "specify the ID of the ledger")
+	v.BindPFlag(azoptions.FlagName(commandNameForLedgersCandidate, flagLedgerID), command.Flags().Lookup(flagLedgerID))
+	command.Flags().String(flagLedgerRef, "", "specify the commit to be evaluated in shadow mode")
+	v.BindPFlag(azoptions.FlagName(commandNameForLedgersCandidate, flagLedgerRef), command.Flags().Lookup(flagLedgerRef))
+	return command
+}
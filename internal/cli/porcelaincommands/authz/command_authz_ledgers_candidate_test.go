@@ -0,0 +1,139 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azconfigs "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+// TestCreateCommandForLedgersCandidate tests the createCommandForLedgerCandidate function.
+func TestCreateCommandForLedgersCandidate(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command sets the candidate ref of a remote ledger evaluated in shadow mode."}
+	aztestutils.BaseCommandTest(t, createCommandForLedgerCandidate, args, false, outputs)
+}
+
+// TestCliLedgersCandidateWithError tests the command for setting the candidate ref of a ledger with an error.
+func TestCliLedgersCandidateWithError(t *testing.T) {
+	tests := []struct {
+		OutputType string
+		HasError   bool
+	}{
+		{
+			OutputType: "terminal",
+			HasError:   true,
+		},
+		{
+			OutputType: "json",
+			HasError:   true,
+		},
+	}
+	for _, test := range tests {
+		args := []string{"ledgers", "candidate", "--ledger-id", "c3160a533ab24fbcb1eab7a09fd85f36", "--ref", "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80", "--output", test.OutputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForLedgerCandidate(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, test.OutputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		papClient := azmocks.NewGrpcPAPClientMock()
+		papClient.On("UpdateLedgerCandidate", mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrCliArguments)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+		printerMock.On("Error", mock.Anything).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcPAPClient", mock.Anything).Return(papClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, true, outputs)
+		if test.HasError {
+			printerMock.AssertCalled(t, "Error", mock.Anything)
+		} else {
+			printerMock.AssertNotCalled(t, "Error", mock.Anything)
+		}
+	}
+}
+
+// TestCliLedgersCandidateWithSuccess tests the command for setting the candidate ref of a ledger with success.
+func TestCliLedgersCandidateWithSuccess(t *testing.T) {
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"ledgers", "candidate", "--ledger-id", "c3160a533ab24fbcb1eab7a09fd85f36", "--ref", "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80", "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set("output", outputType)
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForLedgerCandidate(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		papClient := azmocks.NewGrpcPAPClientMock()
+		ledger := &azmodelspap.Ledger{
+			LedgerID:     "c3160a533ab24fbcb1eab7a09fd85f36",
+			ZoneID:       581616507495,
+			Name:         "materabranch",
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+			Ref:          "007867724d1aa801216d92d8d08ed2269a55e495575aceb1f46cded8594159ee",
+			CandidateRef: "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80",
+		}
+		papClient.On("UpdateLedgerCandidate", mock.Anything, mock.Anything, mock.Anything).Return(ledger, nil)
+
+		printerMock := azmocks.NewPrinterMock()
+		outputPrinter := map[string]any{}
+
+		if outputType == "terminal" {
+			outputPrinter[ledger.LedgerID] = fmt.Sprintf("ref %s, candidate ref %s", ledger.Ref, ledger.CandidateRef)
+		} else {
+			outputPrinter["ledgers"] = []*azmodelspap.Ledger{ledger}
+		}
+		printerMock.On("PrintMap", outputPrinter).Return()
+		printerMock.On("PrintlnMap", outputPrinter).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcPAPClient", mock.Anything).Return(papClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, false, outputs)
+		printerMock.AssertCalled(t, "PrintlnMap", outputPrinter)
+	}
+}
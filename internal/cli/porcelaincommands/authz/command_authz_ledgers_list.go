@@ -27,6 +27,8 @@ import (
 	azcli "github.com/permguard/permguard/pkg/cli"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
 )
 
 const (
@@ -69,7 +71,20 @@ func runECommandForListLedgers(deps azcli.CliDependenciesProvider, cmd *cobra.Co
 	ledgerID := v.GetString(azoptions.FlagName(commandNameForLedgersList, flagLedgerID))
 	kind := v.GetString(azoptions.FlagName(commandNameForLedgersList, flagLedgerKind))
 	name := v.GetString(azoptions.FlagName(commandNameForLedgersList, aziclicommon.FlagCommonName))
-	ledgers, err := client.FetchLedgersBy(page, pageSize, zoneID, ledgerID, kind, name)
+	query, all, err := aziclicommon.BuildFetchQuery(v, commandNameForLedgersList)
+	if err != nil {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to list ledgers.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to list ledgers", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	ledgers, nextCursor, err := aziclicommon.FetchPages(query, all, func(query *azmodels.FetchQuery) ([]azmodelpap.Ledger, string, error) {
+		return client.FetchLedgersByQuery(page, pageSize, zoneID, ledgerID, kind, name, query)
+	})
 	if err != nil {
 		if ctx.IsNotVerboseTerminalOutput() {
 			printer.Println("Failed to list ledgers.")
@@ -90,6 +105,9 @@ func runECommandForListLedgers(deps azcli.CliDependenciesProvider, cmd *cobra.Co
 	} else if ctx.IsJSONOutput() {
 		output["ledgers"] = ledgers
 	}
+	if nextCursor != "" {
+		output["next_cursor"] = nextCursor
+	}
 	printer.PrintlnMap(output)
 	return nil
 }
@@ -108,6 +126,8 @@ Examples:
   permguard authz ledgers list --zone-id 273165098782 --name v1
   # list all ledgers filtered by ledger id
   permguard authz ledgers list --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f
+  # list all the ledgers with a name prefix, newest first, following all the pages
+  permguard authz ledgers list --zone-id 273165098782 --name-prefix v1 --sort-by created_at --sort-order desc --all
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runECommandForListLedgers(deps, cmd, v)
@@ -125,5 +145,6 @@ Examples:
 
 	command.Flags().String(aziclicommon.FlagCommonName, "", "filter results by ledger name")
 	v.BindPFlag(azoptions.FlagName(commandNameForLedgersList, aziclicommon.FlagCommonName), command.Flags().Lookup(aziclicommon.FlagCommonName))
+	aziclicommon.AddFetchQueryFlags(command, v, commandNameForLedgersList, "ledger")
 	return command
 }
@@ -0,0 +1,56 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+)
+
+const (
+	// commandNameForLedgersPromote is the command name for ledgers promote.
+	commandNameForLedgersPromote = "ledgers-promote"
+)
+
+// runECommandForLedgerPromote runs the command for promoting the candidate ref of a ledger.
+func runECommandForLedgerPromote(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	return runECommandForLedgerCandidate(deps, cmd, v, commandNameForLedgersPromote, true)
+}
+
+// createCommandForLedgerPromote creates a command for promoting the ledger candidate.
+func createCommandForLedgerPromote(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "promote",
+		Short: "Promote the candidate ref of a remote ledger to its active ref",
+		Long: aziclicommon.BuildCliLongTemplate(`This command promotes the candidate ref of a remote ledger to its active ref.
+
+Examples:
+  # promote the candidate ref of a ledger and output the result in json format
+  permguard authz ledgers promote --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --output json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForLedgerPromote(deps, cmd, v)
+		},
+	}
+	command.Flags().String(flagLedgerID, "", "specify the ID of the ledger to promote")
+	v.BindPFlag(azoptions.FlagName(commandNameForLedgersPromote, flagLedgerID), command.Flags().Lookup(flagLedgerID))
+	return command
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+const (
+	// commandNameForLedgersProtect is the command name for ledgers protect.
+	commandNameForLedgersProtect = "ledgers-protect"
+	// flagLedgerRequiredApprovals is the flag for the number of approvals required to move the ledger ref.
+	flagLedgerRequiredApprovals = "required-approvals"
+)
+
+// runECommandForLedgerProtect runs the command for updating the protection of a ledger.
+func runECommandForLedgerProtect(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to update the ledger protection.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to update the ledger protection", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	papTarget, err := ctx.GetPAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcPAPClient(papTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForLedger, aziclicommon.FlagCommonZoneID))
+	ledgerID := v.GetString(azoptions.FlagName(commandNameForLedgersProtect, flagLedgerID))
+	requiredApprovals := v.GetInt32(azoptions.FlagName(commandNameForLedgersProtect, flagLedgerRequiredApprovals))
+	ledger, err := client.UpdateLedgerProtection(zoneID, ledgerID, requiredApprovals)
+	if err != nil {
+		return handleError(err)
+	}
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		output[ledger.LedgerID] = fmt.Sprintf("required approvals %d", ledger.RequiredApprovals)
+	} else if ctx.IsJSONOutput() {
+		output["ledgers"] = []*azmodelspap.Ledger{ledger}
+	}
+	printer.PrintlnMap(output)
+	return nil
+}
+
+// createCommandForLedgerProtect creates a command for updating the protection of a ledger.
+func createCommandForLedgerProtect(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "protect",
+		Short: "Set the number of approvals required to update a remote ledger",
+		Long: aziclicommon.BuildCliLongTemplate(`This command sets the number of approvals required to update a remote ledger.
+
+When the number of required approvals is greater than zero, pushes to the ledger are recorded as pending change requests
+and the ledger ref is moved only once the change request has been approved by enough distinct reviewers.
+Setting the number of required approvals to zero removes the protection.
+
+Examples:
+  # require two approvals to update a ledger and output the result in json format
+  permguard authz ledgers protect --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --required-approvals 2 --output json
+  # remove the protection of a ledger
+  permguard authz ledgers protect --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --required-approvals 0
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForLedgerProtect(deps, cmd, v)
+		},
+	}
+	command.Flags().String(flagLedgerID, "", "specify the ID of the ledger to protect")
+	v.BindPFlag(azoptions.FlagName(commandNameForLedgersProtect, flagLedgerID), command.Flags().Lookup(flagLedgerID))
+	command.Flags().Int32(flagLedgerRequiredApprovals, 0, "specify the number of approvals required to update the ledger")
+	v.BindPFlag(azoptions.FlagName(commandNameForLedgersProtect, flagLedgerRequiredApprovals), command.Flags().Lookup(flagLedgerRequiredApprovals))
+	return command
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+const (
+	// commandNameForSearch is the command name for search.
+	commandNameForSearch = "search"
+	// flagSearchPageToken is the flag for the search page token.
+	flagSearchPageToken = "page-token"
+	// searchKindSubjects is the search kind for subjects.
+	searchKindSubjects = "subjects"
+	// searchKindResources is the search kind for resources.
+	searchKindResources = "resources"
+	// searchKindActions is the search kind for actions.
+	searchKindActions = "actions"
+)
+
+// runECommandForSearch runs the command for executing search.
+func runECommandForSearch(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, args []string, kind string) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error, errCode error, message string) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println(fmt.Sprintf("Failed to search the %s.", kind))
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, message, err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	jsonString, err := readAuthzInput(ctx, args)
+	if err != nil {
+		return handleError(err, azerrors.ErrCliOperation, "Invalid input for the authz search.")
+	}
+	pdpTarget, err := ctx.GetPDPTarget()
+	if err != nil {
+		return handleError(err, azerrors.ErrCliArguments, fmt.Sprintf("failed to search the %s.", kind))
+	}
+	client, err := deps.CreateGrpcPDPClient(pdpTarget)
+	if err != nil {
+		return handleError(err, azerrors.ErrCliArguments, fmt.Sprintf("failed to search the %s.", kind))
+	}
+	pageToken := v.GetString(azoptions.FlagName(commandNameForSearch, flagSearchPageToken))
+	pageSize := v.GetInt32(azoptions.FlagName(commandNameForSearch, aziclicommon.FlagCommonPageSize))
+	applyPage := func(request *azmodelspdp.SearchRequest) {
+		if len(pageToken) == 0 && pageSize == 0 {
+			return
+		}
+		if request.Page == nil {
+			request.Page = &azmodelspdp.SearchPageRequest{}
+		}
+		if len(pageToken) > 0 {
+			request.Page.NextToken = pageToken
+		}
+		if pageSize > 0 {
+			request.Page.Size = pageSize
+		}
+	}
+	results := []string{}
+	var searchResp any
+	var page *azmodelspdp.SearchPageResponse
+	switch kind {
+	case searchKindSubjects:
+		var searchReq azmodelspdp.SubjectSearchRequest
+		if err := json.Unmarshal([]byte(jsonString), &searchReq); err != nil {
+			return handleError(err, azerrors.ErrCliOperation, "Invalid input for the authz search.")
+		}
+		applyPage(&searchReq.SearchRequest)
+		resp, err := client.SubjectSearch(&searchReq)
+		if err != nil {
+			return handleError(err, azerrors.ErrCliArguments, fmt.Sprintf("failed to search the %s.", kind))
+		}
+		for _, subject := range resp.Results {
+			results = append(results, fmt.Sprintf("%s: %s", aziclicommon.KeywordText(subject.Type), aziclicommon.IDText(subject.ID)))
+		}
+		searchResp, page = resp, resp.Page
+	case searchKindResources:
+		var searchReq azmodelspdp.ResourceSearchRequest
+		if err := json.Unmarshal([]byte(jsonString), &searchReq); err != nil {
+			return handleError(err, azerrors.ErrCliOperation, "Invalid input for the authz search.")
+		}
+		applyPage(&searchReq.SearchRequest)
+		resp, err := client.ResourceSearch(&searchReq)
+		if err != nil {
+			return handleError(err, azerrors.ErrCliArguments, fmt.Sprintf("failed to search the %s.", kind))
+		}
+		for _, resource := range resp.Results {
+			results = append(results, fmt.Sprintf("%s: %s", aziclicommon.KeywordText(resource.Type), aziclicommon.IDText(resource.ID)))
+		}
+		searchResp, page = resp, resp.Page
+	case searchKindActions:
+		var searchReq azmodelspdp.ActionSearchRequest
+		if err := json.Unmarshal([]byte(jsonString), &searchReq); err != nil {
+			return handleError(err, azerrors.ErrCliOperation, "Invalid input for the authz search.")
+		}
+		applyPage(&searchReq.SearchRequest)
+		resp, err := client.ActionSearch(&searchReq)
+		if err != nil {
+			return handleError(err, azerrors.ErrCliArguments, fmt.Sprintf("failed to search the %s.", kind))
+		}
+		for _, action := range resp.Results {
+			results = append(results, aziclicommon.IDText(action.Name))
+		}
+		searchResp, page = resp, resp.Page
+	}
+	if ctx.IsTerminalOutput() {
+		if len(results) == 0 {
+			printer.Println(fmt.Sprintf("No %s found.", kind))
+		} else {
+			printer.Println(fmt.Sprintf("Search results for %s:", kind))
+			for _, result := range results {
+				printer.Println(fmt.Sprintf("  - %s", result))
+			}
+		}
+		if page != nil && len(page.NextToken) > 0 {
+			printer.Println(fmt.Sprintf("%s: %s", aziclicommon.KeywordText("Next page token"), aziclicommon.CreateText(page.NextToken)))
+		}
+	} else if ctx.IsJSONOutput() {
+		output := map[string]any{}
+		output[fmt.Sprintf("%s_search", kind)] = searchResp
+		printer.PrintlnMap(output)
+	}
+	return nil
+}
+
+// createCommandForSearchKind creates a command for executing the search of a kind.
+func createCommandForSearchKind(deps azcli.CliDependenciesProvider, v *viper.Viper, kind string, short string, long string) *cobra.Command {
+	return &cobra.Command{
+		Use:   kind,
+		Short: short,
+		Long:  aziclicommon.BuildCliLongTemplate(long),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForSearch(deps, cmd, v, args, kind)
+		},
+	}
+}
+
+// createCommandForSearch creates a command for executing search.
+func createCommandForSearch(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "search",
+		Short: "Search the subjects, resources or actions of an authorization request",
+		Long: aziclicommon.BuildCliLongTemplate(`This command searches the subjects, resources or actions of an authorization request.
+
+Examples:
+  # search the subjects allowed to perform an action on a resource
+  permguard authz search subjects /path/to/subject_search_request.json
+  # search the resources on which a subject is allowed to perform an action
+  permguard authz search resources /path/to/resource_search_request.json
+  # search the actions a subject is allowed to perform on a resource fetching the next page
+  permguard authz search actions --page-token b2Zmc2V0OjEwMA /path/to/action_search_request.json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	command.PersistentFlags().String(flagSearchPageToken, "", "specify the token of the page to be fetched")
+	v.BindPFlag(azoptions.FlagName(commandNameForSearch, flagSearchPageToken), command.PersistentFlags().Lookup(flagSearchPageToken))
+
+	command.PersistentFlags().Int32P(aziclicommon.FlagCommonPageSize, aziclicommon.FlagCommonPageSizeShort, 0, "specify the number of results per page")
+	v.BindPFlag(azoptions.FlagName(commandNameForSearch, aziclicommon.FlagCommonPageSize), command.PersistentFlags().Lookup(aziclicommon.FlagCommonPageSize))
+
+	command.AddCommand(createCommandForSearchKind(deps, v, searchKindSubjects, "Search the subjects allowed to perform an action on a resource",
+		`This command searches the subjects allowed to perform an action on a resource.`))
+	command.AddCommand(createCommandForSearchKind(deps, v, searchKindResources, "Search the resources on which a subject is allowed to perform an action",
+		`This command searches the resources on which a subject is allowed to perform an action.`))
+	command.AddCommand(createCommandForSearchKind(deps, v, searchKindActions, "Search the actions a subject is allowed to perform on a resource",
+		`This command searches the actions a subject is allowed to perform on a resource.`))
+	return command
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+const (
+	// commandNameForTemplateLink is the command name for template link.
+	commandNameForTemplateLink = "templatelink"
+	// flagTemplateID is the flag for template id.
+	flagTemplateID = "template-id"
+	// flagLinkID is the flag for template link id.
+	flagLinkID = "link-id"
+	// flagPrincipal is the flag for the entity bound to the principal slot.
+	flagPrincipal = "principal"
+	// flagResource is the flag for the entity bound to the resource slot.
+	flagResource = "resource"
+	// slotPrincipal is the slot of the principal.
+	slotPrincipal = "?principal"
+	// slotResource is the slot of the resource.
+	slotResource = "?resource"
+)
+
+// parseTemplateLinkEntity parses an entity in the format Type::"id".
+func parseTemplateLinkEntity(value string) (azmodelspap.EntityUID, error) {
+	index := strings.LastIndex(value, "::")
+	if index <= 0 {
+		return azmodelspap.EntityUID{}, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("invalid entity %s, the expected format is Type::\"id\"", value))
+	}
+	entityType := value[:index]
+	entityID := strings.Trim(value[index+len("::"):], "\"")
+	if len(strings.TrimSpace(entityID)) == 0 {
+		return azmodelspap.EntityUID{}, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("invalid entity %s, the expected format is Type::\"id\"", value))
+	}
+	return azmodelspap.EntityUID{Type: entityType, ID: entityID}, nil
+}
+
+// buildTemplateLinksOutput builds the output of the template links.
+func buildTemplateLinksOutput(ctx *aziclicommon.CliCommandContext, links []azmodelspap.TemplateLink) map[string]any {
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		for _, link := range links {
+			output[link.LinkID] = link.TemplateID
+		}
+	} else if ctx.IsJSONOutput() {
+		output["templatelinks"] = links
+	}
+	return output
+}
+
+// runECommandForTemplateLinks runs the command for managing template links.
+func runECommandForTemplateLinks(cmd *cobra.Command, args []string) error {
+	return cmd.Help()
+}
+
+// createCommandForTemplateLinks creates a command for managing template links.
+func createCommandForTemplateLinks(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "templatelinks",
+		Short: "Manage policy template links on the remote server",
+		Long:  aziclicommon.BuildCliLongTemplate(`This command manages policy template links on the remote server.`),
+		RunE:  runECommandForTemplateLinks,
+	}
+
+	command.PersistentFlags().Int64(aziclicommon.FlagCommonZoneID, 0, "zone id")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLink, aziclicommon.FlagCommonZoneID), command.PersistentFlags().Lookup(aziclicommon.FlagCommonZoneID))
+
+	command.PersistentFlags().String(flagLedgerID, "", "ledger id")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLink, flagLedgerID), command.PersistentFlags().Lookup(flagLedgerID))
+
+	command.AddCommand(createCommandForTemplateLinkCreate(deps, v))
+	command.AddCommand(createCommandForTemplateLinkDelete(deps, v))
+	command.AddCommand(createCommandForTemplateLinkList(deps, v))
+	return command
+}
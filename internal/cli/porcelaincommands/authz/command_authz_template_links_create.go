@@ -0,0 +1,117 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+const (
+	// commandNameForTemplateLinksCreate is the command name for template links create.
+	commandNameForTemplateLinksCreate = "templatelinks-create"
+)
+
+// runECommandForCreateTemplateLink runs the command for creating a template link.
+func runECommandForCreateTemplateLink(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to create the template link.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to create the template link", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	link := &azmodelspap.TemplateLink{
+		ZoneID:     v.GetInt64(azoptions.FlagName(commandNameForTemplateLink, aziclicommon.FlagCommonZoneID)),
+		LedgerID:   v.GetString(azoptions.FlagName(commandNameForTemplateLink, flagLedgerID)),
+		LinkID:     v.GetString(azoptions.FlagName(commandNameForTemplateLinksCreate, flagLinkID)),
+		TemplateID: v.GetString(azoptions.FlagName(commandNameForTemplateLinksCreate, flagTemplateID)),
+		Slots:      map[string]azmodelspap.EntityUID{},
+	}
+	slotFlags := map[string]string{slotPrincipal: flagPrincipal, slotResource: flagResource}
+	for slot, flag := range slotFlags {
+		value := v.GetString(azoptions.FlagName(commandNameForTemplateLinksCreate, flag))
+		if len(value) == 0 {
+			continue
+		}
+		entity, err := parseTemplateLinkEntity(value)
+		if err != nil {
+			return handleError(err)
+		}
+		link.Slots[slot] = entity
+	}
+	papTarget, err := ctx.GetPAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcPAPClient(papTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	link, err = client.CreateTemplateLink(link)
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildTemplateLinksOutput(ctx, []azmodelspap.TemplateLink{*link}))
+	return nil
+}
+
+// createCommandForTemplateLinkCreate creates a command for creating a template link.
+func createCommandForTemplateLinkCreate(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "create",
+		Short: "Create a remote policy template link",
+		Long: aziclicommon.BuildCliLongTemplate(`This command creates a remote policy template link binding the slots of a template to concrete entities.
+
+Examples:
+  # share the document x with the user y and output the result in json format
+  permguard authz templatelinks create --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --template-id share-document --principal 'MagicFarmacia::Platform::User::"y"' --resource 'MagicFarmacia::Platform::Document::"x"' --output json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForCreateTemplateLink(deps, cmd, v)
+		},
+	}
+	command.Flags().String(flagTemplateID, "", "specify the id of the template to link")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLinksCreate, flagTemplateID), command.Flags().Lookup(flagTemplateID))
+
+	command.Flags().String(flagLinkID, "", "specify the id of the template link, generated when not provided")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLinksCreate, flagLinkID), command.Flags().Lookup(flagLinkID))
+
+	command.Flags().String(flagPrincipal, "", "specify the entity bound to the ?principal slot")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLinksCreate, flagPrincipal), command.Flags().Lookup(flagPrincipal))
+
+	command.Flags().String(flagResource, "", "specify the entity bound to the ?resource slot")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLinksCreate, flagResource), command.Flags().Lookup(flagResource))
+	return command
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+const (
+	// commandNameForTemplateLinksDelete is the command name for template links delete.
+	commandNameForTemplateLinksDelete = "templatelinks-delete"
+)
+
+// runECommandForDeleteTemplateLink runs the command for deleting a template link.
+func runECommandForDeleteTemplateLink(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to delete the template link.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to delete the template link", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	papTarget, err := ctx.GetPAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcPAPClient(papTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForTemplateLink, aziclicommon.FlagCommonZoneID))
+	ledgerID := v.GetString(azoptions.FlagName(commandNameForTemplateLink, flagLedgerID))
+	linkID := v.GetString(azoptions.FlagName(commandNameForTemplateLinksDelete, flagLinkID))
+	link, err := client.DeleteTemplateLink(zoneID, ledgerID, linkID)
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildTemplateLinksOutput(ctx, []azmodelspap.TemplateLink{*link}))
+	return nil
+}
+
+// createCommandForTemplateLinkDelete creates a command for deleting a template link.
+func createCommandForTemplateLinkDelete(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a remote policy template link",
+		Long: aziclicommon.BuildCliLongTemplate(`This command deletes a remote policy template link.
+
+Examples:
+  # delete a template link and output the result in json format
+  permguard authz templatelinks delete --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --link-id share-x-with-y --output json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForDeleteTemplateLink(deps, cmd, v)
+		},
+	}
+	command.Flags().String(flagLinkID, "", "specify the id of the template link to delete")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLinksDelete, flagLinkID), command.Flags().Lookup(flagLinkID))
+	return command
+}
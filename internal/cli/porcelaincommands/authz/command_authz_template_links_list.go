@@ -0,0 +1,106 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// commandNameForTemplateLinksList is the command name for template links list.
+	commandNameForTemplateLinksList = "templatelinks-list"
+)
+
+// runECommandForListTemplateLinks runs the command for listing the template links.
+func runECommandForListTemplateLinks(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to list template links.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to list template links", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	papTarget, err := ctx.GetPAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcPAPClient(papTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	page := v.GetInt32(azoptions.FlagName(commandNameForTemplateLinksList, aziclicommon.FlagCommonPage))
+	pageSize := v.GetInt32(azoptions.FlagName(commandNameForTemplateLinksList, aziclicommon.FlagCommonPageSize))
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForTemplateLink, aziclicommon.FlagCommonZoneID))
+	ledgerID := v.GetString(azoptions.FlagName(commandNameForTemplateLink, flagLedgerID))
+	templateID := v.GetString(azoptions.FlagName(commandNameForTemplateLinksList, flagTemplateID))
+	linkID := v.GetString(azoptions.FlagName(commandNameForTemplateLinksList, flagLinkID))
+	links, err := client.FetchTemplateLinksBy(page, pageSize, zoneID, ledgerID, templateID, linkID)
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildTemplateLinksOutput(ctx, links))
+	return nil
+}
+
+// createCommandForTemplateLinkList creates a command for listing the template links.
+func createCommandForTemplateLinkList(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List remote policy template links",
+		Long: aziclicommon.BuildCliLongTemplate(`This command lists all remote policy template links of a ledger.
+
+Examples:
+  # list all template links of a ledger and output in json format
+  permguard authz templatelinks list --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --output json
+  # list all template links filtered by template id
+  permguard authz templatelinks list --zone-id 273165098782 --ledger-id 668f3771eacf4094ba8a80942ea5fd3f --template-id share-document
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForListTemplateLinks(deps, cmd, v)
+		},
+	}
+
+	command.Flags().Int32P(aziclicommon.FlagCommonPage, aziclicommon.FlagCommonPageShort, 1, "specify the page number for paginated results")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLinksList, aziclicommon.FlagCommonPage), command.Flags().Lookup(aziclicommon.FlagCommonPage))
+
+	command.Flags().Int32P(aziclicommon.FlagCommonPageSize, aziclicommon.FlagCommonPageSizeShort, 1000, "specify the number of results per page")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLinksList, aziclicommon.FlagCommonPageSize), command.Flags().Lookup(aziclicommon.FlagCommonPageSize))
+
+	command.Flags().String(flagTemplateID, "", "filter results by template id")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLinksList, flagTemplateID), command.Flags().Lookup(flagTemplateID))
+
+	command.Flags().String(flagLinkID, "", "filter results by template link id")
+	v.BindPFlag(azoptions.FlagName(commandNameForTemplateLinksList, flagLinkID), command.Flags().Lookup(flagLinkID))
+	return command
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+// TestCreateCommandForTemplateLinks tests the createCommandForTemplateLinks function.
+func TestCreateCommandForTemplateLinks(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command manages policy template links on the remote server."}
+	aztestutils.BaseCommandTest(t, createCommandForTemplateLinks, args, false, outputs)
+}
+
+// TestParseTemplateLinkEntity tests the parseTemplateLinkEntity function.
+func TestParseTemplateLinkEntity(t *testing.T) {
+	assert := assert.New(t)
+
+	entity, err := parseTemplateLinkEntity(`MagicFarmacia::Platform::User::"y"`)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(azmodelspap.EntityUID{Type: "MagicFarmacia::Platform::User", ID: "y"}, entity)
+
+	entity, err = parseTemplateLinkEntity(`Document::x`)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(azmodelspap.EntityUID{Type: "Document", ID: "x"}, entity)
+
+	for _, value := range []string{"", "x", `::"x"`, `Document::""`} {
+		_, err = parseTemplateLinkEntity(value)
+		assert.NotNil(err, "error should not be nil")
+	}
+}
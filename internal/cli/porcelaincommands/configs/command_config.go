@@ -44,5 +44,6 @@ func CreateCommandForConfig(deps azcli.CliDependenciesProvider, v *viper.Viper)
 	command.AddCommand(createCommandForConfigPAPSet(deps, v))
 	command.AddCommand(createCommandForConfigPDPGet(deps, v))
 	command.AddCommand(createCommandForConfigPDPSet(deps, v))
+	command.AddCommand(createCommandForConfigContext(deps, v))
 	return command
 }
@@ -0,0 +1,48 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+)
+
+// runECommandForContext runs the command for managing the cli contexts.
+func runECommandForContext(cmd *cobra.Command, args []string) error {
+	return cmd.Help()
+}
+
+// createCommandForConfigContext creates a command for managing the cli contexts.
+func createCommandForConfigContext(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "context",
+		Short: "Manage the cli contexts",
+		Long: aziclicommon.BuildCliLongTemplate(`This command manages the cli contexts.
+
+A context bundles the zap, pap and pdp targets, the tls and credential settings and the default zone of an environment.
+The active context can be overridden for a single command with the --context flag.`),
+		RunE: runECommandForContext,
+	}
+	command.AddCommand(createCommandForConfigContextCreate(deps, v))
+	command.AddCommand(createCommandForConfigContextUse(deps, v))
+	command.AddCommand(createCommandForConfigContextList(deps, v))
+	command.AddCommand(createCommandForConfigContextDelete(deps, v))
+	return command
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	azvalidators "github.com/permguard/permguard-common/pkg/extensions/validators"
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// commandNameForConfigContextCreate is the command name for config context create.
+	commandNameForConfigContextCreate = "config-context-create"
+	// flagContextZAPTarget is the flag for the zap target of the context.
+	flagContextZAPTarget = "zap-target"
+	// flagContextPAPTarget is the flag for the pap target of the context.
+	flagContextPAPTarget = "pap-target"
+	// flagContextPDPTarget is the flag for the pdp target of the context.
+	flagContextPDPTarget = "pdp-target"
+	// flagContextTLS is the flag to enable tls.
+	flagContextTLS = "tls"
+	// flagContextTLSCAFile is the flag for the tls ca file.
+	flagContextTLSCAFile = "tls-ca-file"
+	// flagContextTLSSkipVerify is the flag to skip the verification of the server certificate.
+	flagContextTLSSkipVerify = "tls-skip-verify"
+	// flagContextToken is the flag for the bearer token.
+	flagContextToken = "token"
+	// flagContextUse is the flag to activate the context once created.
+	flagContextUse = "use"
+)
+
+// runECommandForContextCreate runs the command for creating a cli context.
+func runECommandForContextCreate(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, args []string) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	printErr := func(errCode error, err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to create the context.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, "failed to create the context", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	if len(args) != 1 {
+		return printErr(azerrors.ErrCliArguments, fmt.Errorf("cli: the context name is required"))
+	}
+	flagValue := func(flag string) string {
+		return v.GetString(azoptions.FlagName(commandNameForConfigContextCreate, flag))
+	}
+	profile := &aziclicommon.CliContextProfile{
+		Name:          args[0],
+		ZAPTarget:     flagValue(flagContextZAPTarget),
+		PAPTarget:     flagValue(flagContextPAPTarget),
+		PDPTarget:     flagValue(flagContextPDPTarget),
+		ZoneID:        v.GetInt64(azoptions.FlagName(commandNameForConfigContextCreate, aziclicommon.FlagCommonZoneID)),
+		TLS:           v.GetBool(azoptions.FlagName(commandNameForConfigContextCreate, flagContextTLS)),
+		TLSCAFile:     flagValue(flagContextTLSCAFile),
+		TLSSkipVerify: v.GetBool(azoptions.FlagName(commandNameForConfigContextCreate, flagContextTLSSkipVerify)),
+		Token:         flagValue(flagContextToken),
+	}
+	if err := aziclicommon.ValidateCliContextName(profile.Name); err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	for _, target := range []string{profile.ZAPTarget, profile.PAPTarget, profile.PDPTarget} {
+		if target != "" && !azvalidators.IsValidHostnamePort(target) {
+			return printErr(azerrors.ErrCliArguments, fmt.Errorf("cli: invalid target %s", target))
+		}
+	}
+	if profile.ZoneID < 0 {
+		return printErr(azerrors.ErrCliArguments, fmt.Errorf("cli: invalid zone id %d", profile.ZoneID))
+	}
+	if (profile.TLSCAFile != "" || profile.TLSSkipVerify || profile.Token != "") && !profile.TLS {
+		return printErr(azerrors.ErrCliArguments, fmt.Errorf("cli: the tls ca file, the tls skip verify and the token settings require tls"))
+	}
+	if _, err := aziclicommon.ReadCliContextProfile(v, profile.Name); err == nil {
+		return printErr(azerrors.ErrCliRecordExists, fmt.Errorf("cli: the context %s already exists", profile.Name))
+	}
+	if err := aziclicommon.WriteCliContextProfile(v, profile); err != nil {
+		return printErr(azerrors.ErrCliOperation, err)
+	}
+	active := v.GetBool(azoptions.FlagName(commandNameForConfigContextCreate, flagContextUse))
+	if active {
+		if err := aziclicommon.SetActiveCliContextProfile(v, profile.Name); err != nil {
+			return printErr(azerrors.ErrCliOperation, err)
+		}
+	}
+	if ctx.IsTerminalOutput() {
+		printer.Println(fmt.Sprintf("The context %s has been created.", aziclicommon.KeywordText(profile.Name)))
+	} else if ctx.IsJSONOutput() {
+		printer.PrintlnMap(map[string]any{"contexts": []*aziclicommon.CliContextProfile{profile}, "active": active})
+	}
+	return nil
+}
+
+// createCommandForConfigContextCreate creates a command for creating a cli context.
+func createCommandForConfigContextCreate(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "create",
+		Short: "Create a cli context",
+		Long: aziclicommon.BuildCliLongTemplate(`This command creates a cli context, the targets not set fall back to the ones configured with the set-target commands.
+
+Examples:
+  # create a context for the staging environment and make it the active one
+  permguard config context create staging --zap-target zap.staging.example.com:443 --pap-target pap.staging.example.com:443 --pdp-target pdp.staging.example.com:443 --tls --zone-id 273165098782 --use
+  # create a context for the local environment
+  permguard config context create dev --zap-target localhost:9091 --pap-target localhost:9092 --pdp-target localhost:9094
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForContextCreate(deps, cmd, v, args)
+		},
+	}
+	for flag, usage := range map[string]string{
+		flagContextZAPTarget: "specify the zap grpc target",
+		flagContextPAPTarget: "specify the pap grpc target",
+		flagContextPDPTarget: "specify the pdp grpc target",
+		flagContextTLSCAFile: "specify the pem file of the certificate authorities used to verify the servers",
+		flagContextToken:     "specify the bearer token sent to the servers",
+	} {
+		command.Flags().String(flag, "", usage)
+		v.BindPFlag(azoptions.FlagName(commandNameForConfigContextCreate, flag), command.Flags().Lookup(flag))
+	}
+	command.Flags().Int64(aziclicommon.FlagCommonZoneID, 0, "specify the default zone id")
+	v.BindPFlag(azoptions.FlagName(commandNameForConfigContextCreate, aziclicommon.FlagCommonZoneID), command.Flags().Lookup(aziclicommon.FlagCommonZoneID))
+	for flag, usage := range map[string]string{
+		flagContextTLS:           "connect to the servers with tls",
+		flagContextTLSSkipVerify: "skip the verification of the server certificates",
+		flagContextUse:           "make the context the active one",
+	} {
+		command.Flags().Bool(flag, false, usage)
+		v.BindPFlag(azoptions.FlagName(commandNameForConfigContextCreate, flag), command.Flags().Lookup(flag))
+	}
+	return command
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+)
+
+// TestCreateCommandForConfigContextCreate tests the createCommandForConfigContextCreate function.
+func TestCreateCommandForConfigContextCreate(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command creates a cli context"}
+	aztestutils.BaseCommandTest(t, createCommandForConfigContextCreate, args, false, outputs)
+}
+
+// TestCliConfigContextCreateWithError tests the command for creating a cli context with an error.
+func TestCliConfigContextCreateWithError(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"Prod"},
+		{"prod", "--zap-target", "invalid target"},
+		{"prod", "--token", "secret"},
+	}
+	for _, outputType := range []string{"terminal", "json"} {
+		for _, test := range tests {
+			args := append(append([]string{}, test...), "--output", outputType)
+			v := newContextTestViper(t)
+			cmd, printerMock := newContextTestCommand(v, createCommandForConfigContextCreate, outputType)
+			aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, true, []string{""})
+			printerMock.AssertCalled(t, "Error", mock.Anything)
+		}
+	}
+}
+
+// TestCliConfigContextCreateWithSuccess tests the command for creating a cli context.
+func TestCliConfigContextCreateWithSuccess(t *testing.T) {
+	for _, outputType := range []string{"terminal", "json"} {
+		assert := assert.New(t)
+		args := []string{"prod", "--zap-target", "zap.example.com:443", "--zone-id", "273165098782", "--tls", "--token", "secret", "--use", "--output", outputType}
+		v := newContextTestViper(t)
+		cmd, printerMock := newContextTestCommand(v, createCommandForConfigContextCreate, outputType)
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, false, []string{""})
+		printerMock.AssertNotCalled(t, "Error", mock.Anything)
+
+		v = reloadContextTestViper(t)
+		profile, err := aziclicommon.ReadActiveCliContextProfile(v)
+		assert.Nil(err)
+		assert.Equal(&aziclicommon.CliContextProfile{Name: "prod", ZAPTarget: "zap.example.com:443", ZoneID: 273165098782, TLS: true, Token: "secret"}, profile)
+
+		cmd, printerMock = newContextTestCommand(v, createCommandForConfigContextCreate, outputType)
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, []string{"prod", "--output", outputType}, true, []string{""})
+		printerMock.AssertCalled(t, "Error", mock.Anything)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// runECommandForContextDelete runs the command for deleting a cli context.
+func runECommandForContextDelete(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, args []string) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	printErr := func(errCode error, err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to delete the context.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, "failed to delete the context", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	if len(args) != 1 {
+		return printErr(azerrors.ErrCliArguments, fmt.Errorf("cli: the context name is required"))
+	}
+	name := args[0]
+	if err := aziclicommon.DeleteCliContextProfile(v, name); err != nil {
+		return printErr(azerrors.ErrCliOperation, err)
+	}
+	if ctx.IsTerminalOutput() {
+		printer.Println(fmt.Sprintf("The context %s has been deleted.", aziclicommon.KeywordText(name)))
+	} else if ctx.IsJSONOutput() {
+		printer.PrintlnMap(map[string]any{"context": name})
+	}
+	return nil
+}
+
+// createCommandForConfigContextDelete creates a command for deleting a cli context.
+func createCommandForConfigContextDelete(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a cli context",
+		Long: aziclicommon.BuildCliLongTemplate(`This command deletes a cli context, no context is active once the active one is deleted.
+
+Examples:
+  # delete the staging context
+  permguard config context delete staging
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForContextDelete(deps, cmd, v, args)
+		},
+	}
+	return command
+}
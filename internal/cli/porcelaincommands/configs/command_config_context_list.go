@@ -0,0 +1,95 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// runECommandForContextList runs the command for listing the cli contexts.
+func runECommandForContextList(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	profiles, err := aziclicommon.ReadCliContextProfiles(v)
+	if err != nil {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to list the contexts.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliConfiguration, "failed to list the contexts", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	activeName := ctx.GetCliContextName()
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		for _, profile := range profiles {
+			details := []string{}
+			for _, setting := range [][2]string{{"zap", profile.ZAPTarget}, {"pap", profile.PAPTarget}, {"pdp", profile.PDPTarget}} {
+				if setting[1] != "" {
+					details = append(details, fmt.Sprintf("%s %s", setting[0], setting[1]))
+				}
+			}
+			if profile.ZoneID > 0 {
+				details = append(details, fmt.Sprintf("zone %d", profile.ZoneID))
+			}
+			if profile.TLS {
+				details = append(details, "tls")
+			}
+			if profile.Name == activeName {
+				details = append(details, "active")
+			}
+			output[profile.Name] = strings.Join(details, ", ")
+		}
+	} else if ctx.IsJSONOutput() {
+		output["contexts"] = profiles
+		output["active"] = activeName
+	}
+	printer.PrintlnMap(output)
+	return nil
+}
+
+// createCommandForConfigContextList creates a command for listing the cli contexts.
+func createCommandForConfigContextList(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List the cli contexts",
+		Long: aziclicommon.BuildCliLongTemplate(`This command lists the cli contexts, the tokens are never shown.
+
+Examples:
+  # list the contexts
+  permguard config context list
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForContextList(deps, cmd, v)
+		},
+	}
+	return command
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+)
+
+// TestCreateCommandForConfigContextList tests the createCommandForConfigContextList function.
+func TestCreateCommandForConfigContextList(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command lists the cli contexts"}
+	aztestutils.BaseCommandTest(t, createCommandForConfigContextList, args, false, outputs)
+}
+
+// TestCliConfigContextListWithSuccess tests the command for listing the cli contexts.
+func TestCliConfigContextListWithSuccess(t *testing.T) {
+	for _, outputType := range []string{"terminal", "json"} {
+		assert := assert.New(t)
+		v := newContextTestViper(t)
+		dev := &aziclicommon.CliContextProfile{Name: "dev", ZAPTarget: "localhost:9091"}
+		prod := &aziclicommon.CliContextProfile{Name: "prod", ZAPTarget: "zap.example.com:443", ZoneID: 273165098782, TLS: true, Token: "secret"}
+		assert.Nil(aziclicommon.WriteCliContextProfile(v, dev))
+		assert.Nil(aziclicommon.WriteCliContextProfile(reloadContextTestViper(t), prod))
+		assert.Nil(aziclicommon.UseCliContextProfile(reloadContextTestViper(t), "prod"))
+		v = reloadContextTestViper(t)
+
+		var expected map[string]any
+		if outputType == "terminal" {
+			expected = map[string]any{"dev": "zap localhost:9091", "prod": "zap zap.example.com:443, zone 273165098782, tls, active"}
+		} else {
+			expected = map[string]any{"contexts": []*aziclicommon.CliContextProfile{dev, prod}, "active": "prod"}
+		}
+		cmd, printerMock := newContextTestCommand(v, createCommandForConfigContextList, outputType)
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, []string{"--output", outputType}, false, []string{""})
+		printerMock.AssertCalled(t, "PrintlnMap", expected)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+)
+
+// TestCreateCommandForConfigContext tests the createCommandForConfigContext function.
+func TestCreateCommandForConfigContext(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command manages the cli contexts."}
+	aztestutils.BaseCommandTest(t, createCommandForConfigContext, args, false, outputs)
+}
+
+// newContextTestViper creates a viper backed by a config file in a temporary home directory.
+func newContextTestViper(t *testing.T) *viper.Viper {
+	t.Setenv("HOME", t.TempDir())
+	return reloadContextTestViper(t)
+}
+
+// newContextTestCommand creates a context command using the input viper.
+func newContextTestCommand(v *viper.Viper, createCommand func(azcli.CliDependenciesProvider, *viper.Viper) *cobra.Command, outputType string) (*cobra.Command, *azmocks.PrinterMock) {
+	v.Set("output", outputType)
+
+	depsMocks := azmocks.NewCliDependenciesMock()
+	cmd := createCommand(depsMocks, v)
+	cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+	cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+	cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+	printerMock := azmocks.NewPrinterMock()
+	printerMock.On("Println", mock.Anything).Return()
+	printerMock.On("PrintlnMap", mock.Anything).Return()
+	printerMock.On("Error", mock.Anything).Return()
+	depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+	return cmd, printerMock
+}
+
+// reloadContextTestViper reloads the viper from the config file of the temporary home directory.
+func reloadContextTestViper(t *testing.T) *viper.Viper {
+	v, err := azoptions.NewViperFromConfig(nil)
+	assert.Nil(t, err)
+	return v
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// runECommandForContextUse runs the command for activating a cli context.
+func runECommandForContextUse(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, args []string) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	printErr := func(errCode error, err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to use the context.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, "failed to use the context", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	if len(args) != 1 {
+		return printErr(azerrors.ErrCliArguments, fmt.Errorf("cli: the context name is required"))
+	}
+	name := args[0]
+	if err := aziclicommon.UseCliContextProfile(v, name); err != nil {
+		return printErr(azerrors.ErrCliOperation, err)
+	}
+	if ctx.IsTerminalOutput() {
+		printer.Println(fmt.Sprintf("The context %s is now the active one.", aziclicommon.KeywordText(name)))
+	} else if ctx.IsJSONOutput() {
+		printer.PrintlnMap(map[string]any{"context": name})
+	}
+	return nil
+}
+
+// createCommandForConfigContextUse creates a command for activating a cli context.
+func createCommandForConfigContextUse(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "use",
+		Short: "Use a cli context",
+		Long: aziclicommon.BuildCliLongTemplate(`This command makes a cli context the active one.
+
+Examples:
+  # switch to the prod context
+  permguard config context use prod
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForContextUse(deps, cmd, v, args)
+		},
+	}
+	return command
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+)
+
+// TestCreateCommandForConfigContextUse tests the createCommandForConfigContextUse function.
+func TestCreateCommandForConfigContextUse(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command makes a cli context the active one."}
+	aztestutils.BaseCommandTest(t, createCommandForConfigContextUse, args, false, outputs)
+}
+
+// TestCliConfigContextUseWithError tests the command for using a cli context with an error.
+func TestCliConfigContextUseWithError(t *testing.T) {
+	for _, outputType := range []string{"terminal", "json"} {
+		v := newContextTestViper(t)
+		cmd, printerMock := newContextTestCommand(v, createCommandForConfigContextUse, outputType)
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, []string{"staging", "--output", outputType}, true, []string{""})
+		printerMock.AssertCalled(t, "Error", mock.Anything)
+	}
+}
+
+// TestCliConfigContextUseWithSuccess tests the command for using a cli context.
+func TestCliConfigContextUseWithSuccess(t *testing.T) {
+	for _, outputType := range []string{"terminal", "json"} {
+		assert := assert.New(t)
+		v := newContextTestViper(t)
+		assert.Nil(aziclicommon.WriteCliContextProfile(v, &aziclicommon.CliContextProfile{Name: "staging", ZAPTarget: "localhost:9091"}))
+		v = reloadContextTestViper(t)
+		cmd, printerMock := newContextTestCommand(v, createCommandForConfigContextUse, outputType)
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, []string{"staging", "--output", outputType}, false, []string{""})
+		printerMock.AssertNotCalled(t, "Error", mock.Anything)
+		assert.Equal("staging", aziclicommon.GetActiveCliContextName(reloadContextTestViper(t)))
+	}
+}
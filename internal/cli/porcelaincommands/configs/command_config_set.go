@@ -42,6 +42,15 @@ func viperWriteEndpoint(v *viper.Viper, key string, value string) error {
 	return azclioptions.OverrideViperFromConfig(v, valueMap)
 }
 
+// targetConfigKey returns the config key of a target, the key of the active cli context is returned when a context is active.
+func targetConfigKey(ctx *aziclicommon.CliCommandContext, prefix string, suffix string) string {
+	key := azoptions.FlagName(prefix, suffix)
+	if name := ctx.GetCliContextName(); name != "" {
+		return aziclicommon.CliContextConfigKey(name, key)
+	}
+	return key
+}
+
 // runECommandForZAPSet runs the command for setting the zap gRPC target.
 func runECommandForZAPSet(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, args []string) error {
 	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
@@ -59,7 +68,7 @@ func runECommandForZAPSet(deps azcli.CliDependenciesProvider, cmd *cobra.Command
 		}
 		return aziclicommon.ErrCommandSilent
 	}
-	err = viperWriteEndpoint(v, azoptions.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), args[0])
+	err = viperWriteEndpoint(v, targetConfigKey(ctx, aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), args[0])
 	if err != nil {
 		if ctx.IsNotVerboseTerminalOutput() {
 			printer.Println("Failed to set the zap target.")
@@ -90,7 +99,7 @@ func runECommandForPAPSet(deps azcli.CliDependenciesProvider, cmd *cobra.Command
 		}
 		return aziclicommon.ErrCommandSilent
 	}
-	err = viperWriteEndpoint(v, azoptions.FlagName(aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), args[0])
+	err = viperWriteEndpoint(v, targetConfigKey(ctx, aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), args[0])
 	if err != nil {
 		if ctx.IsNotVerboseTerminalOutput() {
 			printer.Println("Failed to set the pap target.")
@@ -121,7 +130,7 @@ func runECommandForPDPSet(deps azcli.CliDependenciesProvider, cmd *cobra.Command
 		}
 		return aziclicommon.ErrCommandSilent
 	}
-	err = viperWriteEndpoint(v, azoptions.FlagName(aziclicommon.FlagPrefixPDP, aziclicommon.FlagSuffixPDPTarget), args[0])
+	err = viperWriteEndpoint(v, targetConfigKey(ctx, aziclicommon.FlagPrefixPDP, aziclicommon.FlagSuffixPDPTarget), args[0])
 	if err != nil {
 		if ctx.IsNotVerboseTerminalOutput() {
 			printer.Println("Failed to set the zap target.")
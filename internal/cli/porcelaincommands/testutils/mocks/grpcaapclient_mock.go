@@ -20,6 +20,7 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
@@ -98,6 +99,16 @@ func (m *GrpcZAPClientMock) FetchZonesBy(page int32, pageSize int32, zoneID int6
 	return r0, args.Error(1)
 }
 
+// FetchZonesByQuery returns the zones filtering by the criteria and the fetch query along with the cursor of the next page.
+func (m *GrpcZAPClientMock) FetchZonesByQuery(page int32, pageSize int32, zoneID int64, name string, query *azmodels.FetchQuery) ([]azmodelzap.Zone, string, error) {
+	args := m.Called(page, pageSize, zoneID, name, query)
+	var r0 []azmodelzap.Zone
+	if val, ok := args.Get(0).([]azmodelzap.Zone); ok {
+		r0 = val
+	}
+	return r0, args.String(1), args.Error(2)
+}
+
 // CreateIdentity creates a new identity.
 func (m *GrpcZAPClientMock) CreateIdentity(zoneID int64, identitySourceID string, kind string, name string) (*azmodelzap.Identity, error) {
 	args := m.Called(zoneID, identitySourceID, kind, name)
@@ -168,6 +179,26 @@ func (m *GrpcZAPClientMock) FetchIdentitiesBy(page int32, pageSize int32, zoneID
 	return r0, args.Error(1)
 }
 
+// FetchIdentitiesByQuery returns the identities filtering by the criteria and the fetch query along with the cursor of the next page.
+func (m *GrpcZAPClientMock) FetchIdentitiesByQuery(page int32, pageSize int32, zoneID int64, identitySourceID string, identityID string, kind string, name string, query *azmodels.FetchQuery) ([]azmodelzap.Identity, string, error) {
+	args := m.Called(page, pageSize, zoneID, identitySourceID, identityID, kind, name, query)
+	var r0 []azmodelzap.Identity
+	if val, ok := args.Get(0).([]azmodelzap.Identity); ok {
+		r0 = val
+	}
+	return r0, args.String(1), args.Error(2)
+}
+
+// UpsertIdentities creates or updates identities in bulk.
+func (m *GrpcZAPClientMock) UpsertIdentities(options *azmodelzap.BulkUpsertOptions, identities []azmodelzap.Identity) (*azmodelzap.BulkUpsertReport, error) {
+	args := m.Called(options, identities)
+	var r0 *azmodelzap.BulkUpsertReport
+	if val, ok := args.Get(0).(*azmodelzap.BulkUpsertReport); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
 // CreateIdentitySource creates a new identity source.
 func (m *GrpcZAPClientMock) CreateIdentitySource(zoneID int64, name string) (*azmodelzap.IdentitySource, error) {
 	args := m.Called(zoneID, name)
@@ -238,6 +269,16 @@ func (m *GrpcZAPClientMock) FetchIdentitySourcesBy(page int32, pageSize int32, z
 	return r0, args.Error(1)
 }
 
+// FetchIdentitySourcesByQuery returns the identity sources filtering by the criteria and the fetch query along with the cursor of the next page.
+func (m *GrpcZAPClientMock) FetchIdentitySourcesByQuery(page int32, pageSize int32, zoneID int64, identitySourceID string, name string, query *azmodels.FetchQuery) ([]azmodelzap.IdentitySource, string, error) {
+	args := m.Called(page, pageSize, zoneID, identitySourceID, name, query)
+	var r0 []azmodelzap.IdentitySource
+	if val, ok := args.Get(0).([]azmodelzap.IdentitySource); ok {
+		r0 = val
+	}
+	return r0, args.String(1), args.Error(2)
+}
+
 // CreateTenant creates a tenant.
 func (m *GrpcZAPClientMock) CreateTenant(zoneID int64, name string) (*azmodelzap.Tenant, error) {
 	args := m.Called(zoneID, name)
@@ -308,6 +349,86 @@ func (m *GrpcZAPClientMock) FetchTenantsBy(page int32, pageSize int32, zoneID in
 	return r0, args.Error(1)
 }
 
+// FetchTenantsByQuery returns the tenants filtering by the criteria and the fetch query along with the cursor of the next page.
+func (m *GrpcZAPClientMock) FetchTenantsByQuery(page int32, pageSize int32, zoneID int64, tenantID string, name string, query *azmodels.FetchQuery) ([]azmodelzap.Tenant, string, error) {
+	args := m.Called(page, pageSize, zoneID, tenantID, name, query)
+	var r0 []azmodelzap.Tenant
+	if val, ok := args.Get(0).([]azmodelzap.Tenant); ok {
+		r0 = val
+	}
+	return r0, args.String(1), args.Error(2)
+}
+
+// UpsertTenants creates or updates tenants in bulk.
+func (m *GrpcZAPClientMock) UpsertTenants(options *azmodelzap.BulkUpsertOptions, tenants []azmodelzap.Tenant) (*azmodelzap.BulkUpsertReport, error) {
+	args := m.Called(options, tenants)
+	var r0 *azmodelzap.BulkUpsertReport
+	if val, ok := args.Get(0).(*azmodelzap.BulkUpsertReport); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// CreateWebhook creates a webhook.
+func (m *GrpcZAPClientMock) CreateWebhook(webhook *azmodelzap.Webhook) (*azmodelzap.Webhook, error) {
+	args := m.Called(webhook)
+	var r0 *azmodelzap.Webhook
+	if val, ok := args.Get(0).(*azmodelzap.Webhook); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// UpdateWebhook updates a webhook.
+func (m *GrpcZAPClientMock) UpdateWebhook(webhook *azmodelzap.Webhook) (*azmodelzap.Webhook, error) {
+	args := m.Called(webhook)
+	var r0 *azmodelzap.Webhook
+	if val, ok := args.Get(0).(*azmodelzap.Webhook); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// DeleteWebhook deletes a webhook.
+func (m *GrpcZAPClientMock) DeleteWebhook(zoneID int64, webhookID string) (*azmodelzap.Webhook, error) {
+	args := m.Called(zoneID, webhookID)
+	var r0 *azmodelzap.Webhook
+	if val, ok := args.Get(0).(*azmodelzap.Webhook); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchWebhooksBy returns all webhooks filtering by webhook id and name.
+func (m *GrpcZAPClientMock) FetchWebhooksBy(page int32, pageSize int32, zoneID int64, webhookID string, name string) ([]azmodelzap.Webhook, error) {
+	args := m.Called(page, pageSize, zoneID, webhookID, name)
+	var r0 []azmodelzap.Webhook
+	if val, ok := args.Get(0).([]azmodelzap.Webhook); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchWebhookDeliveriesBy returns the webhook deliveries filtering by webhook id and status.
+func (m *GrpcZAPClientMock) FetchWebhookDeliveriesBy(page int32, pageSize int32, zoneID int64, webhookID string, status string) ([]azmodelzap.WebhookDelivery, error) {
+	args := m.Called(page, pageSize, zoneID, webhookID, status)
+	var r0 []azmodelzap.WebhookDelivery
+	if val, ok := args.Get(0).([]azmodelzap.WebhookDelivery); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// RequeueWebhookDelivery requeues a dead webhook delivery.
+func (m *GrpcZAPClientMock) RequeueWebhookDelivery(zoneID int64, deliveryID int64) (*azmodelzap.WebhookDelivery, error) {
+	args := m.Called(zoneID, deliveryID)
+	var r0 *azmodelzap.WebhookDelivery
+	if val, ok := args.Get(0).(*azmodelzap.WebhookDelivery); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
 // NewGrpcZAPClientMock creates a new GrpcZAPClientMock.
 func NewGrpcZAPClientMock() *GrpcZAPClientMock {
 	return &GrpcZAPClientMock{}
@@ -20,6 +20,7 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
 )
 
@@ -48,6 +49,36 @@ func (m *GrpcPAPClientMock) UpdateLedger(ledger *azmodelspap.Ledger) (*azmodelsp
 	return r0, args.Error(1)
 }
 
+// UpdateLedgerCandidate updates the candidate ref of a ledger evaluated in shadow mode.
+func (m *GrpcPAPClientMock) UpdateLedgerCandidate(zoneID int64, ledgerID string, candidateRef string) (*azmodelspap.Ledger, error) {
+	args := m.Called(zoneID, ledgerID, candidateRef)
+	var r0 *azmodelspap.Ledger
+	if val, ok := args.Get(0).(*azmodelspap.Ledger); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// PromoteLedgerCandidate promotes the candidate ref of a ledger to its active ref.
+func (m *GrpcPAPClientMock) PromoteLedgerCandidate(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error) {
+	args := m.Called(zoneID, ledgerID)
+	var r0 *azmodelspap.Ledger
+	if val, ok := args.Get(0).(*azmodelspap.Ledger); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// UpdateLedgerProtection updates the number of approvals required to move the ref of a ledger.
+func (m *GrpcPAPClientMock) UpdateLedgerProtection(zoneID int64, ledgerID string, requiredApprovals int32) (*azmodelspap.Ledger, error) {
+	args := m.Called(zoneID, ledgerID, requiredApprovals)
+	var r0 *azmodelspap.Ledger
+	if val, ok := args.Get(0).(*azmodelspap.Ledger); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
 // DeleteLedger deletes a ledger.
 func (m *GrpcPAPClientMock) DeleteLedger(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error) {
 	args := m.Called(zoneID, ledgerID)
@@ -98,6 +129,126 @@ func (m *GrpcPAPClientMock) FetchLedgersBy(page int32, pageSize int32, zoneID in
 	return r0, args.Error(1)
 }
 
+// FetchLedgersByQuery returns the ledgers filtering by the criteria and the fetch query along with the cursor of the next page.
+func (m *GrpcPAPClientMock) FetchLedgersByQuery(page int32, pageSize int32, zoneID int64, ledgerID string, kind string, name string, query *azmodels.FetchQuery) ([]azmodelspap.Ledger, string, error) {
+	args := m.Called(page, pageSize, zoneID, ledgerID, kind, name, query)
+	var r0 []azmodelspap.Ledger
+	if val, ok := args.Get(0).([]azmodelspap.Ledger); ok {
+		r0 = val
+	}
+	return r0, args.String(1), args.Error(2)
+}
+
+// CreateEntity creates an entity.
+func (m *GrpcPAPClientMock) CreateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error) {
+	args := m.Called(ledgerID, entity)
+	var r0 *azmodelspap.Entity
+	if val, ok := args.Get(0).(*azmodelspap.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// UpdateEntity updates an entity.
+func (m *GrpcPAPClientMock) UpdateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error) {
+	args := m.Called(ledgerID, entity)
+	var r0 *azmodelspap.Entity
+	if val, ok := args.Get(0).(*azmodelspap.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// UpsertEntities creates or replaces a set of entities.
+func (m *GrpcPAPClientMock) UpsertEntities(zoneID int64, ledgerID string, entities []azmodelspap.Entity) ([]azmodelspap.Entity, error) {
+	args := m.Called(zoneID, ledgerID, entities)
+	var r0 []azmodelspap.Entity
+	if val, ok := args.Get(0).([]azmodelspap.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// DeleteEntity deletes an entity.
+func (m *GrpcPAPClientMock) DeleteEntity(zoneID int64, entityType string, entityID string) (*azmodelspap.Entity, error) {
+	args := m.Called(zoneID, entityType, entityID)
+	var r0 *azmodelspap.Entity
+	if val, ok := args.Get(0).(*azmodelspap.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchEntitiesBy returns all entities filtering by entity type and entity id.
+func (m *GrpcPAPClientMock) FetchEntitiesBy(page int32, pageSize int32, zoneID int64, entityType string, entityID string) ([]azmodelspap.Entity, error) {
+	args := m.Called(page, pageSize, zoneID, entityType, entityID)
+	var r0 []azmodelspap.Entity
+	if val, ok := args.Get(0).([]azmodelspap.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// CreateTemplateLink creates a template link.
+func (m *GrpcPAPClientMock) CreateTemplateLink(link *azmodelspap.TemplateLink) (*azmodelspap.TemplateLink, error) {
+	args := m.Called(link)
+	var r0 *azmodelspap.TemplateLink
+	if val, ok := args.Get(0).(*azmodelspap.TemplateLink); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// DeleteTemplateLink deletes a template link.
+func (m *GrpcPAPClientMock) DeleteTemplateLink(zoneID int64, ledgerID string, linkID string) (*azmodelspap.TemplateLink, error) {
+	args := m.Called(zoneID, ledgerID, linkID)
+	var r0 *azmodelspap.TemplateLink
+	if val, ok := args.Get(0).(*azmodelspap.TemplateLink); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchTemplateLinksBy returns all template links of a ledger filtering by template id and link id.
+func (m *GrpcPAPClientMock) FetchTemplateLinksBy(page int32, pageSize int32, zoneID int64, ledgerID string, templateID string, linkID string) ([]azmodelspap.TemplateLink, error) {
+	args := m.Called(page, pageSize, zoneID, ledgerID, templateID, linkID)
+	var r0 []azmodelspap.TemplateLink
+	if val, ok := args.Get(0).([]azmodelspap.TemplateLink); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchChangeRequestsBy returns all change requests of a ledger filtering by change request id and status.
+func (m *GrpcPAPClientMock) FetchChangeRequestsBy(page int32, pageSize int32, zoneID int64, ledgerID string, changeRequestID string, status string) ([]azmodelspap.ChangeRequest, error) {
+	args := m.Called(page, pageSize, zoneID, ledgerID, changeRequestID, status)
+	var r0 []azmodelspap.ChangeRequest
+	if val, ok := args.Get(0).([]azmodelspap.ChangeRequest); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// ApproveChangeRequest approves a pending change request.
+func (m *GrpcPAPClientMock) ApproveChangeRequest(zoneID int64, ledgerID string, changeRequestID string, comment string) (*azmodelspap.ChangeRequest, error) {
+	args := m.Called(zoneID, ledgerID, changeRequestID, comment)
+	var r0 *azmodelspap.ChangeRequest
+	if val, ok := args.Get(0).(*azmodelspap.ChangeRequest); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// RejectChangeRequest rejects a pending change request.
+func (m *GrpcPAPClientMock) RejectChangeRequest(zoneID int64, ledgerID string, changeRequestID string, comment string) (*azmodelspap.ChangeRequest, error) {
+	args := m.Called(zoneID, ledgerID, changeRequestID, comment)
+	var r0 *azmodelspap.ChangeRequest
+	if val, ok := args.Get(0).(*azmodelspap.ChangeRequest); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
 // NewGrpcPAPClientMock creates a new GrpcPAPClientMock.
 func NewGrpcPAPClientMock() *GrpcPAPClientMock {
 	return &GrpcPAPClientMock{}
@@ -0,0 +1,83 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// GrpcPDPClientMock is a mock type for the GrpcPDPClient type.
+type GrpcPDPClientMock struct {
+	mock.Mock
+}
+
+// AuthorizationCheck checks the authorization.
+func (m *GrpcPDPClientMock) AuthorizationCheck(request *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (*azmodelspdp.AuthorizationCheckResponse, error) {
+	args := m.Called(request)
+	var r0 *azmodelspdp.AuthorizationCheckResponse
+	if val, ok := args.Get(0).(*azmodelspdp.AuthorizationCheckResponse); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// SubjectSearch searches the subjects allowed to perform the action on the resource.
+func (m *GrpcPDPClientMock) SubjectSearch(request *azmodelspdp.SubjectSearchRequest) (*azmodelspdp.SubjectSearchResponse, error) {
+	args := m.Called(request)
+	var r0 *azmodelspdp.SubjectSearchResponse
+	if val, ok := args.Get(0).(*azmodelspdp.SubjectSearchResponse); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// ResourceSearch searches the resources on which the subject is allowed to perform the action.
+func (m *GrpcPDPClientMock) ResourceSearch(request *azmodelspdp.ResourceSearchRequest) (*azmodelspdp.ResourceSearchResponse, error) {
+	args := m.Called(request)
+	var r0 *azmodelspdp.ResourceSearchResponse
+	if val, ok := args.Get(0).(*azmodelspdp.ResourceSearchResponse); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// ActionSearch searches the actions the subject is allowed to perform on the resource.
+func (m *GrpcPDPClientMock) ActionSearch(request *azmodelspdp.ActionSearchRequest) (*azmodelspdp.ActionSearchResponse, error) {
+	args := m.Called(request)
+	var r0 *azmodelspdp.ActionSearchResponse
+	if val, ok := args.Get(0).(*azmodelspdp.ActionSearchResponse); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// PartialEvaluation partially evaluates the authorization for the resources of a type and returns the residual conditions.
+func (m *GrpcPDPClientMock) PartialEvaluation(request *azmodelspdp.PartialEvaluationRequest) (*azmodelspdp.PartialEvaluationResponse, error) {
+	args := m.Called(request)
+	var r0 *azmodelspdp.PartialEvaluationResponse
+	if val, ok := args.Get(0).(*azmodelspdp.PartialEvaluationResponse); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// NewGrpcPDPClientMock creates a new GrpcPDPClientMock.
+func NewGrpcPDPClientMock() *GrpcPDPClientMock {
+	return &GrpcPDPClientMock{}
+}
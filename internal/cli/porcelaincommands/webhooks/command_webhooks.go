@@ -0,0 +1,172 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// commandNameForWebhook is the command name for webhook.
+	commandNameForWebhook = "webhook"
+	// flagWebhookID is the webhook id flag.
+	flagWebhookID = "webhook-id"
+	// flagWebhookURL is the webhook url flag.
+	flagWebhookURL = "url"
+	// flagWebhookSecret is the webhook secret flag.
+	flagWebhookSecret = "secret"
+	// flagWebhookEvents is the webhook events flag.
+	flagWebhookEvents = "events"
+	// flagDeliveryID is the delivery id flag.
+	flagDeliveryID = "delivery-id"
+	// flagDeliveryStatus is the delivery status flag.
+	flagDeliveryStatus = "status"
+)
+
+// buildWebhooksOutput builds the output of the webhooks.
+func buildWebhooksOutput(ctx *aziclicommon.CliCommandContext, webhooks []azmodelszap.Webhook) map[string]any {
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		for _, webhook := range webhooks {
+			text := fmt.Sprintf("%s, %s", webhook.Name, webhook.URL)
+			if webhook.Secret != "" {
+				text = fmt.Sprintf("%s, secret %s", text, webhook.Secret)
+			}
+			output[webhook.WebhookID] = text
+		}
+	} else if ctx.IsJSONOutput() {
+		output["webhooks"] = webhooks
+	}
+	return output
+}
+
+// buildWebhookDeliveriesOutput builds the output of the webhook deliveries.
+func buildWebhookDeliveriesOutput(ctx *aziclicommon.CliCommandContext, deliveries []azmodelszap.WebhookDelivery) map[string]any {
+	output := map[string]any{}
+	if ctx.IsTerminalOutput() {
+		for _, delivery := range deliveries {
+			deliveryID := fmt.Sprintf("%d", delivery.DeliveryID)
+			output[deliveryID] = fmt.Sprintf("%s, %s, attempts %d", delivery.Event, delivery.Status, delivery.Attempts)
+		}
+	} else if ctx.IsJSONOutput() {
+		output["deliveries"] = deliveries
+	}
+	return output
+}
+
+// runECommandForUpsertWebhook runs the command for creating or updating a webhook.
+func runECommandForUpsertWebhook(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, flagPrefix string, isCreate bool) error {
+	opErroMessage := "Failed to upsert the webhook"
+	if isCreate {
+		opErroMessage = "Failed to create the webhook"
+	}
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println(fmt.Sprintf("%s.", opErroMessage))
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, strings.ToLower(opErroMessage), err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	webhook := &azmodelszap.Webhook{
+		ZoneID: v.GetInt64(azoptions.FlagName(commandNameForWebhook, aziclicommon.FlagCommonZoneID)),
+		Name:   v.GetString(azoptions.FlagName(flagPrefix, aziclicommon.FlagCommonName)),
+		URL:    v.GetString(azoptions.FlagName(flagPrefix, flagWebhookURL)),
+		Secret: v.GetString(azoptions.FlagName(flagPrefix, flagWebhookSecret)),
+		Events: v.GetStringSlice(azoptions.FlagName(flagPrefix, flagWebhookEvents)),
+	}
+	if isCreate {
+		webhook, err = client.CreateWebhook(webhook)
+	} else {
+		webhook.WebhookID = v.GetString(azoptions.FlagName(flagPrefix, flagWebhookID))
+		webhook, err = client.UpdateWebhook(webhook)
+	}
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildWebhooksOutput(ctx, []azmodelszap.Webhook{*webhook}))
+	return nil
+}
+
+// addUpsertFlags adds the flags used to create or update a webhook.
+func addUpsertFlags(command *cobra.Command, v *viper.Viper, flagPrefix string) {
+	command.Flags().String(aziclicommon.FlagCommonName, "", "specify the name of the webhook")
+	v.BindPFlag(azoptions.FlagName(flagPrefix, aziclicommon.FlagCommonName), command.Flags().Lookup(aziclicommon.FlagCommonName))
+
+	command.Flags().String(flagWebhookURL, "", "specify the http or https endpoint receiving the deliveries")
+	v.BindPFlag(azoptions.FlagName(flagPrefix, flagWebhookURL), command.Flags().Lookup(flagWebhookURL))
+
+	command.Flags().String(flagWebhookSecret, "", "specify the secret used to sign the deliveries")
+	v.BindPFlag(azoptions.FlagName(flagPrefix, flagWebhookSecret), command.Flags().Lookup(flagWebhookSecret))
+
+	command.Flags().StringSlice(flagWebhookEvents, nil, "specify the events to subscribe to, all the events when empty")
+	v.BindPFlag(azoptions.FlagName(flagPrefix, flagWebhookEvents), command.Flags().Lookup(flagWebhookEvents))
+}
+
+// runECommandForWebhooks runs the command for managing webhooks.
+func runECommandForWebhooks(cmd *cobra.Command, args []string) error {
+	return cmd.Help()
+}
+
+// CreateCommandForWebhooks creates a command for managing webhooks.
+func CreateCommandForWebhooks(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "webhooks",
+		Short: "Manage webhooks on the remote server",
+		Long: aziclicommon.BuildCliLongTemplate(`This command manages webhooks on the remote server.
+
+A webhook receives a signed HTTP POST for every subscribed ledger, identity or tenant event of the zone.`),
+		RunE: runECommandForWebhooks,
+	}
+
+	command.PersistentFlags().Int64(aziclicommon.FlagCommonZoneID, 0, "zone id")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhook, aziclicommon.FlagCommonZoneID), command.PersistentFlags().Lookup(aziclicommon.FlagCommonZoneID))
+
+	command.AddCommand(createCommandForWebhookCreate(deps, v))
+	command.AddCommand(createCommandForWebhookUpdate(deps, v))
+	command.AddCommand(createCommandForWebhookDelete(deps, v))
+	command.AddCommand(createCommandForWebhookList(deps, v))
+	command.AddCommand(createCommandForWebhookDeliveries(deps, v))
+	command.AddCommand(createCommandForWebhookRedeliver(deps, v))
+	return command
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+)
+
+const (
+	// commandNameForWebhooksCreate is the command name for webhooks create.
+	commandNameForWebhooksCreate = "webhooks-create"
+)
+
+// runECommandForCreateWebhook runs the command for creating a webhook.
+func runECommandForCreateWebhook(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	return runECommandForUpsertWebhook(deps, cmd, v, commandNameForWebhooksCreate, true)
+}
+
+// createCommandForWebhookCreate creates a command for creating a webhook.
+func createCommandForWebhookCreate(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "create",
+		Short: "Create a remote webhook",
+		Long: aziclicommon.BuildCliLongTemplate(`This command creates a remote webhook.
+
+A random secret is generated when none is provided, the secret is only shown on creation.
+
+Examples:
+  # create a webhook for the ledger ref updates and output the result in json format
+  permguard webhooks create --zone-id 273165098782 --name deployments --url https://hooks.example.com/permguard --events ledger.ref_updated --output json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForCreateWebhook(deps, cmd, v)
+		},
+	}
+	addUpsertFlags(command, v, commandNameForWebhooksCreate)
+	return command
+}
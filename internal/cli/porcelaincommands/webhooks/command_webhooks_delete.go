@@ -0,0 +1,91 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// commandNameForWebhooksDelete is the command name for webhooks delete.
+	commandNameForWebhooksDelete = "webhooks-delete"
+)
+
+// runECommandForDeleteWebhook runs the command for deleting a webhook.
+func runECommandForDeleteWebhook(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to delete the webhook.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to delete the webhook", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForWebhook, aziclicommon.FlagCommonZoneID))
+	webhookID := v.GetString(azoptions.FlagName(commandNameForWebhooksDelete, flagWebhookID))
+	webhook, err := client.DeleteWebhook(zoneID, webhookID)
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildWebhooksOutput(ctx, []azmodelszap.Webhook{*webhook}))
+	return nil
+}
+
+// createCommandForWebhookDelete creates a command for deleting a webhook.
+func createCommandForWebhookDelete(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a remote webhook",
+		Long: aziclicommon.BuildCliLongTemplate(`This command deletes a remote webhook along with its delivery history.
+
+Examples:
+  # delete a webhook and output the result in json format
+  permguard webhooks delete --zone-id 273165098782 --webhook-id 2f1c9e0a7b3d4c5e8f6a1b2c3d4e5f60 --output json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForDeleteWebhook(deps, cmd, v)
+		},
+	}
+	command.Flags().String(flagWebhookID, "", "specify the id of the webhook to delete")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksDelete, flagWebhookID), command.Flags().Lookup(flagWebhookID))
+	return command
+}
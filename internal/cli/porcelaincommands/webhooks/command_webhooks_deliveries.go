@@ -0,0 +1,104 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// commandNameForWebhooksDeliveries is the command name for webhooks deliveries.
+	commandNameForWebhooksDeliveries = "webhooks-deliveries"
+)
+
+// runECommandForListWebhookDeliveries runs the command for listing webhook deliveries.
+func runECommandForListWebhookDeliveries(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to list webhook deliveries.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to list webhook deliveries", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	page := v.GetInt32(azoptions.FlagName(commandNameForWebhooksDeliveries, aziclicommon.FlagCommonPage))
+	pageSize := v.GetInt32(azoptions.FlagName(commandNameForWebhooksDeliveries, aziclicommon.FlagCommonPageSize))
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForWebhook, aziclicommon.FlagCommonZoneID))
+	webhookID := v.GetString(azoptions.FlagName(commandNameForWebhooksDeliveries, flagWebhookID))
+	status := v.GetString(azoptions.FlagName(commandNameForWebhooksDeliveries, flagDeliveryStatus))
+	deliveries, err := client.FetchWebhookDeliveriesBy(page, pageSize, zoneID, webhookID, status)
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildWebhookDeliveriesOutput(ctx, deliveries))
+	return nil
+}
+
+// createCommandForWebhookDeliveries creates a command for listing webhook deliveries.
+func createCommandForWebhookDeliveries(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "deliveries",
+		Short: "List the remote webhook deliveries",
+		Long: aziclicommon.BuildCliLongTemplate(`This command lists the delivery history of the remote webhooks.
+
+Deliveries are pending until acknowledged with a 2xx response, failed attempts are retried with an exponential backoff
+and the deliveries exhausting their retries are moved to the dead status.
+
+Examples:
+  # list the deliveries of a webhook and output in json format
+  permguard webhooks deliveries --zone-id 273165098782 --webhook-id 2f1c9e0a7b3d4c5e8f6a1b2c3d4e5f60 --output json
+  # list the dead deliveries
+  permguard webhooks deliveries --zone-id 273165098782 --status dead
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForListWebhookDeliveries(deps, cmd, v)
+		},
+	}
+	command.Flags().Int32P(aziclicommon.FlagCommonPage, aziclicommon.FlagCommonPageShort, 1, "specify the page number for paginated results")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksDeliveries, aziclicommon.FlagCommonPage), command.Flags().Lookup(aziclicommon.FlagCommonPage))
+	command.Flags().Int32P(aziclicommon.FlagCommonPageSize, aziclicommon.FlagCommonPageSizeShort, 1000, "specify the number of results per page")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksDeliveries, aziclicommon.FlagCommonPageSize), command.Flags().Lookup(aziclicommon.FlagCommonPageSize))
+	command.Flags().String(flagWebhookID, "", "filter results by webhook id")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksDeliveries, flagWebhookID), command.Flags().Lookup(flagWebhookID))
+	command.Flags().String(flagDeliveryStatus, "", "filter results by status (pending, delivered or dead)")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksDeliveries, flagDeliveryStatus), command.Flags().Lookup(flagDeliveryStatus))
+	return command
+}
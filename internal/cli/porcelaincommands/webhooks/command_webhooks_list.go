@@ -0,0 +1,101 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// commandNameForWebhooksList is the command name for webhooks list.
+	commandNameForWebhooksList = "webhooks-list"
+)
+
+// runECommandForListWebhooks runs the command for listing webhooks.
+func runECommandForListWebhooks(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to list webhooks.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to list webhooks", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	page := v.GetInt32(azoptions.FlagName(commandNameForWebhooksList, aziclicommon.FlagCommonPage))
+	pageSize := v.GetInt32(azoptions.FlagName(commandNameForWebhooksList, aziclicommon.FlagCommonPageSize))
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForWebhook, aziclicommon.FlagCommonZoneID))
+	webhookID := v.GetString(azoptions.FlagName(commandNameForWebhooksList, flagWebhookID))
+	name := v.GetString(azoptions.FlagName(commandNameForWebhooksList, aziclicommon.FlagCommonName))
+	webhooks, err := client.FetchWebhooksBy(page, pageSize, zoneID, webhookID, name)
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildWebhooksOutput(ctx, webhooks))
+	return nil
+}
+
+// createCommandForWebhookList creates a command for listing webhooks.
+func createCommandForWebhookList(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List remote webhooks",
+		Long: aziclicommon.BuildCliLongTemplate(`This command lists all remote webhooks.
+
+Examples:
+  # list all webhooks and output in json format
+  permguard webhooks list --zone-id 273165098782 --output json
+  # list all webhooks and filter by name
+  permguard webhooks list --zone-id 273165098782 --name deploy
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForListWebhooks(deps, cmd, v)
+		},
+	}
+	command.Flags().Int32P(aziclicommon.FlagCommonPage, aziclicommon.FlagCommonPageShort, 1, "specify the page number for paginated results")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksList, aziclicommon.FlagCommonPage), command.Flags().Lookup(aziclicommon.FlagCommonPage))
+	command.Flags().Int32P(aziclicommon.FlagCommonPageSize, aziclicommon.FlagCommonPageSizeShort, 1000, "specify the number of results per page")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksList, aziclicommon.FlagCommonPageSize), command.Flags().Lookup(aziclicommon.FlagCommonPageSize))
+	command.Flags().String(flagWebhookID, "", "filter results by webhook id")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksList, flagWebhookID), command.Flags().Lookup(flagWebhookID))
+	command.Flags().String(aziclicommon.FlagCommonName, "", "filter results by webhook name")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksList, aziclicommon.FlagCommonName), command.Flags().Lookup(aziclicommon.FlagCommonName))
+	return command
+}
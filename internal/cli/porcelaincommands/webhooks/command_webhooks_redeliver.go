@@ -0,0 +1,91 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelszap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// commandNameForWebhooksRedeliver is the command name for webhooks redeliver.
+	commandNameForWebhooksRedeliver = "webhooks-redeliver"
+)
+
+// runECommandForRedeliverWebhook runs the command for requeuing a dead webhook delivery.
+func runECommandForRedeliverWebhook(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	handleError := func(err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to redeliver the webhook delivery.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to redeliver the webhook delivery", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return handleError(err)
+	}
+	client, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return handleError(err)
+	}
+	zoneID := v.GetInt64(azoptions.FlagName(commandNameForWebhook, aziclicommon.FlagCommonZoneID))
+	deliveryID := v.GetInt64(azoptions.FlagName(commandNameForWebhooksRedeliver, flagDeliveryID))
+	delivery, err := client.RequeueWebhookDelivery(zoneID, deliveryID)
+	if err != nil {
+		return handleError(err)
+	}
+	printer.PrintlnMap(buildWebhookDeliveriesOutput(ctx, []azmodelszap.WebhookDelivery{*delivery}))
+	return nil
+}
+
+// createCommandForWebhookRedeliver creates a command for requeuing a dead webhook delivery.
+func createCommandForWebhookRedeliver(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "redeliver",
+		Short: "Redeliver a dead remote webhook delivery",
+		Long: aziclicommon.BuildCliLongTemplate(`This command moves a dead webhook delivery back to the outbox with its attempts reset.
+
+Examples:
+  # redeliver a dead delivery and output the result in json format
+  permguard webhooks redeliver --zone-id 273165098782 --delivery-id 42 --output json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForRedeliverWebhook(deps, cmd, v)
+		},
+	}
+	command.Flags().Int64(flagDeliveryID, 0, "specify the id of the dead delivery to redeliver")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksRedeliver, flagDeliveryID), command.Flags().Lookup(flagDeliveryID))
+	return command
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+)
+
+const (
+	// commandNameForWebhooksUpdate is the command name for webhooks update.
+	commandNameForWebhooksUpdate = "webhooks-update"
+)
+
+// runECommandForUpdateWebhook runs the command for updating a webhook.
+func runECommandForUpdateWebhook(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	return runECommandForUpsertWebhook(deps, cmd, v, commandNameForWebhooksUpdate, false)
+}
+
+// createCommandForWebhookUpdate creates a command for updating a webhook.
+func createCommandForWebhookUpdate(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "update",
+		Short: "Update a remote webhook",
+		Long: aziclicommon.BuildCliLongTemplate(`This command updates a remote webhook.
+
+The current secret is kept when no secret is provided.
+
+Examples:
+  # update a webhook and output the result in json format
+  permguard webhooks update --zone-id 273165098782 --webhook-id 2f1c9e0a7b3d4c5e8f6a1b2c3d4e5f60 --name deployments --url https://hooks.example.com/permguard --events ledger.ref_updated,ledger.deleted --output json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForUpdateWebhook(deps, cmd, v)
+		},
+	}
+	command.Flags().String(flagWebhookID, "", "specify the id of the webhook to update")
+	v.BindPFlag(azoptions.FlagName(commandNameForWebhooksUpdate, flagWebhookID), command.Flags().Lookup(flagWebhookID))
+	addUpsertFlags(command, v, commandNameForWebhooksUpdate)
+	return command
+}
@@ -77,10 +77,12 @@ func CreateCommandsForWorkspace(deps azcli.CliDependenciesProvider, v *viper.Vip
 		CreateCommandForWorkspacePull(deps, v),
 		CreateCommandForWorkspaceRefresh(deps, v),
 		CreateCommandForWorkspaceValidate(deps, v),
+		CreateCommandForWorkspaceLint(deps, v),
 		CreateCommandForWorkspaceHistory(deps, v),
 		CreateCommandForWorkspaceObjects(deps, v),
 		CreateCommandForWorkspacePlan(deps, v),
 		CreateCommandForWorkspaceApply(deps, v),
+		CreateCommandForWorkspaceLsp(deps, v),
 	}
 	return commands
 }
@@ -0,0 +1,110 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azicliwksmanager "github.com/permguard/permguard/internal/cli/workspace"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// commandNameForWorkspacesLint is the command name for workspaces lint.
+	commandNameForWorkspacesLint = "workspaces-lint"
+	// commandNameForWorkspacesLintSarif is the file the sarif report is written to.
+	commandNameForWorkspacesLintSarif = "sarif"
+)
+
+// runECommandForLintWorkspace runs the command for linting a workspace.
+func runECommandForLintWorkspace(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	absLang, err := deps.GetLanguageFactory()
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	wksMgr, err := azicliwksmanager.NewInternalManager(ctx, absLang)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	sarifFile := v.GetString(azoptions.FlagName(commandNameForWorkspacesLint, commandNameForWorkspacesLintSarif))
+	exec := func() (map[string]any, error) {
+		return wksMgr.ExecLint(sarifFile, outFunc(ctx, printer))
+	}
+	watch, watchExec, err := readWatchFlags(v, commandNameForWorkspacesLint)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	var output map[string]any
+	if watch {
+		err = watchWorkspace(ctx, printer, wksMgr, "lint", watchExec, exec)
+	} else {
+		output, err = exec()
+	}
+	if err != nil {
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to lint the workspace.", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	if ctx.IsJSONOutput() && !watch {
+		printer.PrintlnMap(output)
+	}
+	return nil
+}
+
+// CreateCommandForWorkspaceLint creates a command for linting a permguard workspace.
+func CreateCommandForWorkspaceLint(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "lint",
+		Short: "Statically analyse the policies of the local state using the rules configured in the manifest",
+		Long: aziclicommon.BuildCliLongTemplate(`This command statically analyses the policies of the local state using the rules configured in the manifest.
+
+Examples:
+  # statically analyse the policies of the local state
+  permguard lint
+  # statically analyse the policies of the local state and write the sarif report
+  permguard lint --sarif permguard.sarif
+  # lint the policies of the workspace each time a source file changes
+  permguard lint --watch`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForLintWorkspace(deps, cmd, v)
+		},
+	}
+	command.Flags().String(commandNameForWorkspacesLintSarif, "", "specify the file the sarif report is written to")
+	v.BindPFlag(azoptions.FlagName(commandNameForWorkspacesLint, commandNameForWorkspacesLintSarif), command.Flags().Lookup(commandNameForWorkspacesLintSarif))
+	command.Flags().Bool(flagWorkspaceWatch, false, "watch the workspace and lint it again each time a source file changes")
+	v.BindPFlag(azoptions.FlagName(commandNameForWorkspacesLint, flagWorkspaceWatch), command.Flags().Lookup(flagWorkspaceWatch))
+	command.Flags().String(flagWorkspaceWatchExec, "", "run the shell command, such as the local policy tests, after each successful lint while watching")
+	v.BindPFlag(azoptions.FlagName(commandNameForWorkspacesLint, flagWorkspaceWatchExec), command.Flags().Lookup(flagWorkspaceWatchExec))
+	return command
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aziclilsp "github.com/permguard/permguard/internal/cli/lsp"
+	azicliwksmanager "github.com/permguard/permguard/internal/cli/workspace"
+	azcli "github.com/permguard/permguard/pkg/cli"
+)
+
+// runECommandForLspWorkspace runs the command for serving the language server of the workspace.
+func runECommandForLspWorkspace(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper) error {
+	// The standard output carries the protocol messages, errors are reported on the standard error.
+	ctx, _, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return aziclicommon.ErrCommandSilent
+	}
+	langFct, err := deps.GetLanguageFactory()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return aziclicommon.ErrCommandSilent
+	}
+	newAnalyzer := func(rootDir string) (aziclilsp.WorkspaceAnalyzer, error) {
+		if err := cmd.Flags().Set(aziclicommon.FlagWorkingDirectory, rootDir); err != nil {
+			return nil, err
+		}
+		rootCtx, _, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+		if err != nil {
+			return nil, err
+		}
+		return azicliwksmanager.NewInternalManager(rootCtx, langFct)
+	}
+	server, err := aziclilsp.NewServer(ctx.GetWorkDir(), newAnalyzer)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return aziclicommon.ErrCommandSilent
+	}
+	if err := server.Serve(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return aziclicommon.ErrCommandSilent
+	}
+	return nil
+}
+
+// CreateCommandForWorkspaceLsp creates a command for serving the language server of a permguard workspace.
+func CreateCommandForWorkspaceLsp(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "lsp",
+		Short: "Serve the language server of the workspace over stdio",
+		Long: aziclicommon.BuildCliLongTemplate(`This command serves the language server of the workspace speaking the language server protocol over stdio.
+
+The language server publishes the diagnostics of the validation of the workspace, completes the entity types, the actions and the attributes declared in the schema, resolves the definition of the policy ids and shows the object id and the remote state of the policies on hover.
+
+Examples:
+  # serve the language server of the workspace in the current directory
+  permguard lsp
+  # serve the language server of the workspace in a given directory
+  permguard lsp --workdir ./magicfarmacia`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForLspWorkspace(deps, cmd, v)
+		},
+	}
+	return command
+}
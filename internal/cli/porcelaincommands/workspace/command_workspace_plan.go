@@ -26,12 +26,17 @@ import (
 	aziclicommon "github.com/permguard/permguard/internal/cli/common"
 	azicliwksmanager "github.com/permguard/permguard/internal/cli/workspace"
 	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 )
 
 const (
 	// commandNameForWorkspacesPlan is the command name for workspaces plan.
 	commandNameForWorkspacesPlan = "workspaces-plan"
+	// commandNameForWorkspacesPlanLint is the flag to execute the lint as a gate of the plan.
+	commandNameForWorkspacesPlanLint = "lint"
+	// commandNameForWorkspacesPlanImpact is the flag to analyse the impact of the plan on the decisions of recorded requests.
+	commandNameForWorkspacesPlanImpact = "impact"
 )
 
 // runECommandForPlanWorkspace runs the command for creating an workspace.
@@ -51,7 +56,22 @@ func runECommandForPlanWorkspace(deps azcli.CliDependenciesProvider, cmd *cobra.
 		color.Red(fmt.Sprintf("%s", err))
 		return aziclicommon.ErrCommandSilent
 	}
-	output, err := wksMgr.ExecPlan(outFunc(ctx, printer))
+	lint := v.GetBool(azoptions.FlagName(commandNameForWorkspacesPlan, commandNameForWorkspacesPlanLint))
+	impactFile := v.GetString(azoptions.FlagName(commandNameForWorkspacesPlan, commandNameForWorkspacesPlanImpact))
+	exec := func() (map[string]any, error) {
+		return wksMgr.ExecPlan(lint, impactFile, outFunc(ctx, printer))
+	}
+	watch, watchExec, err := readWatchFlags(v, commandNameForWorkspacesPlan)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	var output map[string]any
+	if watch {
+		err = watchWorkspace(ctx, printer, wksMgr, "plan", watchExec, exec)
+	} else {
+		output, err = exec()
+	}
 	if err != nil {
 		if ctx.IsNotVerboseTerminalOutput() {
 			printer.Println("Failed execute the plan.")
@@ -62,7 +82,7 @@ func runECommandForPlanWorkspace(deps azcli.CliDependenciesProvider, cmd *cobra.
 		}
 		return aziclicommon.ErrCommandSilent
 	}
-	if ctx.IsJSONOutput() {
+	if ctx.IsJSONOutput() && !watch {
 		printer.PrintlnMap(output)
 	}
 	return nil
@@ -77,10 +97,24 @@ func CreateCommandForWorkspacePlan(deps azcli.CliDependenciesProvider, v *viper.
 
 Examples:
   # generate a plan of changes to apply to the remote ledger based on the differences between the local and remote states
-  permguard plan`),
+  permguard plan
+  # generate a plan of changes failing if the lint of the local state reports errors
+  permguard plan --lint
+  # generate a plan of changes reporting the recorded requests whose decision changes
+  permguard plan --impact requests.jsonl
+  # generate a plan of changes each time a source file of the workspace changes
+  permguard plan --watch`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runECommandForPlanWorkspace(deps, cmd, v)
 		},
 	}
+	command.Flags().Bool(commandNameForWorkspacesPlanLint, false, "execute the lint of the local state as a gate of the plan")
+	v.BindPFlag(azoptions.FlagName(commandNameForWorkspacesPlan, commandNameForWorkspacesPlanLint), command.Flags().Lookup(commandNameForWorkspacesPlanLint))
+	command.Flags().String(commandNameForWorkspacesPlanImpact, "", "replay the authorization requests of the json lines file and report the decisions changing with the plan")
+	v.BindPFlag(azoptions.FlagName(commandNameForWorkspacesPlan, commandNameForWorkspacesPlanImpact), command.Flags().Lookup(commandNameForWorkspacesPlanImpact))
+	command.Flags().Bool(flagWorkspaceWatch, false, "watch the workspace and generate the plan again each time a source file changes")
+	v.BindPFlag(azoptions.FlagName(commandNameForWorkspacesPlan, flagWorkspaceWatch), command.Flags().Lookup(flagWorkspaceWatch))
+	command.Flags().String(flagWorkspaceWatchExec, "", "run the shell command, such as the local policy tests, after each successful plan while watching")
+	v.BindPFlag(azoptions.FlagName(commandNameForWorkspacesPlan, flagWorkspaceWatchExec), command.Flags().Lookup(flagWorkspaceWatchExec))
 	return command
 }
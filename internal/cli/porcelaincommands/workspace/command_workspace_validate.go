@@ -26,6 +26,7 @@ import (
 	aziclicommon "github.com/permguard/permguard/internal/cli/common"
 	azicliwksmanager "github.com/permguard/permguard/internal/cli/workspace"
 	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 )
 
@@ -51,7 +52,20 @@ func runECommandForValidateWorkspace(deps azcli.CliDependenciesProvider, cmd *co
 		color.Red(fmt.Sprintf("%s", err))
 		return aziclicommon.ErrCommandSilent
 	}
-	output, err := wksMgr.ExecValidate(outFunc(ctx, printer))
+	exec := func() (map[string]any, error) {
+		return wksMgr.ExecValidate(outFunc(ctx, printer))
+	}
+	watch, watchExec, err := readWatchFlags(v, commandNameForWorkspacesValidate)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	var output map[string]any
+	if watch {
+		err = watchWorkspace(ctx, printer, wksMgr, "validate", watchExec, exec)
+	} else {
+		output, err = exec()
+	}
 	if err != nil {
 		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
 			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to validate the workspace.", err)
@@ -59,7 +73,7 @@ func runECommandForValidateWorkspace(deps azcli.CliDependenciesProvider, cmd *co
 		}
 		return aziclicommon.ErrCommandSilent
 	}
-	if ctx.IsJSONOutput() {
+	if ctx.IsJSONOutput() && !watch {
 		printer.PrintlnMap(output)
 	}
 	return nil
@@ -74,10 +88,18 @@ func CreateCommandForWorkspaceValidate(deps azcli.CliDependenciesProvider, v *vi
 
 Examples:
   # validate the local state for consistency and correctness",
-  permguard validate`),
+  permguard validate
+  # validate the local state each time a source file of the workspace changes
+  permguard validate --watch
+  # validate the local state and run the local policy tests each time a source file of the workspace changes
+  permguard validate --watch --watch-exec "make test-policies"`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runECommandForValidateWorkspace(deps, cmd, v)
 		},
 	}
+	command.Flags().Bool(flagWorkspaceWatch, false, "watch the workspace and validate it again each time a source file changes")
+	v.BindPFlag(azoptions.FlagName(commandNameForWorkspacesValidate, flagWorkspaceWatch), command.Flags().Lookup(flagWorkspaceWatch))
+	command.Flags().String(flagWorkspaceWatchExec, "", "run the shell command, such as the local policy tests, after each successful validation while watching")
+	v.BindPFlag(azoptions.FlagName(commandNameForWorkspacesValidate, flagWorkspaceWatchExec), command.Flags().Lookup(flagWorkspaceWatchExec))
 	return command
 }
@@ -0,0 +1,186 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azicliwksmanager "github.com/permguard/permguard/internal/cli/workspace"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+)
+
+const (
+	// flagWorkspaceWatch is the flag to watch the workspace and run the command again on changes.
+	flagWorkspaceWatch = "watch"
+	// flagWorkspaceWatchExec is the flag of the shell command run after each successful run while watching, such as the local policy tests.
+	flagWorkspaceWatchExec = "watch-exec"
+	// watchExecChangedFilesEnv is the environment variable passing the changed files to the watch exec command.
+	watchExecChangedFilesEnv = "PERMGUARD_CHANGED_FILES"
+	// clearTerminalSequence is the ansi sequence clearing the terminal.
+	clearTerminalSequence = "\033[H\033[2J"
+)
+
+// readWatchFlags reads the watch flags of the command, the watch exec command requires the watch flag.
+func readWatchFlags(v *viper.Viper, commandName string) (bool, string, error) {
+	watch := v.GetBool(azoptions.FlagName(commandName, flagWorkspaceWatch))
+	watchExec := strings.TrimSpace(v.GetString(azoptions.FlagName(commandName, flagWorkspaceWatchExec)))
+	if len(watchExec) > 0 && !watch {
+		return false, "", fmt.Errorf("the --%s flag requires the --%s flag", flagWorkspaceWatchExec, flagWorkspaceWatch)
+	}
+	return watch, watchExec, nil
+}
+
+// runWatchExec runs the shell command in the workspace directory passing the changed files, the output of the command
+// is written to the writer and the exit code of the command is returned.
+func runWatchExec(workDir string, command string, changedFiles []string, stdout io.Writer, stderr io.Writer) (int, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	cmd.Dir = workDir
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", watchExecChangedFilesEnv, strings.Join(changedFiles, string(os.PathListSeparator))))
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), nil
+	} else if err != nil {
+		return -1, err
+	}
+	return 0, nil
+}
+
+// watchWorkspace runs the command each time a source file of the workspace changes until the process is interrupted,
+// the watch exec command, if any, is run after each successful run.
+func watchWorkspace(ctx *aziclicommon.CliCommandContext, printer azcli.CliPrinter, wksMgr *azicliwksmanager.WorkspaceManager, commandName string, watchExec string, runCommand func() (map[string]any, error)) error {
+	stop := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-signals:
+			close(stop)
+		case <-done:
+		}
+	}()
+
+	run := func(changedFiles []string) {
+		if changedFiles == nil {
+			changedFiles = []string{}
+		}
+		timestamp := time.Now()
+		if ctx.IsTerminalOutput() {
+			printer.Print(clearTerminalSequence)
+			printer.Println(fmt.Sprintf("%s Watching the workspace for changes, press Ctrl+C to stop.", aziclicommon.TimeStampText(timestamp.Format(time.TimeOnly))))
+			if len(changedFiles) > 0 {
+				printer.Println(fmt.Sprintf("Changed files: %s.", aziclicommon.FileText(strings.Join(changedFiles, ", "))))
+			}
+			printer.Println("")
+		}
+		output, err := runCommand()
+		blobified, reused := wksMgr.GetBlobificationStats()
+		var execEvent map[string]any
+		if len(watchExec) > 0 {
+			execEvent = map[string]any{"command": watchExec}
+			if err != nil {
+				execEvent["skipped"] = true
+				if ctx.IsTerminalOutput() {
+					printer.Println("")
+					printer.Println(fmt.Sprintf("Skipped %s as the %s failed.", aziclicommon.KeywordText(watchExec), commandName))
+				}
+			} else {
+				var stdout, stderr io.Writer = os.Stdout, os.Stderr
+				var buffer bytes.Buffer
+				if ctx.IsTerminalOutput() {
+					printer.Println("")
+					printer.Println(fmt.Sprintf("Running %s.", aziclicommon.KeywordText(watchExec)))
+				} else {
+					stdout, stderr = &buffer, &buffer
+				}
+				exitCode, execErr := runWatchExec(ctx.GetWorkDir(), watchExec, changedFiles, stdout, stderr)
+				execEvent["exit_code"] = exitCode
+				execEvent["succeeded"] = execErr == nil && exitCode == 0
+				if !ctx.IsTerminalOutput() {
+					execEvent["output"] = buffer.String()
+				}
+				if execErr != nil {
+					execEvent["error"] = execErr.Error()
+				}
+				if ctx.IsTerminalOutput() {
+					if execErr != nil {
+						printer.Println(fmt.Sprintf("Failed to run %s: %s", aziclicommon.KeywordText(watchExec), aziclicommon.LogErrorText(execErr.Error())))
+					} else if exitCode != 0 {
+						printer.Println(fmt.Sprintf("%s exited with code %s.", aziclicommon.KeywordText(watchExec), aziclicommon.DeleteText(fmt.Sprint(exitCode))))
+					} else {
+						printer.Println(fmt.Sprintf("%s succeeded.", aziclicommon.KeywordText(watchExec)))
+					}
+				}
+			}
+		}
+		if ctx.IsTerminalOutput() {
+			printer.Println("")
+			printer.Println(fmt.Sprintf("Blobified %s changed files and reused %s unchanged files.", aziclicommon.NumberText(blobified), aziclicommon.NumberText(reused)))
+		} else if ctx.IsJSONOutput() {
+			event := map[string]any{
+				"event":           "run",
+				"command":         commandName,
+				"timestamp":       timestamp.UTC().Format(time.RFC3339),
+				"changed_files":   changedFiles,
+				"blobified_files": blobified,
+				"reused_files":    reused,
+				"succeeded":       err == nil,
+				"output":          output,
+			}
+			if err != nil {
+				event["error"] = err.Error()
+			}
+			if execEvent != nil {
+				event["exec"] = execEvent
+			}
+			printer.PrintlnMap(event)
+		}
+	}
+	if err := wksMgr.ExecWatch(stop, run, outFunc(ctx, printer)); err != nil {
+		return err
+	}
+	if ctx.IsTerminalOutput() {
+		printer.Println("Stopped watching the workspace.")
+	} else if ctx.IsJSONOutput() {
+		printer.PrintlnMap(map[string]any{"event": "stopped", "command": commandName})
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+)
+
+// TestReadWatchFlags tests the read of the watch flags.
+func TestReadWatchFlags(t *testing.T) {
+	tests := []struct {
+		watch     bool
+		watchExec string
+		hasError  bool
+	}{
+		{watch: false, watchExec: ""},
+		{watch: true, watchExec: ""},
+		{watch: true, watchExec: " make test-policies "},
+		{watch: false, watchExec: "make test-policies", hasError: true},
+	}
+	for _, test := range tests {
+		assert := assert.New(t)
+		v := viper.New()
+		v.Set(azoptions.FlagName(commandNameForWorkspacesValidate, flagWorkspaceWatch), test.watch)
+		v.Set(azoptions.FlagName(commandNameForWorkspacesValidate, flagWorkspaceWatchExec), test.watchExec)
+		watch, watchExec, err := readWatchFlags(v, commandNameForWorkspacesValidate)
+		if test.hasError {
+			assert.NotNil(err, "the watch exec flag should require the watch flag")
+			continue
+		}
+		assert.Nil(err, "the watch flags should be valid")
+		assert.Equal(test.watch, watch, "the watch flag should be read")
+		assert.Equal(strings.TrimSpace(test.watchExec), watchExec, "the watch exec flag should be read")
+	}
+}
+
+// TestRunWatchExec tests the run of the watch exec command.
+func TestRunWatchExec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands require a posix shell")
+	}
+	workDir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(workDir, "marker.txt"), []byte("marker"), 0o600))
+	tests := []struct {
+		command          string
+		changedFiles     []string
+		expectedExitCode int
+		expectedOutput   string
+	}{
+		{command: "cat marker.txt", expectedOutput: "marker"},
+		{command: `printf "%s" "$PERMGUARD_CHANGED_FILES"`, changedFiles: []string{"a.cedar", "b.cedar"}, expectedOutput: "a.cedar" + string(os.PathListSeparator) + "b.cedar"},
+		{command: "echo failed >&2; exit 3", expectedExitCode: 3, expectedOutput: "failed\n"},
+	}
+	for _, test := range tests {
+		assert := assert.New(t)
+		var output bytes.Buffer
+		exitCode, err := runWatchExec(workDir, test.command, test.changedFiles, &output, &output)
+		assert.Nil(err, "the command should be run")
+		assert.Equal(test.expectedExitCode, exitCode, "the exit code should be returned")
+		assert.Equal(test.expectedOutput, output.String(), "the output should be written")
+	}
+}
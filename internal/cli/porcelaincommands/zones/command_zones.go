@@ -124,5 +124,7 @@ func CreateCommandForZones(deps azcli.CliDependenciesProvider, v *viper.Viper) *
 	command.AddCommand(createCommandForZoneUpdate(deps, v))
 	command.AddCommand(createCommandForZoneDelete(deps, v))
 	command.AddCommand(createCommandForZoneList(deps, v))
+	command.AddCommand(createCommandForZonePlan(deps, v))
+	command.AddCommand(createCommandForZoneApply(deps, v))
 	return command
 }
@@ -0,0 +1,51 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zones
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azcli "github.com/permguard/permguard/pkg/cli"
+)
+
+const (
+	// commandNameForZonesApply is the command name for zones apply.
+	commandNameForZonesApply = "zones-apply"
+)
+
+// createCommandForZoneApply creates a command for applying the zone file.
+func createCommandForZoneApply(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply a zone file to the remote zone",
+		Long: aziclicommon.BuildCliLongTemplate(`This command reconciles the remote zone with a yaml or toml zone file by applying the planned changes.
+
+Examples:
+  # apply a zone file
+  permguard zones apply --file zone.yaml
+  # apply a zone file and delete the resources missing from the file
+  permguard zones apply --file zone.yaml --prune --output json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForReconcileZone(deps, cmd, v, commandNameForZonesApply, true)
+		},
+	}
+	addZoneFileFlags(command, v, commandNameForZonesApply)
+	return command
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zones
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azconfigs "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// TestCreateCommandForZonesApply tests the createCommandForZoneApply function.
+func TestCreateCommandForZonesApply(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command reconciles the remote zone with a yaml or toml zone file"}
+	aztestutils.BaseCommandTest(t, createCommandForZoneApply, args, false, outputs)
+}
+
+// newZonesApplyClientMocks creates the client mocks returning the state of the zone used by the apply tests.
+func newZonesApplyClientMocks(zoneID int64) (*azmocks.GrpcZAPClientMock, *azmocks.GrpcPAPClientMock) {
+	zapClient := azmocks.NewGrpcZAPClientMock()
+	zapClient.On("FetchZonesBy", int32(1), int32(1000), zoneID, "").Return([]azmodelzap.Zone{{ZoneID: zoneID, Name: "mycorporate"}}, nil)
+	zapClient.On("FetchTenantsByQuery", int32(1), int32(1000), zoneID, "", "", &azmodels.FetchQuery{}).Return([]azmodelzap.Tenant{{TenantID: "t1", Name: "rome-branch"}}, "", nil)
+	zapClient.On("FetchIdentitySourcesByQuery", int32(1), int32(1000), zoneID, "", "", &azmodels.FetchQuery{}).Return([]azmodelzap.IdentitySource{}, "", nil)
+	zapClient.On("FetchIdentitiesByQuery", int32(1), int32(1000), zoneID, "", "", "", "", &azmodels.FetchQuery{}).Return([]azmodelzap.Identity{}, "", nil)
+	papClient := azmocks.NewGrpcPAPClientMock()
+	papClient.On("FetchLedgersByQuery", int32(1), int32(1000), zoneID, "", "", "", &azmodels.FetchQuery{}).Return([]azmodelpap.Ledger{{LedgerID: "l1", Kind: "policy", Name: "mycorporate"}}, "", nil)
+	return zapClient, papClient
+}
+
+// TestCliZonesApplyWithError tests the command for applying a zone file with an error.
+func TestCliZonesApplyWithError(t *testing.T) {
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"zones", "apply", "--file", writeTestZoneFile(t), "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForZoneApply(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		zoneID := int64(581616507495)
+		zapClient, papClient := newZonesApplyClientMocks(zoneID)
+		zapClient.On("CreateTenant", zoneID, "matera-branch").Return(nil, azerrors.ErrClientParameter)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+		printerMock.On("Error", mock.Anything).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+		depsMocks.On("CreateGrpcPAPClient", mock.Anything).Return(papClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, true, outputs)
+		printerMock.AssertCalled(t, "Error", mock.Anything)
+	}
+}
+
+// TestCliZonesApplyWithSuccess tests the command for applying a zone file.
+func TestCliZonesApplyWithSuccess(t *testing.T) {
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"zones", "apply", "--file", writeTestZoneFile(t), "--prune", "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set("output", outputType)
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForZoneApply(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		zoneID := int64(581616507495)
+		zapClient, papClient := newZonesApplyClientMocks(zoneID)
+		zapClient.On("CreateTenant", zoneID, "matera-branch").Return(&azmodelzap.Tenant{TenantID: "t2", Name: "matera-branch"}, nil)
+		zapClient.On("DeleteTenant", zoneID, "t1").Return(&azmodelzap.Tenant{TenantID: "t1", Name: "rome-branch"}, nil)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+		depsMocks.On("CreateGrpcPAPClient", mock.Anything).Return(papClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, false, outputs)
+		zapClient.AssertCalled(t, "CreateTenant", zoneID, "matera-branch")
+		zapClient.AssertCalled(t, "DeleteTenant", zoneID, "t1")
+		if outputType == "json" {
+			printerMock.AssertNumberOfCalls(t, "PrintlnMap", 1)
+		}
+	}
+}
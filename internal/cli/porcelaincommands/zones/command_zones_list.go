@@ -27,6 +27,8 @@ import (
 	azcli "github.com/permguard/permguard/pkg/cli"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
 const (
@@ -69,7 +71,20 @@ func runECommandForListZones(deps azcli.CliDependenciesProvider, cmd *cobra.Comm
 	zoneID := v.GetInt64(azoptions.FlagName(commandNameForZonesList, aziclicommon.FlagCommonZoneID))
 	name := v.GetString(azoptions.FlagName(commandNameForZonesList, aziclicommon.FlagCommonName))
 
-	zones, err := client.FetchZonesBy(page, pageSize, zoneID, name)
+	query, all, err := aziclicommon.BuildFetchQuery(v, commandNameForZonesList)
+	if err != nil {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println("Failed to list zones.")
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "failed to list zones", err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zones, nextCursor, err := aziclicommon.FetchPages(query, all, func(query *azmodels.FetchQuery) ([]azmodelzap.Zone, string, error) {
+		return client.FetchZonesByQuery(page, pageSize, zoneID, name, query)
+	})
 	if err != nil {
 		if ctx.IsNotVerboseTerminalOutput() {
 			printer.Println("Failed to list zones.")
@@ -89,6 +104,9 @@ func runECommandForListZones(deps azcli.CliDependenciesProvider, cmd *cobra.Comm
 	} else if ctx.IsJSONOutput() {
 		output["zones"] = zones
 	}
+	if nextCursor != "" {
+		output["next_cursor"] = nextCursor
+	}
 	printer.PrintlnMap(output)
 	return nil
 }
@@ -109,6 +127,8 @@ Examples:
   permguard zones list --zone-id 268786704340
   # list zones and filter by zone and name
   permguard zones list --zone-id 268786704340--name dev
+  # list all the zones with a name prefix, newest first, following all the pages
+  permguard zones list --name-prefix dev --sort-by created_at --sort-order desc --all
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runECommandForListZones(deps, cmd, v)
@@ -122,5 +142,6 @@ Examples:
 	v.BindPFlag(azoptions.FlagName(commandNameForZonesList, aziclicommon.FlagCommonZoneID), command.Flags().Lookup(aziclicommon.FlagCommonZoneID))
 	command.Flags().String(aziclicommon.FlagCommonName, "", "filter results by zone name")
 	v.BindPFlag(azoptions.FlagName(commandNameForZonesList, aziclicommon.FlagCommonName), command.Flags().Lookup(aziclicommon.FlagCommonName))
+	aziclicommon.AddFetchQueryFlags(command, v, commandNameForZonesList, "zone")
 	return command
 }
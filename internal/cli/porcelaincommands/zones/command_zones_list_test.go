@@ -68,7 +68,7 @@ func TestCliZonesListWithError(t *testing.T) {
 		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
 
 		zapClient := azmocks.NewGrpcZAPClientMock()
-		zapClient.On("FetchZonesBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrClientParameter)
+		zapClient.On("FetchZonesByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, "", azerrors.ErrClientParameter)
 
 		printerMock := azmocks.NewPrinterMock()
 		printerMock.On("Println", mock.Anything).Return()
@@ -122,7 +122,7 @@ func TestCliZonesListWithSuccess(t *testing.T) {
 				UpdatedAt: time.Now(),
 			},
 		}
-		zapClient.On("FetchZonesBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(zones, nil)
+		zapClient.On("FetchZonesByQuery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(zones, "", nil)
 
 		printerMock := azmocks.NewPrinterMock()
 		outputPrinter := map[string]any{}
@@ -0,0 +1,177 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zones
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aziclizonestate "github.com/permguard/permguard/internal/cli/zonestate"
+	azcli "github.com/permguard/permguard/pkg/cli"
+	azoptions "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// commandNameForZonesPlan is the command name for zones plan.
+	commandNameForZonesPlan = "zones-plan"
+	// flagZonesPrune is the flag to delete the resources missing from the zone file.
+	flagZonesPrune = "prune"
+)
+
+// runECommandForReconcileZone runs the command for planning or applying the zone file.
+func runECommandForReconcileZone(deps azcli.CliDependenciesProvider, cmd *cobra.Command, v *viper.Viper, commandName string, apply bool) error {
+	opErrorMessage := "Failed to plan the zone"
+	if apply {
+		opErrorMessage = "Failed to apply the zone"
+	}
+	ctx, printer, err := aziclicommon.CreateContextAndPrinter(deps, cmd, v)
+	if err != nil {
+		color.Red(fmt.Sprintf("%s", err))
+		return aziclicommon.ErrCommandSilent
+	}
+	printErr := func(errCode error, err error) error {
+		if ctx.IsNotVerboseTerminalOutput() {
+			printer.Println(fmt.Sprintf("%s.", opErrorMessage))
+		}
+		if ctx.IsVerboseTerminalOutput() || ctx.IsJSONOutput() {
+			sysErr := azerrors.WrapHandledSysErrorWithMessage(errCode, strings.ToLower(opErrorMessage), err)
+			printer.Error(sysErr)
+		}
+		return aziclicommon.ErrCommandSilent
+	}
+	zapTarget, err := ctx.GetZAPTarget()
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	papTarget, err := ctx.GetPAPTarget()
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	file := v.GetString(azoptions.FlagName(commandName, aziclicommon.FlagCommonFile))
+	prune := v.GetBool(azoptions.FlagName(commandName, flagZonesPrune))
+	manifest, err := aziclizonestate.ReadManifest(file)
+	if err != nil {
+		return printErr(azerrors.ErrCliInput, err)
+	}
+	zapClient, err := deps.CreateGrpcZAPClient(zapTarget)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	papClient, err := deps.CreateGrpcPAPClient(papTarget)
+	if err != nil {
+		return printErr(azerrors.ErrCliArguments, err)
+	}
+	state, err := aziclizonestate.FetchZoneState(zapClient, papClient, manifest)
+	if err != nil {
+		return printErr(azerrors.ErrCliOperation, err)
+	}
+	plan, err := aziclizonestate.BuildPlan(manifest, state, prune)
+	if err != nil {
+		return printErr(azerrors.ErrCliInput, err)
+	}
+	if ctx.IsTerminalOutput() {
+		printZonePlan(printer, plan)
+	}
+	if !apply || plan.IsEmpty() {
+		if ctx.IsJSONOutput() {
+			printer.PrintlnMap(map[string]any{"plan": plan})
+		}
+		return nil
+	}
+	applied, err := aziclizonestate.ApplyPlan(zapClient, papClient, plan)
+	if err != nil {
+		if ctx.IsTerminalOutput() {
+			printer.Println(fmt.Sprintf("Applied %s of %s changes.", aziclicommon.NumberText(len(applied)), aziclicommon.NumberText(len(plan.Changes))))
+		}
+		return printErr(azerrors.ErrCliOperation, err)
+	}
+	if ctx.IsTerminalOutput() {
+		printer.Println(fmt.Sprintf("The zone %s has been reconciled with %s changes.", aziclicommon.IDText(fmt.Sprintf("%d", plan.ZoneID)), aziclicommon.NumberText(len(applied))))
+	} else if ctx.IsJSONOutput() {
+		printer.PrintlnMap(map[string]any{"plan": plan, "applied": applied})
+	}
+	return nil
+}
+
+// printZonePlan prints the changes of the plan to the terminal.
+func printZonePlan(printer azcli.CliPrinter, plan *aziclizonestate.Plan) {
+	if plan.IsEmpty() {
+		printer.Println(fmt.Sprintf("The zone %s is up to date, no changes are required.", aziclicommon.NameText(plan.ZoneName)))
+		return
+	}
+	lines := []string{fmt.Sprintf("The following changes have been identified for the zone %s:\n", aziclicommon.NameText(plan.ZoneName))}
+	for _, change := range plan.Changes {
+		name := change.Name
+		if change.Parent != "" {
+			name = fmt.Sprintf("%s/%s", change.Parent, change.Name)
+		}
+		var line string
+		switch change.Action {
+		case aziclizonestate.ActionCreate:
+			line = fmt.Sprintf("\t%s %s %s", aziclicommon.CreateText("+"), aziclicommon.KeywordText(change.Resource), aziclicommon.CreateText(name))
+		case aziclizonestate.ActionUpdate:
+			line = fmt.Sprintf("\t%s %s %s %s", aziclicommon.ModifyText("~"), aziclicommon.KeywordText(change.Resource), aziclicommon.IDText(change.ID), aziclicommon.ModifyText(name))
+		case aziclizonestate.ActionDelete:
+			line = fmt.Sprintf("\t%s %s %s %s", aziclicommon.DeleteText("-"), aziclicommon.KeywordText(change.Resource), aziclicommon.IDText(change.ID), aziclicommon.DeleteText(name))
+		}
+		if change.Details != "" {
+			line = fmt.Sprintf("%s (%s)", line, change.Details)
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "")
+	createdCountText := aziclicommon.CreateText(fmt.Sprint(plan.Count(aziclizonestate.ActionCreate)))
+	modifiedCountText := aziclicommon.ModifyText(fmt.Sprint(plan.Count(aziclizonestate.ActionUpdate)))
+	deletedCountText := aziclicommon.DeleteText(fmt.Sprint(plan.Count(aziclizonestate.ActionDelete)))
+	lines = append(lines, fmt.Sprintf("created %s, modified %s, deleted %s", createdCountText, modifiedCountText, deletedCountText))
+	printer.Println(strings.Join(lines, "\n"))
+}
+
+// addZoneFileFlags adds the flags of the commands reading the zone file.
+func addZoneFileFlags(command *cobra.Command, v *viper.Viper, commandName string) {
+	command.Flags().StringP(aziclicommon.FlagCommonFile, aziclicommon.FlagCommonFileShort, "", "specify the path of the yaml or toml zone file")
+	v.BindPFlag(azoptions.FlagName(commandName, aziclicommon.FlagCommonFile), command.Flags().Lookup(aziclicommon.FlagCommonFile))
+	command.Flags().Bool(flagZonesPrune, false, "delete the remote resources missing from the zone file")
+	v.BindPFlag(azoptions.FlagName(commandName, flagZonesPrune), command.Flags().Lookup(flagZonesPrune))
+}
+
+// createCommandForZonePlan creates a command for planning the zone file.
+func createCommandForZonePlan(deps azcli.CliDependenciesProvider, v *viper.Viper) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "plan",
+		Short: "Plan the changes required to reconcile a remote zone with a zone file",
+		Long: aziclicommon.BuildCliLongTemplate(`This command compares a yaml or toml zone file with the remote zone and shows the changes required to reconcile them.
+
+Examples:
+  # plan the changes of a zone file
+  permguard zones plan --file zone.yaml
+  # plan the changes of a zone file including the deletion of the resources missing from the file
+  permguard zones plan --file zone.toml --prune
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runECommandForReconcileZone(deps, cmd, v, commandNameForZonesPlan, false)
+		},
+	}
+	addZoneFileFlags(command, v, commandNameForZonesPlan)
+	return command
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zones
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	aztestutils "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils"
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azconfigs "github.com/permguard/permguard/pkg/cli/options"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// writeTestZoneFile writes the zone file used by the tests.
+func writeTestZoneFile(t *testing.T) string {
+	file := filepath.Join(t.TempDir(), "zone.yaml")
+	content := `zone:
+  id: 581616507495
+  name: mycorporate
+tenants:
+  - name: matera-branch
+ledgers:
+  - name: mycorporate
+`
+	assert.Nil(t, os.WriteFile(file, []byte(content), 0o600))
+	return file
+}
+
+// TestCreateCommandForZonesPlan tests the createCommandForZonePlan function.
+func TestCreateCommandForZonesPlan(t *testing.T) {
+	args := []string{"-h"}
+	outputs := []string{"The official Permguard Command Line Interface", "Copyright © 2022 Nitro Agility S.r.l.", "This command compares a yaml or toml zone file with the remote zone"}
+	aztestutils.BaseCommandTest(t, createCommandForZonePlan, args, false, outputs)
+}
+
+// TestCliZonesPlanWithError tests the command for planning a zone file with an error.
+func TestCliZonesPlanWithError(t *testing.T) {
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"zones", "plan", "--file", writeTestZoneFile(t), "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForZonePlan(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		zapClient := azmocks.NewGrpcZAPClientMock()
+		zapClient.On("FetchZonesBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrClientParameter)
+		papClient := azmocks.NewGrpcPAPClientMock()
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+		printerMock.On("Error", mock.Anything).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+		depsMocks.On("CreateGrpcPAPClient", mock.Anything).Return(papClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, true, outputs)
+		printerMock.AssertCalled(t, "Error", mock.Anything)
+	}
+}
+
+// TestCliZonesPlanWithSuccess tests the command for planning a zone file.
+func TestCliZonesPlanWithSuccess(t *testing.T) {
+	tests := []string{
+		"terminal",
+		"json",
+	}
+	for _, outputType := range tests {
+		args := []string{"zones", "plan", "--file", writeTestZoneFile(t), "--prune", "--output", outputType}
+		outputs := []string{""}
+
+		v := viper.New()
+		v.Set("output", outputType)
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixZAP, aziclicommon.FlagSuffixZAPTarget), "localhost:9092")
+		v.Set(azconfigs.FlagName(aziclicommon.FlagPrefixPAP, aziclicommon.FlagSuffixPAPTarget), "localhost:9092")
+
+		depsMocks := azmocks.NewCliDependenciesMock()
+		cmd := createCommandForZonePlan(depsMocks, v)
+		cmd.PersistentFlags().StringP(aziclicommon.FlagWorkingDirectory, aziclicommon.FlagWorkingDirectoryShort, ".", "work directory")
+		cmd.PersistentFlags().StringP(aziclicommon.FlagOutput, aziclicommon.FlagOutputShort, outputType, "output format")
+		cmd.PersistentFlags().BoolP(aziclicommon.FlagVerbose, aziclicommon.FlagVerboseShort, true, "true for verbose output")
+
+		zoneID := int64(581616507495)
+		zapClient := azmocks.NewGrpcZAPClientMock()
+		zapClient.On("FetchZonesBy", int32(1), int32(1000), zoneID, "").Return([]azmodelzap.Zone{{ZoneID: zoneID, Name: "mycorporate"}}, nil)
+		zapClient.On("FetchTenantsByQuery", int32(1), int32(1000), zoneID, "", "", &azmodels.FetchQuery{}).Return([]azmodelzap.Tenant{{TenantID: "t1", Name: "rome-branch"}}, "", nil)
+		zapClient.On("FetchIdentitySourcesByQuery", int32(1), int32(1000), zoneID, "", "", &azmodels.FetchQuery{}).Return([]azmodelzap.IdentitySource{}, "", nil)
+		zapClient.On("FetchIdentitiesByQuery", int32(1), int32(1000), zoneID, "", "", "", "", &azmodels.FetchQuery{}).Return([]azmodelzap.Identity{}, "", nil)
+		papClient := azmocks.NewGrpcPAPClientMock()
+		papClient.On("FetchLedgersByQuery", int32(1), int32(1000), zoneID, "", "", "", &azmodels.FetchQuery{}).Return([]azmodelpap.Ledger{{LedgerID: "l1", Kind: "policy", Name: "mycorporate"}}, "", nil)
+
+		printerMock := azmocks.NewPrinterMock()
+		printerMock.On("Println", mock.Anything).Return()
+		printerMock.On("PrintlnMap", mock.Anything).Return()
+
+		depsMocks.On("CreatePrinter", mock.Anything, mock.Anything).Return(printerMock, nil)
+		depsMocks.On("CreateGrpcZAPClient", mock.Anything).Return(zapClient, nil)
+		depsMocks.On("CreateGrpcPAPClient", mock.Anything).Return(papClient, nil)
+
+		aztestutils.BaseCommandWithParamsTest(t, v, cmd, args, false, outputs)
+		if outputType == "terminal" {
+			printerMock.AssertNumberOfCalls(t, "Println", 1)
+		} else {
+			printerMock.AssertNumberOfCalls(t, "PrintlnMap", 1)
+		}
+		zapClient.AssertNotCalled(t, "CreateTenant", mock.Anything, mock.Anything)
+		zapClient.AssertNotCalled(t, "DeleteTenant", mock.Anything, mock.Anything)
+	}
+}
@@ -51,12 +51,12 @@ func (m *RemoteServerManager) GetServerRemoteLedger(remoteInfo *azicliwkscommon.
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput, "ledger info is nil")
 	}
 	zoneerver := fmt.Sprintf("%s:%d", remoteInfo.GetServer(), remoteInfo.GetZAPPort())
-	zapClient, err := aziclients.NewGrpcZAPClient(zoneerver)
+	zapClient, err := aziclients.NewGrpcZAPClientWithConfig(zoneerver, m.ctx.GetGrpcClientConfig())
 	if err != nil {
 		return nil, err
 	}
 	pppServer := fmt.Sprintf("%s:%d", remoteInfo.GetServer(), remoteInfo.GetPAPPort())
-	papClient, err := aziclients.NewGrpcPAPClient(pppServer)
+	papClient, err := aziclients.NewGrpcPAPClientWithConfig(pppServer, m.ctx.GetGrpcClientConfig())
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ type NOTPClient interface {
 // NOTPPush push objects using the NOTP protocol.
 func (m *RemoteServerManager) NOTPPush(server string, papPort int, zoneID int64, ledgerID string, bag map[string]any, clientProvider NOTPClient) (*notpstatemachines.StateMachineRuntimeContext, error) {
 	pppServer := fmt.Sprintf("%s:%d", server, papPort)
-	papClient, err := aziclients.NewGrpcPAPClient(pppServer)
+	papClient, err := aziclients.NewGrpcPAPClientWithConfig(pppServer, m.ctx.GetGrpcClientConfig())
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +147,7 @@ func (m *RemoteServerManager) NOTPPush(server string, papPort int, zoneID int64,
 // NOTPPull pull objects using the NOTP protocol.
 func (m *RemoteServerManager) NOTPPull(server string, papPort int, zoneID int64, ledgerID string, bag map[string]any, clientProvider NOTPClient) (*notpstatemachines.StateMachineRuntimeContext, error) {
 	pppServer := fmt.Sprintf("%s:%d", server, papPort)
-	papClient, err := aziclients.NewGrpcPAPClient(pppServer)
+	papClient, err := aziclients.NewGrpcPAPClientWithConfig(pppServer, m.ctx.GetGrpcClientConfig())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,164 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	azicliwkscosp "github.com/permguard/permguard/internal/cli/workspace/cosp"
+	azicliwkspers "github.com/permguard/permguard/internal/cli/workspace/persistence"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azlint "github.com/permguard/permguard/pkg/authz/lint"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// errMessageDuplicateObjectName is the error message of the duplicate object names.
+	errMessageDuplicateObjectName = "language: duplicate object name found in the code files. please ensure that there are no duplicate object names"
+)
+
+// WorkspaceAnalysis represents the in-memory analysis of the source files of the workspace.
+type WorkspaceAnalysis struct {
+	// CodeFiles are the code files with a section for each object, invalid and duplicate objects are flagged with errors.
+	CodeFiles []azicliwkscosp.CodeFile
+	// Findings are the lint findings raised against the schema.
+	Findings []azlint.Finding
+	// Schema is the schema source of the workspace.
+	Schema *azlint.Source
+	// Ref is the ref of the current head, empty if the workspace has not checked out a ledger.
+	Ref string
+	// LedgerURI is the uri of the ledger of the current head.
+	LedgerURI string
+	// RemoteStates are the states of the objects against the remote ledger indexed by object name.
+	RemoteStates map[string]azicliwkscosp.CodeObjectState
+}
+
+// readSourceFile reads a source file of the workspace, documents overriding the content of the files on disk.
+func (m *WorkspaceManager) readSourceFile(path string, documents map[string][]byte) ([]byte, uint32, error) {
+	data, mode, err := m.persMgr.ReadFile(azicliwkspers.WorkspaceDir, path, false)
+	if document, ok := documents[path]; ok {
+		return document, mode, nil
+	}
+	return data, mode, err
+}
+
+// hasCodeFileErrors returns true if any of the code files has errors.
+func hasCodeFileErrors(codeFiles []azicliwkscosp.CodeFile) bool {
+	for _, codeFile := range codeFiles {
+		if codeFile.HasErrors {
+			return true
+		}
+	}
+	return false
+}
+
+// AnalyzeWorkspace analyses the source files of the workspace without changing the local state, documents override the content of the files on disk and are indexed by path relative to the workspace.
+func (m *WorkspaceManager) AnalyzeWorkspace(documents map[string][]byte) (*WorkspaceAnalysis, error) {
+	if err := m.hasValidManifestWorkspaceDir(); err != nil {
+		return nil, err
+	}
+	absLang, err := m.getLanguageAbstraction()
+	if err != nil {
+		return nil, err
+	}
+	codeFiles, _, err := m.scanSourceCodeFiles(absLang)
+	if err != nil {
+		return nil, err
+	}
+	analysis := &WorkspaceAnalysis{
+		CodeFiles:    []azicliwkscosp.CodeFile{},
+		Findings:     []azlint.Finding{},
+		RemoteStates: map[string]azicliwkscosp.CodeObjectState{},
+	}
+	wkdir := m.ctx.GetWorkDir()
+	schemaFileCount := 0
+	sources := []azlint.Source{}
+	for _, file := range codeFiles {
+		data, mode, err := m.readSourceFile(file.Path, documents)
+		if err != nil {
+			return nil, err
+		}
+		var blbCodeFiles []azicliwkscosp.CodeFile
+		switch file.Kind {
+		case azicliwkscosp.CodeFileTypeOfCodeType:
+			blbCodeFiles, _ = buildLanguageCodeFiles(absLang, file.Path, data, file, wkdir, mode)
+			if !hasCodeFileErrors(blbCodeFiles) {
+				sources = append(sources, azlint.Source{Path: file.Path, Data: data})
+			}
+		case azicliwkscosp.CodeFileOfSchemaType:
+			schemaFileCount++
+			blbCodeFiles, _ = buildPermSchemaCodeFiles(schemaFileCount, file.Path, wkdir, mode, absLang, data, file)
+			if !hasCodeFileErrors(blbCodeFiles) {
+				analysis.Schema = &azlint.Source{Path: file.Path, Data: data}
+			}
+		default:
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliFileOperation, "file type is not supported")
+		}
+		analysis.CodeFiles = append(analysis.CodeFiles, blbCodeFiles...)
+	}
+	nameMap := make(map[string]int)
+	validFiles := []azicliwkscosp.CodeFile{}
+	for i, codeFile := range analysis.CodeFiles {
+		if codeFile.HasErrors {
+			continue
+		}
+		nameMap[codeFile.OName]++
+		if nameMap[codeFile.OName] > 1 {
+			analysis.CodeFiles[i].HasErrors = true
+			analysis.CodeFiles[i].ErrorMessage = errMessageDuplicateObjectName
+			continue
+		}
+		validFiles = append(validFiles, codeFile)
+	}
+	if linter, ok := absLang.(azlang.LanguageLinter); ok {
+		config, err := m.readLintConfig()
+		if err != nil {
+			return nil, err
+		}
+		findings, err := linter.LintPolicies(sources, analysis.Schema, config)
+		if err == nil {
+			for _, finding := range config.Apply(findings) {
+				if finding.RuleID == azlint.RuleUnknownAction || finding.RuleID == azlint.RuleUnknownEntityType {
+					analysis.Findings = append(analysis.Findings, finding)
+				}
+			}
+		}
+	}
+	headCtx, err := m.getCurrentHeadContext()
+	if err != nil {
+		return analysis, nil
+	}
+	analysis.Ref = headCtx.GetRef()
+	analysis.LedgerURI = headCtx.GetLedgerURI()
+	remoteCodeState := []azicliwkscosp.CodeObjectState{}
+	if remoteTree, err := m.GetCurrentHeadTree(headCtx.GetRef()); err == nil && remoteTree != nil {
+		remoteCodeState, err = m.cospMgr.BuildCodeSourceCodeStateForTree(remoteTree)
+		if err != nil {
+			return nil, err
+		}
+	}
+	localCodeState, err := m.cospMgr.ConvertCodeFilesToCodeObjectStates(validFiles)
+	if err != nil {
+		return nil, err
+	}
+	codeStateObjs, err := m.plan(localCodeState, remoteCodeState)
+	if err != nil {
+		return nil, err
+	}
+	for _, codeStateObj := range codeStateObjs {
+		analysis.RemoteStates[codeStateObj.OName] = codeStateObj
+	}
+	return analysis, nil
+}
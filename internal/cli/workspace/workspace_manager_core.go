@@ -47,6 +47,8 @@ const (
 	gitDir = ".git"
 	// gitIgnoreFile represents the git ignore file.
 	gitIgnoreFile = ".gitignore"
+	// defaultLanguage represents the language used when the workspace has no manifest yet.
+	defaultLanguage = "cedar"
 )
 
 // WorkspaceManager implements the internal manager to manage the .permguard directory.
@@ -61,6 +63,7 @@ type WorkspaceManager struct {
 	logsMgr   *azicliwkslogs.LogsManager
 	rfsMgr    *azicliwksrefs.RefManager
 	cospMgr   *azicliwkscosp.COSPManager
+	blobCache *blobCache
 }
 
 // NewInternalManager creates a new internal manager.
@@ -192,3 +195,27 @@ func (m *WorkspaceManager) hasValidManifestWorkspaceDir() error {
 	}
 	return nil
 }
+
+// getLanguageAbstraction returns the language abstraction of the runtime declared in the authz-model manifest,
+// the manifests declaring more than one runtime are rejected.
+func (m *WorkspaceManager) getLanguageAbstraction() (azlang.LanguageAbastraction, error) {
+	exists, _ := m.persMgr.CheckPathIfExists(azicliwkspers.WorkspaceDir, azztasmanifests.ManifestFileName)
+	if !exists {
+		return m.langFct.GetLanguageAbastraction(defaultLanguage)
+	}
+	manifestData, _, err := m.persMgr.ReadFile(azicliwkspers.WorkspaceDir, azztasmanifests.ManifestFileName, false)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliWorkspaceDir, "could not read the manifest file in the workspace directory", err)
+	}
+	manifest, err := azztasmanifests.ConvertBytesToManifest(manifestData)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliWorkspaceDir, "invalid manifest in the workspace directory", err)
+	}
+	if len(manifest.Runtimes) > 1 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliWorkspaceDir, "the manifest in the workspace directory declares more than one runtime")
+	}
+	for _, runtime := range manifest.Runtimes {
+		return m.langFct.GetLanguageAbastraction(runtime.Language.Name)
+	}
+	return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliWorkspaceDir, "the manifest in the workspace directory has no runtime")
+}
@@ -35,8 +35,17 @@ func (m *WorkspaceManager) printFiles(action string, files []string, out aziclic
 	}
 }
 
-// ExecPrintContext prints the context.
+// ExecPrintContext prints the context, the name of the active cli context is added to the json output.
 func (m *WorkspaceManager) ExecPrintContext(output map[string]any, out aziclicommon.PrinterOutFunc) map[string]any {
+	if m.ctx.IsJSONOutput() {
+		if cliContextName := m.ctx.GetCliContextName(); cliContextName != "" {
+			if output == nil {
+				output = map[string]any{}
+			}
+			output["cli_context"] = cliContextName
+		}
+		return output
+	}
 	if !m.ctx.IsVerboseTerminalOutput() {
 		return output
 	}
@@ -44,6 +53,23 @@ func (m *WorkspaceManager) ExecPrintContext(output map[string]any, out aziclicom
 	for key, value := range context {
 		out(nil, "context", fmt.Sprintf("%s '%s'.", key, aziclicommon.FileText(value)), nil, true)
 	}
+	if cliContextName := m.ctx.GetCliContextName(); cliContextName != "" {
+		out(nil, "context", fmt.Sprintf("cli context '%s'.", aziclicommon.KeywordText(cliContextName)), nil, true)
+	}
+	return output
+}
+
+// mergeContextOutput merges the json output of the context into the output of a command.
+func mergeContextOutput(output map[string]any, contextOutput map[string]any) map[string]any {
+	if len(contextOutput) == 0 {
+		return output
+	}
+	if output == nil {
+		output = map[string]any{}
+	}
+	for key, value := range contextOutput {
+		output[key] = value
+	}
 	return output
 }
 
@@ -63,7 +89,7 @@ func (m *WorkspaceManager) ExecInitWorkspace(initParams *InitParms, out aziclico
 		out(nil, "", "Failed to initialize the workspace", nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 
 	homeHiddenDir := m.getHomeHiddenDir()
 	res, err := m.persMgr.CreateDirIfNotExists(azicliwkspers.WorkDir, homeHiddenDir)
@@ -151,7 +177,7 @@ func (m *WorkspaceManager) ExecInitWorkspace(initParams *InitParms, out aziclico
 		}
 		output = out(nil, "workspace", remoteObj, nil, true)
 	}
-	return output, nil
+	return mergeContextOutput(output, contextOutput), nil
 }
 
 // execInternalAddRemote adds a remote.
@@ -186,7 +212,7 @@ func (m *WorkspaceManager) ExecAddRemote(remote string, server string, zapPort i
 		out(nil, "", fmt.Sprintf("Failed to add remote %s.", aziclicommon.KeywordText(remote)), nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 	if !m.isWorkspaceDir() {
 		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
 	}
@@ -197,7 +223,8 @@ func (m *WorkspaceManager) ExecAddRemote(remote string, server string, zapPort i
 	}
 	defer fileLock.Unlock()
 
-	return m.execInternalAddRemote(false, remote, server, zapPort, papPort, out)
+	output, err := m.execInternalAddRemote(false, remote, server, zapPort, papPort, out)
+	return mergeContextOutput(output, contextOutput), err
 }
 
 // ExecRemoveRemote removes a remote.
@@ -237,7 +264,7 @@ func (m *WorkspaceManager) ExecListRemotes(out aziclicommon.PrinterOutFunc) (map
 		out(nil, "", "Failed to list remotes.", nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 	if !m.isWorkspaceDir() {
 		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
 	}
@@ -249,7 +276,7 @@ func (m *WorkspaceManager) ExecListRemotes(out aziclicommon.PrinterOutFunc) (map
 	defer fileLock.Unlock()
 
 	output, err := m.cfgMgr.ExecListRemotes(nil, out)
-	return output, err
+	return mergeContextOutput(output, contextOutput), err
 }
 
 // ExecListLedgers lists the ledgers.
@@ -258,7 +285,7 @@ func (m *WorkspaceManager) ExecListLedgers(out aziclicommon.PrinterOutFunc) (map
 		out(nil, "", "Failed to list ledgers.", nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 	if !m.isWorkspaceDir() {
 		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
 	}
@@ -270,5 +297,5 @@ func (m *WorkspaceManager) ExecListLedgers(out aziclicommon.PrinterOutFunc) (map
 	defer fileLock.Unlock()
 
 	output, err := m.cfgMgr.ExecListLedgers(nil, out)
-	return output, err
+	return mergeContextOutput(output, contextOutput), err
 }
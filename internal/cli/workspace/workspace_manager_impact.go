@@ -0,0 +1,262 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	azids "github.com/permguard/permguard-common/pkg/extensions/ids"
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+	azauthzlangtypes "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/authz/languages/types"
+	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azicliwkscosp "github.com/permguard/permguard/internal/cli/workspace/cosp"
+	azicliwkspers "github.com/permguard/permguard/internal/cli/workspace/persistence"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// impactDecision is the decision of a request against a tree.
+type impactDecision struct {
+	Decision bool     `json:"decision"`
+	Policies []string `json:"policies"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// impactRequest is a replayed request whose decision changes with the plan.
+type impactRequest struct {
+	Line      int            `json:"line"`
+	RequestID string         `json:"request_id,omitempty"`
+	Subject   string         `json:"subject"`
+	Action    string         `json:"action"`
+	Resource  string         `json:"resource"`
+	Before    impactDecision `json:"before"`
+	After     impactDecision `json:"after"`
+}
+
+// impactEvaluation is a request to be replayed.
+type impactEvaluation struct {
+	line       int
+	evaluation azmodelspdp.EvaluationRequest
+	entities   *azmodelspdp.Entities
+}
+
+// buildPolicyStore builds the policy store out of the code objects.
+func (m *WorkspaceManager) buildPolicyStore(codeObjStates []azicliwkscosp.CodeObjectState, readObject func(oid string) (*azobjs.Object, error)) (*azauthzen.PolicyStore, error) {
+	policyStore := &azauthzen.PolicyStore{}
+	for _, codeObjState := range codeObjStates {
+		if codeObjState.State == azicliwkscosp.CodeObjectStateDelete {
+			continue
+		}
+		obj, err := readObject(codeObjState.OID)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, fmt.Sprintf("failed to read the object %s", codeObjState.OID), err)
+		}
+		objInfo, err := m.objMar.GetObjectInfo(obj)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, fmt.Sprintf("failed to read the object %s", codeObjState.OID), err)
+		}
+		switch objInfo.GetHeader().GetCodeTypeID() {
+		case azauthzlangtypes.ClassTypeSchemaID:
+			policyStore.AddSchema(objInfo.GetOID(), objInfo)
+		case azauthzlangtypes.ClassTypePolicyID:
+			policyStore.AddPolicy(objInfo.GetOID(), objInfo)
+		}
+	}
+	return policyStore, nil
+}
+
+// readImpactEvaluations reads the requests to be replayed from the json lines file.
+func (m *WorkspaceManager) readImpactEvaluations(impactFile string) ([]impactEvaluation, error) {
+	data, _, err := m.persMgr.ReadFile(azicliwkspers.WorkDir, impactFile, false)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, fmt.Sprintf("failed to read the impact requests file %s", impactFile), err)
+	}
+	evaluations := []impactEvaluation{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		lineData := bytes.TrimSpace(scanner.Bytes())
+		if len(lineData) == 0 {
+			continue
+		}
+		var request azmodelspdp.AuthorizationCheckWithDefaultsRequest
+		if err := json.Unmarshal(lineData, &request); err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliInput, fmt.Sprintf("invalid request at line %d of the impact requests file", line), err)
+		}
+		expRequest, err := azmodelspdp.ExpandAuthorizationCheckWithDefaults(&request)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliInput, fmt.Sprintf("invalid request at line %d of the impact requests file", line), err)
+		}
+		var entities *azmodelspdp.Entities
+		if request.AuthorizationModel != nil {
+			entities = request.AuthorizationModel.Entities
+		}
+		for _, evaluation := range expRequest.Evaluations {
+			if evaluation.Subject == nil || evaluation.Resource == nil || evaluation.Action == nil {
+				return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput, fmt.Sprintf("missing subject, resource or action in the request at line %d of the impact requests file", line))
+			}
+			evaluations = append(evaluations, impactEvaluation{line: line, evaluation: evaluation, entities: entities})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, fmt.Sprintf("failed to read the impact requests file %s", impactFile), err)
+	}
+	return evaluations, nil
+}
+
+// evaluateImpact evaluates the request against the policy store.
+func evaluateImpact(absLang azlang.LanguageAbastraction, policyStore *azauthzen.PolicyStore, evaluation *impactEvaluation) impactDecision {
+	expRequest := evaluation.evaluation
+	authzCtx := azauthzen.AuthorizationModel{}
+	authzCtx.SetSubject(expRequest.Subject.Type, expRequest.Subject.ID, expRequest.Subject.Source, expRequest.Subject.Properties)
+	authzCtx.SetResource(expRequest.Resource.Type, expRequest.Resource.ID, expRequest.Resource.Properties)
+	authzCtx.SetAction(expRequest.Action.Name, expRequest.Action.Properties)
+	authzCtx.SetContext(expRequest.Context)
+	if evaluation.entities != nil {
+		authzCtx.SetEntities(evaluation.entities.Schema, evaluation.entities.Items)
+	}
+	contextID := expRequest.ContextID
+	if contextID == "" {
+		contextID = azids.GenerateID()
+	}
+	var authzDecision *azauthzen.AuthorizationDecision
+	policies := []string{}
+	var err error
+	if explainer, ok := absLang.(azlang.LanguageDecisionExplainer); ok {
+		authzDecision, policies, err = explainer.ExplainAuthorizationCheck(contextID, policyStore, &authzCtx)
+	} else {
+		authzDecision, err = absLang.AuthorizationCheck(contextID, policyStore, &authzCtx)
+	}
+	if err != nil {
+		return impactDecision{Policies: []string{}, Error: err.Error()}
+	}
+	if authzDecision == nil {
+		return impactDecision{Policies: []string{}, Error: "the authorization decision is missing"}
+	}
+	return impactDecision{Decision: authzDecision.GetDecision(), Policies: policies}
+}
+
+// analyseImpact replays the requests against the remote and the local code objects and returns the requests whose decision changes.
+func (m *WorkspaceManager) analyseImpact(impactFile string, localCodeState, remoteCodeState []azicliwkscosp.CodeObjectState) (int, []impactRequest, error) {
+	absLang, err := m.getLanguageAbstraction()
+	if err != nil {
+		return 0, nil, err
+	}
+	evaluations, err := m.readImpactEvaluations(impactFile)
+	if err != nil {
+		return 0, nil, err
+	}
+	remotePolicyStore, err := m.buildPolicyStore(remoteCodeState, m.cospMgr.ReadObject)
+	if err != nil {
+		return 0, nil, err
+	}
+	localPolicyStore, err := m.buildPolicyStore(localCodeState, m.cospMgr.ReadCodeSourceObject)
+	if err != nil {
+		return 0, nil, err
+	}
+	changes := replayImpact(evaluations, func(evaluation *impactEvaluation) impactDecision {
+		return evaluateImpact(absLang, remotePolicyStore, evaluation)
+	}, func(evaluation *impactEvaluation) impactDecision {
+		return evaluateImpact(absLang, localPolicyStore, evaluation)
+	})
+	return len(evaluations), changes, nil
+}
+
+// replayImpact replays the requests with the decisions before and after the plan and returns the requests whose decision changes.
+func replayImpact(evaluations []impactEvaluation, evaluateBefore, evaluateAfter func(evaluation *impactEvaluation) impactDecision) []impactRequest {
+	changes := []impactRequest{}
+	for i := range evaluations {
+		evaluation := &evaluations[i]
+		before := evaluateBefore(evaluation)
+		after := evaluateAfter(evaluation)
+		if before.Decision == after.Decision && before.Error == after.Error {
+			continue
+		}
+		expRequest := evaluation.evaluation
+		changes = append(changes, impactRequest{
+			Line:      evaluation.line,
+			RequestID: expRequest.RequestID,
+			Subject:   fmt.Sprintf("%s::%s", expRequest.Subject.Type, expRequest.Subject.ID),
+			Action:    expRequest.Action.Name,
+			Resource:  fmt.Sprintf("%s::%s", expRequest.Resource.Type, expRequest.Resource.ID),
+			Before:    before,
+			After:     after,
+		})
+	}
+	return changes
+}
+
+// decisionText returns the text of the decision.
+func decisionText(decision impactDecision) string {
+	if decision.Error != "" {
+		return "error"
+	} else if decision.Decision {
+		return "allow"
+	}
+	return "deny"
+}
+
+// printImpact prints the requests whose decision changes with the plan.
+func (m *WorkspaceManager) printImpact(total int, changes []impactRequest, out aziclicommon.PrinterOutFunc) {
+	if len(changes) == 0 {
+		out(nil, "", fmt.Sprintf("Impact analysis replayed %s requests, no decision changes detected.", aziclicommon.NumberText(total)), nil, true)
+		out(nil, "", "", nil, true)
+		return
+	}
+	allowToDeny, denyToAllow := 0, 0
+	for _, change := range changes {
+		if change.Before.Decision && !change.After.Decision {
+			allowToDeny++
+		} else if !change.Before.Decision && change.After.Decision {
+			denyToAllow++
+		}
+	}
+	out(nil, "", fmt.Sprintf("Impact analysis replayed %s requests, the following decisions change with the plan:\n", aziclicommon.NumberText(total)), nil, true)
+	for _, change := range changes {
+		transition := fmt.Sprintf("%s -> %s", decisionText(change.Before), decisionText(change.After))
+		if change.After.Decision {
+			transition = aziclicommon.CreateText(transition)
+		} else {
+			transition = aziclicommon.DeleteText(transition)
+		}
+		request := fmt.Sprintf("line %d", change.Line)
+		if change.RequestID != "" {
+			request = fmt.Sprintf("%s (%s)", request, change.RequestID)
+		}
+		out(nil, "", fmt.Sprintf("	%s %s %s %s %s", transition, aziclicommon.UnchangedText(request), aziclicommon.NameText(change.Subject), aziclicommon.KeywordText(change.Action), aziclicommon.NameText(change.Resource)), nil, true)
+		for _, decision := range []struct {
+			label    string
+			decision impactDecision
+		}{{"before", change.Before}, {"after", change.After}} {
+			if decision.decision.Error != "" {
+				out(nil, "", fmt.Sprintf("	   %s: %s", decision.label, aziclicommon.LogErrorText(decision.decision.Error)), nil, true)
+			} else if len(decision.decision.Policies) > 0 {
+				out(nil, "", fmt.Sprintf("	   %s: %v", decision.label, decision.decision.Policies), nil, true)
+			}
+		}
+	}
+	out(nil, "", "", nil, true)
+	out(nil, "", fmt.Sprintf("allow to deny %s, deny to allow %s, errors %s", aziclicommon.DeleteText(fmt.Sprint(allowToDeny)), aziclicommon.CreateText(fmt.Sprint(denyToAllow)), aziclicommon.NumberText(len(changes)-allowToDeny-denyToAllow)), nil, true)
+	out(nil, "", "", nil, true)
+}
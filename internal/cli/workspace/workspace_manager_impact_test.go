@@ -0,0 +1,355 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azicliwkscosp "github.com/permguard/permguard/internal/cli/workspace/cosp"
+	azicliwkspers "github.com/permguard/permguard/internal/cli/workspace/persistence"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// newImpactEvaluation creates a request to be replayed.
+func newImpactEvaluation(line int, requestID string) impactEvaluation {
+	return impactEvaluation{
+		line: line,
+		evaluation: azmodelspdp.EvaluationRequest{
+			RequestID: requestID,
+			Subject:   &azmodelspdp.Subject{Type: "user", ID: "amy.smith@acmecorp.com"},
+			Resource:  &azmodelspdp.Resource{Type: "MagicFarmacia::Platform::BranchInfo", ID: "subscription"},
+			Action:    &azmodelspdp.Action{Name: "MagicFarmacia::Platform::Action::view"},
+		},
+	}
+}
+
+// impactDecisions returns the evaluation function serving the decisions by request id.
+func impactDecisions(decisions map[string]impactDecision) func(evaluation *impactEvaluation) impactDecision {
+	return func(evaluation *impactEvaluation) impactDecision {
+		return decisions[evaluation.evaluation.RequestID]
+	}
+}
+
+// TestReadImpactEvaluations tests the read of the requests to be replayed.
+func TestReadImpactEvaluations(t *testing.T) {
+	request := `{"request_id": "inv-%d", "subject": {"type": "user", "id": "amy.smith@acmecorp.com"}, "resource": {"type": "MagicFarmacia::Platform::BranchInfo", "id": "subscription"}, "action": {"name": "MagicFarmacia::Platform::Action::view"}}`
+	tests := []struct {
+		name          string
+		content       string
+		expectedLines []int
+		expectedError string
+	}{
+		{
+			name:          "empty file",
+			content:       "",
+			expectedLines: []int{},
+		},
+		{
+			name:          "blank lines only",
+			content:       "\n  \n\n",
+			expectedLines: []int{},
+		},
+		{
+			name:          "requests with blank lines",
+			content:       fmt.Sprintf(request, 1) + "\n\n" + fmt.Sprintf(request, 3) + "\n",
+			expectedLines: []int{1, 3},
+		},
+		{
+			name:          "request with evaluations",
+			content:       `{"subject": {"type": "user", "id": "amy.smith@acmecorp.com"}, "evaluations": [{"request_id": "inv-1", "resource": {"type": "MagicFarmacia::Platform::BranchInfo", "id": "subscription"}, "action": {"name": "MagicFarmacia::Platform::Action::view"}}, {"request_id": "inv-2", "resource": {"type": "MagicFarmacia::Platform::BranchInfo", "id": "subscription"}, "action": {"name": "MagicFarmacia::Platform::Action::assignRole"}}]}`,
+			expectedLines: []int{1, 1},
+		},
+		{
+			name:          "malformed request",
+			content:       fmt.Sprintf(request, 1) + "\n" + `{"request_id": "inv-2", "subject": ` + "\n",
+			expectedError: "invalid request at line 2",
+		},
+		{
+			name:          "request not being an object",
+			content:       "\n\n[]\n",
+			expectedError: "invalid request at line 3",
+		},
+		{
+			name:          "missing subject",
+			content:       fmt.Sprintf(request, 1) + "\n" + fmt.Sprintf(request, 2) + "\n" + `{"request_id": "inv-3", "resource": {"type": "MagicFarmacia::Platform::BranchInfo", "id": "subscription"}, "action": {"name": "MagicFarmacia::Platform::Action::view"}}`,
+			expectedError: "missing subject, resource or action in the request at line 3",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			root := t.TempDir()
+			persMgr, err := azicliwkspers.NewPersistenceManager(root, ".permguard", nil)
+			assert.Nil(err, "persistence manager should be created")
+			impactFile := filepath.Join(root, "requests.jsonl")
+			assert.Nil(os.WriteFile(impactFile, []byte(test.content), 0o600), "impact file should be written")
+			m := &WorkspaceManager{persMgr: persMgr}
+
+			evaluations, err := m.readImpactEvaluations(impactFile)
+			if test.expectedError != "" {
+				assert.NotNil(err, "the impact file should be rejected")
+				assert.Contains(err.Error(), test.expectedError, "the error should report the line")
+				return
+			}
+			assert.Nil(err, "the impact file should be read")
+			lines := []int{}
+			for _, evaluation := range evaluations {
+				lines = append(lines, evaluation.line)
+				assert.NotEmpty(evaluation.evaluation.ContextID, "the request should have a context id")
+			}
+			assert.Equal(test.expectedLines, lines, "the requests should keep their line")
+		})
+	}
+}
+
+// TestReadImpactEvaluationsWithMissingFile tests the read of a missing impact file.
+func TestReadImpactEvaluationsWithMissingFile(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	persMgr, _ := azicliwkspers.NewPersistenceManager(root, ".permguard", nil)
+	m := &WorkspaceManager{persMgr: persMgr}
+
+	_, err := m.readImpactEvaluations(filepath.Join(root, "missing.jsonl"))
+	assert.NotNil(err, "a missing impact file should be rejected")
+}
+
+// TestReplayImpact tests the replay of the requests before and after the plan.
+func TestReplayImpact(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   map[string]impactDecision
+		after    map[string]impactDecision
+		expected map[string][2]impactDecision
+	}{
+		{
+			name: "allow to deny",
+			before: map[string]impactDecision{
+				"inv-1": {Decision: true, Policies: []string{"view-branch-inventory-auditor"}},
+			},
+			after: map[string]impactDecision{
+				"inv-1": {Decision: false},
+			},
+			expected: map[string][2]impactDecision{
+				"inv-1": {{Decision: true, Policies: []string{"view-branch-inventory-auditor"}}, {Decision: false}},
+			},
+		},
+		{
+			name: "deny to allow",
+			before: map[string]impactDecision{
+				"inv-1": {Decision: false, Policies: []string{"forbid-branch-inventory"}},
+			},
+			after: map[string]impactDecision{
+				"inv-1": {Decision: true, Policies: []string{"view-branch-inventory-auditor", "view-branch-inventory-manager"}},
+			},
+			expected: map[string][2]impactDecision{
+				"inv-1": {{Decision: false, Policies: []string{"forbid-branch-inventory"}}, {Decision: true, Policies: []string{"view-branch-inventory-auditor", "view-branch-inventory-manager"}}},
+			},
+		},
+		{
+			name: "unchanged decisions",
+			before: map[string]impactDecision{
+				"inv-1": {Decision: true, Policies: []string{"view-branch-inventory-auditor"}},
+				"inv-2": {Decision: false},
+			},
+			after: map[string]impactDecision{
+				"inv-1": {Decision: true, Policies: []string{"view-branch-inventory-manager"}},
+				"inv-2": {Decision: false},
+			},
+			expected: map[string][2]impactDecision{},
+		},
+		{
+			name: "mixed decisions",
+			before: map[string]impactDecision{
+				"inv-1": {Decision: true, Policies: []string{"view-branch-inventory-auditor"}},
+				"inv-2": {Decision: false},
+				"inv-3": {Decision: true, Policies: []string{"view-branch-inventory-manager"}},
+			},
+			after: map[string]impactDecision{
+				"inv-1": {Decision: true, Policies: []string{"view-branch-inventory-auditor"}},
+				"inv-2": {Decision: true, Policies: []string{"assign-role-branch"}},
+				"inv-3": {Decision: false, Error: "invalid schema"},
+			},
+			expected: map[string][2]impactDecision{
+				"inv-2": {{Decision: false}, {Decision: true, Policies: []string{"assign-role-branch"}}},
+				"inv-3": {{Decision: true, Policies: []string{"view-branch-inventory-manager"}}, {Decision: false, Error: "invalid schema"}},
+			},
+		},
+		{
+			name:   "no remote state",
+			before: map[string]impactDecision{},
+			after: map[string]impactDecision{
+				"inv-1": {Decision: true, Policies: []string{"view-branch-inventory-auditor"}},
+				"inv-2": {Decision: false},
+			},
+			expected: map[string][2]impactDecision{
+				"inv-1": {{Decision: false}, {Decision: true, Policies: []string{"view-branch-inventory-auditor"}}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			evaluations := []impactEvaluation{}
+			requestIDs := map[string]bool{}
+			for requestID := range test.before {
+				requestIDs[requestID] = true
+			}
+			for requestID := range test.after {
+				requestIDs[requestID] = true
+			}
+			for i := 1; i <= len(requestIDs); i++ {
+				evaluations = append(evaluations, newImpactEvaluation(i, fmt.Sprintf("inv-%d", i)))
+			}
+
+			changes := replayImpact(evaluations, impactDecisions(test.before), impactDecisions(test.after))
+			assert.Len(changes, len(test.expected), "only the changing decisions should be reported")
+			for _, change := range changes {
+				expected, ok := test.expected[change.RequestID]
+				assert.True(ok, "the request %s should not be reported", change.RequestID)
+				assert.Equal(fmt.Sprintf("inv-%d", change.Line), change.RequestID, "the change should keep the line")
+				assert.Equal("user::amy.smith@acmecorp.com", change.Subject, "the change should report the subject")
+				assert.Equal("MagicFarmacia::Platform::Action::view", change.Action, "the change should report the action")
+				assert.Equal("MagicFarmacia::Platform::BranchInfo::subscription", change.Resource, "the change should report the resource")
+				assert.Equal(expected[0], change.Before, "the change should report the decision before the plan")
+				assert.Equal(expected[1], change.After, "the change should report the decision after the plan")
+			}
+		})
+	}
+}
+
+// TestBuildPolicyStore tests the policy store built out of the code objects, including a workspace with no remote state.
+func TestBuildPolicyStore(t *testing.T) {
+	tests := []struct {
+		name          string
+		codeObjStates []azicliwkscosp.CodeObjectState
+		expectedError bool
+		expectedReads int
+	}{
+		{name: "no remote state", codeObjStates: nil},
+		{name: "empty code objects", codeObjStates: []azicliwkscosp.CodeObjectState{}},
+		{
+			name: "deleted code objects",
+			codeObjStates: []azicliwkscosp.CodeObjectState{
+				{State: azicliwkscosp.CodeObjectStateDelete, CodeObject: azicliwkscosp.CodeObject{OID: "oid-1"}},
+			},
+		},
+		{
+			name: "unreadable code objects",
+			codeObjStates: []azicliwkscosp.CodeObjectState{
+				{State: azicliwkscosp.CodeObjectStateCreate, CodeObject: azicliwkscosp.CodeObject{OID: "oid-1"}},
+			},
+			expectedError: true,
+			expectedReads: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			m := &WorkspaceManager{}
+			reads := 0
+			policyStore, err := m.buildPolicyStore(test.codeObjStates, func(oid string) (*azobjs.Object, error) {
+				reads++
+				return nil, fmt.Errorf("object %s not found", oid)
+			})
+			assert.Equal(test.expectedReads, reads, "only the live code objects should be read")
+			if test.expectedError {
+				assert.NotNil(err, "an unreadable code object should be rejected")
+				return
+			}
+			assert.Nil(err, "the policy store should be built")
+			assert.NotNil(policyStore, "an empty policy store should be returned")
+		})
+	}
+}
+
+// TestPrintImpact tests the print of the decisions changing with the plan.
+func TestPrintImpact(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		changes  []impactRequest
+		expected []string
+		absent   []string
+	}{
+		{
+			name:     "no changes",
+			total:    3,
+			changes:  []impactRequest{},
+			expected: []string{"replayed", "no decision changes detected"},
+			absent:   []string{"before:", "after:"},
+		},
+		{
+			name:  "flips and errors",
+			total: 4,
+			changes: []impactRequest{
+				{
+					Line: 1, RequestID: "inv-1", Subject: "user::amy.smith@acmecorp.com", Action: "MagicFarmacia::Platform::Action::view", Resource: "MagicFarmacia::Platform::BranchInfo::subscription",
+					Before: impactDecision{Decision: true, Policies: []string{"view-branch-inventory-auditor"}},
+					After:  impactDecision{Decision: false},
+				},
+				{
+					Line: 2, Subject: "user::john.doe@acmecorp.com", Action: "MagicFarmacia::Platform::Action::assignRole", Resource: "MagicFarmacia::Platform::BranchInfo::subscription",
+					Before: impactDecision{Decision: false},
+					After:  impactDecision{Decision: true, Policies: []string{"assign-role-branch", "assign-role-manager"}},
+				},
+				{
+					Line: 4, RequestID: "inv-4", Subject: "user::amy.smith@acmecorp.com", Action: "MagicFarmacia::Platform::Action::view", Resource: "MagicFarmacia::Platform::BranchInfo::subscription",
+					Before: impactDecision{Decision: false},
+					After:  impactDecision{Decision: false, Error: "invalid schema"},
+				},
+			},
+			expected: []string{
+				"the following decisions change with the plan",
+				"allow -> deny", "line 1", "(inv-1)", "user::amy.smith@acmecorp.com",
+				"before: [view-branch-inventory-auditor]",
+				"deny -> allow", "line 2", "user::john.doe@acmecorp.com", "MagicFarmacia::Platform::Action::assignRole",
+				"after: [assign-role-branch assign-role-manager]",
+				"deny -> error", "line 4", "after: ", "invalid schema",
+				"allow to deny ", "deny to allow ", "errors ",
+			},
+			absent: []string{"line 2 (", "no decision changes detected"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			lines := []string{}
+			var out aziclicommon.PrinterOutFunc = func(output map[string]any, key string, value any, err error, newLine bool) map[string]any {
+				lines = append(lines, fmt.Sprint(value))
+				return output
+			}
+			m := &WorkspaceManager{}
+			m.printImpact(test.total, test.changes, out)
+			text := strings.Join(lines, "\n")
+			assert.Contains(text, fmt.Sprint(test.total), "the number of replayed requests should be printed")
+			for _, expected := range test.expected {
+				assert.Contains(text, expected, "the output should contain %q", expected)
+			}
+			for _, absent := range test.absent {
+				assert.NotContains(text, absent, "the output should not contain %q", absent)
+			}
+		})
+	}
+}
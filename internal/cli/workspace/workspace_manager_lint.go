@@ -0,0 +1,122 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+
+	azztasmanifests "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/manifests"
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azicliwkscosp "github.com/permguard/permguard/internal/cli/workspace/cosp"
+	azicliwkspers "github.com/permguard/permguard/internal/cli/workspace/persistence"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azlint "github.com/permguard/permguard/pkg/authz/lint"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// readLintConfig reads the lint configuration declared in the manifest.
+func (m *WorkspaceManager) readLintConfig() (*azlint.Config, error) {
+	exists, _ := m.persMgr.CheckPathIfExists(azicliwkspers.WorkspaceDir, azztasmanifests.ManifestFileName)
+	if !exists {
+		return azlint.NewConfig(), nil
+	}
+	manifestData, _, err := m.persMgr.ReadFile(azicliwkspers.WorkspaceDir, azztasmanifests.ManifestFileName, false)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliWorkspaceDir, "could not read the manifest file in the workspace directory", err)
+	}
+	config, err := azlint.ConvertManifestBytesToConfig(manifestData)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliWorkspaceDir, "invalid lint configuration in the manifest", err)
+	}
+	return config, nil
+}
+
+// lintLocal statically analyses the source files of the workspace.
+func (m *WorkspaceManager) lintLocal() (*azlint.Config, []azlint.Finding, error) {
+	config, err := m.readLintConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	absLang, err := m.getLanguageAbstraction()
+	if err != nil {
+		return nil, nil, err
+	}
+	linter, ok := absLang.(azlang.LanguageLinter)
+	if !ok {
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliOperation, "the language of the workspace does not support the lint")
+	}
+	codeFiles, _, err := m.scanSourceCodeFiles(absLang)
+	if err != nil {
+		return nil, nil, err
+	}
+	sources := []azlint.Source{}
+	var schema *azlint.Source
+	for _, codeFile := range codeFiles {
+		data, _, err := m.persMgr.ReadFile(azicliwkspers.WorkspaceDir, codeFile.Path, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		source := azlint.Source{Path: codeFile.Path, Data: data}
+		if codeFile.Kind == azicliwkscosp.CodeFileOfSchemaType {
+			schema = &source
+			continue
+		}
+		sources = append(sources, source)
+	}
+	findings, err := linter.LintPolicies(sources, schema, config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return config, config.Apply(findings), nil
+}
+
+// severityText returns the colored text of the severity.
+func severityText(severity azlint.Severity) string {
+	switch severity {
+	case azlint.SeverityError:
+		return aziclicommon.LogErrorText(string(severity))
+	case azlint.SeverityWarning:
+		return aziclicommon.ModifyText(string(severity))
+	}
+	return aziclicommon.UnchangedText(string(severity))
+}
+
+// printLintFindings prints the lint findings.
+func (m *WorkspaceManager) printLintFindings(findings []azlint.Finding, out aziclicommon.PrinterOutFunc) {
+	errors, warnings, notes := 0, 0, 0
+	for _, finding := range findings {
+		switch finding.Severity {
+		case azlint.SeverityError:
+			errors++
+		case azlint.SeverityWarning:
+			warnings++
+		default:
+			notes++
+		}
+	}
+	if len(findings) == 0 {
+		out(nil, "", "No lint findings detected.", nil, true)
+		return
+	}
+	out(nil, "", "The following lint findings have been detected:\n", nil, true)
+	for _, finding := range findings {
+		location := fmt.Sprintf("%s:%d:%d", finding.Path, finding.Line, finding.Column)
+		out(nil, "", fmt.Sprintf("	%s %s %s: %s", aziclicommon.FileText(location), severityText(finding.Severity), aziclicommon.KeywordText(finding.RuleID), finding.Message), nil, true)
+	}
+	out(nil, "", "", nil, true)
+	out(nil, "", fmt.Sprintf("errors %s, warnings %s, notes %s", aziclicommon.NumberText(errors), aziclicommon.NumberText(warnings), aziclicommon.NumberText(notes)), nil, true)
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azicliwkspers "github.com/permguard/permguard/internal/cli/workspace/persistence"
+	azlint "github.com/permguard/permguard/pkg/authz/lint"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// ExecLint statically analyses the policies of the workspace, the sarif report is written to the sarif file if not empty.
+func (m *WorkspaceManager) ExecLint(sarifFile string, out aziclicommon.PrinterOutFunc) (map[string]any, error) {
+	failedOpErr := func(output map[string]any, err error) (map[string]any, error) {
+		out(nil, "", "Failed to lint the current workspace.", nil, true)
+		return output, err
+	}
+	contextOutput := m.ExecPrintContext(nil, out)
+	if !m.isWorkspaceDir() {
+		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
+	}
+
+	fileLock, err := m.tryLock()
+	if err != nil {
+		return failedOpErr(nil, err)
+	}
+	defer fileLock.Unlock()
+
+	output, err := m.execInternalLint(false, sarifFile, out)
+	return mergeContextOutput(output, contextOutput), err
+}
+
+// execInternalLint statically analyses the policies of the workspace.
+func (m *WorkspaceManager) execInternalLint(internal bool, sarifFile string, out aziclicommon.PrinterOutFunc) (map[string]any, error) {
+	failedOpErr := func(output map[string]any, err error) (map[string]any, error) {
+		if !internal {
+			out(nil, "", "Failed to lint the current workspace.", nil, true)
+		}
+		return output, err
+	}
+
+	output, err := m.execInternalValidate(true, out)
+	if err != nil {
+		output, err := failedOpErr(output, err)
+		return out(output, "", fmt.Sprintf("Please execute '%s' to perform a comprehensive validation check for any potential errors.", aziclicommon.CliCommandText("permguard validate")), nil, true), err
+	}
+	return m.execLintValidatedWorkspace(internal, sarifFile, output, out)
+}
+
+// execLintValidatedWorkspace statically analyses the policies of the already validated workspace.
+func (m *WorkspaceManager) execLintValidatedWorkspace(internal bool, sarifFile string, output map[string]any, out aziclicommon.PrinterOutFunc) (map[string]any, error) {
+	failedOpErr := func(output map[string]any, err error) (map[string]any, error) {
+		if !internal {
+			out(nil, "", "Failed to lint the current workspace.", nil, true)
+		}
+		return output, err
+	}
+	if output == nil {
+		output = map[string]any{}
+	}
+
+	if m.ctx.IsVerboseTerminalOutput() {
+		out(nil, "lint", "Lint process initiated.", nil, true)
+	}
+	config, findings, err := m.lintLocal()
+	if err != nil {
+		if m.ctx.IsVerboseTerminalOutput() {
+			out(nil, "lint", "Lint process couldn't be completed.", nil, true)
+		}
+		return failedOpErr(output, err)
+	}
+	if m.ctx.IsVerboseTerminalOutput() {
+		out(nil, "lint", "Lint process completed.", nil, true)
+	}
+
+	if sarifFile != "" {
+		version, _ := m.ctx.GetClientVersion()
+		data, err := azlint.ConvertSarifLogToBytes(azlint.BuildSarifLog(config, findings, version))
+		if err != nil {
+			return failedOpErr(output, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, "failed to build the sarif report", err))
+		}
+		if _, err := m.persMgr.WriteFile(azicliwkspers.WorkDir, sarifFile, data, 0644, false); err != nil {
+			return failedOpErr(output, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, "failed to write the sarif report", err))
+		}
+		if m.ctx.IsVerboseTerminalOutput() {
+			out(nil, "lint", fmt.Sprintf("Sarif report written to %s.", aziclicommon.FileText(sarifFile)), nil, true)
+		}
+	}
+
+	if m.ctx.IsTerminalOutput() {
+		m.printLintFindings(findings, out)
+	}
+	if m.ctx.IsJSONOutput() {
+		output["lint"] = map[string]any{
+			"findings": findings,
+		}
+	}
+	if azlint.HasErrors(findings) {
+		if !internal {
+			out(nil, "", "\nPlease fix the lint errors to proceed.", nil, true)
+		}
+		return failedOpErr(output, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliOperation, "lint errors found in the policies of the workspace. please check the logs for more details."))
+	}
+	if !internal {
+		out(nil, "", "Your workspace has been linted successfully.", nil, true)
+	}
+	return output, nil
+}
@@ -94,8 +94,9 @@ func (m *WorkspaceManager) scanSourceCodeFiles(absLang azlang.LanguageAbastracti
 	return normalizedCodeFiles, normalizedIgnoredCodeFiles, nil
 }
 
-// blobifyPermSchemaFile blobify a permguard schema file.
-func (m *WorkspaceManager) blobifyPermSchemaFile(schemaFileCount int, path string, wkdir string, mode uint32, blbCodeFiles []azicliwkscosp.CodeFile, absLang azlang.LanguageAbastraction, data []byte, file azicliwkscosp.CodeFile) []azicliwkscosp.CodeFile {
+// buildPermSchemaCodeFiles builds the code files of a permguard schema file along with the objects to be saved.
+func buildPermSchemaCodeFiles(schemaFileCount int, path string, wkdir string, mode uint32, absLang azlang.LanguageAbastraction, data []byte, file azicliwkscosp.CodeFile) ([]azicliwkscosp.CodeFile, map[string][]byte) {
+	objects := map[string][]byte{}
 	if schemaFileCount > 1 {
 		codeFile := azicliwkscosp.CodeFile{
 			Path:         strings.TrimPrefix(path, wkdir),
@@ -104,53 +105,60 @@ func (m *WorkspaceManager) blobifyPermSchemaFile(schemaFileCount int, path strin
 			HasErrors:    true,
 			ErrorMessage: "language: only one schema file is permitted in the workspace. please ensure that there are no duplicate schema files",
 		}
-		blbCodeFiles = append(blbCodeFiles, codeFile)
-	} else {
-		multiSecObj, err := absLang.CreateSchemaBlobObjects(path, data)
-		if err != nil {
-			codeFile := &azicliwkscosp.CodeFile{
-				Kind:         file.Kind,
-				Path:         strings.TrimPrefix(path, wkdir),
-				Section:      0,
-				Mode:         mode,
-				HasErrors:    true,
-				ErrorMessage: err.Error(),
-			}
-			blbCodeFiles = append(blbCodeFiles, *codeFile)
-			return blbCodeFiles
-		}
-		secObj := multiSecObj.GetSectionObjects()[0]
-		codeFile := &azicliwkscosp.CodeFile{
-			Kind:            file.Kind,
-			Path:            strings.TrimPrefix(path, wkdir),
-			Section:         secObj.GetNumberOfSection(),
-			Mode:            mode,
-			HasErrors:       secObj.GetError() != nil,
-			OType:           secObj.GetObjectType(),
-			OName:           secObj.GetObjectName(),
-			CodeID:          secObj.GetCodeID(),
-			CodeType:        secObj.GetCodeType(),
-			Language:        secObj.GetLanguage(),
-			LanguageVersion: secObj.GetLanguageVersion(),
-			LanguageType:    secObj.GetLanguageType(),
-		}
-		if codeFile.HasErrors {
-			codeFile.ErrorMessage = azerrors.ConvertToSystemError(secObj.GetError()).Message()
-		} else {
-			obj := secObj.GetObject()
-			codeFile.OID = obj.GetOID()
-			m.cospMgr.SaveCodeSourceObject(obj.GetOID(), obj.GetContent())
+		return []azicliwkscosp.CodeFile{codeFile}, objects
+	}
+	multiSecObj, err := absLang.CreateSchemaBlobObjects(path, data)
+	if err != nil {
+		codeFile := azicliwkscosp.CodeFile{
+			Kind:         file.Kind,
+			Path:         strings.TrimPrefix(path, wkdir),
+			Section:      0,
+			Mode:         mode,
+			HasErrors:    true,
+			ErrorMessage: err.Error(),
 		}
-		blbCodeFiles = append(blbCodeFiles, *codeFile)
+		return []azicliwkscosp.CodeFile{codeFile}, objects
 	}
-	return blbCodeFiles
+	secObj := multiSecObj.GetSectionObjects()[0]
+	codeFile := azicliwkscosp.CodeFile{
+		Kind:            file.Kind,
+		Path:            strings.TrimPrefix(path, wkdir),
+		Section:         secObj.GetNumberOfSection(),
+		Mode:            mode,
+		HasErrors:       secObj.GetError() != nil,
+		OType:           secObj.GetObjectType(),
+		OName:           secObj.GetObjectName(),
+		CodeID:          secObj.GetCodeID(),
+		CodeType:        secObj.GetCodeType(),
+		Language:        secObj.GetLanguage(),
+		LanguageVersion: secObj.GetLanguageVersion(),
+		LanguageType:    secObj.GetLanguageType(),
+	}
+	if codeFile.HasErrors {
+		codeFile.ErrorMessage = azerrors.ConvertToSystemError(secObj.GetError()).Message()
+	} else {
+		obj := secObj.GetObject()
+		codeFile.OID = obj.GetOID()
+		objects[obj.GetOID()] = obj.GetContent()
+	}
+	return []azicliwkscosp.CodeFile{codeFile}, objects
 }
 
-// blobifyPermSchemaFile blobify a permguard code file.
-func (m *WorkspaceManager) blobifylanguageFile(absLang azlang.LanguageAbastraction, path string, data []byte, file azicliwkscosp.CodeFile, wkdir string, mode uint32, blbCodeFiles []azicliwkscosp.CodeFile) []azicliwkscosp.CodeFile {
+// blobifyPermSchemaFile blobify a permguard schema file.
+func (m *WorkspaceManager) blobifyPermSchemaFile(schemaFileCount int, path string, wkdir string, mode uint32, blbCodeFiles []azicliwkscosp.CodeFile, absLang azlang.LanguageAbastraction, data []byte, file azicliwkscosp.CodeFile) []azicliwkscosp.CodeFile {
+	codeFiles, objects := buildPermSchemaCodeFiles(schemaFileCount, path, wkdir, mode, absLang, data, file)
+	for oid, content := range objects {
+		m.cospMgr.SaveCodeSourceObject(oid, content)
+	}
+	return append(blbCodeFiles, codeFiles...)
+}
+
+// buildLanguageCodeFiles builds the code files of a permguard code file along with the objects to be saved.
+func buildLanguageCodeFiles(absLang azlang.LanguageAbastraction, path string, data []byte, file azicliwkscosp.CodeFile, wkdir string, mode uint32) ([]azicliwkscosp.CodeFile, map[string][]byte) {
+	objects := map[string][]byte{}
 	multiSecObj, err := absLang.CreatePolicyBlobObjects(path, data)
 	if err != nil {
-		codeFile := &azicliwkscosp.CodeFile{
+		codeFile := azicliwkscosp.CodeFile{
 			Kind:         file.Kind,
 			Path:         strings.TrimPrefix(path, wkdir),
 			Section:      -1,
@@ -158,12 +166,12 @@ func (m *WorkspaceManager) blobifylanguageFile(absLang azlang.LanguageAbastracti
 			HasErrors:    true,
 			ErrorMessage: err.Error(),
 		}
-		blbCodeFiles = append(blbCodeFiles, *codeFile)
-		return blbCodeFiles
+		return []azicliwkscosp.CodeFile{codeFile}, objects
 	}
+	codeFiles := []azicliwkscosp.CodeFile{}
 	secObjs := multiSecObj.GetSectionObjects()
 	for _, secObj := range secObjs {
-		codeFile := &azicliwkscosp.CodeFile{
+		codeFile := azicliwkscosp.CodeFile{
 			Kind:            file.Kind,
 			Path:            strings.TrimPrefix(path, wkdir),
 			Section:         secObj.GetNumberOfSection(),
@@ -188,11 +196,20 @@ func (m *WorkspaceManager) blobifylanguageFile(absLang azlang.LanguageAbastracti
 		} else {
 			obj := secObj.GetObject()
 			codeFile.OID = obj.GetOID()
-			m.cospMgr.SaveCodeSourceObject(obj.GetOID(), obj.GetContent())
+			objects[obj.GetOID()] = obj.GetContent()
 		}
-		blbCodeFiles = append(blbCodeFiles, *codeFile)
+		codeFiles = append(codeFiles, codeFile)
+	}
+	return codeFiles, objects
+}
+
+// blobifylanguageFile blobify a permguard code file.
+func (m *WorkspaceManager) blobifylanguageFile(absLang azlang.LanguageAbastraction, path string, data []byte, file azicliwkscosp.CodeFile, wkdir string, mode uint32, blbCodeFiles []azicliwkscosp.CodeFile) []azicliwkscosp.CodeFile {
+	codeFiles, objects := buildLanguageCodeFiles(absLang, path, data, file, wkdir, mode)
+	for oid, content := range objects {
+		m.saveCodeSourceObject(oid, content)
 	}
-	return blbCodeFiles
+	return append(blbCodeFiles, codeFiles...)
 }
 
 // blobifyLocal scans source files and creates a blob for each object.
@@ -205,6 +222,10 @@ func (m *WorkspaceManager) blobifyLocal(codeFiles []azicliwkscosp.CodeFile, absL
 	}
 	schemaFileName := schemaFileNames[0]
 	schemaFileCount := 0
+	if m.blobCache != nil {
+		m.blobCache.begin()
+		defer m.blobCache.end()
+	}
 	for _, file := range codeFiles {
 		wkdir := m.ctx.GetWorkDir()
 		path := file.Path
@@ -213,7 +234,22 @@ func (m *WorkspaceManager) blobifyLocal(codeFiles []azicliwkscosp.CodeFile, absL
 			return "", nil, err
 		}
 		if file.Kind == azicliwkscosp.CodeFileTypeOfCodeType {
+			if m.blobCache != nil {
+				cachedCodeFiles, found, err := m.restoreCachedCodeFiles(path, mode, data)
+				if err != nil {
+					return "", nil, err
+				}
+				if found {
+					blbCodeFiles = append(blbCodeFiles, cachedCodeFiles...)
+					continue
+				}
+				m.blobCache.record(path, mode, data)
+			}
+			start := len(blbCodeFiles)
 			blbCodeFiles = m.blobifylanguageFile(absLang, path, data, file, wkdir, mode, blbCodeFiles)
+			if m.blobCache != nil {
+				m.blobCache.commit(blbCodeFiles[start:])
+			}
 		} else if file.Kind == azicliwkscosp.CodeFileOfSchemaType {
 			schemaFileCount++
 			blbCodeFiles = m.blobifyPermSchemaFile(schemaFileCount, path, wkdir, mode, blbCodeFiles, absLang, data, file)
@@ -301,7 +337,7 @@ func (m *WorkspaceManager) retrieveCodeMap() ([]azicliwkscosp.CodeFile, []azicli
 
 	for _, dupFile := range duplicateFiles {
 		dupFile.HasErrors = true
-		dupFile.ErrorMessage = "language: duplicate object name found in the code files. please ensure that there are no duplicate object names"
+		dupFile.ErrorMessage = errMessageDuplicateObjectName
 		invalidFiles = append(invalidFiles, dupFile)
 	}
 
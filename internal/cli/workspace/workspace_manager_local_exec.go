@@ -77,7 +77,7 @@ func (m *WorkspaceManager) ExecRefresh(out aziclicommon.PrinterOutFunc) (map[str
 		out(nil, "", "Failed to refresh the current workspace.", nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 	if !m.isWorkspaceDir() {
 		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
 	}
@@ -88,7 +88,8 @@ func (m *WorkspaceManager) ExecRefresh(out aziclicommon.PrinterOutFunc) (map[str
 	}
 	defer fileLock.Unlock()
 
-	return m.execInternalRefresh(false, out)
+	output, err := m.execInternalRefresh(false, out)
+	return mergeContextOutput(output, contextOutput), err
 }
 
 // execInternalRefresh scans source files in the current directory and synchronizes the local state,
@@ -120,14 +121,8 @@ func (m *WorkspaceManager) execInternalRefresh(internal bool, out aziclicommon.P
 		return failedOpErr(nil, err)
 	}
 
-	// TODO: Read the language from the authz-model manifest
-	// Creates the abstraction for the language
-	// lang, err := m.cfgMgr.GetLanguage()
-	// if err != nil {
-	// 	return failedOpErr(nil, err)
-	// }
-	lang := "cedar"
-	absLang, err := m.langFct.GetLanguageAbastraction(lang)
+	// Creates the abstraction for the language of the authz-model manifest
+	absLang, err := m.getLanguageAbstraction()
 	if err != nil {
 		return failedOpErr(nil, err)
 	}
@@ -194,7 +189,7 @@ func (m *WorkspaceManager) ExecValidate(out aziclicommon.PrinterOutFunc) (map[st
 		out(nil, "", "Failed to validate the current workspace.", nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 	if !m.isWorkspaceDir() {
 		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
 	}
@@ -205,7 +200,8 @@ func (m *WorkspaceManager) ExecValidate(out aziclicommon.PrinterOutFunc) (map[st
 	}
 	defer fileLock.Unlock()
 
-	return m.execInternalValidate(false, out)
+	output, err := m.execInternalValidate(false, out)
+	return mergeContextOutput(output, contextOutput), err
 }
 
 // execInternalValidate validates the local state.
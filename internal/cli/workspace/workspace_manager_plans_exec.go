@@ -25,13 +25,13 @@ import (
 	azicliwkslogs "github.com/permguard/permguard/internal/cli/workspace/logs"
 )
 
-// ExecPlan generates a plan of changes to apply to the remote ledger based on the differences between the local and remote states.
-func (m *WorkspaceManager) ExecPlan(out aziclicommon.PrinterOutFunc) (map[string]any, error) {
+// ExecPlan generates a plan of changes to apply to the remote ledger based on the differences between the local and remote states, the lint is executed as a gate and the impact of the changes is analysed if requested.
+func (m *WorkspaceManager) ExecPlan(lint bool, impactFile string, out aziclicommon.PrinterOutFunc) (map[string]any, error) {
 	failedOpErr := func(output map[string]any, err error) (map[string]any, error) {
 		out(nil, "", "Failed to build the plan.", nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 	if !m.isWorkspaceDir() {
 		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
 	}
@@ -42,11 +42,12 @@ func (m *WorkspaceManager) ExecPlan(out aziclicommon.PrinterOutFunc) (map[string
 	}
 	defer fileLock.Unlock()
 
-	return m.execInternalPlan(false, out)
+	output, err := m.execInternalPlan(false, lint, impactFile, out)
+	return mergeContextOutput(output, contextOutput), err
 }
 
 // execInternalPlan generates a plan of changes to apply to the remote ledger based on the differences between the local and remote states.
-func (m *WorkspaceManager) execInternalPlan(internal bool, out aziclicommon.PrinterOutFunc) (map[string]any, error) {
+func (m *WorkspaceManager) execInternalPlan(internal bool, lint bool, impactFile string, out aziclicommon.PrinterOutFunc) (map[string]any, error) {
 	failedOpErr := func(output map[string]any, err error) (map[string]any, error) {
 		if !internal {
 			out(nil, "", "Failed to build the plan.", nil, true)
@@ -67,6 +68,15 @@ func (m *WorkspaceManager) execInternalPlan(internal bool, out aziclicommon.Prin
 		return out(output, "", fmt.Sprintf("Please execute '%s' to perform a comprehensive validation check for any potential errors.", aziclicommon.CliCommandText("permguard validate")), nil, true), err
 	}
 
+	// Executes the lint gate for the current head
+	if lint {
+		output, err = m.execLintValidatedWorkspace(true, "", output, out)
+		if err != nil {
+			output, err := failedOpErr(output, err)
+			return out(output, "", fmt.Sprintf("Please execute '%s' to review the lint findings.", aziclicommon.CliCommandText("permguard lint")), nil, true), err
+		}
+	}
+
 	if m.ctx.IsVerboseTerminalOutput() {
 		out(nil, "plan", fmt.Sprintf("Head successfully set to %s.", aziclicommon.KeywordText(headCtx.GetRef())), nil, true)
 		out(nil, "plan", fmt.Sprintf("Ledger set to %s.", aziclicommon.KeywordText(headCtx.GetLedgerURI())), nil, true)
@@ -181,6 +191,21 @@ func (m *WorkspaceManager) execInternalPlan(internal bool, out aziclicommon.Prin
 		if m.ctx.IsVerboseTerminalOutput() {
 			out(nil, "plan", "Plan saved successfully.", nil, true)
 		}
+		if impactFile != "" {
+			total, impactChanges, err := m.analyseImpact(impactFile, localCodeState, remoteCodeState)
+			if err != nil {
+				out(nil, "", "Unable to analyse the impact of the plan.", nil, true)
+				return failedOpErr(output, err)
+			}
+			if m.ctx.IsTerminalOutput() {
+				m.printImpact(total, impactChanges, out)
+			} else if m.ctx.IsJSONOutput() {
+				output["impact"] = map[string]any{
+					"requests": total,
+					"changes":  impactChanges,
+				}
+			}
+		}
 		if !internal {
 			out(nil, "", "Run the 'apply' command to apply the changes.", nil, true)
 		}
@@ -202,7 +227,7 @@ func (m *WorkspaceManager) ExecApply(out aziclicommon.PrinterOutFunc) (map[strin
 		out(nil, "", "Failed to apply the plan.", nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 	if !m.isWorkspaceDir() {
 		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
 	}
@@ -213,7 +238,8 @@ func (m *WorkspaceManager) ExecApply(out aziclicommon.PrinterOutFunc) (map[strin
 	}
 	defer fileLock.Unlock()
 
-	return m.execInternalApply(false, out)
+	output, err := m.execInternalApply(false, out)
+	return mergeContextOutput(output, contextOutput), err
 }
 
 // execInternalApply applies the plan to the remote ledger
@@ -232,19 +258,13 @@ func (m *WorkspaceManager) execInternalApply(internal bool, out aziclicommon.Pri
 	}
 
 	// Executes the plan for the current head
-	output, err := m.execInternalPlan(true, out)
+	output, err := m.execInternalPlan(true, false, "", out)
 	if err != nil {
 		return failedOpErr(nil, err)
 	}
 
-	// TODO: Read the language from the authz-model manifest
-	// Creates the abstraction for the language
-	// lang, err := m.cfgMgr.GetLanguage()
-	// if err != nil {
-	// 	return failedOpErr(nil, err)
-	// }
-	lang := "cedar"
-	absLang, err := m.langFct.GetLanguageAbastraction(lang)
+	// Creates the abstraction for the language of the authz-model manifest
+	absLang, err := m.getLanguageAbstraction()
 	if err != nil {
 		return failedOpErr(nil, err)
 	}
@@ -338,6 +358,15 @@ func (m *WorkspaceManager) execInternalApply(internal bool, out aziclicommon.Pri
 		return failedOpErr(nil, err)
 	}
 
+	pulledHeadCtx, err := m.getCurrentHeadContext()
+	if err != nil {
+		return failedOpErr(nil, err)
+	}
+	if pulledHeadCtx.remoteCommitID != commitObj.GetOID() {
+		out(nil, "", fmt.Sprintf("The remote ledger is protected, the commit %s has been submitted as a change request pending approval.", aziclicommon.IDText(commitObj.GetOID())), nil, true)
+		return output, nil
+	}
+
 	out(nil, "", "Apply process completed successfully.", nil, true)
 	if !internal {
 		out(nil, "", fmt.Sprintf("Your workspace is synchronized with the remote ledger: %s.", aziclicommon.KeywordText(headCtx.GetLedgerURI())), nil, true)
@@ -135,7 +135,7 @@ func (m *WorkspaceManager) ExecCheckoutLedger(ledgerURI string, out aziclicommon
 		out(nil, "", fmt.Sprintf("Failed to checkout the ledger %s.", aziclicommon.KeywordText(ledgerURI)), nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 	if !m.isWorkspaceDir() {
 		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
 	}
@@ -146,7 +146,8 @@ func (m *WorkspaceManager) ExecCheckoutLedger(ledgerURI string, out aziclicommon
 	}
 	defer fileLock.Unlock()
 
-	return m.execInternalCheckoutLedger(false, ledgerURI, out)
+	output, err := m.execInternalCheckoutLedger(false, ledgerURI, out)
+	return mergeContextOutput(output, contextOutput), err
 }
 
 // execInternalPull executes an internal pull.
@@ -160,14 +161,8 @@ func (m *WorkspaceManager) execInternalPull(internal bool, out aziclicommon.Prin
 
 	m.execInternalRefresh(true, out)
 
-	// TODO: Read the language from the authz-model manifest
-	// Creates the abstraction for the language
-	// lang, err := m.cfgMgr.GetLanguage()
-	// if err != nil {
-	// 	return failedOpErr(nil, err)
-	// }
-	lang := "cedar"
-	absLang, err := m.langFct.GetLanguageAbastraction(lang)
+	// Creates the abstraction for the language of the authz-model manifest
+	absLang, err := m.getLanguageAbstraction()
 	if err != nil {
 		return failedOpErr(nil, err)
 	}
@@ -378,7 +373,7 @@ func (m *WorkspaceManager) ExecPull(out aziclicommon.PrinterOutFunc) (map[string
 		out(nil, "", "Failed to pull changes from the remote ledger.", nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 	if !m.isWorkspaceDir() {
 		return failedOpErr(nil, m.raiseWrongWorkspaceDirError(out))
 	}
@@ -389,7 +384,8 @@ func (m *WorkspaceManager) ExecPull(out aziclicommon.PrinterOutFunc) (map[string
 	}
 	defer fileLock.Unlock()
 
-	return m.execInternalPull(false, out)
+	output, err := m.execInternalPull(false, out)
+	return mergeContextOutput(output, contextOutput), err
 }
 
 // ExecCloneLedger clones a ledger.
@@ -398,7 +394,7 @@ func (m *WorkspaceManager) ExecCloneLedger(ledgerURI string, zapPort, papPort in
 		out(nil, "", fmt.Sprintf("Failed to clone the ledger %s.", aziclicommon.KeywordText(ledgerURI)), nil, true)
 		return output, err
 	}
-	m.ExecPrintContext(nil, out)
+	contextOutput := m.ExecPrintContext(nil, out)
 
 	var output map[string]any
 	ledgerURI = strings.ToLower(ledgerURI)
@@ -437,5 +433,5 @@ func (m *WorkspaceManager) ExecCloneLedger(ledgerURI string, zapPort, papPort in
 	if aborted {
 		return failedOpErr(output, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput, "operation has been aborted"))
 	}
-	return output, nil
+	return mergeContextOutput(output, contextOutput), nil
 }
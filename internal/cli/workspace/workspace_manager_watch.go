@@ -0,0 +1,296 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azicliwkscosp "github.com/permguard/permguard/internal/cli/workspace/cosp"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azfiles "github.com/permguard/permguard/pkg/core/files"
+)
+
+const (
+	// watchDebounce is the time waited after the last change before running again.
+	watchDebounce = 300 * time.Millisecond
+)
+
+// blobCacheEntry represents the blobification of a code file.
+type blobCacheEntry struct {
+	digest    string
+	codeFiles []azicliwkscosp.CodeFile
+	objects   map[string][]byte
+}
+
+// blobCache caches the blobification of the code files so that only the changed files are blobified again.
+type blobCache struct {
+	entries   map[string]*blobCacheEntry
+	seen      map[string]bool
+	recording *blobCacheEntry
+	blobified int
+	reused    int
+}
+
+// newBlobCache creates a new blob cache.
+func newBlobCache() *blobCache {
+	return &blobCache{entries: map[string]*blobCacheEntry{}}
+}
+
+// blobCacheDigest returns the digest of the content and of the mode of a code file.
+func blobCacheDigest(mode uint32, data []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(fmt.Sprintf("%d:", mode)))
+	hash.Write(data)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// begin starts a new blobification.
+func (c *blobCache) begin() {
+	c.seen = map[string]bool{}
+	c.recording = nil
+	c.blobified = 0
+	c.reused = 0
+}
+
+// end completes the blobification removing the entries of the files which no longer exist.
+func (c *blobCache) end() {
+	for path := range c.entries {
+		if !c.seen[path] {
+			delete(c.entries, path)
+		}
+	}
+	c.recording = nil
+}
+
+// lookup returns the cached entry of the code file if its content has not changed.
+func (c *blobCache) lookup(path string, mode uint32, data []byte) (*blobCacheEntry, bool) {
+	c.seen[path] = true
+	entry, ok := c.entries[path]
+	if !ok || entry.digest != blobCacheDigest(mode, data) {
+		return nil, false
+	}
+	c.reused++
+	return entry, true
+}
+
+// record starts recording the blobification of the code file.
+func (c *blobCache) record(path string, mode uint32, data []byte) {
+	c.seen[path] = true
+	c.blobified++
+	c.recording = &blobCacheEntry{digest: blobCacheDigest(mode, data), objects: map[string][]byte{}}
+	c.entries[path] = c.recording
+}
+
+// commit completes the recording of the blobification of the code file.
+func (c *blobCache) commit(codeFiles []azicliwkscosp.CodeFile) {
+	if c.recording == nil {
+		return
+	}
+	c.recording.codeFiles = append([]azicliwkscosp.CodeFile{}, codeFiles...)
+	c.recording = nil
+}
+
+// saveCodeSourceObject saves a code source object recording it in the blob cache if enabled.
+func (m *WorkspaceManager) saveCodeSourceObject(oid string, content []byte) {
+	if m.blobCache != nil && m.blobCache.recording != nil {
+		m.blobCache.recording.objects[oid] = content
+	}
+	m.cospMgr.SaveCodeSourceObject(oid, content)
+}
+
+// restoreCachedCodeFiles restores the objects of a code file which has not changed since its last blobification.
+func (m *WorkspaceManager) restoreCachedCodeFiles(path string, mode uint32, data []byte) ([]azicliwkscosp.CodeFile, bool, error) {
+	entry, found := m.blobCache.lookup(path, mode, data)
+	if !found {
+		return nil, false, nil
+	}
+	for oid, content := range entry.objects {
+		if _, err := m.cospMgr.SaveCodeSourceObject(oid, content); err != nil {
+			return nil, false, err
+		}
+	}
+	return entry.codeFiles, true, nil
+}
+
+// GetBlobificationStats returns the number of code files blobified and reused by the last refresh while watching the workspace.
+func (m *WorkspaceManager) GetBlobificationStats() (int, int) {
+	if m.blobCache == nil {
+		return 0, 0
+	}
+	return m.blobCache.blobified, m.blobCache.reused
+}
+
+// watchFilter selects the file system events triggering a new run.
+type watchFilter struct {
+	root           string
+	exts           []string
+	schemaNames    []string
+	ignorePatterns []string
+}
+
+// newWatchFilter creates a new watch filter using the supported extensions of the language and the ignore file.
+func newWatchFilter(root string, langSpec azlang.LanguageSpecification) *watchFilter {
+	filter := &watchFilter{
+		root:        root,
+		exts:        langSpec.GetSupportedPolicyFileExtensions(),
+		schemaNames: langSpec.GetSupportedSchemaFileNames(),
+	}
+	filter.loadIgnorePatterns()
+	return filter
+}
+
+// loadIgnorePatterns loads the ignore patterns from the ignore file.
+func (f *watchFilter) loadIgnorePatterns() {
+	f.ignorePatterns = []string{hiddenIgnoreFile, hiddenDir, gitDir, gitIgnoreFile}
+	if patterns, err := azfiles.ReadIgnoreFile(filepath.Join(f.root, hiddenIgnoreFile)); err == nil {
+		f.ignorePatterns = append(f.ignorePatterns, patterns...)
+	}
+}
+
+// isIgnored returns true if the path is excluded from the workspace.
+func (f *watchFilter) isIgnored(path string) bool {
+	return azfiles.IsIgnoredPath(path, f.root, f.ignorePatterns)
+}
+
+// isRelevant returns true if a change of the path requires a new run.
+func (f *watchFilter) isRelevant(path string) bool {
+	name := filepath.Base(path)
+	if name == hiddenIgnoreFile && filepath.Dir(path) == f.root {
+		f.loadIgnorePatterns()
+		return true
+	}
+	for _, schemaName := range f.schemaNames {
+		if name == schemaName && filepath.Dir(path) == f.root {
+			return true
+		}
+	}
+	if f.isIgnored(path) {
+		return false
+	}
+	for _, ext := range f.exts {
+		if strings.HasSuffix(strings.ToLower(name), strings.ToLower(ext)) {
+			return true
+		}
+	}
+	return false
+}
+
+// addWatchDirs adds the directory and its subdirectories which are not ignored to the watcher.
+func addWatchDirs(watcher *fsnotify.Watcher, filter *watchFilter, dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if path != filter.root && filter.isIgnored(path) {
+			return filepath.SkipDir
+		}
+		return watcher.Add(path)
+	})
+}
+
+// ExecWatch runs the function and runs it again with the changed files each time a source file of the workspace changes, until the stop channel is closed.
+func (m *WorkspaceManager) ExecWatch(stop <-chan struct{}, run func(changedFiles []string), out aziclicommon.PrinterOutFunc) error {
+	if !m.isWorkspaceDir() {
+		return m.raiseWrongWorkspaceDirError(out)
+	}
+	if err := m.hasValidManifestWorkspaceDir(); err != nil {
+		return err
+	}
+	absLang, err := m.getLanguageAbstraction()
+	if err != nil {
+		return err
+	}
+	root, err := filepath.Abs(m.ctx.GetWorkDir())
+	if err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, "the workspace directory cannot be resolved", err)
+	}
+	filter := newWatchFilter(root, absLang.GetLanguageSpecification())
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, "the workspace cannot be watched", err)
+	}
+	defer watcher.Close()
+	if err := addWatchDirs(watcher, filter, root); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, "the workspace cannot be watched", err)
+	}
+
+	m.blobCache = newBlobCache()
+	defer func() { m.blobCache = nil }()
+	run(nil)
+
+	changedFiles := map[string]bool{}
+	timer := time.NewTimer(watchDebounce)
+	timer.Stop()
+	defer timer.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() && !filter.isIgnored(event.Name) {
+					if err := addWatchDirs(watcher, filter, event.Name); err != nil {
+						return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, "the workspace cannot be watched", err)
+					}
+					continue
+				}
+			}
+			if event.Has(fsnotify.Chmod) || !filter.isRelevant(event.Name) {
+				continue
+			}
+			relativePath, err := filepath.Rel(root, event.Name)
+			if err != nil {
+				relativePath = event.Name
+			}
+			changedFiles[relativePath] = true
+			timer.Reset(watchDebounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliFileOperation, "the workspace cannot be watched", err)
+		case <-timer.C:
+			files := make([]string, 0, len(changedFiles))
+			for file := range changedFiles {
+				files = append(files, file)
+			}
+			sort.Strings(files)
+			changedFiles = map[string]bool{}
+			run(files)
+		}
+	}
+}
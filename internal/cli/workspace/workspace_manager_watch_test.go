@@ -0,0 +1,143 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azicliwkscosp "github.com/permguard/permguard/internal/cli/workspace/cosp"
+)
+
+// testLanguageSpecification is the language specification used by the watch tests.
+type testLanguageSpecification struct{}
+
+func (s testLanguageSpecification) GetLanguage() string           { return "cedar" }
+func (s testLanguageSpecification) GetLanguageVersion() string    { return "0.0" }
+func (s testLanguageSpecification) GetLanguageVersionID() uint32  { return 0 }
+func (s testLanguageSpecification) GetFrontendLanguage() string   { return "cedar" }
+func (s testLanguageSpecification) GetFrontendLanguageID() uint32 { return 1 }
+func (s testLanguageSpecification) GetBackendLanguage() string    { return "cedar" }
+func (s testLanguageSpecification) GetBackendLanguageID() uint32  { return 1 }
+func (s testLanguageSpecification) GetSupportedSchemaFileNames() []string {
+	return []string{"schema.json"}
+}
+func (s testLanguageSpecification) GetSupportedPolicyFileExtensions() []string {
+	return []string{".cedar"}
+}
+
+// TestBlobCacheReuse tests that the blobification of an unchanged code file is reused.
+func TestBlobCacheReuse(t *testing.T) {
+	assert := assert.New(t)
+	cache := newBlobCache()
+	data := []byte(`permit(principal, action, resource);`)
+	codeFiles := []azicliwkscosp.CodeFile{{Path: "platform/policies.cedar", OID: "515513cd9200cfe899da7ac17a2293ed23a35674b933010d9736e634d3def5fe"}}
+
+	cache.begin()
+	entry, found := cache.lookup("platform/policies.cedar", 0o644, data)
+	assert.False(found, "the first lookup should miss")
+	assert.Nil(entry)
+	cache.record("platform/policies.cedar", 0o644, data)
+	cache.recording.objects[codeFiles[0].OID] = data
+	cache.commit(codeFiles)
+	cache.end()
+	assert.Equal(1, cache.blobified)
+	assert.Equal(0, cache.reused)
+
+	cache.begin()
+	entry, found = cache.lookup("platform/policies.cedar", 0o644, data)
+	cache.end()
+	assert.True(found, "the unchanged code file should be reused")
+	assert.Equal(codeFiles, entry.codeFiles)
+	assert.Equal(map[string][]byte{codeFiles[0].OID: data}, entry.objects)
+	assert.Equal(0, cache.blobified)
+	assert.Equal(1, cache.reused)
+}
+
+// TestBlobCacheInvalidation tests that a change of the content or of the mode invalidates the cached blobification.
+func TestBlobCacheInvalidation(t *testing.T) {
+	assert := assert.New(t)
+	cache := newBlobCache()
+	data := []byte(`permit(principal, action, resource);`)
+
+	cache.begin()
+	cache.record("platform/policies.cedar", 0o644, data)
+	cache.commit(nil)
+	cache.end()
+
+	cache.begin()
+	_, found := cache.lookup("platform/policies.cedar", 0o644, []byte(`forbid(principal, action, resource);`))
+	assert.False(found, "a change of the content should invalidate the entry")
+	_, found = cache.lookup("platform/policies.cedar", 0o755, data)
+	assert.False(found, "a change of the mode should invalidate the entry")
+	_, found = cache.lookup("platform/policies.cedar", 0o644, data)
+	assert.True(found, "the unchanged code file should be reused")
+	cache.end()
+
+	cache.begin()
+	cache.end()
+	assert.Empty(cache.entries, "the entries of the removed code files should be dropped")
+}
+
+// createWorkspaceFiles creates the empty files in the workspace, as the ignore patterns are matched against the existing paths.
+func createWorkspaceFiles(t *testing.T, root string, paths ...string) {
+	for _, path := range paths {
+		assert.Nil(t, os.MkdirAll(filepath.Join(root, filepath.Dir(path)), 0o755))
+		assert.Nil(t, os.WriteFile(filepath.Join(root, path), nil, 0o644))
+	}
+}
+
+// TestWatchFilter tests the selection of the file system events triggering a new run.
+func TestWatchFilter(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	createWorkspaceFiles(t, root, filepath.Join(hiddenDir, "policies.cedar"), filepath.Join(gitDir, "policies.cedar"))
+	filter := newWatchFilter(root, testLanguageSpecification{})
+
+	assert.True(filter.isRelevant(filepath.Join(root, "policies.cedar")))
+	assert.True(filter.isRelevant(filepath.Join(root, "platform", "Policies.CEDAR")))
+	assert.True(filter.isRelevant(filepath.Join(root, "schema.json")))
+	assert.False(filter.isRelevant(filepath.Join(root, "platform", "schema.json")), "the schema is only read from the workspace root")
+	assert.False(filter.isRelevant(filepath.Join(root, "README.md")))
+	assert.False(filter.isRelevant(filepath.Join(root, hiddenDir, "policies.cedar")))
+	assert.False(filter.isRelevant(filepath.Join(root, gitDir, "policies.cedar")))
+	assert.True(filter.isIgnored(filepath.Join(root, hiddenDir)))
+	assert.False(filter.isIgnored(filepath.Join(root, "platform")))
+}
+
+// TestWatchFilterIgnoreFileReload tests that a change of the ignore file reloads the ignore patterns.
+func TestWatchFilterIgnoreFileReload(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	ignoreFile := filepath.Join(root, hiddenIgnoreFile)
+	draft := filepath.Join(root, "drafts", "policies.cedar")
+	createWorkspaceFiles(t, root, filepath.Join("drafts", "policies.cedar"))
+	filter := newWatchFilter(root, testLanguageSpecification{})
+	assert.True(filter.isRelevant(draft))
+
+	assert.Nil(os.WriteFile(ignoreFile, []byte("# drafts are not pushed\ndrafts\n"), 0o644))
+	assert.True(filter.isRelevant(ignoreFile), "a change of the ignore file should trigger a new run")
+	assert.False(filter.isRelevant(draft), "the ignored paths should not trigger a new run")
+	assert.False(filter.isRelevant(filepath.Join(root, "platform", hiddenIgnoreFile)), "only the ignore file of the workspace root is loaded")
+
+	assert.Nil(os.Remove(ignoreFile))
+	assert.True(filter.isRelevant(ignoreFile), "the removal of the ignore file should trigger a new run")
+	assert.True(filter.isRelevant(draft), "the patterns of the removed ignore file should be dropped")
+}
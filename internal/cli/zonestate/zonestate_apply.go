@@ -0,0 +1,130 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zonestate
+
+import (
+	"fmt"
+	"strconv"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azclients "github.com/permguard/permguard/pkg/transport/clients"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// ApplyPlan applies the changes of the plan in order and returns the applied changes, it stops at the first failed change.
+func ApplyPlan(zapClient azclients.GrpcZAPClient, papClient azclients.GrpcPAPClient, plan *Plan) ([]Change, error) {
+	if zapClient == nil || papClient == nil || plan == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "cli: invalid zone plan or clients")
+	}
+	applied := []Change{}
+	sourceIDs := map[string]string{}
+	for _, change := range plan.Changes {
+		var err error
+		change, err = applyChange(zapClient, papClient, plan, sourceIDs, change)
+		if err != nil {
+			return applied, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliOperation,
+				fmt.Sprintf("cli: failed to %s the %s %s", change.Action, change.Resource, change.Name), err)
+		}
+		applied = append(applied, change)
+	}
+	return applied, nil
+}
+
+// applyChange applies a single change and returns it with the identifiers assigned by the server.
+func applyChange(zapClient azclients.GrpcZAPClient, papClient azclients.GrpcPAPClient, plan *Plan, sourceIDs map[string]string, change Change) (Change, error) {
+	zoneID := plan.ZoneID
+	switch change.Resource + ":" + change.Action {
+	case ResourceZone + ":" + ActionCreate:
+		zone, err := zapClient.CreateZone(change.Name)
+		if err != nil {
+			return change, err
+		}
+		plan.ZoneID = zone.ZoneID
+		change.ID = strconv.FormatInt(zone.ZoneID, 10)
+	case ResourceZone + ":" + ActionUpdate:
+		if _, err := zapClient.UpdateZone(&azmodelzap.Zone{ZoneID: zoneID, Name: change.Name}); err != nil {
+			return change, err
+		}
+	case ResourceIdentitySource + ":" + ActionCreate:
+		source, err := zapClient.CreateIdentitySource(zoneID, change.Name)
+		if err != nil {
+			return change, err
+		}
+		sourceIDs[change.Name] = source.IdentitySourceID
+		change.ID = source.IdentitySourceID
+	case ResourceIdentitySource + ":" + ActionDelete:
+		if _, err := zapClient.DeleteIdentitySource(zoneID, change.ID); err != nil {
+			return change, err
+		}
+	case ResourceIdentity + ":" + ActionCreate:
+		if change.ParentID == "" {
+			change.ParentID = sourceIDs[change.Parent]
+		}
+		identity, err := zapClient.CreateIdentity(zoneID, change.ParentID, change.Kind, change.Name)
+		if err != nil {
+			return change, err
+		}
+		change.ID = identity.IdentityID
+	case ResourceIdentity + ":" + ActionUpdate:
+		identity := &azmodelzap.Identity{
+			IdentityID:       change.ID,
+			ZoneID:           zoneID,
+			IdentitySourceID: change.ParentID,
+			Kind:             change.Kind,
+			Name:             change.Name,
+		}
+		if _, err := zapClient.UpdateIdentity(identity); err != nil {
+			return change, err
+		}
+	case ResourceIdentity + ":" + ActionDelete:
+		if _, err := zapClient.DeleteIdentity(zoneID, change.ID); err != nil {
+			return change, err
+		}
+	case ResourceTenant + ":" + ActionCreate:
+		tenant, err := zapClient.CreateTenant(zoneID, change.Name)
+		if err != nil {
+			return change, err
+		}
+		change.ID = tenant.TenantID
+	case ResourceTenant + ":" + ActionDelete:
+		if _, err := zapClient.DeleteTenant(zoneID, change.ID); err != nil {
+			return change, err
+		}
+	case ResourceLedger + ":" + ActionCreate:
+		ledger, err := papClient.CreateLedger(zoneID, change.Kind, change.Name)
+		if err != nil {
+			return change, err
+		}
+		change.ID = ledger.LedgerID
+		if change.RequiredApprovals > 0 {
+			if _, err := papClient.UpdateLedgerProtection(zoneID, change.ID, change.RequiredApprovals); err != nil {
+				return change, err
+			}
+		}
+	case ResourceLedger + ":" + ActionUpdate:
+		if _, err := papClient.UpdateLedgerProtection(zoneID, change.ID, change.RequiredApprovals); err != nil {
+			return change, err
+		}
+	case ResourceLedger + ":" + ActionDelete:
+		if _, err := papClient.DeleteLedger(zoneID, change.ID); err != nil {
+			return change, err
+		}
+	default:
+		return change, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("cli: unsupported change %s of %s", change.Action, change.Resource))
+	}
+	return change, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zonestate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	azmocks "github.com/permguard/permguard/internal/cli/porcelaincommands/testutils/mocks"
+	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// TestApplyPlanForNewZone tests applying the plan of a zone that does not exist yet.
+func TestApplyPlanForNewZone(t *testing.T) {
+	assert := assert.New(t)
+	zoneID := int64(273165098782)
+	zapClient := azmocks.NewGrpcZAPClientMock()
+	papClient := azmocks.NewGrpcPAPClientMock()
+	zapClient.On("CreateZone", "magicfarmacia").Return(&azmodelzap.Zone{ZoneID: zoneID, Name: "magicfarmacia"}, nil)
+	zapClient.On("CreateIdentitySource", zoneID, "keycloak").Return(&azmodelzap.IdentitySource{IdentitySourceID: "s1", Name: "keycloak"}, nil)
+	zapClient.On("CreateIdentity", zoneID, "s1", mock.Anything, mock.Anything).Return(&azmodelzap.Identity{IdentityID: "i1"}, nil)
+	zapClient.On("CreateTenant", zoneID, mock.Anything).Return(&azmodelzap.Tenant{TenantID: "t1"}, nil)
+	papClient.On("CreateLedger", zoneID, "policy", "magicfarmacia").Return(&azmodelpap.Ledger{LedgerID: "l1"}, nil)
+	papClient.On("UpdateLedgerProtection", zoneID, "l1", int32(2)).Return(&azmodelpap.Ledger{LedgerID: "l1", RequiredApprovals: 2}, nil)
+
+	plan, err := BuildPlan(testManifest(), &ZoneState{}, false)
+	assert.Nil(err)
+	applied, err := ApplyPlan(zapClient, papClient, plan)
+	assert.Nil(err)
+	assert.Len(applied, len(plan.Changes))
+	assert.Equal(zoneID, plan.ZoneID)
+	assert.Equal("273165098782", applied[0].ID)
+	assert.Equal("s1", applied[2].ParentID)
+	zapClient.AssertNumberOfCalls(t, "CreateIdentity", 2)
+	zapClient.AssertNumberOfCalls(t, "CreateTenant", 2)
+	papClient.AssertCalled(t, "UpdateLedgerProtection", zoneID, "l1", int32(2))
+}
+
+// TestApplyPlanForExistingZone tests applying updates and deletes to an existing zone.
+func TestApplyPlanForExistingZone(t *testing.T) {
+	assert := assert.New(t)
+	zoneID := int64(273165098782)
+	zapClient := azmocks.NewGrpcZAPClientMock()
+	papClient := azmocks.NewGrpcPAPClientMock()
+	zapClient.On("UpdateIdentity", mock.Anything).Return(&azmodelzap.Identity{IdentityID: "i2"}, nil)
+	zapClient.On("CreateTenant", zoneID, "pisa-branch").Return(&azmodelzap.Tenant{TenantID: "t3"}, nil)
+	papClient.On("UpdateLedgerProtection", zoneID, "l1", int32(2)).Return(&azmodelpap.Ledger{LedgerID: "l1"}, nil)
+	zapClient.On("DeleteIdentity", zoneID, "i3").Return(&azmodelzap.Identity{IdentityID: "i3"}, nil)
+	zapClient.On("DeleteIdentitySource", zoneID, "s2").Return(&azmodelzap.IdentitySource{IdentitySourceID: "s2"}, nil)
+	zapClient.On("DeleteTenant", zoneID, "t2").Return(nil, errors.New("rpc error: code = Unavailable"))
+
+	plan, err := BuildPlan(testManifest(), testState(), true)
+	assert.Nil(err)
+	applied, err := ApplyPlan(zapClient, papClient, plan)
+	assert.NotNil(err)
+	assert.Len(applied, 5)
+	updated := zapClient.Calls[0].Arguments.Get(0).(*azmodelzap.Identity)
+	assert.Equal("role-actor", updated.Kind)
+	assert.Equal("s1", updated.IdentitySourceID)
+	papClient.AssertNotCalled(t, "DeleteLedger", zoneID, "l2")
+}
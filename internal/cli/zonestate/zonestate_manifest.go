@@ -0,0 +1,202 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zonestate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azfiles "github.com/permguard/permguard/pkg/core/files"
+	azvalidators "github.com/permguard/permguard/pkg/core/validators"
+)
+
+const (
+	// DefaultIdentityKind is the kind assigned to the identities without an explicit kind.
+	DefaultIdentityKind = "user"
+	// DefaultLedgerKind is the kind assigned to the ledgers without an explicit kind.
+	DefaultLedgerKind = "policy"
+)
+
+// ZoneManifest is the desired state of a zone.
+type ZoneManifest struct {
+	Zone            ZoneSpec             `yaml:"zone" toml:"zone"`
+	Tenants         []TenantSpec         `yaml:"tenants" toml:"tenants"`
+	IdentitySources []IdentitySourceSpec `yaml:"identity_sources" toml:"identity_sources"`
+	Ledgers         []LedgerSpec         `yaml:"ledgers" toml:"ledgers"`
+}
+
+// ZoneSpec is the desired state of the zone itself.
+type ZoneSpec struct {
+	ID   int64  `yaml:"id" toml:"id"`
+	Name string `yaml:"name" toml:"name"`
+}
+
+// TenantSpec is the desired state of a tenant.
+type TenantSpec struct {
+	Name string `yaml:"name" toml:"name"`
+}
+
+// IdentitySourceSpec is the desired state of an identity source and of its identities.
+type IdentitySourceSpec struct {
+	Name       string         `yaml:"name" toml:"name"`
+	Identities []IdentitySpec `yaml:"identities" toml:"identities"`
+}
+
+// IdentitySpec is the desired state of an identity.
+type IdentitySpec struct {
+	Name string `yaml:"name" toml:"name"`
+	Kind string `yaml:"kind" toml:"kind"`
+}
+
+// LedgerSpec is the desired state of a ledger, omitted required approvals leave the protection of an existing ledger unchanged.
+type LedgerSpec struct {
+	Name              string `yaml:"name" toml:"name"`
+	Kind              string `yaml:"kind" toml:"kind"`
+	RequiredApprovals *int32 `yaml:"required_approvals" toml:"required_approvals"`
+}
+
+// GetRequiredApprovals returns the required approvals of the ledger, zero when they are omitted.
+func (l *LedgerSpec) GetRequiredApprovals() int32 {
+	if l.RequiredApprovals == nil {
+		return 0
+	}
+	return *l.RequiredApprovals
+}
+
+// ReadManifest reads a zone manifest from a yaml or toml file.
+func ReadManifest(file string) (*ZoneManifest, error) {
+	if file == "" {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "cli: the file is required")
+	}
+	manifest := &ZoneManifest{}
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliFileSystem, fmt.Sprintf("cli: failed to read the file %s", file))
+		}
+		if err := yaml.Unmarshal(data, manifest); err != nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput, fmt.Sprintf("cli: the file %s is not a valid yaml document: %s", file, err))
+		}
+	case ".toml":
+		if err := azfiles.ReadTOMLFile(file, manifest); err != nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput, fmt.Sprintf("cli: the file %s is not a valid toml document", file))
+		}
+	default:
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("cli: invalid file format %q, use a yaml or toml file", filepath.Ext(file)))
+	}
+	if err := manifest.Normalize(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// Normalize applies the defaults to the manifest and validates it, the names are validated and normalized as the server does.
+func (m *ZoneManifest) Normalize() error {
+	invalid := func(msg string) error {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput, fmt.Sprintf("cli: %s", msg))
+	}
+	invalidName := func(entity string, name string, err error) error {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliInput, fmt.Sprintf("cli: the %s name %s is not valid", entity, name), err)
+	}
+	if m.Zone.ID < 0 {
+		return invalid("the zone id must be a positive number")
+	}
+	if strings.TrimSpace(m.Zone.Name) == "" {
+		return invalid("the zone name is required")
+	}
+	if err := azvalidators.ValidateName("zone", m.Zone.Name); err != nil {
+		return invalidName("zone", m.Zone.Name, err)
+	}
+	tenants := map[string]bool{}
+	for i := range m.Tenants {
+		name := m.Tenants[i].Name
+		if strings.TrimSpace(name) == "" {
+			return invalid(fmt.Sprintf("the name of the tenant %d is required", i+1))
+		}
+		if err := azvalidators.ValidateName("tenant", name); err != nil {
+			return invalidName("tenant", name, err)
+		}
+		if tenants[name] {
+			return invalid(fmt.Sprintf("the tenant %s is declared more than once", name))
+		}
+		tenants[name] = true
+	}
+	sources := map[string]bool{}
+	identities := map[string]bool{}
+	for i := range m.IdentitySources {
+		source := &m.IdentitySources[i]
+		if strings.TrimSpace(source.Name) == "" {
+			return invalid(fmt.Sprintf("the name of the identity source %d is required", i+1))
+		}
+		if err := azvalidators.ValidateName("identity source", source.Name); err != nil {
+			return invalidName("identity source", source.Name, err)
+		}
+		if sources[source.Name] {
+			return invalid(fmt.Sprintf("the identity source %s is declared more than once", source.Name))
+		}
+		sources[source.Name] = true
+		for j := range source.Identities {
+			identity := &source.Identities[j]
+			if strings.TrimSpace(identity.Name) == "" {
+				return invalid(fmt.Sprintf("the name of the identity %d of the identity source %s is required", j+1, source.Name))
+			}
+			identity.Kind = strings.ToLower(identity.Kind)
+			if identity.Kind == "" {
+				identity.Kind = DefaultIdentityKind
+			}
+			validateIdentityName := azvalidators.ValidateName
+			if identity.Kind == DefaultIdentityKind {
+				validateIdentityName = azvalidators.ValidateIdentityUserName
+			}
+			if err := validateIdentityName("identity", identity.Name); err != nil {
+				return invalidName("identity", identity.Name, err)
+			}
+			identity.Name = strings.ToLower(identity.Name)
+			if identities[identity.Name] {
+				return invalid(fmt.Sprintf("the identity %s is declared more than once", identity.Name))
+			}
+			identities[identity.Name] = true
+		}
+	}
+	ledgers := map[string]bool{}
+	for i := range m.Ledgers {
+		ledger := &m.Ledgers[i]
+		if strings.TrimSpace(ledger.Name) == "" {
+			return invalid(fmt.Sprintf("the name of the ledger %d is required", i+1))
+		}
+		if err := azvalidators.ValidateName("ledger", ledger.Name); err != nil {
+			return invalidName("ledger", ledger.Name, err)
+		}
+		if ledgers[ledger.Name] {
+			return invalid(fmt.Sprintf("the ledger %s is declared more than once", ledger.Name))
+		}
+		ledgers[ledger.Name] = true
+		if ledger.Kind == "" {
+			ledger.Kind = DefaultLedgerKind
+		}
+		if ledger.RequiredApprovals != nil && *ledger.RequiredApprovals < 0 {
+			return invalid(fmt.Sprintf("the required approvals of the ledger %s must not be negative", ledger.Name))
+		}
+	}
+	return nil
+}
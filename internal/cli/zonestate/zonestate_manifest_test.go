@@ -0,0 +1,107 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zonestate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestReadManifest tests reading the zone manifest in yaml and toml.
+func TestReadManifest(t *testing.T) {
+	yamlDoc := `zone:
+  name: magicfarmacia
+tenants:
+  - name: matera-branch
+identity_sources:
+  - name: keycloak
+    identities:
+      - name: Nicola.Gallo@example.com
+      - name: branch-manager
+        kind: Role-Actor
+ledgers:
+  - name: magicfarmacia
+    required_approvals: 2
+`
+	tomlDoc := `[zone]
+name = "magicfarmacia"
+
+[[tenants]]
+name = "matera-branch"
+
+[[identity_sources]]
+name = "keycloak"
+
+  [[identity_sources.identities]]
+  name = "Nicola.Gallo@example.com"
+
+  [[identity_sources.identities]]
+  name = "branch-manager"
+  kind = "Role-Actor"
+
+[[ledgers]]
+name = "magicfarmacia"
+required_approvals = 2
+`
+	for file, content := range map[string]string{"zone.yaml": yamlDoc, "zone.toml": tomlDoc} {
+		assert := assert.New(t)
+		path := filepath.Join(t.TempDir(), file)
+		assert.Nil(os.WriteFile(path, []byte(content), 0o600))
+		manifest, err := ReadManifest(path)
+		assert.Nil(err, file)
+		assert.Equal("magicfarmacia", manifest.Zone.Name)
+		assert.Equal([]TenantSpec{{Name: "matera-branch"}}, manifest.Tenants)
+		assert.Len(manifest.IdentitySources, 1)
+		assert.Equal([]IdentitySpec{{Name: "nicola.gallo@example.com", Kind: DefaultIdentityKind}, {Name: "branch-manager", Kind: "role-actor"}}, manifest.IdentitySources[0].Identities)
+		requiredApprovals := int32(2)
+		assert.Equal([]LedgerSpec{{Name: "magicfarmacia", Kind: DefaultLedgerKind, RequiredApprovals: &requiredApprovals}}, manifest.Ledgers)
+	}
+}
+
+// TestReadManifestWithErrors tests the errors reading the zone manifest.
+func TestReadManifestWithErrors(t *testing.T) {
+	tests := []struct {
+		File    string
+		Content string
+	}{
+		{File: "zone.json", Content: `{}`},
+		{File: "zone.yaml", Content: `zone: [`},
+		{File: "zone.yaml", Content: `tenants: [{name: matera-branch}]`},
+		{File: "zone.yaml", Content: "zone: {name: magicfarmacia}\ntenants: [{name: matera-branch}, {name: matera-branch}]"},
+		{File: "zone.yaml", Content: "zone: {name: magicfarmacia}\nidentity_sources: [{name: keycloak, identities: [{name: nicola.gallo}]}, {name: okta, identities: [{name: nicola.gallo}]}]"},
+		{File: "zone.yaml", Content: "zone: {name: magicfarmacia}\nidentity_sources: [{name: keycloak, identities: [{name: nicola.gallo@example.com}, {name: Nicola.Gallo@example.com}]}]"},
+		{File: "zone.yaml", Content: "zone: {name: magicfarmacia}\nledgers: [{name: magicfarmacia, required_approvals: -1}]"},
+		{File: "zone.yaml", Content: "zone: {name: MagicFarmacia}"},
+		{File: "zone.yaml", Content: "zone: {name: magicfarmacia}\ntenants: [{name: Matera-Branch}]"},
+		{File: "zone.yaml", Content: "zone: {name: magicfarmacia}\nidentity_sources: [{name: permguard-keycloak}]"},
+		{File: "zone.yaml", Content: "zone: {name: magicfarmacia}\nidentity_sources: [{name: keycloak, identities: [{name: Branch-Manager, kind: role-actor}]}]"},
+		{File: "zone.yaml", Content: "zone: {name: magicfarmacia}\nledgers: [{name: magic farmacia}]"},
+		{File: "zone.toml", Content: `zone = [`},
+	}
+	for _, test := range tests {
+		assert := assert.New(t)
+		path := filepath.Join(t.TempDir(), test.File)
+		assert.Nil(os.WriteFile(path, []byte(test.Content), 0o600))
+		_, err := ReadManifest(path)
+		assert.NotNil(err, test.Content)
+	}
+	_, err := ReadManifest(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zonestate
+
+import (
+	"fmt"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// ActionCreate is the action creating a resource.
+	ActionCreate = "create"
+	// ActionUpdate is the action updating a resource.
+	ActionUpdate = "update"
+	// ActionDelete is the action deleting a resource.
+	ActionDelete = "delete"
+
+	// ResourceZone is the zone resource.
+	ResourceZone = "zone"
+	// ResourceTenant is the tenant resource.
+	ResourceTenant = "tenant"
+	// ResourceIdentitySource is the identity source resource.
+	ResourceIdentitySource = "identity_source"
+	// ResourceIdentity is the identity resource.
+	ResourceIdentity = "identity"
+	// ResourceLedger is the ledger resource.
+	ResourceLedger = "ledger"
+)
+
+// Change is a single change required to reconcile a zone.
+type Change struct {
+	Action            string `json:"action"`
+	Resource          string `json:"resource"`
+	ID                string `json:"id,omitempty"`
+	Name              string `json:"name"`
+	Parent            string `json:"parent,omitempty"`
+	ParentID          string `json:"parent_id,omitempty"`
+	Kind              string `json:"kind,omitempty"`
+	RequiredApprovals int32  `json:"required_approvals,omitempty"`
+	Details           string `json:"details,omitempty"`
+}
+
+// Plan is the ordered list of changes required to reconcile a zone with its manifest.
+type Plan struct {
+	ZoneID   int64    `json:"zone_id"`
+	ZoneName string   `json:"zone_name"`
+	Changes  []Change `json:"changes"`
+}
+
+// IsEmpty returns true if the plan has no changes.
+func (p *Plan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+// Count returns the number of changes with the input action.
+func (p *Plan) Count(action string) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// BuildPlan builds the plan reconciling the current state of a zone with its manifest, resources missing from the manifest are deleted only when prune is true.
+func BuildPlan(manifest *ZoneManifest, state *ZoneState, prune bool) (*Plan, error) {
+	if manifest == nil || state == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "cli: invalid zone manifest or state")
+	}
+	plan := &Plan{ZoneName: manifest.Zone.Name, Changes: []Change{}}
+	if state.Zone == nil {
+		plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Resource: ResourceZone, Name: manifest.Zone.Name})
+	} else {
+		plan.ZoneID = state.Zone.ZoneID
+		if state.Zone.Name != manifest.Zone.Name {
+			plan.Changes = append(plan.Changes, Change{
+				Action:   ActionUpdate,
+				Resource: ResourceZone,
+				ID:       fmt.Sprintf("%d", state.Zone.ZoneID),
+				Name:     manifest.Zone.Name,
+				Details:  fmt.Sprintf("name %s -> %s", state.Zone.Name, manifest.Zone.Name),
+			})
+		}
+	}
+
+	sourceIDs := map[string]string{}
+	sourceNames := map[string]string{}
+	for _, source := range state.IdentitySources {
+		sourceIDs[source.Name] = source.IdentitySourceID
+		sourceNames[source.IdentitySourceID] = source.Name
+	}
+	for _, source := range manifest.IdentitySources {
+		if _, ok := sourceIDs[source.Name]; !ok {
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Resource: ResourceIdentitySource, Name: source.Name})
+		}
+	}
+
+	desiredIdentities := map[string]bool{}
+	currentIdentities := map[string]int{}
+	for i, identity := range state.Identities {
+		currentIdentities[identity.Name] = i
+	}
+	for _, source := range manifest.IdentitySources {
+		for _, identity := range source.Identities {
+			desiredIdentities[identity.Name] = true
+			idx, ok := currentIdentities[identity.Name]
+			if !ok {
+				plan.Changes = append(plan.Changes, Change{
+					Action:   ActionCreate,
+					Resource: ResourceIdentity,
+					Name:     identity.Name,
+					Parent:   source.Name,
+					ParentID: sourceIDs[source.Name],
+					Kind:     identity.Kind,
+				})
+				continue
+			}
+			current := state.Identities[idx]
+			if current.IdentitySourceID != sourceIDs[source.Name] {
+				return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput,
+					fmt.Sprintf("cli: the identity %s belongs to the identity source %s and cannot be moved to %s", identity.Name, sourceNames[current.IdentitySourceID], source.Name))
+			}
+			if current.Kind != identity.Kind {
+				plan.Changes = append(plan.Changes, Change{
+					Action:   ActionUpdate,
+					Resource: ResourceIdentity,
+					ID:       current.IdentityID,
+					Name:     identity.Name,
+					Parent:   source.Name,
+					ParentID: current.IdentitySourceID,
+					Kind:     identity.Kind,
+					Details:  fmt.Sprintf("kind %s -> %s", current.Kind, identity.Kind),
+				})
+			}
+		}
+	}
+
+	currentTenants := map[string]bool{}
+	for _, tenant := range state.Tenants {
+		currentTenants[tenant.Name] = true
+	}
+	desiredTenants := map[string]bool{}
+	for _, tenant := range manifest.Tenants {
+		desiredTenants[tenant.Name] = true
+		if !currentTenants[tenant.Name] {
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Resource: ResourceTenant, Name: tenant.Name})
+		}
+	}
+
+	currentLedgers := map[string]int{}
+	for i, ledger := range state.Ledgers {
+		currentLedgers[ledger.Name] = i
+	}
+	desiredLedgers := map[string]bool{}
+	for _, ledger := range manifest.Ledgers {
+		desiredLedgers[ledger.Name] = true
+		idx, ok := currentLedgers[ledger.Name]
+		if !ok {
+			plan.Changes = append(plan.Changes, Change{
+				Action:            ActionCreate,
+				Resource:          ResourceLedger,
+				Name:              ledger.Name,
+				Kind:              ledger.Kind,
+				RequiredApprovals: ledger.GetRequiredApprovals(),
+			})
+			continue
+		}
+		current := state.Ledgers[idx]
+		if current.Kind != ledger.Kind {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliInput,
+				fmt.Sprintf("cli: the ledger %s has kind %s and cannot be changed to %s", ledger.Name, current.Kind, ledger.Kind))
+		}
+		if ledger.RequiredApprovals != nil && current.RequiredApprovals != *ledger.RequiredApprovals {
+			plan.Changes = append(plan.Changes, Change{
+				Action:            ActionUpdate,
+				Resource:          ResourceLedger,
+				ID:                current.LedgerID,
+				Name:              ledger.Name,
+				Kind:              current.Kind,
+				RequiredApprovals: *ledger.RequiredApprovals,
+				Details:           fmt.Sprintf("required_approvals %d -> %d", current.RequiredApprovals, *ledger.RequiredApprovals),
+			})
+		}
+	}
+
+	if !prune {
+		return plan, nil
+	}
+	for _, identity := range state.Identities {
+		if !desiredIdentities[identity.Name] {
+			plan.Changes = append(plan.Changes, Change{
+				Action:   ActionDelete,
+				Resource: ResourceIdentity,
+				ID:       identity.IdentityID,
+				Name:     identity.Name,
+				Parent:   sourceNames[identity.IdentitySourceID],
+				ParentID: identity.IdentitySourceID,
+				Kind:     identity.Kind,
+			})
+		}
+	}
+	desiredSources := map[string]bool{}
+	for _, source := range manifest.IdentitySources {
+		desiredSources[source.Name] = true
+	}
+	for _, source := range state.IdentitySources {
+		if !desiredSources[source.Name] {
+			plan.Changes = append(plan.Changes, Change{Action: ActionDelete, Resource: ResourceIdentitySource, ID: source.IdentitySourceID, Name: source.Name})
+		}
+	}
+	for _, tenant := range state.Tenants {
+		if !desiredTenants[tenant.Name] {
+			plan.Changes = append(plan.Changes, Change{Action: ActionDelete, Resource: ResourceTenant, ID: tenant.TenantID, Name: tenant.Name})
+		}
+	}
+	for _, ledger := range state.Ledgers {
+		if !desiredLedgers[ledger.Name] {
+			plan.Changes = append(plan.Changes, Change{Action: ActionDelete, Resource: ResourceLedger, ID: ledger.LedgerID, Name: ledger.Name, Kind: ledger.Kind})
+		}
+	}
+	return plan, nil
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zonestate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// requiredApprovals returns the pointer to the required approvals of a ledger.
+func requiredApprovals(value int32) *int32 {
+	return &value
+}
+
+// testManifest returns the manifest used by the plan tests.
+func testManifest() *ZoneManifest {
+	manifest := &ZoneManifest{
+		Zone:    ZoneSpec{Name: "magicfarmacia"},
+		Tenants: []TenantSpec{{Name: "matera-branch"}, {Name: "pisa-branch"}},
+		IdentitySources: []IdentitySourceSpec{
+			{Name: "keycloak", Identities: []IdentitySpec{{Name: "nicola.gallo"}, {Name: "branch-manager", Kind: "role-actor"}}},
+		},
+		Ledgers: []LedgerSpec{{Name: "magicfarmacia", RequiredApprovals: requiredApprovals(2)}},
+	}
+	manifest.Normalize()
+	return manifest
+}
+
+// testState returns the state used by the plan tests.
+func testState() *ZoneState {
+	return &ZoneState{
+		Zone:            &azmodelzap.Zone{ZoneID: 273165098782, Name: "magicfarmacia"},
+		Tenants:         []azmodelzap.Tenant{{TenantID: "t1", Name: "matera-branch"}, {TenantID: "t2", Name: "rome-branch"}},
+		IdentitySources: []azmodelzap.IdentitySource{{IdentitySourceID: "s1", Name: "keycloak"}, {IdentitySourceID: "s2", Name: "legacy"}},
+		Identities: []azmodelzap.Identity{
+			{IdentityID: "i1", IdentitySourceID: "s1", Kind: "user", Name: "nicola.gallo"},
+			{IdentityID: "i2", IdentitySourceID: "s1", Kind: "user", Name: "branch-manager"},
+			{IdentityID: "i3", IdentitySourceID: "s2", Kind: "user", Name: "old.user"},
+		},
+		Ledgers: []azmodelpap.Ledger{{LedgerID: "l1", Kind: "policy", Name: "magicfarmacia"}, {LedgerID: "l2", Kind: "policy", Name: "legacy"}},
+	}
+}
+
+// TestBuildPlanForNewZone tests the plan of a zone that does not exist yet.
+func TestBuildPlanForNewZone(t *testing.T) {
+	assert := assert.New(t)
+	plan, err := BuildPlan(testManifest(), &ZoneState{}, true)
+	assert.Nil(err)
+	assert.Equal(int64(0), plan.ZoneID)
+	assert.Equal(7, plan.Count(ActionCreate))
+	assert.Equal(0, plan.Count(ActionUpdate))
+	assert.Equal(0, plan.Count(ActionDelete))
+	resources := []string{}
+	for _, change := range plan.Changes {
+		resources = append(resources, change.Resource)
+	}
+	assert.Equal([]string{ResourceZone, ResourceIdentitySource, ResourceIdentity, ResourceIdentity, ResourceTenant, ResourceTenant, ResourceLedger}, resources)
+	assert.Equal("keycloak", plan.Changes[2].Parent)
+	assert.Equal("", plan.Changes[2].ParentID)
+	assert.Equal(int32(2), plan.Changes[6].RequiredApprovals)
+}
+
+// TestBuildPlanForExistingZone tests the plan of an existing zone with and without pruning.
+func TestBuildPlanForExistingZone(t *testing.T) {
+	assert := assert.New(t)
+	plan, err := BuildPlan(testManifest(), testState(), false)
+	assert.Nil(err)
+	assert.Equal(int64(273165098782), plan.ZoneID)
+	assert.Equal([]Change{
+		{Action: ActionUpdate, Resource: ResourceIdentity, ID: "i2", Name: "branch-manager", Parent: "keycloak", ParentID: "s1", Kind: "role-actor", Details: "kind user -> role-actor"},
+		{Action: ActionCreate, Resource: ResourceTenant, Name: "pisa-branch"},
+		{Action: ActionUpdate, Resource: ResourceLedger, ID: "l1", Name: "magicfarmacia", Kind: "policy", RequiredApprovals: 2, Details: "required_approvals 0 -> 2"},
+	}, plan.Changes)
+
+	plan, err = BuildPlan(testManifest(), testState(), true)
+	assert.Nil(err)
+	assert.Equal(3, plan.Count(ActionUpdate)+plan.Count(ActionCreate))
+	assert.Equal([]Change{
+		{Action: ActionDelete, Resource: ResourceIdentity, ID: "i3", Name: "old.user", Parent: "legacy", ParentID: "s2", Kind: "user"},
+		{Action: ActionDelete, Resource: ResourceIdentitySource, ID: "s2", Name: "legacy"},
+		{Action: ActionDelete, Resource: ResourceTenant, ID: "t2", Name: "rome-branch"},
+		{Action: ActionDelete, Resource: ResourceLedger, ID: "l2", Name: "legacy", Kind: "policy"},
+	}, plan.Changes[3:])
+}
+
+// TestBuildPlanWithErrors tests the changes that cannot be planned.
+func TestBuildPlanWithErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := BuildPlan(nil, testState(), false)
+	assert.NotNil(err)
+
+	manifest := testManifest()
+	manifest.IdentitySources = append(manifest.IdentitySources, IdentitySourceSpec{Name: "ldap", Identities: []IdentitySpec{{Name: "old.user", Kind: "user"}}})
+	_, err = BuildPlan(manifest, testState(), false)
+	assert.NotNil(err)
+
+	manifest = testManifest()
+	manifest.Ledgers[0].Kind = "schema"
+	_, err = BuildPlan(manifest, testState(), false)
+	assert.NotNil(err)
+}
+
+// TestBuildPlanWithoutChanges tests the plan of a zone already in the desired state.
+func TestBuildPlanWithoutChanges(t *testing.T) {
+	assert := assert.New(t)
+	manifest := testManifest()
+	manifest.IdentitySources[0].Identities[1].Kind = "user"
+	manifest.Tenants = manifest.Tenants[:1]
+	manifest.Ledgers[0].RequiredApprovals = requiredApprovals(0)
+	plan, err := BuildPlan(manifest, testState(), false)
+	assert.Nil(err)
+	assert.True(plan.IsEmpty())
+}
+
+// TestBuildPlanWithOmittedRequiredApprovals tests that omitted required approvals leave the protection unchanged while an explicit zero removes it.
+func TestBuildPlanWithOmittedRequiredApprovals(t *testing.T) {
+	assert := assert.New(t)
+	state := testState()
+	state.Ledgers[0].RequiredApprovals = 2
+
+	manifest := testManifest()
+	manifest.IdentitySources[0].Identities[1].Kind = "user"
+	manifest.Tenants = manifest.Tenants[:1]
+	manifest.Ledgers[0].RequiredApprovals = nil
+	plan, err := BuildPlan(manifest, state, false)
+	assert.Nil(err)
+	assert.True(plan.IsEmpty())
+
+	manifest.Ledgers[0].RequiredApprovals = requiredApprovals(0)
+	plan, err = BuildPlan(manifest, state, false)
+	assert.Nil(err)
+	assert.Equal([]Change{
+		{Action: ActionUpdate, Resource: ResourceLedger, ID: "l1", Name: "magicfarmacia", Kind: "policy", RequiredApprovals: 0, Details: "required_approvals 2 -> 0"},
+	}, plan.Changes)
+}
+
+// TestBuildPlanWithNormalizedNames tests that the names of the manifest match the names normalized by the server.
+func TestBuildPlanWithNormalizedNames(t *testing.T) {
+	assert := assert.New(t)
+	manifest := &ZoneManifest{
+		Zone:            ZoneSpec{Name: "magicfarmacia"},
+		Tenants:         []TenantSpec{{Name: "matera-branch"}},
+		IdentitySources: []IdentitySourceSpec{{Name: "keycloak", Identities: []IdentitySpec{{Name: "Nicola.Gallo@example.com"}, {Name: "branch-manager", Kind: "User"}}}},
+		Ledgers:         []LedgerSpec{{Name: "magicfarmacia"}},
+	}
+	assert.Nil(manifest.Normalize())
+	state := testState()
+	state.Identities[0].Name = "nicola.gallo@example.com"
+	plan, err := BuildPlan(manifest, state, false)
+	assert.Nil(err)
+	assert.True(plan.IsEmpty())
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zonestate
+
+import (
+	"fmt"
+
+	aziclicommon "github.com/permguard/permguard/internal/cli/common"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azclients "github.com/permguard/permguard/pkg/transport/clients"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+const (
+	// fetchPageSize is the page size used to read the current state of a zone.
+	fetchPageSize = 1000
+)
+
+// ZoneState is the current state of a zone on the remote server.
+type ZoneState struct {
+	Zone            *azmodelzap.Zone
+	Tenants         []azmodelzap.Tenant
+	IdentitySources []azmodelzap.IdentitySource
+	Identities      []azmodelzap.Identity
+	Ledgers         []azmodelpap.Ledger
+}
+
+// FetchZoneState reads the current state of the zone described by the manifest, the state has a nil zone when the zone does not exist yet.
+func FetchZoneState(zapClient azclients.GrpcZAPClient, papClient azclients.GrpcPAPClient, manifest *ZoneManifest) (*ZoneState, error) {
+	if zapClient == nil || papClient == nil || manifest == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "cli: invalid zone state clients")
+	}
+	state := &ZoneState{}
+	zoneName := ""
+	if manifest.Zone.ID == 0 {
+		zoneName = manifest.Zone.Name
+	}
+	zones, err := zapClient.FetchZonesBy(1, fetchPageSize, manifest.Zone.ID, zoneName)
+	if err != nil {
+		return nil, err
+	}
+	for i := range zones {
+		if (manifest.Zone.ID != 0 && zones[i].ZoneID == manifest.Zone.ID) || (manifest.Zone.ID == 0 && zones[i].Name == manifest.Zone.Name) {
+			state.Zone = &zones[i]
+			break
+		}
+	}
+	if state.Zone == nil {
+		if manifest.Zone.ID != 0 {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliRecordNotFound, fmt.Sprintf("cli: the zone %d does not exist", manifest.Zone.ID))
+		}
+		return state, nil
+	}
+	zoneID := state.Zone.ZoneID
+	state.Tenants, _, err = aziclicommon.FetchPages(&azmodels.FetchQuery{}, true, func(query *azmodels.FetchQuery) ([]azmodelzap.Tenant, string, error) {
+		return zapClient.FetchTenantsByQuery(1, fetchPageSize, zoneID, "", "", query)
+	})
+	if err != nil {
+		return nil, err
+	}
+	state.IdentitySources, _, err = aziclicommon.FetchPages(&azmodels.FetchQuery{}, true, func(query *azmodels.FetchQuery) ([]azmodelzap.IdentitySource, string, error) {
+		return zapClient.FetchIdentitySourcesByQuery(1, fetchPageSize, zoneID, "", "", query)
+	})
+	if err != nil {
+		return nil, err
+	}
+	state.Identities, _, err = aziclicommon.FetchPages(&azmodels.FetchQuery{}, true, func(query *azmodels.FetchQuery) ([]azmodelzap.Identity, string, error) {
+		return zapClient.FetchIdentitiesByQuery(1, fetchPageSize, zoneID, "", "", "", "", query)
+	})
+	if err != nil {
+		return nil, err
+	}
+	state.Ledgers, _, err = aziclicommon.FetchPages(&azmodels.FetchQuery{}, true, func(query *azmodels.FetchQuery) ([]azmodelpap.Ledger, string, error) {
+		return papClient.FetchLedgersByQuery(1, fetchPageSize, zoneID, "", "", "", query)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
@@ -22,6 +22,7 @@ import (
 
 	azapiv1zap "github.com/permguard/permguard/internal/agents/services/zap/endpoints/api/v1"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
@@ -90,9 +91,15 @@ func (c *GrpcZAPClient) FetchZonesByName(page int32, pageSize int32, name string
 
 // FetchZonesBy returns all zones filtering by zone id and name.
 func (c *GrpcZAPClient) FetchZonesBy(page int32, pageSize int32, zoneID int64, name string) ([]azmodelzap.Zone, error) {
+	zones, _, err := c.FetchZonesByQuery(page, pageSize, zoneID, name, nil)
+	return zones, err
+}
+
+// FetchZonesByQuery returns all zones filtering by zone id and name and the fetch query along with the cursor of the next page.
+func (c *GrpcZAPClient) FetchZonesByQuery(page int32, pageSize int32, zoneID int64, name string, query *azmodels.FetchQuery) ([]azmodelzap.Zone, string, error) {
 	client, conn, err := c.createGRPCClient()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer conn.Close()
 	zoneFetchRequest := &azapiv1zap.ZoneFetchRequest{}
@@ -104,9 +111,10 @@ func (c *GrpcZAPClient) FetchZonesBy(page int32, pageSize int32, zoneID int64, n
 	if name != "" {
 		zoneFetchRequest.Name = &name
 	}
+	zoneFetchRequest.Query = azapiv1zap.MapAgentFetchQueryToGrpcFetchQuery(query)
 	stream, err := client.FetchZones(context.Background(), zoneFetchRequest)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	zones := []azmodelzap.Zone{}
 	for {
@@ -115,13 +123,13 @@ func (c *GrpcZAPClient) FetchZonesBy(page int32, pageSize int32, zoneID int64, n
 			break
 		}
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		zone, err := azapiv1zap.MapGrpcZoneResponseToAgentZone(response)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		zones = append(zones, *zone)
 	}
-	return zones, nil
+	return zones, nextFetchCursor(stream.Trailer()), nil
 }
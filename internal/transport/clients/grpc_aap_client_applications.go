@@ -18,33 +18,59 @@ package clients
 
 import (
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 
 	azapiv1zap "github.com/permguard/permguard/internal/agents/services/zap/endpoints/api/v1"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+)
+
+const (
+	// bulkUpsertBatchSize is the number of rows sent in a single message of a bulk upsert stream.
+	bulkUpsertBatchSize = 500
 )
 
 // GrpcZAPClient is a gRPC client for the ZAP service.
 type GrpcZAPClient struct {
 	target string
+	config *GrpcClientConfig
 }
 
 // NewGrpcZAPClient creates a new gRPC client for the ZAP service.
 func NewGrpcZAPClient(target string) (*GrpcZAPClient, error) {
+	return NewGrpcZAPClientWithConfig(target, nil)
+}
+
+// NewGrpcZAPClientWithConfig creates a new gRPC client for the ZAP service with the transport configuration.
+func NewGrpcZAPClientWithConfig(target string, config *GrpcClientConfig) (*GrpcZAPClient, error) {
 	if target == "" {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, "target is required")
 	}
 	return &GrpcZAPClient{
 		target: target,
+		config: config,
 	}, nil
 }
 
 // createGRPCClient creates a new gRPC client.
 func (c *GrpcZAPClient) createGRPCClient() (azapiv1zap.V1ZAPServiceClient, *grpc.ClientConn, error) {
-	conn, err := grpc.NewClient(c.target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts, err := buildDialOptions(c.config)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.NewClient(c.target, opts...)
 	if err != nil {
 		return nil, nil, err
 	}
 	client := azapiv1zap.NewV1ZAPServiceClient(conn)
 	return client, conn, nil
 }
+
+// nextFetchCursor returns the cursor of the next page carried by the trailer of a fetch stream.
+func nextFetchCursor(trailer metadata.MD) string {
+	values := trailer.Get(azmodels.NextCursorMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -22,6 +22,7 @@ import (
 
 	azapiv1zap "github.com/permguard/permguard/internal/agents/services/zap/endpoints/api/v1"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
@@ -92,9 +93,15 @@ func (c *GrpcZAPClient) FetchIdentitiesByEmail(page int32, pageSize int32, zoneI
 
 // FetchIdentitiesBy returns all identities filtering by all criteria.
 func (c *GrpcZAPClient) FetchIdentitiesBy(page int32, pageSize int32, zoneID int64, identitySourceID string, identityID string, kind string, name string) ([]azmodelzap.Identity, error) {
+	identities, _, err := c.FetchIdentitiesByQuery(page, pageSize, zoneID, identitySourceID, identityID, kind, name, nil)
+	return identities, err
+}
+
+// FetchIdentitiesByQuery returns all identities filtering by all criteria and the fetch query along with the cursor of the next page.
+func (c *GrpcZAPClient) FetchIdentitiesByQuery(page int32, pageSize int32, zoneID int64, identitySourceID string, identityID string, kind string, name string, query *azmodels.FetchQuery) ([]azmodelzap.Identity, string, error) {
 	client, conn, err := c.createGRPCClient()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer conn.Close()
 	identityFetchRequest := &azapiv1zap.IdentityFetchRequest{}
@@ -115,9 +122,10 @@ func (c *GrpcZAPClient) FetchIdentitiesBy(page int32, pageSize int32, zoneID int
 	if identityID != "" {
 		identityFetchRequest.IdentityID = &identityID
 	}
+	identityFetchRequest.Query = azapiv1zap.MapAgentFetchQueryToGrpcFetchQuery(query)
 	stream, err := client.FetchIdentities(context.Background(), identityFetchRequest)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	identities := []azmodelzap.Identity{}
 	for {
@@ -126,13 +134,46 @@ func (c *GrpcZAPClient) FetchIdentitiesBy(page int32, pageSize int32, zoneID int
 			break
 		}
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		identity, err := azapiv1zap.MapGrpcIdentityResponseToAgentIdentity(response)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		identities = append(identities, *identity)
 	}
-	return identities, nil
+	return identities, nextFetchCursor(stream.Trailer()), nil
+}
+
+// UpsertIdentities creates or updates identities in bulk, the rows are streamed in batches.
+func (c *GrpcZAPClient) UpsertIdentities(options *azmodelzap.BulkUpsertOptions, identities []azmodelzap.Identity) (*azmodelzap.BulkUpsertReport, error) {
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	stream, err := client.UpsertIdentities(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	request := &azapiv1zap.IdentityBulkUpsertRequest{Options: azapiv1zap.MapAgentBulkUpsertOptionsToGrpcBulkUpsertOptions(options)}
+	for i, item := range identities {
+		request.Identities = append(request.Identities, &azapiv1zap.IdentityBulkRow{IdentitySourceID: item.IdentitySourceID, Kind: item.Kind, Name: item.Name})
+		if len(request.Identities) == bulkUpsertBatchSize || i == len(identities)-1 {
+			if err := stream.Send(request); err != nil {
+				return nil, err
+			}
+			request = &azapiv1zap.IdentityBulkUpsertRequest{}
+		}
+	}
+	if len(identities) == 0 {
+		if err := stream.Send(request); err != nil {
+			return nil, err
+		}
+	}
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1zap.MapGrpcBulkUpsertResponseToAgentBulkUpsertReport(response), nil
 }
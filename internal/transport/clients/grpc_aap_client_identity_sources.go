@@ -22,6 +22,7 @@ import (
 
 	azapiv1zap "github.com/permguard/permguard/internal/agents/services/zap/endpoints/api/v1"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
@@ -91,9 +92,15 @@ func (c *GrpcZAPClient) FetchIdentitySourcesByName(page int32, pageSize int32, z
 
 // FetchIdentitySourcesBy returns all identity sources filtering by identity source id and name.
 func (c *GrpcZAPClient) FetchIdentitySourcesBy(page int32, pageSize int32, zoneID int64, identitySourceID string, name string) ([]azmodelzap.IdentitySource, error) {
+	identitySources, _, err := c.FetchIdentitySourcesByQuery(page, pageSize, zoneID, identitySourceID, name, nil)
+	return identitySources, err
+}
+
+// FetchIdentitySourcesByQuery returns all identity sources filtering by identity source id and name and the fetch query along with the cursor of the next page.
+func (c *GrpcZAPClient) FetchIdentitySourcesByQuery(page int32, pageSize int32, zoneID int64, identitySourceID string, name string, query *azmodels.FetchQuery) ([]azmodelzap.IdentitySource, string, error) {
 	client, conn, err := c.createGRPCClient()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer conn.Close()
 	identitySourceFetchRequest := &azapiv1zap.IdentitySourceFetchRequest{}
@@ -108,9 +115,10 @@ func (c *GrpcZAPClient) FetchIdentitySourcesBy(page int32, pageSize int32, zoneI
 	if identitySourceID != "" {
 		identitySourceFetchRequest.IdentitySourceID = &identitySourceID
 	}
+	identitySourceFetchRequest.Query = azapiv1zap.MapAgentFetchQueryToGrpcFetchQuery(query)
 	stream, err := client.FetchIdentitySources(context.Background(), identitySourceFetchRequest)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	identitySources := []azmodelzap.IdentitySource{}
 	for {
@@ -119,13 +127,13 @@ func (c *GrpcZAPClient) FetchIdentitySourcesBy(page int32, pageSize int32, zoneI
 			break
 		}
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		identitySource, err := azapiv1zap.MapGrpcIdentitySourceResponseToAgentIdentitySource(response)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		identitySources = append(identitySources, *identitySource)
 	}
-	return identitySources, nil
+	return identitySources, nextFetchCursor(stream.Trailer()), nil
 }
@@ -22,6 +22,7 @@ import (
 
 	azapiv1zap "github.com/permguard/permguard/internal/agents/services/zap/endpoints/api/v1"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
@@ -91,9 +92,15 @@ func (c *GrpcZAPClient) FetchTenantsByName(page int32, pageSize int32, zoneID in
 
 // FetchTenantsBy returns all tenants filtering by tenant id and name.
 func (c *GrpcZAPClient) FetchTenantsBy(page int32, pageSize int32, zoneID int64, tenantID string, name string) ([]azmodelzap.Tenant, error) {
+	tenants, _, err := c.FetchTenantsByQuery(page, pageSize, zoneID, tenantID, name, nil)
+	return tenants, err
+}
+
+// FetchTenantsByQuery returns all tenants filtering by tenant id and name and the fetch query along with the cursor of the next page.
+func (c *GrpcZAPClient) FetchTenantsByQuery(page int32, pageSize int32, zoneID int64, tenantID string, name string, query *azmodels.FetchQuery) ([]azmodelzap.Tenant, string, error) {
 	client, conn, err := c.createGRPCClient()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer conn.Close()
 	tenantFetchRequest := &azapiv1zap.TenantFetchRequest{}
@@ -108,9 +115,10 @@ func (c *GrpcZAPClient) FetchTenantsBy(page int32, pageSize int32, zoneID int64,
 	if tenantID != "" {
 		tenantFetchRequest.TenantID = &tenantID
 	}
+	tenantFetchRequest.Query = azapiv1zap.MapAgentFetchQueryToGrpcFetchQuery(query)
 	stream, err := client.FetchTenants(context.Background(), tenantFetchRequest)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	tenants := []azmodelzap.Tenant{}
 	for {
@@ -119,13 +127,46 @@ func (c *GrpcZAPClient) FetchTenantsBy(page int32, pageSize int32, zoneID int64,
 			break
 		}
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		tenant, err := azapiv1zap.MapGrpcTenantResponseToAgentTenant(response)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		tenants = append(tenants, *tenant)
 	}
-	return tenants, nil
+	return tenants, nextFetchCursor(stream.Trailer()), nil
+}
+
+// UpsertTenants creates or updates tenants in bulk, the rows are streamed in batches.
+func (c *GrpcZAPClient) UpsertTenants(options *azmodelzap.BulkUpsertOptions, tenants []azmodelzap.Tenant) (*azmodelzap.BulkUpsertReport, error) {
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	stream, err := client.UpsertTenants(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	request := &azapiv1zap.TenantBulkUpsertRequest{Options: azapiv1zap.MapAgentBulkUpsertOptionsToGrpcBulkUpsertOptions(options)}
+	for i, item := range tenants {
+		request.Tenants = append(request.Tenants, &azapiv1zap.TenantBulkRow{Name: item.Name})
+		if len(request.Tenants) == bulkUpsertBatchSize || i == len(tenants)-1 {
+			if err := stream.Send(request); err != nil {
+				return nil, err
+			}
+			request = &azapiv1zap.TenantBulkUpsertRequest{}
+		}
+	}
+	if len(tenants) == 0 {
+		if err := stream.Send(request); err != nil {
+			return nil, err
+		}
+	}
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1zap.MapGrpcBulkUpsertResponseToAgentBulkUpsertReport(response), nil
 }
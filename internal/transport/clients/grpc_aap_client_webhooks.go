@@ -0,0 +1,183 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"io"
+
+	azapiv1zap "github.com/permguard/permguard/internal/agents/services/zap/endpoints/api/v1"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+)
+
+// CreateWebhook creates a new webhook.
+func (c *GrpcZAPClient) CreateWebhook(webhook *azmodelzap.Webhook) (*azmodelzap.Webhook, error) {
+	if webhook == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, "invalid webhook instance")
+	}
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	createdWebhook, err := client.CreateWebhook(context.Background(), &azapiv1zap.WebhookCreateRequest{
+		ZoneID: webhook.ZoneID,
+		Name:   webhook.Name,
+		URL:    webhook.URL,
+		Secret: webhook.Secret,
+		Events: webhook.Events,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1zap.MapGrpcWebhookResponseToAgentWebhook(createdWebhook)
+}
+
+// UpdateWebhook updates a webhook.
+func (c *GrpcZAPClient) UpdateWebhook(webhook *azmodelzap.Webhook) (*azmodelzap.Webhook, error) {
+	if webhook == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, "invalid webhook instance")
+	}
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	updatedWebhook, err := client.UpdateWebhook(context.Background(), &azapiv1zap.WebhookUpdateRequest{
+		WebhookID: webhook.WebhookID,
+		ZoneID:    webhook.ZoneID,
+		Name:      webhook.Name,
+		URL:       webhook.URL,
+		Secret:    webhook.Secret,
+		Events:    webhook.Events,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1zap.MapGrpcWebhookResponseToAgentWebhook(updatedWebhook)
+}
+
+// DeleteWebhook deletes a webhook.
+func (c *GrpcZAPClient) DeleteWebhook(zoneID int64, webhookID string) (*azmodelzap.Webhook, error) {
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	webhook, err := client.DeleteWebhook(context.Background(), &azapiv1zap.WebhookDeleteRequest{ZoneID: zoneID, WebhookID: webhookID})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1zap.MapGrpcWebhookResponseToAgentWebhook(webhook)
+}
+
+// FetchWebhooksBy returns all webhooks filtering by webhook id and name.
+func (c *GrpcZAPClient) FetchWebhooksBy(page int32, pageSize int32, zoneID int64, webhookID string, name string) ([]azmodelzap.Webhook, error) {
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	webhookFetchRequest := &azapiv1zap.WebhookFetchRequest{}
+	webhookFetchRequest.Page = &page
+	webhookFetchRequest.PageSize = &pageSize
+	if zoneID > 0 {
+		webhookFetchRequest.ZoneID = zoneID
+	}
+	if name != "" {
+		webhookFetchRequest.Name = &name
+	}
+	if webhookID != "" {
+		webhookFetchRequest.WebhookID = &webhookID
+	}
+	stream, err := client.FetchWebhooks(context.Background(), webhookFetchRequest)
+	if err != nil {
+		return nil, err
+	}
+	webhooks := []azmodelzap.Webhook{}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		webhook, err := azapiv1zap.MapGrpcWebhookResponseToAgentWebhook(response)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+	return webhooks, nil
+}
+
+// FetchWebhookDeliveriesBy returns the webhook deliveries filtering by webhook id and status.
+func (c *GrpcZAPClient) FetchWebhookDeliveriesBy(page int32, pageSize int32, zoneID int64, webhookID string, status string) ([]azmodelzap.WebhookDelivery, error) {
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deliveryFetchRequest := &azapiv1zap.WebhookDeliveryFetchRequest{}
+	deliveryFetchRequest.Page = &page
+	deliveryFetchRequest.PageSize = &pageSize
+	if zoneID > 0 {
+		deliveryFetchRequest.ZoneID = zoneID
+	}
+	if webhookID != "" {
+		deliveryFetchRequest.WebhookID = &webhookID
+	}
+	if status != "" {
+		deliveryFetchRequest.Status = &status
+	}
+	stream, err := client.FetchWebhookDeliveries(context.Background(), deliveryFetchRequest)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := []azmodelzap.WebhookDelivery{}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		delivery, err := azapiv1zap.MapGrpcWebhookDeliveryResponseToAgentWebhookDelivery(response)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+	return deliveries, nil
+}
+
+// RequeueWebhookDelivery requeues a dead webhook delivery.
+func (c *GrpcZAPClient) RequeueWebhookDelivery(zoneID int64, deliveryID int64) (*azmodelzap.WebhookDelivery, error) {
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	delivery, err := client.RequeueWebhookDelivery(context.Background(), &azapiv1zap.WebhookDeliveryRequeueRequest{ZoneID: zoneID, DeliveryID: deliveryID})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1zap.MapGrpcWebhookDeliveryResponseToAgentWebhookDelivery(delivery)
+}
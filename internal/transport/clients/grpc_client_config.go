@@ -0,0 +1,90 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// GrpcClientConfig is the transport configuration of the gRPC clients.
+type GrpcClientConfig struct {
+	// TLS enables the transport security.
+	TLS bool
+	// TLSCAFile is the pem file of the certificate authorities used to verify the server, the system pool is used when empty.
+	TLSCAFile string
+	// TLSSkipVerify disables the verification of the server certificate.
+	TLSSkipVerify bool
+	// Token is the bearer token sent with every call, it requires the transport security.
+	Token string
+}
+
+// bearerTokenCredentials sends a bearer token with every call.
+type bearerTokenCredentials struct {
+	token string
+}
+
+// GetRequestMetadata returns the authorization metadata.
+func (c bearerTokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.token}, nil
+}
+
+// RequireTransportSecurity returns true as the token must not be sent in clear text.
+func (c bearerTokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// buildDialOptions builds the dial options of the gRPC connection for the configuration.
+func buildDialOptions(config *GrpcClientConfig) ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{aztelemetry.GrpcClientStatsHandler()}
+	if config == nil || !config.TLS {
+		if config != nil && config.Token != "" {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, "the token requires tls to be enabled")
+		}
+		return append(opts, grpc.WithTransportCredentials(insecure.NewCredentials())), nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.TLSSkipVerify,
+	}
+	if config.TLSCAFile != "" {
+		pem, err := os.ReadFile(config.TLSCAFile)
+		if err != nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, fmt.Sprintf("failed to read the tls ca file %s", config.TLSCAFile))
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, fmt.Sprintf("the tls ca file %s has no valid certificates", config.TLSCAFile))
+		}
+		tlsConfig.RootCAs = pool
+	}
+	opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if config.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerTokenCredentials{token: config.Token}))
+	}
+	return opts, nil
+}
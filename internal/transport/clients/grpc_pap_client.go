@@ -18,7 +18,6 @@ package clients
 
 import (
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	azapiv1pap "github.com/permguard/permguard/internal/agents/services/pap/endpoints/api/v1"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
@@ -27,21 +26,32 @@ import (
 // GrpcPAPClient is a gRPC client for the PAP service.
 type GrpcPAPClient struct {
 	target string
+	config *GrpcClientConfig
 }
 
 // NewGrpcPAPClient creates a new gRPC client for the PAP service.
 func NewGrpcPAPClient(target string) (*GrpcPAPClient, error) {
+	return NewGrpcPAPClientWithConfig(target, nil)
+}
+
+// NewGrpcPAPClientWithConfig creates a new gRPC client for the PAP service with the transport configuration.
+func NewGrpcPAPClientWithConfig(target string, config *GrpcClientConfig) (*GrpcPAPClient, error) {
 	if target == "" {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, "target is required")
 	}
 	return &GrpcPAPClient{
 		target: target,
+		config: config,
 	}, nil
 }
 
 // createGRPCClient creates a new gRPC client.
 func (c *GrpcPAPClient) createGRPCClient() (azapiv1pap.V1PAPServiceClient, *grpc.ClientConn, error) {
-	conn, err := grpc.NewClient(c.target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts, err := buildDialOptions(c.config)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.NewClient(c.target, opts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,108 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"io"
+
+	azapiv1pap "github.com/permguard/permguard/internal/agents/services/pap/endpoints/api/v1"
+	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+// FetchChangeRequestsBy returns all change requests of a ledger filtering by change request id and status.
+func (c *GrpcPAPClient) FetchChangeRequestsBy(page int32, pageSize int32, zoneID int64, ledgerID string, changeRequestID string, status string) ([]azmodelpap.ChangeRequest, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	changeRequestFetchRequest := &azapiv1pap.ChangeRequestFetchRequest{}
+	changeRequestFetchRequest.Page = &page
+	changeRequestFetchRequest.PageSize = &pageSize
+	if zoneID > 0 {
+		changeRequestFetchRequest.ZoneID = zoneID
+	}
+	changeRequestFetchRequest.LedgerID = ledgerID
+	if changeRequestID != "" {
+		changeRequestFetchRequest.ChangeRequestID = &changeRequestID
+	}
+	if status != "" {
+		changeRequestFetchRequest.Status = &status
+	}
+	stream, err := client.FetchChangeRequests(context.Background(), changeRequestFetchRequest)
+	if err != nil {
+		return nil, err
+	}
+	changeRequests := []azmodelpap.ChangeRequest{}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		changeRequest, err := azapiv1pap.MapGrpcChangeRequestResponseToAgentChangeRequest(response)
+		if err != nil {
+			return nil, err
+		}
+		changeRequests = append(changeRequests, *changeRequest)
+	}
+	return changeRequests, nil
+}
+
+// buildChangeRequestReviewRequest builds the review request of a change request.
+func buildChangeRequestReviewRequest(zoneID int64, ledgerID string, changeRequestID string, comment string) *azapiv1pap.ChangeRequestReviewRequest {
+	reviewRequest := &azapiv1pap.ChangeRequestReviewRequest{
+		ZoneID:          zoneID,
+		LedgerID:        ledgerID,
+		ChangeRequestID: changeRequestID,
+	}
+	if comment != "" {
+		reviewRequest.Comment = &comment
+	}
+	return reviewRequest
+}
+
+// ApproveChangeRequest approves a pending change request.
+func (c *GrpcPAPClient) ApproveChangeRequest(zoneID int64, ledgerID string, changeRequestID string, comment string) (*azmodelpap.ChangeRequest, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	changeRequest, err := client.ApproveChangeRequest(context.Background(), buildChangeRequestReviewRequest(zoneID, ledgerID, changeRequestID, comment))
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcChangeRequestResponseToAgentChangeRequest(changeRequest)
+}
+
+// RejectChangeRequest rejects a pending change request.
+func (c *GrpcPAPClient) RejectChangeRequest(zoneID int64, ledgerID string, changeRequestID string, comment string) (*azmodelpap.ChangeRequest, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	changeRequest, err := client.RejectChangeRequest(context.Background(), buildChangeRequestReviewRequest(zoneID, ledgerID, changeRequestID, comment))
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcChangeRequestResponseToAgentChangeRequest(changeRequest)
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"io"
+
+	azapiv1pap "github.com/permguard/permguard/internal/agents/services/pap/endpoints/api/v1"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+// buildEntityUpsertRequest builds the entity upsert request.
+func buildEntityUpsertRequest(ledgerID string, entity *azmodelpap.Entity) (*azapiv1pap.EntityUpsertRequest, error) {
+	if entity == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, "invalid entity instance")
+	}
+	entityRequest, err := azapiv1pap.MapAgentEntityToGrpcEntityRequest(entity)
+	if err != nil {
+		return nil, err
+	}
+	request := &azapiv1pap.EntityUpsertRequest{ZoneID: entity.ZoneID, Entity: entityRequest}
+	if ledgerID != "" {
+		request.LedgerID = &ledgerID
+	}
+	return request, nil
+}
+
+// CreateEntity creates a new entity.
+func (c *GrpcPAPClient) CreateEntity(ledgerID string, entity *azmodelpap.Entity) (*azmodelpap.Entity, error) {
+	request, err := buildEntityUpsertRequest(ledgerID, entity)
+	if err != nil {
+		return nil, err
+	}
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	createdEntity, err := client.CreateEntity(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcEntityResponseToAgentEntity(createdEntity)
+}
+
+// UpdateEntity updates an entity.
+func (c *GrpcPAPClient) UpdateEntity(ledgerID string, entity *azmodelpap.Entity) (*azmodelpap.Entity, error) {
+	request, err := buildEntityUpsertRequest(ledgerID, entity)
+	if err != nil {
+		return nil, err
+	}
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	updatedEntity, err := client.UpdateEntity(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcEntityResponseToAgentEntity(updatedEntity)
+}
+
+// UpsertEntities creates or replaces a set of entities.
+func (c *GrpcPAPClient) UpsertEntities(zoneID int64, ledgerID string, entities []azmodelpap.Entity) ([]azmodelpap.Entity, error) {
+	request := &azapiv1pap.EntityBulkUpsertRequest{ZoneID: zoneID, Entities: make([]*azapiv1pap.EntityRequest, 0, len(entities))}
+	if ledgerID != "" {
+		request.LedgerID = &ledgerID
+	}
+	for _, entity := range entities {
+		entityRequest, err := azapiv1pap.MapAgentEntityToGrpcEntityRequest(&entity)
+		if err != nil {
+			return nil, err
+		}
+		request.Entities = append(request.Entities, entityRequest)
+	}
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.UpsertEntities(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+	upsertedEntities := make([]azmodelpap.Entity, 0, len(response.Entities))
+	for _, entityResponse := range response.Entities {
+		entity, err := azapiv1pap.MapGrpcEntityResponseToAgentEntity(entityResponse)
+		if err != nil {
+			return nil, err
+		}
+		upsertedEntities = append(upsertedEntities, *entity)
+	}
+	return upsertedEntities, nil
+}
+
+// DeleteEntity deletes an entity.
+func (c *GrpcPAPClient) DeleteEntity(zoneID int64, entityType string, entityID string) (*azmodelpap.Entity, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	entity, err := client.DeleteEntity(context.Background(), &azapiv1pap.EntityDeleteRequest{ZoneID: zoneID, Type: entityType, ID: entityID})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcEntityResponseToAgentEntity(entity)
+}
+
+// FetchEntitiesBy returns all entities filtering by entity type and entity id.
+func (c *GrpcPAPClient) FetchEntitiesBy(page int32, pageSize int32, zoneID int64, entityType string, entityID string) ([]azmodelpap.Entity, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	entityFetchRequest := &azapiv1pap.EntityFetchRequest{}
+	entityFetchRequest.Page = &page
+	entityFetchRequest.PageSize = &pageSize
+	if zoneID > 0 {
+		entityFetchRequest.ZoneID = zoneID
+	}
+	if entityType != "" {
+		entityFetchRequest.Type = &entityType
+	}
+	if entityID != "" {
+		entityFetchRequest.ID = &entityID
+	}
+	stream, err := client.FetchEntities(context.Background(), entityFetchRequest)
+	if err != nil {
+		return nil, err
+	}
+	entities := []azmodelpap.Entity{}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entity, err := azapiv1pap.MapGrpcEntityResponseToAgentEntity(response)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, *entity)
+	}
+	return entities, nil
+}
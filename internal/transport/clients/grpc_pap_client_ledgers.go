@@ -22,6 +22,7 @@ import (
 
 	azapiv1pap "github.com/permguard/permguard/internal/agents/services/pap/endpoints/api/v1"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
 )
 
@@ -61,6 +62,48 @@ func (c *GrpcPAPClient) UpdateLedger(ledger *azmodelpap.Ledger) (*azmodelpap.Led
 	return azapiv1pap.MapGrpcLedgerResponseToAgentLedger(updatedLedger)
 }
 
+// UpdateLedgerCandidate updates the candidate ref of a ledger evaluated in shadow mode.
+func (c *GrpcPAPClient) UpdateLedgerCandidate(zoneID int64, ledgerID string, candidateRef string) (*azmodelpap.Ledger, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	ledger, err := client.UpdateLedgerCandidate(context.Background(), &azapiv1pap.LedgerCandidateUpdateRequest{ZoneID: zoneID, LedgerID: ledgerID, CandidateRef: candidateRef})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcLedgerResponseToAgentLedger(ledger)
+}
+
+// PromoteLedgerCandidate promotes the candidate ref of a ledger to its active ref.
+func (c *GrpcPAPClient) PromoteLedgerCandidate(zoneID int64, ledgerID string) (*azmodelpap.Ledger, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	ledger, err := client.PromoteLedgerCandidate(context.Background(), &azapiv1pap.LedgerCandidatePromoteRequest{ZoneID: zoneID, LedgerID: ledgerID})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcLedgerResponseToAgentLedger(ledger)
+}
+
+// UpdateLedgerProtection updates the number of approvals required to move the ref of a ledger.
+func (c *GrpcPAPClient) UpdateLedgerProtection(zoneID int64, ledgerID string, requiredApprovals int32) (*azmodelpap.Ledger, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	ledger, err := client.UpdateLedgerProtection(context.Background(), &azapiv1pap.LedgerProtectionUpdateRequest{ZoneID: zoneID, LedgerID: ledgerID, RequiredApprovals: requiredApprovals})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcLedgerResponseToAgentLedger(ledger)
+}
+
 // DeleteLedger deletes an ledger.
 func (c *GrpcPAPClient) DeleteLedger(zoneID int64, ledgerID string) (*azmodelpap.Ledger, error) {
 	client, conn, err := c.createGRPCClient()
@@ -92,10 +135,16 @@ func (c *GrpcPAPClient) FetchLedgersByName(page int32, pageSize int32, zoneID in
 
 // FetchLedgersBy returns all ledgers filtering by ledger id and name.
 func (c *GrpcPAPClient) FetchLedgersBy(page int32, pageSize int32, zoneID int64, ledgerID string, kind string, name string) ([]azmodelpap.Ledger, error) {
+	ledgers, _, err := c.FetchLedgersByQuery(page, pageSize, zoneID, ledgerID, kind, name, nil)
+	return ledgers, err
+}
+
+// FetchLedgersByQuery returns all ledgers filtering by ledger id and name and the fetch query along with the cursor of the next page.
+func (c *GrpcPAPClient) FetchLedgersByQuery(page int32, pageSize int32, zoneID int64, ledgerID string, kind string, name string, query *azmodels.FetchQuery) ([]azmodelpap.Ledger, string, error) {
 	client, conn, err := c.createGRPCClient()
 	defer conn.Close()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	ledgerFetchRequest := &azapiv1pap.LedgerFetchRequest{}
 	ledgerFetchRequest.Page = &page
@@ -112,9 +161,10 @@ func (c *GrpcPAPClient) FetchLedgersBy(page int32, pageSize int32, zoneID int64,
 	if ledgerID != "" {
 		ledgerFetchRequest.LedgerID = &ledgerID
 	}
+	ledgerFetchRequest.Query = azapiv1pap.MapAgentFetchQueryToGrpcFetchQuery(query)
 	stream, err := client.FetchLedgers(context.Background(), ledgerFetchRequest)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	ledgers := []azmodelpap.Ledger{}
 	for {
@@ -123,13 +173,13 @@ func (c *GrpcPAPClient) FetchLedgersBy(page int32, pageSize int32, zoneID int64,
 			break
 		}
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		ledger, err := azapiv1pap.MapGrpcLedgerResponseToAgentLedger(response)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		ledgers = append(ledgers, *ledger)
 	}
-	return ledgers, nil
+	return ledgers, nextFetchCursor(stream.Trailer()), nil
 }
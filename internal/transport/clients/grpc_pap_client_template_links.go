@@ -0,0 +1,99 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"io"
+
+	azapiv1pap "github.com/permguard/permguard/internal/agents/services/pap/endpoints/api/v1"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
+)
+
+// CreateTemplateLink creates a new template link.
+func (c *GrpcPAPClient) CreateTemplateLink(link *azmodelpap.TemplateLink) (*azmodelpap.TemplateLink, error) {
+	if link == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, "invalid template link instance")
+	}
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	createdLink, err := client.CreateTemplateLink(context.Background(), azapiv1pap.MapAgentTemplateLinkToGrpcTemplateLinkCreateRequest(link))
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcTemplateLinkResponseToAgentTemplateLink(createdLink)
+}
+
+// DeleteTemplateLink deletes a template link.
+func (c *GrpcPAPClient) DeleteTemplateLink(zoneID int64, ledgerID string, linkID string) (*azmodelpap.TemplateLink, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	link, err := client.DeleteTemplateLink(context.Background(), &azapiv1pap.TemplateLinkDeleteRequest{ZoneID: zoneID, LedgerID: ledgerID, LinkID: linkID})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pap.MapGrpcTemplateLinkResponseToAgentTemplateLink(link)
+}
+
+// FetchTemplateLinksBy returns all template links of a ledger filtering by template id and link id.
+func (c *GrpcPAPClient) FetchTemplateLinksBy(page int32, pageSize int32, zoneID int64, ledgerID string, templateID string, linkID string) ([]azmodelpap.TemplateLink, error) {
+	client, conn, err := c.createGRPCClient()
+	defer conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	linkFetchRequest := &azapiv1pap.TemplateLinkFetchRequest{}
+	linkFetchRequest.Page = &page
+	linkFetchRequest.PageSize = &pageSize
+	if zoneID > 0 {
+		linkFetchRequest.ZoneID = zoneID
+	}
+	linkFetchRequest.LedgerID = ledgerID
+	if templateID != "" {
+		linkFetchRequest.TemplateID = &templateID
+	}
+	if linkID != "" {
+		linkFetchRequest.LinkID = &linkID
+	}
+	stream, err := client.FetchTemplateLinks(context.Background(), linkFetchRequest)
+	if err != nil {
+		return nil, err
+	}
+	links := []azmodelpap.TemplateLink{}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		link, err := azapiv1pap.MapGrpcTemplateLinkResponseToAgentTemplateLink(response)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, *link)
+	}
+	return links, nil
+}
@@ -18,7 +18,6 @@ package clients
 
 import (
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	azapiv1pdp "github.com/permguard/permguard/internal/agents/services/pdp/endpoints/api/v1"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
@@ -27,21 +26,32 @@ import (
 // GrpcPDPClient is a gRPC client for the PDP service.
 type GrpcPDPClient struct {
 	target string
+	config *GrpcClientConfig
 }
 
 // NewGrpcPDPClient creates a new gRPC client for the PDP service.
 func NewGrpcPDPClient(target string) (*GrpcPDPClient, error) {
+	return NewGrpcPDPClientWithConfig(target, nil)
+}
+
+// NewGrpcPDPClientWithConfig creates a new gRPC client for the PDP service with the transport configuration.
+func NewGrpcPDPClientWithConfig(target string, config *GrpcClientConfig) (*GrpcPDPClient, error) {
 	if target == "" {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, "target is required")
 	}
 	return &GrpcPDPClient{
 		target: target,
+		config: config,
 	}, nil
 }
 
 // createGRPCClient creates a new gRPC client.
 func (c *GrpcPDPClient) createGRPCClient() (azapiv1pdp.V1PDPServiceClient, *grpc.ClientConn, error) {
-	conn, err := grpc.NewClient(c.target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts, err := buildDialOptions(c.config)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.NewClient(c.target, opts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,78 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+
+	azapiv1pdp "github.com/permguard/permguard/internal/agents/services/pdp/endpoints/api/v1"
+	azmodelpdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// SubjectSearch searches the subjects allowed to perform the action on the resource.
+func (c *GrpcPDPClient) SubjectSearch(request *azmodelpdp.SubjectSearchRequest) (*azmodelpdp.SubjectSearchResponse, error) {
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	req, err := azapiv1pdp.MapAgentSubjectSearchRequestToGrpcSubjectSearchRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.SubjectSearch(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pdp.MapGrpcSubjectSearchResponseToAgentSubjectSearchResponse(response)
+}
+
+// ResourceSearch searches the resources on which the subject is allowed to perform the action.
+func (c *GrpcPDPClient) ResourceSearch(request *azmodelpdp.ResourceSearchRequest) (*azmodelpdp.ResourceSearchResponse, error) {
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	req, err := azapiv1pdp.MapAgentResourceSearchRequestToGrpcResourceSearchRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.ResourceSearch(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pdp.MapGrpcResourceSearchResponseToAgentResourceSearchResponse(response)
+}
+
+// ActionSearch searches the actions the subject is allowed to perform on the resource.
+func (c *GrpcPDPClient) ActionSearch(request *azmodelpdp.ActionSearchRequest) (*azmodelpdp.ActionSearchResponse, error) {
+	client, conn, err := c.createGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	req, err := azapiv1pdp.MapAgentActionSearchRequestToGrpcActionSearchRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.ActionSearch(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pdp.MapGrpcActionSearchResponseToAgentActionSearchResponse(response)
+}
@@ -21,4 +21,6 @@ const (
 	ZoneIDKey = "zoneid"
 	// LedgerIDKey is the ledger ID key.
 	LedgerIDKey = "ledgerid"
+	// CallerKey is the key of the authenticated caller.
+	CallerKey = "caller"
 )
@@ -16,6 +16,10 @@
 
 package storage
 
+import (
+	"context"
+)
+
 // CentralStorage is the interface for the central storage.
 type CentralStorage interface {
 	// GetZAPCentralStorage returns the ZAP central storage.
@@ -24,4 +28,6 @@ type CentralStorage interface {
 	GetPAPCentralStorage() (PAPCentralStorage, error)
 	// GetPDPCentralStorage returns the PDP central storage.
 	GetPDPCentralStorage() (PDPCentralStorage, error)
+	// CheckHealth checks the storage is reachable and up to date.
+	CheckHealth(ctx context.Context) error
 }
@@ -48,6 +48,10 @@ type ZAPCentralStorage interface {
 	DeleteIdentity(zoneID int64, identityID string) (*azmodelszap.Identity, error)
 	// FetchIdentities gets all identities.
 	FetchIdentities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Identity, error)
+	// CountIdentities counts the identities matching the fields.
+	CountIdentities(zoneID int64, fields map[string]any) (int64, error)
+	// UpsertIdentities creates or updates identities in bulk.
+	UpsertIdentities(options *azmodelszap.BulkUpsertOptions, identities []azmodelszap.Identity) (*azmodelszap.BulkUpsertReport, error)
 
 	// CreateTenant creates a new tenant.
 	CreateTenant(tenant *azmodelszap.Tenant) (*azmodelszap.Tenant, error)
@@ -57,4 +61,22 @@ type ZAPCentralStorage interface {
 	DeleteTenant(zoneID int64, tenantID string) (*azmodelszap.Tenant, error)
 	// FetchTenants gets all tenants.
 	FetchTenants(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Tenant, error)
+	// UpsertTenants creates or updates tenants in bulk.
+	UpsertTenants(options *azmodelszap.BulkUpsertOptions, tenants []azmodelszap.Tenant) (*azmodelszap.BulkUpsertReport, error)
+	// CreateWebhook creates a new webhook.
+	CreateWebhook(webhook *azmodelszap.Webhook) (*azmodelszap.Webhook, error)
+	// UpdateWebhook updates a webhook.
+	UpdateWebhook(webhook *azmodelszap.Webhook) (*azmodelszap.Webhook, error)
+	// DeleteWebhook deletes a webhook.
+	DeleteWebhook(zoneID int64, webhookID string) (*azmodelszap.Webhook, error)
+	// FetchWebhooks gets all webhooks.
+	FetchWebhooks(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.Webhook, error)
+	// FetchWebhookDeliveries gets the delivery history of the webhooks.
+	FetchWebhookDeliveries(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelszap.WebhookDelivery, error)
+	// RequeueWebhookDelivery requeues a dead webhook delivery.
+	RequeueWebhookDelivery(zoneID int64, deliveryID int64) (*azmodelszap.WebhookDelivery, error)
+	// FetchDueWebhookDeliveries gets the pending webhook deliveries which are due.
+	FetchDueWebhookDeliveries(limit int32) ([]azmodelszap.WebhookDispatch, error)
+	// RecordWebhookDeliveryAttempt records the outcome of a webhook delivery attempt.
+	RecordWebhookDeliveryAttempt(deliveryID int64, status string, statusCode int32, lastError string, retryDelaySeconds int64) (*azmodelszap.WebhookDelivery, error)
 }
@@ -17,6 +17,8 @@
 package storage
 
 import (
+	"context"
+
 	azruntime "github.com/permguard/permguard/pkg/agents/runtime"
 )
 
@@ -49,3 +51,17 @@ func (s StorageConnector) GetCentralStorage(storageKind StorageKind, runtimeCote
 	}
 	return factory.CreateCentralStorage(storageCtx)
 }
+
+// CheckHealth checks the health of the storages available to the connector.
+func (s StorageConnector) CheckHealth(ctx context.Context, runtimeCotext azruntime.RuntimeContext) error {
+	for storageKind := range s.factories {
+		centralStorage, err := s.GetCentralStorage(storageKind, runtimeCotext)
+		if err != nil {
+			return err
+		}
+		if err := centralStorage.CheckHealth(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -29,10 +29,38 @@ type PAPCentralStorage interface {
 	CreateLedger(ledger *azmodelspap.Ledger) (*azmodelspap.Ledger, error)
 	// UpdateLedger updates an ledger.
 	UpdateLedger(ledger *azmodelspap.Ledger) (*azmodelspap.Ledger, error)
+	// UpdateLedgerCandidate updates the candidate ref of a ledger evaluated in shadow mode.
+	UpdateLedgerCandidate(zoneID int64, ledgerID string, candidateRef string) (*azmodelspap.Ledger, error)
+	// PromoteLedgerCandidate promotes the candidate ref of a ledger to its active ref.
+	PromoteLedgerCandidate(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error)
+	// UpdateLedgerProtection updates the number of approvals required to move the ref of a ledger, zero disables the protection.
+	UpdateLedgerProtection(zoneID int64, ledgerID string, requiredApprovals int32) (*azmodelspap.Ledger, error)
 	// DeleteLedger deletes an ledger.
 	DeleteLedger(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error)
 	// FetchLedgers gets all ledgers.
 	FetchLedgers(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelspap.Ledger, error)
+	// CreateEntity creates a new entity, the entity is validated against the schema of the ledger when the ledger id is provided.
+	CreateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error)
+	// UpdateEntity updates an entity, the entity is validated against the schema of the ledger when the ledger id is provided.
+	UpdateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error)
+	// UpsertEntities creates or replaces a set of entities, the entities are validated against the schema of the ledger when the ledger id is provided.
+	UpsertEntities(zoneID int64, ledgerID string, entities []azmodelspap.Entity) ([]azmodelspap.Entity, error)
+	// DeleteEntity deletes an entity.
+	DeleteEntity(zoneID int64, entityType, entityID string) (*azmodelspap.Entity, error)
+	// FetchEntities gets all entities.
+	FetchEntities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelspap.Entity, error)
+	// CreateTemplateLink creates a new template link, the link is validated against the templates of the ledger.
+	CreateTemplateLink(link *azmodelspap.TemplateLink) (*azmodelspap.TemplateLink, error)
+	// DeleteTemplateLink deletes a template link.
+	DeleteTemplateLink(zoneID int64, ledgerID string, linkID string) (*azmodelspap.TemplateLink, error)
+	// FetchTemplateLinks gets all template links of a ledger.
+	FetchTemplateLinks(page int32, pageSize int32, zoneID int64, ledgerID string, fields map[string]any) ([]azmodelspap.TemplateLink, error)
+	// FetchChangeRequests gets all change requests of a ledger.
+	FetchChangeRequests(page int32, pageSize int32, zoneID int64, ledgerID string, fields map[string]any) ([]azmodelspap.ChangeRequest, error)
+	// ApproveChangeRequest approves a pending change request, the ledger ref is moved once the required approvals are reached.
+	ApproveChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, comment string) (*azmodelspap.ChangeRequest, error)
+	// RejectChangeRequest rejects a pending change request.
+	RejectChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, comment string) (*azmodelspap.ChangeRequest, error)
 	// OnPullHandleRequestCurrentState handles the request for the current state.
 	OnPullHandleRequestCurrentState(handlerCtx *notpstatemachines.HandlerContext, statePacket *notpsmpackets.StatePacket, packets []notppackets.Packetable) (*notpstatemachines.HostHandlerReturn, error)
 	// OnPullSendNotifyCurrentStateResponse notifies the current state.
@@ -17,11 +17,17 @@
 package storage
 
 import (
+	"context"
+
 	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
 )
 
 // PDPCentralStorage is the interface for the PDP central storage.
 type PDPCentralStorage interface {
 	// AuthorizationCheck checks if the request is authorized.
-	AuthorizationCheck(request *azmodelspdp.AuthorizationCheckRequest) ([]azmodelspdp.EvaluationResponse, error)
+	AuthorizationCheck(ctx context.Context, request *azmodelspdp.AuthorizationCheckRequest) ([]azmodelspdp.EvaluationResponse, error)
+	// FetchSubjectCandidates fetches the identities of the zone as subject candidates.
+	FetchSubjectCandidates(ctx context.Context, page int32, pageSize int32, zoneID int64) ([]azmodelspdp.Subject, error)
+	// PartialEvaluation partially evaluates the authorization for the resources of a type.
+	PartialEvaluation(ctx context.Context, request *azmodelspdp.PartialEvaluationRequest) (*azmodelspdp.PartialEvaluationResponse, error)
 }
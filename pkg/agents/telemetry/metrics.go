@@ -0,0 +1,214 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// metricsNamespace is the namespace of the metrics.
+	metricsNamespace = "permguard"
+
+	// DecisionAllow is the label value of an allowed decision.
+	DecisionAllow = "allow"
+	// DecisionDeny is the label value of a denied decision.
+	DecisionDeny = "deny"
+	// DecisionError is the label value of a decision that failed to be evaluated.
+	DecisionError = "error"
+
+	// ShadowAgreement is the label value of a shadow evaluation matching the active decision.
+	ShadowAgreement = "agreement"
+	// ShadowDisagreement is the label value of a shadow evaluation differing from the active decision.
+	ShadowDisagreement = "disagreement"
+	// ShadowError is the label value of a shadow evaluation that failed to be evaluated.
+	ShadowError = "error"
+	// ShadowDropped is the label value of a shadow evaluation dropped as the shadow queue is full.
+	ShadowDropped = "dropped"
+
+	// NOTPOperationPush is the label value of a notp push.
+	NOTPOperationPush = "push"
+	// NOTPOperationPull is the label value of a notp pull.
+	NOTPOperationPull = "pull"
+)
+
+var (
+	// registry is the registry of the metrics.
+	registry = prometheus.NewRegistry()
+
+	// grpcRequestsTotal counts the grpc requests.
+	grpcRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Total number of grpc requests by service, method and status code.",
+	}, []string{"service", "method", "code"})
+	// grpcRequestDuration observes the grpc requests latency.
+	grpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the grpc requests by service and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	// pdpDecisionsTotal counts the pdp decisions.
+	pdpDecisionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pdp",
+		Name:      "decisions_total",
+		Help:      "Total number of pdp decisions by zone, ledger and decision.",
+	}, []string{"zone_id", "ledger_id", "decision"})
+	// pdpEvaluationDuration observes the pdp evaluations latency.
+	pdpEvaluationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pdp",
+		Name:      "evaluation_duration_seconds",
+		Help:      "Latency of the single pdp evaluations by zone and ledger.",
+		Buckets:   []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"zone_id", "ledger_id"})
+	// pdpPolicyStoreLoadDuration observes the time spent loading the policy store.
+	pdpPolicyStoreLoadDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pdp",
+		Name:      "policy_store_load_duration_seconds",
+		Help:      "Time spent loading the policy store by zone and ledger.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"zone_id", "ledger_id"})
+	// pdpShadowEvaluationsTotal counts the shadow evaluations of the candidate refs.
+	pdpShadowEvaluationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pdp",
+		Name:      "shadow_evaluations_total",
+		Help:      "Total number of shadow evaluations of the candidate refs by zone, ledger and outcome.",
+	}, []string{"zone_id", "ledger_id", "outcome"})
+
+	// notpOperationsTotal counts the notp operations.
+	notpOperationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "notp",
+		Name:      "operations_total",
+		Help:      "Total number of notp push and pull operations by zone.",
+	}, []string{"zone_id", "operation"})
+	// notpObjectsTotal counts the objects transferred by notp.
+	notpObjectsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "notp",
+		Name:      "objects_total",
+		Help:      "Total number of objects transferred by notp by zone and operation.",
+	}, []string{"zone_id", "operation"})
+	// notpObjectBytesTotal counts the bytes of the objects transferred by notp.
+	notpObjectBytesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "notp",
+		Name:      "object_bytes_total",
+		Help:      "Total size in bytes of the objects transferred by notp by zone and operation.",
+	}, []string{"zone_id", "operation"})
+
+	// storageErrorsTotal counts the storage errors.
+	storageErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "storage",
+		Name:      "errors_total",
+		Help:      "Total number of storage errors by storage and error kind.",
+	}, []string{"storage", "kind"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		grpcRequestsTotal,
+		grpcRequestDuration,
+		pdpDecisionsTotal,
+		pdpEvaluationDuration,
+		pdpPolicyStoreLoadDuration,
+		pdpShadowEvaluationsTotal,
+		notpOperationsTotal,
+		notpObjectsTotal,
+		notpObjectBytesTotal,
+		storageErrorsTotal,
+	)
+}
+
+// zoneLabel returns the label value for the zone.
+func zoneLabel(zoneID int64) string {
+	return strconv.FormatInt(zoneID, 10)
+}
+
+// MetricsHandler returns the http handler exposing the metrics.
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// ObserveGrpcRequest records a served grpc request.
+func ObserveGrpcRequest(service, method, code string, duration time.Duration) {
+	grpcRequestsTotal.WithLabelValues(service, method, code).Inc()
+	grpcRequestDuration.WithLabelValues(service, method).Observe(duration.Seconds())
+}
+
+// ObservePDPDecision records a pdp decision.
+func ObservePDPDecision(zoneID int64, ledgerID, decision string) {
+	pdpDecisionsTotal.WithLabelValues(zoneLabel(zoneID), ledgerID, decision).Inc()
+}
+
+// ObservePDPEvaluation records the latency of a pdp evaluation.
+func ObservePDPEvaluation(zoneID int64, ledgerID string, duration time.Duration) {
+	pdpEvaluationDuration.WithLabelValues(zoneLabel(zoneID), ledgerID).Observe(duration.Seconds())
+}
+
+// ObservePDPPolicyStoreLoad records the time spent loading a policy store.
+func ObservePDPPolicyStoreLoad(zoneID int64, ledgerID string, duration time.Duration) {
+	pdpPolicyStoreLoadDuration.WithLabelValues(zoneLabel(zoneID), ledgerID).Observe(duration.Seconds())
+}
+
+// ObservePDPShadowEvaluation records the outcome of a shadow evaluation.
+func ObservePDPShadowEvaluation(zoneID int64, ledgerID, outcome string) {
+	pdpShadowEvaluationsTotal.WithLabelValues(zoneLabel(zoneID), ledgerID, outcome).Inc()
+}
+
+// ObserveNOTPOperation records a notp push or pull.
+func ObserveNOTPOperation(zoneID int64, operation string) {
+	notpOperationsTotal.WithLabelValues(zoneLabel(zoneID), operation).Inc()
+}
+
+// ObserveNOTPObject records an object transferred by notp.
+func ObserveNOTPObject(zoneID int64, operation string, size int) {
+	notpObjectsTotal.WithLabelValues(zoneLabel(zoneID), operation).Inc()
+	notpObjectBytesTotal.WithLabelValues(zoneLabel(zoneID), operation).Add(float64(size))
+}
+
+// ObserveStorageError records a storage error.
+func ObserveStorageError(storage, kind string) {
+	storageErrorsTotal.WithLabelValues(storage, kind).Inc()
+}
+
+// RegisterDBStats registers the connection stats of the input database.
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	err := registry.Register(collectors.NewDBStatsCollector(db, dbName))
+	if _, ok := err.(prometheus.AlreadyRegisteredError); ok {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMetricsHandler tests the metrics handler.
+func TestMetricsHandler(t *testing.T) {
+	assert := assert.New(t)
+
+	ObserveGrpcRequest("PDP", "/policydecisionpoint.V1PDPService/AuthorizationCheck", "OK", 10*time.Millisecond)
+	ObservePDPDecision(273165098782, "fd1ac44e4afa4fc4beec622494d3175a", DecisionAllow)
+	ObservePDPEvaluation(273165098782, "fd1ac44e4afa4fc4beec622494d3175a", time.Millisecond)
+	ObservePDPPolicyStoreLoad(273165098782, "fd1ac44e4afa4fc4beec622494d3175a", time.Millisecond)
+	ObservePDPShadowEvaluation(273165098782, "fd1ac44e4afa4fc4beec622494d3175a", ShadowDisagreement)
+	ObserveNOTPOperation(273165098782, NOTPOperationPush)
+	ObserveNOTPObject(273165098782, NOTPOperationPush, 128)
+	ObserveStorageError("SQLITE", "generic")
+
+	recorder := httptest.NewRecorder()
+	MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(http.StatusOK, recorder.Code, "status code should be ok")
+	body := recorder.Body.String()
+	for _, name := range []string{
+		"permguard_grpc_requests_total",
+		"permguard_grpc_request_duration_seconds",
+		"permguard_pdp_decisions_total",
+		"permguard_pdp_evaluation_duration_seconds",
+		"permguard_pdp_policy_store_load_duration_seconds",
+		"permguard_pdp_shadow_evaluations_total",
+		"permguard_notp_operations_total",
+		"permguard_notp_objects_total",
+		"permguard_notp_object_bytes_total",
+		"permguard_storage_errors_total",
+	} {
+		assert.Contains(body, name, "metrics should contain %s", name)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const (
+	// tracerName is the name of the tracer.
+	tracerName = "github.com/permguard/permguard"
+	// tracingServiceName is the service name of the traces.
+	tracingServiceName = "permguard"
+
+	// TracingExporterNone disables the tracing.
+	TracingExporterNone = "none"
+	// TracingExporterOTLP exports the traces to an otlp grpc collector.
+	TracingExporterOTLP = "otlp"
+	// TracingExporterStdout writes the traces to the standard output.
+	TracingExporterStdout = "stdout"
+	// TracingExporterFile writes the traces to a file.
+	TracingExporterFile = "file"
+)
+
+// TracingConfig holds the configuration of the tracing.
+type TracingConfig struct {
+	Exporter     string
+	OTLPEndpoint string
+	OTLPInsecure bool
+	FilePath     string
+	SampleRatio  float64
+	Host         string
+}
+
+// IsValidTracingExporter returns true if the input exporter is supported.
+func IsValidTracingExporter(exporter string) bool {
+	switch strings.ToLower(exporter) {
+	case TracingExporterNone, TracingExporterOTLP, TracingExporterStdout, TracingExporterFile:
+		return true
+	default:
+		return false
+	}
+}
+
+// SetupTracing configures the global tracer provider and returns the function to shut it down.
+func SetupTracing(ctx context.Context, config TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	exporterKind := strings.ToLower(config.Exporter)
+	if exporterKind == "" || exporterKind == TracingExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+	var closer io.Closer
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterKind {
+	case TracingExporterOTLP:
+		opts := []otlptracegrpc.Option{}
+		if config.OTLPEndpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(config.OTLPEndpoint))
+		}
+		if config.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case TracingExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case TracingExporterFile:
+		if config.FilePath == "" {
+			return nil, errors.New("telemetry: tracing file path is required")
+		}
+		var file *os.File
+		file, err = os.OpenFile(config.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		closer = file
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, fmt.Errorf("telemetry: invalid tracing exporter %s", config.Exporter)
+	}
+	if err != nil {
+		if closer != nil {
+			_ = closer.Close()
+		}
+		return nil, err
+	}
+	attrs := []attribute.KeyValue{semconv.ServiceName(tracingServiceName)}
+	if config.Host != "" {
+		attrs = append(attrs, semconv.ServiceInstanceID(config.Host))
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attrs...)),
+	)
+	otel.SetTracerProvider(provider)
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			err = errors.Join(err, closer.Close())
+		}
+		return err
+	}, nil
+}
+
+// StartSpan starts a new span as a child of the span in the input context.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the input error, if any, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// GrpcServerStatsHandler returns the grpc server option tracing the served requests.
+func GrpcServerStatsHandler() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler())
+}
+
+// GrpcClientStatsHandler returns the grpc dial option tracing the client requests and propagating the trace context.
+func GrpcClientStatsHandler() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler())
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSetupTracingWithFileExporter tests the tracing with the file exporter.
+func TestSetupTracingWithFileExporter(t *testing.T) {
+	assert := assert.New(t)
+
+	filePath := filepath.Join(t.TempDir(), "traces.json")
+	shutdown, err := SetupTracing(context.Background(), TracingConfig{
+		Exporter:    TracingExporterFile,
+		FilePath:    filePath,
+		SampleRatio: 1,
+	})
+	assert.Nil(err, "tracing should be setup")
+
+	ctx, parent := StartSpan(context.Background(), "pdp.authorization-check")
+	_, child := StartSpan(ctx, "pdp.evaluation")
+	EndSpan(child, errors.New("evaluation failed"))
+	EndSpan(parent, nil)
+	assert.Equal(parent.SpanContext().TraceID(), child.SpanContext().TraceID(), "child span should share the parent trace")
+
+	assert.Nil(shutdown(context.Background()), "tracing should be shut down")
+	data, err := os.ReadFile(filePath)
+	assert.Nil(err, "traces file should be readable")
+	assert.Contains(string(data), "pdp.authorization-check", "traces should contain the parent span")
+	assert.Contains(string(data), "pdp.evaluation", "traces should contain the child span")
+}
+
+// TestSetupTracingWithInvalidExporter tests the tracing with an invalid exporter.
+func TestSetupTracingWithInvalidExporter(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(IsValidTracingExporter(TracingExporterNone), "none exporter should be valid")
+	assert.False(IsValidTracingExporter("zipkin"), "zipkin exporter should be invalid")
+	_, err := SetupTracing(context.Background(), TracingConfig{Exporter: "zipkin"})
+	assert.NotNil(err, "tracing should not be setup")
+}
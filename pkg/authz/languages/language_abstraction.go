@@ -20,6 +20,8 @@ import (
 	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
 	azztasmanifests "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/manifests"
 	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
+	azlint "github.com/permguard/permguard/pkg/authz/lint"
+	azresiduals "github.com/permguard/permguard/pkg/authz/residuals"
 )
 
 // LanguageSpecification is the interface for the language specification.
@@ -62,6 +64,57 @@ type LanguageAbastraction interface {
 	CreateSchemaContentBytes(blocks []byte) ([]byte, string, error)
 	// ConvertBytesToFrontendLanguage converts bytes to the frontend language.
 	ConvertBytesToFrontendLanguage(langID, langVersionID, langTypeID uint32, content []byte) ([]byte, error)
-	// AuthorizationCheck checks the authorization.
+	// AuthorizationCheck checks the authorization, it must be safe for concurrent use as the evaluations of a request run concurrently over the same policy store, which is only read.
 	AuthorizationCheck(contextID string, policyStore *azauthzen.PolicyStore, authzCtx *azauthzen.AuthorizationModel) (*azauthzen.AuthorizationDecision, error)
 }
+
+// LanguagePartialEvaluator is the optional interface of the language abstractions supporting the partial evaluation.
+type LanguagePartialEvaluator interface {
+	// PartialAuthorizationCheck partially evaluates the authorization when only the type of the resource is known.
+	PartialAuthorizationCheck(contextID string, policyStore *azauthzen.PolicyStore, authzCtx *azauthzen.AuthorizationModel) (*azresiduals.PartialDecision, error)
+}
+
+// LanguageDecisionExplainer is the optional interface of the language abstractions supporting the explanation of the decisions.
+type LanguageDecisionExplainer interface {
+	// ExplainAuthorizationCheck checks the authorization and returns the ids of the policies determining the decision, it must be safe for concurrent use as AuthorizationCheck.
+	ExplainAuthorizationCheck(contextID string, policyStore *azauthzen.PolicyStore, authzCtx *azauthzen.AuthorizationModel) (*azauthzen.AuthorizationDecision, []string, error)
+}
+
+// LanguageSubjectResolver is the optional interface of the language abstractions mapping the subjects to their entities.
+type LanguageSubjectResolver interface {
+	// GetSubjectEntityType returns the entity type of the subject type, false if the subject type isn't an identity type of the language.
+	GetSubjectEntityType(subjectType string) (string, bool)
+}
+
+// LanguageEntitiesValidator is the optional interface of the language abstractions supporting the validation of the entities.
+type LanguageEntitiesValidator interface {
+	// ValidateEntities validates the entities against the schema of the policy store.
+	ValidateEntities(policyStore *azauthzen.PolicyStore, entities []map[string]any) error
+}
+
+// TemplateLinkEntity is the entity bound to a slot of a policy template.
+type TemplateLinkEntity struct {
+	Type string
+	ID   string
+}
+
+// TemplateLink binds the slots of a policy template to concrete entities.
+type TemplateLink struct {
+	LinkID     string
+	TemplateID string
+	Slots      map[string]TemplateLinkEntity
+}
+
+// LanguageTemplateLinker is the optional interface of the language abstractions supporting the policy templates.
+type LanguageTemplateLinker interface {
+	// ValidateTemplateLink validates the template link against the templates of the policy store.
+	ValidateTemplateLink(policyStore *azauthzen.PolicyStore, link *TemplateLink) error
+	// LinkTemplates adds to the policy store the policies instantiated from the template links.
+	LinkTemplates(policyStore *azauthzen.PolicyStore, links []TemplateLink) error
+}
+
+// LanguageLinter is the optional interface of the language abstractions supporting the static analysis of the policies.
+type LanguageLinter interface {
+	// LintPolicies statically analyses the policy sources against the schema, the schema is nil if missing.
+	LintPolicies(sources []azlint.Source, schema *azlint.Source, config *azlint.Config) ([]azlint.Finding, error)
+}
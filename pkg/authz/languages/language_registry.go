@@ -0,0 +1,107 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package languages
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// defaultLanguageRegistry is the registry shared by the cli and the server plugins.
+var defaultLanguageRegistry = NewLanguageRegistry()
+
+// DefaultLanguageRegistry returns the registry shared by the cli and the server plugins.
+func DefaultLanguageRegistry() *LanguageRegistry {
+	return defaultLanguageRegistry
+}
+
+// LanguageRegistry is the registry of the language abstractions.
+type LanguageRegistry struct {
+	mutex     sync.RWMutex
+	languages map[string]LanguageAbastraction
+	backends  map[uint32]LanguageAbastraction
+}
+
+// NewLanguageRegistry creates a new language registry.
+func NewLanguageRegistry() *LanguageRegistry {
+	return &LanguageRegistry{
+		languages: map[string]LanguageAbastraction{},
+		backends:  map[uint32]LanguageAbastraction{},
+	}
+}
+
+// Register registers the language abstraction, a language registered twice is replaced.
+func (r *LanguageRegistry) Register(langAbs LanguageAbastraction) error {
+	if langAbs == nil || langAbs.GetLanguageSpecification() == nil {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "language abstraction is nil")
+	}
+	langSpec := langAbs.GetLanguageSpecification()
+	language := langSpec.GetLanguage()
+	if len(strings.TrimSpace(language)) == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "language name is required")
+	}
+	backendID := langSpec.GetBackendLanguageID()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if registered, exists := r.backends[backendID]; exists && registered.GetLanguageSpecification().GetLanguage() != language {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, fmt.Sprintf("backend language id %d is already registered by %s", backendID, registered.GetLanguageSpecification().GetLanguage()))
+	}
+	r.languages[language] = langAbs
+	r.backends[backendID] = langAbs
+	return nil
+}
+
+// GetLanguageAbastraction gets the language abstraction for the input language.
+func (r *LanguageRegistry) GetLanguageAbastraction(language string) (LanguageAbastraction, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	langAbs, exists := r.languages[language]
+	if !exists {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrConfigurationGeneric, fmt.Sprintf("%s is an invalid language", language))
+	}
+	return langAbs, nil
+}
+
+// GetLanguageAbastractionByID gets the language abstraction for the language and language version ids recorded in the object headers.
+func (r *LanguageRegistry) GetLanguageAbastractionByID(langID, langVersionID uint32) (LanguageAbastraction, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	langAbs, exists := r.backends[langID]
+	if !exists {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, fmt.Sprintf("language id %d is not registered", langID))
+	}
+	if langAbs.GetLanguageSpecification().GetLanguageVersionID() != langVersionID {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, fmt.Sprintf("language version id %d is not supported by %s", langVersionID, langAbs.GetLanguageSpecification().GetLanguage()))
+	}
+	return langAbs, nil
+}
+
+// GetLanguages returns the names of the registered languages.
+func (r *LanguageRegistry) GetLanguages() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	languages := make([]string, 0, len(r.languages))
+	for language := range r.languages {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
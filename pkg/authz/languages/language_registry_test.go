@@ -0,0 +1,85 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package languages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// fakeLanguageSpecification is a language specification for testing.
+type fakeLanguageSpecification struct {
+	LanguageSpecification
+	language          string
+	languageVersionID uint32
+	backendLanguageID uint32
+}
+
+// GetLanguage returns the name of the language.
+func (s *fakeLanguageSpecification) GetLanguage() string { return s.language }
+
+// GetLanguageVersionID returns the id of the language version.
+func (s *fakeLanguageSpecification) GetLanguageVersionID() uint32 { return s.languageVersionID }
+
+// GetBackendLanguageID returns the id of the backend language.
+func (s *fakeLanguageSpecification) GetBackendLanguageID() uint32 { return s.backendLanguageID }
+
+// fakeLanguageAbstraction is a language abstraction for testing.
+type fakeLanguageAbstraction struct {
+	LanguageAbastraction
+	spec *fakeLanguageSpecification
+}
+
+// GetLanguageSpecification returns the specification for the language.
+func (a *fakeLanguageAbstraction) GetLanguageSpecification() LanguageSpecification { return a.spec }
+
+// newFakeLanguageAbstraction creates a fake language abstraction.
+func newFakeLanguageAbstraction(language string, backendID, versionID uint32) *fakeLanguageAbstraction {
+	return &fakeLanguageAbstraction{spec: &fakeLanguageSpecification{language: language, backendLanguageID: backendID, languageVersionID: versionID}}
+}
+
+// TestLanguageRegistry tests the registration and the lookup of the languages.
+func TestLanguageRegistry(t *testing.T) {
+	assert := assert.New(t)
+	registry := NewLanguageRegistry()
+	cedar := newFakeLanguageAbstraction("cedar", 1, 1)
+	rego := newFakeLanguageAbstraction("rego", 2, 1)
+	assert.Nil(registry.Register(cedar))
+	assert.Nil(registry.Register(rego))
+	assert.Nil(registry.Register(cedar))
+	assert.NotNil(registry.Register(newFakeLanguageAbstraction("other", 1, 1)))
+	assert.NotNil(registry.Register(newFakeLanguageAbstraction(" ", 3, 1)))
+	assert.NotNil(registry.Register(nil))
+	assert.Equal([]string{"cedar", "rego"}, registry.GetLanguages())
+
+	langAbs, err := registry.GetLanguageAbastraction("rego")
+	assert.Nil(err)
+	assert.Equal(rego, langAbs)
+	_, err = registry.GetLanguageAbastraction("unknown")
+	assert.True(azerrors.AreErrorsEqual(err, azerrors.ErrConfigurationGeneric))
+
+	langAbs, err = registry.GetLanguageAbastractionByID(1, 1)
+	assert.Nil(err)
+	assert.Equal(cedar, langAbs)
+	_, err = registry.GetLanguageAbastractionByID(1, 2)
+	assert.True(azerrors.AreErrorsEqual(err, azerrors.ErrLanguageGeneric))
+	_, err = registry.GetLanguageAbastractionByID(9, 1)
+	assert.True(azerrors.AreErrorsEqual(err, azerrors.ErrLanguageGeneric))
+}
@@ -0,0 +1,213 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// Severity is the severity of a lint finding.
+type Severity string
+
+const (
+	// SeverityOff disables the rule.
+	SeverityOff Severity = "off"
+	// SeverityNote reports the finding as a note.
+	SeverityNote Severity = "note"
+	// SeverityWarning reports the finding as a warning.
+	SeverityWarning Severity = "warning"
+	// SeverityError reports the finding as an error, errors fail the lint gate.
+	SeverityError Severity = "error"
+)
+
+const (
+	// RuleUnconditionalPermit flags permits matching any principal, action and resource without conditions.
+	RuleUnconditionalPermit = "unconditional-permit"
+	// RuleUnsatisfiablePermit flags permits that can never match given the schema.
+	RuleUnsatisfiablePermit = "unsatisfiable-permit"
+	// RuleShadowedPolicy flags permits fully shadowed by an unconditional forbid.
+	RuleShadowedPolicy = "shadowed-policy"
+	// RuleDuplicatePolicy flags policies identical to another policy.
+	RuleDuplicatePolicy = "duplicate-policy"
+	// RuleOverlappingPolicy flags policies whose scope is covered by an unconditional policy with the same effect.
+	RuleOverlappingPolicy = "overlapping-policy"
+	// RuleMissingAnnotation flags policies missing a required annotation.
+	RuleMissingAnnotation = "missing-annotation"
+	// RuleUnknownAction flags actions not declared in the schema.
+	RuleUnknownAction = "unknown-action"
+	// RuleUnknownEntityType flags entity types not declared in the schema.
+	RuleUnknownEntityType = "unknown-entity-type"
+)
+
+// manifestLintKey is the key of the lint configuration in the manifest.
+const manifestLintKey = "lint"
+
+// Rule is a lint rule.
+type Rule struct {
+	ID          string
+	Description string
+	Severity    Severity
+}
+
+// rules are the lint rules with their default severity.
+var rules = []Rule{
+	{ID: RuleUnconditionalPermit, Description: "Permit matching any principal, action and resource without conditions.", Severity: SeverityError},
+	{ID: RuleUnsatisfiablePermit, Description: "Permit that can never match given the schema.", Severity: SeverityWarning},
+	{ID: RuleShadowedPolicy, Description: "Permit fully shadowed by an unconditional forbid.", Severity: SeverityWarning},
+	{ID: RuleDuplicatePolicy, Description: "Policy identical to another policy.", Severity: SeverityError},
+	{ID: RuleOverlappingPolicy, Description: "Policy whose scope is covered by an unconditional policy with the same effect.", Severity: SeverityWarning},
+	{ID: RuleMissingAnnotation, Description: "Policy missing a required annotation.", Severity: SeverityError},
+	{ID: RuleUnknownAction, Description: "Action not declared in the schema.", Severity: SeverityError},
+	{ID: RuleUnknownEntityType, Description: "Entity type not declared in the schema.", Severity: SeverityError},
+}
+
+// Rules returns the lint rules.
+func Rules() []Rule {
+	return slices.Clone(rules)
+}
+
+// findRule finds a rule by id.
+func findRule(ruleID string) (Rule, bool) {
+	idx := slices.IndexFunc(rules, func(rule Rule) bool { return rule.ID == ruleID })
+	if idx < 0 {
+		return Rule{}, false
+	}
+	return rules[idx], true
+}
+
+// isValidSeverity returns true if the severity is valid.
+func isValidSeverity(severity Severity) bool {
+	switch severity {
+	case SeverityOff, SeverityNote, SeverityWarning, SeverityError:
+		return true
+	}
+	return false
+}
+
+// Source is a source file to be linted.
+type Source struct {
+	Path string
+	Data []byte
+}
+
+// Finding is a lint finding.
+type Finding struct {
+	RuleID   string   `json:"rule_id"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	Path     string   `json:"path"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+	PolicyID string   `json:"policy_id,omitempty"`
+}
+
+// HasErrors returns true if any of the findings is an error.
+func HasErrors(findings []Finding) bool {
+	return slices.ContainsFunc(findings, func(finding Finding) bool { return finding.Severity == SeverityError })
+}
+
+// Config is the lint configuration declared in the manifest.
+type Config struct {
+	Rules               map[string]Severity `json:"rules,omitempty"`
+	RequiredAnnotations []string            `json:"required_annotations,omitempty"`
+}
+
+// NewConfig creates a new lint configuration with the default severities.
+func NewConfig() *Config {
+	return &Config{
+		Rules:               map[string]Severity{},
+		RequiredAnnotations: []string{},
+	}
+}
+
+// ConvertManifestBytesToConfig reads the lint configuration from the manifest, the default configuration is returned if missing.
+func ConvertManifestBytesToConfig(data []byte) (*Config, error) {
+	var manifest map[string]json.RawMessage
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrConfigurationGeneric, "lint: invalid manifest", err)
+	}
+	config := NewConfig()
+	lintData, ok := manifest[manifestLintKey]
+	if !ok {
+		return config, nil
+	}
+	if err := json.Unmarshal(lintData, config); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrConfigurationGeneric, "lint: invalid lint configuration in the manifest", err)
+	}
+	if config.Rules == nil {
+		config.Rules = map[string]Severity{}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate validates the lint configuration.
+func (c *Config) Validate() error {
+	for ruleID, severity := range c.Rules {
+		if _, ok := findRule(ruleID); !ok {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrConfigurationGeneric, fmt.Sprintf("lint: unknown rule %s", ruleID))
+		}
+		if !isValidSeverity(severity) {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrConfigurationGeneric, fmt.Sprintf("lint: invalid severity %s for the rule %s", severity, ruleID))
+		}
+	}
+	for _, annotation := range c.RequiredAnnotations {
+		if annotation == "" {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrConfigurationGeneric, "lint: required annotations cannot be empty")
+		}
+	}
+	return nil
+}
+
+// GetSeverity returns the severity of the rule.
+func (c *Config) GetSeverity(ruleID string) Severity {
+	if severity, ok := c.Rules[ruleID]; ok {
+		return severity
+	}
+	if rule, ok := findRule(ruleID); ok {
+		return rule.Severity
+	}
+	return SeverityOff
+}
+
+// IsEnabled returns true if the rule is enabled.
+func (c *Config) IsEnabled(ruleID string) bool {
+	return c.GetSeverity(ruleID) != SeverityOff
+}
+
+// Apply sets the configured severity of the findings, drops the ones of the disabled rules and sorts them by location.
+func (c *Config) Apply(findings []Finding) []Finding {
+	applied := []Finding{}
+	for _, finding := range findings {
+		finding.Severity = c.GetSeverity(finding.RuleID)
+		if finding.Severity == SeverityOff {
+			continue
+		}
+		applied = append(applied, finding)
+	}
+	slices.SortStableFunc(applied, func(a, b Finding) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column), cmp.Compare(a.RuleID, b.RuleID))
+	})
+	return applied
+}
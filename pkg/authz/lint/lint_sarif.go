@@ -0,0 +1,156 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"encoding/json"
+)
+
+const (
+	// sarifVersion is the version of the sarif format.
+	sarifVersion = "2.1.0"
+	// sarifSchema is the json schema of the sarif format.
+	sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+	// sarifToolName is the name of the tool reported in the sarif log.
+	sarifToolName = "permguard"
+	// sarifToolURI is the information uri of the tool reported in the sarif log.
+	sarifToolURI = "https://www.permguard.com"
+)
+
+// SarifMessage is a sarif message.
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+// SarifRuleConfiguration is the default configuration of a sarif rule.
+type SarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+// SarifRule is a sarif reporting descriptor.
+type SarifRule struct {
+	ID                   string                 `json:"id"`
+	ShortDescription     SarifMessage           `json:"shortDescription"`
+	DefaultConfiguration SarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+// SarifDriver is the sarif tool component.
+type SarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version,omitempty"`
+	Rules          []SarifRule `json:"rules"`
+}
+
+// SarifTool is the sarif tool.
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+// SarifArtifactLocation is the sarif artifact location.
+type SarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SarifRegion is the sarif region.
+type SarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// SarifPhysicalLocation is the sarif physical location.
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Region           *SarifRegion          `json:"region,omitempty"`
+}
+
+// SarifLocation is the sarif location.
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation `json:"physicalLocation"`
+}
+
+// SarifResult is a sarif result.
+type SarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SarifMessage    `json:"message"`
+	Locations []SarifLocation `json:"locations"`
+}
+
+// SarifRun is a sarif run.
+type SarifRun struct {
+	Tool    SarifTool     `json:"tool"`
+	Results []SarifResult `json:"results"`
+}
+
+// SarifLog is a sarif log.
+type SarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+// BuildSarifLog builds the sarif log of the findings.
+func BuildSarifLog(config *Config, findings []Finding, toolVersion string) *SarifLog {
+	sarifRules := make([]SarifRule, 0, len(rules))
+	for _, rule := range rules {
+		level := config.GetSeverity(rule.ID)
+		if level == SeverityOff {
+			level = SeverityNote
+		}
+		sarifRules = append(sarifRules, SarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     SarifMessage{Text: rule.Description},
+			DefaultConfiguration: SarifRuleConfiguration{Level: string(level)},
+		})
+	}
+	results := make([]SarifResult, 0, len(findings))
+	for _, finding := range findings {
+		location := SarifPhysicalLocation{ArtifactLocation: SarifArtifactLocation{URI: finding.Path}}
+		if finding.Line > 0 {
+			location.Region = &SarifRegion{StartLine: finding.Line, StartColumn: finding.Column}
+		}
+		results = append(results, SarifResult{
+			RuleID:    finding.RuleID,
+			Level:     string(finding.Severity),
+			Message:   SarifMessage{Text: finding.Message},
+			Locations: []SarifLocation{{PhysicalLocation: location}},
+		})
+	}
+	return &SarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []SarifRun{
+			{
+				Tool: SarifTool{
+					Driver: SarifDriver{
+						Name:           sarifToolName,
+						InformationURI: sarifToolURI,
+						Version:        toolVersion,
+						Rules:          sarifRules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+// ConvertSarifLogToBytes converts the sarif log to bytes.
+func ConvertSarifLogToBytes(log *SarifLog) ([]byte, error) {
+	return json.MarshalIndent(log, "", "  ")
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConvertManifestBytesToConfig tests the conversion of the manifest to the lint configuration.
+func TestConvertManifestBytesToConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	config, err := ConvertManifestBytesToConfig([]byte(`{"metadata": {"name": "magicfarmacia"}}`))
+	assert.Nil(err, "error should be nil")
+	assert.Equal(SeverityError, config.GetSeverity(RuleUnconditionalPermit))
+	assert.Empty(config.RequiredAnnotations)
+
+	config, err = ConvertManifestBytesToConfig([]byte(`{"lint": {"rules": {"unconditional-permit": "warning", "overlapping-policy": "off"}, "required_annotations": ["owner", "ticket"]}}`))
+	assert.Nil(err, "error should be nil")
+	assert.Equal(SeverityWarning, config.GetSeverity(RuleUnconditionalPermit))
+	assert.False(config.IsEnabled(RuleOverlappingPolicy))
+	assert.True(config.IsEnabled(RuleDuplicatePolicy))
+	assert.Equal([]string{"owner", "ticket"}, config.RequiredAnnotations)
+
+	for _, manifest := range []string{
+		`{"lint": {"rules": {"not-a-rule": "error"}}}`,
+		`{"lint": {"rules": {"unconditional-permit": "fatal"}}}`,
+		`{"lint": {"required_annotations": [""]}}`,
+		`{"lint": []}`,
+		`not json`,
+	} {
+		_, err = ConvertManifestBytesToConfig([]byte(manifest))
+		assert.NotNil(err, "error should not be nil for %s", manifest)
+	}
+}
+
+// TestConfigApply tests the application of the configuration to the findings.
+func TestConfigApply(t *testing.T) {
+	assert := assert.New(t)
+
+	config := NewConfig()
+	config.Rules[RuleOverlappingPolicy] = SeverityOff
+	config.Rules[RuleShadowedPolicy] = SeverityError
+	findings := config.Apply([]Finding{
+		{RuleID: RuleShadowedPolicy, Path: "b.cedar", Line: 1},
+		{RuleID: RuleOverlappingPolicy, Path: "a.cedar", Line: 1},
+		{RuleID: RuleUnsatisfiablePermit, Path: "a.cedar", Line: 10},
+		{RuleID: RuleUnknownAction, Path: "a.cedar", Line: 2},
+	})
+	assert.Len(findings, 3)
+	assert.Equal(RuleUnknownAction, findings[0].RuleID)
+	assert.Equal(SeverityError, findings[0].Severity)
+	assert.Equal(RuleUnsatisfiablePermit, findings[1].RuleID)
+	assert.Equal(SeverityWarning, findings[1].Severity)
+	assert.Equal(RuleShadowedPolicy, findings[2].RuleID)
+	assert.Equal(SeverityError, findings[2].Severity)
+	assert.True(HasErrors(findings))
+	assert.False(HasErrors(findings[1:2]))
+}
+
+// TestBuildSarifLog tests the creation of the sarif log.
+func TestBuildSarifLog(t *testing.T) {
+	assert := assert.New(t)
+
+	config := NewConfig()
+	findings := config.Apply([]Finding{
+		{RuleID: RuleUnconditionalPermit, Path: "policies.cedar", Line: 3, Column: 1, PolicyID: "allow-all", Message: "policy allow-all permits every request"},
+	})
+	log := BuildSarifLog(config, findings, "0.0.1")
+	data, err := ConvertSarifLogToBytes(log)
+	assert.Nil(err, "error should be nil")
+
+	var decoded map[string]any
+	assert.Nil(json.Unmarshal(data, &decoded), "error should be nil")
+	assert.Equal("2.1.0", decoded["version"])
+	runs := decoded["runs"].([]any)
+	assert.Len(runs, 1)
+	run := runs[0].(map[string]any)
+	driver := run["tool"].(map[string]any)["driver"].(map[string]any)
+	assert.Equal("permguard", driver["name"])
+	assert.Len(driver["rules"], len(Rules()))
+	results := run["results"].([]any)
+	assert.Len(results, 1)
+	result := results[0].(map[string]any)
+	assert.Equal(RuleUnconditionalPermit, result["ruleId"])
+	assert.Equal("error", result["level"])
+	location := result["locations"].([]any)[0].(map[string]any)["physicalLocation"].(map[string]any)
+	assert.Equal("policies.cedar", location["artifactLocation"].(map[string]any)["uri"])
+	assert.Equal(float64(3), location["region"].(map[string]any)["startLine"])
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package residuals
+
+const (
+	// OpAnd is the logical conjunction of the arguments.
+	OpAnd = "and"
+	// OpOr is the logical disjunction of the arguments.
+	OpOr = "or"
+	// OpNot is the logical negation of the argument.
+	OpNot = "not"
+	// OpEq is the equality comparison.
+	OpEq = "eq"
+	// OpNe is the inequality comparison.
+	OpNe = "ne"
+	// OpLt is the less than comparison.
+	OpLt = "lt"
+	// OpLe is the less than or equal comparison.
+	OpLe = "le"
+	// OpGt is the greater than comparison.
+	OpGt = "gt"
+	// OpGe is the greater than or equal comparison.
+	OpGe = "ge"
+	// OpIn is the membership of the first argument in the hierarchy or set of the second argument.
+	OpIn = "in"
+	// OpIs is the type check of the argument against the type in value.
+	OpIs = "is"
+	// OpHas is the presence check of the attribute in path.
+	OpHas = "has"
+	// OpLike is the match of the argument against the pattern in value.
+	OpLike = "like"
+	// OpContains is the membership of the second argument in the set of the first argument.
+	OpContains = "contains"
+	// OpContainsAll checks that the first argument contains all the elements of the second argument.
+	OpContainsAll = "contains_all"
+	// OpContainsAny checks that the first argument contains any of the elements of the second argument.
+	OpContainsAny = "contains_any"
+	// OpResource is the resource being filtered.
+	OpResource = "resource"
+	// OpAttribute is the resource attribute in path.
+	OpAttribute = "attribute"
+	// OpValue is the constant in value.
+	OpValue = "value"
+)
+
+const (
+	// DecisionPermit is the decision when the request is permitted for every resource.
+	DecisionPermit = "permit"
+	// DecisionDeny is the decision when the request is denied for every resource.
+	DecisionDeny = "deny"
+	// DecisionConditional is the decision when the request is permitted only for the resources matching the residual.
+	DecisionConditional = "conditional"
+)
+
+// Expression is a portable residual expression tree.
+type Expression struct {
+	Op    string        `json:"op"`
+	Args  []*Expression `json:"args,omitempty"`
+	Path  []string      `json:"path,omitempty"`
+	Value any           `json:"value,omitempty"`
+}
+
+// PartialDecision is the result of a partial evaluation.
+type PartialDecision struct {
+	ContextID string
+	Decision  string
+	Residual  *Expression
+}
+
+// NewPartialDecision creates a new partial decision out of the residual expression.
+func NewPartialDecision(contextID string, residual *Expression) *PartialDecision {
+	decision := DecisionConditional
+	if IsTrue(residual) {
+		decision = DecisionPermit
+	} else if IsFalse(residual) {
+		decision = DecisionDeny
+	}
+	return &PartialDecision{
+		ContextID: contextID,
+		Decision:  decision,
+		Residual:  residual,
+	}
+}
+
+// Value creates a constant expression.
+func Value(value any) *Expression {
+	return &Expression{Op: OpValue, Value: value}
+}
+
+// Bool creates a constant boolean expression.
+func Bool(value bool) *Expression {
+	return Value(value)
+}
+
+// Resource creates an expression referring to the resource.
+func Resource() *Expression {
+	return &Expression{Op: OpResource}
+}
+
+// Attribute creates an expression referring to a resource attribute.
+func Attribute(path ...string) *Expression {
+	return &Expression{Op: OpAttribute, Path: path}
+}
+
+// Has creates an expression checking the presence of a resource attribute.
+func Has(path ...string) *Expression {
+	return &Expression{Op: OpHas, Path: path}
+}
+
+// Binary creates an expression applying the operator to the two arguments.
+func Binary(op string, left, right *Expression) *Expression {
+	return &Expression{Op: op, Args: []*Expression{left, right}}
+}
+
+// IsTrue returns true if the expression is the constant true.
+func IsTrue(expr *Expression) bool {
+	if expr == nil || expr.Op != OpValue {
+		return false
+	}
+	value, ok := expr.Value.(bool)
+	return ok && value
+}
+
+// IsFalse returns true if the expression is the constant false.
+func IsFalse(expr *Expression) bool {
+	if expr == nil || expr.Op != OpValue {
+		return false
+	}
+	value, ok := expr.Value.(bool)
+	return ok && !value
+}
+
+// And creates the simplified conjunction of the expressions.
+func And(exprs ...*Expression) *Expression {
+	args := []*Expression{}
+	for _, expr := range exprs {
+		switch {
+		case IsTrue(expr):
+			continue
+		case IsFalse(expr):
+			return Bool(false)
+		case expr.Op == OpAnd:
+			args = append(args, expr.Args...)
+		default:
+			args = append(args, expr)
+		}
+	}
+	switch len(args) {
+	case 0:
+		return Bool(true)
+	case 1:
+		return args[0]
+	}
+	return &Expression{Op: OpAnd, Args: args}
+}
+
+// Or creates the simplified disjunction of the expressions.
+func Or(exprs ...*Expression) *Expression {
+	args := []*Expression{}
+	for _, expr := range exprs {
+		switch {
+		case IsFalse(expr):
+			continue
+		case IsTrue(expr):
+			return Bool(true)
+		case expr.Op == OpOr:
+			args = append(args, expr.Args...)
+		default:
+			args = append(args, expr)
+		}
+	}
+	switch len(args) {
+	case 0:
+		return Bool(false)
+	case 1:
+		return args[0]
+	}
+	return &Expression{Op: OpOr, Args: args}
+}
+
+// Not creates the simplified negation of the expression.
+func Not(expr *Expression) *Expression {
+	switch {
+	case IsTrue(expr):
+		return Bool(false)
+	case IsFalse(expr):
+		return Bool(true)
+	case expr.Op == OpNot && len(expr.Args) == 1:
+		return expr.Args[0]
+	}
+	return &Expression{Op: OpNot, Args: []*Expression{expr}}
+}
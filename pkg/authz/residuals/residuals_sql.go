@@ -0,0 +1,218 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package residuals
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// SQLResourceIDColumnDefault is the default column of the resource identifier.
+	SQLResourceIDColumnDefault = "id"
+)
+
+// sqlIdentifierRegex is the regex of the columns which are generated out of attribute paths.
+var sqlIdentifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SQLOptions holds the options of the SQL translation.
+type SQLOptions struct {
+	// ResourceIDColumn is the column of the resource identifier.
+	ResourceIDColumn string
+	// Columns maps the dot separated attribute paths to columns.
+	Columns map[string]string
+}
+
+// sqlTranslator translates residual expressions to SQL.
+type sqlTranslator struct {
+	options *SQLOptions
+	args    []any
+}
+
+// ToSQL translates the residual expression to a parametrized SQL WHERE fragment.
+func ToSQL(expr *Expression, options *SQLOptions) (string, []any, error) {
+	if expr == nil {
+		return "", nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "residual expression is nil")
+	}
+	if options == nil {
+		options = &SQLOptions{}
+	}
+	translator := &sqlTranslator{options: options, args: []any{}}
+	where, err := translator.predicate(expr)
+	if err != nil {
+		return "", nil, err
+	}
+	return where, translator.args, nil
+}
+
+// column returns the column of the attribute path.
+func (t *sqlTranslator) column(path []string) (string, error) {
+	key := strings.Join(path, ".")
+	if column, ok := t.options.Columns[key]; ok {
+		return column, nil
+	}
+	column := strings.Join(path, "_")
+	if !sqlIdentifierRegex.MatchString(column) {
+		return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("attribute %s cannot be mapped to a column", key))
+	}
+	return column, nil
+}
+
+// operand translates an operand expression.
+func (t *sqlTranslator) operand(expr *Expression) (string, error) {
+	switch expr.Op {
+	case OpResource:
+		if t.options.ResourceIDColumn != "" {
+			return t.options.ResourceIDColumn, nil
+		}
+		return SQLResourceIDColumnDefault, nil
+	case OpAttribute:
+		return t.column(expr.Path)
+	case OpValue:
+		value := expr.Value
+		if entity, ok := value.(map[string]any); ok {
+			id, ok := entity["id"]
+			if !ok {
+				return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "record values cannot be translated to sql")
+			}
+			value = id
+		}
+		if _, ok := value.([]any); ok {
+			return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "set values cannot be translated to sql")
+		}
+		t.args = append(t.args, value)
+		return "?", nil
+	}
+	return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("operator %s cannot be used as sql operand", expr.Op))
+}
+
+// predicate translates a boolean expression.
+func (t *sqlTranslator) predicate(expr *Expression) (string, error) {
+	switch expr.Op {
+	case OpValue:
+		if IsTrue(expr) {
+			return "1 = 1", nil
+		} else if IsFalse(expr) {
+			return "1 = 0", nil
+		}
+		return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "non boolean value cannot be used as sql predicate")
+	case OpAnd, OpOr:
+		parts := make([]string, len(expr.Args))
+		for i, arg := range expr.Args {
+			part, err := t.predicate(arg)
+			if err != nil {
+				return "", err
+			}
+			parts[i] = part
+		}
+		return "(" + strings.Join(parts, " "+strings.ToUpper(expr.Op)+" ") + ")", nil
+	case OpNot:
+		if len(expr.Args) != 1 {
+			return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "not operator requires one argument")
+		}
+		part, err := t.predicate(expr.Args[0])
+		if err != nil {
+			return "", err
+		}
+		return "NOT (" + part + ")", nil
+	case OpHas:
+		column, err := t.column(expr.Path)
+		if err != nil {
+			return "", err
+		}
+		return column + " IS NOT NULL", nil
+	case OpLike:
+		if len(expr.Args) != 1 {
+			return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "like operator requires one argument")
+		}
+		pattern, ok := expr.Value.(string)
+		if !ok {
+			return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "like operator requires a string pattern")
+		}
+		left, err := t.operand(expr.Args[0])
+		if err != nil {
+			return "", err
+		}
+		t.args = append(t.args, likePatternToSQL(pattern))
+		return left + ` LIKE ? ESCAPE '\'`, nil
+	case OpEq, OpNe, OpLt, OpLe, OpGt, OpGe:
+		return t.comparison(expr)
+	}
+	return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("operator %s cannot be translated to sql", expr.Op))
+}
+
+// comparison translates a comparison expression.
+func (t *sqlTranslator) comparison(expr *Expression) (string, error) {
+	if len(expr.Args) != 2 {
+		return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("%s operator requires two arguments", expr.Op))
+	}
+	left, right := expr.Args[0], expr.Args[1]
+	if (expr.Op == OpEq || expr.Op == OpNe) && right.Op == OpValue && right.Value == nil {
+		column, err := t.operand(left)
+		if err != nil {
+			return "", err
+		}
+		if expr.Op == OpEq {
+			return column + " IS NULL", nil
+		}
+		return column + " IS NOT NULL", nil
+	}
+	leftSQL, err := t.operand(left)
+	if err != nil {
+		return "", err
+	}
+	rightSQL, err := t.operand(right)
+	if err != nil {
+		return "", err
+	}
+	operators := map[string]string{OpEq: "=", OpNe: "<>", OpLt: "<", OpLe: "<=", OpGt: ">", OpGe: ">="}
+	return leftSQL + " " + operators[expr.Op] + " " + rightSQL, nil
+}
+
+// likePatternToSQL converts a pattern using the * wildcard to a SQL LIKE pattern.
+func likePatternToSQL(pattern string) string {
+	var sb strings.Builder
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			if r == '*' {
+				sb.WriteRune('*')
+			} else {
+				sb.WriteRune('\\')
+				sb.WriteRune(r)
+			}
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '*':
+			sb.WriteRune('%')
+		case r == '%' || r == '_':
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if escaped {
+		sb.WriteString(`\\`)
+	}
+	return sb.String()
+}
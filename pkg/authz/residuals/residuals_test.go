@@ -0,0 +1,99 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package residuals
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSimplification tests the simplification of the logical operators.
+func TestSimplification(t *testing.T) {
+	assert := assert.New(t)
+	eq := Binary(OpEq, Attribute("owner"), Value("alice"))
+
+	assert.True(IsTrue(And()))
+	assert.True(IsFalse(Or()))
+	assert.True(IsFalse(And(eq, Bool(false))))
+	assert.True(IsTrue(Or(eq, Bool(true))))
+	assert.Equal(eq, And(Bool(true), eq))
+	assert.Equal(eq, Or(Bool(false), eq))
+	assert.Equal(eq, Not(Not(eq)))
+	assert.True(IsFalse(Not(Bool(true))))
+
+	nested := And(eq, And(eq, eq))
+	assert.Equal(OpAnd, nested.Op)
+	assert.Len(nested.Args, 3)
+}
+
+// TestNewPartialDecision tests the creation of the partial decision.
+func TestNewPartialDecision(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(DecisionPermit, NewPartialDecision("ctx", Bool(true)).Decision)
+	assert.Equal(DecisionDeny, NewPartialDecision("ctx", Bool(false)).Decision)
+	decision := NewPartialDecision("ctx", Has("owner"))
+	assert.Equal(DecisionConditional, decision.Decision)
+	assert.Equal("ctx", decision.ContextID)
+}
+
+// TestExpressionJSON tests the json encoding of the expression.
+func TestExpressionJSON(t *testing.T) {
+	assert := assert.New(t)
+	expr := And(Binary(OpEq, Attribute("owner"), Value("alice")), Binary(OpEq, Attribute("archived"), Value(false)))
+	data, err := json.Marshal(expr)
+	assert.Nil(err)
+	assert.JSONEq(`{"op":"and","args":[{"op":"eq","args":[{"op":"attribute","path":["owner"]},{"op":"value","value":"alice"}]},{"op":"eq","args":[{"op":"attribute","path":["archived"]},{"op":"value","value":false}]}]}`, string(data))
+}
+
+// TestToSQL tests the translation of the residual expression to sql.
+func TestToSQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    *Expression
+		options *SQLOptions
+		sql     string
+		args    []any
+		hasErr  bool
+	}{
+		{"true", Bool(true), nil, "1 = 1", []any{}, false},
+		{"false", Bool(false), nil, "1 = 0", []any{}, false},
+		{"eq", Binary(OpEq, Attribute("owner"), Value("alice")), nil, "owner = ?", []any{"alice"}, false},
+		{"mapped", Binary(OpGe, Attribute("details", "level"), Value(int64(3))), &SQLOptions{Columns: map[string]string{"details.level": "lvl"}}, "lvl >= ?", []any{int64(3)}, false},
+		{"nested path", Binary(OpLt, Attribute("details", "level"), Value(int64(3))), nil, "details_level < ?", []any{int64(3)}, false},
+		{"null", Binary(OpNe, Attribute("owner"), Value(nil)), nil, "owner IS NOT NULL", []any{}, false},
+		{"resource", Binary(OpEq, Resource(), Value(map[string]any{"type": "Doc", "id": "1"})), &SQLOptions{ResourceIDColumn: "doc_id"}, "doc_id = ?", []any{"1"}, false},
+		{"logical", Not(Or(Has("owner"), Binary(OpEq, Attribute("public"), Value(true)))), nil, "NOT ((owner IS NOT NULL OR public = ?))", []any{true}, false},
+		{"like", &Expression{Op: OpLike, Args: []*Expression{Attribute("name")}, Value: `a_*\*`}, nil, `name LIKE ? ESCAPE '\'`, []any{`a\_%*`}, false},
+		{"unsupported", Binary(OpIn, Resource(), Value(map[string]any{"type": "Folder", "id": "1"})), nil, "", nil, true},
+		{"injection", Binary(OpEq, Attribute("owner; drop"), Value("x")), nil, "", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			sql, args, err := ToSQL(test.expr, test.options)
+			if test.hasErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(test.sql, sql)
+			assert.Equal(test.args, args)
+		})
+	}
+}
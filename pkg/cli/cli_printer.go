@@ -17,12 +17,16 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
+	"os"
 	"reflect"
 	"sort"
 	"strings"
+	"text/template"
 
 	"github.com/fatih/color"
 	"google.golang.org/grpc/status"
@@ -102,29 +106,85 @@ func marshalWithSnakeCase(v any) ([]byte, error) {
 
 // CliPrinterTerminal is the cli printer.
 type CliPrinterTerminal struct {
-	verbose bool
-	output  string
+	verbose  bool
+	output   string
+	template *template.Template
+	writer   io.Writer
 }
 
 // NewCliPrinterTerminal returns a new cli printer.
 func NewCliPrinterTerminal(verbose bool, output string) (*CliPrinterTerminal, error) {
-	out := strings.ToUpper(output)
-	if out != OutputTerminal && out != OutputJSON {
+	out, tmplText, err := ParseOutput(output)
+	if err != nil {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliGeneric, "invalid output")
 	}
+	var tmpl *template.Template
+	if out == OutputTemplate {
+		tmpl, err = template.New("output").Parse(tmplText)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrCliArguments, "invalid output template", err)
+		}
+	}
 	return &CliPrinterTerminal{
-		verbose: verbose,
-		output:  strings.ToUpper(output),
+		verbose:  verbose,
+		output:   out,
+		template: tmpl,
+		writer:   os.Stdout,
 	}, nil
 }
 
+// isDocumentOutput returns true if errors are printed as a structured document.
+func (cp *CliPrinterTerminal) isDocumentOutput() bool {
+	return cp.output == OutputJSON || cp.output == OutputYAML || cp.output == OutputTable || cp.output == OutputTemplate
+}
+
+// printFormatted prints the output rendered from its json document.
+func (cp *CliPrinterTerminal) printFormatted(output map[string]any) {
+	jsonData, err := marshalWithSnakeCase(output)
+	if err != nil {
+		return
+	}
+	switch cp.output {
+	case OutputYAML:
+		err = renderYAML(cp.writer, jsonData)
+	case OutputTable:
+		err = renderTable(cp.writer, jsonData)
+	case OutputTemplate:
+		err = renderTemplate(cp.writer, cp.template, jsonData)
+	default:
+		fmt.Fprintln(cp.writer, string(jsonData))
+	}
+	if err != nil {
+		color.Red("error: %s\n", err.Error())
+	}
+}
+
 // printJSON prints the output as json.
 func (cp *CliPrinterTerminal) printJSON(output map[string]any) {
 	jsonData, err := marshalWithSnakeCase(output)
 	if err != nil {
 		return
 	}
-	fmt.Println(string(jsonData))
+	fmt.Fprintln(cp.writer, string(jsonData))
+}
+
+// printTemplateError prints the error output through the template, the json document is printed instead when the
+// template does not apply to the error fields.
+func (cp *CliPrinterTerminal) printTemplateError(output map[string]any) {
+	jsonData, err := marshalWithSnakeCase(output)
+	if err != nil {
+		return
+	}
+	var buf bytes.Buffer
+	tmpl, err := cp.template.Clone()
+	if err == nil {
+		err = renderTemplate(&buf, tmpl.Option("missingkey=error"), jsonData)
+	}
+	if err != nil {
+		fmt.Fprintln(cp.writer, string(jsonData))
+		return
+	}
+	cp.writer.Write(buf.Bytes())
 }
 
 // printValue prints the value.
@@ -212,6 +272,11 @@ func (cp *CliPrinterTerminal) print(output map[string]any, newLine bool) {
 			output = make(map[string]any)
 		}
 		cp.printJSON(output)
+	case OutputYAML, OutputTable, OutputTemplate:
+		if output == nil {
+			output = make(map[string]any)
+		}
+		cp.printFormatted(output)
 	case OutputTerminal:
 		fallthrough
 	default:
@@ -251,14 +316,14 @@ func (cp *CliPrinterTerminal) extractCodeAndMessage(input string) (string, strin
 func (cp *CliPrinterTerminal) createOutputWithputError(errCode string, errMsg string) map[string]any {
 	var output map[string]any
 	if cp.verbose {
-		if cp.output == OutputJSON {
+		if cp.isDocumentOutput() {
 			output = map[string]any{"errorCode": errCode, "errorMessage": errMsg}
 		} else {
 			output = map[string]any{"error": fmt.Sprintf(errorMessageCodeMsg, errCode, errMsg)}
 		}
 	} else {
 		sysErr := azerrors.NewSystemError(errCode).(azerrors.SystemError)
-		if cp.output == OutputJSON {
+		if cp.isDocumentOutput() {
 			output = map[string]any{"errorCode": sysErr.Code(), "errorMessage": sysErr.Message()}
 		} else {
 			output = map[string]any{"error": fmt.Sprintf(errorMessageCodeMsg, sysErr.Code(), sysErr.Message())}
@@ -272,7 +337,7 @@ func (cp *CliPrinterTerminal) createOutputWithError(errInputMsg string) map[stri
 	var output map[string]any
 	code := azerrors.ZeroErrorCode
 	if cp.verbose {
-		if cp.output == OutputJSON {
+		if cp.isDocumentOutput() {
 			output = map[string]any{"errorCode": code, "errorMessage": errInputMsg}
 		} else {
 			output = map[string]any{"error": fmt.Sprintf(errorMessageCodeMsg, code, errInputMsg)}
@@ -280,7 +345,7 @@ func (cp *CliPrinterTerminal) createOutputWithError(errInputMsg string) map[stri
 		}
 	} else {
 		message := "unknown error"
-		if cp.output == OutputJSON {
+		if cp.isDocumentOutput() {
 			output = map[string]any{"errorCode": code, "errorMessage": message}
 		} else {
 			output = map[string]any{"error": fmt.Sprintf(errorMessageCodeMsg, code, message)}
@@ -324,6 +389,10 @@ func (cp *CliPrinterTerminal) ErrorWithOutput(output map[string]any, err error)
 	switch cp.output {
 	case OutputJSON:
 		cp.printJSON(output)
+	case OutputYAML, OutputTable:
+		cp.printFormatted(output)
+	case OutputTemplate:
+		cp.printTemplateError(output)
 	case OutputTerminal:
 		fallthrough
 	default:
@@ -0,0 +1,362 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// OutputYAML is the yaml output.
+	OutputYAML = "YAML"
+	// OutputTable is the table output.
+	OutputTable = "TABLE"
+	// OutputTemplate is the go template output.
+	OutputTemplate = "TEMPLATE"
+)
+
+// ParseOutput parses an output specification such as `json`, `table` or `template={{.name}}`
+// and returns the normalized output format and the template text, if any.
+func ParseOutput(output string) (string, string, error) {
+	spec := strings.TrimSpace(output)
+	format, tmpl, hasTemplate := strings.Cut(spec, "=")
+	format = strings.ToUpper(strings.TrimSpace(format))
+	switch format {
+	case OutputTerminal, OutputJSON, OutputYAML, OutputTable:
+		if hasTemplate {
+			return "", "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("output %s does not accept a template", strings.ToLower(format)))
+		}
+		return format, "", nil
+	case OutputTemplate:
+		if !hasTemplate || strings.TrimSpace(tmpl) == "" {
+			return "", "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "output template requires a template, for example template='{{.name}}'")
+		}
+		tmpl = strings.NewReplacer(`\t`, "\t", `\n`, "\n").Replace(tmpl)
+		return format, tmpl, nil
+	default:
+		return "", "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, fmt.Sprintf("invalid output %s", output))
+	}
+}
+
+// IsStructuredOutput returns true if the output format is rendered from the json document.
+func IsStructuredOutput(format string) bool {
+	switch strings.ToUpper(format) {
+	case OutputJSON, OutputYAML, OutputTable, OutputTemplate:
+		return true
+	}
+	return false
+}
+
+// orderedObject is a json object decoded preserving the order of its keys.
+type orderedObject struct {
+	keys   []string
+	values map[string]any
+}
+
+// decodeOrdered decodes a json document preserving the order of the object keys.
+func decodeOrdered(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return decodeOrderedValue(dec)
+}
+
+// decodeOrderedValue decodes the next json value from the decoder.
+func decodeOrderedValue(dec *json.Decoder) (any, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+	switch delim {
+	case '{':
+		obj := &orderedObject{values: map[string]any{}}
+		for dec.More() {
+			keyToken, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyToken.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid object key %v", keyToken)
+			}
+			value, err := decodeOrderedValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := obj.values[key]; !exists {
+				obj.keys = append(obj.keys, key)
+			}
+			obj.values[key] = value
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case '[':
+		items := []any{}
+		for dec.More() {
+			value, err := decodeOrderedValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("unexpected json delimiter %v", delim)
+}
+
+// orderedToYAMLNode converts an ordered value to a yaml node.
+func orderedToYAMLNode(value any) *yaml.Node {
+	switch v := value.(type) {
+	case *orderedObject:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, key := range v.keys {
+			node.Content = append(node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+				orderedToYAMLNode(v.values[key]))
+		}
+		return node
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, item := range v {
+			node.Content = append(node.Content, orderedToYAMLNode(item))
+		}
+		return node
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(v.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprintf("%t", v)}
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	}
+}
+
+// orderedToPlain converts an ordered value to plain maps and slices.
+func orderedToPlain(value any) any {
+	switch v := value.(type) {
+	case *orderedObject:
+		out := make(map[string]any, len(v.keys))
+		for _, key := range v.keys {
+			out[key] = orderedToPlain(v.values[key])
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = orderedToPlain(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// writeCompactJSON writes an ordered value as compact json.
+func writeCompactJSON(buf *bytes.Buffer, value any) {
+	switch v := value.(type) {
+	case *orderedObject:
+		buf.WriteByte('{')
+		for i, key := range v.keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			keyData, _ := json.Marshal(key)
+			buf.Write(keyData)
+			buf.WriteByte(':')
+			writeCompactJSON(buf, v.values[key])
+		}
+		buf.WriteByte('}')
+	case []any:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCompactJSON(buf, item)
+		}
+		buf.WriteByte(']')
+	default:
+		data, _ := json.Marshal(v)
+		buf.Write(data)
+	}
+}
+
+// formatCell formats an ordered value as a table cell.
+func formatCell(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprintf("%t", v)
+	default:
+		var buf bytes.Buffer
+		writeCompactJSON(&buf, v)
+		return buf.String()
+	}
+}
+
+// extractRows returns the key and the rows of the first list of objects in the document.
+func extractRows(doc *orderedObject) (string, []*orderedObject, bool) {
+	keys := append([]string(nil), doc.keys...)
+	sort.Strings(keys)
+	for _, key := range keys {
+		items, ok := doc.values[key].([]any)
+		if !ok {
+			continue
+		}
+		rows := make([]*orderedObject, 0, len(items))
+		for _, item := range items {
+			row, ok := item.(*orderedObject)
+			if !ok {
+				break
+			}
+			rows = append(rows, row)
+		}
+		if len(rows) == len(items) {
+			return key, rows, true
+		}
+	}
+	return "", nil, false
+}
+
+// renderYAML renders the json document as yaml.
+func renderYAML(w io.Writer, data []byte) error {
+	doc, err := decodeOrdered(data)
+	if err != nil {
+		return err
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(orderedToYAMLNode(doc)); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// renderTable renders the json document as a table.
+func renderTable(w io.Writer, data []byte) error {
+	value, err := decodeOrdered(data)
+	if err != nil {
+		return err
+	}
+	doc, ok := value.(*orderedObject)
+	if !ok {
+		_, err := fmt.Fprintln(w, formatCell(value))
+		return err
+	}
+	rowsKey, rows, hasRows := extractRows(doc)
+	if !hasRows {
+		rows = []*orderedObject{doc}
+	}
+	columns := []string{}
+	seen := map[string]bool{}
+	for _, row := range rows {
+		for _, key := range row.keys {
+			if !seen[key] {
+				seen[key] = true
+				columns = append(columns, key)
+			}
+		}
+	}
+	if len(columns) > 0 {
+		tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		headers := make([]string, len(columns))
+		for i, column := range columns {
+			headers[i] = strings.ToUpper(column)
+		}
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+		for _, row := range rows {
+			cells := make([]string, len(columns))
+			for i, column := range columns {
+				cells[i] = formatCell(row.values[column])
+			}
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	if hasRows {
+		for _, key := range doc.keys {
+			if key == rowsKey {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "%s: %s\n", key, formatCell(doc.values[key])); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// renderTemplate renders the json document with the go template, once per row when the document contains a list.
+func renderTemplate(w io.Writer, tmpl *template.Template, data []byte) error {
+	value, err := decodeOrdered(data)
+	if err != nil {
+		return err
+	}
+	items := []any{value}
+	if doc, ok := value.(*orderedObject); ok {
+		if _, rows, hasRows := extractRows(doc); hasRows {
+			items = make([]any, len(rows))
+			for i, row := range rows {
+				items[i] = row
+			}
+		}
+	}
+	for _, item := range items {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, orderedToPlain(item)); err != nil {
+			return err
+		}
+		if !strings.HasSuffix(buf.String(), "\n") {
+			buf.WriteByte('\n')
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
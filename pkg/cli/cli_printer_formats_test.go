@@ -0,0 +1,85 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseOutput tests the parsing of the output specification.
+func TestParseOutput(t *testing.T) {
+	assert := assert.New(t)
+
+	format, tmpl, err := ParseOutput("yaml")
+	assert.Nil(err)
+	assert.Equal(OutputYAML, format)
+	assert.Empty(tmpl)
+
+	format, tmpl, err = ParseOutput(`template={{.zone_id}}\t{{.name}}`)
+	assert.Nil(err)
+	assert.Equal(OutputTemplate, format)
+	assert.Equal("{{.zone_id}}\t{{.name}}", tmpl)
+
+	for _, output := range []string{"xml", "template", "template=", "json={{.name}}"} {
+		_, _, err = ParseOutput(output)
+		assert.NotNil(err, output)
+	}
+	assert.True(IsStructuredOutput(OutputTable))
+	assert.False(IsStructuredOutput(OutputTerminal))
+}
+
+// TestRenderYAML tests the yaml rendering preserving the key order.
+func TestRenderYAML(t *testing.T) {
+	assert := assert.New(t)
+	var buf bytes.Buffer
+	err := renderYAML(&buf, []byte(`{"zones":[{"zone_id":273165098782,"name":"zone1","enabled":true,"note":"123"}]}`))
+	assert.Nil(err)
+	assert.Equal("zones:\n  - zone_id: 273165098782\n    name: zone1\n    enabled: true\n    note: \"123\"\n", buf.String())
+}
+
+// TestRenderTable tests the table rendering.
+func TestRenderTable(t *testing.T) {
+	assert := assert.New(t)
+	var buf bytes.Buffer
+	err := renderTable(&buf, []byte(`{"next_cursor":"abc","zones":[{"zone_id":1,"name":"zone1"},{"zone_id":2,"name":"zone2","tags":["a"]}]}`))
+	assert.Nil(err)
+	assert.Equal("ZONE_ID   NAME    TAGS\n1         zone1   \n2         zone2   [\"a\"]\nnext_cursor: abc\n", buf.String())
+
+	buf.Reset()
+	err = renderTable(&buf, []byte(`{"error_code":"08002","error_message":"invalid"}`))
+	assert.Nil(err)
+	assert.Equal("ERROR_CODE   ERROR_MESSAGE\n08002        invalid\n", buf.String())
+}
+
+// TestRenderTemplate tests the template rendering.
+func TestRenderTemplate(t *testing.T) {
+	assert := assert.New(t)
+	tmpl := template.Must(template.New("output").Parse("{{.zone_id}}\t{{.name}}"))
+	var buf bytes.Buffer
+	err := renderTemplate(&buf, tmpl, []byte(`{"zones":[{"zone_id":1,"name":"zone1"},{"zone_id":2,"name":"zone2"}]}`))
+	assert.Nil(err)
+	assert.Equal("1\tzone1\n2\tzone2\n", buf.String())
+
+	buf.Reset()
+	err = renderTemplate(&buf, tmpl, []byte(`{"zone_id":3,"name":"zone3"}`))
+	assert.Nil(err)
+	assert.Equal("3\tzone3\n", buf.String())
+}
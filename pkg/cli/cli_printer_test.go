@@ -0,0 +1,77 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// newCliPrinterForTest creates a printer writing to a buffer.
+func newCliPrinterForTest(t *testing.T, output string) (*CliPrinterTerminal, *bytes.Buffer) {
+	printer, err := NewCliPrinterTerminal(false, output)
+	assert.Nil(t, err)
+	var buf bytes.Buffer
+	printer.writer = &buf
+	return printer, &buf
+}
+
+// TestCliPrinterFormattedOutput tests that the formatted outputs are written to the printer writer.
+func TestCliPrinterFormattedOutput(t *testing.T) {
+	tests := []struct {
+		output   string
+		expected string
+	}{
+		{output: "json", expected: "{\"name\":\"zone1\"}\n"},
+		{output: "yaml", expected: "name: zone1\n"},
+		{output: "table", expected: "NAME\nzone1\n"},
+		{output: "template={{.name}}", expected: "zone1\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.output, func(t *testing.T) {
+			printer, buf := newCliPrinterForTest(t, test.output)
+			printer.PrintlnMap(map[string]any{"name": "zone1"})
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+// TestCliPrinterErrorWithOutput tests that the errors are printed in the structured outputs.
+func TestCliPrinterErrorWithOutput(t *testing.T) {
+	err := azerrors.WrapSystemErrorWithMessage(azerrors.ErrCliArguments, "invalid zone id")
+	tests := []struct {
+		name     string
+		output   string
+		expected string
+	}{
+		{name: "json", output: "json", expected: "{\"error_code\":\"08002\",\"error_message\":\"cli: invalid arguments\"}\n"},
+		{name: "yaml", output: "yaml", expected: "error_code: \"08002\"\nerror_message: 'cli: invalid arguments'\n"},
+		{name: "template with the error fields", output: "template={{.error_code}} {{.error_message}}", expected: "08002 cli: invalid arguments\n"},
+		{name: "template without the error fields", output: "template={{.name}}", expected: "{\"error_code\":\"08002\",\"error_message\":\"cli: invalid arguments\"}\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			printer, buf := newCliPrinterForTest(t, test.output)
+			printer.Error(err)
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
@@ -124,6 +124,35 @@ func OverrideViperFromConfig(v *viper.Viper, valueMap map[string]interface{}) er
 	return newViper.WriteConfigAs(v.ConfigFileUsed())
 }
 
+// DeleteViperConfigKeys deletes the keys from the viper config, the segments of a nested key are separated by dots.
+func DeleteViperConfigKeys(v *viper.Viper, keys ...string) error {
+	newViper, err := NewViperFromConfig(nil)
+	if err != nil {
+		return err
+	}
+	fileSettings := newViper.AllSettings()
+	for _, key := range keys {
+		settings := fileSettings
+		segments := strings.Split(strings.ToLower(key), ".")
+		for i, segment := range segments {
+			if i == len(segments)-1 {
+				delete(settings, segment)
+				break
+			}
+			nested, ok := settings[segment].(map[string]interface{})
+			if !ok {
+				break
+			}
+			settings = nested
+		}
+	}
+	cleanViper := viper.New()
+	for key, value := range fileSettings {
+		cleanViper.Set(key, value)
+	}
+	return cleanViper.WriteConfigAs(v.ConfigFileUsed())
+}
+
 // Viperize creates a new viper and a new cobra command.
 func Viperize(funcs ...func(*flag.FlagSet) error) (*viper.Viper, *cobra.Command, error) {
 	viper := viper.New()
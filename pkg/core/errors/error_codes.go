@@ -49,6 +49,9 @@ var errorCodes = map[string]string{
 	"04115": "client: update conflict",
 	"04116": "client: invalid SHA256 hash",
 
+	// 042xx: Client Authentication Errors
+	"04200": "client: caller not authenticated",
+
 	// 05xxx: Server Errors
 	"05000": "server: generic error",
 	"05001": "server: infrastructure error",
@@ -122,16 +125,17 @@ var (
 	// 01xxx configuration errors.
 	ErrConfigurationGeneric error = NewSystemError("01000")
 	// 04xxx client errors.
-	ErrClientGeneric        error = NewSystemError("04000")
-	ErrClientParameter      error = NewSystemError("04100")
-	ErrClientPagination     error = NewSystemError("04101")
-	ErrClientEntity         error = NewSystemError("04110")
-	ErrClientID             error = NewSystemError("04111")
-	ErrClientUUID           error = NewSystemError("04112")
-	ErrClientName           error = NewSystemError("04113")
-	ErrClientNotFound       error = NewSystemError("04114")
-	ErrClientUpdateConflict error = NewSystemError("04115")
-	ErrClientSHA256         error = NewSystemError("04116")
+	ErrClientGeneric         error = NewSystemError("04000")
+	ErrClientParameter       error = NewSystemError("04100")
+	ErrClientPagination      error = NewSystemError("04101")
+	ErrClientEntity          error = NewSystemError("04110")
+	ErrClientID              error = NewSystemError("04111")
+	ErrClientUUID            error = NewSystemError("04112")
+	ErrClientName            error = NewSystemError("04113")
+	ErrClientNotFound        error = NewSystemError("04114")
+	ErrClientUpdateConflict  error = NewSystemError("04115")
+	ErrClientSHA256          error = NewSystemError("04116")
+	ErrClientUnauthenticated error = NewSystemError("04200")
 	// 05xxx server errors.
 	ErrServerGeneric               error = NewSystemError("05000")
 	ErrServerInfrastructure        error = NewSystemError("05001")
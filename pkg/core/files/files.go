@@ -230,6 +230,19 @@ func shouldIgnore(path string, root string, ignorePatterns []string) bool {
 	return ignored
 }
 
+// IsIgnoredPath checks if a path or one of its parent directories below the root is ignored by the patterns.
+func IsIgnoredPath(path string, root string, ignorePatterns []string) bool {
+	for current := path; current != root && strings.HasPrefix(current, root); current = filepath.Dir(current) {
+		if shouldIgnore(current, root, ignorePatterns) {
+			return true
+		}
+		if parent := filepath.Dir(current); parent == current {
+			break
+		}
+	}
+	return false
+}
+
 // ListDirectories lists directories.
 func ListDirectories(path string) ([]string, error) {
 	var directories []string
@@ -318,7 +331,7 @@ func WriteCSVStream(filename string, header []string, records any, rowFunc func(
 	}
 	csvWriter := csv.NewWriter(writer)
 	defer csvWriter.Flush()
-	if header == nil {
+	if header != nil {
 		if err := csvWriter.Write(header); err != nil {
 			return err
 		}
@@ -182,3 +182,57 @@ age = 30
 	err = os.RemoveAll(folderName)
 	assert.Nil(err)
 }
+
+func TestWriteAndReadCSVStream(t *testing.T) {
+	assert := assert.New(t)
+
+	folderName := filepath.Join(".tmp", uuid.NewString())
+	err := os.MkdirAll(folderName, 0755)
+	assert.Nil(err)
+
+	for _, compressed := range []bool{false, true} {
+		fileName := filepath.Join(folderName, "records.csv")
+		header := []string{"id", "name"}
+		records := [][]string{{"1", "rent-a-car"}, {"2", "magicfarmacia"}}
+
+		// Write the CSV stream with the header
+		err = WriteCSVStream(fileName, header, records, func(record any) []string { return record.([]string) }, compressed)
+		assert.Nil(err)
+
+		// Read the CSV stream skipping the header
+		readRecords := [][]string{}
+		err = ReadCSVStream(fileName, header, func(record []string) error {
+			readRecords = append(readRecords, record)
+			return nil
+		}, compressed)
+		assert.Nil(err)
+		assert.Equal(records, readRecords)
+	}
+
+	// Clean up the test folder
+	err = os.RemoveAll(folderName)
+	assert.Nil(err)
+}
+
+func TestIsIgnoredPath(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	err := os.MkdirAll(filepath.Join(root, "vendor", "policies"), 0755)
+	assert.Nil(err)
+	ignoredFile := filepath.Join(root, "vendor", "policies", "platform.cedar")
+	err = os.WriteFile(ignoredFile, []byte(""), 0644)
+	assert.Nil(err)
+	draftFile := filepath.Join(root, "draft.cedar")
+	err = os.WriteFile(draftFile, []byte(""), 0644)
+	assert.Nil(err)
+	keptFile := filepath.Join(root, "platform.cedar")
+	err = os.WriteFile(keptFile, []byte(""), 0644)
+	assert.Nil(err)
+
+	patterns := []string{"vendor", "*.cedar", "!platform.cedar"}
+	assert.True(IsIgnoredPath(ignoredFile, root, patterns))
+	assert.True(IsIgnoredPath(draftFile, root, patterns))
+	assert.False(IsIgnoredPath(keptFile, root, patterns))
+	assert.False(IsIgnoredPath(root, root, patterns))
+}
@@ -18,6 +18,7 @@ package validators
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	azvalidators "github.com/permguard/permguard-common/pkg/extensions/validators"
@@ -111,3 +112,14 @@ func ValidateName(entity string, name string) error {
 	}
 	return nil
 }
+
+// namePrefixPattern is the pattern of a name prefix, a name prefix may end with any name character.
+var namePrefixPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9\-\._]*$`)
+
+// ValidateNamePrefix validates a name prefix.
+func ValidateNamePrefix(entity string, namePrefix string) error {
+	if !namePrefixPattern.MatchString(namePrefix) {
+		return fmt.Errorf("validators: %s name prefix %s is not valid. %w", entity, namePrefix, azerrors.ErrClientName)
+	}
+	return nil
+}
@@ -115,3 +115,31 @@ func TestValidateName(t *testing.T) {
 		}
 	}
 }
+
+// TestValidateNamePrefix tests the ValidateNamePrefix function.
+func TestValidateNamePrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		entity     string
+		namePrefix string
+		hasError   bool
+	}{
+		{"zone", "", true},
+		{"zone", "r", false},
+		{"zone", "rent-", false},
+		{"zone", "rent_a.", false},
+		{"zone", "-rent", true},
+		{"zone", "Rent", true},
+		{"zone", "rent%", true},
+	}
+	for _, tc := range testCases {
+		result := ValidateNamePrefix(tc.entity, tc.namePrefix)
+		if tc.hasError {
+			assert.NotNil(result, "error should not be nil")
+			assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientName, result), "error should be ErrClientName")
+		} else {
+			assert.Nil(result, "error should be nil")
+		}
+	}
+}
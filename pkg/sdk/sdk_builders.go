@@ -0,0 +1,321 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+const (
+	// PolicyStoreKindLedger is the kind of the policy store backed by a ledger.
+	PolicyStoreKindLedger = "ledger"
+	// DefaultIdentityType is the default type of the principal and the subject.
+	DefaultIdentityType = "user"
+)
+
+// PrincipalBuilder builds a principal.
+type PrincipalBuilder struct {
+	principal *azmodelspdp.Principal
+}
+
+// NewPrincipalBuilder creates a new principal builder.
+func NewPrincipalBuilder(id string) *PrincipalBuilder {
+	return &PrincipalBuilder{
+		principal: &azmodelspdp.Principal{ID: id, Type: DefaultIdentityType},
+	}
+}
+
+// WithKind sets the type of the principal.
+func (b *PrincipalBuilder) WithKind(principalType string) *PrincipalBuilder {
+	b.principal.Type = principalType
+	return b
+}
+
+// WithSource sets the source of the principal.
+func (b *PrincipalBuilder) WithSource(source string) *PrincipalBuilder {
+	b.principal.Source = source
+	return b
+}
+
+// WithIdentityToken sets the identity token of the principal.
+func (b *PrincipalBuilder) WithIdentityToken(token string) *PrincipalBuilder {
+	b.principal.IdentityToken = token
+	return b
+}
+
+// WithAccessToken sets the access token of the principal.
+func (b *PrincipalBuilder) WithAccessToken(token string) *PrincipalBuilder {
+	b.principal.AccessToken = token
+	return b
+}
+
+// Build builds the principal.
+func (b *PrincipalBuilder) Build() *azmodelspdp.Principal {
+	principal := *b.principal
+	return &principal
+}
+
+// SubjectBuilder builds a subject.
+type SubjectBuilder struct {
+	subject *azmodelspdp.Subject
+}
+
+// NewSubjectBuilder creates a new subject builder.
+func NewSubjectBuilder(id string) *SubjectBuilder {
+	return &SubjectBuilder{
+		subject: &azmodelspdp.Subject{ID: id, Type: DefaultIdentityType},
+	}
+}
+
+// WithKind sets the type of the subject.
+func (b *SubjectBuilder) WithKind(subjectType string) *SubjectBuilder {
+	b.subject.Type = subjectType
+	return b
+}
+
+// WithSource sets the source of the subject.
+func (b *SubjectBuilder) WithSource(source string) *SubjectBuilder {
+	b.subject.Source = source
+	return b
+}
+
+// WithProperty sets a property of the subject.
+func (b *SubjectBuilder) WithProperty(key string, value any) *SubjectBuilder {
+	b.subject.Properties = withProperty(b.subject.Properties, key, value)
+	return b
+}
+
+// Build builds the subject.
+func (b *SubjectBuilder) Build() *azmodelspdp.Subject {
+	subject := *b.subject
+	subject.Properties = copyProperties(b.subject.Properties)
+	return &subject
+}
+
+// ResourceBuilder builds a resource.
+type ResourceBuilder struct {
+	resource *azmodelspdp.Resource
+}
+
+// NewResourceBuilder creates a new resource builder.
+func NewResourceBuilder(resourceType string) *ResourceBuilder {
+	return &ResourceBuilder{
+		resource: &azmodelspdp.Resource{Type: resourceType},
+	}
+}
+
+// WithID sets the identifier of the resource.
+func (b *ResourceBuilder) WithID(id string) *ResourceBuilder {
+	b.resource.ID = id
+	return b
+}
+
+// WithProperty sets a property of the resource.
+func (b *ResourceBuilder) WithProperty(key string, value any) *ResourceBuilder {
+	b.resource.Properties = withProperty(b.resource.Properties, key, value)
+	return b
+}
+
+// Build builds the resource.
+func (b *ResourceBuilder) Build() *azmodelspdp.Resource {
+	resource := *b.resource
+	resource.Properties = copyProperties(b.resource.Properties)
+	return &resource
+}
+
+// ActionBuilder builds an action.
+type ActionBuilder struct {
+	action *azmodelspdp.Action
+}
+
+// NewActionBuilder creates a new action builder.
+func NewActionBuilder(name string) *ActionBuilder {
+	return &ActionBuilder{
+		action: &azmodelspdp.Action{Name: name},
+	}
+}
+
+// WithProperty sets a property of the action.
+func (b *ActionBuilder) WithProperty(key string, value any) *ActionBuilder {
+	b.action.Properties = withProperty(b.action.Properties, key, value)
+	return b
+}
+
+// Build builds the action.
+func (b *ActionBuilder) Build() *azmodelspdp.Action {
+	action := *b.action
+	action.Properties = copyProperties(b.action.Properties)
+	return &action
+}
+
+// ContextBuilder builds the context of a request.
+type ContextBuilder struct {
+	context map[string]any
+}
+
+// NewContextBuilder creates a new context builder.
+func NewContextBuilder() *ContextBuilder {
+	return &ContextBuilder{
+		context: map[string]any{},
+	}
+}
+
+// WithProperty sets a property of the context.
+func (b *ContextBuilder) WithProperty(key string, value any) *ContextBuilder {
+	b.context[key] = value
+	return b
+}
+
+// Build builds the context.
+func (b *ContextBuilder) Build() map[string]any {
+	return copyProperties(b.context)
+}
+
+// EvaluationBuilder builds an evaluation.
+type EvaluationBuilder struct {
+	evaluation *azmodelspdp.EvaluationRequest
+}
+
+// NewEvaluationBuilder creates a new evaluation builder.
+func NewEvaluationBuilder(subject *azmodelspdp.Subject, resource *azmodelspdp.Resource, action *azmodelspdp.Action) *EvaluationBuilder {
+	return &EvaluationBuilder{
+		evaluation: &azmodelspdp.EvaluationRequest{Subject: subject, Resource: resource, Action: action},
+	}
+}
+
+// WithRequestID sets the identifier of the evaluation.
+func (b *EvaluationBuilder) WithRequestID(requestID string) *EvaluationBuilder {
+	b.evaluation.RequestID = requestID
+	return b
+}
+
+// WithContext sets the context of the evaluation.
+func (b *EvaluationBuilder) WithContext(context map[string]any) *EvaluationBuilder {
+	b.evaluation.Context = context
+	return b
+}
+
+// Build builds the evaluation.
+func (b *EvaluationBuilder) Build() azmodelspdp.EvaluationRequest {
+	return *b.evaluation
+}
+
+// AuthorizationCheckBuilder builds an authorization check request.
+type AuthorizationCheckBuilder struct {
+	request *azmodelspdp.AuthorizationCheckWithDefaultsRequest
+}
+
+// NewAuthorizationCheckBuilder creates a new authorization check builder for the policy store of the zone.
+func NewAuthorizationCheckBuilder(zoneID int64, ledgerID string) *AuthorizationCheckBuilder {
+	return &AuthorizationCheckBuilder{
+		request: &azmodelspdp.AuthorizationCheckWithDefaultsRequest{
+			AuthorizationCheckRequest: azmodelspdp.AuthorizationCheckRequest{
+				AuthorizationModel: &azmodelspdp.AuthorizationModelRequest{
+					ZoneID: zoneID,
+					PolicyStore: &azmodelspdp.PolicyStore{
+						Kind: PolicyStoreKindLedger,
+						ID:   ledgerID,
+					},
+				},
+			},
+		},
+	}
+}
+
+// WithRequestID sets the identifier of the request.
+func (b *AuthorizationCheckBuilder) WithRequestID(requestID string) *AuthorizationCheckBuilder {
+	b.request.RequestID = requestID
+	return b
+}
+
+// WithPrincipal sets the principal of the request.
+func (b *AuthorizationCheckBuilder) WithPrincipal(principal *azmodelspdp.Principal) *AuthorizationCheckBuilder {
+	b.request.AuthorizationModel.Principal = principal
+	return b
+}
+
+// WithEntities sets the entities of the request.
+func (b *AuthorizationCheckBuilder) WithEntities(schema string, items []map[string]any) *AuthorizationCheckBuilder {
+	b.request.AuthorizationModel.Entities = &azmodelspdp.Entities{Schema: schema, Items: items}
+	return b
+}
+
+// WithSubject sets the subject of the request.
+func (b *AuthorizationCheckBuilder) WithSubject(subject *azmodelspdp.Subject) *AuthorizationCheckBuilder {
+	b.request.Subject = subject
+	return b
+}
+
+// WithResource sets the resource of the request.
+func (b *AuthorizationCheckBuilder) WithResource(resource *azmodelspdp.Resource) *AuthorizationCheckBuilder {
+	b.request.Resource = resource
+	return b
+}
+
+// WithAction sets the action of the request.
+func (b *AuthorizationCheckBuilder) WithAction(action *azmodelspdp.Action) *AuthorizationCheckBuilder {
+	b.request.Action = action
+	return b
+}
+
+// WithContext sets the context of the request.
+func (b *AuthorizationCheckBuilder) WithContext(context map[string]any) *AuthorizationCheckBuilder {
+	b.request.Context = context
+	return b
+}
+
+// WithEvaluation adds an evaluation to the request.
+func (b *AuthorizationCheckBuilder) WithEvaluation(evaluation azmodelspdp.EvaluationRequest) *AuthorizationCheckBuilder {
+	b.request.Evaluations = append(b.request.Evaluations, evaluation)
+	return b
+}
+
+// WithEvaluationsSemantic sets the semantic of the evaluations.
+func (b *AuthorizationCheckBuilder) WithEvaluationsSemantic(semantic string) *AuthorizationCheckBuilder {
+	b.request.Options = &azmodelspdp.EvaluationsOptions{EvaluationsSemantic: semantic}
+	return b
+}
+
+// Build builds the authorization check request.
+func (b *AuthorizationCheckBuilder) Build() *azmodelspdp.AuthorizationCheckWithDefaultsRequest {
+	request := *b.request
+	authzModel := *b.request.AuthorizationModel
+	request.AuthorizationModel = &authzModel
+	request.Evaluations = append([]azmodelspdp.EvaluationRequest(nil), b.request.Evaluations...)
+	return &request
+}
+
+// withProperty sets the property creating the properties when missing.
+func withProperty(properties map[string]any, key string, value any) map[string]any {
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	properties[key] = value
+	return properties
+}
+
+// copyProperties returns a shallow copy of the properties.
+func copyProperties(properties map[string]any) map[string]any {
+	if properties == nil {
+		return nil
+	}
+	copied := make(map[string]any, len(properties))
+	for key, value := range properties {
+		copied[key] = value
+	}
+	return copied
+}
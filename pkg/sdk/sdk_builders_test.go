@@ -0,0 +1,51 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAuthorizationCheckBuilder tests the building of the authorization check request.
+func TestAuthorizationCheckBuilder(t *testing.T) {
+	assert := assert.New(t)
+	resource := NewResourceBuilder("MagicFarmacia::Platform::Subscription").WithID("e3a786fd").WithProperty("active", true)
+	request := NewAuthorizationCheckBuilder(273165098782, "fd1ac44e").
+		WithRequestID("abc1").
+		WithPrincipal(NewPrincipalBuilder("amy.smith@acmecorp.com").WithSource("keycloak").Build()).
+		WithSubject(NewSubjectBuilder("platform-creator").WithKind("role-actor").WithProperty("isSuperUser", true).Build()).
+		WithResource(resource.Build()).
+		WithAction(NewActionBuilder("MagicFarmacia::Platform::Action::create").Build()).
+		WithContext(NewContextBuilder().WithProperty("time", "2025-01-23T16:17:46+00:00").Build()).
+		Build()
+
+	assert.Equal(int64(273165098782), request.AuthorizationModel.ZoneID)
+	assert.Equal(PolicyStoreKindLedger, request.AuthorizationModel.PolicyStore.Kind)
+	assert.Equal("fd1ac44e", request.AuthorizationModel.PolicyStore.ID)
+	assert.Equal(DefaultIdentityType, request.AuthorizationModel.Principal.Type)
+	assert.Equal("keycloak", request.AuthorizationModel.Principal.Source)
+	assert.Equal("role-actor", request.Subject.Type)
+	assert.Equal(true, request.Subject.Properties["isSuperUser"])
+	assert.Equal("e3a786fd", request.Resource.ID)
+	assert.Equal("MagicFarmacia::Platform::Action::create", request.Action.Name)
+	assert.Equal("2025-01-23T16:17:46+00:00", request.Context["time"])
+
+	resource.WithProperty("active", false)
+	assert.Equal(true, request.Resource.Properties["active"])
+}
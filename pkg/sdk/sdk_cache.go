@@ -0,0 +1,157 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// decisionCacheEntry is a cached decision.
+type decisionCacheEntry struct {
+	response  *azmodelspdp.AuthorizationCheckResponse
+	expiresAt time.Time
+}
+
+// decisionCache caches the authorization check responses for a ttl.
+type decisionCache struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	size    int
+	entries map[string]decisionCacheEntry
+	now     func() time.Time
+}
+
+// newDecisionCache creates a new decision cache.
+func newDecisionCache(ttl time.Duration, size int) *decisionCache {
+	return &decisionCache{
+		ttl:     ttl,
+		size:    size,
+		entries: map[string]decisionCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// decisionCacheKey returns the key of the request, the request id is not part of the key.
+func decisionCacheKey(request *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (string, bool) {
+	keyRequest := *request
+	keyRequest.RequestID = ""
+	data, err := json.Marshal(keyRequest)
+	if err != nil {
+		return "", false
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), true
+}
+
+// get returns the cached response if it has not expired.
+func (c *decisionCache) get(key string) (*azmodelspdp.AuthorizationCheckResponse, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return copyAuthorizationCheckResponse(entry.response), true
+}
+
+// put caches the response, the expired entries are evicted when the cache is full.
+func (c *decisionCache) put(key string, response *azmodelspdp.AuthorizationCheckResponse) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := c.now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		oldestKey := ""
+		var oldestExpiresAt time.Time
+		for entryKey, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, entryKey)
+				continue
+			}
+			if oldestKey == "" || entry.expiresAt.Before(oldestExpiresAt) {
+				oldestKey = entryKey
+				oldestExpiresAt = entry.expiresAt
+			}
+		}
+		if len(c.entries) >= c.size {
+			delete(c.entries, oldestKey)
+		}
+	}
+	c.entries[key] = decisionCacheEntry{
+		response:  copyAuthorizationCheckResponse(response),
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+// isDecisionCacheable returns true if the response can be cached, the responses with an admin reason are not cached.
+func isDecisionCacheable(response *azmodelspdp.AuthorizationCheckResponse) bool {
+	if response == nil {
+		return false
+	}
+	if response.Context != nil && response.Context.ReasonAdmin != nil {
+		return false
+	}
+	for _, evaluation := range response.Evaluations {
+		if evaluation.Context != nil && evaluation.Context.ReasonAdmin != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// copyContextResponse returns a copy of the context response.
+func copyContextResponse(context *azmodelspdp.ContextResponse) *azmodelspdp.ContextResponse {
+	if context == nil {
+		return nil
+	}
+	contextCopy := *context
+	if context.ReasonAdmin != nil {
+		reasonAdmin := *context.ReasonAdmin
+		contextCopy.ReasonAdmin = &reasonAdmin
+	}
+	if context.ReasonUser != nil {
+		reasonUser := *context.ReasonUser
+		contextCopy.ReasonUser = &reasonUser
+	}
+	return &contextCopy
+}
+
+// copyAuthorizationCheckResponse returns a copy of the response so that the callers cannot change the cached one.
+func copyAuthorizationCheckResponse(response *azmodelspdp.AuthorizationCheckResponse) *azmodelspdp.AuthorizationCheckResponse {
+	if response == nil {
+		return nil
+	}
+	responseCopy := *response
+	responseCopy.Context = copyContextResponse(response.Context)
+	if response.Evaluations != nil {
+		responseCopy.Evaluations = make([]azmodelspdp.EvaluationResponse, len(response.Evaluations))
+		for i, evaluation := range response.Evaluations {
+			evaluation.Context = copyContextResponse(evaluation.Context)
+			responseCopy.Evaluations[i] = evaluation
+		}
+	}
+	return &responseCopy
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// TestDecisionCache tests the expiration and the eviction of the cached decisions.
+func TestDecisionCache(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	cache := newDecisionCache(time.Minute, 2)
+	cache.now = func() time.Time { return now }
+
+	cache.put("a", &azmodelspdp.AuthorizationCheckResponse{Decision: true})
+	now = now.Add(time.Second)
+	cache.put("b", &azmodelspdp.AuthorizationCheckResponse{Decision: false})
+	response, ok := cache.get("a")
+	assert.True(ok)
+	assert.True(response.Decision)
+
+	cache.put("c", &azmodelspdp.AuthorizationCheckResponse{Decision: true})
+	_, ok = cache.get("a")
+	assert.False(ok)
+	_, ok = cache.get("b")
+	assert.True(ok)
+
+	now = now.Add(time.Minute)
+	_, ok = cache.get("c")
+	assert.False(ok)
+}
+
+// TestDecisionCacheKey tests that the request identifier is not part of the cache key.
+func TestDecisionCacheKey(t *testing.T) {
+	assert := assert.New(t)
+	first := NewAuthorizationCheckBuilder(1, "ledger").WithRequestID("1").WithAction(NewActionBuilder("view").Build()).Build()
+	second := NewAuthorizationCheckBuilder(1, "ledger").WithRequestID("2").WithAction(NewActionBuilder("view").Build()).Build()
+	third := NewAuthorizationCheckBuilder(1, "ledger").WithAction(NewActionBuilder("edit").Build()).Build()
+	firstKey, ok := decisionCacheKey(first)
+	assert.True(ok)
+	secondKey, _ := decisionCacheKey(second)
+	thirdKey, _ := decisionCacheKey(third)
+	assert.Equal(firstKey, secondKey)
+	assert.NotEqual(firstKey, thirdKey)
+	assert.Equal("1", first.RequestID)
+}
+
+// TestDecisionCacheReturnsCopies tests that the cached responses cannot be changed by the callers.
+func TestDecisionCacheReturnsCopies(t *testing.T) {
+	assert := assert.New(t)
+	cache := newDecisionCache(time.Minute, 2)
+	response := &azmodelspdp.AuthorizationCheckResponse{
+		Decision:    true,
+		Context:     &azmodelspdp.ContextResponse{ReasonUser: &azmodelspdp.ReasonResponse{Code: "200"}},
+		Evaluations: []azmodelspdp.EvaluationResponse{{Decision: true}},
+	}
+	cache.put("a", response)
+	response.Decision = false
+	response.Context.ReasonUser.Code = "403"
+
+	cached, ok := cache.get("a")
+	assert.True(ok)
+	assert.True(cached.Decision)
+	assert.Equal("200", cached.Context.ReasonUser.Code)
+	cached.Decision = false
+	cached.Evaluations[0].Decision = false
+
+	cached, ok = cache.get("a")
+	assert.True(ok)
+	assert.True(cached.Decision)
+	assert.True(cached.Evaluations[0].Decision)
+}
+
+// TestIsDecisionCacheable tests that the responses with an admin reason are not cacheable.
+func TestIsDecisionCacheable(t *testing.T) {
+	assert := assert.New(t)
+	adminReason := &azmodelspdp.ContextResponse{ReasonAdmin: &azmodelspdp.ReasonResponse{Code: "403"}}
+	assert.True(isDecisionCacheable(&azmodelspdp.AuthorizationCheckResponse{Decision: true}))
+	assert.False(isDecisionCacheable(nil))
+	assert.False(isDecisionCacheable(&azmodelspdp.AuthorizationCheckResponse{Context: adminReason}))
+	assert.False(isDecisionCacheable(&azmodelspdp.AuthorizationCheckResponse{
+		Evaluations: []azmodelspdp.EvaluationResponse{{Decision: true}, {Context: adminReason}},
+	}))
+}
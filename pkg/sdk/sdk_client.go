@@ -0,0 +1,185 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	azapiv1pdp "github.com/permguard/permguard/internal/agents/services/pdp/endpoints/api/v1"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// Checker checks the authorization requests.
+type Checker interface {
+	// Check checks the authorization request.
+	Check(ctx context.Context, request *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (*azmodelspdp.AuthorizationCheckResponse, error)
+}
+
+// AZClient is a long-lived client for the PDP service sharing a single connection across the calls.
+type AZClient struct {
+	config *AZConfig
+	conn   *grpc.ClientConn
+	client azapiv1pdp.V1PDPServiceClient
+	cache  *decisionCache
+}
+
+// NewAZClient creates a new client for the PDP service.
+func NewAZClient(opts ...AZOption) (*AZClient, error) {
+	config := newAZConfig()
+	for _, opt := range opts {
+		if err := opt(config); err != nil {
+			return nil, err
+		}
+	}
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), aztelemetry.GrpcClientStatsHandler()}
+	dialOptions = append(dialOptions, config.dialOptions...)
+	conn, err := grpc.NewClient(config.endpoint, dialOptions...)
+	if err != nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientGeneric, "cannot create the pdp connection")
+	}
+	client := &AZClient{
+		config: config,
+		conn:   conn,
+		client: azapiv1pdp.NewV1PDPServiceClient(conn),
+	}
+	if config.cacheTTL > 0 {
+		client.cache = newDecisionCache(config.cacheTTL, config.cacheSize)
+	}
+	return client, nil
+}
+
+// Close closes the connection to the PDP service.
+func (c *AZClient) Close() error {
+	return c.conn.Close()
+}
+
+// isRetryable returns true if the call can be retried.
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// invoke invokes the call applying the deadline to each attempt and retrying the transient failures.
+func invoke[T any](ctx context.Context, config *AZConfig, call func(ctx context.Context) (T, error)) (T, error) {
+	backoff := config.retryBackoff
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, config.timeout)
+		result, err := call(attemptCtx)
+		cancel()
+		if err == nil || attempt >= config.maxRetries || !isRetryable(err) || ctx.Err() != nil {
+			return result, err
+		}
+		select {
+		case <-ctx.Done():
+			return result, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// Check checks the authorization request, the decision is served from the cache when enabled.
+// The responses carrying an admin reason are never cached.
+func (c *AZClient) Check(ctx context.Context, request *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (*azmodelspdp.AuthorizationCheckResponse, error) {
+	if request == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "request is required")
+	}
+	cacheKey, cacheable := "", false
+	if c.cache != nil {
+		cacheKey, cacheable = decisionCacheKey(request)
+		if cacheable {
+			if response, ok := c.cache.get(cacheKey); ok {
+				return response, nil
+			}
+		}
+	}
+	req, err := azapiv1pdp.MapAgentAuthorizationCheckRequestToGrpcAuthorizationCheckRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	grpcResponse, err := invoke(ctx, c.config, func(ctx context.Context) (*azapiv1pdp.AuthorizationCheckResponse, error) {
+		return c.client.AuthorizationCheck(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	response, err := azapiv1pdp.MapGrpcAuthorizationCheckResponseToAgentAuthorizationCheckResponse(grpcResponse)
+	if err != nil {
+		return nil, err
+	}
+	if cacheable && isDecisionCacheable(response) {
+		c.cache.put(cacheKey, response)
+	}
+	return response, nil
+}
+
+// IsPermitted checks the authorization request and returns its decision.
+func (c *AZClient) IsPermitted(ctx context.Context, request *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (bool, error) {
+	response, err := c.Check(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return response.Decision, nil
+}
+
+// BulkCheck checks the evaluations in batches sharing the authorization model and the defaults of the request.
+func (c *AZClient) BulkCheck(ctx context.Context, request *azmodelspdp.AuthorizationCheckWithDefaultsRequest, evaluations []azmodelspdp.EvaluationRequest) ([]azmodelspdp.EvaluationResponse, error) {
+	if request == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "request is required")
+	}
+	results := make([]azmodelspdp.EvaluationResponse, 0, len(evaluations))
+	for start := 0; start < len(evaluations); start += c.config.bulkSize {
+		end := min(start+c.config.bulkSize, len(evaluations))
+		batch := *request
+		batch.Evaluations = evaluations[start:end]
+		response, err := c.Check(ctx, &batch)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Evaluations) != end-start {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "unexpected number of evaluations in the response")
+		}
+		results = append(results, response.Evaluations...)
+	}
+	return results, nil
+}
+
+// PartialEvaluation partially evaluates the authorization for the resources of a type and returns the residual conditions.
+func (c *AZClient) PartialEvaluation(ctx context.Context, request *azmodelspdp.PartialEvaluationRequest) (*azmodelspdp.PartialEvaluationResponse, error) {
+	req, err := azapiv1pdp.MapAgentPartialEvaluationRequestToGrpcPartialEvaluationRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	response, err := invoke(ctx, c.config, func(ctx context.Context) (*azapiv1pdp.PartialEvaluationResponse, error) {
+		return c.client.PartialEvaluation(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return azapiv1pdp.MapGrpcPartialEvaluationResponseToAgentPartialEvaluationResponse(response)
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	azapiv1pdp "github.com/permguard/permguard/internal/agents/services/pdp/endpoints/api/v1"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// fakePDPServer is a PDP server permitting every evaluation after a number of failures.
+type fakePDPServer struct {
+	azapiv1pdp.UnimplementedV1PDPServiceServer
+	failures int32
+	calls    atomic.Int32
+}
+
+// AuthorizationCheck checks the authorization request.
+func (s *fakePDPServer) AuthorizationCheck(_ context.Context, request *azapiv1pdp.AuthorizationCheckRequest) (*azapiv1pdp.AuthorizationCheckResponse, error) {
+	if s.calls.Add(1) <= s.failures {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	response := &azapiv1pdp.AuthorizationCheckResponse{Decision: true}
+	for _, evaluation := range request.Evaluations {
+		response.Evaluations = append(response.Evaluations, &azapiv1pdp.EvaluationResponse{RequestID: evaluation.RequestID, Decision: true})
+	}
+	return response, nil
+}
+
+// newTestAZClient creates a client connected to an in-process fake PDP server.
+func newTestAZClient(t *testing.T, server *fakePDPServer, opts ...AZOption) *AZClient {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	azapiv1pdp.RegisterV1PDPServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	dialer := grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})
+	opts = append([]AZOption{WithEndpoint("passthrough:///bufnet"), WithDialOptions(dialer), WithRetries(2, time.Millisecond)}, opts...)
+	client, err := NewAZClient(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// newTestRequest creates an authorization check request.
+func newTestRequest(requestID string) *azmodelspdp.AuthorizationCheckWithDefaultsRequest {
+	return NewAuthorizationCheckBuilder(273165098782, "fd1ac44e").
+		WithRequestID(requestID).
+		WithSubject(NewSubjectBuilder("amy.smith@acmecorp.com").Build()).
+		WithResource(NewResourceBuilder("MagicFarmacia::Platform::Subscription").WithID("e3a786fd").Build()).
+		WithAction(NewActionBuilder("MagicFarmacia::Platform::Action::view").Build()).
+		Build()
+}
+
+// TestNewAZClientWithInvalidOptions tests the creation of the client with invalid options.
+func TestNewAZClientWithInvalidOptions(t *testing.T) {
+	assert := assert.New(t)
+	_, err := NewAZClient(WithEndpoint(""))
+	assert.NotNil(err)
+	_, err = NewAZClient(WithBulkSize(0))
+	assert.NotNil(err)
+	_, err = NewAZClient(WithDecisionCache(0, 1))
+	assert.NotNil(err)
+}
+
+// TestAZClientCheckWithRetries tests the retries of the transient failures.
+func TestAZClientCheckWithRetries(t *testing.T) {
+	assert := assert.New(t)
+	server := &fakePDPServer{failures: 2}
+	client := newTestAZClient(t, server)
+	permitted, err := client.IsPermitted(context.Background(), newTestRequest("1"))
+	assert.Nil(err)
+	assert.True(permitted)
+	assert.Equal(int32(3), server.calls.Load())
+
+	server = &fakePDPServer{failures: 3}
+	client = newTestAZClient(t, server)
+	_, err = client.Check(context.Background(), newTestRequest("1"))
+	assert.Equal(codes.Unavailable, status.Code(err))
+}
+
+// TestAZClientCheckWithCache tests the caching of the decisions.
+func TestAZClientCheckWithCache(t *testing.T) {
+	assert := assert.New(t)
+	server := &fakePDPServer{}
+	client := newTestAZClient(t, server, WithDecisionCache(time.Minute, 10))
+	for _, requestID := range []string{"1", "2"} {
+		permitted, err := client.IsPermitted(context.Background(), newTestRequest(requestID))
+		assert.Nil(err)
+		assert.True(permitted)
+	}
+	assert.Equal(int32(1), server.calls.Load())
+}
+
+// TestAZClientBulkCheck tests the evaluations in batches.
+func TestAZClientBulkCheck(t *testing.T) {
+	assert := assert.New(t)
+	server := &fakePDPServer{}
+	client := newTestAZClient(t, server, WithBulkSize(2))
+	evaluations := []azmodelspdp.EvaluationRequest{}
+	for _, requestID := range []string{"1", "2", "3", "4", "5"} {
+		evaluations = append(evaluations, NewEvaluationBuilder(nil, nil, NewActionBuilder("view").Build()).WithRequestID(requestID).Build())
+	}
+	responses, err := client.BulkCheck(context.Background(), newTestRequest("bulk"), evaluations)
+	assert.Nil(err)
+	assert.Len(responses, 5)
+	for i, response := range responses {
+		assert.Equal(evaluations[i].RequestID, response.RequestID)
+		assert.True(response.Decision)
+	}
+	assert.Equal(int32(3), server.calls.Load())
+}
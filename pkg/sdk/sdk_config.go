@@ -0,0 +1,133 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// DefaultEndpoint is the default endpoint of the PDP.
+	DefaultEndpoint = "localhost:9094"
+	// DefaultTimeout is the default deadline of each attempt.
+	DefaultTimeout = 5 * time.Second
+	// DefaultMaxRetries is the default number of retries of a failed call.
+	DefaultMaxRetries = 3
+	// DefaultRetryBackoff is the default backoff before the first retry, it doubles on every retry.
+	DefaultRetryBackoff = 100 * time.Millisecond
+	// DefaultBulkSize is the default number of evaluations sent in a single request by the bulk evaluation.
+	DefaultBulkSize = 100
+	// DefaultCacheSize is the default maximum number of cached decisions.
+	DefaultCacheSize = 10000
+)
+
+// AZConfig is the configuration of the client.
+type AZConfig struct {
+	endpoint     string
+	timeout      time.Duration
+	maxRetries   int
+	retryBackoff time.Duration
+	bulkSize     int
+	cacheTTL     time.Duration
+	cacheSize    int
+	dialOptions  []grpc.DialOption
+}
+
+// AZOption is an option to configure the client.
+type AZOption func(*AZConfig) error
+
+// newAZConfig creates a new configuration with the default values.
+func newAZConfig() *AZConfig {
+	return &AZConfig{
+		endpoint:     DefaultEndpoint,
+		timeout:      DefaultTimeout,
+		maxRetries:   DefaultMaxRetries,
+		retryBackoff: DefaultRetryBackoff,
+		bulkSize:     DefaultBulkSize,
+		cacheSize:    DefaultCacheSize,
+	}
+}
+
+// WithEndpoint sets the endpoint of the PDP.
+func WithEndpoint(endpoint string) AZOption {
+	return func(c *AZConfig) error {
+		if len(strings.TrimSpace(endpoint)) == 0 {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "endpoint is required")
+		}
+		c.endpoint = endpoint
+		return nil
+	}
+}
+
+// WithTimeout sets the deadline of each attempt.
+func WithTimeout(timeout time.Duration) AZOption {
+	return func(c *AZConfig) error {
+		if timeout <= 0 {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "timeout must be greater than zero")
+		}
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithRetries sets the number of retries of a failed call and the backoff before the first retry.
+func WithRetries(maxRetries int, backoff time.Duration) AZOption {
+	return func(c *AZConfig) error {
+		if maxRetries < 0 || backoff < 0 {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "retries and backoff cannot be negative")
+		}
+		c.maxRetries = maxRetries
+		c.retryBackoff = backoff
+		return nil
+	}
+}
+
+// WithBulkSize sets the number of evaluations sent in a single request by the bulk evaluation.
+func WithBulkSize(bulkSize int) AZOption {
+	return func(c *AZConfig) error {
+		if bulkSize <= 0 {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "bulk size must be greater than zero")
+		}
+		c.bulkSize = bulkSize
+		return nil
+	}
+}
+
+// WithDecisionCache enables the caching of the decisions for the ttl, keeping at most size decisions.
+func WithDecisionCache(ttl time.Duration, size int) AZOption {
+	return func(c *AZConfig) error {
+		if ttl <= 0 || size <= 0 {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "cache ttl and size must be greater than zero")
+		}
+		c.cacheTTL = ttl
+		c.cacheSize = size
+		return nil
+	}
+}
+
+// WithDialOptions sets additional options to dial the PDP, such as the transport credentials.
+func WithDialOptions(options ...grpc.DialOption) AZOption {
+	return func(c *AZConfig) error {
+		c.dialOptions = append(c.dialOptions, options...)
+		return nil
+	}
+}
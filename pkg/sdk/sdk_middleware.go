@@ -0,0 +1,75 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// GrpcRequestResolver resolves the authorization check request of a gRPC call.
+type GrpcRequestResolver func(ctx context.Context, fullMethod string, req any) (*azmodelspdp.AuthorizationCheckWithDefaultsRequest, error)
+
+// HTTPRequestResolver resolves the authorization check request of an HTTP request.
+type HTTPRequestResolver func(r *http.Request) (*azmodelspdp.AuthorizationCheckWithDefaultsRequest, error)
+
+// UnaryServerInterceptor returns a gRPC interceptor which checks the authorization before the handler runs.
+func UnaryServerInterceptor(checker Checker, resolve GrpcRequestResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		request, err := resolve(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "authorization request cannot be resolved")
+		}
+		response, err := checker.Check(ctx, request)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "authorization cannot be checked")
+		}
+		if !response.Decision {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// HTTPMiddleware returns an HTTP middleware which checks the authorization before the handler runs.
+func HTTPMiddleware(checker Checker, resolve HTTPRequestResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request, err := resolve(r)
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				return
+			}
+			response, err := checker.Check(r.Context(), request)
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+				return
+			}
+			if !response.Decision {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
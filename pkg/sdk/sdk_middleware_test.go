@@ -0,0 +1,102 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// fakeChecker is a checker returning a fixed decision.
+type fakeChecker struct {
+	decision bool
+	err      error
+}
+
+// Check checks the authorization request.
+func (c *fakeChecker) Check(_ context.Context, _ *azmodelspdp.AuthorizationCheckWithDefaultsRequest) (*azmodelspdp.AuthorizationCheckResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &azmodelspdp.AuthorizationCheckResponse{Decision: c.decision}, nil
+}
+
+// TestHTTPMiddleware tests the enforcement of the authorization by the HTTP middleware.
+func TestHTTPMiddleware(t *testing.T) {
+	tests := []struct {
+		name    string
+		checker *fakeChecker
+		status  int
+	}{
+		{"permit", &fakeChecker{decision: true}, http.StatusOK},
+		{"deny", &fakeChecker{decision: false}, http.StatusForbidden},
+		{"error", &fakeChecker{err: errors.New("unavailable")}, http.StatusServiceUnavailable},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			resolve := func(r *http.Request) (*azmodelspdp.AuthorizationCheckWithDefaultsRequest, error) {
+				return NewAuthorizationCheckBuilder(1, "ledger").WithAction(NewActionBuilder(r.Method).Build()).Build(), nil
+			}
+			handler := HTTPMiddleware(test.checker, resolve)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+			assert.Equal(test.status, recorder.Code)
+		})
+	}
+}
+
+// TestUnaryServerInterceptor tests the enforcement of the authorization by the gRPC interceptor.
+func TestUnaryServerInterceptor(t *testing.T) {
+	tests := []struct {
+		name    string
+		checker *fakeChecker
+		code    codes.Code
+	}{
+		{"permit", &fakeChecker{decision: true}, codes.OK},
+		{"deny", &fakeChecker{decision: false}, codes.PermissionDenied},
+		{"error", &fakeChecker{err: errors.New("unavailable")}, codes.Unavailable},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			resolve := func(_ context.Context, fullMethod string, _ any) (*azmodelspdp.AuthorizationCheckWithDefaultsRequest, error) {
+				return NewAuthorizationCheckBuilder(1, "ledger").WithAction(NewActionBuilder(fullMethod).Build()).Build(), nil
+			}
+			interceptor := UnaryServerInterceptor(test.checker, resolve)
+			handled := false
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Method"}, func(_ context.Context, _ any) (any, error) {
+				handled = true
+				return nil, nil
+			})
+			assert.Equal(test.code, status.Code(err))
+			assert.Equal(test.code == codes.OK, handled)
+		})
+	}
+}
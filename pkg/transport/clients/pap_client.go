@@ -17,6 +17,7 @@
 package clients
 
 import (
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelpap "github.com/permguard/permguard/pkg/transport/models/pap"
 )
 
@@ -26,6 +27,12 @@ type GrpcPAPClient interface {
 	CreateLedger(zoneID int64, kind string, name string) (*azmodelpap.Ledger, error)
 	// UpdateLedger updates a ledger.
 	UpdateLedger(ledger *azmodelpap.Ledger) (*azmodelpap.Ledger, error)
+	// UpdateLedgerCandidate updates the candidate ref of a ledger evaluated in shadow mode.
+	UpdateLedgerCandidate(zoneID int64, ledgerID string, candidateRef string) (*azmodelpap.Ledger, error)
+	// PromoteLedgerCandidate promotes the candidate ref of a ledger to its active ref.
+	PromoteLedgerCandidate(zoneID int64, ledgerID string) (*azmodelpap.Ledger, error)
+	// UpdateLedgerProtection updates the number of approvals required to move the ref of a ledger.
+	UpdateLedgerProtection(zoneID int64, ledgerID string, requiredApprovals int32) (*azmodelpap.Ledger, error)
 	// DeleteLedger deletes a ledger.
 	DeleteLedger(zoneID int64, ledgerID string) (*azmodelpap.Ledger, error)
 	// FetchLedgers returns all ledgers.
@@ -36,4 +43,28 @@ type GrpcPAPClient interface {
 	FetchLedgersByName(page int32, pageSize int32, zoneID int64, name string) ([]azmodelpap.Ledger, error)
 	// FetchLedgersBy returns all ledgers filtering by ledger id and name.
 	FetchLedgersBy(page int32, pageSize int32, zoneID int64, ledgerID string, kind string, name string) ([]azmodelpap.Ledger, error)
+	// FetchLedgersByQuery returns the ledgers filtering by the criteria and the fetch query along with the cursor of the next page.
+	FetchLedgersByQuery(page int32, pageSize int32, zoneID int64, ledgerID string, kind string, name string, query *azmodels.FetchQuery) ([]azmodelpap.Ledger, string, error)
+	// CreateEntity creates an entity, the entity is validated against the ledger schema when the ledger id is not empty.
+	CreateEntity(ledgerID string, entity *azmodelpap.Entity) (*azmodelpap.Entity, error)
+	// UpdateEntity updates an entity, the entity is validated against the ledger schema when the ledger id is not empty.
+	UpdateEntity(ledgerID string, entity *azmodelpap.Entity) (*azmodelpap.Entity, error)
+	// UpsertEntities creates or replaces a set of entities.
+	UpsertEntities(zoneID int64, ledgerID string, entities []azmodelpap.Entity) ([]azmodelpap.Entity, error)
+	// DeleteEntity deletes an entity.
+	DeleteEntity(zoneID int64, entityType string, entityID string) (*azmodelpap.Entity, error)
+	// FetchEntitiesBy returns all entities filtering by entity type and entity id.
+	FetchEntitiesBy(page int32, pageSize int32, zoneID int64, entityType string, entityID string) ([]azmodelpap.Entity, error)
+	// CreateTemplateLink creates a template link binding the slots of a policy template to concrete entities.
+	CreateTemplateLink(link *azmodelpap.TemplateLink) (*azmodelpap.TemplateLink, error)
+	// DeleteTemplateLink deletes a template link.
+	DeleteTemplateLink(zoneID int64, ledgerID string, linkID string) (*azmodelpap.TemplateLink, error)
+	// FetchTemplateLinksBy returns all template links of a ledger filtering by template id and link id.
+	FetchTemplateLinksBy(page int32, pageSize int32, zoneID int64, ledgerID string, templateID string, linkID string) ([]azmodelpap.TemplateLink, error)
+	// FetchChangeRequestsBy returns all change requests of a ledger filtering by change request id and status.
+	FetchChangeRequestsBy(page int32, pageSize int32, zoneID int64, ledgerID string, changeRequestID string, status string) ([]azmodelpap.ChangeRequest, error)
+	// ApproveChangeRequest approves a pending change request.
+	ApproveChangeRequest(zoneID int64, ledgerID string, changeRequestID string, comment string) (*azmodelpap.ChangeRequest, error)
+	// RejectChangeRequest rejects a pending change request.
+	RejectChangeRequest(zoneID int64, ledgerID string, changeRequestID string, comment string) (*azmodelpap.ChangeRequest, error)
 }
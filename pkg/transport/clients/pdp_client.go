@@ -24,4 +24,12 @@ import (
 type GrpcPDPClient interface {
 	// AuthorizationCheck checks the authorization.
 	AuthorizationCheck(request *azmodelpdp.AuthorizationCheckWithDefaultsRequest) (*azmodelpdp.AuthorizationCheckResponse, error)
+	// SubjectSearch searches the subjects allowed to perform the action on the resource.
+	SubjectSearch(request *azmodelpdp.SubjectSearchRequest) (*azmodelpdp.SubjectSearchResponse, error)
+	// ResourceSearch searches the resources on which the subject is allowed to perform the action.
+	ResourceSearch(request *azmodelpdp.ResourceSearchRequest) (*azmodelpdp.ResourceSearchResponse, error)
+	// ActionSearch searches the actions the subject is allowed to perform on the resource.
+	ActionSearch(request *azmodelpdp.ActionSearchRequest) (*azmodelpdp.ActionSearchResponse, error)
+	// PartialEvaluation partially evaluates the authorization for the resources of a type and returns the residual conditions.
+	PartialEvaluation(request *azmodelpdp.PartialEvaluationRequest) (*azmodelpdp.PartialEvaluationResponse, error)
 }
@@ -17,6 +17,7 @@
 package clients
 
 import (
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
 	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
 )
 
@@ -36,6 +37,8 @@ type GrpcZAPClient interface {
 	FetchZonesByName(page int32, pageSize int32, name string) ([]azmodelzap.Zone, error)
 	// FetchZonesBy fetches zones by.
 	FetchZonesBy(page int32, pageSize int32, zoneID int64, name string) ([]azmodelzap.Zone, error)
+	// FetchZonesByQuery returns the zones filtering by the criteria and the fetch query along with the cursor of the next page.
+	FetchZonesByQuery(page int32, pageSize int32, zoneID int64, name string, query *azmodels.FetchQuery) ([]azmodelzap.Zone, string, error)
 	// CreateIdentity creates a new identity.
 	CreateIdentity(zoneID int64, identitySourceID string, kind string, name string) (*azmodelzap.Identity, error)
 	// UpdateIdentity updates an identity.
@@ -50,6 +53,10 @@ type GrpcZAPClient interface {
 	FetchIdentitiesByEmail(page int32, pageSize int32, zoneID int64, name string) ([]azmodelzap.Identity, error)
 	// FetchIdentitiesBy returns all identities filtering by all criteria.
 	FetchIdentitiesBy(page int32, pageSize int32, zoneID int64, identitySourceID string, identityID string, kind string, name string) ([]azmodelzap.Identity, error)
+	// FetchIdentitiesByQuery returns the identities filtering by the criteria and the fetch query along with the cursor of the next page.
+	FetchIdentitiesByQuery(page int32, pageSize int32, zoneID int64, identitySourceID string, identityID string, kind string, name string, query *azmodels.FetchQuery) ([]azmodelzap.Identity, string, error)
+	// UpsertIdentities creates or updates identities in bulk.
+	UpsertIdentities(options *azmodelzap.BulkUpsertOptions, identities []azmodelzap.Identity) (*azmodelzap.BulkUpsertReport, error)
 	// CreateIdentitySource creates a new identity source.
 	CreateIdentitySource(zoneID int64, name string) (*azmodelzap.IdentitySource, error)
 	// UpdateIdentitySource updates an identity source.
@@ -64,6 +71,8 @@ type GrpcZAPClient interface {
 	FetchIdentitySourcesByName(page int32, pageSize int32, zoneID int64, name string) ([]azmodelzap.IdentitySource, error)
 	// FetchIdentitySourcesBy returns all identity sources filtering by identity source id and name.
 	FetchIdentitySourcesBy(page int32, pageSize int32, zoneID int64, identitySourceID string, name string) ([]azmodelzap.IdentitySource, error)
+	// FetchIdentitySourcesByQuery returns the identity sources filtering by the criteria and the fetch query along with the cursor of the next page.
+	FetchIdentitySourcesByQuery(page int32, pageSize int32, zoneID int64, identitySourceID string, name string, query *azmodels.FetchQuery) ([]azmodelzap.IdentitySource, string, error)
 	// CreateTenant creates a tenant.
 	CreateTenant(zoneID int64, name string) (*azmodelzap.Tenant, error)
 	// UpdateTenant updates a tenant.
@@ -78,4 +87,20 @@ type GrpcZAPClient interface {
 	FetchTenantsByName(page int32, pageSize int32, zoneID int64, name string) ([]azmodelzap.Tenant, error)
 	// FetchTenantsBy returns all tenants filtering by tenant id and name.
 	FetchTenantsBy(page int32, pageSize int32, zoneID int64, tenantID string, name string) ([]azmodelzap.Tenant, error)
+	// FetchTenantsByQuery returns the tenants filtering by the criteria and the fetch query along with the cursor of the next page.
+	FetchTenantsByQuery(page int32, pageSize int32, zoneID int64, tenantID string, name string, query *azmodels.FetchQuery) ([]azmodelzap.Tenant, string, error)
+	// UpsertTenants creates or updates tenants in bulk.
+	UpsertTenants(options *azmodelzap.BulkUpsertOptions, tenants []azmodelzap.Tenant) (*azmodelzap.BulkUpsertReport, error)
+	// CreateWebhook creates a webhook.
+	CreateWebhook(webhook *azmodelzap.Webhook) (*azmodelzap.Webhook, error)
+	// UpdateWebhook updates a webhook.
+	UpdateWebhook(webhook *azmodelzap.Webhook) (*azmodelzap.Webhook, error)
+	// DeleteWebhook deletes a webhook.
+	DeleteWebhook(zoneID int64, webhookID string) (*azmodelzap.Webhook, error)
+	// FetchWebhooksBy returns all webhooks filtering by webhook id and name.
+	FetchWebhooksBy(page int32, pageSize int32, zoneID int64, webhookID string, name string) ([]azmodelzap.Webhook, error)
+	// FetchWebhookDeliveriesBy returns the webhook deliveries filtering by webhook id and status.
+	FetchWebhookDeliveriesBy(page int32, pageSize int32, zoneID int64, webhookID string, status string) ([]azmodelzap.WebhookDelivery, error)
+	// RequeueWebhookDelivery requeues a dead webhook delivery.
+	RequeueWebhookDelivery(zoneID int64, deliveryID int64) (*azmodelzap.WebhookDelivery, error)
 }
@@ -0,0 +1,147 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// FieldFetchCursor is the field of the continuation cursor of a fetch.
+	FieldFetchCursor = "cursor"
+	// FieldFetchSortBy is the field of the sort key of a fetch.
+	FieldFetchSortBy = "sort_by"
+	// FieldFetchSortOrder is the field of the sort order of a fetch.
+	FieldFetchSortOrder = "sort_order"
+	// FieldFetchNamePrefix is the field filtering the names by prefix.
+	FieldFetchNamePrefix = "name_prefix"
+	// FieldFetchCreatedAfter is the field filtering the items created at or after a time.
+	FieldFetchCreatedAfter = "created_after"
+	// FieldFetchCreatedBefore is the field filtering the items created before a time.
+	FieldFetchCreatedBefore = "created_before"
+
+	// SortByID sorts by the identifier of the items.
+	SortByID = "id"
+	// SortByName sorts by the name of the items.
+	SortByName = "name"
+	// SortByCreatedAt sorts by the creation time of the items.
+	SortByCreatedAt = "created_at"
+	// SortOrderAsc is the ascending sort order.
+	SortOrderAsc = "asc"
+	// SortOrderDesc is the descending sort order.
+	SortOrderDesc = "desc"
+
+	// NextCursorMetadataKey is the key of the grpc trailer carrying the cursor of the next page.
+	NextCursorMetadataKey = "permguard-next-cursor"
+)
+
+// IsValidSortBy checks if the sort key is valid.
+func IsValidSortBy(sortBy string) bool {
+	switch sortBy {
+	case SortByID, SortByName, SortByCreatedAt:
+		return true
+	}
+	return false
+}
+
+// IsValidSortOrder checks if the sort order is valid.
+func IsValidSortOrder(sortOrder string) bool {
+	switch sortOrder {
+	case SortOrderAsc, SortOrderDesc:
+		return true
+	}
+	return false
+}
+
+// FetchQuery is the pagination, sorting and filtering query of a fetch.
+type FetchQuery struct {
+	Cursor        string
+	SortBy        string
+	SortOrder     string
+	NamePrefix    string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// NormalizedSort returns the sort key and order of the query with the defaults applied.
+func (q *FetchQuery) NormalizedSort() (string, string) {
+	sortBy, sortOrder := SortByID, SortOrderAsc
+	if q != nil && q.SortBy != "" {
+		sortBy = q.SortBy
+	}
+	if q != nil && q.SortOrder != "" {
+		sortOrder = q.SortOrder
+	}
+	return sortBy, sortOrder
+}
+
+// FetchCursor is the decoded position of a continuation cursor.
+type FetchCursor struct {
+	SortBy    string `json:"s"`
+	SortOrder string `json:"o"`
+	Value     string `json:"v,omitempty"`
+	ID        string `json:"i"`
+}
+
+// EncodeFetchCursor encodes a cursor as an opaque string.
+func EncodeFetchCursor(cursor *FetchCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeFetchCursor decodes an opaque cursor.
+func DecodeFetchCursor(cursor string) (*FetchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("cursor is not valid: %w", err)
+	}
+	var fetchCursor FetchCursor
+	if err := json.Unmarshal(data, &fetchCursor); err != nil {
+		return nil, fmt.Errorf("cursor is not valid: %w", err)
+	}
+	if !IsValidSortBy(fetchCursor.SortBy) || !IsValidSortOrder(fetchCursor.SortOrder) || fetchCursor.ID == "" {
+		return nil, fmt.Errorf("cursor is not valid")
+	}
+	if fetchCursor.SortBy != SortByID && fetchCursor.Value == "" {
+		return nil, fmt.Errorf("cursor is not valid")
+	}
+	return &fetchCursor, nil
+}
+
+// NewFetchCursor builds the cursor positioned after the given item.
+func NewFetchCursor(sortBy string, sortOrder string, id string, name string, createdAt time.Time) (string, error) {
+	cursor := &FetchCursor{SortBy: sortBy, SortOrder: sortOrder, ID: id}
+	switch sortBy {
+	case SortByName:
+		cursor.Value = name
+	case SortByCreatedAt:
+		cursor.Value = createdAt.UTC().Format(time.RFC3339Nano)
+	}
+	return EncodeFetchCursor(cursor)
+}
+
+// NewFetchCursorForCodeID builds the cursor positioned after the given item identified by a numeric id.
+func NewFetchCursorForCodeID(sortBy string, sortOrder string, id int64, name string, createdAt time.Time) (string, error) {
+	return NewFetchCursor(sortBy, sortOrder, strconv.FormatInt(id, 10), name, createdAt)
+}
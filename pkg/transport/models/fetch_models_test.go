@@ -0,0 +1,79 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFetchCursorRoundTrip tests the encoding and decoding of the fetch cursors.
+func TestFetchCursorRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	createdAt := time.Date(2024, 8, 25, 14, 7, 59, 634000000, time.UTC)
+	tests := []struct {
+		SortBy string
+		Value  string
+	}{
+		{SortBy: SortByID, Value: ""},
+		{SortBy: SortByName, Value: "matera-branch"},
+		{SortBy: SortByCreatedAt, Value: "2024-08-25T14:07:59.634Z"},
+	}
+	for _, test := range tests {
+		cursor, err := NewFetchCursor(test.SortBy, SortOrderDesc, "c3160a533ab24fbcb1eab7a09fd85f36", "matera-branch", createdAt)
+		assert.Nil(err, "error should be nil")
+		decoded, err := DecodeFetchCursor(cursor)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(&FetchCursor{SortBy: test.SortBy, SortOrder: SortOrderDesc, Value: test.Value, ID: "c3160a533ab24fbcb1eab7a09fd85f36"}, decoded)
+	}
+
+	cursor, _ := NewFetchCursorForCodeID(SortByID, SortOrderAsc, 273165098782, "", createdAt)
+	decoded, _ := DecodeFetchCursor(cursor)
+	assert.Equal("273165098782", decoded.ID, "zone id should be encoded")
+}
+
+// TestDecodeFetchCursorWithErrors tests the decoding of invalid fetch cursors.
+func TestDecodeFetchCursorWithErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	invalidCursors := []string{"", "not-a-cursor!", "e30"}
+	for _, cursor := range invalidCursors {
+		_, err := DecodeFetchCursor(cursor)
+		assert.NotNil(err, "error should not be nil")
+	}
+	cursor, _ := EncodeFetchCursor(&FetchCursor{SortBy: SortByName, SortOrder: SortOrderAsc, ID: "c3160a533ab24fbcb1eab7a09fd85f36"})
+	_, err := DecodeFetchCursor(cursor)
+	assert.NotNil(err, "cursor sorted by name requires a value")
+}
+
+// TestFetchQueryNormalizedSort tests the defaults of the fetch query sort.
+func TestFetchQueryNormalizedSort(t *testing.T) {
+	assert := assert.New(t)
+
+	var query *FetchQuery
+	sortBy, sortOrder := query.NormalizedSort()
+	assert.Equal(SortByID, sortBy)
+	assert.Equal(SortOrderAsc, sortOrder)
+
+	query = &FetchQuery{SortBy: SortByName, SortOrder: SortOrderDesc}
+	sortBy, sortOrder = query.NormalizedSort()
+	assert.Equal(SortByName, sortBy)
+	assert.Equal(SortOrderDesc, sortOrder)
+}
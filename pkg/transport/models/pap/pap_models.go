@@ -27,17 +27,99 @@ const (
 	FieldLedgerName     = "name"
 	FieldSchemaSchemaID = "schema_id"
 	FieldSchemaZoneID   = "zone_id"
+	FieldEntityZoneID   = "zone_id"
+	FieldEntityType     = "entity_type"
+	FieldEntityID       = "entity_id"
+
+	FieldTemplateLinkZoneID     = "zone_id"
+	FieldTemplateLinkLedgerID   = "ledger_id"
+	FieldTemplateLinkTemplateID = "template_id"
+	FieldTemplateLinkLinkID     = "link_id"
+
+	FieldChangeRequestChangeRequestID = "change_request_id"
+	FieldChangeRequestStatus          = "status"
+)
+
+const (
+	// ChangeRequestStatusPending is the status of a change request waiting for approvals.
+	ChangeRequestStatusPending = "pending"
+	// ChangeRequestStatusRejected is the status of a rejected change request.
+	ChangeRequestStatusRejected = "rejected"
+	// ChangeRequestStatusApplied is the status of a change request whose commit has been applied to the ledger ref.
+	ChangeRequestStatusApplied = "applied"
 )
 
 // Ledger is the ledger.
 type Ledger struct {
-	LedgerID  string    `json:"ledger_id" validate:"required,isuuid"`
-	CreatedAt time.Time `json:"created_at" validate:"required"`
-	UpdatedAt time.Time `json:"updated_at" validate:"required"`
-	ZoneID    int64     `json:"zone_id" validate:"required,gt=0"`
-	Name      string    `json:"name"`
-	Kind      string    `json:"kind" validate:"required,oneof='policy'"`
-	Ref       string    `json:"ref"`
+	LedgerID          string    `json:"ledger_id" validate:"required,isuuid"`
+	CreatedAt         time.Time `json:"created_at" validate:"required"`
+	UpdatedAt         time.Time `json:"updated_at" validate:"required"`
+	ZoneID            int64     `json:"zone_id" validate:"required,gt=0"`
+	Name              string    `json:"name"`
+	Kind              string    `json:"kind" validate:"required,oneof='policy'"`
+	Ref               string    `json:"ref"`
+	CandidateRef      string    `json:"candidate_ref,omitempty"`
+	RequiredApprovals int32     `json:"required_approvals,omitempty"`
+}
+
+// EntityUID is the unique identifier of an entity.
+type EntityUID struct {
+	Type string `json:"type" validate:"required"`
+	ID   string `json:"id" validate:"required"`
+}
+
+// Entity is an entity of the zone entity store.
+type Entity struct {
+	ZoneID     int64          `json:"zone_id" validate:"required,gt=0"`
+	Type       string         `json:"type" validate:"required"`
+	ID         string         `json:"id" validate:"required"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+	Attributes map[string]any `json:"attrs"`
+	Parents    []EntityUID    `json:"parents"`
+}
+
+// TemplateLink binds the slots of a policy template of the ledger to concrete entities.
+type TemplateLink struct {
+	ZoneID     int64                `json:"zone_id" validate:"required,gt=0"`
+	LedgerID   string               `json:"ledger_id" validate:"required,isuuid"`
+	LinkID     string               `json:"link_id"`
+	CreatedAt  time.Time            `json:"created_at"`
+	UpdatedAt  time.Time            `json:"updated_at"`
+	TemplateID string               `json:"template_id" validate:"required"`
+	Slots      map[string]EntityUID `json:"slots"`
+}
+
+// ChangeRequestPlanItem is a code object changed by a change request.
+type ChangeRequestPlanItem struct {
+	CodeID   string `json:"code_id"`
+	CodeType string `json:"code_type"`
+	OID      string `json:"oid"`
+	State    string `json:"state"`
+}
+
+// ChangeRequestReview is the review of a change request.
+type ChangeRequestReview struct {
+	Reviewer  string    `json:"reviewer"`
+	CreatedAt time.Time `json:"created_at"`
+	Decision  string    `json:"decision"`
+	Comment   string    `json:"comment,omitempty"`
+}
+
+// ChangeRequest is a pending update of the ref of a protected ledger.
+type ChangeRequest struct {
+	ChangeRequestID   string                  `json:"change_request_id"`
+	CreatedAt         time.Time               `json:"created_at"`
+	UpdatedAt         time.Time               `json:"updated_at"`
+	ZoneID            int64                   `json:"zone_id" validate:"required,gt=0"`
+	LedgerID          string                  `json:"ledger_id" validate:"required,isuuid"`
+	BaseRef           string                  `json:"base_ref"`
+	CommitID          string                  `json:"commit_id"`
+	Author            string                  `json:"author"`
+	Status            string                  `json:"status"`
+	RequiredApprovals int32                   `json:"required_approvals"`
+	Plan              []ChangeRequestPlanItem `json:"plan"`
+	Reviews           []ChangeRequestReview   `json:"reviews"`
 }
 
 // Schema is the schema.
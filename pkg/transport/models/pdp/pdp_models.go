@@ -16,6 +16,10 @@
 
 package pdp
 
+import (
+	azresiduals "github.com/permguard/permguard/pkg/authz/residuals"
+)
+
 // PolicyStore is the location where policies are maintained.
 type PolicyStore struct {
 	Kind string `json:"kind,omitempty"`
@@ -73,6 +77,20 @@ type AuthorizationModelRequest struct {
 	Entities    *Entities    `json:"entities,omitempty"`
 }
 
+const (
+	// EvaluationsSemanticExecuteAll executes all the evaluations.
+	EvaluationsSemanticExecuteAll = "execute_all"
+	// EvaluationsSemanticDenyOnFirstDeny stops the evaluations at the first deny, an evaluation failing with an error counts as a deny.
+	EvaluationsSemanticDenyOnFirstDeny = "deny_on_first_deny"
+	// EvaluationsSemanticPermitOnFirstPermit stops the evaluations at the first permit.
+	EvaluationsSemanticPermitOnFirstPermit = "permit_on_first_permit"
+)
+
+// EvaluationsOptions represents the options to be applied to the evaluations.
+type EvaluationsOptions struct {
+	EvaluationsSemantic string `json:"evaluations_semantic,omitempty"`
+}
+
 // EvaluationRequest represents the request to evaluate the authorization decision.
 type EvaluationRequest struct {
 	RequestID string         `json:"request_id,omitempty"`
@@ -87,6 +105,7 @@ type EvaluationRequest struct {
 type AuthorizationCheckRequest struct {
 	AuthorizationModel *AuthorizationModelRequest `json:"authorization_model,omitempty" validate:"required"`
 	Evaluations        []EvaluationRequest        `json:"evaluations,omitempty"`
+	Options            *EvaluationsOptions        `json:"options,omitempty"`
 }
 
 // AuthorizationCheckWithDefaultsRequest represents the request to perform an authorization decision with defaults.
@@ -128,3 +147,104 @@ type AuthorizationCheckResponse struct {
 	Context     *ContextResponse     `json:"context,omitempty"`
 	Evaluations []EvaluationResponse `json:"evaluations,omitempty"`
 }
+
+// Search Request
+
+const (
+	// SearchPageSizeDefault is the default number of results returned by a search page.
+	SearchPageSizeDefault = 100
+)
+
+// SearchPageRequest represents the pagination of the search request.
+type SearchPageRequest struct {
+	NextToken string `json:"next_token,omitempty"`
+	Size      int32  `json:"size,omitempty"`
+}
+
+// SearchRequest represents the input shared by the search requests.
+type SearchRequest struct {
+	AuthorizationModel *AuthorizationModelRequest `json:"authorization_model,omitempty" validate:"required"`
+	RequestID          string                     `json:"request_id,omitempty"`
+	Subject            *Subject                   `json:"subject,omitempty"`
+	Resource           *Resource                  `json:"resource,omitempty"`
+	Action             *Action                    `json:"action,omitempty"`
+	Context            map[string]any             `json:"context,omitempty"`
+	Page               *SearchPageRequest         `json:"page,omitempty"`
+}
+
+// SubjectSearchRequest represents the request to search the subjects allowed to perform an action on a resource.
+type SubjectSearchRequest struct {
+	SearchRequest
+	Candidates []Subject `json:"candidates,omitempty"`
+}
+
+// ResourceSearchRequest represents the request to search the resources on which a subject is allowed to perform an action.
+type ResourceSearchRequest struct {
+	SearchRequest
+	Candidates []Resource `json:"candidates,omitempty"`
+}
+
+// ActionSearchRequest represents the request to search the actions a subject is allowed to perform on a resource.
+type ActionSearchRequest struct {
+	SearchRequest
+	Candidates []Action `json:"candidates,omitempty"`
+}
+
+// Search Response
+
+// SearchPageResponse represents the pagination of the search response.
+type SearchPageResponse struct {
+	NextToken string `json:"next_token,omitempty"`
+}
+
+// SubjectSearchResponse represents the outcome of the subject search.
+type SubjectSearchResponse struct {
+	RequestID string              `json:"request_id,omitempty"`
+	Results   []Subject           `json:"results"`
+	Page      *SearchPageResponse `json:"page,omitempty"`
+}
+
+// ResourceSearchResponse represents the outcome of the resource search.
+type ResourceSearchResponse struct {
+	RequestID string              `json:"request_id,omitempty"`
+	Results   []Resource          `json:"results"`
+	Page      *SearchPageResponse `json:"page,omitempty"`
+}
+
+// ActionSearchResponse represents the outcome of the action search.
+type ActionSearchResponse struct {
+	RequestID string              `json:"request_id,omitempty"`
+	Results   []Action            `json:"results"`
+	Page      *SearchPageResponse `json:"page,omitempty"`
+}
+
+// PartialEvaluationSQLOptions is the options to translate the residual to a SQL WHERE fragment.
+type PartialEvaluationSQLOptions struct {
+	ResourceIDColumn string            `json:"resource_id_column,omitempty"`
+	Columns          map[string]string `json:"columns,omitempty"`
+}
+
+// PartialEvaluationRequest is the request to partially evaluate the authorization for the resources of a type.
+type PartialEvaluationRequest struct {
+	AuthorizationModel *AuthorizationModelRequest   `json:"authorization_model,omitempty" validate:"required"`
+	RequestID          string                       `json:"request_id,omitempty"`
+	Subject            *Subject                     `json:"subject,omitempty"`
+	Resource           *Resource                    `json:"resource,omitempty"`
+	Action             *Action                      `json:"action,omitempty"`
+	Context            map[string]any               `json:"context,omitempty"`
+	SQL                *PartialEvaluationSQLOptions `json:"sql,omitempty"`
+}
+
+// PartialEvaluationSQLResponse is the residual translated to a SQL WHERE fragment.
+type PartialEvaluationSQLResponse struct {
+	Where string `json:"where"`
+	Args  []any  `json:"args"`
+}
+
+// PartialEvaluationResponse is the response of the partial evaluation.
+type PartialEvaluationResponse struct {
+	RequestID string                        `json:"request_id,omitempty"`
+	Decision  string                        `json:"decision"`
+	Residual  *azresiduals.Expression       `json:"residual,omitempty"`
+	SQL       *PartialEvaluationSQLResponse `json:"sql,omitempty"`
+}
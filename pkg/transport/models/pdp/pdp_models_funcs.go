@@ -17,7 +17,13 @@
 package pdp
 
 import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
 	"strings"
+
+	azids "github.com/permguard/permguard-common/pkg/extensions/ids"
 )
 
 const (
@@ -53,6 +59,72 @@ func IsValidIdentiyType(identityType string) bool {
 	return false
 }
 
+// IsValidEvaluationsSemantic checks if the evaluations semantic is valid.
+func IsValidEvaluationsSemantic(semantic string) bool {
+	switch semantic {
+	case "", EvaluationsSemanticExecuteAll, EvaluationsSemanticDenyOnFirstDeny, EvaluationsSemanticPermitOnFirstPermit:
+		return true
+	}
+	return false
+}
+
+// GetEvaluationsSemantic returns the evaluations semantic of the options defaulting to execute all.
+func GetEvaluationsSemantic(options *EvaluationsOptions) string {
+	if options == nil || len(options.EvaluationsSemantic) == 0 {
+		return EvaluationsSemanticExecuteAll
+	}
+	return options.EvaluationsSemantic
+}
+
+// IsEvaluationsStop checks if the decision stops the evaluations for the evaluations semantic, the decision of an evaluation failing with an error is deny.
+func IsEvaluationsStop(semantic string, decision bool) bool {
+	switch semantic {
+	case EvaluationsSemanticDenyOnFirstDeny:
+		return !decision
+	case EvaluationsSemanticPermitOnFirstPermit:
+		return decision
+	}
+	return false
+}
+
+// TruncateEvaluationsBySemantic truncates the evaluations after the first one stopping the evaluations for the evaluations semantic.
+func TruncateEvaluationsBySemantic(semantic string, evaluations []EvaluationResponse) []EvaluationResponse {
+	for i, evaluation := range evaluations {
+		if IsEvaluationsStop(semantic, evaluation.Decision) {
+			return evaluations[:i+1]
+		}
+	}
+	return evaluations
+}
+
+// searchPageTokenPrefix is the prefix of the search page tokens.
+const searchPageTokenPrefix = "offset:"
+
+// EncodeSearchPageToken encodes the offset of the next search candidate as an opaque page token.
+func EncodeSearchPageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s%d", searchPageTokenPrefix, offset)))
+}
+
+// DecodeSearchPageToken decodes the offset of the next search candidate from the page token.
+func DecodeSearchPageToken(token string) (int, error) {
+	if len(token) == 0 {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+	value, found := strings.CutPrefix(string(data), searchPageTokenPrefix)
+	if !found {
+		return 0, errors.New("invalid page token")
+	}
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid page token")
+	}
+	return offset, nil
+}
+
 // NewEvaluationErrorResponse creates an evaluation error response.
 func NewEvaluationErrorResponse(requestID string, erroCode string, adminReason string, userReason string) *EvaluationResponse {
 	return &EvaluationResponse{
@@ -92,3 +164,60 @@ func NewAuthorizationCheckErrorResponse(authzCheckResponse *AuthorizationCheckRe
 	}
 	return authzCheckResponse
 }
+
+// ExpandAuthorizationCheckWithDefaults expands the authorization check with defaults into one evaluation per request.
+func ExpandAuthorizationCheckWithDefaults(request *AuthorizationCheckWithDefaultsRequest) (*AuthorizationCheckRequest, error) {
+	expReq := &AuthorizationCheckRequest{}
+	expReq.AuthorizationModel = request.AuthorizationModel
+	expReq.Options = request.Options
+
+	if len(request.Evaluations) == 0 {
+		expRequest := EvaluationRequest{
+			RequestID: request.RequestID,
+			Subject:   request.Subject,
+			Resource:  request.Resource,
+			Action:    request.Action,
+			Context:   request.Context,
+			ContextID: azids.GenerateID(),
+		}
+		if expRequest.Context == nil {
+			expRequest.Context = make(map[string]interface{})
+		}
+		expReq.Evaluations = []EvaluationRequest{expRequest}
+	} else {
+		requestID := request.RequestID
+		expReq.Evaluations = []EvaluationRequest{}
+		for _, evaluation := range request.Evaluations {
+			expRequest := EvaluationRequest{
+				RequestID: request.RequestID,
+				Subject:   request.Subject,
+				Resource:  request.Resource,
+				Action:    request.Action,
+				Context:   request.Context,
+				ContextID: azids.GenerateID(),
+			}
+			if len(evaluation.RequestID) > 0 {
+				expRequest.RequestID = evaluation.RequestID
+			} else {
+				expRequest.RequestID = requestID
+			}
+			if evaluation.Subject != nil {
+				expRequest.Subject = evaluation.Subject
+			}
+			if evaluation.Resource != nil {
+				expRequest.Resource = evaluation.Resource
+			}
+			if evaluation.Action != nil {
+				expRequest.Action = evaluation.Action
+			}
+			if evaluation.Context != nil && len(evaluation.Context) > 0 {
+				expRequest.Context = evaluation.Context
+			}
+			if expRequest.Context == nil {
+				expRequest.Context = make(map[string]interface{})
+			}
+			expReq.Evaluations = append(expReq.Evaluations, expRequest)
+		}
+	}
+	return expReq, nil
+}
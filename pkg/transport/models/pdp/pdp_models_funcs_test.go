@@ -0,0 +1,78 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pdp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEvaluationsSemantic tests the evaluations semantic helpers.
+func TestEvaluationsSemantic(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsValidEvaluationsSemantic(""))
+	assert.True(IsValidEvaluationsSemantic(EvaluationsSemanticDenyOnFirstDeny))
+	assert.False(IsValidEvaluationsSemantic("invalid"))
+	assert.Equal(EvaluationsSemanticExecuteAll, GetEvaluationsSemantic(nil))
+	assert.Equal(EvaluationsSemanticPermitOnFirstPermit, GetEvaluationsSemantic(&EvaluationsOptions{EvaluationsSemantic: EvaluationsSemanticPermitOnFirstPermit}))
+
+	evaluations := []EvaluationResponse{{Decision: true}, {Decision: false}, {Decision: true}}
+	assert.Len(TruncateEvaluationsBySemantic(EvaluationsSemanticExecuteAll, evaluations), 3)
+	assert.Len(TruncateEvaluationsBySemantic(EvaluationsSemanticDenyOnFirstDeny, evaluations), 2)
+	assert.Len(TruncateEvaluationsBySemantic(EvaluationsSemanticPermitOnFirstPermit, evaluations), 1)
+}
+
+// TestSearchPageToken tests the encoding and decoding of the search page tokens.
+func TestSearchPageToken(t *testing.T) {
+	assert := assert.New(t)
+	offset, err := DecodeSearchPageToken("")
+	assert.Nil(err)
+	assert.Equal(0, offset)
+	offset, err = DecodeSearchPageToken(EncodeSearchPageToken(42))
+	assert.Nil(err)
+	assert.Equal(42, offset)
+	_, err = DecodeSearchPageToken("not-a-token")
+	assert.NotNil(err)
+}
+
+// TestExpandAuthorizationCheckWithDefaults tests the expansion of the authorization check with defaults.
+func TestExpandAuthorizationCheckWithDefaults(t *testing.T) {
+	assert := assert.New(t)
+	request := &AuthorizationCheckWithDefaultsRequest{
+		RequestID: "req-1",
+		Subject:   &Subject{Type: "user", ID: "amy"},
+		Resource:  &Resource{Type: "Branch", ID: "1"},
+		Action:    &Action{Name: "view"},
+	}
+	expRequest, err := ExpandAuthorizationCheckWithDefaults(request)
+	assert.Nil(err)
+	assert.Len(expRequest.Evaluations, 1)
+	assert.Equal("req-1", expRequest.Evaluations[0].RequestID)
+	assert.NotEmpty(expRequest.Evaluations[0].ContextID)
+	assert.NotNil(expRequest.Evaluations[0].Context)
+
+	request.Evaluations = []EvaluationRequest{{Action: &Action{Name: "edit"}}, {RequestID: "req-2", Resource: &Resource{Type: "Branch", ID: "2"}}}
+	expRequest, err = ExpandAuthorizationCheckWithDefaults(request)
+	assert.Nil(err)
+	assert.Len(expRequest.Evaluations, 2)
+	assert.Equal("edit", expRequest.Evaluations[0].Action.Name)
+	assert.Equal("req-1", expRequest.Evaluations[0].RequestID)
+	assert.Equal("amy", expRequest.Evaluations[1].Subject.ID)
+	assert.Equal("2", expRequest.Evaluations[1].Resource.ID)
+	assert.Equal("req-2", expRequest.Evaluations[1].RequestID)
+}
@@ -34,8 +34,96 @@ const (
 	FieldIdentityIdentityID             = "identity_id"
 	FieldIdentityName                   = "name"
 	FieldIdentityKind                   = "kind"
+	FieldWebhookWebhookID               = "webhook_id"
+	FieldWebhookName                    = "name"
+	FieldWebhookDeliveryWebhookID       = "webhook_id"
+	FieldWebhookDeliveryStatus          = "status"
 )
 
+const (
+	// WebhookEventAll subscribes a webhook to all the events.
+	WebhookEventAll = "*"
+	// WebhookEventLedgerCreated is the event of a ledger creation.
+	WebhookEventLedgerCreated = "ledger.created"
+	// WebhookEventLedgerUpdated is the event of a ledger update.
+	WebhookEventLedgerUpdated = "ledger.updated"
+	// WebhookEventLedgerDeleted is the event of a ledger deletion.
+	WebhookEventLedgerDeleted = "ledger.deleted"
+	// WebhookEventLedgerRefUpdated is the event of a ledger ref moving to a new commit.
+	WebhookEventLedgerRefUpdated = "ledger.ref_updated"
+	// WebhookEventIdentityCreated is the event of an identity creation.
+	WebhookEventIdentityCreated = "identity.created"
+	// WebhookEventIdentityUpdated is the event of an identity update.
+	WebhookEventIdentityUpdated = "identity.updated"
+	// WebhookEventIdentityDeleted is the event of an identity deletion.
+	WebhookEventIdentityDeleted = "identity.deleted"
+	// WebhookEventTenantCreated is the event of a tenant creation.
+	WebhookEventTenantCreated = "tenant.created"
+	// WebhookEventTenantUpdated is the event of a tenant update.
+	WebhookEventTenantUpdated = "tenant.updated"
+	// WebhookEventTenantDeleted is the event of a tenant deletion.
+	WebhookEventTenantDeleted = "tenant.deleted"
+)
+
+const (
+	// WebhookDeliveryStatusPending is the status of a delivery waiting to be sent or retried.
+	WebhookDeliveryStatusPending = "pending"
+	// WebhookDeliveryStatusDelivered is the status of a delivery acknowledged by the receiver.
+	WebhookDeliveryStatusDelivered = "delivered"
+	// WebhookDeliveryStatusDead is the status of a delivery that exhausted its retries.
+	WebhookDeliveryStatusDead = "dead"
+)
+
+// IsValidWebhookEvent checks if the webhook event is valid.
+func IsValidWebhookEvent(event string) bool {
+	switch event {
+	case WebhookEventAll,
+		WebhookEventLedgerCreated, WebhookEventLedgerUpdated, WebhookEventLedgerDeleted, WebhookEventLedgerRefUpdated,
+		WebhookEventIdentityCreated, WebhookEventIdentityUpdated, WebhookEventIdentityDeleted,
+		WebhookEventTenantCreated, WebhookEventTenantUpdated, WebhookEventTenantDeleted:
+		return true
+	}
+	return false
+}
+
+// IsValidWebhookDeliveryStatus checks if the webhook delivery status is valid.
+func IsValidWebhookDeliveryStatus(status string) bool {
+	switch status {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+const (
+	// BulkConflictSkip keeps the existing item when an imported item has the same name.
+	BulkConflictSkip = "skip"
+	// BulkConflictUpdate updates the existing item when an imported item has the same name.
+	BulkConflictUpdate = "update"
+	// BulkConflictFail fails the imported item when an item with the same name exists.
+	BulkConflictFail = "fail"
+)
+
+const (
+	// BulkRowStatusCreated is the status of an imported item that has been created.
+	BulkRowStatusCreated = "created"
+	// BulkRowStatusUpdated is the status of an imported item that has updated an existing one.
+	BulkRowStatusUpdated = "updated"
+	// BulkRowStatusSkipped is the status of an imported item skipped because of a conflict.
+	BulkRowStatusSkipped = "skipped"
+	// BulkRowStatusFailed is the status of an imported item that could not be applied.
+	BulkRowStatusFailed = "failed"
+)
+
+// IsValidBulkConflictPolicy checks if the bulk conflict policy is valid.
+func IsValidBulkConflictPolicy(policy string) bool {
+	switch policy {
+	case BulkConflictSkip, BulkConflictUpdate, BulkConflictFail:
+		return true
+	}
+	return false
+}
+
 // Zone is the zone.
 type Zone struct {
 	ZoneID    int64     `json:"zone_id" validate:"required,gt=0"`
@@ -72,3 +160,92 @@ type Identity struct {
 	Kind             string    `json:"identity_type" validate:"required,oneof='user' 'actor'"`
 	Name             string    `json:"name" validate:"required"`
 }
+
+// Webhook is a subscription of an endpoint to the events of a zone.
+type Webhook struct {
+	WebhookID string    `json:"webhook_id" validate:"required,isuuid"`
+	CreatedAt time.Time `json:"created_at" validate:"required"`
+	UpdatedAt time.Time `json:"updated_at" validate:"required"`
+	ZoneID    int64     `json:"zone_id" validate:"required,gt=0"`
+	Name      string    `json:"name" validate:"required,name"`
+	URL       string    `json:"url" validate:"required,url"`
+	Secret    string    `json:"secret,omitempty"`
+	Events    []string  `json:"events"`
+}
+
+// WebhookDelivery is the delivery of an event to a webhook.
+type WebhookDelivery struct {
+	DeliveryID     int64     `json:"delivery_id"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	ZoneID         int64     `json:"zone_id"`
+	WebhookID      string    `json:"webhook_id"`
+	Event          string    `json:"event"`
+	Payload        string    `json:"payload"`
+	Status         string    `json:"status"`
+	Attempts       int32     `json:"attempts"`
+	NextAttemptAt  time.Time `json:"next_attempt_at"`
+	LastStatusCode int32     `json:"last_status_code,omitempty"`
+	LastError      string    `json:"last_error,omitempty"`
+}
+
+// WebhookDispatch is a due delivery along with the endpoint and the secret of its webhook.
+type WebhookDispatch struct {
+	Delivery WebhookDelivery
+	URL      string
+	Secret   string
+}
+
+// BulkUpsertOptions are the options of a bulk upsert.
+type BulkUpsertOptions struct {
+	ZoneID         int64  `json:"zone_id"`
+	DryRun         bool   `json:"dry_run"`
+	ConflictPolicy string `json:"conflict_policy"`
+	FullReport     bool   `json:"full_report"`
+}
+
+// BulkRowResult is the outcome of a row of a bulk upsert.
+type BulkRowResult struct {
+	Row    int32  `json:"row"`
+	Name   string `json:"name"`
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BulkUpsertReport is the report of a bulk upsert, the changes are committed only if no row failed and it is not a dry run.
+type BulkUpsertReport struct {
+	DryRun    bool            `json:"dry_run"`
+	Committed bool            `json:"committed"`
+	Created   int32           `json:"created"`
+	Updated   int32           `json:"updated"`
+	Skipped   int32           `json:"skipped"`
+	Failed    int32           `json:"failed"`
+	Rows      []BulkRowResult `json:"rows"`
+}
+
+// AddRow adds the outcome of a row to the report.
+func (r *BulkUpsertReport) AddRow(row BulkRowResult) {
+	switch row.Status {
+	case BulkRowStatusCreated:
+		r.Created++
+	case BulkRowStatusUpdated:
+		r.Updated++
+	case BulkRowStatusSkipped:
+		r.Skipped++
+	case BulkRowStatusFailed:
+		r.Failed++
+	}
+	r.Rows = append(r.Rows, row)
+}
+
+// TrimSucceededRows removes the rows created or updated from the report, the counts are kept.
+func (r *BulkUpsertReport) TrimSucceededRows() {
+	rows := []BulkRowResult{}
+	for _, row := range r.Rows {
+		if row.Status == BulkRowStatusFailed || row.Status == BulkRowStatusSkipped {
+			rows = append(rows, row)
+		}
+	}
+	r.Rows = rows
+}
@@ -0,0 +1,365 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/cedar-policy/cedar-go"
+	"github.com/cedar-policy/cedar-go/types"
+	"github.com/cedar-policy/cedar-go/x/exp/ast"
+
+	azlint "github.com/permguard/permguard/pkg/authz/lint"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azcedarschema "github.com/permguard/permguard/plugin/languages/cedar/internal/schema"
+	azcedartemplates "github.com/permguard/permguard/plugin/languages/cedar/internal/templates"
+)
+
+// policy is a parsed policy to be linted.
+type policy struct {
+	id          string
+	path        string
+	position    cedar.Position
+	annotations cedar.Annotations
+	ast         *ast.Policy
+	// key is the canonical representation of the policy without the annotations.
+	key string
+}
+
+// isUnconditional returns true if the policy has no conditions.
+func (p *policy) isUnconditional() bool {
+	return len(p.ast.Conditions) == 0
+}
+
+// name returns the name of the policy used in the messages.
+func (p *policy) name() string {
+	if p.id == "" {
+		return fmt.Sprintf("at line %d", p.position.Line)
+	}
+	return p.id
+}
+
+// Linter statically analyses cedar policies.
+type Linter struct {
+	schema *azcedarschema.Schema
+	config *azlint.Config
+}
+
+// NewLinter creates a new linter, the schema is nil if missing.
+func NewLinter(schema *azcedarschema.Schema, config *azlint.Config) *Linter {
+	if config == nil {
+		config = azlint.NewConfig()
+	}
+	return &Linter{
+		schema: schema,
+		config: config,
+	}
+}
+
+// parsePolicies parses the policies of the sources.
+func parsePolicies(sources []azlint.Source) ([]*policy, error) {
+	policies := []*policy{}
+	for _, source := range sources {
+		policyList, err := cedar.NewPolicyListFromBytes(source.Path, azcedartemplates.ReplaceSlots(source.Data))
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[cedar] invalid policy syntax in %s", source.Path), err)
+		}
+		for _, cedarPolicy := range policyList {
+			key, err := canonicalKey(cedarPolicy)
+			if err != nil {
+				return nil, err
+			}
+			annotations := cedarPolicy.Annotations()
+			policies = append(policies, &policy{
+				id:          string(annotations["id"]),
+				path:        source.Path,
+				position:    cedarPolicy.Position(),
+				annotations: annotations,
+				ast:         (*ast.Policy)(cedarPolicy.AST()),
+				key:         key,
+			})
+		}
+	}
+	return policies, nil
+}
+
+// canonicalKey returns the canonical json of the policy without the annotations.
+func canonicalKey(cedarPolicy *cedar.Policy) (string, error) {
+	policyJSON, err := cedarPolicy.MarshalJSON()
+	if err != nil {
+		return "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] policy could not be serialized", err)
+	}
+	var policyMap map[string]any
+	if err := json.Unmarshal(policyJSON, &policyMap); err != nil {
+		return "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] policy could not be serialized", err)
+	}
+	delete(policyMap, "annotations")
+	key, err := json.Marshal(policyMap)
+	if err != nil {
+		return "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] policy could not be serialized", err)
+	}
+	return string(key), nil
+}
+
+// newFinding creates a finding for the policy.
+func newFinding(ruleID string, p *policy, message string) azlint.Finding {
+	return azlint.Finding{
+		RuleID:   ruleID,
+		Message:  message,
+		Path:     p.path,
+		Line:     p.position.Line,
+		Column:   p.position.Column,
+		PolicyID: p.id,
+	}
+}
+
+// Lint lints the policies of the sources.
+func (l *Linter) Lint(sources []azlint.Source) ([]azlint.Finding, error) {
+	policies, err := parsePolicies(sources)
+	if err != nil {
+		return nil, err
+	}
+	findings := []azlint.Finding{}
+	for i, p := range policies {
+		findings = append(findings, l.lintAnnotations(p)...)
+		if p.ast.Effect == ast.EffectPermit && p.isUnconditional() && isAll(p.ast.Principal) && isAll(p.ast.Action) && isAll(p.ast.Resource) {
+			findings = append(findings, newFinding(azlint.RuleUnconditionalPermit, p, fmt.Sprintf("policy %s permits any principal to perform any action on any resource", p.name())))
+		}
+		if l.schema != nil {
+			findings = append(findings, l.lintSchema(p)...)
+		}
+		findings = append(findings, l.lintRelations(i, policies)...)
+	}
+	return findings, nil
+}
+
+// lintAnnotations checks the required annotations of the policy.
+func (l *Linter) lintAnnotations(p *policy) []azlint.Finding {
+	findings := []azlint.Finding{}
+	for _, annotation := range l.config.RequiredAnnotations {
+		if _, ok := p.annotations[types.Ident(annotation)]; !ok {
+			findings = append(findings, newFinding(azlint.RuleMissingAnnotation, p, fmt.Sprintf("policy %s is missing the required annotation @%s", p.name(), annotation)))
+		}
+	}
+	return findings
+}
+
+// lintRelations checks the policy against the other policies.
+func (l *Linter) lintRelations(i int, policies []*policy) []azlint.Finding {
+	p := policies[i]
+	findings := []azlint.Finding{}
+	var duplicateOf, shadowedBy, overlappedBy *policy
+	for j, q := range policies {
+		if i == j {
+			continue
+		}
+		if p.key == q.key {
+			// Duplicates are reported on the policies following the first occurrence.
+			if j < i && duplicateOf == nil {
+				duplicateOf = q
+			}
+			continue
+		}
+		if !q.isUnconditional() || !l.covers(q, p) {
+			continue
+		}
+		if p.ast.Effect == ast.EffectPermit && q.ast.Effect == ast.EffectForbid && shadowedBy == nil {
+			shadowedBy = q
+		} else if p.ast.Effect == q.ast.Effect && overlappedBy == nil && (j < i || !p.isUnconditional() || !l.covers(p, q)) {
+			overlappedBy = q
+		}
+	}
+	if duplicateOf != nil {
+		findings = append(findings, newFinding(azlint.RuleDuplicatePolicy, p, fmt.Sprintf("policy %s duplicates the policy %s", p.name(), duplicateOf.name())))
+	}
+	if shadowedBy != nil {
+		findings = append(findings, newFinding(azlint.RuleShadowedPolicy, p, fmt.Sprintf("policy %s is fully shadowed by the forbid policy %s", p.name(), shadowedBy.name())))
+	}
+	if overlappedBy != nil {
+		findings = append(findings, newFinding(azlint.RuleOverlappingPolicy, p, fmt.Sprintf("policy %s is overlapped by the unconditional policy %s", p.name(), overlappedBy.name())))
+	}
+	return findings
+}
+
+// lintSchema checks the policy against the schema.
+func (l *Linter) lintSchema(p *policy) []azlint.Finding {
+	findings := []azlint.Finding{}
+	for _, scope := range []struct {
+		name string
+		node ast.IsScopeNode
+	}{{"principal", p.ast.Principal}, {"resource", p.ast.Resource}} {
+		for _, entityType := range scopeEntityTypes(scope.node) {
+			if !l.schema.HasEntityType(string(entityType)) {
+				findings = append(findings, newFinding(azlint.RuleUnknownEntityType, p, fmt.Sprintf("entity type %s in the %s scope of the policy %s is not declared in the schema", entityType, scope.name, p.name())))
+			}
+		}
+	}
+	actionUIDs := scopeEntities(p.ast.Action)
+	for _, actionUID := range actionUIDs {
+		if _, ok := l.schema.GetAction(actionUID.String()); !ok {
+			findings = append(findings, newFinding(azlint.RuleUnknownAction, p, fmt.Sprintf("action %s of the policy %s is not declared in the schema", actionUID, p.name())))
+		}
+	}
+	if p.ast.Effect == ast.EffectPermit && !l.isSatisfiable(p) {
+		findings = append(findings, newFinding(azlint.RuleUnsatisfiablePermit, p, fmt.Sprintf("policy %s can never match as none of its actions applies to its principal and resource types", p.name())))
+	}
+	return findings
+}
+
+// isSatisfiable returns false if none of the actions of the policy applies to the principal and resource types of the policy.
+func (l *Linter) isSatisfiable(p *policy) bool {
+	principalType := scopeType(p.ast.Principal)
+	resourceType := scopeType(p.ast.Resource)
+	if principalType == "" && resourceType == "" {
+		return true
+	}
+	actionUIDs := []string{}
+	switch s := p.ast.Action.(type) {
+	case ast.ScopeTypeEq:
+		actionUIDs = append(actionUIDs, s.Entity.String())
+	case ast.ScopeTypeIn:
+		actionUIDs = append(actionUIDs, l.schema.GetActionDescendants(s.Entity.String())...)
+	case ast.ScopeTypeInSet:
+		for _, entity := range s.Entities {
+			actionUIDs = append(actionUIDs, l.schema.GetActionDescendants(entity.String())...)
+		}
+	default:
+		return true
+	}
+	checked := false
+	for _, actionUID := range actionUIDs {
+		action, ok := l.schema.GetAction(actionUID)
+		if !ok || !action.AppliesTo {
+			continue
+		}
+		checked = true
+		if (principalType == "" || action.PrincipalTypes[principalType]) && (resourceType == "" || action.ResourceTypes[resourceType]) {
+			return true
+		}
+	}
+	return !checked
+}
+
+// covers returns true if every request matching the scope of the inner policy matches the scope of the outer policy.
+func (l *Linter) covers(outer, inner *policy) bool {
+	isEqual := func(uid, ancestor types.EntityUID) bool { return uid == ancestor }
+	isActionDescendant := isEqual
+	if l.schema != nil {
+		isActionDescendant = func(uid, ancestor types.EntityUID) bool {
+			return l.schema.IsActionDescendant(uid.String(), ancestor.String())
+		}
+	}
+	return coversScope(outer.ast.Principal, inner.ast.Principal, isEqual) &&
+		coversScope(outer.ast.Action, inner.ast.Action, isActionDescendant) &&
+		coversScope(outer.ast.Resource, inner.ast.Resource, isEqual)
+}
+
+// coversScope returns true if every entity matching the inner scope matches the outer scope.
+func coversScope(outer, inner ast.IsScopeNode, isDescendant func(uid, ancestor types.EntityUID) bool) bool {
+	switch o := outer.(type) {
+	case ast.ScopeTypeAll:
+		return true
+	case ast.ScopeTypeEq:
+		i, ok := inner.(ast.ScopeTypeEq)
+		return ok && i.Entity == o.Entity
+	case ast.ScopeTypeIn:
+		return coversIn(inner, []types.EntityUID{o.Entity}, isDescendant)
+	case ast.ScopeTypeInSet:
+		return coversIn(inner, o.Entities, isDescendant)
+	case ast.ScopeTypeIs:
+		innerType := scopeType(inner)
+		return innerType != "" && innerType == string(o.Type)
+	case ast.ScopeTypeIsIn:
+		return scopeType(inner) == string(o.Type) && coversIn(inner, []types.EntityUID{o.Entity}, isDescendant)
+	}
+	return false
+}
+
+// coversIn returns true if every entity matching the inner scope is a descendant of one of the ancestors.
+func coversIn(inner ast.IsScopeNode, ancestors []types.EntityUID, isDescendant func(uid, ancestor types.EntityUID) bool) bool {
+	isInAncestors := func(uid types.EntityUID) bool {
+		return slices.ContainsFunc(ancestors, func(ancestor types.EntityUID) bool { return isDescendant(uid, ancestor) })
+	}
+	switch i := inner.(type) {
+	case ast.ScopeTypeEq:
+		return isInAncestors(i.Entity)
+	case ast.ScopeTypeIn:
+		return isInAncestors(i.Entity)
+	case ast.ScopeTypeInSet:
+		return len(i.Entities) > 0 && !slices.ContainsFunc(i.Entities, func(entity types.EntityUID) bool { return !isInAncestors(entity) })
+	case ast.ScopeTypeIsIn:
+		return isInAncestors(i.Entity)
+	}
+	return false
+}
+
+// isAll returns true if the scope matches any entity.
+func isAll(scope ast.IsScopeNode) bool {
+	_, ok := scope.(ast.ScopeTypeAll)
+	return ok
+}
+
+// scopeType returns the entity type the scope is restricted to, builtin types are ignored.
+func scopeType(scope ast.IsScopeNode) string {
+	var entityType types.EntityType
+	switch s := scope.(type) {
+	case ast.ScopeTypeEq:
+		entityType = s.Entity.Type
+	case ast.ScopeTypeIs:
+		entityType = s.Type
+	case ast.ScopeTypeIsIn:
+		entityType = s.Type
+	}
+	if azcedarschema.IsBuiltinType(string(entityType)) {
+		return ""
+	}
+	return string(entityType)
+}
+
+// scopeEntities returns the entities referenced by the scope.
+func scopeEntities(scope ast.IsScopeNode) []types.EntityUID {
+	switch s := scope.(type) {
+	case ast.ScopeTypeEq:
+		return []types.EntityUID{s.Entity}
+	case ast.ScopeTypeIn:
+		return []types.EntityUID{s.Entity}
+	case ast.ScopeTypeInSet:
+		return s.Entities
+	case ast.ScopeTypeIsIn:
+		return []types.EntityUID{s.Entity}
+	}
+	return nil
+}
+
+// scopeEntityTypes returns the entity types referenced by the scope.
+func scopeEntityTypes(scope ast.IsScopeNode) []types.EntityType {
+	entityTypes := []types.EntityType{}
+	switch s := scope.(type) {
+	case ast.ScopeTypeIs:
+		entityTypes = append(entityTypes, s.Type)
+	case ast.ScopeTypeIsIn:
+		entityTypes = append(entityTypes, s.Type)
+	}
+	for _, entity := range scopeEntities(scope) {
+		if !slices.Contains(entityTypes, entity.Type) {
+			entityTypes = append(entityTypes, entity.Type)
+		}
+	}
+	return entityTypes
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azlint "github.com/permguard/permguard/pkg/authz/lint"
+	azcedarschema "github.com/permguard/permguard/plugin/languages/cedar/internal/schema"
+)
+
+const testSchema = `{
+  "MagicFarmacia::Platform": {
+    "entityTypes": { "Branch": {}, "Order": {}, "User": {} },
+    "actions": {
+      "manage": {},
+      "view": {
+        "appliesTo": { "principalTypes": ["User"], "resourceTypes": ["Branch"] },
+        "memberOf": [{ "id": "manage" }]
+      },
+      "delete": {
+        "appliesTo": { "principalTypes": ["User"], "resourceTypes": ["Order"] },
+        "memberOf": [{ "id": "manage" }]
+      }
+    }
+  }
+}`
+
+// lintRules returns the findings indexed by the policy id and rule id.
+func lintRules(t *testing.T, config *azlint.Config, policies string) map[string][]string {
+	t.Helper()
+	schema, err := azcedarschema.ParseSchema([]byte(testSchema))
+	assert.Nil(t, err, "ParseSchema should not return an error")
+	findings, err := NewLinter(schema, config).Lint([]azlint.Source{{Path: "policies.cedar", Data: []byte(policies)}})
+	assert.Nil(t, err, "Lint should not return an error")
+	rules := map[string][]string{}
+	for _, finding := range findings {
+		assert.Equal(t, "policies.cedar", finding.Path)
+		assert.Positive(t, finding.Line)
+		rules[finding.PolicyID] = append(rules[finding.PolicyID], finding.RuleID)
+	}
+	return rules
+}
+
+// TestLintPolicies tests the lint of the policies.
+func TestLintPolicies(t *testing.T) {
+	assert := assert.New(t)
+
+	rules := lintRules(t, nil, `
+@id("allow-all")
+permit(principal, action, resource);
+
+@id("view-branch")
+permit(
+  principal is MagicFarmacia::Platform::User,
+  action == MagicFarmacia::Platform::Action::"view",
+  resource is MagicFarmacia::Platform::Branch
+) when { principal.isEnabled };
+
+@id("view-order")
+permit(
+  principal is MagicFarmacia::Platform::User,
+  action == MagicFarmacia::Platform::Action::"view",
+  resource is MagicFarmacia::Platform::Order
+);
+
+@id("view-branch-copy")
+permit(
+  principal is MagicFarmacia::Platform::User,
+  action == MagicFarmacia::Platform::Action::"view",
+  resource is MagicFarmacia::Platform::Branch
+) when { principal.isEnabled };
+
+@id("archive")
+permit(
+  principal is MagicFarmacia::Platform::Customer,
+  action == MagicFarmacia::Platform::Action::"archive",
+  resource
+);
+
+@id("deny-delete")
+forbid(
+  principal,
+  action in MagicFarmacia::Platform::Action::"manage",
+  resource == MagicFarmacia::Platform::Order::"o-1"
+);
+
+@id("delete-order")
+permit(
+  principal == MagicFarmacia::Platform::User::"u-1",
+  action == MagicFarmacia::Platform::Action::"delete",
+  resource == MagicFarmacia::Platform::Order::"o-1"
+);
+`)
+	assert.Equal([]string{azlint.RuleUnconditionalPermit}, rules["allow-all"])
+	assert.Equal([]string{azlint.RuleOverlappingPolicy}, rules["view-branch"])
+	assert.Equal([]string{azlint.RuleUnsatisfiablePermit, azlint.RuleOverlappingPolicy}, rules["view-order"])
+	assert.Equal([]string{azlint.RuleDuplicatePolicy, azlint.RuleOverlappingPolicy}, rules["view-branch-copy"])
+	assert.Equal([]string{azlint.RuleUnknownEntityType, azlint.RuleUnknownAction, azlint.RuleOverlappingPolicy}, rules["archive"])
+	assert.Empty(rules["deny-delete"])
+	assert.Equal([]string{azlint.RuleShadowedPolicy, azlint.RuleOverlappingPolicy}, rules["delete-order"])
+}
+
+// TestLintRequiredAnnotations tests the lint of the required annotations.
+func TestLintRequiredAnnotations(t *testing.T) {
+	assert := assert.New(t)
+
+	config := azlint.NewConfig()
+	config.RequiredAnnotations = []string{"owner", "ticket"}
+	rules := lintRules(t, config, `
+@id("view-branch")
+@owner("platform")
+permit(
+  principal is MagicFarmacia::Platform::User,
+  action == MagicFarmacia::Platform::Action::"view",
+  resource is MagicFarmacia::Platform::Branch
+);
+
+@id("delete-order")
+@owner("platform")
+@ticket("PG-1")
+permit(
+  principal is MagicFarmacia::Platform::User,
+  action == MagicFarmacia::Platform::Action::"delete",
+  resource is MagicFarmacia::Platform::Order
+);
+`)
+	assert.Equal([]string{azlint.RuleMissingAnnotation}, rules["view-branch"])
+	assert.Empty(rules["delete-order"])
+}
+
+// TestLintTemplates tests the lint of the policy templates.
+func TestLintTemplates(t *testing.T) {
+	assert := assert.New(t)
+
+	rules := lintRules(t, nil, `
+@id("share-branch")
+permit(
+  principal == ?principal,
+  action == MagicFarmacia::Platform::Action::"view",
+  resource == ?resource
+);
+`)
+	assert.Empty(rules)
+}
+
+// TestLintWithoutSchema tests the lint of the policies without a schema.
+func TestLintWithoutSchema(t *testing.T) {
+	assert := assert.New(t)
+
+	findings, err := NewLinter(nil, nil).Lint([]azlint.Source{{Path: "policies.cedar", Data: []byte(`
+@id("archive")
+permit(
+  principal is MagicFarmacia::Platform::Customer,
+  action == MagicFarmacia::Platform::Action::"archive",
+  resource
+);
+`)}})
+	assert.Nil(err, "Lint should not return an error")
+	assert.Empty(findings)
+
+	_, err = NewLinter(nil, nil).Lint([]azlint.Source{{Path: "policies.cedar", Data: []byte(`permit(`)}})
+	assert.NotNil(err, "Lint should return an error")
+}
@@ -0,0 +1,646 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package partial
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/cedar-policy/cedar-go"
+	cedarast "github.com/cedar-policy/cedar-go/ast"
+	"github.com/cedar-policy/cedar-go/types"
+	"github.com/cedar-policy/cedar-go/x/exp/ast"
+
+	azresiduals "github.com/permguard/permguard/pkg/authz/residuals"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// delegatedPolicyID is the id of the policy used to delegate the evaluation of the known expressions to cedar.
+	delegatedPolicyID = "permguard-partial-evaluation"
+)
+
+// booleanExtensions are the extension functions returning a boolean.
+var booleanExtensions = []types.Path{
+	"lessThan", "lessThanOrEqual", "greaterThan", "greaterThanOrEqual",
+	"isIpv4", "isIpv6", "isLoopback", "isMulticast", "isInRange",
+}
+
+// partialValue is either a known cedar value or a residual expression.
+type partialValue struct {
+	value cedar.Value
+	expr  *azresiduals.Expression
+}
+
+// isKnown returns true if the value is known.
+func (v partialValue) isKnown() bool {
+	return v.expr == nil
+}
+
+// known creates a known partial value.
+func known(value cedar.Value) partialValue {
+	return partialValue{value: value}
+}
+
+// residual creates a residual partial value, constant boolean expressions are converted to known values.
+func residual(expr *azresiduals.Expression) partialValue {
+	if azresiduals.IsTrue(expr) {
+		return known(cedar.True)
+	} else if azresiduals.IsFalse(expr) {
+		return known(cedar.False)
+	}
+	return partialValue{expr: expr}
+}
+
+// Evaluator partially evaluates cedar policies for requests where only the type of the resource is known.
+type Evaluator struct {
+	entities cedar.EntityMap
+	request  cedar.Request
+}
+
+// NewEvaluator creates a new evaluator.
+func NewEvaluator(entities cedar.EntityMap, request *cedar.Request) *Evaluator {
+	return &Evaluator{
+		entities: entities,
+		request:  *request,
+	}
+}
+
+// Evaluate evaluates the policy set and returns the residual expression the resource has to satisfy to be permitted.
+func (e *Evaluator) Evaluate(ps *cedar.PolicySet) (*azresiduals.Expression, error) {
+	policies := ps.Map()
+	policyIDs := make([]cedar.PolicyID, 0, len(policies))
+	for policyID := range policies {
+		policyIDs = append(policyIDs, policyID)
+	}
+	slices.Sort(policyIDs)
+	permits := []*azresiduals.Expression{}
+	forbids := []*azresiduals.Expression{}
+	for _, policyID := range policyIDs {
+		policy := (*ast.Policy)(policies[policyID].AST())
+		expr, err := e.evaluatePolicy(policy)
+		if err != nil {
+			if azerrors.AreErrorsEqual(err, azerrors.ErrNotImplemented) {
+				return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrNotImplemented, fmt.Sprintf("[cedar] policy %s cannot be partially evaluated", policyID), err)
+			}
+			// Policies failing the evaluation are ignored as it happens in the authorization check.
+			continue
+		}
+		if policy.Effect == ast.EffectPermit {
+			permits = append(permits, expr)
+		} else {
+			forbids = append(forbids, expr)
+		}
+	}
+	return azresiduals.And(azresiduals.Or(permits...), azresiduals.Not(azresiduals.Or(forbids...))), nil
+}
+
+// evaluatePolicy evaluates the scope and the conditions of the policy.
+func (e *Evaluator) evaluatePolicy(policy *ast.Policy) (*azresiduals.Expression, error) {
+	if !e.matchScope(policy.Principal, e.request.Principal) || !e.matchScope(policy.Action, e.request.Action) {
+		return azresiduals.Bool(false), nil
+	}
+	exprs := []*azresiduals.Expression{e.evaluateResourceScope(policy.Resource)}
+	for _, condition := range policy.Conditions {
+		value, err := e.evaluate(condition.Body)
+		if err != nil {
+			return nil, err
+		}
+		expr, err := e.toBoolean(value)
+		if err != nil {
+			return nil, err
+		}
+		if condition.Condition == ast.ConditionUnless {
+			expr = azresiduals.Not(expr)
+		}
+		exprs = append(exprs, expr)
+		if azresiduals.IsFalse(expr) {
+			break
+		}
+	}
+	return azresiduals.And(exprs...), nil
+}
+
+// matchScope returns true if the entity matches the scope.
+func (e *Evaluator) matchScope(scope ast.IsScopeNode, uid cedar.EntityUID) bool {
+	switch s := scope.(type) {
+	case ast.ScopeTypeAll:
+		return true
+	case ast.ScopeTypeEq:
+		return uid == s.Entity
+	case ast.ScopeTypeIn:
+		return e.isDescendant(uid, s.Entity)
+	case ast.ScopeTypeInSet:
+		return slices.ContainsFunc(s.Entities, func(entity cedar.EntityUID) bool { return e.isDescendant(uid, entity) })
+	case ast.ScopeTypeIs:
+		return uid.Type == s.Type
+	case ast.ScopeTypeIsIn:
+		return uid.Type == s.Type && e.isDescendant(uid, s.Entity)
+	}
+	return false
+}
+
+// evaluateResourceScope evaluates the resource scope.
+func (e *Evaluator) evaluateResourceScope(scope ast.IsResourceScopeNode) *azresiduals.Expression {
+	resourceType := e.request.Resource.Type
+	switch s := scope.(type) {
+	case ast.ScopeTypeAll:
+		return azresiduals.Bool(true)
+	case ast.ScopeTypeEq:
+		if s.Entity.Type != resourceType {
+			return azresiduals.Bool(false)
+		}
+		return azresiduals.Binary(azresiduals.OpEq, azresiduals.Resource(), azresiduals.Value(toPortableValue(s.Entity)))
+	case ast.ScopeTypeIn:
+		return azresiduals.Binary(azresiduals.OpIn, azresiduals.Resource(), azresiduals.Value(toPortableValue(s.Entity)))
+	case ast.ScopeTypeIs:
+		return azresiduals.Bool(s.Type == resourceType)
+	case ast.ScopeTypeIsIn:
+		if s.Type != resourceType {
+			return azresiduals.Bool(false)
+		}
+		return azresiduals.Binary(azresiduals.OpIn, azresiduals.Resource(), azresiduals.Value(toPortableValue(s.Entity)))
+	}
+	return azresiduals.Bool(false)
+}
+
+// isDescendant returns true if the entity is equal to or a descendant of the ancestor.
+func (e *Evaluator) isDescendant(uid, ancestor cedar.EntityUID) bool {
+	visited := map[cedar.EntityUID]bool{}
+	queue := []cedar.EntityUID{uid}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == ancestor {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		entity, ok := e.entities.Get(current)
+		if !ok {
+			continue
+		}
+		entity.Parents.Iterate(func(parent cedar.EntityUID) bool {
+			queue = append(queue, parent)
+			return true
+		})
+	}
+	return false
+}
+
+// evaluate evaluates the node.
+func (e *Evaluator) evaluate(node ast.IsNode) (partialValue, error) {
+	if !referencesResource(node) && isBoolean(node) {
+		return e.delegate(node)
+	}
+	switch n := node.(type) {
+	case ast.NodeValue:
+		return known(n.Value), nil
+	case ast.NodeTypeVariable:
+		return e.evaluateVariable(n)
+	case ast.NodeTypeAccess:
+		return e.evaluateAccess(n)
+	case ast.NodeTypeHas:
+		return e.evaluateHas(n)
+	case ast.NodeTypeAnd:
+		return e.evaluateLogical(n.Left, n.Right, true)
+	case ast.NodeTypeOr:
+		return e.evaluateLogical(n.Left, n.Right, false)
+	case ast.NodeTypeNot:
+		value, err := e.evaluate(n.Arg)
+		if err != nil {
+			return partialValue{}, err
+		}
+		expr, err := e.toBoolean(value)
+		if err != nil {
+			return partialValue{}, err
+		}
+		return residual(azresiduals.Not(expr)), nil
+	case ast.NodeTypeIfThenElse:
+		return e.evaluateIfThenElse(n)
+	case ast.NodeTypeEquals:
+		return e.evaluateBinary(azresiduals.OpEq, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeEquals{BinaryNode: b} })
+	case ast.NodeTypeNotEquals:
+		return e.evaluateBinary(azresiduals.OpNe, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeNotEquals{BinaryNode: b} })
+	case ast.NodeTypeLessThan:
+		return e.evaluateBinary(azresiduals.OpLt, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeLessThan{BinaryNode: b} })
+	case ast.NodeTypeLessThanOrEqual:
+		return e.evaluateBinary(azresiduals.OpLe, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeLessThanOrEqual{BinaryNode: b} })
+	case ast.NodeTypeGreaterThan:
+		return e.evaluateBinary(azresiduals.OpGt, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeGreaterThan{BinaryNode: b} })
+	case ast.NodeTypeGreaterThanOrEqual:
+		return e.evaluateBinary(azresiduals.OpGe, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeGreaterThanOrEqual{BinaryNode: b} })
+	case ast.NodeTypeIn:
+		return e.evaluateBinary(azresiduals.OpIn, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeIn{BinaryNode: b} })
+	case ast.NodeTypeContains:
+		return e.evaluateBinary(azresiduals.OpContains, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeContains{BinaryNode: b} })
+	case ast.NodeTypeContainsAll:
+		return e.evaluateBinary(azresiduals.OpContainsAll, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeContainsAll{BinaryNode: b} })
+	case ast.NodeTypeContainsAny:
+		return e.evaluateBinary(azresiduals.OpContainsAny, n.BinaryNode, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeContainsAny{BinaryNode: b} })
+	case ast.NodeTypeLike:
+		return e.evaluateLike(n)
+	case ast.NodeTypeIs:
+		return e.evaluateIs(n)
+	case ast.NodeTypeIsIn:
+		isValue, err := e.evaluateIs(n.NodeTypeIs)
+		if err != nil {
+			return partialValue{}, err
+		}
+		return e.evaluateLogicalValues(isValue, func() (partialValue, error) {
+			return e.evaluateBinary(azresiduals.OpIn, ast.BinaryNode{Left: n.Left, Right: n.Entity}, func(b ast.BinaryNode) ast.IsNode { return ast.NodeTypeIn{BinaryNode: b} })
+		}, true)
+	case ast.NodeTypeSet:
+		values := make([]cedar.Value, len(n.Elements))
+		for i, element := range n.Elements {
+			value, err := e.evaluateKnown(element)
+			if err != nil {
+				return partialValue{}, err
+			}
+			values[i] = value
+		}
+		return known(cedar.NewSet(values...)), nil
+	case ast.NodeTypeRecord:
+		record := cedar.RecordMap{}
+		for _, element := range n.Elements {
+			value, err := e.evaluateKnown(element.Value)
+			if err != nil {
+				return partialValue{}, err
+			}
+			record[element.Key] = value
+		}
+		return known(cedar.NewRecord(record)), nil
+	}
+	return partialValue{}, unsupportedError(node)
+}
+
+// evaluateKnown evaluates a node which is required to be known.
+func (e *Evaluator) evaluateKnown(node ast.IsNode) (cedar.Value, error) {
+	value, err := e.evaluate(node)
+	if err != nil {
+		return nil, err
+	}
+	if !value.isKnown() {
+		return nil, unsupportedError(node)
+	}
+	return value.value, nil
+}
+
+// evaluateVariable evaluates a variable.
+func (e *Evaluator) evaluateVariable(n ast.NodeTypeVariable) (partialValue, error) {
+	switch n.Name {
+	case "principal":
+		return known(e.request.Principal), nil
+	case "action":
+		return known(e.request.Action), nil
+	case "resource":
+		return residual(azresiduals.Resource()), nil
+	case "context":
+		return known(e.request.Context), nil
+	}
+	return partialValue{}, evaluationError(fmt.Sprintf("unknown variable %s", n.Name))
+}
+
+// evaluateAccess evaluates an attribute access.
+func (e *Evaluator) evaluateAccess(n ast.NodeTypeAccess) (partialValue, error) {
+	base, err := e.evaluate(n.Arg)
+	if err != nil {
+		return partialValue{}, err
+	}
+	if !base.isKnown() {
+		path, ok := resourcePath(base.expr)
+		if !ok {
+			return partialValue{}, unsupportedError(n)
+		}
+		return residual(azresiduals.Attribute(append(path, string(n.Value))...)), nil
+	}
+	var record cedar.Record
+	switch v := base.value.(type) {
+	case cedar.Record:
+		record = v
+	case cedar.EntityUID:
+		entity, ok := e.entities.Get(v)
+		if !ok {
+			return partialValue{}, evaluationError(fmt.Sprintf("entity %s does not exist", v))
+		}
+		record = entity.Attributes
+	default:
+		return partialValue{}, evaluationError(fmt.Sprintf("attribute %s cannot be accessed", n.Value))
+	}
+	value, ok := record.Get(n.Value)
+	if !ok {
+		return partialValue{}, evaluationError(fmt.Sprintf("attribute %s does not exist", n.Value))
+	}
+	return known(value), nil
+}
+
+// evaluateHas evaluates an attribute presence check.
+func (e *Evaluator) evaluateHas(n ast.NodeTypeHas) (partialValue, error) {
+	base, err := e.evaluate(n.Arg)
+	if err != nil {
+		return partialValue{}, err
+	}
+	if !base.isKnown() {
+		path, ok := resourcePath(base.expr)
+		if !ok {
+			return partialValue{}, unsupportedError(n)
+		}
+		return residual(azresiduals.Has(append(path, string(n.Value))...)), nil
+	}
+	return e.delegate(ast.NodeTypeHas{StrOpNode: ast.StrOpNode{Arg: ast.NodeValue{Value: base.value}, Value: n.Value}})
+}
+
+// evaluateLogical evaluates the conjunction or the disjunction of two nodes.
+func (e *Evaluator) evaluateLogical(left, right ast.IsNode, isAnd bool) (partialValue, error) {
+	leftValue, err := e.evaluate(left)
+	if err != nil {
+		return partialValue{}, err
+	}
+	return e.evaluateLogicalValues(leftValue, func() (partialValue, error) { return e.evaluate(right) }, isAnd)
+}
+
+// evaluateLogicalValues evaluates the conjunction or the disjunction of a value and a lazily evaluated value.
+func (e *Evaluator) evaluateLogicalValues(leftValue partialValue, right func() (partialValue, error), isAnd bool) (partialValue, error) {
+	leftExpr, err := e.toBoolean(leftValue)
+	if err != nil {
+		return partialValue{}, err
+	}
+	if (isAnd && azresiduals.IsFalse(leftExpr)) || (!isAnd && azresiduals.IsTrue(leftExpr)) {
+		return residual(leftExpr), nil
+	}
+	rightValue, err := right()
+	if err != nil {
+		return partialValue{}, err
+	}
+	rightExpr, err := e.toBoolean(rightValue)
+	if err != nil {
+		return partialValue{}, err
+	}
+	if isAnd {
+		return residual(azresiduals.And(leftExpr, rightExpr)), nil
+	}
+	return residual(azresiduals.Or(leftExpr, rightExpr)), nil
+}
+
+// evaluateIfThenElse evaluates a conditional node.
+func (e *Evaluator) evaluateIfThenElse(n ast.NodeTypeIfThenElse) (partialValue, error) {
+	condValue, err := e.evaluate(n.If)
+	if err != nil {
+		return partialValue{}, err
+	}
+	condExpr, err := e.toBoolean(condValue)
+	if err != nil {
+		return partialValue{}, err
+	}
+	if azresiduals.IsTrue(condExpr) {
+		return e.evaluate(n.Then)
+	} else if azresiduals.IsFalse(condExpr) {
+		return e.evaluate(n.Else)
+	}
+	exprs := make([]*azresiduals.Expression, 2)
+	for i, branch := range []ast.IsNode{n.Then, n.Else} {
+		value, err := e.evaluate(branch)
+		if err != nil {
+			return partialValue{}, err
+		}
+		if exprs[i], err = e.toBoolean(value); err != nil {
+			return partialValue{}, unsupportedError(n)
+		}
+	}
+	return residual(azresiduals.Or(azresiduals.And(condExpr, exprs[0]), azresiduals.And(azresiduals.Not(condExpr), exprs[1]))), nil
+}
+
+// evaluateBinary evaluates a binary operator, known operands are delegated to cedar.
+func (e *Evaluator) evaluateBinary(op string, n ast.BinaryNode, build func(ast.BinaryNode) ast.IsNode) (partialValue, error) {
+	left, err := e.evaluate(n.Left)
+	if err != nil {
+		return partialValue{}, err
+	}
+	right, err := e.evaluate(n.Right)
+	if err != nil {
+		return partialValue{}, err
+	}
+	if left.isKnown() && right.isKnown() {
+		return e.delegate(build(ast.BinaryNode{Left: ast.NodeValue{Value: left.value}, Right: ast.NodeValue{Value: right.value}}))
+	}
+	return residual(azresiduals.Binary(op, toExpression(left), toExpression(right))), nil
+}
+
+// evaluateLike evaluates a pattern match.
+func (e *Evaluator) evaluateLike(n ast.NodeTypeLike) (partialValue, error) {
+	arg, err := e.evaluate(n.Arg)
+	if err != nil {
+		return partialValue{}, err
+	}
+	if arg.isKnown() {
+		return e.delegate(ast.NodeTypeLike{Arg: ast.NodeValue{Value: arg.value}, Value: n.Value})
+	}
+	pattern := string(n.Value.MarshalCedar())
+	pattern = pattern[1 : len(pattern)-1]
+	return residual(&azresiduals.Expression{Op: azresiduals.OpLike, Args: []*azresiduals.Expression{arg.expr}, Value: pattern}), nil
+}
+
+// evaluateIs evaluates a type check.
+func (e *Evaluator) evaluateIs(n ast.NodeTypeIs) (partialValue, error) {
+	arg, err := e.evaluate(n.Left)
+	if err != nil {
+		return partialValue{}, err
+	}
+	if arg.isKnown() {
+		return e.delegate(ast.NodeTypeIs{Left: ast.NodeValue{Value: arg.value}, EntityType: n.EntityType})
+	}
+	if arg.expr.Op == azresiduals.OpResource {
+		return known(cedar.Boolean(n.EntityType == e.request.Resource.Type)), nil
+	}
+	return residual(&azresiduals.Expression{Op: azresiduals.OpIs, Args: []*azresiduals.Expression{arg.expr}, Value: string(n.EntityType)}), nil
+}
+
+// delegate evaluates a boolean node not referring to the resource with cedar.
+func (e *Evaluator) delegate(node ast.IsNode) (partialValue, error) {
+	policy := ast.Permit().When(ast.NewNode(node))
+	ps := cedar.NewPolicySet()
+	ps.Add(delegatedPolicyID, cedar.NewPolicyFromAST((*cedarast.Policy)(policy)))
+	decision, diagnostic := ps.IsAuthorized(e.entities, e.request)
+	if len(diagnostic.Errors) > 0 {
+		return partialValue{}, evaluationError(diagnostic.Errors[0].Message)
+	}
+	return known(cedar.Boolean(decision == cedar.Allow)), nil
+}
+
+// toBoolean converts the partial value to a boolean residual expression.
+func (e *Evaluator) toBoolean(value partialValue) (*azresiduals.Expression, error) {
+	if !value.isKnown() {
+		return value.expr, nil
+	}
+	boolean, ok := value.value.(cedar.Boolean)
+	if !ok {
+		return nil, evaluationError("expected a boolean value")
+	}
+	return azresiduals.Bool(bool(boolean)), nil
+}
+
+// resourcePath returns the attribute path of a residual expression referring to the resource.
+func resourcePath(expr *azresiduals.Expression) ([]string, bool) {
+	switch expr.Op {
+	case azresiduals.OpResource:
+		return []string{}, true
+	case azresiduals.OpAttribute:
+		return slices.Clone(expr.Path), true
+	}
+	return nil, false
+}
+
+// toExpression converts the partial value to a residual expression.
+func toExpression(value partialValue) *azresiduals.Expression {
+	if value.isKnown() {
+		return azresiduals.Value(toPortableValue(value.value))
+	}
+	return value.expr
+}
+
+// toPortableValue converts a cedar value to a json compatible value.
+func toPortableValue(value cedar.Value) any {
+	switch v := value.(type) {
+	case cedar.Boolean:
+		return bool(v)
+	case cedar.Long:
+		return int64(v)
+	case cedar.String:
+		return string(v)
+	case cedar.EntityUID:
+		return map[string]any{"type": string(v.Type), "id": string(v.ID)}
+	case cedar.Set:
+		values := make([]any, 0, v.Len())
+		v.Iterate(func(element cedar.Value) bool {
+			values = append(values, toPortableValue(element))
+			return true
+		})
+		return values
+	case cedar.Record:
+		values := map[string]any{}
+		v.Iterate(func(key cedar.String, element cedar.Value) bool {
+			values[string(key)] = toPortableValue(element)
+			return true
+		})
+		return values
+	}
+	return value.String()
+}
+
+// isBoolean returns true if the node always evaluates to a boolean.
+func isBoolean(node ast.IsNode) bool {
+	switch n := node.(type) {
+	case ast.NodeTypeAnd, ast.NodeTypeOr, ast.NodeTypeNot,
+		ast.NodeTypeEquals, ast.NodeTypeNotEquals,
+		ast.NodeTypeLessThan, ast.NodeTypeLessThanOrEqual, ast.NodeTypeGreaterThan, ast.NodeTypeGreaterThanOrEqual,
+		ast.NodeTypeIn, ast.NodeTypeHas, ast.NodeTypeHasTag, ast.NodeTypeLike, ast.NodeTypeIs, ast.NodeTypeIsIn,
+		ast.NodeTypeContains, ast.NodeTypeContainsAll, ast.NodeTypeContainsAny:
+		return true
+	case ast.NodeTypeExtensionCall:
+		return slices.Contains(booleanExtensions, n.Name)
+	}
+	return false
+}
+
+// referencesResource returns true if the node refers to the resource, unknown nodes are considered referring to it.
+func referencesResource(node ast.IsNode) bool {
+	switch n := node.(type) {
+	case ast.NodeValue:
+		return false
+	case ast.NodeTypeVariable:
+		return n.Name == "resource"
+	case ast.NodeTypeAccess:
+		return referencesResource(n.Arg)
+	case ast.NodeTypeHas:
+		return referencesResource(n.Arg)
+	case ast.NodeTypeLike:
+		return referencesResource(n.Arg)
+	case ast.NodeTypeIs:
+		return referencesResource(n.Left)
+	case ast.NodeTypeIsIn:
+		return referencesResource(n.Left) || referencesResource(n.Entity)
+	case ast.NodeTypeNot:
+		return referencesResource(n.Arg)
+	case ast.NodeTypeNegate:
+		return referencesResource(n.Arg)
+	case ast.NodeTypeIfThenElse:
+		return referencesResource(n.If) || referencesResource(n.Then) || referencesResource(n.Else)
+	case ast.NodeTypeExtensionCall:
+		return slices.ContainsFunc(n.Args, referencesResource)
+	case ast.NodeTypeSet:
+		return slices.ContainsFunc(n.Elements, referencesResource)
+	case ast.NodeTypeRecord:
+		return slices.ContainsFunc(n.Elements, func(element ast.RecordElementNode) bool { return referencesResource(element.Value) })
+	}
+	if binary, ok := binaryNode(node); ok {
+		return referencesResource(binary.Left) || referencesResource(binary.Right)
+	}
+	return true
+}
+
+// binaryNode returns the binary node of the binary operators.
+func binaryNode(node ast.IsNode) (ast.BinaryNode, bool) {
+	switch n := node.(type) {
+	case ast.NodeTypeAnd:
+		return n.BinaryNode, true
+	case ast.NodeTypeOr:
+		return n.BinaryNode, true
+	case ast.NodeTypeEquals:
+		return n.BinaryNode, true
+	case ast.NodeTypeNotEquals:
+		return n.BinaryNode, true
+	case ast.NodeTypeLessThan:
+		return n.BinaryNode, true
+	case ast.NodeTypeLessThanOrEqual:
+		return n.BinaryNode, true
+	case ast.NodeTypeGreaterThan:
+		return n.BinaryNode, true
+	case ast.NodeTypeGreaterThanOrEqual:
+		return n.BinaryNode, true
+	case ast.NodeTypeIn:
+		return n.BinaryNode, true
+	case ast.NodeTypeContains:
+		return n.BinaryNode, true
+	case ast.NodeTypeContainsAll:
+		return n.BinaryNode, true
+	case ast.NodeTypeContainsAny:
+		return n.BinaryNode, true
+	case ast.NodeTypeHasTag:
+		return n.BinaryNode, true
+	case ast.NodeTypeGetTag:
+		return n.BinaryNode, true
+	case ast.NodeTypeAdd:
+		return n.BinaryNode, true
+	case ast.NodeTypeSub:
+		return n.BinaryNode, true
+	case ast.NodeTypeMult:
+		return n.BinaryNode, true
+	}
+	return ast.BinaryNode{}, false
+}
+
+// unsupportedError creates the error for the nodes not supported by the partial evaluation.
+func unsupportedError(node ast.IsNode) error {
+	return azerrors.WrapSystemErrorWithMessage(azerrors.ErrNotImplemented, fmt.Sprintf("[cedar] partial evaluation does not support %T", node))
+}
+
+// evaluationError creates the error for the nodes failing the evaluation.
+func evaluationError(message string) error {
+	return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] partial evaluation failed: %s", message))
+}
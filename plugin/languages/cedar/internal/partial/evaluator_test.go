@@ -0,0 +1,132 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package partial
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cedar-policy/cedar-go"
+	"github.com/stretchr/testify/assert"
+
+	azresiduals "github.com/permguard/permguard/pkg/authz/residuals"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// evaluatePolicies evaluates the policies for the principal with the view action on the document resource type.
+func evaluatePolicies(t *testing.T, principal string, context cedar.RecordMap, policies string) (*azresiduals.Expression, error) {
+	ps, err := cedar.NewPolicySetFromBytes("policies.cedar", []byte(policies))
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := cedar.NewEntityUID("Group", "managers")
+	entities := cedar.EntityMap{
+		cedar.NewEntityUID("User", "alice"): cedar.Entity{
+			UID:        cedar.NewEntityUID("User", "alice"),
+			Parents:    cedar.NewEntityUIDSet(manager),
+			Attributes: cedar.NewRecord(cedar.RecordMap{"department": cedar.String("finance")}),
+		},
+	}
+	request := &cedar.Request{
+		Principal: cedar.NewEntityUID("User", cedar.String(principal)),
+		Action:    cedar.NewEntityUID("Action", "view"),
+		Resource:  cedar.NewEntityUID("Document", ""),
+		Context:   cedar.NewRecord(context),
+	}
+	return NewEvaluator(entities, request).Evaluate(ps)
+}
+
+// TestEvaluate tests the partial evaluation of the policies.
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal string
+		context   cedar.RecordMap
+		policies  string
+		residual  string
+	}{
+		{
+			name:      "permit-all",
+			principal: "alice",
+			policies:  `permit(principal == User::"alice", action == Action::"view", resource);`,
+			residual:  `{"op":"value","value":true}`,
+		},
+		{
+			name:      "no-matching-principal",
+			principal: "bob",
+			policies:  `permit(principal == User::"alice", action == Action::"view", resource);`,
+			residual:  `{"op":"value","value":false}`,
+		},
+		{
+			name:      "principal-hierarchy-and-other-resource-type",
+			principal: "alice",
+			policies:  `permit(principal in Group::"managers", action, resource is Folder);`,
+			residual:  `{"op":"value","value":false}`,
+		},
+		{
+			name:      "attribute-equals-principal",
+			principal: "alice",
+			policies:  `permit(principal, action == Action::"view", resource is Document) when { resource.owner == principal };`,
+			residual:  `{"op":"eq","args":[{"op":"attribute","path":["owner"]},{"op":"value","value":{"id":"alice","type":"User"}}]}`,
+		},
+		{
+			name:      "known-conditions-are-delegated",
+			principal: "alice",
+			context:   cedar.RecordMap{"level": cedar.Long(5)},
+			policies:  `permit(principal, action, resource) when { context.level > 3 && principal.department == resource.department };`,
+			residual:  `{"op":"eq","args":[{"op":"value","value":"finance"},{"op":"attribute","path":["department"]}]}`,
+		},
+		{
+			name:      "failing-known-conditions",
+			principal: "alice",
+			context:   cedar.RecordMap{"level": cedar.Long(1)},
+			policies:  `permit(principal, action, resource) when { context.level > 3 && resource.public };`,
+			residual:  `{"op":"value","value":false}`,
+		},
+		{
+			name:      "permit-and-forbid",
+			principal: "alice",
+			policies: `permit(principal, action, resource) when { resource has owner && resource.name like "report-*" };
+forbid(principal, action, resource) unless { resource.level <= 3 };`,
+			residual: `{"op":"and","args":[{"op":"has","path":["owner"]},{"op":"like","args":[{"op":"attribute","path":["name"]}],"value":"report-*"},{"op":"le","args":[{"op":"attribute","path":["level"]},{"op":"value","value":3}]}]}`,
+		},
+		{
+			name:      "resource-scope",
+			principal: "alice",
+			policies:  `permit(principal, action, resource in Folder::"public");`,
+			residual:  `{"op":"in","args":[{"op":"resource"},{"op":"value","value":{"id":"public","type":"Folder"}}]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			residual, err := evaluatePolicies(t, test.principal, test.context, test.policies)
+			assert.Nil(err)
+			data, err := json.Marshal(residual)
+			assert.Nil(err)
+			assert.JSONEq(test.residual, string(data))
+		})
+	}
+}
+
+// TestEvaluateUnsupported tests the partial evaluation of the policies with unsupported expressions.
+func TestEvaluateUnsupported(t *testing.T) {
+	assert := assert.New(t)
+	_, err := evaluatePolicies(t, "alice", nil, `permit(principal, action, resource) when { resource.amount.lessThan(decimal("1.0")) };`)
+	assert.NotNil(err)
+	assert.True(azerrors.AreErrorsEqual(err, azerrors.ErrNotImplemented))
+}
@@ -0,0 +1,290 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// namespaceSeparator is the separator of the cedar namespaces.
+	namespaceSeparator = "::"
+	// builtinNamespace is the namespace of the entity types provided by permguard.
+	builtinNamespace = "Permguard" + namespaceSeparator
+	// actionTypeName is the name of the entity type of the actions.
+	actionTypeName = "Action"
+)
+
+// jsonAttribute is an attribute of the cedar json schema.
+type jsonAttribute struct {
+	Type     string `json:"type"`
+	Required *bool  `json:"required,omitempty"`
+}
+
+// jsonEntityType is an entity type of the cedar json schema.
+type jsonEntityType struct {
+	MemberOfTypes []string `json:"memberOfTypes"`
+	Shape         *struct {
+		Attributes map[string]jsonAttribute `json:"attributes"`
+	} `json:"shape"`
+}
+
+// jsonActionRef is a reference to an action of the cedar json schema.
+type jsonActionRef struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// jsonAction is an action of the cedar json schema.
+type jsonAction struct {
+	AppliesTo *struct {
+		PrincipalTypes []string `json:"principalTypes"`
+		ResourceTypes  []string `json:"resourceTypes"`
+	} `json:"appliesTo"`
+	MemberOf []jsonActionRef `json:"memberOf"`
+}
+
+// jsonNamespace is a namespace of the cedar json schema.
+type jsonNamespace struct {
+	EntityTypes map[string]jsonEntityType `json:"entityTypes"`
+	Actions     map[string]jsonAction     `json:"actions"`
+}
+
+// entityType is an entity type of the schema.
+type entityType struct {
+	memberOfTypes map[string]bool
+	attributes    map[string]jsonAttribute
+}
+
+// Action is an action of the schema.
+type Action struct {
+	// AppliesTo is false if the schema does not declare the principal and resource types of the action.
+	AppliesTo      bool
+	PrincipalTypes map[string]bool
+	ResourceTypes  map[string]bool
+	memberOf       []string
+}
+
+// Schema is the subset of the cedar json schema required to validate the entities and to lint the policies.
+type Schema struct {
+	entityTypes map[string]*entityType
+	actions     map[string]*Action
+}
+
+// qualifyTypeName qualifies the type name with the namespace unless already qualified.
+func qualifyTypeName(namespace, name string) string {
+	if namespace == "" || strings.Contains(name, namespaceSeparator) {
+		return name
+	}
+	return namespace + namespaceSeparator + name
+}
+
+// actionUID returns the uid of the action in the cedar format.
+func actionUID(namespace, actionType, id string) string {
+	if actionType == "" {
+		actionType = qualifyTypeName(namespace, actionTypeName)
+	}
+	return fmt.Sprintf("%s%s%q", actionType, namespaceSeparator, id)
+}
+
+// ParseSchema parses the cedar json schema.
+func ParseSchema(data []byte) (*Schema, error) {
+	var namespaces map[string]jsonNamespace
+	if err := json.Unmarshal(data, &namespaces); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] invalid schema syntax", err)
+	}
+	schema := &Schema{entityTypes: map[string]*entityType{}, actions: map[string]*Action{}}
+	for namespace, ns := range namespaces {
+		for name, jsonType := range ns.EntityTypes {
+			typ := &entityType{
+				memberOfTypes: map[string]bool{},
+				attributes:    map[string]jsonAttribute{},
+			}
+			for _, memberOfType := range jsonType.MemberOfTypes {
+				typ.memberOfTypes[qualifyTypeName(namespace, memberOfType)] = true
+			}
+			if jsonType.Shape != nil {
+				for attrName, attr := range jsonType.Shape.Attributes {
+					typ.attributes[attrName] = attr
+				}
+			}
+			schema.entityTypes[qualifyTypeName(namespace, name)] = typ
+		}
+		for name, jsonAction := range ns.Actions {
+			action := &Action{
+				AppliesTo:      jsonAction.AppliesTo != nil,
+				PrincipalTypes: map[string]bool{},
+				ResourceTypes:  map[string]bool{},
+			}
+			if jsonAction.AppliesTo != nil {
+				for _, principalType := range jsonAction.AppliesTo.PrincipalTypes {
+					action.PrincipalTypes[qualifyTypeName(namespace, principalType)] = true
+				}
+				for _, resourceType := range jsonAction.AppliesTo.ResourceTypes {
+					action.ResourceTypes[qualifyTypeName(namespace, resourceType)] = true
+				}
+			}
+			for _, memberOf := range jsonAction.MemberOf {
+				memberOfType := memberOf.Type
+				if memberOfType != "" {
+					memberOfType = qualifyTypeName(namespace, memberOfType)
+				}
+				action.memberOf = append(action.memberOf, actionUID(namespace, memberOfType, memberOf.ID))
+			}
+			schema.actions[actionUID(namespace, "", name)] = action
+		}
+	}
+	return schema, nil
+}
+
+// IsBuiltinType returns true if the entity type is provided by permguard.
+func IsBuiltinType(name string) bool {
+	return strings.HasPrefix(name, builtinNamespace)
+}
+
+// HasEntityType returns true if the entity type is declared in the schema or provided by permguard.
+func (s *Schema) HasEntityType(name string) bool {
+	_, declared := s.entityTypes[name]
+	return declared || IsBuiltinType(name)
+}
+
+// GetAction returns the action given its uid in the cedar format.
+func (s *Schema) GetAction(uid string) (*Action, bool) {
+	action, ok := s.actions[uid]
+	return action, ok
+}
+
+// IsActionDescendant returns true if the action is equal to or a member, directly or transitively, of the ancestor.
+func (s *Schema) IsActionDescendant(uid, ancestor string) bool {
+	visited := map[string]bool{}
+	queue := []string{uid}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == ancestor {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		if action, ok := s.actions[current]; ok {
+			queue = append(queue, action.memberOf...)
+		}
+	}
+	return false
+}
+
+// GetActionDescendants returns the uids of the actions equal to or members, directly or transitively, of the ancestor.
+func (s *Schema) GetActionDescendants(ancestor string) []string {
+	uids := []string{}
+	for uid := range s.actions {
+		if s.IsActionDescendant(uid, ancestor) {
+			uids = append(uids, uid)
+		}
+	}
+	slices.Sort(uids)
+	return uids
+}
+
+// entityUID returns the type and the id of an entity uid.
+func entityUID(value any) (string, string, bool) {
+	uid, ok := value.(map[string]any)
+	if !ok {
+		return "", "", false
+	}
+	uidType, okType := uid["type"].(string)
+	uidID, okID := uid["id"].(string)
+	return uidType, uidID, okType && okID
+}
+
+// isValueOfType returns true if the value matches the primitive type, non primitive types are not checked.
+func isValueOfType(attrType string, value any) bool {
+	switch attrType {
+	case "String":
+		_, ok := value.(string)
+		return ok
+	case "Long":
+		switch number := value.(type) {
+		case float64:
+			return number == float64(int64(number))
+		case int, int32, int64, json.Number:
+			return true
+		}
+		return false
+	case "Boolean":
+		_, ok := value.(bool)
+		return ok
+	case "Set":
+		_, ok := value.([]any)
+		return ok
+	case "Record":
+		_, ok := value.(map[string]any)
+		return ok
+	}
+	return true
+}
+
+// ValidateEntity validates an entity, in the cedar json entity format, against the schema.
+func (s *Schema) ValidateEntity(item map[string]any) error {
+	uidType, uidID, ok := entityUID(item["uid"])
+	if !ok {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] entity uid is not valid")
+	}
+	typ, declared := s.entityTypes[uidType]
+	if !declared {
+		if IsBuiltinType(uidType) {
+			return nil
+		}
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] entity type %s is not declared in the schema", uidType))
+	}
+	entityRef := fmt.Sprintf("%s::\"%s\"", uidType, uidID)
+	parents, _ := item["parents"].([]any)
+	for _, parent := range parents {
+		parentType, _, ok := entityUID(parent)
+		if !ok {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] parent of the entity %s is not valid", entityRef))
+		}
+		if !typ.memberOfTypes[parentType] {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] entity %s cannot be a member of the type %s", entityRef, parentType))
+		}
+	}
+	attrs, _ := item["attrs"].(map[string]any)
+	for attrName, value := range attrs {
+		attr, ok := typ.attributes[attrName]
+		if !ok {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] attribute %s of the entity %s is not declared in the schema", attrName, entityRef))
+		}
+		if !isValueOfType(attr.Type, value) {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] attribute %s of the entity %s is not of type %s", attrName, entityRef, attr.Type))
+		}
+	}
+	for attrName, attr := range typ.attributes {
+		if attr.Required != nil && !*attr.Required {
+			continue
+		}
+		if _, ok := attrs[attrName]; !ok {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] required attribute %s of the entity %s is missing", attrName, entityRef))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const testSchema = `{
+  "MagicFarmacia::Platform": {
+    "entityTypes": {
+      "Branch": {
+        "shape": {
+          "type": "Record",
+          "attributes": {
+            "city": { "type": "String" },
+            "employees": { "type": "Long", "required": false }
+          }
+        },
+        "memberOfTypes": ["Account"]
+      },
+      "Account": {
+        "shape": { "type": "Record", "attributes": {} },
+        "memberOfTypes": []
+      }
+    },
+    "actions": {}
+  }
+}`
+
+// TestValidateEntity tests the validation of the entities against the schema.
+func TestValidateEntity(t *testing.T) {
+	assert := assert.New(t)
+
+	schema, err := ParseSchema([]byte(testSchema))
+	assert.Nil(err, "ParseSchema should not return an error")
+
+	branch := func(attrs map[string]any, parents ...any) map[string]any {
+		return map[string]any{
+			"uid":     map[string]any{"type": "MagicFarmacia::Platform::Branch", "id": "milan"},
+			"attrs":   attrs,
+			"parents": parents,
+		}
+	}
+	account := map[string]any{"type": "MagicFarmacia::Platform::Account", "id": "acme"}
+
+	tests := map[string]struct {
+		item  map[string]any
+		valid bool
+	}{
+		"valid":             {item: branch(map[string]any{"city": "milan", "employees": float64(10)}, account), valid: true},
+		"optional-missing":  {item: branch(map[string]any{"city": "milan"}), valid: true},
+		"builtin-type":      {item: map[string]any{"uid": map[string]any{"type": "Permguard::IAM::User", "id": "alice"}}, valid: true},
+		"undeclared-type":   {item: map[string]any{"uid": map[string]any{"type": "MagicFarmacia::Platform::Store", "id": "s1"}}},
+		"invalid-parent":    {item: branch(map[string]any{"city": "milan"}, map[string]any{"type": "MagicFarmacia::Platform::Branch", "id": "rome"})},
+		"undeclared-attr":   {item: branch(map[string]any{"city": "milan", "country": "italy"})},
+		"wrong-attr-type":   {item: branch(map[string]any{"city": "milan", "employees": "ten"})},
+		"required-missing":  {item: branch(map[string]any{})},
+		"invalid-uid":       {item: map[string]any{"uid": "milan"}},
+		"fractional-number": {item: branch(map[string]any{"city": "milan", "employees": 1.5})},
+	}
+	for name, test := range tests {
+		err := schema.ValidateEntity(test.item)
+		if test.valid {
+			assert.Nil(err, "entity should be valid: %s", name)
+		} else {
+			assert.True(azerrors.AreErrorsEqual(azerrors.ErrLanguangeSemantic, err), "entity should not be valid: %s", name)
+		}
+	}
+
+	_, err = ParseSchema([]byte("{"))
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrLanguageSyntax, err), "invalid schema should return a syntax error")
+}
+
+// TestSchemaActions tests the actions of the schema.
+func TestSchemaActions(t *testing.T) {
+	assert := assert.New(t)
+
+	schema, err := ParseSchema([]byte(`{
+  "MagicFarmacia::Platform": {
+    "entityTypes": { "Branch": {}, "User": {} },
+    "actions": {
+      "manage": {},
+      "view": {
+        "appliesTo": { "principalTypes": ["User"], "resourceTypes": ["Branch"] },
+        "memberOf": [{ "id": "manage" }]
+      }
+    }
+  }
+}`))
+	assert.Nil(err, "ParseSchema should not return an error")
+
+	assert.True(schema.HasEntityType("MagicFarmacia::Platform::Branch"))
+	assert.True(schema.HasEntityType("Permguard::IAM::User"))
+	assert.False(schema.HasEntityType("MagicFarmacia::Platform::Order"))
+
+	view, ok := schema.GetAction(`MagicFarmacia::Platform::Action::"view"`)
+	assert.True(ok)
+	assert.True(view.AppliesTo)
+	assert.True(view.PrincipalTypes["MagicFarmacia::Platform::User"])
+	assert.True(view.ResourceTypes["MagicFarmacia::Platform::Branch"])
+	manage, ok := schema.GetAction(`MagicFarmacia::Platform::Action::"manage"`)
+	assert.True(ok)
+	assert.False(manage.AppliesTo)
+	_, ok = schema.GetAction(`MagicFarmacia::Platform::Action::"delete"`)
+	assert.False(ok)
+
+	assert.True(schema.IsActionDescendant(`MagicFarmacia::Platform::Action::"view"`, `MagicFarmacia::Platform::Action::"manage"`))
+	assert.False(schema.IsActionDescendant(`MagicFarmacia::Platform::Action::"manage"`, `MagicFarmacia::Platform::Action::"view"`))
+	assert.Equal([]string{`MagicFarmacia::Platform::Action::"manage"`, `MagicFarmacia::Platform::Action::"view"`}, schema.GetActionDescendants(`MagicFarmacia::Platform::Action::"manage"`))
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cedar-policy/cedar-go"
+
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// SlotPrincipal is the slot of the principal.
+	SlotPrincipal = "?principal"
+	// SlotResource is the slot of the resource.
+	SlotResource = "?resource"
+	// slotEntityType is the type of the placeholder entities replacing the slots.
+	slotEntityType = "Permguard::Template::Slot"
+)
+
+// slots is the list of the slots supported by the cedar templates.
+var slots = []string{SlotPrincipal, SlotResource}
+
+// placeholder returns the cedar placeholder entity of the slot.
+func placeholder(slot string) string {
+	return fmt.Sprintf("%s::\"%s\"", slotEntityType, strings.TrimPrefix(slot, "?"))
+}
+
+// isIdentifierChar returns true if the char can be part of an identifier.
+func isIdentifierChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// ReplaceSlots replaces the slots of the cedar source with placeholder entities so that the source can be parsed.
+func ReplaceSlots(data []byte) []byte {
+	var out bytes.Buffer
+	inString, inComment := false, false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case inComment:
+			inComment = c != '\n'
+		case inString:
+			if c == '\\' && i+1 < len(data) {
+				out.WriteByte(c)
+				i++
+				c = data[i]
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			inComment = true
+		case c == '?':
+			replaced := false
+			for _, slot := range slots {
+				end := i + len(slot)
+				if !bytes.HasPrefix(data[i:], []byte(slot)) || (end < len(data) && isIdentifierChar(data[end])) {
+					continue
+				}
+				out.WriteString(placeholder(slot))
+				i = end - 1
+				replaced = true
+				break
+			}
+			if replaced {
+				continue
+			}
+		}
+		out.WriteByte(c)
+	}
+	return out.Bytes()
+}
+
+// RestoreSlots restores the slots of the cedar source replaced by placeholder entities.
+func RestoreSlots(data []byte) []byte {
+	for _, slot := range slots {
+		data = bytes.ReplaceAll(data, []byte(placeholder(slot)), []byte(slot))
+	}
+	return data
+}
+
+// slotOf returns the slot of the placeholder entity if the value is a placeholder entity.
+func slotOf(value map[string]any) (string, bool) {
+	entityType, _ := value["type"].(string)
+	entityID, _ := value["id"].(string)
+	if entityType != slotEntityType || len(entityID) == 0 {
+		return "", false
+	}
+	return "?" + entityID, true
+}
+
+// walk visits the json value replacing the placeholder entities with the value returned by the visitor.
+func walk(value any, visitor func(slot string) (any, error)) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		if slot, ok := slotOf(v); ok {
+			return visitor(slot)
+		}
+		for key, item := range v {
+			replaced, err := walk(item, visitor)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = replaced
+		}
+	case []any:
+		for i, item := range v {
+			replaced, err := walk(item, visitor)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = replaced
+		}
+	}
+	return value, nil
+}
+
+// Slots returns the sorted slots referenced by the json policy, a policy without slots is not a template.
+func Slots(policyJSON []byte) ([]string, error) {
+	var policy any
+	if err := json.Unmarshal(policyJSON, &policy); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] template could not be unmarshalled", err)
+	}
+	found := map[string]bool{}
+	_, err := walk(policy, func(slot string) (any, error) {
+		found[slot] = true
+		return map[string]any{"type": slotEntityType, "id": strings.TrimPrefix(slot, "?")}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(found))
+	for slot := range found {
+		result = append(result, slot)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// Link instantiates the json policy template binding its slots to the entities of the template link.
+func Link(templateJSON []byte, link *azlang.TemplateLink) ([]byte, error) {
+	if link == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] invalid template link")
+	}
+	templateSlots, err := Slots(templateJSON)
+	if err != nil {
+		return nil, err
+	}
+	if len(templateSlots) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] policy %s is not a template", link.TemplateID))
+	}
+	if len(templateSlots) != len(link.Slots) {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] template %s requires the slots %s", link.TemplateID, strings.Join(templateSlots, ", ")))
+	}
+	var policy any
+	if err := json.Unmarshal(templateJSON, &policy); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] template could not be unmarshalled", err)
+	}
+	policy, err = walk(policy, func(slot string) (any, error) {
+		entity, ok := link.Slots[slot]
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] template link %s is missing the slot %s", link.LinkID, slot))
+		}
+		if len(strings.TrimSpace(entity.Type)) == 0 || len(strings.TrimSpace(entity.ID)) == 0 {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] template link %s has an invalid entity for the slot %s", link.LinkID, slot))
+		}
+		return map[string]any{"type": entity.Type, "id": entity.ID}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if policyMap, ok := policy.(map[string]any); ok {
+		annotations, _ := policyMap["annotations"].(map[string]any)
+		if annotations == nil {
+			annotations = map[string]any{}
+		}
+		annotations["id"] = link.LinkID
+		policyMap["annotations"] = annotations
+	}
+	policyJSON, err := json.Marshal(policy)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] linked policy could not be marshalled", err)
+	}
+	var cedarPolicy cedar.Policy
+	if err := cedarPolicy.UnmarshalJSON(policyJSON); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] linked policy is invalid", err)
+	}
+	return policyJSON, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import (
+	"testing"
+
+	"github.com/cedar-policy/cedar-go"
+	"github.com/stretchr/testify/assert"
+
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const testTemplate = `// share a document with ?principal
+@id("share-document")
+permit(
+  principal == ?principal,
+  action == MagicFarmacia::Platform::Action::"view",
+  resource in ?resource
+)
+when { context.note != "?resource" };
+`
+
+// parseTemplate parses the template and returns its json form.
+func parseTemplate(t *testing.T, source string) []byte {
+	policySet, err := cedar.NewPolicySetFromBytes("template.cedar", ReplaceSlots([]byte(source)))
+	assert.Nil(t, err, "NewPolicySetFromBytes should not return an error")
+	for _, policy := range policySet.Map() {
+		policyJSON, err := policy.MarshalJSON()
+		assert.Nil(t, err, "MarshalJSON should not return an error")
+		return policyJSON
+	}
+	t.Fatal("the template should contain a policy")
+	return nil
+}
+
+// TestReplaceAndRestoreSlots tests the replacement of the slots with placeholder entities.
+func TestReplaceAndRestoreSlots(t *testing.T) {
+	assert := assert.New(t)
+
+	replaced := string(ReplaceSlots([]byte(testTemplate)))
+	assert.Contains(replaced, `principal == Permguard::Template::Slot::"principal"`)
+	assert.Contains(replaced, `resource in Permguard::Template::Slot::"resource"`)
+	assert.Contains(replaced, `// share a document with ?principal`, "slots in comments should not be replaced")
+	assert.Contains(replaced, `context.note != "?resource"`, "slots in strings should not be replaced")
+	assert.Equal(testTemplate, string(RestoreSlots([]byte(replaced))))
+
+	notSlot := `permit(principal, action, resource) when { context has ?principals };`
+	assert.Equal(notSlot, string(ReplaceSlots([]byte(notSlot))))
+}
+
+// TestSlots tests the detection of the slots of the templates.
+func TestSlots(t *testing.T) {
+	assert := assert.New(t)
+
+	slots, err := Slots(parseTemplate(t, testTemplate))
+	assert.Nil(err, "Slots should not return an error")
+	assert.Equal([]string{SlotPrincipal, SlotResource}, slots)
+
+	slots, err = Slots(parseTemplate(t, `@id("static") permit(principal, action, resource);`))
+	assert.Nil(err, "Slots should not return an error")
+	assert.Empty(slots, "static policies should not have slots")
+
+	_, err = Slots([]byte("{"))
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrLanguageSyntax, err), "invalid json should return a syntax error")
+}
+
+// TestLink tests the instantiation of the templates.
+func TestLink(t *testing.T) {
+	assert := assert.New(t)
+
+	templateJSON := parseTemplate(t, testTemplate)
+	link := &azlang.TemplateLink{
+		LinkID:     "share-x-with-y",
+		TemplateID: "share-document",
+		Slots: map[string]azlang.TemplateLinkEntity{
+			SlotPrincipal: {Type: "MagicFarmacia::Platform::User", ID: "y"},
+			SlotResource:  {Type: "MagicFarmacia::Platform::Document", ID: "x"},
+		},
+	}
+	policyJSON, err := Link(templateJSON, link)
+	assert.Nil(err, "Link should not return an error")
+
+	var policy cedar.Policy
+	assert.Nil(policy.UnmarshalJSON(policyJSON), "the linked policy should be valid")
+	assert.Equal(cedar.String("share-x-with-y"), policy.Annotations()["id"])
+	source := string(policy.MarshalCedar())
+	assert.Contains(source, `principal == MagicFarmacia::Platform::User::"y"`)
+	assert.Contains(source, `resource in MagicFarmacia::Platform::Document::"x"`)
+	assert.NotContains(source, "Permguard::Template::Slot")
+
+	delete(link.Slots, SlotResource)
+	_, err = Link(templateJSON, link)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrLanguangeSemantic, err), "missing slots should return a semantic error")
+
+	link.Slots[SlotResource] = azlang.TemplateLinkEntity{Type: "MagicFarmacia::Platform::Document"}
+	_, err = Link(templateJSON, link)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrLanguangeSemantic, err), "entities without id should return a semantic error")
+
+	_, err = Link(parseTemplate(t, `@id("static") permit(principal, action, resource);`), link)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrLanguangeSemantic, err), "static policies should not be linked")
+}
@@ -19,6 +19,7 @@ package cedar
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/cedar-policy/cedar-go"
@@ -31,7 +32,13 @@ import (
 	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
 	azengine "github.com/permguard/permguard/pkg/authz/engines"
 	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azlint "github.com/permguard/permguard/pkg/authz/lint"
+	azresiduals "github.com/permguard/permguard/pkg/authz/residuals"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azcedarlint "github.com/permguard/permguard/plugin/languages/cedar/internal/lint"
+	azcedarpartial "github.com/permguard/permguard/plugin/languages/cedar/internal/partial"
+	azcedarschema "github.com/permguard/permguard/plugin/languages/cedar/internal/schema"
+	azcedartemplates "github.com/permguard/permguard/plugin/languages/cedar/internal/templates"
 )
 
 // CedarLanguageAbstraction is the abstraction for the cedar language.
@@ -82,7 +89,7 @@ func (abs *CedarLanguageAbstraction) CreatePolicyBlobObjects(filePath string, da
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "[cedar] unsupported frontend language")
 	}
 
-	policySet, err := cedar.NewPolicySetFromBytes(filePath, data)
+	policySet, err := cedar.NewPolicySetFromBytes(filePath, azcedartemplates.ReplaceSlots(data))
 	if err != nil {
 		multiSecObj, err2 := azobjs.NewMultiSectionsObject(filePath, 0, nil)
 		if err2 != nil {
@@ -130,13 +137,23 @@ func (abs *CedarLanguageAbstraction) CreatePolicyBlobObjects(filePath string, da
 			continue
 		}
 
-		header, err := azobjs.NewObjectHeader(true, langID, langVersionID, langPolicyTypeID, codeID, codeTypeID)
+		policyJSON, err := policy.MarshalJSON()
 		if err != nil {
 			multiSecObj.AddSectionObjectWithError(i, err)
 			continue
 		}
 
-		policyJSON, err := policy.MarshalJSON()
+		slots, err := azcedartemplates.Slots(policyJSON)
+		if err != nil {
+			multiSecObj.AddSectionObjectWithError(i, err)
+			continue
+		}
+		policyType, policyTypeID := langPolicyType, langPolicyTypeID
+		if len(slots) > 0 {
+			policyType, policyTypeID = LanguageTemplateType, LanguageTemplateTypeID
+		}
+
+		header, err := azobjs.NewObjectHeader(true, langID, langVersionID, policyTypeID, codeID, codeTypeID)
 		if err != nil {
 			multiSecObj.AddSectionObjectWithError(i, err)
 			continue
@@ -153,7 +170,7 @@ func (abs *CedarLanguageAbstraction) CreatePolicyBlobObjects(filePath string, da
 			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[cedar] failed to get the object info", err)
 		}
 
-		multiSecObj.AddSectionObjectWithParams(obj, objInfo.GetType(), objName, codeID, codeType, lang, langVersion, langPolicyType, i)
+		multiSecObj.AddSectionObjectWithParams(obj, objInfo.GetType(), objName, codeID, codeType, lang, langVersion, policyType, i)
 	}
 
 	return multiSecObj, nil
@@ -247,6 +264,13 @@ func (abs *CedarLanguageAbstraction) ConvertBytesToFrontendLanguage(langID, lang
 			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] invalid policy syntax", err)
 		}
 		frontendContent = cedarPolicy.MarshalCedar()
+	case LanguageTemplateTypeID:
+		var cedarPolicy cedar.Policy
+		err := cedarPolicy.UnmarshalJSON(content)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] invalid template syntax", err)
+		}
+		frontendContent = azcedartemplates.RestoreSlots(cedarPolicy.MarshalCedar())
 	case azcedarlang.LanguageSchemaTypeID:
 		frontendContent = content
 	default:
@@ -255,12 +279,14 @@ func (abs *CedarLanguageAbstraction) ConvertBytesToFrontendLanguage(langID, lang
 	return frontendContent, nil
 }
 
-// AuthorizationCheck checks the authorization.
-func (abs *CedarLanguageAbstraction) AuthorizationCheck(contextID string, policyStore *azauthzen.PolicyStore, authzCtx *azauthzen.AuthorizationModel) (*azauthzen.AuthorizationDecision, error) {
-	// Creates a new policy set.
+// createPolicySet creates the cedar policy set out of the policy store.
+func createPolicySet(policyStore *azauthzen.PolicyStore) (*cedar.PolicySet, error) {
 	ps := cedar.NewPolicySet()
 	for _, policy := range policyStore.GetPolicies() {
 		objInfo := policy.GetObjectInfo()
+		if objInfo.GetHeader().GetLanguageTypeID() == LanguageTemplateTypeID {
+			continue
+		}
 		policyBytes := objInfo.GetInstance().([]byte)
 		var policy cedar.Policy
 		if err := policy.UnmarshalJSON(policyBytes); err != nil {
@@ -269,36 +295,43 @@ func (abs *CedarLanguageAbstraction) AuthorizationCheck(contextID string, policy
 		codeID := objInfo.GetHeader().GetCodeID()
 		ps.Add(cedar.PolicyID(codeID), &policy)
 	}
+	return ps, nil
+}
 
+// createRequest creates the cedar request and the entities out of the authorization context, the resource id is optional if not required.
+func createRequest(authzCtx *azauthzen.AuthorizationModel, resourceIDRequired bool) (*cedar.Request, cedar.EntityMap, error) {
 	// Extract the subject from the authorization context.
 	subject := authzCtx.GetSubject()
 	subjectID := subject.GetID()
 	if len(strings.TrimSpace(subjectID)) == 0 {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the subject id")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the subject id")
 	}
 	subjectKind := subject.GetType()
 	pmgSubjectKind, err := createPermguardSubjectKind(subjectKind)
 	if err != nil {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the subject type")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the subject type")
 	}
 	subjectProperties, err := createEntityAttribJSON(pmgSubjectKind, subjectID, subject.GetProperties())
 	if err != nil {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the subject properties")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the subject properties")
 	}
 
 	// Extract the resource from the authorization context.
 	resource := authzCtx.GetResource()
 	resourceType := resource.GetType()
 	if len(strings.TrimSpace(resourceType)) == 0 {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the resource type")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the resource type")
 	}
 	resourceID := resource.GetID()
-	if len(strings.TrimSpace(resourceID)) == 0 {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the resource id")
+	if len(strings.TrimSpace(resourceID)) == 0 && resourceIDRequired {
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the resource id")
 	}
-	resourceProperties, err := createEntityAttribJSON(resourceType, resourceID, resource.GetProperties())
-	if err != nil {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the resource properties")
+	var resourceProperties map[string]any
+	if len(strings.TrimSpace(resourceID)) > 0 {
+		resourceProperties, err = createEntityAttribJSON(resourceType, resourceID, resource.GetProperties())
+		if err != nil {
+			return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the resource properties")
+		}
 	}
 
 	// Extract the action from the authorization context.
@@ -306,19 +339,19 @@ func (abs *CedarLanguageAbstraction) AuthorizationCheck(contextID string, policy
 	actionID := action.GetID()
 	actiondIndex := strings.LastIndex(actionID, "::")
 	if actiondIndex == -1 {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for an invalid action format")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for an invalid action format")
 	}
 	actionType := actionID[:actiondIndex]
 	if len(strings.TrimSpace(actionType)) == 0 {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the action type")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the action type")
 	}
 	actionID = actionID[actiondIndex+len("::"):]
 	if len(strings.TrimSpace(actionID)) == 0 {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the action id")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the action id")
 	}
 	actionProperties, err := createEntityAttribJSON(actionType, actionID, action.GetProperties())
 	if err != nil {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the action properties")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the action properties")
 	}
 
 	// Extract the context from the authorization context.
@@ -326,10 +359,10 @@ func (abs *CedarLanguageAbstraction) AuthorizationCheck(contextID string, policy
 	contextRecord := cedar.NewRecord(context)
 	jsonContext, err := json.Marshal(authzCtx.GetContext())
 	if err != nil {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the context")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the context")
 	}
 	if err := contextRecord.UnmarshalJSON(jsonContext); err != nil {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the context")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the context")
 	}
 	hasIllegalKey := false
 	contextRecord.Iterate(func(key cedar.String, val cedar.Value) bool {
@@ -343,36 +376,54 @@ func (abs *CedarLanguageAbstraction) AuthorizationCheck(contextID string, policy
 	})
 	if hasIllegalKey {
 		errMsg := fmt.Sprintf("[cedar] bad request for an invalid context key, key %s is reserved by permguard and cannot be used", actionID)
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, errMsg)
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, errMsg)
 	}
 
 	// Build the entities.
 	authzEntities := authzCtx.GetEntities()
 	authzEntitiesItems := authzEntities.GetItems()
 	if _, err := verifyUIDTypeFromEntityMap(authzEntitiesItems); err != nil {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the entities")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the entities")
 	}
 	authzEntitiesItems = append(authzEntitiesItems, subjectProperties)
 	authzEntitiesItems = append(authzEntitiesItems, actionProperties)
-	authzEntitiesItems = append(authzEntitiesItems, resourceProperties)
+	if resourceProperties != nil {
+		authzEntitiesItems = append(authzEntitiesItems, resourceProperties)
+	}
 	jsonEntities, err := json.Marshal(authzEntitiesItems)
 	if err != nil {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the entities")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the entities")
 	}
 	var entities cedar.EntityMap
 	if err := json.Unmarshal(jsonEntities, &entities); err != nil {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the entities")
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] bad request for the entities")
 	}
 
 	// Create the request.
-	req := cedar.Request{
+	req := &cedar.Request{
 		Principal: cedar.NewEntityUID(cedar.EntityType(pmgSubjectKind), cedar.String(subjectID)),
 		Action:    cedar.NewEntityUID(cedar.EntityType(actionType), cedar.String(actionID)),
 		Resource:  cedar.NewEntityUID(cedar.EntityType(resourceType), cedar.String(resourceID)),
 		Context:   contextRecord,
 	}
+	return req, entities, nil
+}
+
+// authorizationCheck checks the authorization and returns the ids of the policies determining the decision.
+func (abs *CedarLanguageAbstraction) authorizationCheck(contextID string, policyStore *azauthzen.PolicyStore, authzCtx *azauthzen.AuthorizationModel) (*azauthzen.AuthorizationDecision, []string, error) {
+	// Creates a new policy set.
+	ps, err := createPolicySet(policyStore)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Creates the request.
+	req, entities, err := createRequest(authzCtx, true)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	ok, _ := ps.IsAuthorized(entities, req)
+	ok, diagnostic := ps.IsAuthorized(entities, *req)
 	var adminError, userError *azauthzen.AuthorizationError
 	if !ok {
 		adminError, userError = createAuthorizationErrors(azauthzen.AuthzErrForbiddenCode, azauthzen.AuthzErrForbiddenMessage, azauthzen.AuthzErrForbiddenMessage)
@@ -380,7 +431,164 @@ func (abs *CedarLanguageAbstraction) AuthorizationCheck(contextID string, policy
 	// Take the decision.
 	authzDecision, err := azauthzen.NewAuthorizationDecision(contextID, bool(ok), adminError, userError)
 	if err != nil {
-		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[cedar] failed to create the authorization decision", err)
+		return nil, nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[cedar] failed to create the authorization decision", err)
+	}
+	policyIDs := make([]string, 0, len(diagnostic.Reasons))
+	for _, reason := range diagnostic.Reasons {
+		policyIDs = append(policyIDs, string(reason.PolicyID))
+	}
+	slices.Sort(policyIDs)
+	return authzDecision, policyIDs, nil
+}
+
+// AuthorizationCheck checks the authorization, it is safe for concurrent use as each check creates its own policy set and only reads the policy store.
+func (abs *CedarLanguageAbstraction) AuthorizationCheck(contextID string, policyStore *azauthzen.PolicyStore, authzCtx *azauthzen.AuthorizationModel) (*azauthzen.AuthorizationDecision, error) {
+	authzDecision, _, err := abs.authorizationCheck(contextID, policyStore, authzCtx)
+	return authzDecision, err
+}
+
+// ExplainAuthorizationCheck checks the authorization and returns the ids of the policies determining the decision.
+func (abs *CedarLanguageAbstraction) ExplainAuthorizationCheck(contextID string, policyStore *azauthzen.PolicyStore, authzCtx *azauthzen.AuthorizationModel) (*azauthzen.AuthorizationDecision, []string, error) {
+	return abs.authorizationCheck(contextID, policyStore, authzCtx)
+}
+
+// PartialAuthorizationCheck partially evaluates the authorization when only the type of the resource is known.
+func (abs *CedarLanguageAbstraction) PartialAuthorizationCheck(contextID string, policyStore *azauthzen.PolicyStore, authzCtx *azauthzen.AuthorizationModel) (*azresiduals.PartialDecision, error) {
+	// Creates a new policy set.
+	ps, err := createPolicySet(policyStore)
+	if err != nil {
+		return nil, err
+	}
+
+	// Creates the request without the resource id.
+	req, entities, err := createRequest(authzCtx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Evaluate the residual.
+	residual, err := azcedarpartial.NewEvaluator(entities, req).Evaluate(ps)
+	if err != nil {
+		return nil, err
+	}
+	return azresiduals.NewPartialDecision(contextID, residual), nil
+}
+
+// GetSubjectEntityType returns the entity type of the subject type, false if the subject type isn't a Permguard identity type.
+func (abs *CedarLanguageAbstraction) GetSubjectEntityType(subjectType string) (string, bool) {
+	entityType, ok := permguardSubjectKinds[strings.ToUpper(subjectType)]
+	return entityType, ok
+}
+
+// ValidateEntities validates the entities against the schema of the policy store.
+func (abs *CedarLanguageAbstraction) ValidateEntities(policyStore *azauthzen.PolicyStore, entities []map[string]any) error {
+	for _, schemaObj := range policyStore.GetSchemas() {
+		schemaBytes, ok := schemaObj.GetObjectInfo().GetInstance().([]byte)
+		if !ok {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] schema could not be read")
+		}
+		schema, err := azcedarschema.ParseSchema(schemaBytes)
+		if err != nil {
+			return err
+		}
+		for _, entity := range entities {
+			if err := schema.ValidateEntity(entity); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// findTemplates returns the templates of the policy store indexed by their code id.
+func findTemplates(policyStore *azauthzen.PolicyStore) (map[string][]byte, map[string]bool, error) {
+	templates := map[string][]byte{}
+	policies := map[string]bool{}
+	for _, policy := range policyStore.GetPolicies() {
+		objInfo := policy.GetObjectInfo()
+		header := objInfo.GetHeader()
+		if header.GetLanguageTypeID() != LanguageTemplateTypeID {
+			policies[header.GetCodeID()] = true
+			continue
+		}
+		templateBytes, ok := objInfo.GetInstance().([]byte)
+		if !ok {
+			return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, "[cedar] template could not be read")
+		}
+		templates[header.GetCodeID()] = templateBytes
+	}
+	return templates, policies, nil
+}
+
+// ValidateTemplateLink validates the template link against the templates of the policy store.
+func (abs *CedarLanguageAbstraction) ValidateTemplateLink(policyStore *azauthzen.PolicyStore, link *azlang.TemplateLink) error {
+	if link == nil {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] invalid template link")
+	}
+	if isValid, err := azauthzlangvalidators.ValidatePolicyName(link.LinkID); !isValid {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguangeSemantic, "[cedar] invalid template link id", err)
+	}
+	templates, policies, err := findTemplates(policyStore)
+	if err != nil {
+		return err
+	}
+	if _, exists := templates[link.LinkID]; exists || policies[link.LinkID] {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] template link id %s conflicts with a policy id", link.LinkID))
+	}
+	template, exists := templates[link.TemplateID]
+	if !exists {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[cedar] template %s does not exist", link.TemplateID))
+	}
+	_, err = azcedartemplates.Link(template, link)
+	return err
+}
+
+// LinkTemplates adds to the policy store the policies instantiated from the template links.
+func (abs *CedarLanguageAbstraction) LinkTemplates(policyStore *azauthzen.PolicyStore, links []azlang.TemplateLink) error {
+	if len(links) == 0 {
+		return nil
+	}
+	templates, _, err := findTemplates(policyStore)
+	if err != nil {
+		return err
+	}
+	langSpec := abs.GetLanguageSpecification()
+	for _, link := range links {
+		template, exists := templates[link.TemplateID]
+		if !exists {
+			// The links of templates removed from the ledger are ignored.
+			continue
+		}
+		policyJSON, err := azcedartemplates.Link(template, &link)
+		if err != nil {
+			return err
+		}
+		header, err := azobjs.NewObjectHeader(true, langSpec.GetBackendLanguageID(), langSpec.GetLanguageVersionID(), azcedarlang.LanguagePolicyTypeID, link.LinkID, azauthzlangtypes.ClassTypePolicyID)
+		if err != nil {
+			return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[cedar] failed to create the linked policy header", err)
+		}
+		obj, err := abs.objMng.CreateBlobObject(header, policyJSON)
+		if err != nil {
+			return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[cedar] failed to create the linked policy", err)
+		}
+		objInfo, err := abs.objMng.GetObjectInfo(obj)
+		if err != nil {
+			return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[cedar] failed to get the object info", err)
+		}
+		policyStore.AddPolicy(objInfo.GetOID(), objInfo)
+	}
+	return nil
+}
+
+// LintPolicies statically analyses the policy sources against the schema, the schema is nil if missing.
+func (abs *CedarLanguageAbstraction) LintPolicies(sources []azlint.Source, schema *azlint.Source, config *azlint.Config) ([]azlint.Finding, error) {
+	var cedarSchema *azcedarschema.Schema
+	if schema != nil {
+		var err error
+		cedarSchema, err = azcedarschema.ParseSchema(schema.Data)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return authzDecision, nil
+	return azcedarlint.NewLinter(cedarSchema, config).Lint(sources)
 }
@@ -66,16 +66,18 @@ func createAuthorizationErrors(code string, adminMessage, userMessage string) (*
 	return adminError, userError
 }
 
+// permguardSubjectKinds maps the Permguard subject types to their entity types.
+var permguardSubjectKinds = map[string]string{
+	azmodelspdp.PermguardUser:      "Permguard::IAM::User",
+	azmodelspdp.PermguardRoleActor: "Permguard::IAM::RoleActor",
+	azmodelspdp.PermguardTwinActor: "Permguard::IAM::TwinActor",
+}
+
 // createPermguardSubjectKind creates a Permguard subject kind.
 func createPermguardSubjectKind(kind string) (string, error) {
 	kind = strings.ToUpper(kind)
-	switch kind {
-	case azmodelspdp.PermguardUser:
-		kind = "Permguard::IAM::User"
-	case azmodelspdp.PermguardRoleActor:
-		kind = "Permguard::IAM::RoleActor"
-	case azmodelspdp.PermguardTwinActor:
-		kind = "Permguard::IAM::TwinActor"
+	if pmgKind, ok := permguardSubjectKinds[kind]; ok {
+		kind = pmgKind
 	}
 	return kind, nil
 }
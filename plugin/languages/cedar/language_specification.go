@@ -16,6 +16,13 @@
 
 package cedar
 
+const (
+	// LanguageTemplateType is the language type of the policy templates.
+	LanguageTemplateType = "template"
+	// LanguageTemplateTypeID is the id of the language type of the policy templates.
+	LanguageTemplateTypeID = uint32(3)
+)
+
 // CedarLanguageSpecification is the specification for the cedar language.
 type CedarLanguageSpecification struct {
 	language                      string
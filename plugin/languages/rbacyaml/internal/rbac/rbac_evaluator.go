@@ -0,0 +1,305 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rbac
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// DefaultSubjectType is the type of the subjects bound without type.
+	DefaultSubjectType = "user"
+)
+
+// Request is the request to be evaluated.
+type Request struct {
+	SubjectType        string
+	SubjectID          string
+	SubjectSource      string
+	SubjectProperties  map[string]any
+	ResourceType       string
+	ResourceID         string
+	ResourceProperties map[string]any
+	Action             string
+	ActionProperties   map[string]any
+	Context            map[string]any
+}
+
+// PolicySet is the set of roles and bindings to be evaluated.
+type PolicySet struct {
+	roles    map[string]*Role
+	bindings []*Binding
+	schema   *Schema
+}
+
+// NewPolicySet creates a new policy set.
+func NewPolicySet() *PolicySet {
+	return &PolicySet{
+		roles:    map[string]*Role{},
+		bindings: []*Binding{},
+	}
+}
+
+// AddRole adds a role to the policy set.
+func (p *PolicySet) AddRole(role *Role) error {
+	if _, exists := p.roles[role.Name]; exists {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[rbac-yaml] role %s is defined more than once", role.Name))
+	}
+	p.roles[role.Name] = role
+	return nil
+}
+
+// AddBinding adds a binding to the policy set.
+func (p *PolicySet) AddBinding(binding *Binding) {
+	p.bindings = append(p.bindings, binding)
+}
+
+// SetSchema sets the schema used to validate the requests.
+func (p *PolicySet) SetSchema(schema *Schema) {
+	p.schema = schema
+}
+
+// validateRequest validates the request against the schema.
+func (p *PolicySet) validateRequest(req *Request) error {
+	if p.schema == nil || len(p.schema.Resources) == 0 {
+		return nil
+	}
+	for _, resource := range p.schema.Resources {
+		if resource.Type != req.ResourceType {
+			continue
+		}
+		for _, action := range resource.Actions {
+			if action == req.Action {
+				return nil
+			}
+		}
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[rbac-yaml] action %s is not declared for the resource type %s", req.Action, req.ResourceType))
+	}
+	return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, fmt.Sprintf("[rbac-yaml] resource type %s is not declared in the schema", req.ResourceType))
+}
+
+// IsAuthorized returns true if any binding of the subject grants a role permitting the action on the resource.
+func (p *PolicySet) IsAuthorized(req *Request) (bool, error) {
+	if err := p.validateRequest(req); err != nil {
+		return false, err
+	}
+	for _, binding := range p.bindings {
+		if !matchSubjects(binding.Subjects, req) || !evaluateConditions(binding.Conditions, req) {
+			continue
+		}
+		role, exists := p.roles[binding.Role]
+		if !exists {
+			continue
+		}
+		for _, permission := range role.Permissions {
+			if !matchValue(permission.Resource, req.ResourceType) || !matchActions(permission.Actions, req.Action) {
+				continue
+			}
+			if evaluateConditions(permission.Conditions, req) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// matchValue matches the value against the pattern supporting the wildcard.
+func matchValue(pattern, value string) bool {
+	return pattern == Wildcard || pattern == value
+}
+
+// matchActions matches the action against the actions of a permission.
+func matchActions(actions []string, action string) bool {
+	for _, candidate := range actions {
+		if matchValue(candidate, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSubjects matches the subject of the request against the subjects of a binding.
+func matchSubjects(subjects []Subject, req *Request) bool {
+	for _, subject := range subjects {
+		subjectType := subject.Type
+		if subjectType == "" {
+			subjectType = DefaultSubjectType
+		}
+		if !strings.EqualFold(subjectType, req.SubjectType) || !matchValue(subject.ID, req.SubjectID) {
+			continue
+		}
+		if subject.Source != "" && subject.Source != req.SubjectSource {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// lookupAttribute returns the value of the attribute of the request.
+func lookupAttribute(attribute string, req *Request) (any, bool) {
+	scope, path, _ := strings.Cut(attribute, ".")
+	var properties map[string]any
+	switch scope {
+	case ScopeSubject:
+		switch path {
+		case "id":
+			return req.SubjectID, true
+		case "type":
+			return req.SubjectType, true
+		case "source":
+			return req.SubjectSource, true
+		}
+		properties = req.SubjectProperties
+	case ScopeResource:
+		switch path {
+		case "id":
+			return req.ResourceID, true
+		case "type":
+			return req.ResourceType, true
+		}
+		properties = req.ResourceProperties
+	case ScopeAction:
+		if path == "name" {
+			return req.Action, true
+		}
+		properties = req.ActionProperties
+	case ScopeContext:
+		properties = req.Context
+	default:
+		return nil, false
+	}
+	var value any = properties
+	for _, key := range strings.Split(path, ".") {
+		record, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		value, ok = record[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// evaluateConditions returns true if all the conditions are satisfied.
+func evaluateConditions(conditions []Condition, req *Request) bool {
+	for _, condition := range conditions {
+		if !evaluateCondition(condition, req) {
+			return false
+		}
+	}
+	return true
+}
+
+// evaluateCondition returns true if the condition is satisfied, missing attributes don't satisfy any condition.
+func evaluateCondition(condition Condition, req *Request) bool {
+	attribute, exists := lookupAttribute(condition.Attribute, req)
+	if condition.Operator == OperatorExists {
+		return exists
+	}
+	if !exists {
+		return false
+	}
+	expected := condition.Value
+	if condition.ValueFrom != "" {
+		value, ok := lookupAttribute(condition.ValueFrom, req)
+		if !ok {
+			return false
+		}
+		expected = value
+	}
+	switch condition.Operator {
+	case OperatorEquals:
+		return equalValues(attribute, expected)
+	case OperatorNotEquals:
+		return !equalValues(attribute, expected)
+	case OperatorIn:
+		return containsValue(expected, attribute)
+	case OperatorNotIn:
+		return !containsValue(expected, attribute)
+	case OperatorContains:
+		return containsValue(attribute, expected)
+	case OperatorGreaterThan, OperatorGreaterThanOrEquals, OperatorLessThan, OperatorLessThanOrEquals:
+		left, ok := toNumber(attribute)
+		if !ok {
+			return false
+		}
+		right, ok := toNumber(expected)
+		if !ok {
+			return false
+		}
+		switch condition.Operator {
+		case OperatorGreaterThan:
+			return left > right
+		case OperatorGreaterThanOrEquals:
+			return left >= right
+		case OperatorLessThan:
+			return left < right
+		default:
+			return left <= right
+		}
+	}
+	return false
+}
+
+// toNumber converts the numeric value to float64.
+func toNumber(value any) (float64, bool) {
+	switch number := value.(type) {
+	case int:
+		return float64(number), true
+	case int32:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case uint64:
+		return float64(number), true
+	case float32:
+		return float64(number), true
+	case float64:
+		return number, true
+	}
+	return 0, false
+}
+
+// equalValues compares the values regardless of the numeric types.
+func equalValues(left, right any) bool {
+	if leftNumber, ok := toNumber(left); ok {
+		rightNumber, ok := toNumber(right)
+		return ok && leftNumber == rightNumber
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+// containsValue returns true if the list contains the value.
+func containsValue(list, value any) bool {
+	items, ok := list.([]any)
+	if !ok {
+		return false
+	}
+	for _, item := range items {
+		if equalValues(item, value) {
+			return true
+		}
+	}
+	return false
+}
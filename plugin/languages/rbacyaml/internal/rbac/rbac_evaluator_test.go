@@ -0,0 +1,191 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const testDocument = `
+roles:
+  - name: pharmacist
+    permissions:
+      - resource: MagicFarmacia::Platform::Inventory
+        actions: [MagicFarmacia::Platform::Action::view]
+      - resource: MagicFarmacia::Platform::Order
+        actions: ["*"]
+        conditions:
+          - attribute: resource.branch
+            operator: equals
+            value_from: subject.branch
+          - attribute: resource.amount
+            operator: less_than_or_equals
+            value: 1000
+bindings:
+  - name: pharmacists
+    role: pharmacist
+    subjects:
+      - id: amy.smith@acmecorp.com
+      - type: role-actor
+        id: "*"
+        source: keycloak
+    conditions:
+      - attribute: context.shift
+        operator: in
+        value: [morning, evening]
+`
+
+// newTestPolicySet creates the policy set out of the test document.
+func newTestPolicySet(t *testing.T) *PolicySet {
+	doc, err := ParseDocument([]byte(testDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+	policySet := NewPolicySet()
+	for i := range doc.Roles {
+		if err := doc.Roles[i].Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if err := policySet.AddRole(&doc.Roles[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := range doc.Bindings {
+		if err := doc.Bindings[i].Validate(); err != nil {
+			t.Fatal(err)
+		}
+		policySet.AddBinding(&doc.Bindings[i])
+	}
+	return policySet
+}
+
+// TestIsAuthorized tests the evaluation of the roles and bindings.
+func TestIsAuthorized(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  Request
+		decision bool
+	}{
+		{
+			name:     "permission without conditions",
+			request:  Request{SubjectType: "USER", SubjectID: "amy.smith@acmecorp.com", ResourceType: "MagicFarmacia::Platform::Inventory", Action: "MagicFarmacia::Platform::Action::view", Context: map[string]any{"shift": "morning"}},
+			decision: true,
+		},
+		{
+			name:     "action not granted",
+			request:  Request{SubjectType: "user", SubjectID: "amy.smith@acmecorp.com", ResourceType: "MagicFarmacia::Platform::Inventory", Action: "MagicFarmacia::Platform::Action::delete", Context: map[string]any{"shift": "morning"}},
+			decision: false,
+		},
+		{
+			name:     "binding condition not satisfied",
+			request:  Request{SubjectType: "user", SubjectID: "amy.smith@acmecorp.com", ResourceType: "MagicFarmacia::Platform::Inventory", Action: "MagicFarmacia::Platform::Action::view", Context: map[string]any{"shift": "night"}},
+			decision: false,
+		},
+		{
+			name:     "subject not bound",
+			request:  Request{SubjectType: "user", SubjectID: "bob@acmecorp.com", ResourceType: "MagicFarmacia::Platform::Inventory", Action: "MagicFarmacia::Platform::Action::view", Context: map[string]any{"shift": "morning"}},
+			decision: false,
+		},
+		{
+			name:     "wildcard subject with source",
+			request:  Request{SubjectType: "role-actor", SubjectID: "auditor", SubjectSource: "keycloak", ResourceType: "MagicFarmacia::Platform::Inventory", Action: "MagicFarmacia::Platform::Action::view", Context: map[string]any{"shift": "evening"}},
+			decision: true,
+		},
+		{
+			name:     "wildcard subject with another source",
+			request:  Request{SubjectType: "role-actor", SubjectID: "auditor", SubjectSource: "ldap", ResourceType: "MagicFarmacia::Platform::Inventory", Action: "MagicFarmacia::Platform::Action::view", Context: map[string]any{"shift": "evening"}},
+			decision: false,
+		},
+		{
+			name: "attribute conditions satisfied",
+			request: Request{SubjectType: "user", SubjectID: "amy.smith@acmecorp.com", SubjectProperties: map[string]any{"branch": "milan"},
+				ResourceType: "MagicFarmacia::Platform::Order", ResourceProperties: map[string]any{"branch": "milan", "amount": float64(250)},
+				Action: "MagicFarmacia::Platform::Action::approve", Context: map[string]any{"shift": "morning"}},
+			decision: true,
+		},
+		{
+			name: "attribute conditions not satisfied",
+			request: Request{SubjectType: "user", SubjectID: "amy.smith@acmecorp.com", SubjectProperties: map[string]any{"branch": "milan"},
+				ResourceType: "MagicFarmacia::Platform::Order", ResourceProperties: map[string]any{"branch": "rome", "amount": float64(250)},
+				Action: "MagicFarmacia::Platform::Action::approve", Context: map[string]any{"shift": "morning"}},
+			decision: false,
+		},
+		{
+			name: "missing attribute",
+			request: Request{SubjectType: "user", SubjectID: "amy.smith@acmecorp.com", SubjectProperties: map[string]any{"branch": "milan"},
+				ResourceType: "MagicFarmacia::Platform::Order", ResourceProperties: map[string]any{"branch": "milan"},
+				Action: "MagicFarmacia::Platform::Action::approve", Context: map[string]any{"shift": "morning"}},
+			decision: false,
+		},
+	}
+	policySet := newTestPolicySet(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			decision, err := policySet.IsAuthorized(&test.request)
+			assert.Nil(err)
+			assert.Equal(test.decision, decision)
+		})
+	}
+}
+
+// TestIsAuthorizedWithSchema tests the validation of the requests against the schema.
+func TestIsAuthorizedWithSchema(t *testing.T) {
+	assert := assert.New(t)
+	schema, err := ParseSchema([]byte(`
+resources:
+  - type: MagicFarmacia::Platform::Inventory
+    actions: [MagicFarmacia::Platform::Action::view]
+`))
+	assert.Nil(err)
+	policySet := newTestPolicySet(t)
+	policySet.SetSchema(schema)
+	request := Request{SubjectType: "user", SubjectID: "amy.smith@acmecorp.com", ResourceType: "MagicFarmacia::Platform::Inventory", Action: "MagicFarmacia::Platform::Action::view", Context: map[string]any{"shift": "morning"}}
+	decision, err := policySet.IsAuthorized(&request)
+	assert.Nil(err)
+	assert.True(decision)
+	request.ResourceType = "MagicFarmacia::Platform::Order"
+	_, err = policySet.IsAuthorized(&request)
+	assert.True(azerrors.AreErrorsEqual(err, azerrors.ErrLanguangeSemantic))
+}
+
+// TestValidate tests the validation of the roles and bindings.
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+	assert.NotNil((&Role{Name: "Pharmacist", Permissions: []Permission{{Resource: "a", Actions: []string{"b"}}}}).Validate())
+	assert.NotNil((&Role{Name: "pharmacist"}).Validate())
+	assert.NotNil((&Role{Name: "pharmacist", Permissions: []Permission{{Resource: "a"}}}).Validate())
+	assert.NotNil((&Role{Name: "pharmacist", Permissions: []Permission{{Resource: "a", Actions: []string{"b"}, Conditions: []Condition{{Attribute: "owner", Operator: OperatorEquals}}}}}).Validate())
+	assert.NotNil((&Role{Name: "pharmacist", Permissions: []Permission{{Resource: "a", Actions: []string{"b"}, Conditions: []Condition{{Attribute: "resource.owner", Operator: "matches"}}}}}).Validate())
+	assert.Nil((&Role{Name: "pharmacist", Permissions: []Permission{{Resource: "a", Actions: []string{"b"}, Conditions: []Condition{{Attribute: "resource.owner", Operator: OperatorEquals, ValueFrom: "subject.id"}}}}}).Validate())
+	assert.NotNil((&Binding{Name: "pharmacists", Role: "pharmacist"}).Validate())
+	assert.NotNil((&Binding{Name: "pharmacists", Role: "pharmacist", Subjects: []Subject{{Type: "user"}}}).Validate())
+	assert.Nil((&Binding{Name: "pharmacists", Role: "pharmacist", Subjects: []Subject{{ID: "amy"}}}).Validate())
+
+	policySet := NewPolicySet()
+	assert.Nil(policySet.AddRole(&Role{Name: "pharmacist"}))
+	assert.NotNil(policySet.AddRole(&Role{Name: "pharmacist"}))
+
+	_, err := ParseDocument([]byte("roles: [:"))
+	assert.True(azerrors.AreErrorsEqual(err, azerrors.ErrLanguageSyntax))
+	_, err = ParseSchema([]byte("resources:\n  - type: a\n"))
+	assert.NotNil(err)
+}
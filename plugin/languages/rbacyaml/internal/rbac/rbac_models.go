@@ -0,0 +1,225 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rbac
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// Wildcard matches any resource type, action or subject id.
+	Wildcard = "*"
+)
+
+const (
+	// OperatorEquals checks that the attribute equals the value.
+	OperatorEquals = "equals"
+	// OperatorNotEquals checks that the attribute doesn't equal the value.
+	OperatorNotEquals = "not_equals"
+	// OperatorIn checks that the attribute is one of the values.
+	OperatorIn = "in"
+	// OperatorNotIn checks that the attribute is none of the values.
+	OperatorNotIn = "not_in"
+	// OperatorContains checks that the attribute list contains the value.
+	OperatorContains = "contains"
+	// OperatorExists checks that the attribute is present.
+	OperatorExists = "exists"
+	// OperatorGreaterThan checks that the attribute is greater than the value.
+	OperatorGreaterThan = "greater_than"
+	// OperatorGreaterThanOrEquals checks that the attribute is greater than or equal to the value.
+	OperatorGreaterThanOrEquals = "greater_than_or_equals"
+	// OperatorLessThan checks that the attribute is less than the value.
+	OperatorLessThan = "less_than"
+	// OperatorLessThanOrEquals checks that the attribute is less than or equal to the value.
+	OperatorLessThanOrEquals = "less_than_or_equals"
+)
+
+const (
+	// ScopeSubject is the scope of the subject attributes.
+	ScopeSubject = "subject"
+	// ScopeResource is the scope of the resource attributes.
+	ScopeResource = "resource"
+	// ScopeAction is the scope of the action attributes.
+	ScopeAction = "action"
+	// ScopeContext is the scope of the context attributes.
+	ScopeContext = "context"
+)
+
+// nameRegex is the regex of the role and binding names.
+var nameRegex = regexp.MustCompile(`^[a-z][a-z0-9\-_]*$`)
+
+// Condition is a condition on an attribute.
+type Condition struct {
+	Attribute string `yaml:"attribute" json:"attribute"`
+	Operator  string `yaml:"operator" json:"operator"`
+	Value     any    `yaml:"value,omitempty" json:"value,omitempty"`
+	ValueFrom string `yaml:"value_from,omitempty" json:"value_from,omitempty"`
+}
+
+// Permission grants the actions on a resource type.
+type Permission struct {
+	Resource   string      `yaml:"resource" json:"resource"`
+	Actions    []string    `yaml:"actions" json:"actions"`
+	Conditions []Condition `yaml:"conditions,omitempty" json:"conditions,omitempty"`
+}
+
+// Role is a named set of permissions.
+type Role struct {
+	Name        string       `yaml:"name" json:"name"`
+	Description string       `yaml:"description,omitempty" json:"description,omitempty"`
+	Permissions []Permission `yaml:"permissions" json:"permissions"`
+}
+
+// Subject is a subject bound to a role.
+type Subject struct {
+	Type   string `yaml:"type,omitempty" json:"type,omitempty"`
+	ID     string `yaml:"id" json:"id"`
+	Source string `yaml:"source,omitempty" json:"source,omitempty"`
+}
+
+// Binding binds the subjects to a role.
+type Binding struct {
+	Name       string      `yaml:"name" json:"name"`
+	Role       string      `yaml:"role" json:"role"`
+	Subjects   []Subject   `yaml:"subjects" json:"subjects"`
+	Conditions []Condition `yaml:"conditions,omitempty" json:"conditions,omitempty"`
+}
+
+// Document is a yaml rbac document.
+type Document struct {
+	Roles    []Role    `yaml:"roles,omitempty" json:"roles,omitempty"`
+	Bindings []Binding `yaml:"bindings,omitempty" json:"bindings,omitempty"`
+}
+
+// ResourceType is a resource type declared in the schema.
+type ResourceType struct {
+	Type    string   `yaml:"type" json:"type"`
+	Actions []string `yaml:"actions" json:"actions"`
+}
+
+// Schema declares the resource types and their actions.
+type Schema struct {
+	Resources []ResourceType `yaml:"resources" json:"resources"`
+}
+
+// ParseDocument parses a yaml rbac document.
+func ParseDocument(data []byte) (*Document, error) {
+	var doc Document
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] invalid document syntax", err)
+	}
+	return &doc, nil
+}
+
+// ParseSchema parses and validates a yaml rbac schema.
+func ParseSchema(data []byte) (*Schema, error) {
+	var schema Schema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] invalid schema syntax", err)
+	}
+	for _, resource := range schema.Resources {
+		if len(strings.TrimSpace(resource.Type)) == 0 {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] schema resource type is required")
+		}
+		if len(resource.Actions) == 0 {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[rbac-yaml] schema resource type %s has no actions", resource.Type))
+		}
+	}
+	return &schema, nil
+}
+
+// ValidateName validates a role or binding name.
+func ValidateName(name string) error {
+	if !nameRegex.MatchString(name) {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[rbac-yaml] %s is an invalid name, names must be lowercase and can contain digits, dashes and underscores", name))
+	}
+	return nil
+}
+
+// validateConditions validates the conditions.
+func validateConditions(conditions []Condition) error {
+	for _, condition := range conditions {
+		scope, _, _ := strings.Cut(condition.Attribute, ".")
+		switch scope {
+		case ScopeSubject, ScopeResource, ScopeAction, ScopeContext:
+		default:
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[rbac-yaml] attribute %s must start with subject, resource, action or context", condition.Attribute))
+		}
+		switch condition.Operator {
+		case OperatorEquals, OperatorNotEquals, OperatorIn, OperatorNotIn, OperatorContains, OperatorExists,
+			OperatorGreaterThan, OperatorGreaterThanOrEquals, OperatorLessThan, OperatorLessThanOrEquals:
+		default:
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[rbac-yaml] %s is an invalid operator", condition.Operator))
+		}
+		if condition.ValueFrom != "" && condition.Value != nil {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] value and value_from cannot be both set")
+		}
+		if condition.ValueFrom != "" {
+			if err := validateConditions([]Condition{{Attribute: condition.ValueFrom, Operator: OperatorExists}}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate validates the role.
+func (r *Role) Validate() error {
+	if err := ValidateName(r.Name); err != nil {
+		return err
+	}
+	if len(r.Permissions) == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[rbac-yaml] role %s has no permissions", r.Name))
+	}
+	for _, permission := range r.Permissions {
+		if len(strings.TrimSpace(permission.Resource)) == 0 {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[rbac-yaml] role %s has a permission without resource", r.Name))
+		}
+		if len(permission.Actions) == 0 {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[rbac-yaml] role %s has a permission without actions", r.Name))
+		}
+		if err := validateConditions(permission.Conditions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate validates the binding.
+func (b *Binding) Validate() error {
+	if err := ValidateName(b.Name); err != nil {
+		return err
+	}
+	if err := ValidateName(b.Role); err != nil {
+		return err
+	}
+	if len(b.Subjects) == 0 {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[rbac-yaml] binding %s has no subjects", b.Name))
+	}
+	for _, subject := range b.Subjects {
+		if len(strings.TrimSpace(subject.ID)) == 0 {
+			return azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, fmt.Sprintf("[rbac-yaml] binding %s has a subject without id", b.Name))
+		}
+	}
+	return validateConditions(b.Conditions)
+}
@@ -0,0 +1,366 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rbacyaml
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+	azauthzlangtypes "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/authz/languages/types"
+	azauthzlangvalidators "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/authz/languages/validators"
+	azztasmanifests "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/manifests"
+	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
+	azengine "github.com/permguard/permguard/pkg/authz/engines"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azrbac "github.com/permguard/permguard/plugin/languages/rbacyaml/internal/rbac"
+)
+
+// RBACYAMLLanguageAbstraction is the abstraction for the yaml rbac language.
+type RBACYAMLLanguageAbstraction struct {
+	objMng *azobjs.ObjectManager
+}
+
+// NewRBACYAMLLanguageAbstraction creates a new RBACYAMLLanguageAbstraction.
+func NewRBACYAMLLanguageAbstraction() (*RBACYAMLLanguageAbstraction, error) {
+	objMng, err := azobjs.NewObjectManager()
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] failed to create the object manager", err)
+	}
+	return &RBACYAMLLanguageAbstraction{
+		objMng: objMng,
+	}, nil
+}
+
+// BuildManifest builds the manifest.
+func (abs *RBACYAMLLanguageAbstraction) BuildManifest(manifest *azztasmanifests.Manifest, template string) (*azztasmanifests.Manifest, error) {
+	return buildManifest(manifest, template, azengine.EngineName, azengine.EngineVersion, azengine.EngineDist)
+}
+
+// ValidateManifest validates the manifest.
+func (abs *RBACYAMLLanguageAbstraction) ValidateManifest(manifest *azztasmanifests.Manifest) (bool, error) {
+	return validateManifest(manifest)
+}
+
+// GetLanguageSpecification returns the specification for the language.
+func (abs *RBACYAMLLanguageAbstraction) GetLanguageSpecification() azlang.LanguageSpecification {
+	return &RBACYAMLLanguageSpecification{
+		language:                      LanguageName,
+		languageVersion:               LanguageSyntaxVersion,
+		languageVersionID:             LanguageSyntaxVersionID,
+		frontendLanguage:              LanguageRBACYAML,
+		frontendLanguageID:            LanguageRBACYAMLID,
+		backendLanguage:               LanguageRBACJSON,
+		backendLanguageID:             LanguageRBACJSONID,
+		supportedPolicyFileExtensions: []string{LanguageFileExtension, LanguageFileExtensionShort},
+		supportedSchemaFileNames:      []string{LanguageSchemaFileName},
+	}
+}
+
+// policySection is a role or a binding to be stored as a policy object.
+type policySection struct {
+	name     string
+	langType string
+	typeID   uint32
+	validate func() error
+	content  any
+}
+
+// CreatePolicyBlobObjects creates multi sections policy blob objects.
+func (abs *RBACYAMLLanguageAbstraction) CreatePolicyBlobObjects(filePath string, data []byte) (*azobjs.MultiSectionsObject, error) {
+	doc, err := azrbac.ParseDocument(data)
+	if err != nil {
+		multiSecObj, err2 := azobjs.NewMultiSectionsObject(filePath, 0, nil)
+		if err2 != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] failed to create the multi section object", err2)
+		}
+		multiSecObj.AddSectionObjectWithError(0, err)
+		return multiSecObj, nil
+	}
+
+	sections := []policySection{}
+	for _, role := range doc.Roles {
+		sections = append(sections, policySection{name: role.Name, langType: LanguageRoleType, typeID: LanguageRoleTypeID, validate: role.Validate, content: role})
+	}
+	for _, binding := range doc.Bindings {
+		sections = append(sections, policySection{name: binding.Name, langType: LanguageBindingType, typeID: LanguageBindingTypeID, validate: binding.Validate, content: binding})
+	}
+	multiSecObj, err := azobjs.NewMultiSectionsObject(filePath, len(sections), nil)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] failed to create the multi section object", err)
+	}
+
+	const (
+		codeType   = azauthzlangtypes.ClassTypePolicy
+		codeTypeID = azauthzlangtypes.ClassTypePolicyID
+	)
+
+	langSpec := abs.GetLanguageSpecification()
+	lang := langSpec.GetBackendLanguage()
+	langID := langSpec.GetBackendLanguageID()
+	langVersion := langSpec.GetLanguageVersion()
+	langVersionID := langSpec.GetLanguageVersionID()
+
+	for i, section := range sections {
+		objName := section.name
+		codeID := objName
+		if err := section.validate(); err != nil {
+			multiSecObj.AddSectionObjectWithError(i, err)
+			continue
+		}
+		if isValid, err := azauthzlangvalidators.ValidatePolicyName(codeID); !isValid {
+			multiSecObj.AddSectionObjectWithError(i, err)
+			continue
+		}
+
+		header, err := azobjs.NewObjectHeader(true, langID, langVersionID, section.typeID, codeID, codeTypeID)
+		if err != nil {
+			multiSecObj.AddSectionObjectWithError(i, err)
+			continue
+		}
+
+		sectionJSON, err := json.Marshal(section.content)
+		if err != nil {
+			multiSecObj.AddSectionObjectWithError(i, err)
+			continue
+		}
+
+		obj, err := abs.objMng.CreateBlobObject(header, sectionJSON)
+		if err != nil {
+			multiSecObj.AddSectionObjectWithError(i, err)
+			continue
+		}
+
+		objInfo, err := abs.objMng.GetObjectInfo(obj)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] failed to get the object info", err)
+		}
+
+		multiSecObj.AddSectionObjectWithParams(obj, objInfo.GetType(), objName, codeID, codeType, lang, langVersion, section.langType, i)
+	}
+
+	return multiSecObj, nil
+}
+
+// CreatePolicyContentBytes creates a multi policy content bytes.
+func (abs *RBACYAMLLanguageAbstraction) CreatePolicyContentBytes(blocks [][]byte) ([]byte, string, error) {
+	merged := &azrbac.Document{}
+	for _, block := range blocks {
+		doc, err := azrbac.ParseDocument(block)
+		if err != nil {
+			return nil, "", err
+		}
+		merged.Roles = append(merged.Roles, doc.Roles...)
+		merged.Bindings = append(merged.Bindings, doc.Bindings...)
+	}
+	content, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] failed to create the policy content", err)
+	}
+	return content, LanguageFileExtension, nil
+}
+
+// CreateSchemaBlobObjects creates multi sections schema blob objects.
+func (abs *RBACYAMLLanguageAbstraction) CreateSchemaBlobObjects(path string, data []byte) (*azobjs.MultiSectionsObject, error) {
+	const (
+		objName = azauthzlangtypes.ClassTypeSchema
+
+		codeID     = azauthzlangtypes.ClassTypeSchema
+		codeType   = azauthzlangtypes.ClassTypeSchema
+		codeTypeID = azauthzlangtypes.ClassTypeSchemaID
+	)
+
+	langSpec := abs.GetLanguageSpecification()
+	lang := langSpec.GetBackendLanguage()
+	langID := langSpec.GetBackendLanguageID()
+	langVersion := langSpec.GetLanguageVersion()
+	langVersionID := langSpec.GetLanguageVersionID()
+
+	multiSecObj, err := azobjs.NewMultiSectionsObject(path, 1, nil)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] failed to create the multi section object", err)
+	}
+	if _, err := azrbac.ParseSchema(data); err != nil {
+		multiSecObj.AddSectionObjectWithError(0, err)
+		return multiSecObj, nil
+	}
+	header, err := azobjs.NewObjectHeader(true, langID, langVersionID, LanguageSchemaTypeID, codeID, codeTypeID)
+	if err != nil {
+		multiSecObj.AddSectionObjectWithError(0, err)
+		return multiSecObj, nil
+	}
+
+	obj, err := abs.objMng.CreateBlobObject(header, data)
+	if err != nil {
+		multiSecObj.AddSectionObjectWithError(0, err)
+		return multiSecObj, nil
+	}
+
+	objInfo, err := abs.objMng.GetObjectInfo(obj)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] failed to get the object info", err)
+	}
+
+	multiSecObj.AddSectionObjectWithParams(obj, objInfo.GetType(), objName, codeID, codeType, lang, langVersion, LanguageSchemaType, 0)
+	return multiSecObj, nil
+}
+
+// CreateSchemaContentBytes creates a schema content bytes.
+func (abs *RBACYAMLLanguageAbstraction) CreateSchemaContentBytes(blocks []byte) ([]byte, string, error) {
+	if len(blocks) == 0 {
+		return nil, "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] schema cannot be empty")
+	}
+	return blocks, LanguageSchemaFileName, nil
+}
+
+// ConvertBytesToFrontendLanguage converts bytes to the frontend language.
+func (abs *RBACYAMLLanguageAbstraction) ConvertBytesToFrontendLanguage(langID, langVersionID, langTypeID uint32, content []byte) ([]byte, error) {
+	langSpec := abs.GetLanguageSpecification()
+	if langSpec.GetBackendLanguageID() != langID {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] invalid backend language")
+	}
+	if langSpec.GetLanguageVersionID() != langVersionID {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] invalid backend language version")
+	}
+	doc := &azrbac.Document{}
+	switch langTypeID {
+	case LanguageRoleTypeID:
+		var role azrbac.Role
+		if err := json.Unmarshal(content, &role); err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] invalid role syntax", err)
+		}
+		doc.Roles = []azrbac.Role{role}
+	case LanguageBindingTypeID:
+		var binding azrbac.Binding
+		if err := json.Unmarshal(content, &binding); err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] invalid binding syntax", err)
+		}
+		doc.Bindings = []azrbac.Binding{binding}
+	case LanguageSchemaTypeID:
+		return content, nil
+	default:
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] invalid syntax")
+	}
+	frontendContent, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] failed to convert to the frontend language", err)
+	}
+	return frontendContent, nil
+}
+
+// createPolicySet creates the rbac policy set out of the policy store.
+func createPolicySet(policyStore *azauthzen.PolicyStore) (*azrbac.PolicySet, error) {
+	policySet := azrbac.NewPolicySet()
+	for _, schema := range policyStore.GetSchemas() {
+		schemaBytes, _ := schema.GetObjectInfo().GetInstance().([]byte)
+		rbacSchema, err := azrbac.ParseSchema(schemaBytes)
+		if err != nil {
+			return nil, err
+		}
+		policySet.SetSchema(rbacSchema)
+	}
+	for _, policy := range policyStore.GetPolicies() {
+		objInfo := policy.GetObjectInfo()
+		policyBytes, _ := objInfo.GetInstance().([]byte)
+		switch objInfo.GetHeader().GetLanguageTypeID() {
+		case LanguageRoleTypeID:
+			var role azrbac.Role
+			if err := json.Unmarshal(policyBytes, &role); err != nil {
+				return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] role could not be unmarshalled", err)
+			}
+			if err := policySet.AddRole(&role); err != nil {
+				return nil, err
+			}
+		case LanguageBindingTypeID:
+			var binding azrbac.Binding
+			if err := json.Unmarshal(policyBytes, &binding); err != nil {
+				return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] binding could not be unmarshalled", err)
+			}
+			policySet.AddBinding(&binding)
+		default:
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageSyntax, "[rbac-yaml] invalid policy type")
+		}
+	}
+	return policySet, nil
+}
+
+// createRequest creates the rbac request out of the authorization context.
+func createRequest(authzCtx *azauthzen.AuthorizationModel) (*azrbac.Request, error) {
+	subject := authzCtx.GetSubject()
+	if len(strings.TrimSpace(subject.GetID())) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[rbac-yaml] bad request for the subject id")
+	}
+	resource := authzCtx.GetResource()
+	if len(strings.TrimSpace(resource.GetType())) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[rbac-yaml] bad request for the resource type")
+	}
+	action := authzCtx.GetAction()
+	if len(strings.TrimSpace(action.GetID())) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "[rbac-yaml] bad request for the action")
+	}
+	subjectType := subject.GetType()
+	if subjectType == "" {
+		subjectType = azrbac.DefaultSubjectType
+	}
+	return &azrbac.Request{
+		SubjectType:        subjectType,
+		SubjectID:          subject.GetID(),
+		SubjectSource:      subject.GetSource(),
+		SubjectProperties:  subject.GetProperties(),
+		ResourceType:       resource.GetType(),
+		ResourceID:         resource.GetID(),
+		ResourceProperties: resource.GetProperties(),
+		Action:             action.GetID(),
+		ActionProperties:   action.GetProperties(),
+		Context:            authzCtx.GetContext(),
+	}, nil
+}
+
+// AuthorizationCheck checks the authorization.
+func (abs *RBACYAMLLanguageAbstraction) AuthorizationCheck(contextID string, policyStore *azauthzen.PolicyStore, authzCtx *azauthzen.AuthorizationModel) (*azauthzen.AuthorizationDecision, error) {
+	// Creates a new policy set.
+	policySet, err := createPolicySet(policyStore)
+	if err != nil {
+		return nil, err
+	}
+
+	// Creates the request.
+	req, err := createRequest(authzCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := policySet.IsAuthorized(req)
+	if err != nil {
+		return nil, err
+	}
+	var adminError, userError *azauthzen.AuthorizationError
+	if !ok {
+		adminError, _ = azauthzen.NewAuthorizationError(azauthzen.AuthzErrForbiddenCode, azauthzen.AuthzErrForbiddenMessage)
+		userError, _ = azauthzen.NewAuthorizationError(azauthzen.AuthzErrForbiddenCode, azauthzen.AuthzErrForbiddenMessage)
+	}
+	// Take the decision.
+	authzDecision, err := azauthzen.NewAuthorizationDecision(contextID, ok, adminError, userError)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, fmt.Sprintf("[rbac-yaml] failed to create the authorization decision for %s", contextID), err)
+	}
+	return authzDecision, nil
+}
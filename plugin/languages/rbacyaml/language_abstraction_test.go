@@ -0,0 +1,98 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rbacyaml
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azrbac "github.com/permguard/permguard/plugin/languages/rbacyaml/internal/rbac"
+)
+
+// TestLanguageSpecification tests the language specification.
+func TestLanguageSpecification(t *testing.T) {
+	assert := assert.New(t)
+
+	langAbs, err := NewRBACYAMLLanguageAbstraction()
+	assert.Nil(err, "NewRBACYAMLLanguageAbstraction should not return an error")
+
+	langSpec := langAbs.GetLanguageSpecification()
+	assert.NotNil(langSpec, "LanguageSpecification should not be nil")
+	assert.Equal(LanguageName, langSpec.GetLanguage(), "Language mismatch")
+	assert.Equal(LanguageRBACYAML, langSpec.GetFrontendLanguage(), "FrontendLanguage mismatch")
+	assert.Equal(LanguageRBACJSONID, langSpec.GetBackendLanguageID(), "BackendLanguageID mismatch")
+	assert.ElementsMatch([]string{".yaml", ".yml"}, langSpec.GetSupportedPolicyFileExtensions(), "SupportedPolicyFileExtensions mismatch")
+	assert.ElementsMatch([]string{"schema.yaml"}, langSpec.GetSupportedSchemaFileNames(), "SupportedSchemaFileNames mismatch")
+}
+
+// TestCreatePolicyContentBytes tests the merge of the policy blocks.
+func TestCreatePolicyContentBytes(t *testing.T) {
+	assert := assert.New(t)
+
+	langAbs, err := NewRBACYAMLLanguageAbstraction()
+	assert.Nil(err, "NewRBACYAMLLanguageAbstraction should not return an error")
+
+	roleBlock := []byte("roles:\n  - name: viewer\n    permissions:\n      - resource: document\n        actions: [read]\n")
+	bindingBlock := []byte("bindings:\n  - name: viewer-binding\n    role: viewer\n    subjects:\n      - id: alice\n")
+	content, ext, err := langAbs.CreatePolicyContentBytes([][]byte{roleBlock, bindingBlock})
+	assert.Nil(err, "CreatePolicyContentBytes should not return an error")
+	assert.Equal(LanguageFileExtension, ext, "Extension mismatch")
+
+	doc, err := azrbac.ParseDocument(content)
+	assert.Nil(err, "ParseDocument should not return an error")
+	assert.Len(doc.Roles, 1, "Roles count mismatch")
+	assert.Len(doc.Bindings, 1, "Bindings count mismatch")
+	assert.Equal("viewer", doc.Bindings[0].Role, "Binding role mismatch")
+
+	_, _, err = langAbs.CreatePolicyContentBytes([][]byte{[]byte("roles: [")})
+	assert.NotNil(err, "CreatePolicyContentBytes should return an error for an invalid block")
+}
+
+// TestConvertBytesToFrontendLanguage tests the conversion from the backend to the frontend language.
+func TestConvertBytesToFrontendLanguage(t *testing.T) {
+	assert := assert.New(t)
+
+	langAbs, err := NewRBACYAMLLanguageAbstraction()
+	assert.Nil(err, "NewRBACYAMLLanguageAbstraction should not return an error")
+
+	role := azrbac.Role{
+		Name: "editor",
+		Permissions: []azrbac.Permission{
+			{Resource: "document", Actions: []string{"read", "write"}},
+		},
+	}
+	roleJSON, err := json.Marshal(role)
+	assert.Nil(err, "json.Marshal should not return an error")
+
+	content, err := langAbs.ConvertBytesToFrontendLanguage(LanguageRBACJSONID, LanguageSyntaxVersionID, LanguageRoleTypeID, roleJSON)
+	assert.Nil(err, "ConvertBytesToFrontendLanguage should not return an error")
+	doc, err := azrbac.ParseDocument(content)
+	assert.Nil(err, "ParseDocument should not return an error")
+	assert.Len(doc.Roles, 1, "Roles count mismatch")
+	assert.Equal(role.Name, doc.Roles[0].Name, "Role name mismatch")
+	assert.Equal(role.Permissions[0].Actions, doc.Roles[0].Permissions[0].Actions, "Role actions mismatch")
+
+	schema := []byte("resources:\n  - type: document\n    actions: [read, write]\n")
+	content, err = langAbs.ConvertBytesToFrontendLanguage(LanguageRBACJSONID, LanguageSyntaxVersionID, LanguageSchemaTypeID, schema)
+	assert.Nil(err, "ConvertBytesToFrontendLanguage should not return an error")
+	assert.Equal(schema, content, "Schema content mismatch")
+
+	_, err = langAbs.ConvertBytesToFrontendLanguage(LanguageRBACYAMLID, LanguageSyntaxVersionID, LanguageRoleTypeID, roleJSON)
+	assert.NotNil(err, "ConvertBytesToFrontendLanguage should return an error for an invalid backend language")
+}
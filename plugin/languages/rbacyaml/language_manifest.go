@@ -0,0 +1,140 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rbacyaml
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	azztasmanifests "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/manifests"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+const (
+	// ManifestTemplateDefault is the default manifest template.
+	ManifestTemplateDefault = "default"
+	// manifestRootPartition is the root partition of the manifest.
+	manifestRootPartition = "/"
+)
+
+// manifestRuntimeName returns the name of the runtime of the language.
+func manifestRuntimeName() string {
+	return fmt.Sprintf("%s%s+", LanguageName, LanguageSyntaxVersion)
+}
+
+// convertManifestToMap converts the manifest to a generic map.
+func convertManifestToMap(manifest *azztasmanifests.Manifest) (map[string]any, error) {
+	data, err := azztasmanifests.ConvertManifestToBytes(manifest, false)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] failed to convert the manifest", err)
+	}
+	manifestMap := map[string]any{}
+	if err := json.Unmarshal(data, &manifestMap); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] failed to convert the manifest", err)
+	}
+	return manifestMap, nil
+}
+
+// getManifestSection returns the section of the manifest, creating it when missing.
+func getManifestSection(manifestMap map[string]any, name string) map[string]any {
+	section, ok := manifestMap[name].(map[string]any)
+	if !ok {
+		section = map[string]any{}
+		manifestMap[name] = section
+	}
+	return section
+}
+
+// buildManifest adds the runtime and the root partition of the language to the manifest.
+func buildManifest(manifest *azztasmanifests.Manifest, template, engineName, engineVersion, engineDist string) (*azztasmanifests.Manifest, error) {
+	if manifest == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] manifest is nil")
+	}
+	if template != "" && template != ManifestTemplateDefault {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, fmt.Sprintf("[rbac-yaml] %s is an invalid template", template))
+	}
+	manifestMap, err := convertManifestToMap(manifest)
+	if err != nil {
+		return nil, err
+	}
+	runtimeName := manifestRuntimeName()
+	runtimes := getManifestSection(manifestMap, "runtimes")
+	runtimes[runtimeName] = map[string]any{
+		"language": map[string]any{
+			"name":    LanguageName,
+			"version": LanguageSyntaxVersion + "+",
+		},
+		"engine": map[string]any{
+			"name":         engineName,
+			"version":      engineVersion,
+			"distribution": engineDist,
+		},
+	}
+	partitions := getManifestSection(manifestMap, "partitions")
+	if _, exists := partitions[manifestRootPartition]; !exists {
+		partitions[manifestRootPartition] = map[string]any{
+			"runtime": runtimeName,
+			"schema":  false,
+		}
+	}
+	data, err := json.Marshal(manifestMap)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] failed to build the manifest", err)
+	}
+	return azztasmanifests.ConvertBytesToManifest(data)
+}
+
+// validateManifest validates that the manifest declares a runtime of the language used by the partitions.
+func validateManifest(manifest *azztasmanifests.Manifest) (bool, error) {
+	if manifest == nil {
+		return false, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] manifest is nil")
+	}
+	if ok, err := azztasmanifests.ValidateManifest(manifest); !ok || err != nil {
+		return false, err
+	}
+	manifestMap, err := convertManifestToMap(manifest)
+	if err != nil {
+		return false, err
+	}
+	runtimes := getManifestSection(manifestMap, "runtimes")
+	languageRuntimes := map[string]struct{}{}
+	for runtimeName, runtime := range runtimes {
+		runtimeMap, _ := runtime.(map[string]any)
+		language, _ := runtimeMap["language"].(map[string]any)
+		if language["name"] != LanguageName {
+			continue
+		}
+		version, _ := language["version"].(string)
+		if strings.TrimSuffix(version, "+") != LanguageSyntaxVersion {
+			return false, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, fmt.Sprintf("[rbac-yaml] %s is an unsupported language version", version))
+		}
+		languageRuntimes[runtimeName] = struct{}{}
+	}
+	if len(languageRuntimes) == 0 {
+		return false, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] manifest has no runtime for the language")
+	}
+	partitions := getManifestSection(manifestMap, "partitions")
+	for _, partition := range partitions {
+		partitionMap, _ := partition.(map[string]any)
+		runtimeName, _ := partitionMap["runtime"].(string)
+		if _, ok := languageRuntimes[runtimeName]; ok {
+			return true, nil
+		}
+	}
+	return false, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "[rbac-yaml] manifest has no partition using the language runtime")
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rbacyaml
+
+const (
+	// LanguageName is the name of the language.
+	LanguageName = "rbac-yaml"
+	// LanguageSyntaxVersion is the version of the language syntax.
+	LanguageSyntaxVersion = "0.0"
+	// LanguageSyntaxVersionID is the id of the version of the language syntax.
+	LanguageSyntaxVersionID = uint32(0)
+	// LanguageRBACYAML is the name of the frontend language.
+	LanguageRBACYAML = "rbac-yaml"
+	// LanguageRBACYAMLID is the id of the frontend language.
+	LanguageRBACYAMLID = uint32(101)
+	// LanguageRBACJSON is the name of the backend language.
+	LanguageRBACJSON = "rbac-json"
+	// LanguageRBACJSONID is the id of the backend language.
+	LanguageRBACJSONID = uint32(102)
+	// LanguageFileExtension is the extension of the policy files.
+	LanguageFileExtension = ".yaml"
+	// LanguageFileExtensionShort is the short extension of the policy files.
+	LanguageFileExtensionShort = ".yml"
+	// LanguageSchemaFileName is the name of the schema file.
+	LanguageSchemaFileName = "schema.yaml"
+	// LanguageRoleType is the language type of the roles.
+	LanguageRoleType = "role"
+	// LanguageRoleTypeID is the id of the language type of the roles.
+	LanguageRoleTypeID = uint32(1)
+	// LanguageSchemaType is the language type of the schema.
+	LanguageSchemaType = "schema"
+	// LanguageSchemaTypeID is the id of the language type of the schema.
+	LanguageSchemaTypeID = uint32(2)
+	// LanguageBindingType is the language type of the role bindings.
+	LanguageBindingType = "binding"
+	// LanguageBindingTypeID is the id of the language type of the role bindings.
+	LanguageBindingTypeID = uint32(3)
+)
+
+// RBACYAMLLanguageSpecification is the specification for the yaml rbac language.
+type RBACYAMLLanguageSpecification struct {
+	language                      string
+	languageVersion               string
+	languageVersionID             uint32
+	frontendLanguage              string
+	frontendLanguageID            uint32
+	backendLanguage               string
+	backendLanguageID             uint32
+	supportedPolicyFileExtensions []string
+	supportedSchemaFileNames      []string
+}
+
+// GetLanguage returns the name of the language.
+func (ls *RBACYAMLLanguageSpecification) GetLanguage() string {
+	return ls.language
+}
+
+// GetLanguageVersion returns the language version.
+func (ls *RBACYAMLLanguageSpecification) GetLanguageVersion() string {
+	return ls.languageVersion
+}
+
+// GetLanguageVersionID returns the language version ID.
+func (ls *RBACYAMLLanguageSpecification) GetLanguageVersionID() uint32 {
+	return ls.languageVersionID
+}
+
+// GetFrontendLanguage returns the name of the language.
+func (ls *RBACYAMLLanguageSpecification) GetFrontendLanguage() string {
+	return ls.frontendLanguage
+}
+
+// GetFrontendLanguageID returns the id of the language.
+func (ls *RBACYAMLLanguageSpecification) GetFrontendLanguageID() uint32 {
+	return ls.frontendLanguageID
+}
+
+// GetBackendLanguage returns the name of the backend language.
+func (ls *RBACYAMLLanguageSpecification) GetBackendLanguage() string {
+	return ls.backendLanguage
+}
+
+// GetBackendLanguageID returns the id of the backend language.
+func (ls *RBACYAMLLanguageSpecification) GetBackendLanguageID() uint32 {
+	return ls.backendLanguageID
+}
+
+// GetSupportedPolicyFileExtensions returns the list of supported policy file extensions.
+func (ls *RBACYAMLLanguageSpecification) GetSupportedPolicyFileExtensions() []string {
+	return ls.supportedPolicyFileExtensions
+}
+
+// GetSupportedSchemaFileNames returns the list of supported schema file names.
+func (ls *RBACYAMLLanguageSpecification) GetSupportedSchemaFileNames() []string {
+	return ls.supportedSchemaFileNames
+}
@@ -56,8 +56,9 @@ func (c *SQLiteStorageFactoryConfig) InitFromViper(v *viper.Viper) error {
 
 // SQLiteStorageFactory holds the configuration for the server factory.
 type SQLiteStorageFactory struct {
-	config          *SQLiteStorageFactoryConfig
-	sqliteConnector azidb.SQLiteConnector
+	config           *SQLiteStorageFactoryConfig
+	sqliteConnector  azidb.SQLiteConnector
+	migrationVersion int64
 }
 
 // NewSQLiteStorageFactory creates a new server factory configuration.
@@ -69,13 +70,18 @@ func NewSQLiteStorageFactory(storageFctyCfg *SQLiteStorageFactoryConfig) (*SQLit
 	if err != nil {
 		return nil, err
 	}
+	migrationVersion, err := latestMigrationVersion()
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrConfigurationGeneric, "cannot read the sqlite migrations", err)
+	}
 	return &SQLiteStorageFactory{
-		config:          storageFctyCfg,
-		sqliteConnector: connection,
+		config:           storageFctyCfg,
+		sqliteConnector:  connection,
+		migrationVersion: migrationVersion,
 	}, nil
 }
 
 // CreateCentralStorage returns the central storage.
 func (f *SQLiteStorageFactory) CreateCentralStorage(storageContext *azstorage.StorageContext) (azstorage.CentralStorage, error) {
-	return azicentralstorage.NewSQLiteCentralStorage(storageContext, f.sqliteConnector)
+	return azicentralstorage.NewSQLiteCentralStorage(storageContext, f.sqliteConnector, f.migrationVersion)
 }
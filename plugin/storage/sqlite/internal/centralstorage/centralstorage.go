@@ -17,11 +17,14 @@
 package centralstorage
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
 	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
 	azidb "github.com/permguard/permguard/plugin/storage/sqlite/internal/extensions/db"
 )
@@ -32,42 +35,111 @@ type SqliteRepo interface {
 	// DeleteZone deletes a zone.
 	DeleteZone(tx *sql.Tx, zoneID int64) (*azirepos.Zone, error)
 	// FetchZone fetches a zone.
-	FetchZones(db *sqlx.DB, page int32, pageSize int32, filterID *int64, filterName *string) ([]azirepos.Zone, error)
+	FetchZones(db *sqlx.DB, page int32, pageSize int32, filterID *int64, filterName *string, options *azirepos.FetchOptions) ([]azirepos.Zone, error)
 
 	// UpsertIdentitySource creates or updates an identity source.
 	UpsertIdentitySource(tx *sql.Tx, isCreate bool, identitySource *azirepos.IdentitySource) (*azirepos.IdentitySource, error)
 	// DeleteIdentitySource deletes an identity source.
 	DeleteIdentitySource(tx *sql.Tx, zoneID int64, identitySourceID string) (*azirepos.IdentitySource, error)
 	// FetchIdentitySources fetches identity sources.
-	FetchIdentitySources(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.IdentitySource, error)
+	FetchIdentitySources(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, options *azirepos.FetchOptions) ([]azirepos.IdentitySource, error)
 
 	// UpsertIdentity creates or updates an identity.
 	UpsertIdentity(tx *sql.Tx, isCreate bool, identity *azirepos.Identity) (*azirepos.Identity, error)
+	// ReadIdentityByName reads an identity by name.
+	ReadIdentityByName(tx *sql.Tx, zoneID int64, name string) (*azirepos.Identity, error)
 	// DeleteIdentity deletes an identity.
 	DeleteIdentity(tx *sql.Tx, zoneID int64, identityID string) (*azirepos.Identity, error)
 	// FetchIdentities fetches identities.
-	FetchIdentities(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.Identity, error)
+	FetchIdentities(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, filterIdentitySourceID *string, filterKind *int16, options *azirepos.FetchOptions) ([]azirepos.Identity, error)
+	// CountIdentities counts the identities matching the filters.
+	CountIdentities(db *sqlx.DB, zoneID int64, filterID *string, filterName *string, filterIdentitySourceID *string, filterKind *int16, options *azirepos.FetchOptions) (int64, error)
 
 	// UpsertTenant creates or updates an tenant.
 	UpsertTenant(tx *sql.Tx, isCreate bool, tenant *azirepos.Tenant) (*azirepos.Tenant, error)
+	// ReadTenantByName reads a tenant by name.
+	ReadTenantByName(tx *sql.Tx, zoneID int64, name string) (*azirepos.Tenant, error)
 	// DeleteTenant deletes an tenant.
 	DeleteTenant(tx *sql.Tx, zoneID int64, tenantID string) (*azirepos.Tenant, error)
 	// FetchTenant fetches an tenant.
-	FetchTenants(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.Tenant, error)
+	FetchTenants(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, options *azirepos.FetchOptions) ([]azirepos.Tenant, error)
 
 	// UpsertLedger creates or updates a ledger.
 	UpsertLedger(tx *sql.Tx, isCreate bool, ledger *azirepos.Ledger) (*azirepos.Ledger, error)
 	// DeleteLedger deletes a ledger.
 	DeleteLedger(tx *sql.Tx, zoneID int64, ledgerID string) (*azirepos.Ledger, error)
 	// FetchLedgers fetches ledgers.
-	FetchLedgers(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.Ledger, error)
+	FetchLedgers(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, options *azirepos.FetchOptions) ([]azirepos.Ledger, error)
 	// UpdateLedgerRef updates the ledger ref.
 	UpdateLedgerRef(tx *sql.Tx, zoneID int64, ledgerID, currentRef, newRef string) error
+	// UpdateLedgerCandidateRef updates the ledger candidate ref.
+	UpdateLedgerCandidateRef(tx *sql.Tx, zoneID int64, ledgerID, candidateRef string) (*azirepos.Ledger, error)
+	// PromoteLedgerCandidateRef promotes the ledger candidate ref to the ledger ref.
+	PromoteLedgerCandidateRef(tx *sql.Tx, zoneID int64, ledgerID string) (*azirepos.Ledger, error)
+	// UpdateLedgerRequiredApprovals updates the ledger required approvals.
+	UpdateLedgerRequiredApprovals(tx *sql.Tx, zoneID int64, ledgerID string, requiredApprovals int32) (*azirepos.Ledger, error)
+
+	// UpsertEntity creates or updates an entity.
+	UpsertEntity(tx *sql.Tx, isCreate bool, entity *azirepos.Entity) (*azirepos.Entity, error)
+	// UpsertEntities creates or replaces a set of entities.
+	UpsertEntities(tx *sql.Tx, entities []azirepos.Entity) ([]azirepos.Entity, error)
+	// DeleteEntity deletes an entity.
+	DeleteEntity(tx *sql.Tx, zoneID int64, entityType, entityID string) (*azirepos.Entity, error)
+	// FetchEntities fetches entities.
+	FetchEntities(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterType *string, filterID *string) ([]azirepos.Entity, error)
+	// FetchEntitiesByUIDs fetches the entities matching the uids.
+	FetchEntitiesByUIDs(db *sqlx.DB, zoneID int64, uids []azirepos.EntityUID) ([]azirepos.Entity, error)
+
+	// CreateTemplateLink creates a template link.
+	CreateTemplateLink(tx *sql.Tx, link *azirepos.TemplateLink) (*azirepos.TemplateLink, error)
+	// DeleteTemplateLink deletes a template link.
+	DeleteTemplateLink(tx *sql.Tx, zoneID int64, ledgerID string, linkID string) (*azirepos.TemplateLink, error)
+	// FetchTemplateLinks fetches the template links of a ledger.
+	FetchTemplateLinks(db *sqlx.DB, page int32, pageSize int32, zoneID int64, ledgerID string, filterTemplateID *string, filterLinkID *string) ([]azirepos.TemplateLink, error)
+	// FetchAllTemplateLinks fetches all the template links of a ledger.
+	FetchAllTemplateLinks(db *sqlx.DB, zoneID int64, ledgerID string) ([]azirepos.TemplateLink, error)
+	// FetchTemplateLinksVersion fetches the version of the template links of a ledger.
+	FetchTemplateLinksVersion(db *sqlx.DB, zoneID int64, ledgerID string) (string, error)
+
+	// CreateChangeRequest creates a change request.
+	CreateChangeRequest(tx *sql.Tx, changeRequest *azirepos.ChangeRequest) (*azirepos.ChangeRequest, error)
+	// ReadChangeRequest reads a change request.
+	ReadChangeRequest(tx *sql.Tx, zoneID int64, changeRequestID string) (*azirepos.ChangeRequest, error)
+	// UpdateChangeRequestStatus updates the status of a change request.
+	UpdateChangeRequestStatus(tx *sql.Tx, zoneID int64, changeRequestID string, currentStatus string, newStatus string) (*azirepos.ChangeRequest, error)
+	// CreateChangeRequestReview creates a change request review.
+	CreateChangeRequestReview(tx *sql.Tx, review *azirepos.ChangeRequestReview) (*azirepos.ChangeRequestReview, error)
+	// CountChangeRequestApprovals counts the approvals of a change request.
+	CountChangeRequestApprovals(tx *sql.Tx, zoneID int64, changeRequestID string) (int32, error)
+	// FetchChangeRequests fetches the change requests of a ledger.
+	FetchChangeRequests(db *sqlx.DB, page int32, pageSize int32, zoneID int64, ledgerID string, filterID *string, filterStatus *string) ([]azirepos.ChangeRequest, error)
+	// FetchChangeRequestReviews fetches the reviews of a change request.
+	FetchChangeRequestReviews(db *sqlx.DB, zoneID int64, changeRequestID string) ([]azirepos.ChangeRequestReview, error)
+
+	// UpsertWebhook creates or updates a webhook.
+	UpsertWebhook(tx *sql.Tx, isCreate bool, webhook *azirepos.Webhook) (*azirepos.Webhook, error)
+	// DeleteWebhook deletes a webhook.
+	DeleteWebhook(tx *sql.Tx, zoneID int64, webhookID string) (*azirepos.Webhook, error)
+	// FetchWebhooks fetches webhooks.
+	FetchWebhooks(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.Webhook, error)
+	// FetchWebhookDeliveries fetches webhook deliveries.
+	FetchWebhookDeliveries(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterWebhookID *string, filterStatus *string) ([]azirepos.WebhookDelivery, error)
+	// FetchDueWebhookDeliveries fetches the pending webhook deliveries which are due.
+	FetchDueWebhookDeliveries(db *sqlx.DB, limit int32) ([]azirepos.WebhookDispatch, error)
+	// RecordWebhookDeliveryAttempt records the outcome of a webhook delivery attempt.
+	RecordWebhookDeliveryAttempt(tx *sql.Tx, deliveryID int64, status string, statusCode int32, lastError string, retryDelaySeconds int64) (*azirepos.WebhookDelivery, error)
+	// RequeueWebhookDelivery requeues a dead webhook delivery.
+	RequeueWebhookDelivery(tx *sql.Tx, zoneID int64, deliveryID int64) (*azirepos.WebhookDelivery, error)
 
 	// UpsertKeyValue creates or updates a key value.
 	UpsertKeyValue(tx *sql.Tx, keyValue *azirepos.KeyValue) (*azirepos.KeyValue, error)
 	// DeleteKeyValue deletes a key value.
 	GetKeyValue(db *sqlx.DB, zoneID int64, key string) (*azirepos.KeyValue, error)
+	// ReadKeyValue reads a key value within a transaction.
+	ReadKeyValue(tx *sql.Tx, zoneID int64, key string) (*azirepos.KeyValue, error)
+
+	// GetMigrationVersion gets the version of the latest applied migration.
+	GetMigrationVersion(db *sqlx.DB) (int64, error)
 }
 
 // SqliteExecutor is the interface for executing sqlite commands.
@@ -92,18 +164,43 @@ func (s SqliteExec) Connect(ctx *azstorage.StorageContext, sqliteConnector azidb
 
 // SQLiteCentralStorage implements the sqlite central storage.
 type SQLiteCentralStorage struct {
-	ctx             *azstorage.StorageContext
-	sqliteConnector azidb.SQLiteConnector
+	ctx              *azstorage.StorageContext
+	sqliteConnector  azidb.SQLiteConnector
+	sqlRepo          SqliteRepo
+	sqlExec          SqliteExecutor
+	migrationVersion int64
 }
 
 // NewSQLiteCentralStorage creates a new sqlite central storage.
-func NewSQLiteCentralStorage(storageContext *azstorage.StorageContext, sqliteConnector azidb.SQLiteConnector) (*SQLiteCentralStorage, error) {
+func NewSQLiteCentralStorage(storageContext *azstorage.StorageContext, sqliteConnector azidb.SQLiteConnector, migrationVersion int64) (*SQLiteCentralStorage, error) {
 	return &SQLiteCentralStorage{
-		ctx:             storageContext,
-		sqliteConnector: sqliteConnector,
+		ctx:              storageContext,
+		sqliteConnector:  sqliteConnector,
+		sqlRepo:          &azirepos.Repository{},
+		sqlExec:          &SqliteExec{},
+		migrationVersion: migrationVersion,
 	}, nil
 }
 
+// CheckHealth checks the database is reachable and migrated to the expected version.
+func (s SQLiteCentralStorage) CheckHealth(ctx context.Context) error {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return err
+	}
+	if err := db.PingContext(ctx); err != nil {
+		return azirepos.WrapSqlite3Error("cannot ping sqlite", err)
+	}
+	version, err := s.sqlRepo.GetMigrationVersion(db)
+	if err != nil {
+		return err
+	}
+	if version < s.migrationVersion {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrStorageGeneric, fmt.Sprintf("sqlite migration version %d is behind the expected version %d", version, s.migrationVersion))
+	}
+	return nil
+}
+
 // GetZAPCentralStorage returns the ZAP central storage.
 func (s SQLiteCentralStorage) GetZAPCentralStorage() (azstorage.ZAPCentralStorage, error) {
 	return newSQLiteZAPCentralStorage(s.ctx, s.sqliteConnector, nil, nil)
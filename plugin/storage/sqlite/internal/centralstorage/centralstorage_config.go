@@ -18,6 +18,8 @@ package centralstorage
 
 import (
 	"fmt"
+	"math"
+	"runtime"
 
 	azruntime "github.com/permguard/permguard/pkg/agents/runtime"
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
@@ -32,6 +34,8 @@ const (
 	maxPageSizeKey = "data-fetch-maxpagesize"
 	// maxPageSizeDefault is the default value for the maximum number of items to fetch per request.
 	maxPageSizeDefault = 10000
+	// evaluationWorkersKey is the key for the maximum number of evaluations to be processed concurrently.
+	evaluationWorkersKey = "evaluation-workers"
 )
 
 // SQLiteCentralStorageConfig is the SQLite central storage configuration.
@@ -57,14 +61,33 @@ func NewSQLiteCentralStorageConfig(ctx *azstorage.StorageContext) (*SQLiteCentra
 func (c *SQLiteCentralStorageConfig) GetDataFetchMaxPageSize() int32 {
 	maxSize, err := c.configReader.GetValue(maxPageSizeKey)
 	if err != nil {
-		return 10000
+		return maxPageSizeDefault
 	}
-	if intValue, ok := maxSize.(int32); ok {
-		return intValue
+	switch value := maxSize.(type) {
+	case int:
+		if value > 0 && value <= math.MaxInt32 {
+			return int32(value)
+		}
+	case int32:
+		if value > 0 {
+			return value
+		}
 	}
 	return maxPageSizeDefault
 }
 
+// GetEvaluationWorkers returns the maximum number of evaluations to be processed concurrently.
+func (c *SQLiteCentralStorageConfig) GetEvaluationWorkers() int {
+	workers, err := c.configReader.GetValue(evaluationWorkersKey)
+	if err != nil {
+		return runtime.NumCPU()
+	}
+	if intValue, ok := workers.(int); ok && intValue > 0 {
+		return intValue
+	}
+	return runtime.NumCPU()
+}
+
 // GetEnabledDefaultCreation returns the flag to enable the creation of default entities.
 func (c *SQLiteCentralStorageConfig) GetEnabledDefaultCreation() bool {
 	enableDefaultCreation, err := c.configReader.GetValue(enabledDefaultCreationKey)
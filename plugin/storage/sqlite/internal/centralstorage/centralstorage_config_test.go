@@ -0,0 +1,52 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azrtmmocks "github.com/permguard/permguard/pkg/agents/runtime/mocks"
+	azservices "github.com/permguard/permguard/pkg/agents/services"
+	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+)
+
+// TestGetDataFetchMaxPageSize tests the maximum page size read from the service configuration.
+func TestGetDataFetchMaxPageSize(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		values   map[string]any
+		expected int32
+	}{
+		{map[string]any{maxPageSizeKey: 500}, 500},
+		{map[string]any{maxPageSizeKey: int32(250)}, 250},
+		{map[string]any{maxPageSizeKey: 0}, maxPageSizeDefault},
+		{map[string]any{maxPageSizeKey: "500"}, maxPageSizeDefault},
+		{map[string]any{}, maxPageSizeDefault},
+	}
+	for _, test := range tests {
+		svcCfgReader, err := azservices.NewServiceConfiguration(test.values)
+		assert.Nil(err, "error should be nil")
+		storageCtx, err := azstorage.NewStorageContext(azrtmmocks.NewRuntimeContextMock(nil, svcCfgReader), azstorage.StorageSQLite)
+		assert.Nil(err, "error should be nil")
+		config, err := NewSQLiteCentralStorageConfig(storageCtx)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(test.expected, config.GetDataFetchMaxPageSize(), "max page size should match")
+	}
+}
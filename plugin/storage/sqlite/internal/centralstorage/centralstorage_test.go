@@ -17,6 +17,7 @@
 package centralstorage
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -78,7 +79,7 @@ func TestNewSQLiteCentralStorage(t *testing.T) {
 		mockStorageCtx, _ := azstorage.NewStorageContext(mockRuntimeCtx, azstorage.StorageSQLite)
 		mockConnector := azmocks.NewMockSQLiteConnector()
 
-		sqliteExec, err := NewSQLiteCentralStorage(mockStorageCtx, mockConnector)
+		sqliteExec, err := NewSQLiteCentralStorage(mockStorageCtx, mockConnector, 7)
 		assert.Nil(err)
 
 		zapcentralstorage, err := sqliteExec.GetZAPCentralStorage()
@@ -91,3 +92,44 @@ func TestNewSQLiteCentralStorage(t *testing.T) {
 	}
 
 }
+
+// TestSQLiteCentralStorageCheckHealth tests the health check of the sqlite central storage.
+func TestSQLiteCentralStorageCheckHealth(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		version     int64
+		versionErr  error
+		expectedErr bool
+	}{
+		{version: 7, versionErr: nil, expectedErr: false},
+		{version: 6, versionErr: nil, expectedErr: true},
+		{version: 0, versionErr: azerrors.ErrStorageGeneric, expectedErr: true},
+	}
+	for _, test := range tests {
+		mockRuntimeCtx := azrtmmocks.NewRuntimeContextMock(nil, nil)
+		mockStorageCtx, _ := azstorage.NewStorageContext(mockRuntimeCtx, azstorage.StorageSQLite)
+		mockConnector := azmocks.NewMockSQLiteConnector()
+		mockSQLRepo := azmocks.NewMockSqliteRepo()
+		mockSQLExec := azmocks.NewMockSqliteExecutor()
+
+		sqlDB, sqlDBMock, _ := sqlmock.New(sqlmock.MonitorPingsOption(true))
+		sqlxDB := sqlx.NewDb(sqlDB, "sqlite3")
+		sqlDBMock.ExpectPing()
+
+		mockSQLExec.On("Connect", mock.Anything, mock.Anything).Return(sqlxDB, nil)
+		mockSQLRepo.On("GetMigrationVersion", mock.Anything).Return(test.version, test.versionErr)
+
+		storage, _ := NewSQLiteCentralStorage(mockStorageCtx, mockConnector, 7)
+		storage.sqlRepo = mockSQLRepo
+		storage.sqlExec = mockSQLExec
+
+		err := storage.CheckHealth(context.Background())
+		if test.expectedErr {
+			assert.NotNil(err, "error should not be nil")
+		} else {
+			assert.Nil(err, "error should be nil")
+		}
+		sqlDB.Close()
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// fetchFieldString returns the string value of a fetch field.
+func fetchFieldString(fields map[string]any, key string) (string, bool, error) {
+	value, ok := fields[key]
+	if !ok {
+		return "", false, nil
+	}
+	text, ok := value.(string)
+	if !ok {
+		return "", false, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - %s is not valid", key))
+	}
+	return text, text != "", nil
+}
+
+// fetchFieldTime returns the time value of a fetch field.
+func fetchFieldTime(fields map[string]any, key string) (*time.Time, error) {
+	value, ok := fields[key]
+	if !ok {
+		return nil, nil
+	}
+	t, ok := value.(time.Time)
+	if !ok {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - %s is not valid", key))
+	}
+	return &t, nil
+}
+
+// buildFetchOptions builds the repository fetch options from the fetch fields, nil is returned if no option is set.
+func buildFetchOptions(fields map[string]any, isCodeID bool) (*azirepos.FetchOptions, error) {
+	cursor, hasCursor, err := fetchFieldString(fields, azmodels.FieldFetchCursor)
+	if err != nil {
+		return nil, err
+	}
+	sortBy, hasSortBy, err := fetchFieldString(fields, azmodels.FieldFetchSortBy)
+	if err != nil {
+		return nil, err
+	}
+	sortOrder, hasSortOrder, err := fetchFieldString(fields, azmodels.FieldFetchSortOrder)
+	if err != nil {
+		return nil, err
+	}
+	namePrefix, hasNamePrefix, err := fetchFieldString(fields, azmodels.FieldFetchNamePrefix)
+	if err != nil {
+		return nil, err
+	}
+	createdAfter, err := fetchFieldTime(fields, azmodels.FieldFetchCreatedAfter)
+	if err != nil {
+		return nil, err
+	}
+	createdBefore, err := fetchFieldTime(fields, azmodels.FieldFetchCreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	if !hasCursor && !hasSortBy && !hasSortOrder && !hasNamePrefix && createdAfter == nil && createdBefore == nil {
+		return nil, nil
+	}
+	query := &azmodels.FetchQuery{SortBy: sortBy, SortOrder: sortOrder}
+	sortBy, sortOrder = query.NormalizedSort()
+	if !azmodels.IsValidSortBy(sortBy) || !azmodels.IsValidSortOrder(sortOrder) {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - sort %s %s is not valid", sortBy, sortOrder))
+	}
+	options := &azirepos.FetchOptions{
+		SortBy:        sortBy,
+		SortDesc:      sortOrder == azmodels.SortOrderDesc,
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+	}
+	if hasNamePrefix {
+		options.NamePrefix = &namePrefix
+	}
+	if !hasCursor {
+		return options, nil
+	}
+	fetchCursor, err := azmodels.DecodeFetchCursor(cursor)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - cursor is not valid", err)
+	}
+	if fetchCursor.SortBy != sortBy || fetchCursor.SortOrder != sortOrder {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - cursor does not match the requested sort")
+	}
+	if isCodeID {
+		id, err := strconv.ParseInt(fetchCursor.ID, 10, 64)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - cursor is not valid", err)
+		}
+		options.AfterID = id
+	} else {
+		options.AfterID = fetchCursor.ID
+	}
+	switch sortBy {
+	case azmodels.SortByName:
+		options.AfterValue = fetchCursor.Value
+	case azmodels.SortByCreatedAt:
+		createdAt, err := time.Parse(time.RFC3339Nano, fetchCursor.Value)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - cursor is not valid", err)
+		}
+		options.AfterValue = createdAt
+	}
+	return options, nil
+}
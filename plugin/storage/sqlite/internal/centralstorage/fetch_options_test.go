@@ -0,0 +1,83 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodels "github.com/permguard/permguard/pkg/transport/models"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// TestBuildFetchOptionsWithoutFields tests that no options are built without fetch fields.
+func TestBuildFetchOptionsWithoutFields(t *testing.T) {
+	assert := assert.New(t)
+
+	options, err := buildFetchOptions(map[string]any{azmodels.FieldFetchCursor: ""}, false)
+	assert.Nil(err, "error should be nil")
+	assert.Nil(options, "options should be nil")
+}
+
+// TestBuildFetchOptionsWithCursor tests the options built from a cursor.
+func TestBuildFetchOptionsWithCursor(t *testing.T) {
+	assert := assert.New(t)
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	cursor, err := azmodels.NewFetchCursorForCodeID(azmodels.SortByCreatedAt, azmodels.SortOrderDesc, 581616507495, "rent-a-car", createdAt)
+	assert.Nil(err, "error should be nil")
+
+	fields := map[string]any{
+		azmodels.FieldFetchCursor:     cursor,
+		azmodels.FieldFetchSortBy:     azmodels.SortByCreatedAt,
+		azmodels.FieldFetchSortOrder:  azmodels.SortOrderDesc,
+		azmodels.FieldFetchNamePrefix: "rent",
+	}
+	options, err := buildFetchOptions(fields, true)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(azirepos.FetchSortByCreatedAt, options.SortBy, "sort by is not correct")
+	assert.True(options.SortDesc, "sort should be descending")
+	assert.Equal(int64(581616507495), options.AfterID, "after id is not correct")
+	assert.Equal(createdAt, options.AfterValue, "after value is not correct")
+	assert.Equal("rent", *options.NamePrefix, "name prefix is not correct")
+}
+
+// TestBuildFetchOptionsWithInvalidInput tests the options built from invalid fields.
+func TestBuildFetchOptionsWithInvalidInput(t *testing.T) {
+	assert := assert.New(t)
+
+	cursor, _ := azmodels.NewFetchCursor(azmodels.SortByName, azmodels.SortOrderAsc, "abc", "rent-a-car", time.Now())
+	tests := []map[string]any{
+		{azmodels.FieldFetchSortBy: "updated_at"},
+		{azmodels.FieldFetchSortOrder: "up"},
+		{azmodels.FieldFetchNamePrefix: 1},
+		{azmodels.FieldFetchCreatedAfter: "yesterday"},
+		{azmodels.FieldFetchCursor: "not-valid"},
+		{azmodels.FieldFetchCursor: cursor},
+		{azmodels.FieldFetchCursor: cursor, azmodels.FieldFetchSortBy: azmodels.SortByName},
+	}
+	for i, fields := range tests {
+		isCodeID := i == len(tests)-1
+		options, err := buildFetchOptions(fields, isCodeID)
+		assert.Nil(options, "options should be nil")
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+}
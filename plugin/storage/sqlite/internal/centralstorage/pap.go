@@ -18,6 +18,7 @@ package centralstorage
 
 import (
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
 	azidb "github.com/permguard/permguard/plugin/storage/sqlite/internal/extensions/db"
@@ -30,6 +31,7 @@ type SQLiteCentralStoragePAP struct {
 	sqlRepo         SqliteRepo
 	sqlExec         SqliteExecutor
 	config          *SQLiteCentralStorageConfig
+	languages       *azlang.LanguageRegistry
 }
 
 // newSQLitePAPCentralStorage creates a new SQLitePAPCentralStorage.
@@ -53,5 +55,6 @@ func newSQLitePAPCentralStorage(storageContext *azstorage.StorageContext, sqlite
 		sqlRepo:         ledger,
 		sqlExec:         sqlExec,
 		config:          config,
+		languages:       azlang.DefaultLanguageRegistry(),
 	}, nil
 }
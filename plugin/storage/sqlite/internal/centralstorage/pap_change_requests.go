@@ -0,0 +1,309 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+const (
+	// changeRequestPlanStateCreate is the plan state of a code object created by the change request.
+	changeRequestPlanStateCreate = "create"
+	// changeRequestPlanStateModify is the plan state of a code object modified by the change request.
+	changeRequestPlanStateModify = "modify"
+	// changeRequestPlanStateDelete is the plan state of a code object deleted by the change request.
+	changeRequestPlanStateDelete = "delete"
+)
+
+// readObjectInTx reads the object within a transaction, so that the objects written by the transaction are visible.
+func (s SQLiteCentralStoragePAP) readObjectInTx(tx *sql.Tx, zoneID int64, oid string) (*azobjs.Object, error) {
+	keyValue, err := s.sqlRepo.ReadKeyValue(tx, zoneID, oid)
+	if err != nil || keyValue == nil || keyValue.Value == nil {
+		return nil, nil
+	}
+	return azobjs.NewObject(keyValue.Value)
+}
+
+// readCommitInTx reads a commit within a transaction.
+func (s SQLiteCentralStoragePAP) readCommitInTx(tx *sql.Tx, objMng *azobjs.ObjectManager, zoneID int64, commitID string) (*azobjs.Commit, error) {
+	commitObj, err := s.readObjectInTx(tx, zoneID, commitID)
+	if err != nil || commitObj == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrStorageGeneric, fmt.Sprintf("commit %s couldn't be read", commitID))
+	}
+	return GetObjectForType[azobjs.Commit](objMng, commitObj)
+}
+
+// readZoneIdentityName reads the name of an identity of the zone, the role is used to describe the identity in the errors.
+func (s SQLiteCentralStoragePAP) readZoneIdentityName(tx *sql.Tx, zoneID int64, name string, role string) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUnauthenticated, fmt.Sprintf("invalid client input - the %s must be an authenticated caller", role))
+	}
+	dbIdentity, err := s.sqlRepo.ReadIdentityByName(tx, zoneID, name)
+	if err != nil {
+		if azerrors.AreErrorsEqual(azerrors.ErrClientNotFound, err) {
+			return "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - the %s %s is not an identity of the zone %d", role, name, zoneID), err)
+		}
+		return "", err
+	}
+	return dbIdentity.Name, nil
+}
+
+// readCommitTreeEntries reads the tree entries of a commit indexed by code id.
+func (s SQLiteCentralStoragePAP) readCommitTreeEntries(tx *sql.Tx, objMng *azobjs.ObjectManager, zoneID int64, commitID string) (map[string]azobjs.TreeEntry, error) {
+	entries := map[string]azobjs.TreeEntry{}
+	if commitID == azobjs.ZeroOID {
+		return entries, nil
+	}
+	commit, err := s.readCommitInTx(tx, objMng, zoneID, commitID)
+	if err != nil {
+		return nil, err
+	}
+	treeObj, err := s.readObjectInTx(tx, zoneID, commit.GetTree())
+	if err != nil || treeObj == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrStorageGeneric, fmt.Sprintf("tree %s couldn't be read", commit.GetTree()))
+	}
+	tree, err := GetObjectForType[azobjs.Tree](objMng, treeObj)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range tree.GetEntries() {
+		entries[entry.GetCodeID()] = entry
+	}
+	return entries, nil
+}
+
+// buildChangeRequestPlan builds the plan of the code objects changed moving the ledger from the base ref to the commit.
+func (s SQLiteCentralStoragePAP) buildChangeRequestPlan(tx *sql.Tx, zoneID int64, baseRef string, commitID string) ([]azmodelspap.ChangeRequestPlanItem, error) {
+	objMng, err := azobjs.NewObjectManager()
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "failed to create the object manager", err)
+	}
+	baseEntries, err := s.readCommitTreeEntries(tx, objMng, zoneID, baseRef)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.readCommitTreeEntries(tx, objMng, zoneID, commitID)
+	if err != nil {
+		return nil, err
+	}
+	plan := []azmodelspap.ChangeRequestPlanItem{}
+	for codeID, entry := range entries {
+		baseEntry, ok := baseEntries[codeID]
+		if ok && baseEntry.GetOID() == entry.GetOID() {
+			continue
+		}
+		state := changeRequestPlanStateCreate
+		if ok {
+			state = changeRequestPlanStateModify
+		}
+		plan = append(plan, azmodelspap.ChangeRequestPlanItem{CodeID: codeID, CodeType: entry.GetCodeType(), OID: entry.GetOID(), State: state})
+	}
+	for codeID, baseEntry := range baseEntries {
+		if _, ok := entries[codeID]; ok {
+			continue
+		}
+		plan = append(plan, azmodelspap.ChangeRequestPlanItem{CodeID: codeID, CodeType: baseEntry.GetCodeType(), OID: baseEntry.GetOID(), State: changeRequestPlanStateDelete})
+	}
+	sort.Slice(plan, func(i, j int) bool {
+		return plan[i].CodeID < plan[j].CodeID
+	})
+	return plan, nil
+}
+
+// createChangeRequest creates a pending change request for a commit pushed to a protected ledger instead of moving its ref,
+// the change request is created within the transaction writing the pushed objects and its author is the authenticated caller.
+func (s SQLiteCentralStoragePAP) createChangeRequest(tx *sql.Tx, ledger *azmodelspap.Ledger, commitID string, caller string) (*azirepos.ChangeRequest, error) {
+	objMng, err := azobjs.NewObjectManager()
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "failed to create the object manager", err)
+	}
+	commit, err := s.readCommitInTx(tx, objMng, ledger.ZoneID, commitID)
+	if err != nil {
+		return nil, err
+	}
+	author, err := s.readZoneIdentityName(tx, ledger.ZoneID, caller, "author")
+	if err != nil {
+		return nil, err
+	}
+	if commitAuthor := commit.GetMetaData().GetAuthor(); commitAuthor != "" && !strings.EqualFold(commitAuthor, author) {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - the commit author %s is not the authenticated caller %s", commitAuthor, author))
+	}
+	plan, err := s.buildChangeRequestPlan(tx, ledger.ZoneID, ledger.Ref, commitID)
+	if err != nil {
+		return nil, err
+	}
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "failed to serialize the change request plan", err)
+	}
+	return s.sqlRepo.CreateChangeRequest(tx, &azirepos.ChangeRequest{
+		ZoneID:            ledger.ZoneID,
+		LedgerID:          ledger.LedgerID,
+		BaseRef:           ledger.Ref,
+		CommitID:          commitID,
+		Author:            author,
+		Plan:              string(planJSON),
+		RequiredApprovals: ledger.RequiredApprovals,
+	})
+}
+
+// reviewChangeRequest records the review of a pending change request and moves the ledger ref once the required approvals are reached.
+func (s SQLiteCentralStoragePAP) reviewChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, decision string, comment string) (*azmodelspap.ChangeRequest, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbChangeRequest, err := s.sqlRepo.ReadChangeRequest(tx, zoneID, changeRequestID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if dbChangeRequest.LedgerID != ledgerID {
+		tx.Rollback()
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("invalid client input - change request %s is not found in the ledger %s", changeRequestID, ledgerID))
+	}
+	if dbChangeRequest.Status != azirepos.ChangeRequestStatusPending {
+		tx.Rollback()
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUpdateConflict, fmt.Sprintf("invalid client input - change request %s is %s", changeRequestID, dbChangeRequest.Status))
+	}
+	reviewer, err = s.readZoneIdentityName(tx, zoneID, reviewer, "reviewer")
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if reviewer == dbChangeRequest.Author {
+		tx.Rollback()
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - change request %s cannot be reviewed by its author %s", changeRequestID, reviewer))
+	}
+	_, err = s.sqlRepo.CreateChangeRequestReview(tx, &azirepos.ChangeRequestReview{
+		ZoneID:          zoneID,
+		ChangeRequestID: changeRequestID,
+		Reviewer:        reviewer,
+		Decision:        decision,
+		Comment:         comment,
+	})
+	if err != nil {
+		tx.Rollback()
+		if azerrors.AreErrorsEqual(azerrors.ErrStorageConstraintUnique, err) {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientUpdateConflict, fmt.Sprintf("invalid client input - change request %s has already been reviewed by %s", changeRequestID, reviewer), err)
+		}
+		return nil, err
+	}
+	newStatus := ""
+	if decision == azirepos.ChangeRequestDecisionReject {
+		newStatus = azirepos.ChangeRequestStatusRejected
+	} else {
+		approvals, err := s.sqlRepo.CountChangeRequestApprovals(tx, zoneID, changeRequestID)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if approvals >= dbChangeRequest.RequiredApprovals {
+			err = s.sqlRepo.UpdateLedgerRef(tx, zoneID, ledgerID, dbChangeRequest.BaseRef, dbChangeRequest.CommitID)
+			if err != nil {
+				tx.Rollback()
+				return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientUpdateConflict, fmt.Sprintf("invalid client input - the ledger %s has moved since the change request %s was created", ledgerID, changeRequestID), err)
+			}
+			newStatus = azirepos.ChangeRequestStatusApplied
+		}
+	}
+	if newStatus != "" {
+		dbChangeRequest, err = s.sqlRepo.UpdateChangeRequestStatus(tx, zoneID, changeRequestID, azirepos.ChangeRequestStatusPending, newStatus)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	if logger := s.ctx.GetLogger(); logger != nil {
+		logger.Info(s.ctx.GetLogMessage(fmt.Sprintf("Change request %s of the ledger %s reviewed by %s with decision %s, status %s", changeRequestID, ledgerID, reviewer, decision, dbChangeRequest.Status)))
+	}
+	dbReviews, err := s.sqlRepo.FetchChangeRequestReviews(db, zoneID, changeRequestID)
+	if err != nil {
+		return nil, err
+	}
+	return mapChangeRequestToAgentChangeRequest(dbChangeRequest, dbReviews)
+}
+
+// ApproveChangeRequest approves a pending change request, the ledger ref is moved once the required approvals are reached.
+func (s SQLiteCentralStoragePAP) ApproveChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, comment string) (*azmodelspap.ChangeRequest, error) {
+	return s.reviewChangeRequest(zoneID, ledgerID, changeRequestID, reviewer, azirepos.ChangeRequestDecisionApprove, comment)
+}
+
+// RejectChangeRequest rejects a pending change request.
+func (s SQLiteCentralStoragePAP) RejectChangeRequest(zoneID int64, ledgerID string, changeRequestID string, reviewer string, comment string) (*azmodelspap.ChangeRequest, error) {
+	return s.reviewChangeRequest(zoneID, ledgerID, changeRequestID, reviewer, azirepos.ChangeRequestDecisionReject, comment)
+}
+
+// FetchChangeRequests returns all change requests of a ledger.
+func (s SQLiteCentralStoragePAP) FetchChangeRequests(page int32, pageSize int32, zoneID int64, ledgerID string, fields map[string]any) ([]azmodelspap.ChangeRequest, error) {
+	if page <= 0 || pageSize <= 0 || pageSize > s.config.GetDataFetchMaxPageSize() {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, err
+	}
+	var filterID *string
+	if _, ok := fields[azmodelspap.FieldChangeRequestChangeRequestID]; ok {
+		changeRequestID, ok := fields[azmodelspap.FieldChangeRequestChangeRequestID].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - change request id is not valid (change request id: %s)", changeRequestID))
+		}
+		filterID = &changeRequestID
+	}
+	var filterStatus *string
+	if _, ok := fields[azmodelspap.FieldChangeRequestStatus]; ok {
+		status, ok := fields[azmodelspap.FieldChangeRequestStatus].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - change request status is not valid (status: %s)", status))
+		}
+		filterStatus = &status
+	}
+	dbChangeRequests, err := s.sqlRepo.FetchChangeRequests(db, page, pageSize, zoneID, ledgerID, filterID, filterStatus)
+	if err != nil {
+		return nil, err
+	}
+	changeRequests := make([]azmodelspap.ChangeRequest, len(dbChangeRequests))
+	for i, dbChangeRequest := range dbChangeRequests {
+		dbReviews, err := s.sqlRepo.FetchChangeRequestReviews(db, zoneID, dbChangeRequest.ChangeRequestID)
+		if err != nil {
+			return nil, err
+		}
+		changeRequest, err := mapChangeRequestToAgentChangeRequest(&dbChangeRequest, dbReviews)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, fmt.Sprintf("failed to convert change request (%s)", azirepos.LogChangeRequestEntry(&dbChangeRequest)), err)
+		}
+		changeRequests[i] = *changeRequest
+	}
+	return changeRequests, nil
+}
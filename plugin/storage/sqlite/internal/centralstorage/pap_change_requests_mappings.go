@@ -0,0 +1,55 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"encoding/json"
+
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// mapChangeRequestToAgentChangeRequest maps a ChangeRequest and its reviews to a model ChangeRequest.
+func mapChangeRequestToAgentChangeRequest(changeRequest *azirepos.ChangeRequest, reviews []azirepos.ChangeRequestReview) (*azmodelspap.ChangeRequest, error) {
+	plan := []azmodelspap.ChangeRequestPlanItem{}
+	if err := json.Unmarshal([]byte(changeRequest.Plan), &plan); err != nil {
+		return nil, err
+	}
+	agentReviews := make([]azmodelspap.ChangeRequestReview, len(reviews))
+	for i, review := range reviews {
+		agentReviews[i] = azmodelspap.ChangeRequestReview{
+			Reviewer:  review.Reviewer,
+			CreatedAt: review.CreatedAt,
+			Decision:  review.Decision,
+			Comment:   review.Comment,
+		}
+	}
+	return &azmodelspap.ChangeRequest{
+		ChangeRequestID:   changeRequest.ChangeRequestID,
+		CreatedAt:         changeRequest.CreatedAt,
+		UpdatedAt:         changeRequest.UpdatedAt,
+		ZoneID:            changeRequest.ZoneID,
+		LedgerID:          changeRequest.LedgerID,
+		BaseRef:           changeRequest.BaseRef,
+		CommitID:          changeRequest.CommitID,
+		Author:            changeRequest.Author,
+		Status:            changeRequest.Status,
+		RequiredApprovals: changeRequest.RequiredApprovals,
+		Plan:              plan,
+		Reviews:           agentReviews,
+	}, nil
+}
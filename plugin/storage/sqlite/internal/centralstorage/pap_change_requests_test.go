@@ -0,0 +1,348 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+	azmocks "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/testutils/mocks"
+)
+
+// newChangeRequestForTest creates a change request for the tests.
+func newChangeRequestForTest(status string) *azirepos.ChangeRequest {
+	return &azirepos.ChangeRequest{
+		ChangeRequestID:   "7d3f0a5b2e8c4b1f9a6d3c2e1f0a9b8c",
+		ZoneID:            232956849236,
+		LedgerID:          "c3160a533ab24fbcb1eab7a09fd85f36",
+		BaseRef:           "007867724d1aa801216d92d8d08ed2269a55e495575aceb1f46cded8594159ee",
+		CommitID:          "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80",
+		Author:            "nicola.gallo@example.com",
+		Plan:              `[{"code_id":"view-branch","code_type":"acpolicy","oid":"4c1a","state":"create"}]`,
+		Status:            status,
+		RequiredApprovals: 2,
+	}
+}
+
+// registerChangeRequestCommitForMocking registers a commit creating one policy readable within the transaction and returns its id.
+func registerChangeRequestCommitForMocking(assert *assert.Assertions, mockSQLRepo *azmocks.MockSqliteRepo, tx *sql.Tx, zoneID int64, author string) string {
+	tree, err := azobjs.NewTree()
+	assert.Nil(err, "error should be nil")
+	treeEntry, err := azobjs.NewTreeEntry("blob", "515513cd9200cfe899da7ac17a2293ed23a35674b933010d9736e634d3def5fe", "view-branch", "view-branch", "acpolicy", "cedar", "*", "policy")
+	assert.Nil(err, "error should be nil")
+	tree.AddEntry(treeEntry)
+	treeObj, err := azobjs.CreateTreeObject(tree)
+	assert.Nil(err, "error should be nil")
+	commit, err := azobjs.NewCommit(treeObj.GetOID(), azobjs.ZeroOID, author, time.Now(), author, time.Now(), "cli commit")
+	assert.Nil(err, "error should be nil")
+	commitObj, err := azobjs.CreateCommitObject(commit)
+	assert.Nil(err, "error should be nil")
+	mockSQLRepo.On("ReadKeyValue", tx, zoneID, treeObj.GetOID()).Return(&azirepos.KeyValue{ZoneID: zoneID, Key: treeObj.GetOID(), Value: treeObj.GetContent()}, nil)
+	mockSQLRepo.On("ReadKeyValue", tx, zoneID, commitObj.GetOID()).Return(&azirepos.KeyValue{ZoneID: zoneID, Key: commitObj.GetOID(), Value: commitObj.GetContent()}, nil)
+	return commitObj.GetOID()
+}
+
+// TestCreateChangeRequest tests that the change request is built and created within the transaction of the pushed objects.
+func TestCreateChangeRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with a commit which cannot be read within the transaction
+		storage, _, _, mockSQLRepo, _, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLDB.ExpectBegin()
+		tx, _ := sqlDB.Begin()
+		mockSQLRepo.On("ReadKeyValue", tx, changeRequest.ZoneID, changeRequest.CommitID).Return(nil, errors.New("READ-ERROR"))
+		ledger := &azmodelspap.Ledger{ZoneID: changeRequest.ZoneID, LedgerID: changeRequest.LedgerID, Ref: azobjs.ZeroOID, RequiredApprovals: 2}
+		outChangeRequest, err := storage.createChangeRequest(tx, ledger, changeRequest.CommitID, "Nicola.Gallo@example.com")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.NotNil(err, "error should not be nil")
+		mockSQLRepo.AssertNotCalled(t, "GetKeyValue", mock.Anything, mock.Anything, mock.Anything)
+		mockSQLRepo.AssertNotCalled(t, "CreateChangeRequest", mock.Anything, mock.Anything)
+	}
+
+	{ // Test with a push without an authenticated caller
+		storage, _, _, mockSQLRepo, _, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLDB.ExpectBegin()
+		tx, _ := sqlDB.Begin()
+		commitID := registerChangeRequestCommitForMocking(assert, mockSQLRepo, tx, changeRequest.ZoneID, "Nicola.Gallo@example.com")
+		ledger := &azmodelspap.Ledger{ZoneID: changeRequest.ZoneID, LedgerID: changeRequest.LedgerID, Ref: azobjs.ZeroOID, RequiredApprovals: 2}
+		outChangeRequest, err := storage.createChangeRequest(tx, ledger, commitID, "")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUnauthenticated, err), "error should be errclientunauthenticated")
+		mockSQLRepo.AssertNotCalled(t, "ReadIdentityByName", mock.Anything, mock.Anything, mock.Anything)
+		mockSQLRepo.AssertNotCalled(t, "CreateChangeRequest", mock.Anything, mock.Anything)
+	}
+
+	{ // Test with a caller which is not an identity of the zone
+		storage, _, _, mockSQLRepo, _, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLDB.ExpectBegin()
+		tx, _ := sqlDB.Begin()
+		commitID := registerChangeRequestCommitForMocking(assert, mockSQLRepo, tx, changeRequest.ZoneID, "")
+		mockSQLRepo.On("ReadIdentityByName", tx, changeRequest.ZoneID, "unknown@example.com").Return(nil, azerrors.ErrClientNotFound)
+		ledger := &azmodelspap.Ledger{ZoneID: changeRequest.ZoneID, LedgerID: changeRequest.LedgerID, Ref: azobjs.ZeroOID, RequiredApprovals: 2}
+		outChangeRequest, err := storage.createChangeRequest(tx, ledger, commitID, "unknown@example.com")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+		mockSQLRepo.AssertNotCalled(t, "CreateChangeRequest", mock.Anything, mock.Anything)
+	}
+
+	{ // Test with a commit author which is not the authenticated caller
+		storage, _, _, mockSQLRepo, _, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLDB.ExpectBegin()
+		tx, _ := sqlDB.Begin()
+		commitID := registerChangeRequestCommitForMocking(assert, mockSQLRepo, tx, changeRequest.ZoneID, "amy.smith@example.com")
+		mockSQLRepo.On("ReadIdentityByName", tx, changeRequest.ZoneID, "Nicola.Gallo@example.com").Return(&azirepos.Identity{ZoneID: changeRequest.ZoneID, Name: changeRequest.Author}, nil)
+		ledger := &azmodelspap.Ledger{ZoneID: changeRequest.ZoneID, LedgerID: changeRequest.LedgerID, Ref: azobjs.ZeroOID, RequiredApprovals: 2}
+		outChangeRequest, err := storage.createChangeRequest(tx, ledger, commitID, "Nicola.Gallo@example.com")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+		mockSQLRepo.AssertNotCalled(t, "CreateChangeRequest", mock.Anything, mock.Anything)
+	}
+
+	{ // Test with success
+		storage, _, _, mockSQLRepo, _, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLDB.ExpectBegin()
+		tx, _ := sqlDB.Begin()
+		commitID := registerChangeRequestCommitForMocking(assert, mockSQLRepo, tx, changeRequest.ZoneID, "")
+		mockSQLRepo.On("ReadIdentityByName", tx, changeRequest.ZoneID, "Nicola.Gallo@example.com").Return(&azirepos.Identity{ZoneID: changeRequest.ZoneID, Name: changeRequest.Author}, nil)
+		mockSQLRepo.On("CreateChangeRequest", tx, mock.MatchedBy(func(cr *azirepos.ChangeRequest) bool {
+			return cr.Author == changeRequest.Author && cr.CommitID == commitID && cr.RequiredApprovals == 2 && strings.Contains(cr.Plan, `"code_id":"view-branch"`)
+		})).Return(changeRequest, nil)
+		ledger := &azmodelspap.Ledger{ZoneID: changeRequest.ZoneID, LedgerID: changeRequest.LedgerID, Ref: azobjs.ZeroOID, RequiredApprovals: 2}
+		outChangeRequest, err := storage.createChangeRequest(tx, ledger, commitID, "Nicola.Gallo@example.com")
+		assert.Nil(err, "error should be nil")
+		assert.Equal(changeRequest, outChangeRequest, "change request should be equal")
+		mockSQLRepo.AssertCalled(t, "CreateChangeRequest", tx, mock.Anything)
+	}
+}
+
+// registerReviewerForMocking registers the identities of the zone reviewing the change requests.
+func registerReviewerForMocking(mockSQLRepo *azmocks.MockSqliteRepo, zoneID int64) {
+	for _, name := range []string{"amy.smith@example.com", "john.doe@example.com", "nicola.gallo@example.com"} {
+		mockSQLRepo.On("ReadIdentityByName", mock.Anything, zoneID, name).Return(&azirepos.Identity{ZoneID: zoneID, Name: name}, nil)
+	}
+	mockSQLRepo.On("ReadIdentityByName", mock.Anything, zoneID, "Nicola.Gallo@example.com").Return(&azirepos.Identity{ZoneID: zoneID, Name: "nicola.gallo@example.com"}, nil)
+	mockSQLRepo.On("ReadIdentityByName", mock.Anything, zoneID, "unknown@example.com").Return(nil, azerrors.ErrClientNotFound)
+}
+
+// TestApproveChangeRequest tests the ApproveChangeRequest function.
+func TestApproveChangeRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with a change request which is not pending
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusRejected)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		mockSQLDB.ExpectRollback()
+		outChangeRequest, err := storage.ApproveChangeRequest(changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.ChangeRequestID, "amy.smith@example.com", "")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUpdateConflict, err), "error should be errclientupdateconflict")
+		mockSQLRepo.AssertNotCalled(t, "CreateChangeRequestReview", mock.Anything, mock.Anything)
+	}
+
+	{ // Test without an authenticated reviewer
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		mockSQLDB.ExpectRollback()
+		outChangeRequest, err := storage.ApproveChangeRequest(changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.ChangeRequestID, "", "")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUnauthenticated, err), "error should be errclientunauthenticated")
+		mockSQLRepo.AssertNotCalled(t, "CreateChangeRequestReview", mock.Anything, mock.Anything)
+	}
+
+	{ // Test with a reviewer which is not an identity of the zone
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		registerReviewerForMocking(mockSQLRepo, changeRequest.ZoneID)
+		mockSQLDB.ExpectRollback()
+		outChangeRequest, err := storage.ApproveChangeRequest(changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.ChangeRequestID, "unknown@example.com", "")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+		mockSQLRepo.AssertNotCalled(t, "CreateChangeRequestReview", mock.Anything, mock.Anything)
+	}
+
+	{ // Test with the author approving its own change request
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		registerReviewerForMocking(mockSQLRepo, changeRequest.ZoneID)
+		mockSQLDB.ExpectRollback()
+		outChangeRequest, err := storage.ApproveChangeRequest(changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.ChangeRequestID, "Nicola.Gallo@example.com", "")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+		mockSQLRepo.AssertNotCalled(t, "CreateChangeRequestReview", mock.Anything, mock.Anything)
+	}
+
+	{ // Test with a reviewer who has already reviewed the change request
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		registerReviewerForMocking(mockSQLRepo, changeRequest.ZoneID)
+		mockSQLRepo.On("CreateChangeRequestReview", mock.Anything, mock.Anything).Return(nil, azerrors.ErrStorageConstraintUnique)
+		mockSQLDB.ExpectRollback()
+		outChangeRequest, err := storage.ApproveChangeRequest(changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.ChangeRequestID, "amy.smith@example.com", "")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUpdateConflict, err), "error should be errclientupdateconflict")
+	}
+
+	{ // Test with the approvals below the required ones
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		registerReviewerForMocking(mockSQLRepo, changeRequest.ZoneID)
+		mockSQLRepo.On("CreateChangeRequestReview", mock.Anything, mock.Anything).Return(&azirepos.ChangeRequestReview{}, nil)
+		mockSQLRepo.On("CountChangeRequestApprovals", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(int32(1), nil)
+		mockSQLDB.ExpectCommit().WillReturnError(nil)
+		mockSQLRepo.On("FetchChangeRequestReviews", sqlDB, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return([]azirepos.ChangeRequestReview{
+			{ZoneID: changeRequest.ZoneID, ChangeRequestID: changeRequest.ChangeRequestID, Reviewer: "amy.smith@example.com", Decision: azirepos.ChangeRequestDecisionApprove},
+		}, nil)
+		outChangeRequest, err := storage.ApproveChangeRequest(changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.ChangeRequestID, "amy.smith@example.com", "")
+		assert.Nil(err, "error should be nil")
+		assert.Equal(azmodelspap.ChangeRequestStatusPending, outChangeRequest.Status, "change request status should be equal")
+		assert.Len(outChangeRequest.Reviews, 1, "change request reviews should be one")
+		assert.Len(outChangeRequest.Plan, 1, "change request plan should have one item")
+		mockSQLRepo.AssertNotCalled(t, "UpdateLedgerRef", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	}
+
+	{ // Test with the required approvals reached
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		appliedChangeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusApplied)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		registerReviewerForMocking(mockSQLRepo, changeRequest.ZoneID)
+		mockSQLRepo.On("CreateChangeRequestReview", mock.Anything, mock.Anything).Return(&azirepos.ChangeRequestReview{}, nil)
+		mockSQLRepo.On("CountChangeRequestApprovals", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(int32(2), nil)
+		mockSQLRepo.On("UpdateLedgerRef", mock.Anything, changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.BaseRef, changeRequest.CommitID).Return(nil)
+		mockSQLRepo.On("UpdateChangeRequestStatus", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID, azirepos.ChangeRequestStatusPending, azirepos.ChangeRequestStatusApplied).Return(appliedChangeRequest, nil)
+		mockSQLDB.ExpectCommit().WillReturnError(nil)
+		mockSQLRepo.On("FetchChangeRequestReviews", sqlDB, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return([]azirepos.ChangeRequestReview{}, nil)
+		outChangeRequest, err := storage.ApproveChangeRequest(changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.ChangeRequestID, "john.doe@example.com", "")
+		assert.Nil(err, "error should be nil")
+		assert.Equal(azmodelspap.ChangeRequestStatusApplied, outChangeRequest.Status, "change request status should be equal")
+	}
+
+	{ // Test with a ledger ref moved since the change request was created
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		registerReviewerForMocking(mockSQLRepo, changeRequest.ZoneID)
+		mockSQLRepo.On("CreateChangeRequestReview", mock.Anything, mock.Anything).Return(&azirepos.ChangeRequestReview{}, nil)
+		mockSQLRepo.On("CountChangeRequestApprovals", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(int32(2), nil)
+		mockSQLRepo.On("UpdateLedgerRef", mock.Anything, changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.BaseRef, changeRequest.CommitID).Return(azerrors.ErrClientParameter)
+		mockSQLDB.ExpectRollback()
+		outChangeRequest, err := storage.ApproveChangeRequest(changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.ChangeRequestID, "john.doe@example.com", "")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUpdateConflict, err), "error should be errclientupdateconflict")
+		mockSQLRepo.AssertNotCalled(t, "UpdateChangeRequestStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+// TestRejectChangeRequest tests the RejectChangeRequest function.
+func TestRejectChangeRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with a change request of another ledger
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		mockSQLDB.ExpectRollback()
+		outChangeRequest, err := storage.RejectChangeRequest(changeRequest.ZoneID, azirepos.GenerateUUID(), changeRequest.ChangeRequestID, "amy.smith@example.com", "")
+		assert.Nil(outChangeRequest, "change request should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientNotFound, err), "error should be errclientnotfound")
+	}
+
+	{ // Test with success
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		rejectedChangeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusRejected)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadChangeRequest", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return(changeRequest, nil)
+		registerReviewerForMocking(mockSQLRepo, changeRequest.ZoneID)
+		mockSQLRepo.On("CreateChangeRequestReview", mock.Anything, mock.Anything).Return(&azirepos.ChangeRequestReview{}, nil)
+		mockSQLRepo.On("UpdateChangeRequestStatus", mock.Anything, changeRequest.ZoneID, changeRequest.ChangeRequestID, azirepos.ChangeRequestStatusPending, azirepos.ChangeRequestStatusRejected).Return(rejectedChangeRequest, nil)
+		mockSQLDB.ExpectCommit().WillReturnError(nil)
+		mockSQLRepo.On("FetchChangeRequestReviews", sqlDB, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return([]azirepos.ChangeRequestReview{
+			{ZoneID: changeRequest.ZoneID, ChangeRequestID: changeRequest.ChangeRequestID, Reviewer: "amy.smith@example.com", Decision: azirepos.ChangeRequestDecisionReject, Comment: "too broad"},
+		}, nil)
+		outChangeRequest, err := storage.RejectChangeRequest(changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.ChangeRequestID, "amy.smith@example.com", "too broad")
+		assert.Nil(err, "error should be nil")
+		assert.Equal(azmodelspap.ChangeRequestStatusRejected, outChangeRequest.Status, "change request status should be equal")
+		assert.Equal("too broad", outChangeRequest.Reviews[0].Comment, "change request review comment should be equal")
+		mockSQLRepo.AssertNotCalled(t, "CountChangeRequestApprovals", mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+// TestFetchChangeRequestsWithSuccess tests the FetchChangeRequests function with success.
+func TestFetchChangeRequestsWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with invalid page
+		storage, _, _, _, _, _, _ := createSQLitePAPCentralStorageWithMocks()
+		changeRequests, err := storage.FetchChangeRequests(0, 100, 232956849236, "c3160a533ab24fbcb1eab7a09fd85f36", nil)
+		assert.Nil(changeRequests, "change requests should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
+	}
+
+	{ // Test with the status filter
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePAPCentralStorageWithMocks()
+		changeRequest := newChangeRequestForTest(azirepos.ChangeRequestStatusPending)
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		status := azmodelspap.ChangeRequestStatusPending
+		mockSQLRepo.On("FetchChangeRequests", sqlDB, int32(1), int32(100), changeRequest.ZoneID, changeRequest.LedgerID, (*string)(nil), &status).Return([]azirepos.ChangeRequest{*changeRequest}, nil)
+		mockSQLRepo.On("FetchChangeRequestReviews", sqlDB, changeRequest.ZoneID, changeRequest.ChangeRequestID).Return([]azirepos.ChangeRequestReview{}, nil)
+		fields := map[string]any{azmodelspap.FieldChangeRequestStatus: status}
+		changeRequests, err := storage.FetchChangeRequests(1, 100, changeRequest.ZoneID, changeRequest.LedgerID, fields)
+		assert.Nil(err, "error should be nil")
+		assert.Len(changeRequests, 1, "change requests should be fetched")
+		assert.Equal("view-branch", changeRequests[0].Plan[0].CodeID, "change request plan code id should be equal")
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"context"
+	"fmt"
+
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// loadPolicyStore loads the policy store of the ledger as it is evaluated by the PDP.
+func (s SQLiteCentralStoragePAP) loadPolicyStore(zoneID int64, ledgerID string) (*azauthzen.PolicyStore, azlang.LanguageAbastraction, error) {
+	pdpStorage := &SQLiteCentralStoragePDP{
+		ctx:             s.ctx,
+		sqliteConnector: s.sqliteConnector,
+		sqlRepo:         s.sqlRepo,
+		sqlExec:         s.sqlExec,
+		config:          s.config,
+		languages:       s.languages,
+	}
+	authzModel := &azmodelspdp.AuthorizationModelRequest{
+		ZoneID:      zoneID,
+		PolicyStore: &azmodelspdp.PolicyStore{Kind: azirepos.LedgerType, ID: ledgerID},
+	}
+	return authorizationCheckLoadPolicyStore(context.Background(), pdpStorage, authzModel)
+}
+
+// validateEntities validates the entities against the schema of the ledger, no validation is performed when the ledger id is empty.
+func (s SQLiteCentralStoragePAP) validateEntities(zoneID int64, ledgerID string, entities []azmodelspap.Entity) error {
+	if ledgerID == "" {
+		return nil
+	}
+	policyStore, languageAbs, err := s.loadPolicyStore(zoneID, ledgerID)
+	if err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - schema of the ledger %s could not be loaded", ledgerID), err)
+	}
+	validator, ok := languageAbs.(azlang.LanguageEntitiesValidator)
+	if !ok {
+		return nil
+	}
+	items := make([]map[string]any, len(entities))
+	for i := range entities {
+		items[i] = mapAgentEntityToEntityItem(&entities[i])
+	}
+	if err := validator.ValidateEntities(policyStore, items); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - entities are not valid for the schema of the ledger %s", ledgerID), err)
+	}
+	return nil
+}
+
+// upsertEntity creates or updates an entity.
+func (s SQLiteCentralStoragePAP) upsertEntity(isCreate bool, ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error) {
+	if entity == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - entity is nil")
+	}
+	if err := s.validateEntities(entity.ZoneID, ledgerID, []azmodelspap.Entity{*entity}); err != nil {
+		return nil, err
+	}
+	dbInEntity, err := mapAgentEntityToEntity(entity)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - entity attributes or parents are not valid", err)
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutEntity, err := s.sqlRepo.UpsertEntity(tx, isCreate, dbInEntity)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapEntityToAgentEntity(dbOutEntity)
+}
+
+// CreateEntity creates a new entity.
+func (s SQLiteCentralStoragePAP) CreateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error) {
+	return s.upsertEntity(true, ledgerID, entity)
+}
+
+// UpdateEntity updates an entity.
+func (s SQLiteCentralStoragePAP) UpdateEntity(ledgerID string, entity *azmodelspap.Entity) (*azmodelspap.Entity, error) {
+	return s.upsertEntity(false, ledgerID, entity)
+}
+
+// UpsertEntities creates or replaces a set of entities in a single transaction.
+func (s SQLiteCentralStoragePAP) UpsertEntities(zoneID int64, ledgerID string, entities []azmodelspap.Entity) ([]azmodelspap.Entity, error) {
+	if len(entities) == 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - entities are empty")
+	}
+	dbInEntities := make([]azirepos.Entity, len(entities))
+	for i := range entities {
+		if entities[i].ZoneID != zoneID {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - entity %s::%s doesn't belong to the zone %d", entities[i].Type, entities[i].ID, zoneID))
+		}
+		dbInEntity, err := mapAgentEntityToEntity(&entities[i])
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - entity attributes or parents are not valid", err)
+		}
+		dbInEntities[i] = *dbInEntity
+	}
+	if err := s.validateEntities(zoneID, ledgerID, entities); err != nil {
+		return nil, err
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutEntities, err := s.sqlRepo.UpsertEntities(tx, dbInEntities)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	outEntities := make([]azmodelspap.Entity, len(dbOutEntities))
+	for i := range dbOutEntities {
+		entity, err := mapEntityToAgentEntity(&dbOutEntities[i])
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, fmt.Sprintf("failed to convert entity (%s)", azirepos.LogEntityEntry(&dbOutEntities[i])), err)
+		}
+		outEntities[i] = *entity
+	}
+	return outEntities, nil
+}
+
+// DeleteEntity deletes an entity.
+func (s SQLiteCentralStoragePAP) DeleteEntity(zoneID int64, entityType, entityID string) (*azmodelspap.Entity, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutEntity, err := s.sqlRepo.DeleteEntity(tx, zoneID, entityType, entityID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapEntityToAgentEntity(dbOutEntity)
+}
+
+// FetchEntities returns all entities.
+func (s SQLiteCentralStoragePAP) FetchEntities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelspap.Entity, error) {
+	if page <= 0 || pageSize <= 0 || pageSize > s.config.GetDataFetchMaxPageSize() {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, err
+	}
+	var filterType *string
+	if _, ok := fields[azmodelspap.FieldEntityType]; ok {
+		entityType, ok := fields[azmodelspap.FieldEntityType].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - entity type is not valid (entity type: %s)", entityType))
+		}
+		filterType = &entityType
+	}
+	var filterID *string
+	if _, ok := fields[azmodelspap.FieldEntityID]; ok {
+		entityID, ok := fields[azmodelspap.FieldEntityID].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - entity id is not valid (entity id: %s)", entityID))
+		}
+		filterID = &entityID
+	}
+	dbEntities, err := s.sqlRepo.FetchEntities(db, page, pageSize, zoneID, filterType, filterID)
+	if err != nil {
+		return nil, err
+	}
+	entities := make([]azmodelspap.Entity, len(dbEntities))
+	for i := range dbEntities {
+		entity, err := mapEntityToAgentEntity(&dbEntities[i])
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, fmt.Sprintf("failed to convert entity (%s)", azirepos.LogEntityEntry(&dbEntities[i])), err)
+		}
+		entities[i] = *entity
+	}
+	return entities, nil
+}
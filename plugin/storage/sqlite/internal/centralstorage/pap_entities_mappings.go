@@ -0,0 +1,89 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"encoding/json"
+
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// mapAgentEntityToEntity maps a model Entity to an Entity.
+func mapAgentEntityToEntity(entity *azmodelspap.Entity) (*azirepos.Entity, error) {
+	attributes := entity.Attributes
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	attributesJSON, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+	parents := entity.Parents
+	if parents == nil {
+		parents = []azmodelspap.EntityUID{}
+	}
+	parentsJSON, err := json.Marshal(parents)
+	if err != nil {
+		return nil, err
+	}
+	return &azirepos.Entity{
+		ZoneID:     entity.ZoneID,
+		EntityType: entity.Type,
+		EntityID:   entity.ID,
+		Attributes: string(attributesJSON),
+		Parents:    string(parentsJSON),
+	}, nil
+}
+
+// mapEntityToAgentEntity maps an Entity to a model Entity.
+func mapEntityToAgentEntity(entity *azirepos.Entity) (*azmodelspap.Entity, error) {
+	attributes := map[string]any{}
+	if err := json.Unmarshal([]byte(entity.Attributes), &attributes); err != nil {
+		return nil, err
+	}
+	parents := []azmodelspap.EntityUID{}
+	if err := json.Unmarshal([]byte(entity.Parents), &parents); err != nil {
+		return nil, err
+	}
+	return &azmodelspap.Entity{
+		ZoneID:     entity.ZoneID,
+		Type:       entity.EntityType,
+		ID:         entity.EntityID,
+		CreatedAt:  entity.CreatedAt,
+		UpdatedAt:  entity.UpdatedAt,
+		Attributes: attributes,
+		Parents:    parents,
+	}, nil
+}
+
+// mapAgentEntityToEntityItem maps a model Entity to an entity item of the authorization model.
+func mapAgentEntityToEntityItem(entity *azmodelspap.Entity) map[string]any {
+	attributes := entity.Attributes
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	parents := make([]any, len(entity.Parents))
+	for i, parent := range entity.Parents {
+		parents[i] = map[string]any{"type": parent.Type, "id": parent.ID}
+	}
+	return map[string]any{
+		"uid":     map[string]any{"type": entity.Type, "id": entity.ID},
+		"attrs":   attributes,
+		"parents": parents,
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// TestCreateEntityWithErrors tests the CreateEntity function with errors.
+func TestCreateEntityWithErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with nil entity
+		storage, _, _, _, _, _, _ := createSQLitePAPCentralStorageWithMocks()
+		entity, err := storage.CreateEntity("", nil)
+		assert.Nil(entity, "entity should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with rollback error
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("UpsertEntity", mock.Anything, true, mock.Anything).Return(nil, errors.New("ROLLBACK-ERROR"))
+		entity, err := storage.CreateEntity("", &azmodelspap.Entity{ZoneID: 232956849236, Type: "Branch", ID: "milan"})
+		assert.Nil(entity, "entity should be nil")
+		assert.Equal("ROLLBACK-ERROR", err.Error(), "error should be equal")
+	}
+}
+
+// TestCreateEntityWithSuccess tests the CreateEntity function with success.
+func TestCreateEntityWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+
+	dbOutEntity := &azirepos.Entity{
+		ZoneID:     232956849236,
+		EntityType: "Branch",
+		EntityID:   "milan",
+		Attributes: `{"city":"milan"}`,
+		Parents:    `[{"type":"Account","id":"acme"}]`,
+	}
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLDB.ExpectBegin()
+	mockSQLRepo.On("UpsertEntity", mock.Anything, true, mock.MatchedBy(func(entity *azirepos.Entity) bool {
+		return entity.Attributes == dbOutEntity.Attributes && entity.Parents == dbOutEntity.Parents
+	})).Return(dbOutEntity, nil)
+	mockSQLDB.ExpectCommit().WillReturnError(nil)
+
+	inEntity := &azmodelspap.Entity{
+		ZoneID:     232956849236,
+		Type:       "Branch",
+		ID:         "milan",
+		Attributes: map[string]any{"city": "milan"},
+		Parents:    []azmodelspap.EntityUID{{Type: "Account", ID: "acme"}},
+	}
+	outEntity, err := storage.CreateEntity("", inEntity)
+	assert.Nil(err, "error should be nil")
+	assert.NotNil(outEntity, "entity should not be nil")
+	assert.Equal("milan", outEntity.Attributes["city"], "entity attributes should be equal")
+	assert.Equal(inEntity.Parents, outEntity.Parents, "entity parents should be equal")
+}
+
+// TestUpsertEntitiesWithErrors tests the UpsertEntities function with errors.
+func TestUpsertEntitiesWithErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with empty entities
+		storage, _, _, _, _, _, _ := createSQLitePAPCentralStorageWithMocks()
+		entities, err := storage.UpsertEntities(232956849236, "", nil)
+		assert.Nil(entities, "entities should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with an entity of another zone
+		storage, _, _, _, _, _, _ := createSQLitePAPCentralStorageWithMocks()
+		entities, err := storage.UpsertEntities(232956849236, "", []azmodelspap.Entity{{ZoneID: 1, Type: "Branch", ID: "milan"}})
+		assert.Nil(entities, "entities should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+}
+
+// TestUpsertEntitiesWithSuccess tests the UpsertEntities function with success.
+func TestUpsertEntitiesWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+
+	dbOutEntities := []azirepos.Entity{
+		{ZoneID: 232956849236, EntityType: "Account", EntityID: "acme", Attributes: "{}", Parents: "[]"},
+		{ZoneID: 232956849236, EntityType: "Branch", EntityID: "milan", Attributes: "{}", Parents: `[{"type":"Account","id":"acme"}]`},
+	}
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLDB.ExpectBegin()
+	mockSQLRepo.On("UpsertEntities", mock.Anything, mock.Anything).Return(dbOutEntities, nil)
+	mockSQLDB.ExpectCommit().WillReturnError(nil)
+
+	inEntities := []azmodelspap.Entity{
+		{ZoneID: 232956849236, Type: "Account", ID: "acme"},
+		{ZoneID: 232956849236, Type: "Branch", ID: "milan", Parents: []azmodelspap.EntityUID{{Type: "Account", ID: "acme"}}},
+	}
+	outEntities, err := storage.UpsertEntities(232956849236, "", inEntities)
+	assert.Nil(err, "error should be nil")
+	assert.Len(outEntities, 2, "entities should be upserted")
+	assert.Equal("acme", outEntities[1].Parents[0].ID, "entity parent should be equal")
+}
+
+// TestFetchEntitiesWithSuccess tests the FetchEntities function with success.
+func TestFetchEntitiesWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with invalid page
+		storage, _, _, _, _, _, _ := createSQLitePAPCentralStorageWithMocks()
+		entities, err := storage.FetchEntities(0, 100, 232956849236, nil)
+		assert.Nil(entities, "entities should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
+	}
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePAPCentralStorageWithMocks()
+	dbOutEntities := []azirepos.Entity{
+		{ZoneID: 232956849236, EntityType: "Branch", EntityID: "milan", Attributes: "{}", Parents: "[]"},
+	}
+	entityType := "Branch"
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLRepo.On("FetchEntities", sqlDB, int32(1), int32(100), int64(232956849236), &entityType, (*string)(nil)).Return(dbOutEntities, nil)
+
+	fields := map[string]any{azmodelspap.FieldEntityType: entityType}
+	entities, err := storage.FetchEntities(1, 100, 232956849236, fields)
+	assert.Nil(err, "error should be nil")
+	assert.Len(entities, 1, "entities should be fetched")
+	assert.Equal("milan", entities[0].ID, "entity id should be equal")
+}
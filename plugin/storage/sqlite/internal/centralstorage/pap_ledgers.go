@@ -19,6 +19,7 @@ package centralstorage
 import (
 	"fmt"
 
+	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
 	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
@@ -101,6 +102,83 @@ func (s SQLiteCentralStoragePAP) UpdateLedger(ledger *azmodelspap.Ledger) (*azmo
 	return mapLedgerToAgentLedger(dbOutLedger)
 }
 
+// UpdateLedgerCandidate updates the candidate ref of a ledger, the zero ref clears the candidate.
+func (s SQLiteCentralStoragePAP) UpdateLedgerCandidate(zoneID int64, ledgerID string, candidateRef string) (*azmodelspap.Ledger, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	if candidateRef != azobjs.ZeroOID {
+		obj, err := s.readObject(db, zoneID, candidateRef)
+		if err != nil || obj == nil {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("invalid client input - candidate commit %s is not found", candidateRef))
+		}
+		objMng, err := azobjs.NewObjectManager()
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "failed to create the object manager", err)
+		}
+		if _, err := GetObjectForType[azobjs.Commit](objMng, obj); err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - candidate ref %s is not a commit", candidateRef), err)
+		}
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutLedger, err := s.sqlRepo.UpdateLedgerCandidateRef(tx, zoneID, ledgerID, candidateRef)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapLedgerToAgentLedger(dbOutLedger)
+}
+
+// PromoteLedgerCandidate promotes the candidate ref of a ledger to its active ref.
+func (s SQLiteCentralStoragePAP) PromoteLedgerCandidate(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutLedger, err := s.sqlRepo.PromoteLedgerCandidateRef(tx, zoneID, ledgerID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapLedgerToAgentLedger(dbOutLedger)
+}
+
+// UpdateLedgerProtection updates the number of approvals required to move the ref of a ledger, zero disables the protection.
+// The protection cannot be lowered while the ledger has pending change requests, they have to be approved or rejected first.
+func (s SQLiteCentralStoragePAP) UpdateLedgerProtection(zoneID int64, ledgerID string, requiredApprovals int32) (*azmodelspap.Ledger, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutLedger, err := s.sqlRepo.UpdateLedgerRequiredApprovals(tx, zoneID, ledgerID, requiredApprovals)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapLedgerToAgentLedger(dbOutLedger)
+}
+
 // DeleteLedger deletes a ledger.
 func (s SQLiteCentralStoragePAP) DeleteLedger(zoneID int64, ledgerID string) (*azmodelspap.Ledger, error) {
 	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
@@ -147,7 +225,11 @@ func (s SQLiteCentralStoragePAP) FetchLedgers(page int32, pageSize int32, zoneID
 		}
 		filterName = &ledgerName
 	}
-	dbLedgers, err := s.sqlRepo.FetchLedgers(db, page, pageSize, zoneID, filterID, filterName)
+	options, err := buildFetchOptions(fields, false)
+	if err != nil {
+		return nil, err
+	}
+	dbLedgers, err := s.sqlRepo.FetchLedgers(db, page, pageSize, zoneID, filterID, filterName, options)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package centralstorage
 
 import (
+	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
 	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
 	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
 )
@@ -27,13 +28,19 @@ func mapLedgerToAgentLedger(ledger *azirepos.Ledger) (*azmodelspap.Ledger, error
 	if err != nil {
 		return nil, err
 	}
+	candidateRef := ledger.CandidateRef
+	if candidateRef == azobjs.ZeroOID {
+		candidateRef = ""
+	}
 	return &azmodelspap.Ledger{
-		LedgerID:  ledger.LedgerID,
-		CreatedAt: ledger.CreatedAt,
-		UpdatedAt: ledger.UpdatedAt,
-		ZoneID:    ledger.ZoneID,
-		Name:      ledger.Name,
-		Kind:      kind,
-		Ref:       ledger.Ref,
+		LedgerID:          ledger.LedgerID,
+		CreatedAt:         ledger.CreatedAt,
+		UpdatedAt:         ledger.UpdatedAt,
+		ZoneID:            ledger.ZoneID,
+		Name:              ledger.Name,
+		Kind:              kind,
+		Ref:               ledger.Ref,
+		CandidateRef:      candidateRef,
+		RequiredApprovals: ledger.RequiredApprovals,
 	}, nil
 }
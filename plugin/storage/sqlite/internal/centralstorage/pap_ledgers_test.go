@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
 	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
@@ -271,6 +272,123 @@ func TestDeleteLedgerWithSuccess(t *testing.T) {
 	assert.Equal(dbOutLedger.UpdatedAt, outLedgers.UpdatedAt, "updated at should be equal")
 }
 
+// TestUpdateLedgerCandidate tests the UpdateLedgerCandidate function.
+func TestUpdateLedgerCandidate(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with a candidate commit not found
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLRepo.On("GetKeyValue", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		outLedger, err := storage.UpdateLedgerCandidate(232956849236, azirepos.GenerateUUID(), "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80")
+		assert.Nil(outLedger, "ledger should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientNotFound, err), "error should be errclientnotfound")
+	}
+
+	{ // Test clearing the candidate
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		dbOutLedger := &azirepos.Ledger{
+			ZoneID:       232956849236,
+			LedgerID:     azirepos.GenerateUUID(),
+			Name:         "rent-a-car1",
+			Kind:         1,
+			Ref:          "007867724d1aa801216d92d8d08ed2269a55e495575aceb1f46cded8594159ee",
+			CandidateRef: azobjs.ZeroOID,
+		}
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("UpdateLedgerCandidateRef", mock.Anything, dbOutLedger.ZoneID, dbOutLedger.LedgerID, azobjs.ZeroOID).Return(dbOutLedger, nil)
+		mockSQLDB.ExpectCommit().WillReturnError(nil)
+		outLedger, err := storage.UpdateLedgerCandidate(dbOutLedger.ZoneID, dbOutLedger.LedgerID, azobjs.ZeroOID)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(dbOutLedger.Ref, outLedger.Ref, "ledger ref should be equal")
+		assert.Empty(outLedger.CandidateRef, "ledger candidate ref should be empty")
+	}
+}
+
+// TestPromoteLedgerCandidate tests the PromoteLedgerCandidate function.
+func TestPromoteLedgerCandidate(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with a repository error
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("PromoteLedgerCandidateRef", mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrClientParameter)
+		mockSQLDB.ExpectRollback()
+		outLedger, err := storage.PromoteLedgerCandidate(232956849236, azirepos.GenerateUUID())
+		assert.Nil(outLedger, "ledger should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with the protection lowered while the ledger has pending change requests
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("UpdateLedgerRequiredApprovals", mock.Anything, mock.Anything, mock.Anything, int32(0)).Return(nil, azerrors.ErrClientUpdateConflict)
+		mockSQLDB.ExpectRollback()
+		outLedger, err := storage.UpdateLedgerProtection(232956849236, azirepos.GenerateUUID(), 0)
+		assert.Nil(outLedger, "ledger should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUpdateConflict, err), "error should be errclientupdateconflict")
+		assert.Nil(mockSQLDB.ExpectationsWereMet(), "the transaction should be rolled back")
+	}
+
+	{ // Test with success
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		dbOutLedger := &azirepos.Ledger{
+			ZoneID:       232956849236,
+			LedgerID:     azirepos.GenerateUUID(),
+			Name:         "rent-a-car1",
+			Kind:         1,
+			Ref:          "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80",
+			CandidateRef: azobjs.ZeroOID,
+		}
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("PromoteLedgerCandidateRef", mock.Anything, dbOutLedger.ZoneID, dbOutLedger.LedgerID).Return(dbOutLedger, nil)
+		mockSQLDB.ExpectCommit().WillReturnError(nil)
+		outLedger, err := storage.PromoteLedgerCandidate(dbOutLedger.ZoneID, dbOutLedger.LedgerID)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(dbOutLedger.Ref, outLedger.Ref, "ledger ref should be equal")
+	}
+}
+
+// TestUpdateLedgerProtection tests the UpdateLedgerProtection function.
+func TestUpdateLedgerProtection(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with a repository error
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("UpdateLedgerRequiredApprovals", mock.Anything, mock.Anything, mock.Anything, int32(-1)).Return(nil, azerrors.ErrClientParameter)
+		mockSQLDB.ExpectRollback()
+		outLedger, err := storage.UpdateLedgerProtection(232956849236, azirepos.GenerateUUID(), -1)
+		assert.Nil(outLedger, "ledger should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with success
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		dbOutLedger := &azirepos.Ledger{
+			ZoneID:            232956849236,
+			LedgerID:          azirepos.GenerateUUID(),
+			Name:              "rent-a-car1",
+			Kind:              1,
+			Ref:               "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80",
+			CandidateRef:      azobjs.ZeroOID,
+			RequiredApprovals: 2,
+		}
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("UpdateLedgerRequiredApprovals", mock.Anything, dbOutLedger.ZoneID, dbOutLedger.LedgerID, int32(2)).Return(dbOutLedger, nil)
+		mockSQLDB.ExpectCommit().WillReturnError(nil)
+		outLedger, err := storage.UpdateLedgerProtection(dbOutLedger.ZoneID, dbOutLedger.LedgerID, 2)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(int32(2), outLedger.RequiredApprovals, "ledger required approvals should be equal")
+	}
+}
+
 // TestFetchLedgerWithErrors tests the FetchLedger function with errors.
 func TestFetchLedgerWithErrors(t *testing.T) {
 	assert := assert.New(t)
@@ -318,7 +436,7 @@ func TestFetchLedgerWithErrors(t *testing.T) {
 	{ // Test with server error
 		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePAPCentralStorageWithMocks()
 		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
-		mockSQLRepo.On("FetchLedgers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
+		mockSQLRepo.On("FetchLedgers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
 		outLedgers, err := storage.FetchLedgers(1, 100, 232956849236, nil)
 		assert.Nil(outLedgers, "ledgers should be nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrServerGeneric, err), "error should be errservergeneric")
@@ -349,7 +467,7 @@ func TestFetchLedgerWithSuccess(t *testing.T) {
 	}
 
 	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
-	mockSQLRepo.On("FetchLedgers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutLedgers, nil)
+	mockSQLRepo.On("FetchLedgers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutLedgers, nil)
 
 	outLedgers, err := storage.FetchLedgers(1, 100, 232956849236, map[string]any{azmodelspap.FieldLedgerLedgerID: azirepos.GenerateUUID(), azmodelspap.FieldLedgerName: "rent-a-car2"})
 	assert.Nil(err, "error should be nil")
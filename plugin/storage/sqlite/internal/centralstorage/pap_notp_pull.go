@@ -18,6 +18,7 @@ package centralstorage
 
 import (
 	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
 
@@ -34,6 +35,7 @@ func (s SQLiteCentralStoragePAP) OnPullHandleRequestCurrentState(handlerCtx *not
 	if !ok || zoneID <= 0 {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid input zone id.")
 	}
+	aztelemetry.ObserveNOTPOperation(zoneID, aztelemetry.NOTPOperationPull)
 	if len(packets) == 0 {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid input packets for notify current state.")
 	}
@@ -213,6 +215,11 @@ func (s SQLiteCentralStoragePAP) buildPushPacketablesForCommit(zoneID int64, com
 		}
 		packetable = append(packetable, packet)
 	}
+	for _, packet := range packetable {
+		if objPacket, ok := packet.(*notpagpackets.ObjectStatePacket); ok {
+			aztelemetry.ObserveNOTPObject(zoneID, aztelemetry.NOTPOperationPull, len(objPacket.Content))
+		}
+	}
 	return packetable, nil
 }
 
@@ -17,7 +17,10 @@
 package centralstorage
 
 import (
+	"fmt"
+
 	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
 
@@ -34,6 +37,7 @@ func (s SQLiteCentralStoragePAP) OnPushHandleNotifyCurrentState(handlerCtx *notp
 	if !ok || zoneID <= 0 {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid input zone id.")
 	}
+	aztelemetry.ObserveNOTPOperation(zoneID, aztelemetry.NOTPOperationPush)
 	if len(packets) == 0 {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid input packets for notify current state.")
 	}
@@ -150,6 +154,7 @@ func (s SQLiteCentralStoragePAP) OnPushHandleExchangeDataStream(handlerCtx *notp
 			tx.Rollback()
 			return nil, err
 		}
+		aztelemetry.ObserveNOTPObject(zoneID, aztelemetry.NOTPOperationPush, len(objStatePacket.Content))
 	}
 	if statePacket.HasCompletedDataStream() {
 		ledger, err := s.readLedgerFromHandlerContext(handlerCtx)
@@ -157,9 +162,24 @@ func (s SQLiteCentralStoragePAP) OnPushHandleExchangeDataStream(handlerCtx *notp
 			return nil, err
 		}
 		remoteCommitID, _ := getFromHandlerContext[string](handlerCtx, RemoteCommitIDKey)
-		err = s.sqlRepo.UpdateLedgerRef(tx, ledger.ZoneID, ledger.LedgerID, ledger.Ref, remoteCommitID)
-		if err := tx.Commit(); err != nil {
-			return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+		if ledger.RequiredApprovals > 0 {
+			caller, _ := getFromHandlerContext[string](handlerCtx, azagentnotpsm.CallerKey)
+			changeRequest, err := s.createChangeRequest(tx, ledger, remoteCommitID, caller)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+			}
+			if logger := s.ctx.GetLogger(); logger != nil {
+				logger.Info(s.ctx.GetLogMessage(fmt.Sprintf("Change request %s created for the commit %s of the ledger %s", changeRequest.ChangeRequestID, remoteCommitID, ledger.LedgerID)))
+			}
+		} else {
+			err = s.sqlRepo.UpdateLedgerRef(tx, ledger.ZoneID, ledger.LedgerID, ledger.Ref, remoteCommitID)
+			if err := tx.Commit(); err != nil {
+				return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+			}
 		}
 	}
 	handlerReturn := &notpstatemachines.HostHandlerReturn{
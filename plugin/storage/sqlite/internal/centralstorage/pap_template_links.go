@@ -0,0 +1,130 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"fmt"
+
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// CreateTemplateLink creates a new template link.
+func (s SQLiteCentralStoragePAP) CreateTemplateLink(link *azmodelspap.TemplateLink) (*azmodelspap.TemplateLink, error) {
+	if link == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - template link is nil")
+	}
+	inLink := *link
+	if inLink.LinkID == "" {
+		inLink.LinkID = azirepos.GenerateUUID()
+	}
+	policyStore, languageAbs, err := s.loadPolicyStore(inLink.ZoneID, inLink.LedgerID)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - templates of the ledger %s could not be loaded", inLink.LedgerID), err)
+	}
+	linker, ok := languageAbs.(azlang.LanguageTemplateLinker)
+	if !ok {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - the language %s doesn't support policy templates", languageAbs.GetLanguageSpecification().GetLanguage()))
+	}
+	if err := linker.ValidateTemplateLink(policyStore, mapAgentTemplateLinkToLanguageTemplateLink(&inLink)); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - template link is not valid for the templates of the ledger %s", inLink.LedgerID), err)
+	}
+	dbInLink, err := mapAgentTemplateLinkToTemplateLink(&inLink)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - template link slots are not valid", err)
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutLink, err := s.sqlRepo.CreateTemplateLink(tx, dbInLink)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapTemplateLinkToAgentTemplateLink(dbOutLink)
+}
+
+// DeleteTemplateLink deletes a template link.
+func (s SQLiteCentralStoragePAP) DeleteTemplateLink(zoneID int64, ledgerID string, linkID string) (*azmodelspap.TemplateLink, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutLink, err := s.sqlRepo.DeleteTemplateLink(tx, zoneID, ledgerID, linkID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapTemplateLinkToAgentTemplateLink(dbOutLink)
+}
+
+// FetchTemplateLinks returns all template links of a ledger.
+func (s SQLiteCentralStoragePAP) FetchTemplateLinks(page int32, pageSize int32, zoneID int64, ledgerID string, fields map[string]any) ([]azmodelspap.TemplateLink, error) {
+	if page <= 0 || pageSize <= 0 || pageSize > s.config.GetDataFetchMaxPageSize() {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, err
+	}
+	var filterTemplateID *string
+	if _, ok := fields[azmodelspap.FieldTemplateLinkTemplateID]; ok {
+		templateID, ok := fields[azmodelspap.FieldTemplateLinkTemplateID].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - template id is not valid (template id: %s)", templateID))
+		}
+		filterTemplateID = &templateID
+	}
+	var filterLinkID *string
+	if _, ok := fields[azmodelspap.FieldTemplateLinkLinkID]; ok {
+		linkID, ok := fields[azmodelspap.FieldTemplateLinkLinkID].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - template link id is not valid (link id: %s)", linkID))
+		}
+		filterLinkID = &linkID
+	}
+	dbLinks, err := s.sqlRepo.FetchTemplateLinks(db, page, pageSize, zoneID, ledgerID, filterTemplateID, filterLinkID)
+	if err != nil {
+		return nil, err
+	}
+	links := make([]azmodelspap.TemplateLink, len(dbLinks))
+	for i, dbLink := range dbLinks {
+		link, err := mapTemplateLinkToAgentTemplateLink(&dbLink)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, fmt.Sprintf("failed to convert template link (%s)", azirepos.LogTemplateLinkEntry(&dbLink)), err)
+		}
+		links[i] = *link
+	}
+	return links, nil
+}
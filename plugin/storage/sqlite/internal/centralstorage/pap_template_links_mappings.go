@@ -0,0 +1,74 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"encoding/json"
+
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// mapAgentTemplateLinkToTemplateLink maps a model TemplateLink to a TemplateLink.
+func mapAgentTemplateLinkToTemplateLink(link *azmodelspap.TemplateLink) (*azirepos.TemplateLink, error) {
+	slots := link.Slots
+	if slots == nil {
+		slots = map[string]azmodelspap.EntityUID{}
+	}
+	slotsJSON, err := json.Marshal(slots)
+	if err != nil {
+		return nil, err
+	}
+	return &azirepos.TemplateLink{
+		ZoneID:     link.ZoneID,
+		LedgerID:   link.LedgerID,
+		LinkID:     link.LinkID,
+		TemplateID: link.TemplateID,
+		Slots:      string(slotsJSON),
+	}, nil
+}
+
+// mapTemplateLinkToAgentTemplateLink maps a TemplateLink to a model TemplateLink.
+func mapTemplateLinkToAgentTemplateLink(link *azirepos.TemplateLink) (*azmodelspap.TemplateLink, error) {
+	slots := map[string]azmodelspap.EntityUID{}
+	if err := json.Unmarshal([]byte(link.Slots), &slots); err != nil {
+		return nil, err
+	}
+	return &azmodelspap.TemplateLink{
+		ZoneID:     link.ZoneID,
+		LedgerID:   link.LedgerID,
+		LinkID:     link.LinkID,
+		CreatedAt:  link.CreatedAt,
+		UpdatedAt:  link.UpdatedAt,
+		TemplateID: link.TemplateID,
+		Slots:      slots,
+	}, nil
+}
+
+// mapAgentTemplateLinkToLanguageTemplateLink maps a model TemplateLink to a language TemplateLink.
+func mapAgentTemplateLinkToLanguageTemplateLink(link *azmodelspap.TemplateLink) *azlang.TemplateLink {
+	slots := make(map[string]azlang.TemplateLinkEntity, len(link.Slots))
+	for slot, entity := range link.Slots {
+		slots[slot] = azlang.TemplateLinkEntity{Type: entity.Type, ID: entity.ID}
+	}
+	return &azlang.TemplateLink{
+		LinkID:     link.LinkID,
+		TemplateID: link.TemplateID,
+		Slots:      slots,
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspap "github.com/permguard/permguard/pkg/transport/models/pap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// TestCreateTemplateLinkWithErrors tests the CreateTemplateLink function with errors.
+func TestCreateTemplateLinkWithErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with nil template link
+		storage, _, _, _, _, _, _ := createSQLitePAPCentralStorageWithMocks()
+		link, err := storage.CreateTemplateLink(nil)
+		assert.Nil(link, "template link should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with a ledger which cannot be loaded
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLRepo.On("FetchLedgers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]azirepos.Ledger{}, nil)
+		link, err := storage.CreateTemplateLink(&azmodelspap.TemplateLink{
+			ZoneID:     232956849236,
+			LedgerID:   "c3160a533ab24fbcb1eab7a09fd85f36",
+			TemplateID: "share-document",
+		})
+		assert.Nil(link, "template link should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+		mockSQLRepo.AssertNotCalled(t, "CreateTemplateLink", mock.Anything, mock.Anything)
+	}
+}
+
+// TestDeleteTemplateLinkWithSuccess tests the DeleteTemplateLink function with success.
+func TestDeleteTemplateLinkWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with rollback error
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("DeleteTemplateLink", mock.Anything, int64(232956849236), "c3160a533ab24fbcb1eab7a09fd85f36", "share").Return(nil, errors.New("ROLLBACK-ERROR"))
+		link, err := storage.DeleteTemplateLink(232956849236, "c3160a533ab24fbcb1eab7a09fd85f36", "share")
+		assert.Nil(link, "template link should be nil")
+		assert.Equal("ROLLBACK-ERROR", err.Error(), "error should be equal")
+	}
+
+	{ // Test with success
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLitePAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("DeleteTemplateLink", mock.Anything, int64(232956849236), "c3160a533ab24fbcb1eab7a09fd85f36", "share").Return(&azirepos.TemplateLink{
+			ZoneID:     232956849236,
+			LedgerID:   "c3160a533ab24fbcb1eab7a09fd85f36",
+			LinkID:     "share",
+			TemplateID: "share-document",
+			Slots:      `{"?principal":{"type":"User","id":"y"}}`,
+		}, nil)
+		mockSQLDB.ExpectCommit().WillReturnError(nil)
+		link, err := storage.DeleteTemplateLink(232956849236, "c3160a533ab24fbcb1eab7a09fd85f36", "share")
+		assert.Nil(err, "error should be nil")
+		assert.Equal("y", link.Slots["?principal"].ID, "template link slot should be equal")
+	}
+}
+
+// TestFetchTemplateLinksWithSuccess tests the FetchTemplateLinks function with success.
+func TestFetchTemplateLinksWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with invalid page
+		storage, _, _, _, _, _, _ := createSQLitePAPCentralStorageWithMocks()
+		links, err := storage.FetchTemplateLinks(0, 100, 232956849236, "c3160a533ab24fbcb1eab7a09fd85f36", nil)
+		assert.Nil(links, "template links should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
+	}
+
+	{ // Test with filters
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		templateID := "share-document"
+		mockSQLRepo.On("FetchTemplateLinks", sqlDB, int32(1), int32(100), int64(232956849236), "c3160a533ab24fbcb1eab7a09fd85f36", &templateID, (*string)(nil)).Return([]azirepos.TemplateLink{
+			{ZoneID: 232956849236, LedgerID: "c3160a533ab24fbcb1eab7a09fd85f36", LinkID: "share", TemplateID: templateID, Slots: "{}"},
+		}, nil)
+		fields := map[string]any{azmodelspap.FieldTemplateLinkTemplateID: templateID}
+		links, err := storage.FetchTemplateLinks(1, 100, 232956849236, "c3160a533ab24fbcb1eab7a09fd85f36", fields)
+		assert.Nil(err, "error should be nil")
+		assert.Len(links, 1, "template links should be fetched")
+		assert.Equal("share", links[0].LinkID, "template link id should be equal")
+	}
+}
@@ -18,6 +18,7 @@ package centralstorage
 
 import (
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
 	azidb "github.com/permguard/permguard/plugin/storage/sqlite/internal/extensions/db"
@@ -30,6 +31,9 @@ type SQLiteCentralStoragePDP struct {
 	sqlRepo         SqliteRepo
 	sqlExec         SqliteExecutor
 	config          *SQLiteCentralStorageConfig
+	languages       *azlang.LanguageRegistry
+	policyStores    *policyStoreCache
+	shadows         *shadowEvaluator
 }
 
 // newSQLitePDPCentralStorage creates a new SQLitePDPCentralStorage.
@@ -47,11 +51,17 @@ func newSQLitePDPCentralStorage(storageContext *azstorage.StorageContext, sqlite
 	if err != nil {
 		return nil, err
 	}
-	return &SQLiteCentralStoragePDP{
+	storage := &SQLiteCentralStoragePDP{
 		ctx:             storageContext,
 		sqliteConnector: sqliteConnector,
 		sqlRepo:         ledger,
 		sqlExec:         sqlExec,
 		config:          config,
-	}, nil
+		languages:       azlang.DefaultLanguageRegistry(),
+		policyStores:    newPolicyStoreCache(policyStoreCacheSize),
+	}
+	storage.shadows = newShadowEvaluator(shadowQueueSize, shadowWorkers, func(job *authorizationCheckShadowJob) {
+		authorizationCheckEvaluateShadow(storage, job)
+	})
+	return storage, nil
 }
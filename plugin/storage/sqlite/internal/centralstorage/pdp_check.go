@@ -17,16 +17,23 @@
 package centralstorage
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 
 	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
 	azauthzlangtypes "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/authz/languages/types"
 	azobjs "github.com/permguard/permguard-ztauthstar/pkg/ztauthstar/authstarmodels/objects"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
-	azplugincedar "github.com/permguard/permguard/plugin/languages/cedar"
 )
 
 // authorizationCheckBuildContextResponse builds the context response for the authorization check.
@@ -111,51 +118,140 @@ func authorizationCheckReadTree(s *SQLiteCentralStoragePDP, db *sqlx.DB, objMng
 	return objMng.DeserializeTree(ocontent)
 }
 
-// AuthorizationCheck performs the authorization check.
-func (s SQLiteCentralStoragePDP) AuthorizationCheck(request *azmodelspdp.AuthorizationCheckRequest) ([]azmodelspdp.EvaluationResponse, error) {
-	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+// authorizationCheckReadObjectInfo reads the object info of a tree entry for the authorization check.
+func authorizationCheckReadObjectInfo(s *SQLiteCentralStoragePDP, db *sqlx.DB, objMng *azobjs.ObjectManager, zoneID int64, entryID string) (*azobjs.ObjectInfo, error) {
+	value, err := authorizationCheckReadKeyValue(s, db, objMng, zoneID, entryID)
 	if err != nil {
-		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "server couldn't connect to the database", err)
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, fmt.Sprintf("server couldn't read the key %s", entryID), err)
 	}
+	obj, err := objMng.DeserializeObjectFromBytes(value)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't deserialize the object from bytes", err)
+	}
+	objInfo, err := objMng.GetObjectInfo(obj)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't read object info", err)
+	}
+	return objInfo, nil
+}
 
-	authzCtx := request.AuthorizationModel
-	dbLedgers, err := s.sqlRepo.FetchLedgers(db, 1, 2, authzCtx.ZoneID, &authzCtx.PolicyStore.ID, nil)
+// authorizationCheckBuildModel builds the authorization model of a single expanded request.
+func authorizationCheckBuildModel(expandedRequest *azmodelspdp.EvaluationRequest, entities *azmodelspdp.Entities) *azauthzen.AuthorizationModel {
+	authzCtx := &azauthzen.AuthorizationModel{}
+	authzCtx.SetSubject(expandedRequest.Subject.Type, expandedRequest.Subject.ID, expandedRequest.Subject.Source, expandedRequest.Subject.Properties)
+	authzCtx.SetResource(expandedRequest.Resource.Type, expandedRequest.Resource.ID, expandedRequest.Resource.Properties)
+	authzCtx.SetAction(expandedRequest.Action.Name, expandedRequest.Action.Properties)
+	authzCtx.SetContext(expandedRequest.Context)
+	if entities != nil {
+		authzCtx.SetEntities(entities.Schema, entities.Items)
+	}
+	return authzCtx
+}
+
+// authorizationCheckEvaluate evaluates a single expanded request against the policy store, it returns false if the evaluation failed with an error.
+func authorizationCheckEvaluate(ctx context.Context, languageAbs azlang.LanguageAbastraction, authzPolicyStore *azauthzen.PolicyStore, request *azmodelspdp.AuthorizationCheckRequest, expandedRequest *azmodelspdp.EvaluationRequest, entities *azmodelspdp.Entities) (azmodelspdp.EvaluationResponse, bool) {
+	zoneID := request.AuthorizationModel.ZoneID
+	ledgerID := request.AuthorizationModel.PolicyStore.ID
+	authzCtx := authorizationCheckBuildModel(expandedRequest, entities)
+	contextID := expandedRequest.ContextID
+	_, evalSpan := aztelemetry.StartSpan(ctx, "pdp.evaluation", attribute.String("permguard.request_id", expandedRequest.RequestID))
+	evalStart := time.Now()
+	authzResponse, err := languageAbs.AuthorizationCheck(contextID, authzPolicyStore, authzCtx)
+	aztelemetry.ObservePDPEvaluation(zoneID, ledgerID, time.Since(evalStart))
+	if err == nil && authzResponse != nil {
+		evalSpan.SetAttributes(attribute.Bool("permguard.decision", authzResponse.GetDecision()))
+	}
+	aztelemetry.EndSpan(evalSpan, err)
 	if err != nil {
-		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguangeSemantic, "bad request for either zone id or policy store id", err)
+		aztelemetry.ObservePDPDecision(zoneID, ledgerID, aztelemetry.DecisionError)
+		return *azmodelspdp.NewEvaluationErrorResponse(expandedRequest.RequestID, azauthzen.AuthzErrInternalErrorCode, err.Error(), azauthzen.AuthzErrInternalErrorMessage), false
 	}
-	if len(dbLedgers) != 1 {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "bad request for either zone id or policy store id")
+	if authzResponse == nil {
+		aztelemetry.ObservePDPDecision(zoneID, ledgerID, aztelemetry.DecisionError)
+		return *azmodelspdp.NewEvaluationErrorResponse(expandedRequest.RequestID, azauthzen.AuthzErrInternalErrorCode, "because of a nil authz response", azauthzen.AuthzErrInternalErrorMessage), false
 	}
-	ledger := dbLedgers[0]
-	ledgerRef := ledger.Ref
-	if ledgerRef == azobjs.ZeroOID {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't validate the ledger reference")
+	if authzResponse.GetDecision() {
+		aztelemetry.ObservePDPDecision(zoneID, ledgerID, aztelemetry.DecisionAllow)
+	} else {
+		aztelemetry.ObservePDPDecision(zoneID, ledgerID, aztelemetry.DecisionDeny)
 	}
+	return azmodelspdp.EvaluationResponse{
+		RequestID: expandedRequest.RequestID,
+		Decision:  authzResponse.GetDecision(),
+		Context:   authorizationCheckBuildContextResponse(authzResponse),
+	}, true
+}
 
+// authorizationCheckResolveLanguage resolves the language abstraction of the object out of its header.
+func authorizationCheckResolveLanguage(s *SQLiteCentralStoragePDP, objInfo *azobjs.ObjectInfo, current azlang.LanguageAbastraction) (azlang.LanguageAbastraction, error) {
+	header := objInfo.GetHeader()
+	if header == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't read the object header")
+	}
+	languageAbs, err := s.languages.GetLanguageAbastractionByID(header.GetLanguageID(), header.GetLanguageVersionID())
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguageGeneric, fmt.Sprintf("server couldn't resolve the language of the object %s", objInfo.GetOID()), err)
+	}
+	if current != nil && current != languageAbs {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguageGeneric, "server couldn't evaluate a policy store mixing multiple languages")
+	}
+	return languageAbs, nil
+}
+
+// authorizationCheckShadow is the candidate policy store of a ledger evaluated in shadow mode next to the active one.
+type authorizationCheckShadow struct {
+	activeRef    string
+	candidateRef string
+	policyStore  *azauthzen.PolicyStore
+	languageAbs  azlang.LanguageAbastraction
+}
+
+// authorizationCheckLoadPolicyStoreForRef loads the policy store of the ledger at the given ref and resolves its language abstraction,
+// the policy stores linked with their template links are cached by ledger ref and template links version.
+func authorizationCheckLoadPolicyStoreForRef(ctx context.Context, s *SQLiteCentralStoragePDP, db *sqlx.DB, zoneID int64, ledgerID string, ledgerRef string) (*azauthzen.PolicyStore, azlang.LanguageAbastraction, error) {
+	linksVersion, err := s.sqlRepo.FetchTemplateLinksVersion(db, zoneID, ledgerID)
+	if err != nil {
+		return nil, nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't load the template links version", err)
+	}
+	cacheKey := policyStoreCacheKey(zoneID, ledgerID, ledgerRef, linksVersion)
+	if authzPolicyStore, languageAbs, ok := s.policyStores.get(cacheKey); ok {
+		return authzPolicyStore, languageAbs, nil
+	}
+	authzPolicyStore, languageAbs, err := authorizationCheckBuildPolicyStoreForRef(ctx, s, db, zoneID, ledgerID, ledgerRef)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.policyStores.put(cacheKey, authzPolicyStore, languageAbs)
+	return authzPolicyStore, languageAbs, nil
+}
+
+// authorizationCheckBuildPolicyStoreForRef builds the policy store of the ledger at the given ref and links its template links.
+func authorizationCheckBuildPolicyStoreForRef(ctx context.Context, s *SQLiteCentralStoragePDP, db *sqlx.DB, zoneID int64, ledgerID string, ledgerRef string) (*azauthzen.PolicyStore, azlang.LanguageAbastraction, error) {
 	authzPolicyStore := azauthzen.PolicyStore{}
 	authzPolicyStore.SetVersion(ledgerRef)
 
 	objMng, err := azobjs.NewObjectManager()
 	if err != nil {
-		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't create the object manager", err)
+		return nil, nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't create the object manager", err)
 	}
-	treeObj, err := authorizationCheckReadTree(&s, db, objMng, authzCtx.ZoneID, ledgerRef)
+	_, treeSpan := aztelemetry.StartSpan(ctx, "pdp.tree-read", attribute.String("permguard.commit_id", ledgerRef))
+	treeObj, err := authorizationCheckReadTree(s, db, objMng, zoneID, ledgerRef)
+	aztelemetry.EndSpan(treeSpan, err)
 	if err != nil {
-		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't read the tree", err)
+		return nil, nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't read the tree", err)
 	}
+	var languageAbs azlang.LanguageAbastraction
 	for _, entry := range treeObj.GetEntries() {
 		entryID := entry.GetOID()
-		value, err := authorizationCheckReadKeyValue(&s, db, objMng, authzCtx.ZoneID, entryID)
+		_, blobSpan := aztelemetry.StartSpan(ctx, "pdp.blob-load", attribute.String("permguard.object_id", entryID))
+		objInfo, err := authorizationCheckReadObjectInfo(s, db, objMng, zoneID, entryID)
+		aztelemetry.EndSpan(blobSpan, err)
 		if err != nil {
-			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, fmt.Sprintf("server couldn't read the key %s", entryID), err)
+			return nil, nil, err
 		}
-		obj, err := objMng.DeserializeObjectFromBytes(value)
+		languageAbs, err = authorizationCheckResolveLanguage(s, objInfo, languageAbs)
 		if err != nil {
-			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't deserialize the object from bytes", err)
-		}
-		objInfo, err := objMng.GetObjectInfo(obj)
-		if err != nil {
-			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't read object info", err)
+			return nil, nil, err
 		}
 		objInfoHeader := objInfo.GetHeader()
 		oid := objInfo.GetOID()
@@ -164,44 +260,177 @@ func (s SQLiteCentralStoragePDP) AuthorizationCheck(request *azmodelspdp.Authori
 		} else if objInfoHeader.GetCodeTypeID() == azauthzlangtypes.ClassTypePolicyID {
 			authzPolicyStore.AddPolicy(oid, objInfo)
 		} else {
-			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't process the code type id")
+			return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't process the code type id")
 		}
 	}
 
-	cedarLanguageAbs, err := azplugincedar.NewCedarLanguageAbstraction()
+	if languageAbs == nil {
+		return nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "server couldn't resolve the language of an empty policy store")
+	}
+	if err := authorizationCheckLinkTemplates(s, db, zoneID, ledgerID, languageAbs, &authzPolicyStore); err != nil {
+		return nil, nil, err
+	}
+	return &authzPolicyStore, languageAbs, nil
+}
+
+// authorizationCheckLoadPolicyStores loads the active policy store of the authorization model and, if requested, the candidate one to be evaluated in shadow mode.
+func authorizationCheckLoadPolicyStores(ctx context.Context, s *SQLiteCentralStoragePDP, authzModel *azmodelspdp.AuthorizationModelRequest, withShadow bool) (*azauthzen.PolicyStore, azlang.LanguageAbastraction, *authorizationCheckShadow, error) {
+	zoneID := authzModel.ZoneID
+	ledgerID := authzModel.PolicyStore.ID
+	loadStart := time.Now()
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
 	if err != nil {
-		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't validate the language abstraction layer", err)
+		return nil, nil, nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "server couldn't connect to the database", err)
 	}
 
-	evaluations := []azmodelspdp.EvaluationResponse{}
-	for _, expandedRequest := range request.Evaluations {
-		authzCtx := azauthzen.AuthorizationModel{}
-		authzCtx.SetSubject(expandedRequest.Subject.Type, expandedRequest.Subject.ID, expandedRequest.Subject.Source, expandedRequest.Subject.Properties)
-		authzCtx.SetResource(expandedRequest.Resource.Type, expandedRequest.Resource.ID, expandedRequest.Resource.Properties)
-		authzCtx.SetAction(expandedRequest.Action.Name, expandedRequest.Action.Properties)
-		authzCtx.SetContext(expandedRequest.Context)
-		entities := request.AuthorizationModel.Entities
-		if entities != nil {
-			authzCtx.SetEntities(entities.Schema, entities.Items)
-		}
-		contextID := expandedRequest.ContextID
-		authzResponse, err := cedarLanguageAbs.AuthorizationCheck(contextID, &authzPolicyStore, &authzCtx)
+	_, lookupSpan := aztelemetry.StartSpan(ctx, "pdp.ledger-lookup")
+	dbLedgers, err := s.sqlRepo.FetchLedgers(db, 1, 2, zoneID, &ledgerID, nil, nil)
+	aztelemetry.EndSpan(lookupSpan, err)
+	if err != nil {
+		return nil, nil, nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguangeSemantic, "bad request for either zone id or policy store id", err)
+	}
+	if len(dbLedgers) != 1 {
+		return nil, nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrLanguangeSemantic, "bad request for either zone id or policy store id")
+	}
+	ledger := dbLedgers[0]
+	ledgerRef := ledger.Ref
+	if ledgerRef == azobjs.ZeroOID {
+		return nil, nil, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't validate the ledger reference")
+	}
+
+	authzPolicyStore, languageAbs, err := authorizationCheckLoadPolicyStoreForRef(ctx, s, db, zoneID, ledger.LedgerID, ledgerRef)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	aztelemetry.ObservePDPPolicyStoreLoad(zoneID, ledgerID, time.Since(loadStart))
+
+	var shadow *authorizationCheckShadow
+	candidateRef := ledger.CandidateRef
+	if withShadow && candidateRef != "" && candidateRef != azobjs.ZeroOID && candidateRef != ledgerRef {
+		_, shadowSpan := aztelemetry.StartSpan(ctx, "pdp.shadow-load", attribute.String("permguard.commit_id", candidateRef))
+		candidatePolicyStore, candidateLanguageAbs, err := authorizationCheckLoadPolicyStoreForRef(ctx, s, db, zoneID, ledger.LedgerID, candidateRef)
+		aztelemetry.EndSpan(shadowSpan, err)
 		if err != nil {
-			evaluation := azmodelspdp.NewEvaluationErrorResponse(expandedRequest.RequestID, azauthzen.AuthzErrInternalErrorCode, err.Error(), azauthzen.AuthzErrInternalErrorMessage)
-			evaluations = append(evaluations, *evaluation)
-			continue
+			// The shadow evaluation must never affect the active decisions, so the candidate is skipped.
+			if logger := s.ctx.GetLogger(); logger != nil {
+				logger.Warn(s.ctx.GetLogMessage(fmt.Sprintf("PDP shadow evaluation skipped, the candidate ref %s couldn't be loaded", candidateRef)), zap.Error(err))
+			}
+		} else {
+			shadow = &authorizationCheckShadow{
+				activeRef:    ledgerRef,
+				candidateRef: candidateRef,
+				policyStore:  candidatePolicyStore,
+				languageAbs:  candidateLanguageAbs,
+			}
 		}
-		if authzResponse == nil {
-			evaluation := azmodelspdp.NewEvaluationErrorResponse(expandedRequest.RequestID, azauthzen.AuthzErrInternalErrorCode, "because of a nil authz response", azauthzen.AuthzErrInternalErrorMessage)
-			evaluations = append(evaluations, *evaluation)
-			continue
+	}
+	return authzPolicyStore, languageAbs, shadow, nil
+}
+
+// authorizationCheckLoadPolicyStore loads the policy store of the authorization model and resolves its language abstraction.
+func authorizationCheckLoadPolicyStore(ctx context.Context, s *SQLiteCentralStoragePDP, authzModel *azmodelspdp.AuthorizationModelRequest) (*azauthzen.PolicyStore, azlang.LanguageAbastraction, error) {
+	authzPolicyStore, languageAbs, _, err := authorizationCheckLoadPolicyStores(ctx, s, authzModel, false)
+	return authzPolicyStore, languageAbs, err
+}
+
+// authorizationCheckExplain evaluates the authorization model and returns the decision along with the ids of the policies determining it, if supported by the language.
+func authorizationCheckExplain(languageAbs azlang.LanguageAbastraction, authzPolicyStore *azauthzen.PolicyStore, contextID string, authzCtx *azauthzen.AuthorizationModel) (bool, []string, error) {
+	var authzResponse *azauthzen.AuthorizationDecision
+	policies := []string{}
+	var err error
+	if explainer, ok := languageAbs.(azlang.LanguageDecisionExplainer); ok {
+		authzResponse, policies, err = explainer.ExplainAuthorizationCheck(contextID, authzPolicyStore, authzCtx)
+	} else {
+		authzResponse, err = languageAbs.AuthorizationCheck(contextID, authzPolicyStore, authzCtx)
+	}
+	if err != nil {
+		return false, nil, err
+	}
+	if authzResponse == nil {
+		return false, nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't evaluate because of a nil authz response")
+	}
+	return authzResponse.GetDecision(), policies, nil
+}
+
+// authorizationCheckRunEvaluations runs the evaluations on up to the given number of workers, which share the policy store and the language abstraction
+// as both are only read while evaluating. An evaluation failing with an error has a deny decision, so it stops the evaluations for the deny_on_first_deny semantic.
+func authorizationCheckRunEvaluations(ctx context.Context, workers int, semantic string, requests []azmodelspdp.EvaluationRequest, evaluate func(expandedRequest *azmodelspdp.EvaluationRequest) azmodelspdp.EvaluationResponse) []azmodelspdp.EvaluationResponse {
+	evaluations := make([]azmodelspdp.EvaluationResponse, len(requests))
+	workers = max(1, min(workers, len(requests)))
+	var stopIndex atomic.Int64
+	stopIndex.Store(int64(len(requests)))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				expandedRequest := requests[i]
+				if int64(i) > stopIndex.Load() {
+					evaluations[i] = *azmodelspdp.NewEvaluationErrorResponse(expandedRequest.RequestID, azauthzen.AuthzErrInternalErrorCode, "because the evaluation has been skipped", azauthzen.AuthzErrInternalErrorMessage)
+					continue
+				}
+				if err := ctx.Err(); err != nil {
+					evaluations[i] = *azmodelspdp.NewEvaluationErrorResponse(expandedRequest.RequestID, azauthzen.AuthzErrInternalErrorCode, err.Error(), azauthzen.AuthzErrInternalErrorMessage)
+					continue
+				}
+				evaluations[i] = evaluate(&expandedRequest)
+				if azmodelspdp.IsEvaluationsStop(semantic, evaluations[i].Decision) {
+					for current := stopIndex.Load(); int64(i) < current; current = stopIndex.Load() {
+						if stopIndex.CompareAndSwap(current, int64(i)) {
+							break
+						}
+					}
+				}
+			}
+		}()
+	}
+	for i := range requests {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return evaluations
+}
+
+// AuthorizationCheck performs the authorization check.
+func (s SQLiteCentralStoragePDP) AuthorizationCheck(ctx context.Context, request *azmodelspdp.AuthorizationCheckRequest) ([]azmodelspdp.EvaluationResponse, error) {
+	authzCtx := request.AuthorizationModel
+	zoneID := authzCtx.ZoneID
+	ledgerID := authzCtx.PolicyStore.ID
+	ctx, span := aztelemetry.StartSpan(ctx, "pdp.authorization-check",
+		attribute.Int64("permguard.zone_id", zoneID),
+		attribute.String("permguard.ledger_id", ledgerID),
+		attribute.Int("permguard.evaluations", len(request.Evaluations)),
+	)
+	defer span.End()
+	if err := ctx.Err(); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't complete the authorization check", err)
+	}
+	authzPolicyStore, languageAbs, shadow, err := authorizationCheckLoadPolicyStores(ctx, &s, authzCtx, true)
+	if err != nil {
+		return nil, err
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "server couldn't connect to the database", err)
+	}
+	entitiesSchema := languageAbs.GetLanguageSpecification().GetLanguage()
+
+	semantic := azmodelspdp.GetEvaluationsSemantic(request.Options)
+	workers := s.config.GetEvaluationWorkers()
+	evaluations := authorizationCheckRunEvaluations(ctx, workers, semantic, request.Evaluations, func(expandedRequest *azmodelspdp.EvaluationRequest) azmodelspdp.EvaluationResponse {
+		entities, err := authorizationCheckLoadEntities(&s, db, zoneID, languageAbs, entitiesSchema, authzCtx.Entities, expandedRequest.Subject, expandedRequest.Resource)
+		if err != nil {
+			return *azmodelspdp.NewEvaluationErrorResponse(expandedRequest.RequestID, azauthzen.AuthzErrInternalErrorCode, err.Error(), azauthzen.AuthzErrInternalErrorMessage)
 		}
-		evaluation := &azmodelspdp.EvaluationResponse{
-			RequestID: expandedRequest.RequestID,
-			Decision:  authzResponse.GetDecision(),
-			Context:   authorizationCheckBuildContextResponse(authzResponse),
+		evaluation, evaluated := authorizationCheckEvaluate(ctx, languageAbs, authzPolicyStore, request, expandedRequest, entities)
+		// The errored evaluations are denied, hence they are not compared with the candidate decisions.
+		if shadow != nil && evaluated {
+			authorizationCheckQueueShadow(ctx, &s, languageAbs, authzPolicyStore, shadow, request, expandedRequest, entities, &evaluation)
 		}
-		evaluations = append(evaluations, *evaluation)
-	}
+		return evaluation
+	})
 	return evaluations, nil
 }
@@ -0,0 +1,84 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// createEvaluationRequests creates the expanded evaluation requests.
+func createEvaluationRequests(count int) []azmodelspdp.EvaluationRequest {
+	requests := make([]azmodelspdp.EvaluationRequest, count)
+	for i := range requests {
+		requests[i] = azmodelspdp.EvaluationRequest{
+			RequestID: fmt.Sprintf("request-%d", i),
+			Subject:   &azmodelspdp.Subject{Type: "user", ID: "nicola.gallo"},
+			Resource:  &azmodelspdp.Resource{Type: "MagicFarmacia::Platform::Subscription", ID: fmt.Sprintf("subscription-%d", i)},
+			Action:    &azmodelspdp.Action{Name: "MagicFarmacia::Platform::Action::view"},
+		}
+	}
+	return requests
+}
+
+// TestAuthorizationCheckRunEvaluationsConcurrently tests the evaluations running concurrently over a shared policy store, to be run with -race.
+func TestAuthorizationCheckRunEvaluationsConcurrently(t *testing.T) {
+	assert := assert.New(t)
+	policyStore := &azauthzen.PolicyStore{}
+	requests := createEvaluationRequests(64)
+	var inFlight, maxInFlight atomic.Int32
+	evaluations := authorizationCheckRunEvaluations(context.Background(), 8, azmodelspdp.EvaluationsSemanticExecuteAll, requests, func(expandedRequest *azmodelspdp.EvaluationRequest) azmodelspdp.EvaluationResponse {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for previous := maxInFlight.Load(); current > previous && !maxInFlight.CompareAndSwap(previous, current); previous = maxInFlight.Load() {
+		}
+		_ = policyStore.GetPolicies()
+		time.Sleep(time.Millisecond)
+		return azmodelspdp.EvaluationResponse{RequestID: expandedRequest.RequestID, Decision: len(expandedRequest.Resource.ID)%2 == 0}
+	})
+	assert.Len(evaluations, len(requests), "every request should be evaluated")
+	for i, evaluation := range evaluations {
+		assert.Equal(requests[i].RequestID, evaluation.RequestID, "evaluations should keep the order of the requests")
+		assert.Equal(len(requests[i].Resource.ID)%2 == 0, evaluation.Decision, "decision should be the one of the request")
+	}
+	assert.Greater(maxInFlight.Load(), int32(1), "evaluations should run concurrently")
+}
+
+// TestAuthorizationCheckRunEvaluationsDenyOnFirstDenyWithError tests that an evaluation failing with an error stops the evaluations for the deny_on_first_deny semantic.
+func TestAuthorizationCheckRunEvaluationsDenyOnFirstDenyWithError(t *testing.T) {
+	assert := assert.New(t)
+	requests := createEvaluationRequests(5)
+	var evaluated atomic.Int32
+	evaluations := authorizationCheckRunEvaluations(context.Background(), 1, azmodelspdp.EvaluationsSemanticDenyOnFirstDeny, requests, func(expandedRequest *azmodelspdp.EvaluationRequest) azmodelspdp.EvaluationResponse {
+		evaluated.Add(1)
+		if expandedRequest.RequestID == "request-2" {
+			return *azmodelspdp.NewEvaluationErrorResponse(expandedRequest.RequestID, azauthzen.AuthzErrInternalErrorCode, "entities couldn't be loaded", azauthzen.AuthzErrInternalErrorMessage)
+		}
+		return azmodelspdp.EvaluationResponse{RequestID: expandedRequest.RequestID, Decision: true}
+	})
+	assert.Equal(int32(3), evaluated.Load(), "evaluations after the failed one should be skipped")
+	assert.False(evaluations[2].Decision, "failed evaluation should be a deny")
+	assert.Len(azmodelspdp.TruncateEvaluationsBySemantic(azmodelspdp.EvaluationsSemanticDenyOnFirstDeny, evaluations), 3, "evaluations should stop at the failed one")
+}
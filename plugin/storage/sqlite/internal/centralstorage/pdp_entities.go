@@ -0,0 +1,130 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// entityItemUID returns the uid of an entity item of the authorization model.
+func entityItemUID(item map[string]any) (azirepos.EntityUID, bool) {
+	uid, ok := item["uid"].(map[string]any)
+	if !ok {
+		return azirepos.EntityUID{}, false
+	}
+	uidType, okType := uid["type"].(string)
+	uidID, okID := uid["id"].(string)
+	if !okType || !okID {
+		return azirepos.EntityUID{}, false
+	}
+	return azirepos.EntityUID{EntityType: uidType, EntityID: uidID}, true
+}
+
+// entityItemParents returns the parents of an entity item of the authorization model.
+func entityItemParents(item map[string]any) []azirepos.EntityUID {
+	parents, _ := item["parents"].([]any)
+	uids := make([]azirepos.EntityUID, 0, len(parents))
+	for _, parent := range parents {
+		parentMap, ok := parent.(map[string]any)
+		if !ok {
+			continue
+		}
+		if uid, ok := entityItemUID(map[string]any{"uid": parentMap}); ok {
+			uids = append(uids, uid)
+		}
+	}
+	return uids
+}
+
+// entityUIDKey returns the key of an entity uid.
+func entityUIDKey(uid azirepos.EntityUID) string {
+	return uid.EntityType + "\x00" + uid.EntityID
+}
+
+// authorizationCheckLoadEntities merges the stored entities reachable from the subject and the resource with the request ones, the request entities take precedence.
+func authorizationCheckLoadEntities(s *SQLiteCentralStoragePDP, db *sqlx.DB, zoneID int64, languageAbs azlang.LanguageAbastraction, schema string, requestEntities *azmodelspdp.Entities, subject *azmodelspdp.Subject, resource *azmodelspdp.Resource) (*azmodelspdp.Entities, error) {
+	entities := &azmodelspdp.Entities{Schema: schema}
+	if requestEntities != nil {
+		if requestEntities.Schema != "" {
+			entities.Schema = requestEntities.Schema
+		}
+		entities.Items = append(entities.Items, requestEntities.Items...)
+	}
+
+	// The loaded entities are either supplied by the request or already fetched, the requested ones are the uids already queued for fetching.
+	loaded := map[string]bool{}
+	requested := map[string]bool{}
+	frontier := []azirepos.EntityUID{}
+	enqueue := func(uid azirepos.EntityUID) {
+		key := entityUIDKey(uid)
+		if uid.EntityType == "" || uid.EntityID == "" || loaded[key] || requested[key] {
+			return
+		}
+		requested[key] = true
+		frontier = append(frontier, uid)
+	}
+	for _, item := range entities.Items {
+		if uid, ok := entityItemUID(item); ok {
+			loaded[entityUIDKey(uid)] = true
+		}
+	}
+	for _, item := range entities.Items {
+		for _, parent := range entityItemParents(item) {
+			enqueue(parent)
+		}
+	}
+	// The subject type is language specific, hence it is resolved by the language and the subjects which aren't identities are not loaded.
+	if subject != nil {
+		if resolver, ok := languageAbs.(azlang.LanguageSubjectResolver); ok {
+			if subjectType, ok := resolver.GetSubjectEntityType(subject.Type); ok {
+				enqueue(azirepos.EntityUID{EntityType: subjectType, EntityID: subject.ID})
+			}
+		}
+	}
+	if resource != nil && resource.Type != "" {
+		enqueue(azirepos.EntityUID{EntityType: resource.Type, EntityID: resource.ID})
+	}
+
+	for len(frontier) > 0 {
+		dbEntities, err := s.sqlRepo.FetchEntitiesByUIDs(db, zoneID, frontier)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "server couldn't load the stored entities", err)
+		}
+		frontier = []azirepos.EntityUID{}
+		for i := range dbEntities {
+			key := entityUIDKey(azirepos.EntityUID{EntityType: dbEntities[i].EntityType, EntityID: dbEntities[i].EntityID})
+			if loaded[key] {
+				continue
+			}
+			loaded[key] = true
+			entity, err := mapEntityToAgentEntity(&dbEntities[i])
+			if err != nil {
+				return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, "server couldn't map the stored entity", err)
+			}
+			entities.Items = append(entities.Items, mapAgentEntityToEntityItem(entity))
+			for _, parent := range entity.Parents {
+				enqueue(azirepos.EntityUID{EntityType: parent.Type, EntityID: parent.ID})
+			}
+		}
+	}
+	return entities, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// subjectResolverLanguageMock is a language abstraction mock resolving the user subjects.
+type subjectResolverLanguageMock struct {
+	azlang.LanguageAbastraction
+}
+
+// GetSubjectEntityType returns the entity type of the user subjects.
+func (l *subjectResolverLanguageMock) GetSubjectEntityType(subjectType string) (string, bool) {
+	if subjectType != "user" {
+		return "", false
+	}
+	return "Permguard::IAM::User", true
+}
+
+// TestAuthorizationCheckLoadEntities tests the merge of the stored entities with the request ones.
+func TestAuthorizationCheckLoadEntities(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, _, _, mockSQLRepo, _, sqlDB, _ := createSQLitePDPCentralStorageWithMocks()
+	zoneID := int64(232956849236)
+
+	// The account is supplied by the request, hence it is not fetched.
+	firstLevel := []azirepos.EntityUID{
+		{EntityType: "Permguard::IAM::User", EntityID: "alice"},
+		{EntityType: "Branch", EntityID: "milan"},
+	}
+	mockSQLRepo.On("FetchEntitiesByUIDs", sqlDB, zoneID, firstLevel).Return([]azirepos.Entity{
+		{ZoneID: zoneID, EntityType: "Branch", EntityID: "milan", Attributes: `{"city":"milan"}`, Parents: `[{"type":"Region","id":"eu"}]`},
+		{ZoneID: zoneID, EntityType: "Permguard::IAM::User", EntityID: "alice", Attributes: "{}", Parents: `[{"type":"Group","id":"admins"}]`},
+	}, nil).Once()
+	secondLevel := []azirepos.EntityUID{
+		{EntityType: "Region", EntityID: "eu"},
+		{EntityType: "Group", EntityID: "admins"},
+	}
+	mockSQLRepo.On("FetchEntitiesByUIDs", sqlDB, zoneID, secondLevel).Return([]azirepos.Entity{
+		{ZoneID: zoneID, EntityType: "Region", EntityID: "eu", Attributes: "{}", Parents: `[{"type":"Branch","id":"milan"}]`},
+	}, nil).Once()
+
+	requestEntities := &azmodelspdp.Entities{
+		Schema: "cedar",
+		Items: []map[string]any{
+			{
+				"uid":     map[string]any{"type": "Branch", "id": "rome"},
+				"attrs":   map[string]any{},
+				"parents": []any{map[string]any{"type": "Account", "id": "acme"}},
+			},
+			{
+				"uid":     map[string]any{"type": "Account", "id": "acme"},
+				"attrs":   map[string]any{"active": true},
+				"parents": []any{},
+			},
+		},
+	}
+	subject := &azmodelspdp.Subject{Type: "user", ID: "alice"}
+	resource := &azmodelspdp.Resource{Type: "Branch", ID: "milan"}
+	entities, err := authorizationCheckLoadEntities(storage, sqlDB, zoneID, &subjectResolverLanguageMock{}, "cedar", requestEntities, subject, resource)
+	assert.Nil(err, "error should be nil")
+	mockSQLRepo.AssertExpectations(t)
+	assert.Len(entities.Items, 5, "request and reachable stored entities should be merged")
+	assert.Equal(requestEntities.Items[1], entities.Items[1], "request entities should take precedence")
+	uids := []azirepos.EntityUID{}
+	for _, item := range entities.Items {
+		uid, _ := entityItemUID(item)
+		uids = append(uids, uid)
+	}
+	assert.Contains(uids, azirepos.EntityUID{EntityType: "Region", EntityID: "eu"}, "ancestors should be loaded")
+	assert.NotContains(uids, azirepos.EntityUID{EntityType: "Group", EntityID: "missing"}, "unreachable entities should not be loaded")
+}
+
+// TestAuthorizationCheckLoadEntitiesWithUnresolvedSubject tests that the subjects which aren't identities are not loaded.
+func TestAuthorizationCheckLoadEntitiesWithUnresolvedSubject(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, _, _, mockSQLRepo, _, sqlDB, _ := createSQLitePDPCentralStorageWithMocks()
+	zoneID := int64(232956849236)
+
+	mockSQLRepo.On("FetchEntitiesByUIDs", sqlDB, zoneID, []azirepos.EntityUID{{EntityType: "Branch", EntityID: "milan"}}).Return([]azirepos.Entity{}, nil).Once()
+
+	subject := &azmodelspdp.Subject{Type: "Branch", ID: "milan"}
+	resource := &azmodelspdp.Resource{Type: "Branch", ID: "milan"}
+	_, err := authorizationCheckLoadEntities(storage, sqlDB, zoneID, &subjectResolverLanguageMock{}, "cedar", nil, subject, resource)
+	assert.Nil(err, "error should be nil")
+	_, err = authorizationCheckLoadEntities(storage, sqlDB, zoneID, nil, "cedar", nil, &azmodelspdp.Subject{Type: "user", ID: "alice"}, nil)
+	assert.Nil(err, "error should be nil")
+	mockSQLRepo.AssertExpectations(t)
+	mockSQLRepo.AssertNumberOfCalls(t, "FetchEntitiesByUIDs", 1)
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+// PartialEvaluation partially evaluates the authorization for the resources of a type.
+func (s SQLiteCentralStoragePDP) PartialEvaluation(ctx context.Context, request *azmodelspdp.PartialEvaluationRequest) (*azmodelspdp.PartialEvaluationResponse, error) {
+	authzModel := request.AuthorizationModel
+	ctx, span := aztelemetry.StartSpan(ctx, "pdp.partial-evaluation",
+		attribute.Int64("permguard.zone_id", authzModel.ZoneID),
+		attribute.String("permguard.ledger_id", authzModel.PolicyStore.ID),
+	)
+	defer span.End()
+	if err := ctx.Err(); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't complete the partial evaluation", err)
+	}
+	authzPolicyStore, languageAbs, err := authorizationCheckLoadPolicyStore(ctx, &s, authzModel)
+	if err != nil {
+		return nil, err
+	}
+
+	partialEvaluator, ok := languageAbs.(azlang.LanguagePartialEvaluator)
+	if !ok {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrNotImplemented, "server couldn't partially evaluate as the language doesn't support it")
+	}
+
+	authzCtx := azauthzen.AuthorizationModel{}
+	authzCtx.SetSubject(request.Subject.Type, request.Subject.ID, request.Subject.Source, request.Subject.Properties)
+	authzCtx.SetResource(request.Resource.Type, request.Resource.ID, request.Resource.Properties)
+	authzCtx.SetAction(request.Action.Name, request.Action.Properties)
+	authzCtx.SetContext(request.Context)
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "server couldn't connect to the database", err)
+	}
+	entities, err := authorizationCheckLoadEntities(&s, db, authzModel.ZoneID, languageAbs, languageAbs.GetLanguageSpecification().GetLanguage(), authzModel.Entities, request.Subject, request.Resource)
+	if err != nil {
+		return nil, err
+	}
+	authzCtx.SetEntities(entities.Schema, entities.Items)
+	partialDecision, err := partialEvaluator.PartialAuthorizationCheck(request.RequestID, authzPolicyStore, &authzCtx)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("permguard.decision", partialDecision.Decision))
+	return &azmodelspdp.PartialEvaluationResponse{
+		RequestID: request.RequestID,
+		Decision:  partialDecision.Decision,
+		Residual:  partialDecision.Residual,
+	}, nil
+}
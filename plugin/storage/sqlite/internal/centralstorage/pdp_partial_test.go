@@ -0,0 +1,84 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// createPartialEvaluationRequest creates a partial evaluation request.
+func createPartialEvaluationRequest() *azmodelspdp.PartialEvaluationRequest {
+	return &azmodelspdp.PartialEvaluationRequest{
+		AuthorizationModel: &azmodelspdp.AuthorizationModelRequest{
+			ZoneID:      232956849236,
+			PolicyStore: &azmodelspdp.PolicyStore{Kind: "ledger", ID: "fd1ac44e4afa4fc4beec622494d3175a"},
+		},
+		Subject:  &azmodelspdp.Subject{Type: "user", ID: "nicola.gallo"},
+		Resource: &azmodelspdp.Resource{Type: "MagicFarmacia::Platform::Subscription"},
+		Action:   &azmodelspdp.Action{Name: "MagicFarmacia::Platform::Action::view"},
+		Context:  map[string]any{},
+	}
+}
+
+// TestPartialEvaluationWithErrors tests the PartialEvaluation function with errors.
+func TestPartialEvaluationWithErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with connection error
+		storage, mockStorageCtx, mockConnector, _, mockSQLExec, _, _ := createSQLitePDPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(nil, errors.New("CONNECT-ERROR"))
+		response, err := storage.PartialEvaluation(context.Background(), createPartialEvaluationRequest())
+		assert.Nil(response, "response should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrStorageGeneric, err), "error should be errstoragegeneric")
+	}
+
+	{ // Test with ledger fetch error
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePDPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLRepo.On("FetchLedgers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
+		response, err := storage.PartialEvaluation(context.Background(), createPartialEvaluationRequest())
+		assert.Nil(response, "response should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrLanguangeSemantic, err), "error should be errlanguangesemantic")
+	}
+
+	{ // Test with ledger not found
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePDPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLRepo.On("FetchLedgers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]azirepos.Ledger{}, nil)
+		response, err := storage.PartialEvaluation(context.Background(), createPartialEvaluationRequest())
+		assert.Nil(response, "response should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrLanguangeSemantic, err), "error should be errlanguangesemantic")
+	}
+
+	{ // Test with cancelled context
+		storage, _, _, _, _, _, _ := createSQLitePDPCentralStorageWithMocks()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		response, err := storage.PartialEvaluation(ctx, createPartialEvaluationRequest())
+		assert.Nil(response, "response should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrServerGeneric, err), "error should be errservergeneric")
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"fmt"
+	"sync"
+
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+)
+
+const (
+	// policyStoreCacheSize is the maximum number of cached policy stores.
+	policyStoreCacheSize = 256
+)
+
+// policyStoreCacheEntry is a cached policy store with its language abstraction.
+type policyStoreCacheEntry struct {
+	policyStore *azauthzen.PolicyStore
+	languageAbs azlang.LanguageAbastraction
+}
+
+// policyStoreCache caches the policy stores linked with their template links, the oldest entries are evicted first.
+type policyStoreCache struct {
+	mutex   sync.Mutex
+	size    int
+	entries map[string]policyStoreCacheEntry
+	keys    []string
+}
+
+// newPolicyStoreCache creates a new policy store cache.
+func newPolicyStoreCache(size int) *policyStoreCache {
+	return &policyStoreCache{
+		size:    size,
+		entries: map[string]policyStoreCacheEntry{},
+	}
+}
+
+// policyStoreCacheKey returns the key of a policy store, the ledger ref identifies the policies and the links version identifies the template links.
+func policyStoreCacheKey(zoneID int64, ledgerID string, ledgerRef string, linksVersion string) string {
+	return fmt.Sprintf("%d/%s/%s/%s", zoneID, ledgerID, ledgerRef, linksVersion)
+}
+
+// get returns the cached policy store, a nil cache caches nothing.
+func (c *policyStoreCache) get(key string) (*azauthzen.PolicyStore, azlang.LanguageAbastraction, bool) {
+	if c == nil {
+		return nil, nil, false
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, nil, false
+	}
+	return entry.policyStore, entry.languageAbs, true
+}
+
+// put caches the policy store, the cached policy stores must be read only.
+func (c *policyStoreCache) put(key string, policyStore *azauthzen.PolicyStore, languageAbs azlang.LanguageAbastraction) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		for len(c.keys) > 0 && len(c.keys) >= c.size {
+			delete(c.entries, c.keys[0])
+			c.keys = c.keys[1:]
+		}
+		c.keys = append(c.keys, key)
+	}
+	c.entries[key] = policyStoreCacheEntry{policyStore: policyStore, languageAbs: languageAbs}
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+)
+
+// TestPolicyStoreCache tests the eviction of the cached policy stores.
+func TestPolicyStoreCache(t *testing.T) {
+	assert := assert.New(t)
+	cache := newPolicyStoreCache(2)
+	cache.put("a", &azauthzen.PolicyStore{}, nil)
+	cache.put("b", &azauthzen.PolicyStore{}, nil)
+	cache.put("a", &azauthzen.PolicyStore{}, nil)
+	cache.put("c", &azauthzen.PolicyStore{}, nil)
+	_, _, ok := cache.get("a")
+	assert.False(ok, "oldest policy store should be evicted")
+	_, _, ok = cache.get("b")
+	assert.True(ok, "policy store should be cached")
+	_, _, ok = cache.get("c")
+	assert.True(ok, "policy store should be cached")
+
+	var nilCache *policyStoreCache
+	nilCache.put("a", &azauthzen.PolicyStore{}, nil)
+	_, _, ok = nilCache.get("a")
+	assert.False(ok, "nil cache should cache nothing")
+}
+
+// TestAuthorizationCheckLoadPolicyStoreForRefWithCache tests that the policy stores are cached by ledger ref and template links version.
+func TestAuthorizationCheckLoadPolicyStoreForRefWithCache(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, _, _, mockSQLRepo, _, sqlDB, _ := createSQLitePDPCentralStorageWithMocks()
+	zoneID := int64(232956849236)
+	ledgerID := "c3160a533ab24fbcb1eab7a09fd85f36"
+	ledgerRef := "a1b2c3"
+
+	cachedPolicyStore := &azauthzen.PolicyStore{}
+	storage.policyStores.put(policyStoreCacheKey(zoneID, ledgerID, ledgerRef, "1:2024-01-01"), cachedPolicyStore, nil)
+
+	mockSQLRepo.On("FetchTemplateLinksVersion", sqlDB, zoneID, ledgerID).Return("1:2024-01-01", nil).Once()
+	policyStore, _, err := authorizationCheckLoadPolicyStoreForRef(context.Background(), storage, sqlDB, zoneID, ledgerID, ledgerRef)
+	assert.Nil(err, "error should be nil")
+	assert.Same(cachedPolicyStore, policyStore, "cached policy store should be returned")
+
+	// A new template link changes the version, hence the policy store is built again.
+	mockSQLRepo.On("FetchTemplateLinksVersion", sqlDB, zoneID, ledgerID).Return("2:2024-01-02", nil).Once()
+	mockSQLRepo.On("GetKeyValue", sqlDB, zoneID, ledgerRef).Return(nil, errors.New("READ-ERROR")).Maybe()
+	policyStore, _, err = authorizationCheckLoadPolicyStoreForRef(context.Background(), storage, sqlDB, zoneID, ledgerID, ledgerRef)
+	assert.NotNil(err, "error should not be nil")
+	assert.Nil(policyStore, "policy store should be nil")
+	mockSQLRepo.AssertExpectations(t)
+}
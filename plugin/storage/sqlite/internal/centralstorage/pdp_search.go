@@ -0,0 +1,78 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// FetchSubjectCandidates fetches the identities of the zone as subject candidates.
+func (s SQLiteCentralStoragePDP) FetchSubjectCandidates(ctx context.Context, page int32, pageSize int32, zoneID int64) ([]azmodelspdp.Subject, error) {
+	if page <= 0 || pageSize <= 0 || pageSize > s.config.GetDataFetchMaxPageSize() {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	_, span := aztelemetry.StartSpan(ctx, "pdp.subject-candidates", attribute.Int64("permguard.zone_id", zoneID))
+	subjects, err := s.fetchSubjectCandidates(page, pageSize, zoneID)
+	aztelemetry.EndSpan(span, err)
+	return subjects, err
+}
+
+// fetchSubjectCandidates fetches the identities of the zone and maps them to subjects.
+func (s SQLiteCentralStoragePDP) fetchSubjectCandidates(page int32, pageSize int32, zoneID int64) ([]azmodelspdp.Subject, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, err
+	}
+	dbIdentities, err := s.sqlRepo.FetchIdentities(db, page, pageSize, zoneID, nil, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	sources := map[string]string{}
+	subjects := make([]azmodelspdp.Subject, len(dbIdentities))
+	for i, identity := range dbIdentities {
+		kind, err := azirepos.ConvertIdentityKindToString(identity.Kind)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, fmt.Sprintf("failed to convert identity entity (%s)", azirepos.LogIdentityEntry(&identity)), err)
+		}
+		source, ok := sources[identity.IdentitySourceID]
+		if !ok {
+			identitySourceID := identity.IdentitySourceID
+			dbIdentitySources, err := s.sqlRepo.FetchIdentitySources(db, 1, 1, zoneID, &identitySourceID, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			if len(dbIdentitySources) == 1 {
+				source = dbIdentitySources[0].Name
+			}
+			sources[identitySourceID] = source
+		}
+		subjects[i] = azmodelspdp.Subject{
+			Type:   kind,
+			ID:     identity.Name,
+			Source: source,
+		}
+	}
+	return subjects, nil
+}
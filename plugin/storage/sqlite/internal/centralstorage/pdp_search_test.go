@@ -0,0 +1,105 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	azrtmmocks "github.com/permguard/permguard/pkg/agents/runtime/mocks"
+	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+	azmocks "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/testutils/mocks"
+)
+
+// createSQLitePDPCentralStorageWithMocks creates a new SQLiteCentralStoragePDP with mocks.
+func createSQLitePDPCentralStorageWithMocks() (*SQLiteCentralStoragePDP, *azstorage.StorageContext, *azmocks.MockSQLiteConnector, *azmocks.MockSqliteRepo, *azmocks.MockSqliteExecutor, *sqlx.DB, sqlmock.Sqlmock) {
+	mockRuntimeCtx := azrtmmocks.NewRuntimeContextMock(nil, nil)
+	mockStorageCtx, _ := azstorage.NewStorageContext(mockRuntimeCtx, azstorage.StorageSQLite)
+	mockConnector := azmocks.NewMockSQLiteConnector()
+	mockSQLRepo := azmocks.NewMockSqliteRepo()
+	mockSQLExec := azmocks.NewMockSqliteExecutor()
+	storage, _ := newSQLitePDPCentralStorage(mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec)
+	sqlDB, sqlMock, _ := sqlmock.New()
+	sqlxDB := sqlx.NewDb(sqlDB, "sqlite3")
+	return storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlxDB, sqlMock
+}
+
+// TestFetchSubjectCandidatesWithErrors tests the FetchSubjectCandidates function with errors.
+func TestFetchSubjectCandidatesWithErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with invalid page
+		storage, _, _, _, _, _, _ := createSQLitePDPCentralStorageWithMocks()
+		subjects, err := storage.FetchSubjectCandidates(context.Background(), 0, 100, 232956849236)
+		assert.Nil(subjects, "subjects should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
+	}
+
+	{ // Test with connection error
+		storage, mockStorageCtx, mockConnector, _, mockSQLExec, _, _ := createSQLitePDPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(nil, errors.New("CONNECT-ERROR"))
+		subjects, err := storage.FetchSubjectCandidates(context.Background(), 1, 100, 232956849236)
+		assert.Nil(subjects, "subjects should be nil")
+		assert.NotNil(err, "error should not be nil")
+	}
+
+	{ // Test with server error
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePDPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLRepo.On("FetchIdentities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
+		subjects, err := storage.FetchSubjectCandidates(context.Background(), 1, 100, 232956849236)
+		assert.Nil(subjects, "subjects should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrServerGeneric, err), "error should be errservergeneric")
+	}
+}
+
+// TestFetchSubjectCandidatesWithSuccess tests the FetchSubjectCandidates function with success.
+func TestFetchSubjectCandidatesWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLitePDPCentralStorageWithMocks()
+
+	identitySourceID := azirepos.GenerateUUID()
+	dbOutIdentities := []azirepos.Identity{
+		{IdentityID: azirepos.GenerateUUID(), ZoneID: 232956849236, IdentitySourceID: identitySourceID, Kind: 1, Name: "nicola.gallo"},
+		{IdentityID: azirepos.GenerateUUID(), ZoneID: 232956849236, IdentitySourceID: identitySourceID, Kind: 2, Name: "platform-admin"},
+	}
+	dbOutIdentitySources := []azirepos.IdentitySource{
+		{IdentitySourceID: identitySourceID, ZoneID: 232956849236, Name: "keycloak"},
+	}
+
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLRepo.On("FetchIdentities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutIdentities, nil)
+	mockSQLRepo.On("FetchIdentitySources", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutIdentitySources, nil).Once()
+
+	subjects, err := storage.FetchSubjectCandidates(context.Background(), 1, 100, 232956849236)
+	assert.Nil(err, "error should be nil")
+	assert.Len(subjects, 2, "subjects should have the same length of the identities")
+	assert.Equal("user", subjects[0].Type, "subject type should be user")
+	assert.Equal("nicola.gallo", subjects[0].ID, "subject id should be the identity name")
+	assert.Equal("keycloak", subjects[0].Source, "subject source should be the identity source name")
+	assert.Equal("role-actor", subjects[1].Type, "subject type should be role-actor")
+	mockSQLRepo.AssertNumberOfCalls(t, "FetchIdentitySources", 1)
+}
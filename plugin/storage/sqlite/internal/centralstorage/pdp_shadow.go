@@ -0,0 +1,153 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azmodelspdp "github.com/permguard/permguard/pkg/transport/models/pdp"
+)
+
+const (
+	// shadowQueueSize is the maximum number of shadow evaluations waiting to be evaluated.
+	shadowQueueSize = 1024
+	// shadowWorkers is the number of workers evaluating the shadow evaluations.
+	shadowWorkers = 2
+)
+
+// authorizationCheckShadowJob is a shadow evaluation queued to be compared with the active decision.
+type authorizationCheckShadowJob struct {
+	ctx              context.Context
+	zoneID           int64
+	ledgerID         string
+	languageAbs      azlang.LanguageAbastraction
+	authzPolicyStore *azauthzen.PolicyStore
+	shadow           *authorizationCheckShadow
+	expandedRequest  azmodelspdp.EvaluationRequest
+	authzCtx         *azauthzen.AuthorizationModel
+	activeDecision   bool
+}
+
+// shadowEvaluator evaluates the shadow evaluations off the request path, the evaluations are dropped when the queue is full.
+type shadowEvaluator struct {
+	once     sync.Once
+	jobs     chan authorizationCheckShadowJob
+	workers  int
+	evaluate func(job *authorizationCheckShadowJob)
+}
+
+// newShadowEvaluator creates a new shadow evaluator, the workers are started with the first queued evaluation.
+func newShadowEvaluator(queueSize int, workers int, evaluate func(job *authorizationCheckShadowJob)) *shadowEvaluator {
+	return &shadowEvaluator{
+		jobs:     make(chan authorizationCheckShadowJob, queueSize),
+		workers:  max(1, workers),
+		evaluate: evaluate,
+	}
+}
+
+// enqueue queues the shadow evaluation without blocking, it returns false if the evaluation has been dropped.
+func (e *shadowEvaluator) enqueue(job authorizationCheckShadowJob) bool {
+	if e == nil {
+		return false
+	}
+	e.once.Do(func() {
+		for range e.workers {
+			go func() {
+				for job := range e.jobs {
+					e.evaluate(&job)
+				}
+			}()
+		}
+	})
+	select {
+	case e.jobs <- job:
+		return true
+	default:
+		return false
+	}
+}
+
+// authorizationCheckQueueShadow queues the evaluation of a single expanded request against the candidate policy store.
+func authorizationCheckQueueShadow(ctx context.Context, s *SQLiteCentralStoragePDP, languageAbs azlang.LanguageAbastraction, authzPolicyStore *azauthzen.PolicyStore, shadow *authorizationCheckShadow, request *azmodelspdp.AuthorizationCheckRequest, expandedRequest *azmodelspdp.EvaluationRequest, entities *azmodelspdp.Entities, evaluation *azmodelspdp.EvaluationResponse) {
+	job := authorizationCheckShadowJob{
+		ctx:              context.WithoutCancel(ctx),
+		zoneID:           request.AuthorizationModel.ZoneID,
+		ledgerID:         request.AuthorizationModel.PolicyStore.ID,
+		languageAbs:      languageAbs,
+		authzPolicyStore: authzPolicyStore,
+		shadow:           shadow,
+		expandedRequest:  *expandedRequest,
+		authzCtx:         authorizationCheckBuildModel(expandedRequest, entities),
+		activeDecision:   evaluation.Decision,
+	}
+	if !s.shadows.enqueue(job) {
+		aztelemetry.ObservePDPShadowEvaluation(job.zoneID, job.ledgerID, aztelemetry.ShadowDropped)
+	}
+}
+
+// authorizationCheckEvaluateShadow evaluates a queued request against the candidate policy store and records the disagreements with the active decision.
+func authorizationCheckEvaluateShadow(s *SQLiteCentralStoragePDP, job *authorizationCheckShadowJob) {
+	zoneID := job.zoneID
+	ledgerID := job.ledgerID
+	shadow := job.shadow
+	expandedRequest := &job.expandedRequest
+	_, shadowSpan := aztelemetry.StartSpan(job.ctx, "pdp.shadow-evaluation", attribute.String("permguard.request_id", expandedRequest.RequestID))
+	candidateDecision, candidatePolicies, err := authorizationCheckExplain(shadow.languageAbs, shadow.policyStore, expandedRequest.ContextID, job.authzCtx)
+	aztelemetry.EndSpan(shadowSpan, err)
+	logger := s.ctx.GetLogger()
+	if err != nil {
+		aztelemetry.ObservePDPShadowEvaluation(zoneID, ledgerID, aztelemetry.ShadowError)
+		if logger != nil {
+			logger.Debug(s.ctx.GetLogMessage(fmt.Sprintf("PDP shadow evaluation failed for the candidate ref %s", shadow.candidateRef)), zap.Error(err))
+		}
+		return
+	}
+	if candidateDecision == job.activeDecision {
+		aztelemetry.ObservePDPShadowEvaluation(zoneID, ledgerID, aztelemetry.ShadowAgreement)
+		return
+	}
+	aztelemetry.ObservePDPShadowEvaluation(zoneID, ledgerID, aztelemetry.ShadowDisagreement)
+	if logger == nil {
+		return
+	}
+	_, activePolicies, err := authorizationCheckExplain(job.languageAbs, job.authzPolicyStore, expandedRequest.ContextID, job.authzCtx)
+	if err != nil {
+		activePolicies = []string{}
+	}
+	logger.Warn(s.ctx.GetLogMessage("PDP shadow evaluation disagreement"),
+		zap.Int64("zone_id", zoneID),
+		zap.String("ledger_id", ledgerID),
+		zap.String("request_id", expandedRequest.RequestID),
+		zap.String("subject", fmt.Sprintf("%s::%s", expandedRequest.Subject.Type, expandedRequest.Subject.ID)),
+		zap.String("action", expandedRequest.Action.Name),
+		zap.String("resource", fmt.Sprintf("%s::%s", expandedRequest.Resource.Type, expandedRequest.Resource.ID)),
+		zap.String("active_ref", shadow.activeRef),
+		zap.Bool("active_decision", job.activeDecision),
+		zap.Strings("active_policies", activePolicies),
+		zap.String("candidate_ref", shadow.candidateRef),
+		zap.Bool("candidate_decision", candidateDecision),
+		zap.Strings("candidate_policies", candidatePolicies),
+	)
+}
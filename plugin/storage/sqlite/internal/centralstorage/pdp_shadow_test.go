@@ -0,0 +1,45 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestShadowEvaluatorWithFullQueue tests that the shadow evaluations are dropped without blocking when the queue is full.
+func TestShadowEvaluatorWithFullQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan string, 2)
+	release := make(chan struct{})
+	evaluator := newShadowEvaluator(1, 1, func(job *authorizationCheckShadowJob) {
+		started <- job.ledgerID
+		<-release
+	})
+
+	assert.True(evaluator.enqueue(authorizationCheckShadowJob{ledgerID: "first"}), "evaluation should be queued")
+	assert.Equal("first", <-started, "first evaluation should be started")
+	assert.True(evaluator.enqueue(authorizationCheckShadowJob{ledgerID: "second"}), "evaluation should be queued")
+	assert.False(evaluator.enqueue(authorizationCheckShadowJob{ledgerID: "third"}), "evaluation should be dropped")
+	close(release)
+	assert.Equal("second", <-started, "second evaluation should be started")
+
+	var nilEvaluator *shadowEvaluator
+	assert.False(nilEvaluator.enqueue(authorizationCheckShadowJob{}), "evaluation should be dropped")
+}
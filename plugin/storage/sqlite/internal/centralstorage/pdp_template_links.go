@@ -0,0 +1,54 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+)
+
+// authorizationCheckLinkTemplates adds to the policy store the policies instantiated from the template links of the ledger.
+func authorizationCheckLinkTemplates(s *SQLiteCentralStoragePDP, db *sqlx.DB, zoneID int64, ledgerID string, languageAbs azlang.LanguageAbastraction, policyStore *azauthzen.PolicyStore) error {
+	linker, ok := languageAbs.(azlang.LanguageTemplateLinker)
+	if !ok {
+		return nil
+	}
+	dbLinks, err := s.sqlRepo.FetchAllTemplateLinks(db, zoneID, ledgerID)
+	if err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "server couldn't load the template links", err)
+	}
+	if len(dbLinks) == 0 {
+		return nil
+	}
+	links := make([]azlang.TemplateLink, len(dbLinks))
+	for i, dbLink := range dbLinks {
+		link, err := mapTemplateLinkToAgentTemplateLink(&dbLink)
+		if err != nil {
+			return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, fmt.Sprintf("failed to convert template link (link id: %s)", dbLink.LinkID), err)
+		}
+		links[i] = *mapAgentTemplateLinkToLanguageTemplateLink(link)
+	}
+	if err := linker.LinkTemplates(policyStore, links); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrLanguangeSemantic, "server couldn't link the policy templates", err)
+	}
+	return nil
+}
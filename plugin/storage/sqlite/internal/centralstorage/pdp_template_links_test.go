@@ -0,0 +1,69 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	azauthzen "github.com/permguard/permguard-ztauthstar/pkg/authzen"
+	azlang "github.com/permguard/permguard/pkg/authz/languages"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// templateLinkerLanguageMock is a language abstraction mock supporting the policy templates.
+type templateLinkerLanguageMock struct {
+	azlang.LanguageAbastraction
+	links []azlang.TemplateLink
+}
+
+// ValidateTemplateLink validates the template link.
+func (l *templateLinkerLanguageMock) ValidateTemplateLink(policyStore *azauthzen.PolicyStore, link *azlang.TemplateLink) error {
+	return nil
+}
+
+// LinkTemplates records the template links.
+func (l *templateLinkerLanguageMock) LinkTemplates(policyStore *azauthzen.PolicyStore, links []azlang.TemplateLink) error {
+	l.links = links
+	return nil
+}
+
+// TestAuthorizationCheckLinkTemplates tests the linking of the templates of the ledger.
+func TestAuthorizationCheckLinkTemplates(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, _, _, mockSQLRepo, _, sqlDB, _ := createSQLitePDPCentralStorageWithMocks()
+	zoneID := int64(232956849236)
+	ledgerID := "c3160a533ab24fbcb1eab7a09fd85f36"
+
+	mockSQLRepo.On("FetchAllTemplateLinks", sqlDB, zoneID, ledgerID).Return([]azirepos.TemplateLink{
+		{ZoneID: zoneID, LedgerID: ledgerID, LinkID: "share", TemplateID: "share-document", Slots: `{"?principal":{"type":"User","id":"y"},"?resource":{"type":"Document","id":"x"}}`},
+	}, nil)
+
+	language := &templateLinkerLanguageMock{}
+	err := authorizationCheckLinkTemplates(storage, sqlDB, zoneID, ledgerID, language, &azauthzen.PolicyStore{})
+	assert.Nil(err, "error should be nil")
+	assert.Len(language.links, 1, "template links should be linked")
+	assert.Equal("share-document", language.links[0].TemplateID, "template id should be equal")
+	assert.Equal(azlang.TemplateLinkEntity{Type: "Document", ID: "x"}, language.links[0].Slots["?resource"], "slot entity should be equal")
+
+	// Languages without templates support are not linked.
+	err = authorizationCheckLinkTemplates(storage, sqlDB, zoneID, ledgerID, nil, &azauthzen.PolicyStore{})
+	assert.Nil(err, "error should be nil")
+	mockSQLRepo.AssertNumberOfCalls(t, "FetchAllTemplateLinks", 1)
+}
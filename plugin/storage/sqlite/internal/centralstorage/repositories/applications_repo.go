@@ -112,7 +112,7 @@ func (r *Repository) DeleteZone(tx *sql.Tx, zoneID int64) (*Zone, error) {
 }
 
 // FetchZones retrieves zones.
-func (r *Repository) FetchZones(db *sqlx.DB, page int32, pageSize int32, filterID *int64, filterName *string) ([]Zone, error) {
+func (r *Repository) FetchZones(db *sqlx.DB, page int32, pageSize int32, filterID *int64, filterName *string, options *FetchOptions) ([]Zone, error) {
 	if page <= 0 || pageSize <= 0 {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
 	}
@@ -141,19 +141,24 @@ func (r *Repository) FetchZones(db *sqlx.DB, page int32, pageSize int32, filterI
 		args = append(args, zoneName)
 	}
 
+	conditions, args, orderBy, err := applyFetchOptions("zone", "zone_id", options, conditions, args)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(conditions) > 0 {
 		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	baseQuery += " ORDER BY zone_id ASC"
+	baseQuery += orderBy
 
 	limit := pageSize
-	offset := (page - 1) * pageSize
+	offset := options.fetchOffset(page, pageSize)
 	baseQuery += " LIMIT ? OFFSET ?"
 
 	args = append(args, limit, offset)
 
-	err := db.Select(&dbZones, baseQuery, args...)
+	err = db.Select(&dbZones, baseQuery, args...)
 	if err != nil {
 		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve zones - operation 'retrieve-zones' encountered an issue with parameters %v", args), err)
 	}
@@ -337,27 +337,27 @@ func TestRepoFetchZoneWithInvalidInput(t *testing.T) {
 	defer sqlDB.Close()
 
 	{ // Test with invalid page
-		_, err := ledger.FetchZones(sqlDB, 0, 100, nil, nil)
+		_, err := ledger.FetchZones(sqlDB, 0, 100, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid page size
-		_, err := ledger.FetchZones(sqlDB, 1, 0, nil, nil)
+		_, err := ledger.FetchZones(sqlDB, 1, 0, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid zone id
 		zoneID := int64(0)
-		_, err := ledger.FetchZones(sqlDB, 1, 1, &zoneID, nil)
+		_, err := ledger.FetchZones(sqlDB, 1, 1, &zoneID, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
 	}
 
 	{ // Test with invalid zone id
 		zoneName := "@"
-		_, err := ledger.FetchZones(sqlDB, 1, 1, nil, &zoneName)
+		_, err := ledger.FetchZones(sqlDB, 1, 1, nil, &zoneName, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientName, err), "error should be errclientname")
 	}
@@ -380,7 +380,7 @@ func TestRepoFetchZoneWithSuccess(t *testing.T) {
 		WithArgs(sqlZones[0].ZoneID, zoneName, pageSize, page-1).
 		WillReturnRows(sqlZoneRows)
 
-	dbOutZone, err := ledger.FetchZones(sqlDB, page, pageSize, &sqlZones[0].ZoneID, &sqlZones[0].Name)
+	dbOutZone, err := ledger.FetchZones(sqlDB, page, pageSize, &sqlZones[0].ZoneID, &sqlZones[0].Name, nil)
 
 	orderedSQLZones := make([]Zone, len(sqlZones))
 	copy(orderedSQLZones, sqlZones)
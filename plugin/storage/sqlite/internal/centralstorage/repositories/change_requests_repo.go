@@ -0,0 +1,292 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azvalidators "github.com/permguard/permguard/pkg/core/validators"
+)
+
+const (
+	// ChangeRequestType is the type of the change request.
+	ChangeRequestType = "change request"
+	// ChangeRequestReviewerType is the type of the change request reviewer.
+	ChangeRequestReviewerType = "reviewer"
+	// ChangeRequestAuthorType is the type of the change request author.
+	ChangeRequestAuthorType = "author"
+)
+
+const (
+	// ChangeRequestStatusPending is the status of a change request waiting for approvals.
+	ChangeRequestStatusPending = "pending"
+	// ChangeRequestStatusRejected is the status of a rejected change request.
+	ChangeRequestStatusRejected = "rejected"
+	// ChangeRequestStatusApplied is the status of a change request whose commit has been applied to the ledger ref.
+	ChangeRequestStatusApplied = "applied"
+)
+
+const (
+	// ChangeRequestDecisionApprove is the decision of a review approving the change request.
+	ChangeRequestDecisionApprove = "approve"
+	// ChangeRequestDecisionReject is the decision of a review rejecting the change request.
+	ChangeRequestDecisionReject = "reject"
+)
+
+const (
+	// errorMessageChangeRequestInvalidZoneID is the error message change request invalid zone id.
+	errorMessageChangeRequestInvalidZoneID = "invalid client input - zone id is not valid (id: %d)"
+	// errorMessageChangeRequestInvalidID is the error message change request invalid id.
+	errorMessageChangeRequestInvalidID = "invalid client input - change request id is not valid (id: %s)"
+	// changeRequestSelectColumns are the columns selected for the change requests.
+	changeRequestSelectColumns = "change_request_id, created_at, updated_at, zone_id, ledger_id, base_ref, commit_id, author, plan, status, required_approvals"
+	// changeRequestReviewSelectColumns are the columns selected for the change request reviews.
+	changeRequestReviewSelectColumns = "zone_id, change_request_id, reviewer, created_at, decision, comment"
+)
+
+// isValidChangeRequestStatus checks if the change request status is valid.
+func isValidChangeRequestStatus(status string) bool {
+	switch status {
+	case ChangeRequestStatusPending, ChangeRequestStatusRejected, ChangeRequestStatusApplied:
+		return true
+	}
+	return false
+}
+
+// validateChangeRequestID validates the zone id and the id of a change request.
+func validateChangeRequestID(zoneID int64, changeRequestID string) error {
+	if err := azvalidators.ValidateCodeID(ChangeRequestType, zoneID); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageChangeRequestInvalidZoneID, zoneID), err)
+	}
+	if err := azvalidators.ValidateUUID(ChangeRequestType, changeRequestID); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageChangeRequestInvalidID, changeRequestID), err)
+	}
+	return nil
+}
+
+// CreateChangeRequest creates a pending change request.
+func (r *Repository) CreateChangeRequest(tx *sql.Tx, changeRequest *ChangeRequest) (*ChangeRequest, error) {
+	if changeRequest == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - change request data is missing or malformed (%s)", LogChangeRequestEntry(changeRequest)))
+	}
+	if err := azvalidators.ValidateCodeID(ChangeRequestType, changeRequest.ZoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageChangeRequestInvalidZoneID, changeRequest.ZoneID), err)
+	}
+	if err := azvalidators.ValidateUUID(ChangeRequestType, changeRequest.LedgerID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - ledger id is not valid (id: %s)", changeRequest.LedgerID), err)
+	}
+	if err := azvalidators.ValidateSHA256(ChangeRequestType, changeRequest.BaseRef); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - base ref is not valid (ref: %s)", changeRequest.BaseRef), err)
+	}
+	if err := azvalidators.ValidateSHA256(ChangeRequestType, changeRequest.CommitID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - commit id is not valid (id: %s)", changeRequest.CommitID), err)
+	}
+	if err := azvalidators.ValidateIdentityUserName(ChangeRequestAuthorType, changeRequest.Author); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - author is not valid (author: %s)", changeRequest.Author), err)
+	}
+	if changeRequest.RequiredApprovals <= 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - required approvals is not valid (value: %d)", changeRequest.RequiredApprovals))
+	}
+	plan := changeRequest.Plan
+	if strings.TrimSpace(plan) == "" {
+		plan = "[]"
+	}
+
+	changeRequestID := GenerateUUID()
+	_, err := tx.Exec("INSERT INTO change_requests (change_request_id, zone_id, ledger_id, base_ref, commit_id, author, plan, status, required_approvals) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		changeRequestID, changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.BaseRef, changeRequest.CommitID, strings.ToLower(changeRequest.Author), plan, ChangeRequestStatusPending, changeRequest.RequiredApprovals)
+	if err != nil {
+		params := map[string]string{WrapSqlite3ParamForeignKey: "ledger id"}
+		return nil, WrapSqlite3ErrorWithParams(fmt.Sprintf("failed to create change request - operation 'create-change-request' encountered an issue (%s)", LogChangeRequestEntry(changeRequest)), err, params)
+	}
+	return r.ReadChangeRequest(tx, changeRequest.ZoneID, changeRequestID)
+}
+
+// ReadChangeRequest reads a change request within the transaction.
+func (r *Repository) ReadChangeRequest(tx *sql.Tx, zoneID int64, changeRequestID string) (*ChangeRequest, error) {
+	if err := validateChangeRequestID(zoneID, changeRequestID); err != nil {
+		return nil, err
+	}
+	var dbChangeRequest ChangeRequest
+	err := tx.QueryRow("SELECT "+changeRequestSelectColumns+" FROM change_requests WHERE zone_id = ? and change_request_id = ?", zoneID, changeRequestID).Scan(
+		&dbChangeRequest.ChangeRequestID,
+		&dbChangeRequest.CreatedAt,
+		&dbChangeRequest.UpdatedAt,
+		&dbChangeRequest.ZoneID,
+		&dbChangeRequest.LedgerID,
+		&dbChangeRequest.BaseRef,
+		&dbChangeRequest.CommitID,
+		&dbChangeRequest.Author,
+		&dbChangeRequest.Plan,
+		&dbChangeRequest.Status,
+		&dbChangeRequest.RequiredApprovals,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("change request not found (zone_id: %d, change_request_id: %s)", zoneID, changeRequestID), err)
+		}
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve change request - operation 'retrieve-change-request' encountered an issue (id: %s)", changeRequestID), err)
+	}
+	return &dbChangeRequest, nil
+}
+
+// UpdateChangeRequestStatus moves a change request from the current status to the new one.
+func (r *Repository) UpdateChangeRequestStatus(tx *sql.Tx, zoneID int64, changeRequestID string, currentStatus string, newStatus string) (*ChangeRequest, error) {
+	if err := validateChangeRequestID(zoneID, changeRequestID); err != nil {
+		return nil, err
+	}
+	if !isValidChangeRequestStatus(currentStatus) || !isValidChangeRequestStatus(newStatus) {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - change request status is not valid (current: %s, new: %s)", currentStatus, newStatus))
+	}
+	result, err := tx.Exec("UPDATE change_requests SET status = ?, updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE zone_id = ? AND change_request_id = ? AND status = ?",
+		newStatus, zoneID, changeRequestID, currentStatus)
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to update change request status", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to get rows affected for update change request status", err)
+	}
+	if rows != 1 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUpdateConflict, fmt.Sprintf("update failed, change request is not %s (zone_id: %d, change_request_id: %s)", currentStatus, zoneID, changeRequestID))
+	}
+	return r.ReadChangeRequest(tx, zoneID, changeRequestID)
+}
+
+// CreateChangeRequestReview records the review of a change request, each reviewer can review a change request once.
+func (r *Repository) CreateChangeRequestReview(tx *sql.Tx, review *ChangeRequestReview) (*ChangeRequestReview, error) {
+	if review == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - change request review data is missing or malformed (%s)", LogChangeRequestReviewEntry(review)))
+	}
+	if err := validateChangeRequestID(review.ZoneID, review.ChangeRequestID); err != nil {
+		return nil, err
+	}
+	if err := azvalidators.ValidateIdentityUserName(ChangeRequestReviewerType, review.Reviewer); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - reviewer is not valid (reviewer: %s)", review.Reviewer), err)
+	}
+	if review.Decision != ChangeRequestDecisionApprove && review.Decision != ChangeRequestDecisionReject {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - review decision is not valid (decision: %s)", review.Decision))
+	}
+	_, err := tx.Exec("INSERT INTO change_request_reviews (zone_id, change_request_id, reviewer, decision, comment) VALUES (?, ?, ?, ?, ?)",
+		review.ZoneID, review.ChangeRequestID, review.Reviewer, review.Decision, review.Comment)
+	if err != nil {
+		params := map[string]string{WrapSqlite3ParamForeignKey: "change request id"}
+		return nil, WrapSqlite3ErrorWithParams(fmt.Sprintf("failed to create change request review - operation 'create-change-request-review' encountered an issue (%s)", LogChangeRequestReviewEntry(review)), err, params)
+	}
+	var dbReview ChangeRequestReview
+	err = tx.QueryRow("SELECT "+changeRequestReviewSelectColumns+" FROM change_request_reviews WHERE change_request_id = ? and reviewer = ?", review.ChangeRequestID, review.Reviewer).Scan(
+		&dbReview.ZoneID,
+		&dbReview.ChangeRequestID,
+		&dbReview.Reviewer,
+		&dbReview.CreatedAt,
+		&dbReview.Decision,
+		&dbReview.Comment,
+	)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve change request review - operation 'retrieve-created-change-request-review' encountered an issue (%s)", LogChangeRequestReviewEntry(review)), err)
+	}
+	return &dbReview, nil
+}
+
+// CountChangeRequestApprovals counts the distinct reviewers approving a change request.
+func (r *Repository) CountChangeRequestApprovals(tx *sql.Tx, zoneID int64, changeRequestID string) (int32, error) {
+	if err := validateChangeRequestID(zoneID, changeRequestID); err != nil {
+		return 0, err
+	}
+	var approvals int32
+	err := tx.QueryRow("SELECT COUNT(DISTINCT reviewer) FROM change_request_reviews WHERE zone_id = ? AND change_request_id = ? AND decision = ?",
+		zoneID, changeRequestID, ChangeRequestDecisionApprove).Scan(&approvals)
+	if err != nil {
+		return 0, WrapSqlite3Error(fmt.Sprintf("failed to count change request approvals - operation 'count-change-request-approvals' encountered an issue (id: %s)", changeRequestID), err)
+	}
+	return approvals, nil
+}
+
+// FetchChangeRequests retrieves the change requests of a ledger.
+func (r *Repository) FetchChangeRequests(db *sqlx.DB, page int32, pageSize int32, zoneID int64, ledgerID string, filterID *string, filterStatus *string) ([]ChangeRequest, error) {
+	if page <= 0 || pageSize <= 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	if err := azvalidators.ValidateCodeID(ChangeRequestType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageChangeRequestInvalidZoneID, zoneID), err)
+	}
+	if err := azvalidators.ValidateUUID(ChangeRequestType, ledgerID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - ledger id is not valid (id: %s)", ledgerID), err)
+	}
+
+	var dbChangeRequests []ChangeRequest
+
+	baseQuery := "SELECT " + changeRequestSelectColumns + " FROM change_requests"
+	var conditions []string
+	var args []any
+
+	conditions = append(conditions, "zone_id = ?", "ledger_id = ?")
+	args = append(args, zoneID, ledgerID)
+
+	if filterID != nil {
+		changeRequestID := *filterID
+		if err := azvalidators.ValidateUUID(ChangeRequestType, changeRequestID); err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf(errorMessageChangeRequestInvalidID, changeRequestID), err)
+		}
+		conditions = append(conditions, "change_request_id = ?")
+		args = append(args, changeRequestID)
+	}
+
+	if filterStatus != nil {
+		status := *filterStatus
+		if !isValidChangeRequestStatus(status) {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - change request status is not valid (status: %s)", status))
+		}
+		conditions = append(conditions, "status = ?")
+		args = append(args, status)
+	}
+
+	baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+	baseQuery += " ORDER BY created_at ASC, change_request_id ASC"
+
+	limit := pageSize
+	offset := (page - 1) * pageSize
+	baseQuery += " LIMIT ? OFFSET ?"
+
+	args = append(args, limit, offset)
+
+	err := db.Select(&dbChangeRequests, baseQuery, args...)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve change requests - operation 'retrieve-change-requests' encountered an issue with parameters %v", args), err)
+	}
+
+	return dbChangeRequests, nil
+}
+
+// FetchChangeRequestReviews retrieves the reviews of a change request.
+func (r *Repository) FetchChangeRequestReviews(db *sqlx.DB, zoneID int64, changeRequestID string) ([]ChangeRequestReview, error) {
+	if err := validateChangeRequestID(zoneID, changeRequestID); err != nil {
+		return nil, err
+	}
+	var dbReviews []ChangeRequestReview
+	err := db.Select(&dbReviews, "SELECT "+changeRequestReviewSelectColumns+" FROM change_request_reviews WHERE zone_id = ? AND change_request_id = ? ORDER BY created_at ASC, reviewer ASC", zoneID, changeRequestID)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve change request reviews - operation 'retrieve-change-request-reviews' encountered an issue (id: %s)", changeRequestID), err)
+	}
+	return dbReviews, nil
+}
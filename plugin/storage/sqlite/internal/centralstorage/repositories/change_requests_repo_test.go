@@ -0,0 +1,246 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azidbtestutils "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories/testutils"
+)
+
+// registerChangeRequestForMocking registers a change request for mocking.
+func registerChangeRequestForMocking(status string) (*ChangeRequest, *sqlmock.Rows) {
+	changeRequest := &ChangeRequest{
+		ChangeRequestID:   "7d3f0a5b2e8c4b1f9a6d3c2e1f0a9b8c",
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		ZoneID:            581616507495,
+		LedgerID:          "c3160a533ab24fbcb1eab7a09fd85f36",
+		BaseRef:           "007867724d1aa801216d92d8d08ed2269a55e495575aceb1f46cded8594159ee",
+		CommitID:          "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80",
+		Author:            "nicola.gallo@example.com",
+		Plan:              `[{"code_id":"view-branch","code_type":"acpolicy","oid":"4c1a","state":"create"}]`,
+		Status:            status,
+		RequiredApprovals: 2,
+	}
+	sqlRows := sqlmock.NewRows([]string{"change_request_id", "created_at", "updated_at", "zone_id", "ledger_id", "base_ref", "commit_id", "author", "plan", "status", "required_approvals"}).
+		AddRow(changeRequest.ChangeRequestID, changeRequest.CreatedAt, changeRequest.UpdatedAt, changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.BaseRef, changeRequest.CommitID, changeRequest.Author, changeRequest.Plan, changeRequest.Status, changeRequest.RequiredApprovals)
+	return changeRequest, sqlRows
+}
+
+// TestRepoCreateChangeRequestWithInvalidInput tests the creation of a change request with invalid input.
+func TestRepoCreateChangeRequestWithInvalidInput(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, _ := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	tx, _ := sqlDB.Begin()
+
+	changeRequest, _ := registerChangeRequestForMocking(ChangeRequestStatusPending)
+	tests := []*ChangeRequest{
+		nil,
+		{LedgerID: changeRequest.LedgerID, BaseRef: changeRequest.BaseRef, CommitID: changeRequest.CommitID, Author: changeRequest.Author, RequiredApprovals: 1},
+		{ZoneID: changeRequest.ZoneID, LedgerID: "not-a-ledger", BaseRef: changeRequest.BaseRef, CommitID: changeRequest.CommitID, Author: changeRequest.Author, RequiredApprovals: 1},
+		{ZoneID: changeRequest.ZoneID, LedgerID: changeRequest.LedgerID, BaseRef: "invalid", CommitID: changeRequest.CommitID, Author: changeRequest.Author, RequiredApprovals: 1},
+		{ZoneID: changeRequest.ZoneID, LedgerID: changeRequest.LedgerID, BaseRef: changeRequest.BaseRef, CommitID: "invalid", Author: changeRequest.Author, RequiredApprovals: 1},
+		{ZoneID: changeRequest.ZoneID, LedgerID: changeRequest.LedgerID, BaseRef: changeRequest.BaseRef, CommitID: changeRequest.CommitID, Author: "", RequiredApprovals: 1},
+		{ZoneID: changeRequest.ZoneID, LedgerID: changeRequest.LedgerID, BaseRef: changeRequest.BaseRef, CommitID: changeRequest.CommitID, Author: changeRequest.Author, RequiredApprovals: 0},
+	}
+	for _, test := range tests {
+		_, err := repository.CreateChangeRequest(tx, test)
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+}
+
+// TestRepoCreateChangeRequestWithSuccess tests the creation of a change request with success.
+func TestRepoCreateChangeRequestWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	changeRequest, sqlChangeRequestRows := registerChangeRequestForMocking(ChangeRequestStatusPending)
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectExec(regexp.QuoteMeta("INSERT INTO change_requests (change_request_id, zone_id, ledger_id, base_ref, commit_id, author, plan, status, required_approvals) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)")).
+		WithArgs(sqlmock.AnyArg(), changeRequest.ZoneID, changeRequest.LedgerID, changeRequest.BaseRef, changeRequest.CommitID, changeRequest.Author, changeRequest.Plan, ChangeRequestStatusPending, changeRequest.RequiredApprovals).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT change_request_id, created_at, updated_at, zone_id, ledger_id, base_ref, commit_id, author, plan, status, required_approvals FROM change_requests WHERE zone_id = ? and change_request_id = ?")).
+		WithArgs(changeRequest.ZoneID, sqlmock.AnyArg()).
+		WillReturnRows(sqlChangeRequestRows)
+
+	tx, _ := sqlDB.Begin()
+	dbOutChangeRequest, err := repository.CreateChangeRequest(tx, &ChangeRequest{
+		ZoneID:            changeRequest.ZoneID,
+		LedgerID:          changeRequest.LedgerID,
+		BaseRef:           changeRequest.BaseRef,
+		CommitID:          changeRequest.CommitID,
+		Author:            changeRequest.Author,
+		Plan:              changeRequest.Plan,
+		RequiredApprovals: changeRequest.RequiredApprovals,
+	})
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.NotNil(dbOutChangeRequest, "change request should be not nil")
+	assert.Equal(ChangeRequestStatusPending, dbOutChangeRequest.Status, "change request status is not correct")
+	assert.Equal(changeRequest.CommitID, dbOutChangeRequest.CommitID, "change request commit id is not correct")
+}
+
+// TestRepoUpdateChangeRequestStatus tests the update of the status of a change request.
+func TestRepoUpdateChangeRequestStatus(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	changeRequest, sqlChangeRequestRows := registerChangeRequestForMocking(ChangeRequestStatusApplied)
+	sqlUpdate := regexp.QuoteMeta("UPDATE change_requests SET status = ?, updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE zone_id = ? AND change_request_id = ? AND status = ?")
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectExec(sqlUpdate).
+		WithArgs(ChangeRequestStatusApplied, changeRequest.ZoneID, changeRequest.ChangeRequestID, ChangeRequestStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT change_request_id, created_at, updated_at, zone_id, ledger_id, base_ref, commit_id, author, plan, status, required_approvals FROM change_requests WHERE zone_id = ? and change_request_id = ?")).
+		WithArgs(changeRequest.ZoneID, changeRequest.ChangeRequestID).
+		WillReturnRows(sqlChangeRequestRows)
+	sqlDBMock.ExpectExec(sqlUpdate).
+		WithArgs(ChangeRequestStatusRejected, changeRequest.ZoneID, changeRequest.ChangeRequestID, ChangeRequestStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	tx, _ := sqlDB.Begin()
+	{ // Test with invalid status
+		_, err := repository.UpdateChangeRequestStatus(tx, changeRequest.ZoneID, changeRequest.ChangeRequestID, ChangeRequestStatusPending, "merged")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	dbOutChangeRequest, err := repository.UpdateChangeRequestStatus(tx, changeRequest.ZoneID, changeRequest.ChangeRequestID, ChangeRequestStatusPending, ChangeRequestStatusApplied)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(ChangeRequestStatusApplied, dbOutChangeRequest.Status, "change request status is not correct")
+
+	_, err = repository.UpdateChangeRequestStatus(tx, changeRequest.ZoneID, changeRequest.ChangeRequestID, ChangeRequestStatusPending, ChangeRequestStatusRejected)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUpdateConflict, err), "error should be errclientupdateconflict")
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+}
+
+// TestRepoCreateChangeRequestReview tests the creation of a change request review.
+func TestRepoCreateChangeRequestReview(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	changeRequest, _ := registerChangeRequestForMocking(ChangeRequestStatusPending)
+	review := &ChangeRequestReview{
+		ZoneID:          changeRequest.ZoneID,
+		ChangeRequestID: changeRequest.ChangeRequestID,
+		Reviewer:        "amy.smith@example.com",
+		Decision:        ChangeRequestDecisionApprove,
+		Comment:         "looks good",
+	}
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectExec(regexp.QuoteMeta("INSERT INTO change_request_reviews (zone_id, change_request_id, reviewer, decision, comment) VALUES (?, ?, ?, ?, ?)")).
+		WithArgs(review.ZoneID, review.ChangeRequestID, review.Reviewer, review.Decision, review.Comment).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT zone_id, change_request_id, reviewer, created_at, decision, comment FROM change_request_reviews WHERE change_request_id = ? and reviewer = ?")).
+		WithArgs(review.ChangeRequestID, review.Reviewer).
+		WillReturnRows(sqlmock.NewRows([]string{"zone_id", "change_request_id", "reviewer", "created_at", "decision", "comment"}).
+			AddRow(review.ZoneID, review.ChangeRequestID, review.Reviewer, time.Now(), review.Decision, review.Comment))
+
+	tx, _ := sqlDB.Begin()
+	tests := []*ChangeRequestReview{
+		nil,
+		{ZoneID: review.ZoneID, ChangeRequestID: "invalid", Reviewer: review.Reviewer, Decision: ChangeRequestDecisionApprove},
+		{ZoneID: review.ZoneID, ChangeRequestID: review.ChangeRequestID, Reviewer: "", Decision: ChangeRequestDecisionApprove},
+		{ZoneID: review.ZoneID, ChangeRequestID: review.ChangeRequestID, Reviewer: review.Reviewer, Decision: "maybe"},
+	}
+	for _, test := range tests {
+		_, err := repository.CreateChangeRequestReview(tx, test)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	dbOutReview, err := repository.CreateChangeRequestReview(tx, review)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(review.Reviewer, dbOutReview.Reviewer, "reviewer is not correct")
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+}
+
+// TestRepoCountChangeRequestApprovals tests the count of the approvals of a change request.
+func TestRepoCountChangeRequestApprovals(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	changeRequest, _ := registerChangeRequestForMocking(ChangeRequestStatusPending)
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(DISTINCT reviewer) FROM change_request_reviews WHERE zone_id = ? AND change_request_id = ? AND decision = ?")).
+		WithArgs(changeRequest.ZoneID, changeRequest.ChangeRequestID, ChangeRequestDecisionApprove).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	tx, _ := sqlDB.Begin()
+	approvals, err := repository.CountChangeRequestApprovals(tx, changeRequest.ZoneID, changeRequest.ChangeRequestID)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(int32(2), approvals, "approvals are not correct")
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+}
+
+// TestRepoFetchChangeRequestsWithSuccess tests the fetch of the change requests with success.
+func TestRepoFetchChangeRequestsWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, _, sqlDB, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	changeRequest, sqlChangeRequestRows := registerChangeRequestForMocking(ChangeRequestStatusPending)
+
+	page := int32(1)
+	pageSize := int32(100)
+	status := ChangeRequestStatusPending
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT change_request_id, created_at, updated_at, zone_id, ledger_id, base_ref, commit_id, author, plan, status, required_approvals FROM change_requests WHERE zone_id = ? AND ledger_id = ? AND status = ? ORDER BY created_at ASC, change_request_id ASC LIMIT ? OFFSET ?")).
+		WithArgs(changeRequest.ZoneID, changeRequest.LedgerID, status, pageSize, int32(0)).
+		WillReturnRows(sqlChangeRequestRows)
+
+	dbOutChangeRequests, err := repository.FetchChangeRequests(sqlDB, page, pageSize, changeRequest.ZoneID, changeRequest.LedgerID, nil, &status)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Len(dbOutChangeRequests, 1, "change requests should be one")
+
+	_, err = repository.FetchChangeRequests(sqlDB, 0, pageSize, changeRequest.ZoneID, changeRequest.LedgerID, nil, nil)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
+
+	invalidStatus := "merged"
+	_, err = repository.FetchChangeRequests(sqlDB, page, pageSize, changeRequest.ZoneID, changeRequest.LedgerID, nil, &invalidStatus)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+}
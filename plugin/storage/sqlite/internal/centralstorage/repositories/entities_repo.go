@@ -0,0 +1,236 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azvalidators "github.com/permguard/permguard/pkg/core/validators"
+)
+
+const (
+	// EntityType is the type of the entity.
+	EntityType = "entity"
+	// entityMaxLength is the max length of the type and of the id of an entity.
+	entityMaxLength = 254
+	// entitiesFetchBatchSize is the max number of entities fetched by uids in a single query.
+	entitiesFetchBatchSize = 200
+)
+
+const (
+	// errorMessageEntityInvalidZoneID is the error message entity invalid zone id.
+	errorMessageEntityInvalidZoneID = "invalid client input - zone id is not valid (id: %d)"
+	// entitySelectColumns are the columns selected for the entities.
+	entitySelectColumns = "zone_id, entity_type, entity_id, created_at, updated_at, attributes, parents"
+)
+
+// validateEntityUID validates the type and the id of an entity.
+func validateEntityUID(entityType, entityID string) error {
+	if strings.TrimSpace(entityType) == "" || len(entityType) > entityMaxLength {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - entity type is not valid (type: %s)", entityType))
+	}
+	if strings.TrimSpace(entityID) == "" || len(entityID) > entityMaxLength {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - entity id is not valid (id: %s)", entityID))
+	}
+	return nil
+}
+
+// validateEntity validates an entity.
+func validateEntity(entity *Entity) error {
+	if entity == nil {
+		return azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - entity data is missing or malformed (%s)", LogEntityEntry(entity)))
+	}
+	if err := azvalidators.ValidateCodeID(EntityType, entity.ZoneID); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageEntityInvalidZoneID, entity.ZoneID), err)
+	}
+	return validateEntityUID(entity.EntityType, entity.EntityID)
+}
+
+// readEntity reads an entity.
+func readEntity(tx *sql.Tx, zoneID int64, entityType, entityID string) (*Entity, error) {
+	var dbEntity Entity
+	err := tx.QueryRow("SELECT "+entitySelectColumns+" FROM entities WHERE zone_id = ? and entity_type = ? and entity_id = ?", zoneID, entityType, entityID).Scan(
+		&dbEntity.ZoneID,
+		&dbEntity.EntityType,
+		&dbEntity.EntityID,
+		&dbEntity.CreatedAt,
+		&dbEntity.UpdatedAt,
+		&dbEntity.Attributes,
+		&dbEntity.Parents,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &dbEntity, nil
+}
+
+// UpsertEntity creates or updates an entity.
+func (r *Repository) UpsertEntity(tx *sql.Tx, isCreate bool, entity *Entity) (*Entity, error) {
+	if err := validateEntity(entity); err != nil {
+		return nil, err
+	}
+	var result sql.Result
+	var err error
+	if isCreate {
+		result, err = tx.Exec("INSERT INTO entities (zone_id, entity_type, entity_id, attributes, parents) VALUES (?, ?, ?, ?, ?)",
+			entity.ZoneID, entity.EntityType, entity.EntityID, entity.Attributes, entity.Parents)
+	} else {
+		result, err = tx.Exec("UPDATE entities SET attributes = ?, parents = ?, updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE zone_id = ? and entity_type = ? and entity_id = ?",
+			entity.Attributes, entity.Parents, entity.ZoneID, entity.EntityType, entity.EntityID)
+	}
+	if err != nil || result == nil {
+		action := "update"
+		if isCreate {
+			action = "create"
+		}
+		params := map[string]string{WrapSqlite3ParamForeignKey: "zone id"}
+		return nil, WrapSqlite3ErrorWithParams(fmt.Sprintf("failed to %s entity - operation '%s-entity' encountered an issue (%s)", action, action, LogEntityEntry(entity)), err, params)
+	}
+	if !isCreate {
+		rows, err := result.RowsAffected()
+		if err != nil || rows != 1 {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("failed to update entity - operation 'update-entity' could not find the entity (%s)", LogEntityEntry(entity)))
+		}
+	}
+	dbEntity, err := readEntity(tx, entity.ZoneID, entity.EntityType, entity.EntityID)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve entity - operation 'retrieve-upserted-entity' encountered an issue (%s)", LogEntityEntry(entity)), err)
+	}
+	return dbEntity, nil
+}
+
+// UpsertEntities creates or replaces a set of entities.
+func (r *Repository) UpsertEntities(tx *sql.Tx, entities []Entity) ([]Entity, error) {
+	dbEntities := make([]Entity, 0, len(entities))
+	for i := range entities {
+		entity := &entities[i]
+		if err := validateEntity(entity); err != nil {
+			return nil, err
+		}
+		_, err := tx.Exec(`INSERT INTO entities (zone_id, entity_type, entity_id, attributes, parents) VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT(zone_id, entity_type, entity_id) DO UPDATE SET attributes = excluded.attributes, parents = excluded.parents, updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')`,
+			entity.ZoneID, entity.EntityType, entity.EntityID, entity.Attributes, entity.Parents)
+		if err != nil {
+			params := map[string]string{WrapSqlite3ParamForeignKey: "zone id"}
+			return nil, WrapSqlite3ErrorWithParams(fmt.Sprintf("failed to upsert entity - operation 'bulk-upsert-entity' encountered an issue (%s)", LogEntityEntry(entity)), err, params)
+		}
+		dbEntity, err := readEntity(tx, entity.ZoneID, entity.EntityType, entity.EntityID)
+		if err != nil {
+			return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve entity - operation 'retrieve-upserted-entity' encountered an issue (%s)", LogEntityEntry(entity)), err)
+		}
+		dbEntities = append(dbEntities, *dbEntity)
+	}
+	return dbEntities, nil
+}
+
+// DeleteEntity deletes an entity.
+func (r *Repository) DeleteEntity(tx *sql.Tx, zoneID int64, entityType, entityID string) (*Entity, error) {
+	if err := azvalidators.ValidateCodeID(EntityType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageEntityInvalidZoneID, zoneID), err)
+	}
+	if err := validateEntityUID(entityType, entityID); err != nil {
+		return nil, err
+	}
+	dbEntity, err := readEntity(tx, zoneID, entityType, entityID)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("invalid client input - entity is not valid (type: %s, id: %s)", entityType, entityID), err)
+	}
+	res, err := tx.Exec("DELETE FROM entities WHERE zone_id = ? and entity_type = ? and entity_id = ?", zoneID, entityType, entityID)
+	if err != nil || res == nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to delete entity - operation 'delete-entity' encountered an issue (type: %s, id: %s)", entityType, entityID), err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil || rows != 1 {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to delete entity - operation 'delete-entity' could not find the entity (type: %s, id: %s)", entityType, entityID), err)
+	}
+	return dbEntity, nil
+}
+
+// FetchEntities retrieves entities.
+func (r *Repository) FetchEntities(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterType *string, filterID *string) ([]Entity, error) {
+	if page <= 0 || pageSize <= 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	if err := azvalidators.ValidateCodeID(EntityType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf(errorMessageEntityInvalidZoneID, zoneID), err)
+	}
+
+	var dbEntities []Entity
+
+	baseQuery := "SELECT " + entitySelectColumns + " FROM entities"
+	var conditions []string
+	var args []any
+
+	conditions = append(conditions, "zone_id = ?")
+	args = append(args, zoneID)
+
+	if filterType != nil {
+		conditions = append(conditions, "entity_type = ?")
+		args = append(args, *filterType)
+	}
+
+	if filterID != nil {
+		conditions = append(conditions, "entity_id = ?")
+		args = append(args, *filterID)
+	}
+
+	baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+	baseQuery += " ORDER BY entity_type ASC, entity_id ASC"
+
+	limit := pageSize
+	offset := (page - 1) * pageSize
+	baseQuery += " LIMIT ? OFFSET ?"
+
+	args = append(args, limit, offset)
+
+	err := db.Select(&dbEntities, baseQuery, args...)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve entities - operation 'retrieve-entities' encountered an issue with parameters %v", args), err)
+	}
+
+	return dbEntities, nil
+}
+
+// FetchEntitiesByUIDs retrieves the entities matching the uids.
+func (r *Repository) FetchEntitiesByUIDs(db *sqlx.DB, zoneID int64, uids []EntityUID) ([]Entity, error) {
+	if err := azvalidators.ValidateCodeID(EntityType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf(errorMessageEntityInvalidZoneID, zoneID), err)
+	}
+	dbEntities := []Entity{}
+	for start := 0; start < len(uids); start += entitiesFetchBatchSize {
+		end := min(start+entitiesFetchBatchSize, len(uids))
+		var conditions []string
+		args := []any{zoneID}
+		for _, uid := range uids[start:end] {
+			conditions = append(conditions, "(entity_type = ? AND entity_id = ?)")
+			args = append(args, uid.EntityType, uid.EntityID)
+		}
+		query := "SELECT " + entitySelectColumns + " FROM entities WHERE zone_id = ? AND (" + strings.Join(conditions, " OR ") + ")"
+		var batch []Entity
+		if err := db.Select(&batch, query, args...); err != nil {
+			return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve entities - operation 'retrieve-entities-by-uids' encountered an issue with parameters %v", args), err)
+		}
+		dbEntities = append(dbEntities, batch...)
+	}
+	return dbEntities, nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azidbtestutils "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories/testutils"
+)
+
+// registerEntityForMocking registers an entity for mocking.
+func registerEntityForMocking() (*Entity, *sqlmock.Rows) {
+	entity := &Entity{
+		ZoneID:     581616507495,
+		EntityType: "MagicFarmacia::Platform::Branch",
+		EntityID:   "milan",
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Attributes: `{"city":"milan"}`,
+		Parents:    `[{"type":"MagicFarmacia::Platform::Account","id":"acme"}]`,
+	}
+	sqlRows := sqlmock.NewRows([]string{"zone_id", "entity_type", "entity_id", "created_at", "updated_at", "attributes", "parents"}).
+		AddRow(entity.ZoneID, entity.EntityType, entity.EntityID, entity.CreatedAt, entity.UpdatedAt, entity.Attributes, entity.Parents)
+	return entity, sqlRows
+}
+
+// TestRepoUpsertEntityWithInvalidInput tests the upsert of an entity with invalid input.
+func TestRepoUpsertEntityWithInvalidInput(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, _ := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	tx, _ := sqlDB.Begin()
+
+	{ // Test with nil entity
+		_, err := repository.UpsertEntity(tx, true, nil)
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with invalid zone id
+		_, err := repository.UpsertEntity(tx, true, &Entity{EntityType: "Branch", EntityID: "milan"})
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with invalid entity type and id
+		tests := []Entity{
+			{ZoneID: 581616507495, EntityType: " ", EntityID: "milan"},
+			{ZoneID: 581616507495, EntityType: "Branch", EntityID: ""},
+		}
+		for _, test := range tests {
+			_, err := repository.UpsertEntity(tx, false, &test)
+			assert.NotNil(err, "error should be not nil")
+			assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+		}
+	}
+}
+
+// TestRepoUpsertEntityWithSuccess tests the upsert of an entity with success.
+func TestRepoUpsertEntityWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	tests := []bool{
+		true,
+		false,
+	}
+	for _, isCreate := range tests {
+		_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+		defer sqlDB.Close()
+
+		entity, sqlEntityRows := registerEntityForMocking()
+
+		sqlDBMock.ExpectBegin()
+		if isCreate {
+			sqlDBMock.ExpectExec(`INSERT INTO entities \(zone_id, entity_type, entity_id, attributes, parents\) VALUES \(\?, \?, \?, \?, \?\)`).
+				WithArgs(entity.ZoneID, entity.EntityType, entity.EntityID, entity.Attributes, entity.Parents).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+		} else {
+			sqlDBMock.ExpectExec(`UPDATE entities SET attributes = \?, parents = \?`).
+				WithArgs(entity.Attributes, entity.Parents, entity.ZoneID, entity.EntityType, entity.EntityID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+		}
+		sqlDBMock.ExpectQuery(`SELECT zone_id, entity_type, entity_id, created_at, updated_at, attributes, parents FROM entities WHERE zone_id = \? and entity_type = \? and entity_id = \?`).
+			WithArgs(entity.ZoneID, entity.EntityType, entity.EntityID).
+			WillReturnRows(sqlEntityRows)
+
+		tx, _ := sqlDB.Begin()
+		dbOutEntity, err := repository.UpsertEntity(tx, isCreate, &Entity{
+			ZoneID:     entity.ZoneID,
+			EntityType: entity.EntityType,
+			EntityID:   entity.EntityID,
+			Attributes: entity.Attributes,
+			Parents:    entity.Parents,
+		})
+
+		assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+		assert.Nil(err, "error should be nil")
+		assert.NotNil(dbOutEntity, "entity should be not nil")
+		assert.Equal(entity.EntityType, dbOutEntity.EntityType, "entity type is not correct")
+		assert.Equal(entity.Parents, dbOutEntity.Parents, "entity parents are not correct")
+	}
+}
+
+// TestRepoUpdateEntityNotFound tests the update of a missing entity.
+func TestRepoUpdateEntityNotFound(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	entity, _ := registerEntityForMocking()
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectExec(`UPDATE entities SET attributes = \?, parents = \?`).
+		WithArgs(entity.Attributes, entity.Parents, entity.ZoneID, entity.EntityType, entity.EntityID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	tx, _ := sqlDB.Begin()
+	dbOutEntity, err := repository.UpsertEntity(tx, false, entity)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(dbOutEntity, "entity should be nil")
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientNotFound, err), "error should be errclientnotfound")
+}
+
+// TestRepoDeleteEntityWithSuccess tests the delete of an entity with success.
+func TestRepoDeleteEntityWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	entity, sqlEntityRows := registerEntityForMocking()
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectQuery(`SELECT zone_id, entity_type, entity_id, created_at, updated_at, attributes, parents FROM entities WHERE zone_id = \? and entity_type = \? and entity_id = \?`).
+		WithArgs(entity.ZoneID, entity.EntityType, entity.EntityID).
+		WillReturnRows(sqlEntityRows)
+	sqlDBMock.ExpectExec(`DELETE FROM entities WHERE zone_id = \? and entity_type = \? and entity_id = \?`).
+		WithArgs(entity.ZoneID, entity.EntityType, entity.EntityID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	tx, _ := sqlDB.Begin()
+	dbOutEntity, err := repository.DeleteEntity(tx, entity.ZoneID, entity.EntityType, entity.EntityID)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.NotNil(dbOutEntity, "entity should be not nil")
+	assert.Equal(entity.EntityID, dbOutEntity.EntityID, "entity id should be correct")
+}
+
+// TestRepoFetchEntitiesWithSuccess tests the fetch of entities with success.
+func TestRepoFetchEntitiesWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	{ // Test with invalid page
+		_, err := repository.FetchEntities(sqlDB, 0, 100, 581616507495, nil, nil)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
+	}
+
+	entity, sqlEntityRows := registerEntityForMocking()
+	sqlSelect := "SELECT zone_id, entity_type, entity_id, created_at, updated_at, attributes, parents FROM entities WHERE zone_id = ? AND entity_type = ? ORDER BY entity_type ASC, entity_id ASC LIMIT ? OFFSET ?"
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).
+		WithArgs(entity.ZoneID, entity.EntityType, int32(10), int32(0)).
+		WillReturnRows(sqlEntityRows)
+
+	dbOutEntities, err := repository.FetchEntities(sqlDB, 1, 10, entity.ZoneID, &entity.EntityType, nil)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Len(dbOutEntities, 1, "entities should be fetched")
+}
+
+// TestRepoFetchEntitiesByUIDsWithSuccess tests the fetch of entities by uids with success.
+func TestRepoFetchEntitiesByUIDsWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	entity, sqlEntityRows := registerEntityForMocking()
+	sqlSelect := "SELECT zone_id, entity_type, entity_id, created_at, updated_at, attributes, parents FROM entities WHERE zone_id = ? AND ((entity_type = ? AND entity_id = ?) OR (entity_type = ? AND entity_id = ?))"
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).
+		WithArgs(entity.ZoneID, entity.EntityType, entity.EntityID, "Permguard::IAM::User", "alice").
+		WillReturnRows(sqlEntityRows)
+
+	uids := []EntityUID{
+		{EntityType: entity.EntityType, EntityID: entity.EntityID},
+		{EntityType: "Permguard::IAM::User", EntityID: "alice"},
+	}
+	dbOutEntities, err := repository.FetchEntitiesByUIDs(sqlDB, entity.ZoneID, uids)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Len(dbOutEntities, 1, "entities should be fetched")
+}
@@ -22,6 +22,8 @@ import (
 
 	"github.com/mattn/go-sqlite3"
 
+	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 )
 
@@ -29,6 +31,22 @@ const (
 	WrapSqlite3ParamForeignKey = "foreign-key"
 )
 
+const (
+	// storageErrorKindGeneric is the metric label of a generic storage error.
+	storageErrorKindGeneric = "generic"
+	// storageErrorKindForeignKey is the metric label of a foreign key constraint error.
+	storageErrorKindForeignKey = "constraint-foreign-key"
+	// storageErrorKindUnique is the metric label of a unique constraint error.
+	storageErrorKindUnique = "constraint-unique"
+	// storageErrorKindNotFound is the metric label of a not found error.
+	storageErrorKindNotFound = "not-found"
+)
+
+// observeSqlite3Error records the storage error.
+func observeSqlite3Error(kind string) {
+	aztelemetry.ObserveStorageError(azstorage.StorageSQLite.String(), kind)
+}
+
 // WrapSqlite3Error wraps a sqlite3 error.
 func WrapSqlite3Error(msg string, err error) error {
 	return WrapSqlite3ErrorWithParams(msg, err, nil)
@@ -48,24 +66,30 @@ func readErroMapParam(key string, params map[string]string) string {
 func WrapSqlite3ErrorWithParams(msg string, err error, params map[string]string) error {
 	sqliteErr, ok := err.(sqlite3.Error)
 	if !ok {
+		observeSqlite3Error(storageErrorKindGeneric)
 		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, fmt.Sprintf("(%s)", msg), err)
 	}
 	switch sqliteErr.Code {
 	case sqlite3.ErrConstraint:
 		if errors.As(err, &sqliteErr) {
 			if sqliteErr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+				observeSqlite3Error(storageErrorKindForeignKey)
 				foreignKey := readErroMapParam(WrapSqlite3ParamForeignKey, params)
 				if foreignKey != "" {
 					return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageConstraintForeignKey, fmt.Sprintf("%s validation failed: the provided zone id does not exist - %s", foreignKey, msg), err)
 				}
 				return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageConstraintForeignKey, fmt.Sprintf("foreign key constraint failed - %s", msg), err)
 			}
+			observeSqlite3Error(storageErrorKindUnique)
 			return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageConstraintUnique, fmt.Sprintf("unique constraint failed - %s", msg), err)
 		}
+		observeSqlite3Error(storageErrorKindUnique)
 		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageConstraintUnique, fmt.Sprintf("constraint failed - %s", msg), err)
 	case sqlite3.ErrNotFound:
+		observeSqlite3Error(storageErrorKindNotFound)
 		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageNotFound, fmt.Sprintf("record not found - %s", msg), err)
 	default:
+		observeSqlite3Error(storageErrorKindGeneric)
 		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, fmt.Sprintf("generic error (%s)", msg), err)
 	}
 }
@@ -0,0 +1,131 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azvalidators "github.com/permguard/permguard/pkg/core/validators"
+)
+
+const (
+	// FetchSortByID sorts by the identifier column.
+	FetchSortByID = "id"
+	// FetchSortByName sorts by the name column.
+	FetchSortByName = "name"
+	// FetchSortByCreatedAt sorts by the creation time column.
+	FetchSortByCreatedAt = "created_at"
+
+	// fetchTimeLayout is the layout of the timestamps stored by the sqlite triggers and defaults.
+	fetchTimeLayout = "2006-01-02 15:04:05.000"
+)
+
+// FetchOptions are the keyset pagination, sorting and filtering options of a fetch.
+type FetchOptions struct {
+	SortBy        string
+	SortDesc      bool
+	AfterValue    any
+	AfterID       any
+	NamePrefix    *string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// hasCursor returns true if the fetch continues after a cursor.
+func (o *FetchOptions) hasCursor() bool {
+	return o != nil && o.AfterID != nil
+}
+
+// fetchOffset returns the offset of the page, the keyset fetches always start from the cursor.
+func (o *FetchOptions) fetchOffset(page int32, pageSize int32) int32 {
+	if o.hasCursor() {
+		return 0
+	}
+	return (page - 1) * pageSize
+}
+
+// formatFetchValue formats a value to be compared with a column.
+func formatFetchValue(value any) any {
+	if t, ok := value.(time.Time); ok {
+		return t.UTC().Format(fetchTimeLayout)
+	}
+	return value
+}
+
+// escapeLikePattern escapes the wildcards of a like pattern.
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+// applyFetchOptions appends the conditions and arguments of the fetch options and returns the order by clause.
+func applyFetchOptions(entity string, idColumn string, options *FetchOptions, conditions []string, args []any) ([]string, []any, string, error) {
+	if options == nil {
+		return conditions, args, fmt.Sprintf(" ORDER BY %s ASC", idColumn), nil
+	}
+	if options.NamePrefix != nil {
+		namePrefix := *options.NamePrefix
+		if err := azvalidators.ValidateNamePrefix(entity, namePrefix); err != nil {
+			return nil, nil, "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientName, fmt.Sprintf("invalid client input - %s name prefix is not valid (name prefix: %s)", entity, namePrefix), err)
+		}
+		conditions = append(conditions, `name LIKE ? ESCAPE '\'`)
+		args = append(args, escapeLikePattern(namePrefix)+"%")
+	}
+	if options.CreatedAfter != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, formatFetchValue(*options.CreatedAfter))
+	}
+	if options.CreatedBefore != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, formatFetchValue(*options.CreatedBefore))
+	}
+	var sortColumn string
+	switch options.SortBy {
+	case "", FetchSortByID:
+		sortColumn = idColumn
+	case FetchSortByName:
+		sortColumn = "name"
+	case FetchSortByCreatedAt:
+		sortColumn = "created_at"
+	default:
+		return nil, nil, "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - sort key %s is not valid", options.SortBy))
+	}
+	operator, direction := ">", "ASC"
+	if options.SortDesc {
+		operator, direction = "<", "DESC"
+	}
+	if options.hasCursor() {
+		if sortColumn == idColumn {
+			conditions = append(conditions, fmt.Sprintf("%s %s ?", idColumn, operator))
+			args = append(args, options.AfterID)
+		} else {
+			if options.AfterValue == nil {
+				return nil, nil, "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - cursor value is missing")
+			}
+			value := formatFetchValue(options.AfterValue)
+			conditions = append(conditions, fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", sortColumn, operator, sortColumn, idColumn, operator))
+			args = append(args, value, value, options.AfterID)
+		}
+	}
+	orderBy := fmt.Sprintf(" ORDER BY %s %s", sortColumn, direction)
+	if sortColumn != idColumn {
+		orderBy += fmt.Sprintf(", %s %s", idColumn, direction)
+	}
+	return conditions, args, orderBy, nil
+}
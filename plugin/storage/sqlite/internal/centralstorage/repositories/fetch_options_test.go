@@ -0,0 +1,149 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azidbtestutils "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories/testutils"
+)
+
+// TestApplyFetchOptionsWithoutOptions tests that nil options keep the default ordering.
+func TestApplyFetchOptionsWithoutOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	conditions, args, orderBy, err := applyFetchOptions("tenant", "tenant_id", nil, []string{"zone_id = ?"}, []any{int64(1)})
+	assert.Nil(err, "error should be nil")
+	assert.Equal([]string{"zone_id = ?"}, conditions, "conditions should not change")
+	assert.Equal([]any{int64(1)}, args, "args should not change")
+	assert.Equal(" ORDER BY tenant_id ASC", orderBy, "order by is not correct")
+}
+
+// TestApplyFetchOptionsWithFilters tests the name prefix and creation time filters.
+func TestApplyFetchOptionsWithFilters(t *testing.T) {
+	assert := assert.New(t)
+
+	namePrefix := "rent_"
+	after := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	before := after.Add(24 * time.Hour)
+	options := &FetchOptions{
+		NamePrefix:    &namePrefix,
+		CreatedAfter:  &after,
+		CreatedBefore: &before,
+	}
+	conditions, args, orderBy, err := applyFetchOptions("tenant", "tenant_id", options, nil, nil)
+	assert.Nil(err, "error should be nil")
+	assert.Equal([]string{`name LIKE ? ESCAPE '\'`, "created_at >= ?", "created_at < ?"}, conditions, "conditions are not correct")
+	assert.Equal([]any{`rent\_%`, "2024-05-01 10:00:00.000", "2024-05-02 10:00:00.000"}, args, "args are not correct")
+	assert.Equal(" ORDER BY tenant_id ASC", orderBy, "order by is not correct")
+}
+
+// TestApplyFetchOptionsWithCursor tests the keyset conditions of the sort keys.
+func TestApplyFetchOptionsWithCursor(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with the id sort
+		options := &FetchOptions{SortBy: FetchSortByID, SortDesc: true, AfterID: "abc"}
+		conditions, args, orderBy, err := applyFetchOptions("tenant", "tenant_id", options, nil, nil)
+		assert.Nil(err, "error should be nil")
+		assert.Equal([]string{"tenant_id < ?"}, conditions, "conditions are not correct")
+		assert.Equal([]any{"abc"}, args, "args are not correct")
+		assert.Equal(" ORDER BY tenant_id DESC", orderBy, "order by is not correct")
+	}
+
+	{ // Test with the name sort
+		options := &FetchOptions{SortBy: FetchSortByName, AfterValue: "rent-a-car", AfterID: "abc"}
+		conditions, args, orderBy, err := applyFetchOptions("tenant", "tenant_id", options, nil, nil)
+		assert.Nil(err, "error should be nil")
+		assert.Equal([]string{"(name > ? OR (name = ? AND tenant_id > ?))"}, conditions, "conditions are not correct")
+		assert.Equal([]any{"rent-a-car", "rent-a-car", "abc"}, args, "args are not correct")
+		assert.Equal(" ORDER BY name ASC, tenant_id ASC", orderBy, "order by is not correct")
+	}
+
+	{ // Test with the creation time sort
+		createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		options := &FetchOptions{SortBy: FetchSortByCreatedAt, SortDesc: true, AfterValue: createdAt, AfterID: int64(581616507495)}
+		conditions, args, orderBy, err := applyFetchOptions("zone", "zone_id", options, nil, nil)
+		assert.Nil(err, "error should be nil")
+		assert.Equal([]string{"(created_at < ? OR (created_at = ? AND zone_id < ?))"}, conditions, "conditions are not correct")
+		assert.Equal([]any{"2024-05-01 10:00:00.000", "2024-05-01 10:00:00.000", int64(581616507495)}, args, "args are not correct")
+		assert.Equal(" ORDER BY created_at DESC, zone_id DESC", orderBy, "order by is not correct")
+	}
+}
+
+// TestApplyFetchOptionsWithInvalidInput tests the fetch options with invalid input.
+func TestApplyFetchOptionsWithInvalidInput(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with invalid sort key
+		_, _, _, err := applyFetchOptions("tenant", "tenant_id", &FetchOptions{SortBy: "updated_at"}, nil, nil)
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with missing cursor value
+		_, _, _, err := applyFetchOptions("tenant", "tenant_id", &FetchOptions{SortBy: FetchSortByName, AfterID: "abc"}, nil, nil)
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with invalid name prefix
+		namePrefix := "@"
+		_, _, _, err := applyFetchOptions("tenant", "tenant_id", &FetchOptions{NamePrefix: &namePrefix}, nil, nil)
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientName, err), "error should be errclientname")
+	}
+}
+
+// TestRepoFetchTenantWithCursor tests the fetch of tenants after a cursor.
+func TestRepoFetchTenantWithCursor(t *testing.T) {
+	assert := assert.New(t)
+	ledger := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	tenant := Tenant{
+		TenantID:  GenerateUUID(),
+		ZoneID:    581616507495,
+		Name:      "rent-a-car",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	sqlSelect := "SELECT * FROM tenants WHERE zone_id = ? AND (name > ? OR (name = ? AND tenant_id > ?)) ORDER BY name ASC, tenant_id ASC LIMIT ? OFFSET ?"
+	sqlRows := sqlmock.NewRows([]string{"zone_id", "tenant_id", "created_at", "updated_at", "name"}).
+		AddRow(tenant.ZoneID, tenant.TenantID, tenant.CreatedAt, tenant.UpdatedAt, tenant.Name)
+
+	pageSize := int32(10)
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).
+		WithArgs(tenant.ZoneID, "rent", "rent", "abc", pageSize, int32(0)).
+		WillReturnRows(sqlRows)
+
+	options := &FetchOptions{SortBy: FetchSortByName, AfterValue: "rent", AfterID: "abc"}
+	dbOutTenants, err := ledger.FetchTenants(sqlDB, 3, pageSize, tenant.ZoneID, nil, nil, options)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Len(dbOutTenants, 1, "tenants len should be correct")
+	assert.Equal(tenant.TenantID, dbOutTenants[0].TenantID, "tenant id is not correct")
+}
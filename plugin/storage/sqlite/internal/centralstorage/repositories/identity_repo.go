@@ -124,6 +124,31 @@ func (r *Repository) UpsertIdentity(tx *sql.Tx, isCreate bool, identity *Identit
 	return &dbIdentity, nil
 }
 
+// ReadIdentityByName reads an identity by name within the transaction.
+func (r *Repository) ReadIdentityByName(tx *sql.Tx, zoneID int64, name string) (*Identity, error) {
+	if err := azvalidators.ValidateCodeID("identity", zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageIdentityInvalidZoneID, zoneID), err)
+	}
+	identityName := strings.ToLower(name)
+	var dbIdentity Identity
+	err := tx.QueryRow("SELECT zone_id, identity_id, created_at, updated_at, identity_source_id, kind, name FROM identities WHERE zone_id = ? and name = ?", zoneID, identityName).Scan(
+		&dbIdentity.ZoneID,
+		&dbIdentity.IdentityID,
+		&dbIdentity.CreatedAt,
+		&dbIdentity.UpdatedAt,
+		&dbIdentity.IdentitySourceID,
+		&dbIdentity.Kind,
+		&dbIdentity.Name,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("identity not found (zone_id: %d, name: %s)", zoneID, identityName), err)
+		}
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve identity - operation 'retrieve-identity-by-name' encountered an issue (name: %s)", identityName), err)
+	}
+	return &dbIdentity, nil
+}
+
 // DeleteIdentity deletes an identity.
 func (r *Repository) DeleteIdentity(tx *sql.Tx, zoneID int64, identityID string) (*Identity, error) {
 	if err := azvalidators.ValidateCodeID("identity", zoneID); err != nil {
@@ -156,18 +181,12 @@ func (r *Repository) DeleteIdentity(tx *sql.Tx, zoneID int64, identityID string)
 	return &dbIdentity, nil
 }
 
-// FetchIdentities retrieves identities.
-func (r *Repository) FetchIdentities(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]Identity, error) {
-	if page <= 0 || pageSize <= 0 {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
-	}
+// identityFetchConditions returns the conditions, the arguments and the order by clause filtering the identities.
+func identityFetchConditions(zoneID int64, filterID *string, filterName *string, filterIdentitySourceID *string, filterKind *int16, options *FetchOptions) ([]string, []any, string, error) {
 	if err := azvalidators.ValidateCodeID("identity", zoneID); err != nil {
-		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf(errorMessageIdentityInvalidZoneID, zoneID), err)
+		return nil, nil, "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf(errorMessageIdentityInvalidZoneID, zoneID), err)
 	}
 
-	var dbIdentities []Identity
-
-	baseQuery := "SELECT * FROM identities"
 	var conditions []string
 	var args []any
 
@@ -177,7 +196,7 @@ func (r *Repository) FetchIdentities(db *sqlx.DB, page int32, pageSize int32, zo
 	if filterID != nil {
 		identityID := *filterID
 		if err := azvalidators.ValidateUUID("identity", identityID); err != nil {
-			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf("invalid client input - identity id is not valid (id: %s)", identityID), err)
+			return nil, nil, "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf("invalid client input - identity id is not valid (id: %s)", identityID), err)
 		}
 		conditions = append(conditions, "identity_id = ?")
 		args = append(args, identityID)
@@ -187,7 +206,7 @@ func (r *Repository) FetchIdentities(db *sqlx.DB, page int32, pageSize int32, zo
 		identityName := *filterName
 		if err := azvalidators.ValidateIdentityUserName("identity", identityName); err != nil {
 			if err := azvalidators.ValidateName("identity", identityName); err != nil {
-				return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientName, fmt.Sprintf("invalid client input - identity name is not valid (name: %s)", identityName), err)
+				return nil, nil, "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientName, fmt.Sprintf("invalid client input - identity name is not valid (name: %s)", identityName), err)
 			}
 		}
 		identityName = "%" + identityName + "%"
@@ -195,22 +214,73 @@ func (r *Repository) FetchIdentities(db *sqlx.DB, page int32, pageSize int32, zo
 		args = append(args, identityName)
 	}
 
+	if filterIdentitySourceID != nil {
+		identitySourceID := *filterIdentitySourceID
+		if err := azvalidators.ValidateUUID("identity", identitySourceID); err != nil {
+			return nil, nil, "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf("invalid client input - identity source id is not valid (id: %s)", identitySourceID), err)
+		}
+		conditions = append(conditions, "identity_source_id = ?")
+		args = append(args, identitySourceID)
+	}
+
+	if filterKind != nil {
+		conditions = append(conditions, "kind = ?")
+		args = append(args, *filterKind)
+	}
+
+	return applyFetchOptions("identity", "identity_id", options, conditions, args)
+}
+
+// FetchIdentities retrieves identities.
+func (r *Repository) FetchIdentities(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, filterIdentitySourceID *string, filterKind *int16, options *FetchOptions) ([]Identity, error) {
+	if page <= 0 || pageSize <= 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	conditions, args, orderBy, err := identityFetchConditions(zoneID, filterID, filterName, filterIdentitySourceID, filterKind, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var dbIdentities []Identity
+
+	baseQuery := "SELECT * FROM identities"
 	if len(conditions) > 0 {
 		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	baseQuery += " ORDER BY identity_id ASC"
+	baseQuery += orderBy
 
 	limit := pageSize
-	offset := (page - 1) * pageSize
+	offset := options.fetchOffset(page, pageSize)
 	baseQuery += " LIMIT ? OFFSET ?"
 
 	args = append(args, limit, offset)
 
-	err := db.Select(&dbIdentities, baseQuery, args...)
+	err = db.Select(&dbIdentities, baseQuery, args...)
 	if err != nil {
 		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve identities - operation 'retrieve-identities' encountered an issue with parameters %v", args), err)
 	}
 
 	return dbIdentities, nil
 }
+
+// CountIdentities counts the identities matching the filters.
+func (r *Repository) CountIdentities(db *sqlx.DB, zoneID int64, filterID *string, filterName *string, filterIdentitySourceID *string, filterKind *int16, options *FetchOptions) (int64, error) {
+	conditions, args, _, err := identityFetchConditions(zoneID, filterID, filterName, filterIdentitySourceID, filterKind, options)
+	if err != nil {
+		return 0, err
+	}
+
+	baseQuery := "SELECT COUNT(*) FROM identities"
+	if len(conditions) > 0 {
+		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var count int64
+	err = db.Get(&count, baseQuery, args...)
+	if err != nil {
+		return 0, WrapSqlite3Error(fmt.Sprintf("failed to count identities - operation 'count-identities' encountered an issue with parameters %v", args), err)
+	}
+
+	return count, nil
+}
@@ -380,37 +380,44 @@ func TestRepoFetchIdentityWithInvalidInput(t *testing.T) {
 	defer sqlDB.Close()
 
 	{ // Test with invalid page
-		_, err := ledger.FetchIdentities(sqlDB, 0, 100, 581616507495, nil, nil)
+		_, err := ledger.FetchIdentities(sqlDB, 0, 100, 581616507495, nil, nil, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid page size
-		_, err := ledger.FetchIdentities(sqlDB, 1, 0, 581616507495, nil, nil)
+		_, err := ledger.FetchIdentities(sqlDB, 1, 0, 581616507495, nil, nil, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid zone id
 		identityID := GenerateUUID()
-		_, err := ledger.FetchIdentities(sqlDB, 1, 1, 0, &identityID, nil)
+		_, err := ledger.FetchIdentities(sqlDB, 1, 1, 0, &identityID, nil, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
 	}
 
 	{ // Test with invalid identity id
 		identityID := ""
-		_, err := ledger.FetchIdentities(sqlDB, 1, 1, 581616507495, &identityID, nil)
+		_, err := ledger.FetchIdentities(sqlDB, 1, 1, 581616507495, &identityID, nil, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
 	}
 
 	{ // Test with invalid identity name
 		identityName := "@"
-		_, err := ledger.FetchIdentities(sqlDB, 1, 1, 581616507495, nil, &identityName)
+		_, err := ledger.FetchIdentities(sqlDB, 1, 1, 581616507495, nil, &identityName, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientName, err), "error should be errclientname")
 	}
+
+	{ // Test with invalid identity source id
+		identitySourceID := "not-a-uuid"
+		_, err := ledger.FetchIdentities(sqlDB, 1, 1, 581616507495, nil, nil, &identitySourceID, nil, nil)
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
+	}
 }
 
 // TestRepoFetchIdentityWithSuccess tests the fetch of identities with success.
@@ -430,7 +437,7 @@ func TestRepoFetchIdentityWithSuccess(t *testing.T) {
 		WithArgs(sqlIdentities[0].ZoneID, sqlIdentities[0].IdentityID, identityName, pageSize, page-1).
 		WillReturnRows(sqlIdentityRows)
 
-	dbOutIdentities, err := ledger.FetchIdentities(sqlDB, page, pageSize, sqlIdentities[0].ZoneID, &sqlIdentities[0].IdentityID, &sqlIdentities[0].Name)
+	dbOutIdentities, err := ledger.FetchIdentities(sqlDB, page, pageSize, sqlIdentities[0].ZoneID, &sqlIdentities[0].IdentityID, &sqlIdentities[0].Name, nil, nil, nil)
 
 	orderedSQLIdentities := make([]Identity, len(sqlIdentities))
 	copy(orderedSQLIdentities, sqlIdentities)
@@ -450,3 +457,61 @@ func TestRepoFetchIdentityWithSuccess(t *testing.T) {
 	}
 	assert.Nil(err, "error should be nil")
 }
+
+// TestRepoReadIdentityByName tests the read of an identity by name.
+func TestRepoReadIdentityByName(t *testing.T) {
+	assert := assert.New(t)
+	ledger := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	sqlSelect := "SELECT zone_id, identity_id, created_at, updated_at, identity_source_id, kind, name FROM identities WHERE zone_id = ? and name = ?"
+	identity := Identity{ZoneID: 581616507495, IdentityID: GenerateUUID(), IdentitySourceID: GenerateUUID(), Kind: 1, Name: "nicola.gallo", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	columns := []string{"zone_id", "identity_id", "created_at", "updated_at", "identity_source_id", "kind", "name"}
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).
+		WithArgs(identity.ZoneID, identity.Name).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(identity.ZoneID, identity.IdentityID, identity.CreatedAt, identity.UpdatedAt, identity.IdentitySourceID, identity.Kind, identity.Name))
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).
+		WithArgs(identity.ZoneID, "missing").
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	tx, _ := sqlDB.Begin()
+	_, err := ledger.ReadIdentityByName(tx, 0, identity.Name)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+
+	dbOutIdentity, err := ledger.ReadIdentityByName(tx, identity.ZoneID, "Nicola.Gallo")
+	assert.Nil(err, "error should be nil")
+	assert.Equal(identity.IdentityID, dbOutIdentity.IdentityID, "identity id is not correct")
+
+	_, err = ledger.ReadIdentityByName(tx, identity.ZoneID, "missing")
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientNotFound, err), "error should be errclientnotfound")
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+}
+
+// TestRepoCountIdentities tests the count of identities.
+func TestRepoCountIdentities(t *testing.T) {
+	assert := assert.New(t)
+	ledger := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	_, err := ledger.CountIdentities(sqlDB, 0, nil, nil, nil, nil, nil)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
+
+	identitySourceID := GenerateUUID()
+	kind := int16(1)
+	namePrefix := "nicola"
+	sqlCount := `SELECT COUNT(*) FROM identities WHERE zone_id = ? AND identity_source_id = ? AND kind = ? AND name LIKE ? ESCAPE '\'`
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlCount)).
+		WithArgs(int64(581616507495), identitySourceID, kind, namePrefix+"%").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	count, err := ledger.CountIdentities(sqlDB, 581616507495, nil, nil, &identitySourceID, &kind, &FetchOptions{NamePrefix: &namePrefix})
+	assert.Nil(err, "error should be nil")
+	assert.Equal(int64(7), count, "count is not correct")
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+}
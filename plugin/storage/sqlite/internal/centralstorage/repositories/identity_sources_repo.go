@@ -115,7 +115,7 @@ func (r *Repository) DeleteIdentitySource(tx *sql.Tx, zoneID int64, identitySour
 }
 
 // FetchIdentitySources retrieves identity sources.
-func (r *Repository) FetchIdentitySources(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]IdentitySource, error) {
+func (r *Repository) FetchIdentitySources(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, options *FetchOptions) ([]IdentitySource, error) {
 	if page <= 0 || pageSize <= 0 {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
 	}
@@ -151,19 +151,24 @@ func (r *Repository) FetchIdentitySources(db *sqlx.DB, page int32, pageSize int3
 		args = append(args, identitySourceName)
 	}
 
+	conditions, args, orderBy, err := applyFetchOptions("identity source", "identity_source_id", options, conditions, args)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(conditions) > 0 {
 		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	baseQuery += " ORDER BY identity_source_id ASC"
+	baseQuery += orderBy
 
 	limit := pageSize
-	offset := (page - 1) * pageSize
+	offset := options.fetchOffset(page, pageSize)
 	baseQuery += " LIMIT ? OFFSET ?"
 
 	args = append(args, limit, offset)
 
-	err := db.Select(&dbIdentitySources, baseQuery, args...)
+	err = db.Select(&dbIdentitySources, baseQuery, args...)
 	if err != nil {
 		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve identity sources - operation 'retrieve-identity-sources' encountered an issue with parameters %v", args), err)
 	}
@@ -362,34 +362,34 @@ func TestRepoFetchIdentitySourceWithInvalidInput(t *testing.T) {
 	defer sqlDB.Close()
 
 	{ // Test with invalid page
-		_, err := ledger.FetchIdentitySources(sqlDB, 0, 100, 581616507495, nil, nil)
+		_, err := ledger.FetchIdentitySources(sqlDB, 0, 100, 581616507495, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid page size
-		_, err := ledger.FetchIdentitySources(sqlDB, 1, 0, 581616507495, nil, nil)
+		_, err := ledger.FetchIdentitySources(sqlDB, 1, 0, 581616507495, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid zone id
 		identitySourceID := GenerateUUID()
-		_, err := ledger.FetchIdentitySources(sqlDB, 1, 1, 0, &identitySourceID, nil)
+		_, err := ledger.FetchIdentitySources(sqlDB, 1, 1, 0, &identitySourceID, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
 	}
 
 	{ // Test with invalid identity source id
 		identitySourceID := ""
-		_, err := ledger.FetchIdentitySources(sqlDB, 1, 1, 581616507495, &identitySourceID, nil)
+		_, err := ledger.FetchIdentitySources(sqlDB, 1, 1, 581616507495, &identitySourceID, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
 	}
 
 	{ // Test with invalid identity source name
 		identitySourceName := "@"
-		_, err := ledger.FetchIdentitySources(sqlDB, 1, 1, 581616507495, nil, &identitySourceName)
+		_, err := ledger.FetchIdentitySources(sqlDB, 1, 1, 581616507495, nil, &identitySourceName, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientName, err), "error should be errclientname")
 	}
@@ -412,7 +412,7 @@ func TestRepoFetchIdentitySourceWithSuccess(t *testing.T) {
 		WithArgs(sqlIdentitySources[0].ZoneID, sqlIdentitySources[0].IdentitySourceID, identitySourceName, pageSize, page-1).
 		WillReturnRows(sqlIdentitySourceRows)
 
-	dbOutIdentitySource, err := ledger.FetchIdentitySources(sqlDB, page, pageSize, sqlIdentitySources[0].ZoneID, &sqlIdentitySources[0].IdentitySourceID, &sqlIdentitySources[0].Name)
+	dbOutIdentitySource, err := ledger.FetchIdentitySources(sqlDB, page, pageSize, sqlIdentitySources[0].ZoneID, &sqlIdentitySources[0].IdentitySourceID, &sqlIdentitySources[0].Name, nil)
 
 	orderedSQLIdentitySources := make([]IdentitySource, len(sqlIdentitySources))
 	copy(orderedSQLIdentitySources, sqlIdentitySources)
@@ -90,3 +90,25 @@ func (r *Repository) GetKeyValue(db *sqlx.DB, zoneID int64, key string) (*KeyVal
 
 	return &dbKeyValue, nil
 }
+
+// ReadKeyValue retrieves the value for a given key from the key-value store within a transaction.
+func (r *Repository) ReadKeyValue(tx *sql.Tx, zoneID int64, key string) (*KeyValue, error) {
+	if key == "" {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - key is missing or empty")
+	}
+
+	var dbKeyValue KeyValue
+	err := tx.QueryRow("SELECT zone_id, kv_key, kv_value FROM key_values WHERE zone_id = ? and kv_key = ?", zoneID, key).Scan(
+		&dbKeyValue.ZoneID,
+		&dbKeyValue.Key,
+		&dbKeyValue.Value,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageNotFound, fmt.Sprintf("no value found for key (%s)", key), err)
+		}
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve key-value pair - operation 'read-key-value' encountered an issue (key: %s)", key), err)
+	}
+
+	return &dbKeyValue, nil
+}
@@ -160,3 +160,31 @@ func TestRepoGetKeyValueWithErrors(t *testing.T) {
 	assert.NotNil(err, "error should be not nil")
 	assert.True(azerrors.AreErrorsEqual(azerrors.ErrStorageNotFound, err), "error should be errstoragenotfound")
 }
+
+// TestRepoReadKeyValueWithSuccess tests the retrieval of a key-value pair within a transaction with success.
+func TestRepoReadKeyValueWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	ledger := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	keyValue := &KeyValue{
+		ZoneID: 45645646,
+		Key:    "test-key",
+		Value:  []byte("test-value"),
+	}
+	sqlDBMock.ExpectBegin()
+	sqlRows := sqlmock.NewRows([]string{"zone_id", "kv_key", "kv_value"}).
+		AddRow(keyValue.ZoneID, keyValue.Key, keyValue.Value)
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(`SELECT zone_id, kv_key, kv_value FROM key_values WHERE zone_id = ? and kv_key = ?`)).
+		WithArgs(keyValue.ZoneID, keyValue.Key).
+		WillReturnRows(sqlRows)
+
+	tx, _ := sqlDB.Begin()
+	dbOutKeyValue, err := ledger.ReadKeyValue(tx, keyValue.ZoneID, keyValue.Key)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Equal(keyValue.Value, dbOutKeyValue.Value, "value is not correct")
+}
@@ -33,6 +33,11 @@ const (
 	errorMessageLedgerInvalidZoneID = "invalid client input - zone id is not valid (id: %d)"
 )
 
+const (
+	// ledgerZeroRef is the ref of a ledger without commits.
+	ledgerZeroRef = "0000000000000000000000000000000000000000000000000000000000000000"
+)
+
 const (
 	LedgerType       = "ledger"
 	LedgerTypePolicy = "policy"
@@ -101,7 +106,7 @@ func (r *Repository) UpsertLedger(tx *sql.Tx, isCreate bool, ledger *Ledger) (*L
 	}
 
 	var dbLedger Ledger
-	err = tx.QueryRow("SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref FROM ledgers WHERE zone_id = ? and ledger_id = ?", zoneID, ledgerID).Scan(
+	err = tx.QueryRow("SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref, candidate_ref, required_approvals FROM ledgers WHERE zone_id = ? and ledger_id = ?", zoneID, ledgerID).Scan(
 		&dbLedger.ZoneID,
 		&dbLedger.LedgerID,
 		&dbLedger.CreatedAt,
@@ -109,6 +114,8 @@ func (r *Repository) UpsertLedger(tx *sql.Tx, isCreate bool, ledger *Ledger) (*L
 		&dbLedger.Kind,
 		&dbLedger.Name,
 		&dbLedger.Ref,
+		&dbLedger.CandidateRef,
+		&dbLedger.RequiredApprovals,
 	)
 	if err != nil {
 		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve ledger - operation 'retrieve-created-ledger' encountered an issue (%s)", LogLedgerEntry(ledger)), err)
@@ -159,6 +166,123 @@ func (r *Repository) UpdateLedgerRef(tx *sql.Tx, zoneID int64, ledgerID, current
 	return nil
 }
 
+// UpdateLedgerCandidateRef updates the candidate ref of a ledger, the zero ref clears the candidate.
+func (r *Repository) UpdateLedgerCandidateRef(tx *sql.Tx, zoneID int64, ledgerID, candidateRef string) (*Ledger, error) {
+	if err := azvalidators.ValidateCodeID(LedgerType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageLedgerInvalidZoneID, zoneID), err)
+	}
+	if err := azvalidators.ValidateUUID(LedgerType, ledgerID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - ledger id is not valid (id: %s)", ledgerID), err)
+	}
+	if err := azvalidators.ValidateSHA256(LedgerType, candidateRef); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - candidate ref is not valid (ref: %s)", candidateRef), err)
+	}
+
+	result, err := tx.Exec("UPDATE ledgers SET candidate_ref = ? WHERE zone_id = ? AND ledger_id = ?", candidateRef, zoneID, ledgerID)
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to update ledger candidate ref", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to get rows affected for update candidate ref", err)
+	}
+	if rows != 1 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("ledger not found (zone_id: %d, ledger_id: %s)", zoneID, ledgerID))
+	}
+	return r.readLedger(tx, zoneID, ledgerID)
+}
+
+// PromoteLedgerCandidateRef promotes the candidate ref of a ledger to its ref and clears the candidate.
+func (r *Repository) PromoteLedgerCandidateRef(tx *sql.Tx, zoneID int64, ledgerID string) (*Ledger, error) {
+	if err := azvalidators.ValidateCodeID(LedgerType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageLedgerInvalidZoneID, zoneID), err)
+	}
+	if err := azvalidators.ValidateUUID(LedgerType, ledgerID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - ledger id is not valid (id: %s)", ledgerID), err)
+	}
+
+	dbLedger, err := r.readLedger(tx, zoneID, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+	if dbLedger.CandidateRef == ledgerZeroRef {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - ledger has no candidate ref (zone_id: %d, ledger_id: %s)", zoneID, ledgerID))
+	}
+
+	result, err := tx.Exec("UPDATE ledgers SET ref = candidate_ref, candidate_ref = ? WHERE zone_id = ? AND ledger_id = ? AND ref = ? AND candidate_ref = ?",
+		ledgerZeroRef, zoneID, ledgerID, dbLedger.Ref, dbLedger.CandidateRef)
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to promote ledger candidate ref", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to get rows affected for promote candidate ref", err)
+	}
+	if rows != 1 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUpdateConflict, fmt.Sprintf("promote failed, no rows affected (zone_id: %d, ledger_id: %s)", zoneID, ledgerID))
+	}
+	return r.readLedger(tx, zoneID, ledgerID)
+}
+
+// UpdateLedgerRequiredApprovals updates the number of approvals required to move the ref of a ledger, zero disables the protection.
+// The required approvals cannot be lowered while the ledger has pending change requests.
+func (r *Repository) UpdateLedgerRequiredApprovals(tx *sql.Tx, zoneID int64, ledgerID string, requiredApprovals int32) (*Ledger, error) {
+	if err := azvalidators.ValidateCodeID(LedgerType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageLedgerInvalidZoneID, zoneID), err)
+	}
+	if err := azvalidators.ValidateUUID(LedgerType, ledgerID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - ledger id is not valid (id: %s)", ledgerID), err)
+	}
+	if requiredApprovals < 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - required approvals is not valid (value: %d)", requiredApprovals))
+	}
+
+	var pendingChangeRequests int32
+	err := tx.QueryRow("SELECT COUNT(*) FROM change_requests cr JOIN ledgers l ON l.zone_id = cr.zone_id AND l.ledger_id = cr.ledger_id WHERE l.zone_id = ? AND l.ledger_id = ? AND l.required_approvals > ? AND cr.status = ?",
+		zoneID, ledgerID, requiredApprovals, ChangeRequestStatusPending).Scan(&pendingChangeRequests)
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to count the pending change requests of the ledger", err)
+	}
+	if pendingChangeRequests > 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUpdateConflict, fmt.Sprintf("update failed, the required approvals cannot be lowered while the ledger has %d pending change requests (zone_id: %d, ledger_id: %s)", pendingChangeRequests, zoneID, ledgerID))
+	}
+	result, err := tx.Exec("UPDATE ledgers SET required_approvals = ? WHERE zone_id = ? AND ledger_id = ?", requiredApprovals, zoneID, ledgerID)
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to update ledger required approvals", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to get rows affected for update required approvals", err)
+	}
+	if rows != 1 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("ledger not found (zone_id: %d, ledger_id: %s)", zoneID, ledgerID))
+	}
+	return r.readLedger(tx, zoneID, ledgerID)
+}
+
+// readLedger reads a ledger within the transaction.
+func (r *Repository) readLedger(tx *sql.Tx, zoneID int64, ledgerID string) (*Ledger, error) {
+	var dbLedger Ledger
+	err := tx.QueryRow("SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref, candidate_ref, required_approvals FROM ledgers WHERE zone_id = ? and ledger_id = ?", zoneID, ledgerID).Scan(
+		&dbLedger.ZoneID,
+		&dbLedger.LedgerID,
+		&dbLedger.CreatedAt,
+		&dbLedger.UpdatedAt,
+		&dbLedger.Kind,
+		&dbLedger.Name,
+		&dbLedger.Ref,
+		&dbLedger.CandidateRef,
+		&dbLedger.RequiredApprovals,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("ledger not found (zone_id: %d, ledger_id: %s)", zoneID, ledgerID), err)
+		}
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve ledger - operation 'retrieve-ledger' encountered an issue (id: %s)", ledgerID), err)
+	}
+	return &dbLedger, nil
+}
+
 // DeleteLedger deletes a ledger.
 func (r *Repository) DeleteLedger(tx *sql.Tx, zoneID int64, ledgerID string) (*Ledger, error) {
 	if err := azvalidators.ValidateCodeID(LedgerType, zoneID); err != nil {
@@ -169,7 +293,7 @@ func (r *Repository) DeleteLedger(tx *sql.Tx, zoneID int64, ledgerID string) (*L
 	}
 
 	var dbLedger Ledger
-	err := tx.QueryRow("SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref FROM ledgers WHERE zone_id = ? and ledger_id = ?", zoneID, ledgerID).Scan(
+	err := tx.QueryRow("SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref, candidate_ref, required_approvals FROM ledgers WHERE zone_id = ? and ledger_id = ?", zoneID, ledgerID).Scan(
 		&dbLedger.ZoneID,
 		&dbLedger.LedgerID,
 		&dbLedger.CreatedAt,
@@ -177,6 +301,8 @@ func (r *Repository) DeleteLedger(tx *sql.Tx, zoneID int64, ledgerID string) (*L
 		&dbLedger.Kind,
 		&dbLedger.Name,
 		&dbLedger.Ref,
+		&dbLedger.CandidateRef,
+		&dbLedger.RequiredApprovals,
 	)
 	if err != nil {
 		return nil, WrapSqlite3Error(fmt.Sprintf("invalid client input - ledger id is not valid (id: %s)", ledgerID), err)
@@ -193,7 +319,7 @@ func (r *Repository) DeleteLedger(tx *sql.Tx, zoneID int64, ledgerID string) (*L
 }
 
 // FetchLedgers retrieves ledgers.
-func (r *Repository) FetchLedgers(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]Ledger, error) {
+func (r *Repository) FetchLedgers(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, options *FetchOptions) ([]Ledger, error) {
 	if page <= 0 || pageSize <= 0 {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
 	}
@@ -229,19 +355,24 @@ func (r *Repository) FetchLedgers(db *sqlx.DB, page int32, pageSize int32, zoneI
 		args = append(args, ledgerName)
 	}
 
+	conditions, args, orderBy, err := applyFetchOptions("ledger", "ledger_id", options, conditions, args)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(conditions) > 0 {
 		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	baseQuery += " ORDER BY ledger_id ASC"
+	baseQuery += orderBy
 
 	limit := pageSize
-	offset := (page - 1) * pageSize
+	offset := options.fetchOffset(page, pageSize)
 	baseQuery += " LIMIT ? OFFSET ?"
 
 	args = append(args, limit, offset)
 
-	err := db.Select(&dbLedgers, baseQuery, args...)
+	err = db.Select(&dbLedgers, baseQuery, args...)
 	if err != nil {
 		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve ledgers - operation 'retrieve-ledgers' encountered an issue with parameters %v", args), err)
 	}
@@ -34,13 +34,14 @@ import (
 // registerLedgerForUpsertMocking registers a ledger for upsert mocking.
 func registerLedgerForUpsertMocking(isCreate bool) (*Ledger, string, *sqlmock.Rows) {
 	ledger := &Ledger{
-		LedgerID:  GenerateUUID(),
-		ZoneID:    581616507495,
-		Name:      "rent-a-car",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-		Kind:      1,
-		Ref:       "0000000000000000000000000000000000000000000000000000000000000000",
+		LedgerID:     GenerateUUID(),
+		ZoneID:       581616507495,
+		Name:         "rent-a-car",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+		Kind:         1,
+		Ref:          "0000000000000000000000000000000000000000000000000000000000000000",
+		CandidateRef: "0000000000000000000000000000000000000000000000000000000000000000",
 	}
 	var sql string
 	if isCreate {
@@ -48,26 +49,27 @@ func registerLedgerForUpsertMocking(isCreate bool) (*Ledger, string, *sqlmock.Ro
 	} else {
 		sql = `UPDATE ledgers SET name = \? WHERE zone_id = \? and ledger_id = \?`
 	}
-	sqlRows := sqlmock.NewRows([]string{"zone_id", "ledger_id", "created_at", "updated_at", "kind", "name", "ref"}).
-		AddRow(ledger.ZoneID, ledger.LedgerID, ledger.CreatedAt, ledger.UpdatedAt, ledger.Kind, ledger.Name, ledger.Ref)
+	sqlRows := sqlmock.NewRows([]string{"zone_id", "ledger_id", "created_at", "updated_at", "kind", "name", "ref", "candidate_ref", "required_approvals"}).
+		AddRow(ledger.ZoneID, ledger.LedgerID, ledger.CreatedAt, ledger.UpdatedAt, ledger.Kind, ledger.Name, ledger.Ref, ledger.CandidateRef, ledger.RequiredApprovals)
 	return ledger, sql, sqlRows
 }
 
 // registerLedgerForDeleteMocking registers a ledger for delete mocking.
 func registerLedgerForDeleteMocking() (string, *Ledger, *sqlmock.Rows, string) {
 	ledger := &Ledger{
-		LedgerID:  GenerateUUID(),
-		ZoneID:    581616507495,
-		Name:      "rent-a-car",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-		Kind:      1,
-		Ref:       "0000000000000000000000000000000000000000000000000000000000000000",
+		LedgerID:     GenerateUUID(),
+		ZoneID:       581616507495,
+		Name:         "rent-a-car",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+		Kind:         1,
+		Ref:          "0000000000000000000000000000000000000000000000000000000000000000",
+		CandidateRef: "0000000000000000000000000000000000000000000000000000000000000000",
 	}
-	var sqlSelect = `SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref FROM ledgers WHERE zone_id = \? and ledger_id = \?`
+	var sqlSelect = `SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref, candidate_ref, required_approvals FROM ledgers WHERE zone_id = \? and ledger_id = \?`
 	var sqlDelete = `DELETE FROM ledgers WHERE zone_id = \? and ledger_id = \?`
-	sqlRows := sqlmock.NewRows([]string{"zone_id", "ledger_id", "created_at", "updated_at", "kind", "name", "ref"}).
-		AddRow(ledger.ZoneID, ledger.LedgerID, ledger.CreatedAt, ledger.UpdatedAt, ledger.Kind, ledger.Name, ledger.Ref)
+	sqlRows := sqlmock.NewRows([]string{"zone_id", "ledger_id", "created_at", "updated_at", "kind", "name", "ref", "candidate_ref", "required_approvals"}).
+		AddRow(ledger.ZoneID, ledger.LedgerID, ledger.CreatedAt, ledger.UpdatedAt, ledger.Kind, ledger.Name, ledger.Ref, ledger.CandidateRef, ledger.RequiredApprovals)
 	return sqlSelect, ledger, sqlRows, sqlDelete
 }
 
@@ -190,7 +192,7 @@ func TestRepoUpsertLedgerWithSuccess(t *testing.T) {
 				WillReturnResult(sqlmock.NewResult(1, 1))
 		}
 
-		sqlDBMock.ExpectQuery(`SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref FROM ledgers WHERE zone_id = \? and ledger_id = \?`).
+		sqlDBMock.ExpectQuery(`SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref, candidate_ref, required_approvals FROM ledgers WHERE zone_id = \? and ledger_id = \?`).
 			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnRows(sqlLedgerRows)
 
@@ -372,34 +374,34 @@ func TestRepoFetchLedgerWithInvalidInput(t *testing.T) {
 	defer sqlDB.Close()
 
 	{ // Test with invalid page
-		_, err := ledger.FetchLedgers(sqlDB, 0, 100, 581616507495, nil, nil)
+		_, err := ledger.FetchLedgers(sqlDB, 0, 100, 581616507495, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid page size
-		_, err := ledger.FetchLedgers(sqlDB, 1, 0, 581616507495, nil, nil)
+		_, err := ledger.FetchLedgers(sqlDB, 1, 0, 581616507495, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid zone id
 		ledgerID := GenerateUUID()
-		_, err := ledger.FetchLedgers(sqlDB, 1, 1, 0, &ledgerID, nil)
+		_, err := ledger.FetchLedgers(sqlDB, 1, 1, 0, &ledgerID, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
 	}
 
 	{ // Test with invalid ledger id
 		ledgerID := ""
-		_, err := ledger.FetchLedgers(sqlDB, 1, 1, 581616507495, &ledgerID, nil)
+		_, err := ledger.FetchLedgers(sqlDB, 1, 1, 581616507495, &ledgerID, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
 	}
 
 	{ // Test with invalid ledger name
 		ledgerName := "@"
-		_, err := ledger.FetchLedgers(sqlDB, 1, 1, 581616507495, nil, &ledgerName)
+		_, err := ledger.FetchLedgers(sqlDB, 1, 1, 581616507495, nil, &ledgerName, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientName, err), "error should be errclientname")
 	}
@@ -422,7 +424,7 @@ func TestRepoFetchLedgerWithSuccess(t *testing.T) {
 		WithArgs(sqlLedgers[0].ZoneID, sqlLedgers[0].LedgerID, ledgerName, pageSize, page-1).
 		WillReturnRows(sqlLedgerRows)
 
-	dbOutLedger, err := ledger.FetchLedgers(sqlDB, page, pageSize, sqlLedgers[0].ZoneID, &sqlLedgers[0].LedgerID, &sqlLedgers[0].Name)
+	dbOutLedger, err := ledger.FetchLedgers(sqlDB, page, pageSize, sqlLedgers[0].ZoneID, &sqlLedgers[0].LedgerID, &sqlLedgers[0].Name, nil)
 
 	orderedSQLLedgers := make([]Ledger, len(sqlLedgers))
 	copy(orderedSQLLedgers, sqlLedgers)
@@ -440,3 +442,113 @@ func TestRepoFetchLedgerWithSuccess(t *testing.T) {
 	}
 	assert.Nil(err, "error should be nil")
 }
+
+// TestRepoUpdateLedgerCandidateRef tests the update of the candidate ref of a ledger.
+func TestRepoUpdateLedgerCandidateRef(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	ledger, _, sqlLedgerRows := registerLedgerForUpsertMocking(false)
+	candidateRef := "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80"
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectExec(`UPDATE ledgers SET candidate_ref = \? WHERE zone_id = \? AND ledger_id = \?`).
+		WithArgs(candidateRef, ledger.ZoneID, ledger.LedgerID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlDBMock.ExpectQuery(`SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref, candidate_ref, required_approvals FROM ledgers WHERE zone_id = \? and ledger_id = \?`).
+		WithArgs(ledger.ZoneID, ledger.LedgerID).
+		WillReturnRows(sqlLedgerRows)
+
+	tx, _ := sqlDB.Begin()
+	{ // Test with invalid candidate ref
+		_, err := repository.UpdateLedgerCandidateRef(tx, ledger.ZoneID, ledger.LedgerID, "invalid")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	dbOutLedger, err := repository.UpdateLedgerCandidateRef(tx, ledger.ZoneID, ledger.LedgerID, candidateRef)
+	assert.Nil(err, "error should be nil")
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Equal(ledger.LedgerID, dbOutLedger.LedgerID, "ledger id is not correct")
+}
+
+// TestRepoPromoteLedgerCandidateRef tests the promotion of the candidate ref of a ledger.
+func TestRepoPromoteLedgerCandidateRef(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	ledger, _, _ := registerLedgerForUpsertMocking(false)
+	currentRef := "007867724d1aa801216d92d8d08ed2269a55e495575aceb1f46cded8594159ee"
+	candidateRef := "8a169320102ba429b4f7c0a5a9cde6e9bf2ace6335af3b57b11970718c05aa80"
+	sqlSelect := `SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref, candidate_ref, required_approvals FROM ledgers WHERE zone_id = \? and ledger_id = \?`
+	ledgerRows := func(ref, candidateRef string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"zone_id", "ledger_id", "created_at", "updated_at", "kind", "name", "ref", "candidate_ref", "required_approvals"}).
+			AddRow(ledger.ZoneID, ledger.LedgerID, ledger.CreatedAt, ledger.UpdatedAt, ledger.Kind, ledger.Name, ref, candidateRef, 0)
+	}
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectQuery(sqlSelect).WithArgs(ledger.ZoneID, ledger.LedgerID).WillReturnRows(ledgerRows(currentRef, ledgerZeroRef))
+	sqlDBMock.ExpectQuery(sqlSelect).WithArgs(ledger.ZoneID, ledger.LedgerID).WillReturnRows(ledgerRows(currentRef, candidateRef))
+	sqlDBMock.ExpectExec(`UPDATE ledgers SET ref = candidate_ref, candidate_ref = \? WHERE zone_id = \? AND ledger_id = \? AND ref = \? AND candidate_ref = \?`).
+		WithArgs(ledgerZeroRef, ledger.ZoneID, ledger.LedgerID, currentRef, candidateRef).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlDBMock.ExpectQuery(sqlSelect).WithArgs(ledger.ZoneID, ledger.LedgerID).WillReturnRows(ledgerRows(candidateRef, ledgerZeroRef))
+
+	tx, _ := sqlDB.Begin()
+	{ // Test without candidate ref
+		_, err := repository.PromoteLedgerCandidateRef(tx, ledger.ZoneID, ledger.LedgerID)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	dbOutLedger, err := repository.PromoteLedgerCandidateRef(tx, ledger.ZoneID, ledger.LedgerID)
+	assert.Nil(err, "error should be nil")
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Equal(candidateRef, dbOutLedger.Ref, "ledger ref is not correct")
+	assert.Equal(ledgerZeroRef, dbOutLedger.CandidateRef, "ledger candidate ref is not correct")
+}
+
+// TestRepoUpdateLedgerRequiredApprovals tests the update of the required approvals of a ledger.
+func TestRepoUpdateLedgerRequiredApprovals(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	ledger, _, sqlLedgerRows := registerLedgerForUpsertMocking(false)
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectQuery(`SELECT COUNT\(\*\) FROM change_requests cr JOIN ledgers l ON l.zone_id = cr.zone_id AND l.ledger_id = cr.ledger_id WHERE l.zone_id = \? AND l.ledger_id = \? AND l.required_approvals > \? AND cr.status = \?`).
+		WithArgs(ledger.ZoneID, ledger.LedgerID, int32(0), ChangeRequestStatusPending).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	sqlDBMock.ExpectQuery(`SELECT COUNT\(\*\) FROM change_requests cr JOIN ledgers l ON l.zone_id = cr.zone_id AND l.ledger_id = cr.ledger_id WHERE l.zone_id = \? AND l.ledger_id = \? AND l.required_approvals > \? AND cr.status = \?`).
+		WithArgs(ledger.ZoneID, ledger.LedgerID, int32(2), ChangeRequestStatusPending).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	sqlDBMock.ExpectExec(`UPDATE ledgers SET required_approvals = \? WHERE zone_id = \? AND ledger_id = \?`).
+		WithArgs(int32(2), ledger.ZoneID, ledger.LedgerID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlDBMock.ExpectQuery(`SELECT zone_id, ledger_id, created_at, updated_at, kind, name, ref, candidate_ref, required_approvals FROM ledgers WHERE zone_id = \? and ledger_id = \?`).
+		WithArgs(ledger.ZoneID, ledger.LedgerID).
+		WillReturnRows(sqlLedgerRows)
+
+	tx, _ := sqlDB.Begin()
+	{ // Test with invalid required approvals
+		_, err := repository.UpdateLedgerRequiredApprovals(tx, ledger.ZoneID, ledger.LedgerID, -1)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with the protection lowered while the ledger has pending change requests
+		_, err := repository.UpdateLedgerRequiredApprovals(tx, ledger.ZoneID, ledger.LedgerID, 0)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUpdateConflict, err), "error should be errclientupdateconflict")
+	}
+
+	dbOutLedger, err := repository.UpdateLedgerRequiredApprovals(tx, ledger.ZoneID, ledger.LedgerID, 2)
+	assert.Nil(err, "error should be nil")
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Equal(ledger.LedgerID, dbOutLedger.LedgerID, "ledger id is not correct")
+}
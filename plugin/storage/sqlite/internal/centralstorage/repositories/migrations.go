@@ -0,0 +1,32 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// GetMigrationVersion retrieves the version of the latest applied migration.
+func (r *Repository) GetMigrationVersion(db *sqlx.DB) (int64, error) {
+	var version int64
+	err := db.QueryRow("SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version").Scan(&version)
+	if err != nil {
+		return 0, WrapSqlite3Error("failed to retrieve the migration version - operation 'retrieve-migration-version' encountered an issue", err)
+	}
+	return version, nil
+}
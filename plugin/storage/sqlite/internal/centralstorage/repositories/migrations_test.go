@@ -0,0 +1,66 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azidbtestutils "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories/testutils"
+)
+
+// TestRepoGetMigrationVersionWithSuccess tests the retrieval of the migration version with success.
+func TestRepoGetMigrationVersionWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	ledger := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version")).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(7))
+
+	version, err := ledger.GetMigrationVersion(sqlDB)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Equal(int64(7), version, "version is not correct")
+	assert.Nil(err, "error should be nil")
+}
+
+// TestRepoGetMigrationVersionWithErrors tests the retrieval of the migration version with errors.
+func TestRepoGetMigrationVersionWithErrors(t *testing.T) {
+	assert := assert.New(t)
+	ledger := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version")).
+		WillReturnError(errors.New("no such table: goose_db_version"))
+
+	version, err := ledger.GetMigrationVersion(sqlDB)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Equal(int64(0), version, "version should be zero")
+	assert.NotNil(err, "error should be not nil")
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrStorageGeneric, err), "error should be errstoragegeneric")
+}
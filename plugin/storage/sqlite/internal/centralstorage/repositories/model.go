@@ -92,13 +92,15 @@ func LogTenantEntry(tenant *Tenant) string {
 
 // Ledger is the model for the schema table.
 type Ledger struct {
-	LedgerID  string    `db:"ledger_id"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
-	ZoneID    int64     `db:"zone_id"`
-	Kind      int16     `db:"kind"`
-	Name      string    `db:"name"`
-	Ref       string    `db:"ref"`
+	LedgerID          string    `db:"ledger_id"`
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+	ZoneID            int64     `db:"zone_id"`
+	Kind              int16     `db:"kind"`
+	Name              string    `db:"name"`
+	Ref               string    `db:"ref"`
+	CandidateRef      string    `db:"candidate_ref"`
+	RequiredApprovals int32     `db:"required_approvals"`
 }
 
 // LogLedgerEntry returns a string representation of the ledger.
@@ -123,3 +125,140 @@ func LogKeyValueEntry(keyValue *KeyValue) string {
 	}
 	return fmt.Sprintf("keyvalue key: %s", keyValue.Key)
 }
+
+// Entity is the model for the entity table.
+type Entity struct {
+	ZoneID     int64     `db:"zone_id"`
+	EntityType string    `db:"entity_type"`
+	EntityID   string    `db:"entity_id"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+	Attributes string    `db:"attributes"`
+	Parents    string    `db:"parents"`
+}
+
+// LogEntityEntry returns a string representation of the entity.
+func LogEntityEntry(entity *Entity) string {
+	if entity == nil {
+		return "entity is nil"
+	}
+	return fmt.Sprintf("entity type: %s, entity id: %s, zone id: %d", entity.EntityType, entity.EntityID, entity.ZoneID)
+}
+
+// EntityUID is the unique identifier of an entity within a zone.
+type EntityUID struct {
+	EntityType string
+	EntityID   string
+}
+
+// TemplateLink is the model for the template_links table.
+type TemplateLink struct {
+	ZoneID     int64     `db:"zone_id"`
+	LedgerID   string    `db:"ledger_id"`
+	LinkID     string    `db:"link_id"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+	TemplateID string    `db:"template_id"`
+	Slots      string    `db:"slots"`
+}
+
+// LogTemplateLinkEntry returns a string representation of the template link.
+func LogTemplateLinkEntry(link *TemplateLink) string {
+	if link == nil {
+		return "template link is nil"
+	}
+	return fmt.Sprintf("link id: %s, template id: %s, ledger id: %s, zone id: %d", link.LinkID, link.TemplateID, link.LedgerID, link.ZoneID)
+}
+
+// ChangeRequest is the model for the change_requests table.
+type ChangeRequest struct {
+	ChangeRequestID   string    `db:"change_request_id"`
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+	ZoneID            int64     `db:"zone_id"`
+	LedgerID          string    `db:"ledger_id"`
+	BaseRef           string    `db:"base_ref"`
+	CommitID          string    `db:"commit_id"`
+	Author            string    `db:"author"`
+	Plan              string    `db:"plan"`
+	Status            string    `db:"status"`
+	RequiredApprovals int32     `db:"required_approvals"`
+}
+
+// LogChangeRequestEntry returns a string representation of the change request.
+func LogChangeRequestEntry(changeRequest *ChangeRequest) string {
+	if changeRequest == nil {
+		return "change request is nil"
+	}
+	return fmt.Sprintf("change request id: %s, ledger id: %s, zone id: %d, commit id: %s", changeRequest.ChangeRequestID, changeRequest.LedgerID, changeRequest.ZoneID, changeRequest.CommitID)
+}
+
+// ChangeRequestReview is the model for the change_request_reviews table.
+type ChangeRequestReview struct {
+	ZoneID          int64     `db:"zone_id"`
+	ChangeRequestID string    `db:"change_request_id"`
+	Reviewer        string    `db:"reviewer"`
+	CreatedAt       time.Time `db:"created_at"`
+	Decision        string    `db:"decision"`
+	Comment         string    `db:"comment"`
+}
+
+// LogChangeRequestReviewEntry returns a string representation of the change request review.
+func LogChangeRequestReviewEntry(review *ChangeRequestReview) string {
+	if review == nil {
+		return "change request review is nil"
+	}
+	return fmt.Sprintf("change request id: %s, reviewer: %s, decision: %s", review.ChangeRequestID, review.Reviewer, review.Decision)
+}
+
+// Webhook is the model for the webhooks table.
+type Webhook struct {
+	WebhookID string    `db:"webhook_id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	ZoneID    int64     `db:"zone_id"`
+	Name      string    `db:"name"`
+	URL       string    `db:"url"`
+	Secret    string    `db:"secret"`
+	Events    string    `db:"events"`
+}
+
+// LogWebhookEntry returns a string representation of the webhook.
+func LogWebhookEntry(webhook *Webhook) string {
+	if webhook == nil {
+		return "webhook is nil"
+	}
+	return fmt.Sprintf("webhook id: %s, zone id: %d, name: %s, url: %s", webhook.WebhookID, webhook.ZoneID, webhook.Name, webhook.URL)
+}
+
+// WebhookDelivery is the model for the webhook deliveries table.
+type WebhookDelivery struct {
+	DeliveryID     int64     `db:"delivery_id"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+	ZoneID         int64     `db:"zone_id"`
+	WebhookID      string    `db:"webhook_id"`
+	ChangeStreamID int64     `db:"change_stream_id"`
+	Event          string    `db:"event"`
+	Payload        string    `db:"payload"`
+	Status         string    `db:"status"`
+	Attempts       int32     `db:"attempts"`
+	NextAttemptAt  time.Time `db:"next_attempt_at"`
+	LastStatusCode int32     `db:"last_status_code"`
+	LastError      string    `db:"last_error"`
+}
+
+// LogWebhookDeliveryEntry returns a string representation of the webhook delivery.
+func LogWebhookDeliveryEntry(delivery *WebhookDelivery) string {
+	if delivery == nil {
+		return "webhook delivery is nil"
+	}
+	return fmt.Sprintf("delivery id: %d, webhook id: %s, event: %s, status: %s", delivery.DeliveryID, delivery.WebhookID, delivery.Event, delivery.Status)
+}
+
+// WebhookDispatch is a due webhook delivery joined with the endpoint and the secret of its webhook.
+type WebhookDispatch struct {
+	WebhookDelivery
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
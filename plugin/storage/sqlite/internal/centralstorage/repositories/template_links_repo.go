@@ -0,0 +1,192 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azvalidators "github.com/permguard/permguard/pkg/core/validators"
+)
+
+const (
+	// TemplateLinkType is the type of the template link.
+	TemplateLinkType = "template link"
+)
+
+const (
+	// errorMessageTemplateLinkInvalidZoneID is the error message template link invalid zone id.
+	errorMessageTemplateLinkInvalidZoneID = "invalid client input - zone id is not valid (id: %d)"
+	// errorMessageTemplateLinkInvalidLedgerID is the error message template link invalid ledger id.
+	errorMessageTemplateLinkInvalidLedgerID = "invalid client input - ledger id is not valid (id: %s)"
+	// templateLinkSelectColumns are the columns selected for the template links.
+	templateLinkSelectColumns = "zone_id, ledger_id, link_id, created_at, updated_at, template_id, slots"
+)
+
+// validateTemplateLinkLedger validates the zone id and the ledger id of a template link.
+func validateTemplateLinkLedger(zoneID int64, ledgerID string) error {
+	if err := azvalidators.ValidateCodeID(TemplateLinkType, zoneID); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageTemplateLinkInvalidZoneID, zoneID), err)
+	}
+	if err := azvalidators.ValidateUUID(TemplateLinkType, ledgerID); err != nil {
+		return azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageTemplateLinkInvalidLedgerID, ledgerID), err)
+	}
+	return nil
+}
+
+// CreateTemplateLink creates a template link.
+func (r *Repository) CreateTemplateLink(tx *sql.Tx, link *TemplateLink) (*TemplateLink, error) {
+	if link == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - template link data is missing or malformed (%s)", LogTemplateLinkEntry(link)))
+	}
+	if err := validateTemplateLinkLedger(link.ZoneID, link.LedgerID); err != nil {
+		return nil, err
+	}
+	linkID := link.LinkID
+	if err := azvalidators.ValidateName(TemplateLinkType, linkID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - template link id is not valid (id: %s)", linkID), err)
+	}
+	if strings.TrimSpace(link.TemplateID) == "" {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - template id is not valid (%s)", LogTemplateLinkEntry(link)))
+	}
+	_, err := tx.Exec("INSERT INTO template_links (zone_id, ledger_id, link_id, template_id, slots) VALUES (?, ?, ?, ?, ?)",
+		link.ZoneID, link.LedgerID, linkID, link.TemplateID, link.Slots)
+	if err != nil {
+		params := map[string]string{WrapSqlite3ParamForeignKey: "ledger id"}
+		return nil, WrapSqlite3ErrorWithParams(fmt.Sprintf("failed to create template link - operation 'create-template-link' encountered an issue (%s)", LogTemplateLinkEntry(link)), err, params)
+	}
+	var dbLink TemplateLink
+	err = tx.QueryRow("SELECT "+templateLinkSelectColumns+" FROM template_links WHERE ledger_id = ? and link_id = ?", link.LedgerID, linkID).Scan(
+		&dbLink.ZoneID,
+		&dbLink.LedgerID,
+		&dbLink.LinkID,
+		&dbLink.CreatedAt,
+		&dbLink.UpdatedAt,
+		&dbLink.TemplateID,
+		&dbLink.Slots,
+	)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve template link - operation 'retrieve-created-template-link' encountered an issue (%s)", LogTemplateLinkEntry(link)), err)
+	}
+	return &dbLink, nil
+}
+
+// DeleteTemplateLink deletes a template link.
+func (r *Repository) DeleteTemplateLink(tx *sql.Tx, zoneID int64, ledgerID string, linkID string) (*TemplateLink, error) {
+	if err := validateTemplateLinkLedger(zoneID, ledgerID); err != nil {
+		return nil, err
+	}
+	var dbLink TemplateLink
+	err := tx.QueryRow("SELECT "+templateLinkSelectColumns+" FROM template_links WHERE zone_id = ? and ledger_id = ? and link_id = ?", zoneID, ledgerID, linkID).Scan(
+		&dbLink.ZoneID,
+		&dbLink.LedgerID,
+		&dbLink.LinkID,
+		&dbLink.CreatedAt,
+		&dbLink.UpdatedAt,
+		&dbLink.TemplateID,
+		&dbLink.Slots,
+	)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("invalid client input - template link id is not valid (id: %s)", linkID), err)
+	}
+	res, err := tx.Exec("DELETE FROM template_links WHERE zone_id = ? and ledger_id = ? and link_id = ?", zoneID, ledgerID, linkID)
+	if err != nil || res == nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to delete template link - operation 'delete-template-link' encountered an issue (id: %s)", linkID), err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil || rows != 1 {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to delete template link - operation 'delete-template-link' could not find the template link (id: %s)", linkID), err)
+	}
+	return &dbLink, nil
+}
+
+// FetchTemplateLinks retrieves the template links of a ledger.
+func (r *Repository) FetchTemplateLinks(db *sqlx.DB, page int32, pageSize int32, zoneID int64, ledgerID string, filterTemplateID *string, filterLinkID *string) ([]TemplateLink, error) {
+	if page <= 0 || pageSize <= 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	if err := validateTemplateLinkLedger(zoneID, ledgerID); err != nil {
+		return nil, err
+	}
+
+	var dbLinks []TemplateLink
+
+	baseQuery := "SELECT " + templateLinkSelectColumns + " FROM template_links"
+	var conditions []string
+	var args []any
+
+	conditions = append(conditions, "zone_id = ?", "ledger_id = ?")
+	args = append(args, zoneID, ledgerID)
+
+	if filterTemplateID != nil {
+		conditions = append(conditions, "template_id = ?")
+		args = append(args, *filterTemplateID)
+	}
+
+	if filterLinkID != nil {
+		conditions = append(conditions, "link_id = ?")
+		args = append(args, *filterLinkID)
+	}
+
+	baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+	baseQuery += " ORDER BY link_id ASC"
+
+	limit := pageSize
+	offset := (page - 1) * pageSize
+	baseQuery += " LIMIT ? OFFSET ?"
+
+	args = append(args, limit, offset)
+
+	err := db.Select(&dbLinks, baseQuery, args...)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve template links - operation 'retrieve-template-links' encountered an issue with parameters %v", args), err)
+	}
+
+	return dbLinks, nil
+}
+
+// FetchAllTemplateLinks retrieves all the template links of a ledger.
+func (r *Repository) FetchAllTemplateLinks(db *sqlx.DB, zoneID int64, ledgerID string) ([]TemplateLink, error) {
+	if err := validateTemplateLinkLedger(zoneID, ledgerID); err != nil {
+		return nil, err
+	}
+	var dbLinks []TemplateLink
+	err := db.Select(&dbLinks, "SELECT "+templateLinkSelectColumns+" FROM template_links WHERE zone_id = ? AND ledger_id = ? ORDER BY link_id ASC", zoneID, ledgerID)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve template links - operation 'retrieve-all-template-links' encountered an issue (zone id: %d, ledger id: %s)", zoneID, ledgerID), err)
+	}
+	return dbLinks, nil
+}
+
+// FetchTemplateLinksVersion retrieves the version of the template links of a ledger, the version changes whenever a link is created or deleted.
+func (r *Repository) FetchTemplateLinksVersion(db *sqlx.DB, zoneID int64, ledgerID string) (string, error) {
+	if err := validateTemplateLinkLedger(zoneID, ledgerID); err != nil {
+		return "", err
+	}
+	var count int64
+	var lastUpdatedAt sql.NullString
+	err := db.QueryRowx("SELECT COUNT(*), MAX(updated_at) FROM template_links WHERE zone_id = ? AND ledger_id = ?", zoneID, ledgerID).Scan(&count, &lastUpdatedAt)
+	if err != nil {
+		return "", WrapSqlite3Error(fmt.Sprintf("failed to retrieve template links version - operation 'retrieve-template-links-version' encountered an issue (zone id: %d, ledger id: %s)", zoneID, ledgerID), err)
+	}
+	return fmt.Sprintf("%d:%s", count, lastUpdatedAt.String), nil
+}
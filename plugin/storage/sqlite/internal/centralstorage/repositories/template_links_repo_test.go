@@ -0,0 +1,198 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azidbtestutils "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories/testutils"
+)
+
+// registerTemplateLinkForMocking registers a template link for mocking.
+func registerTemplateLinkForMocking() (*TemplateLink, *sqlmock.Rows) {
+	link := &TemplateLink{
+		ZoneID:     581616507495,
+		LedgerID:   "c3160a533ab24fbcb1eab7a09fd85f36",
+		LinkID:     "share-document-x-with-y",
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		TemplateID: "share-document",
+		Slots:      `{"?principal":{"type":"MagicFarmacia::Platform::User","id":"y"}}`,
+	}
+	sqlRows := sqlmock.NewRows([]string{"zone_id", "ledger_id", "link_id", "created_at", "updated_at", "template_id", "slots"}).
+		AddRow(link.ZoneID, link.LedgerID, link.LinkID, link.CreatedAt, link.UpdatedAt, link.TemplateID, link.Slots)
+	return link, sqlRows
+}
+
+// TestRepoCreateTemplateLinkWithInvalidInput tests the creation of a template link with invalid input.
+func TestRepoCreateTemplateLinkWithInvalidInput(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, _ := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	tx, _ := sqlDB.Begin()
+
+	tests := []*TemplateLink{
+		nil,
+		{LedgerID: "c3160a533ab24fbcb1eab7a09fd85f36", LinkID: "share", TemplateID: "share-document"},
+		{ZoneID: 581616507495, LedgerID: "not-a-ledger", LinkID: "share", TemplateID: "share-document"},
+		{ZoneID: 581616507495, LedgerID: "c3160a533ab24fbcb1eab7a09fd85f36", LinkID: "Share", TemplateID: "share-document"},
+		{ZoneID: 581616507495, LedgerID: "c3160a533ab24fbcb1eab7a09fd85f36", LinkID: "share", TemplateID: " "},
+	}
+	for _, test := range tests {
+		_, err := repository.CreateTemplateLink(tx, test)
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+}
+
+// TestRepoCreateTemplateLinkWithSuccess tests the creation of a template link with success.
+func TestRepoCreateTemplateLinkWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	link, sqlLinkRows := registerTemplateLinkForMocking()
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectExec(`INSERT INTO template_links \(zone_id, ledger_id, link_id, template_id, slots\) VALUES \(\?, \?, \?, \?, \?\)`).
+		WithArgs(link.ZoneID, link.LedgerID, link.LinkID, link.TemplateID, link.Slots).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlDBMock.ExpectQuery(`SELECT zone_id, ledger_id, link_id, created_at, updated_at, template_id, slots FROM template_links WHERE ledger_id = \? and link_id = \?`).
+		WithArgs(link.LedgerID, link.LinkID).
+		WillReturnRows(sqlLinkRows)
+
+	tx, _ := sqlDB.Begin()
+	dbOutLink, err := repository.CreateTemplateLink(tx, &TemplateLink{
+		ZoneID:     link.ZoneID,
+		LedgerID:   link.LedgerID,
+		LinkID:     link.LinkID,
+		TemplateID: link.TemplateID,
+		Slots:      link.Slots,
+	})
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.NotNil(dbOutLink, "template link should be not nil")
+	assert.Equal(link.LinkID, dbOutLink.LinkID, "template link id is not correct")
+	assert.Equal(link.Slots, dbOutLink.Slots, "template link slots are not correct")
+}
+
+// TestRepoDeleteTemplateLinkWithSuccess tests the deletion of a template link with success.
+func TestRepoDeleteTemplateLinkWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	link, sqlLinkRows := registerTemplateLinkForMocking()
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectQuery(`SELECT zone_id, ledger_id, link_id, created_at, updated_at, template_id, slots FROM template_links WHERE zone_id = \? and ledger_id = \? and link_id = \?`).
+		WithArgs(link.ZoneID, link.LedgerID, link.LinkID).
+		WillReturnRows(sqlLinkRows)
+	sqlDBMock.ExpectExec(`DELETE FROM template_links WHERE zone_id = \? and ledger_id = \? and link_id = \?`).
+		WithArgs(link.ZoneID, link.LedgerID, link.LinkID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	tx, _ := sqlDB.Begin()
+	dbOutLink, err := repository.DeleteTemplateLink(tx, link.ZoneID, link.LedgerID, link.LinkID)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Equal(link.TemplateID, dbOutLink.TemplateID, "template id is not correct")
+}
+
+// TestRepoFetchTemplateLinksWithSuccess tests the fetch of the template links with success.
+func TestRepoFetchTemplateLinksWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, _, sqlDB, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	link, sqlLinkRows := registerTemplateLinkForMocking()
+
+	page := int32(1)
+	pageSize := int32(100)
+	templateID := link.TemplateID
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT zone_id, ledger_id, link_id, created_at, updated_at, template_id, slots FROM template_links WHERE zone_id = ? AND ledger_id = ? AND template_id = ? ORDER BY link_id ASC LIMIT ? OFFSET ?")).
+		WithArgs(link.ZoneID, link.LedgerID, templateID, pageSize, int32(0)).
+		WillReturnRows(sqlLinkRows)
+
+	dbOutLinks, err := repository.FetchTemplateLinks(sqlDB, page, pageSize, link.ZoneID, link.LedgerID, &templateID, nil)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Len(dbOutLinks, 1, "template links should be one")
+
+	_, err = repository.FetchTemplateLinks(sqlDB, 0, pageSize, link.ZoneID, link.LedgerID, nil, nil)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
+}
+
+// TestRepoFetchAllTemplateLinksWithSuccess tests the fetch of all the template links of a ledger.
+func TestRepoFetchAllTemplateLinksWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, _, sqlDB, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	link, sqlLinkRows := registerTemplateLinkForMocking()
+
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT zone_id, ledger_id, link_id, created_at, updated_at, template_id, slots FROM template_links WHERE zone_id = ? AND ledger_id = ? ORDER BY link_id ASC")).
+		WithArgs(link.ZoneID, link.LedgerID).
+		WillReturnRows(sqlLinkRows)
+
+	dbOutLinks, err := repository.FetchAllTemplateLinks(sqlDB, link.ZoneID, link.LedgerID)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Len(dbOutLinks, 1, "template links should be one")
+}
+
+// TestRepoFetchTemplateLinksVersionWithSuccess tests the fetch of the version of the template links of a ledger.
+func TestRepoFetchTemplateLinksVersionWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, _, sqlDB, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	link, _ := registerTemplateLinkForMocking()
+
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*), MAX(updated_at) FROM template_links WHERE zone_id = ? AND ledger_id = ?")).
+		WithArgs(link.ZoneID, link.LedgerID).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "max"}).AddRow(2, "2024-01-01 10:00:00.000"))
+
+	version, err := repository.FetchTemplateLinksVersion(sqlDB, link.ZoneID, link.LedgerID)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Equal("2:2024-01-01 10:00:00.000", version, "version should be equal")
+}
@@ -83,6 +83,28 @@ func (r *Repository) UpsertTenant(tx *sql.Tx, isCreate bool, tenant *Tenant) (*T
 	return &dbTenant, nil
 }
 
+// ReadTenantByName reads a tenant by name within the transaction.
+func (r *Repository) ReadTenantByName(tx *sql.Tx, zoneID int64, name string) (*Tenant, error) {
+	if err := azvalidators.ValidateCodeID("tenant", zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageTenantInvalidZoneID, zoneID), err)
+	}
+	var dbTenant Tenant
+	err := tx.QueryRow("SELECT zone_id, tenant_id, created_at, updated_at, name FROM tenants WHERE zone_id = ? and name = ?", zoneID, name).Scan(
+		&dbTenant.ZoneID,
+		&dbTenant.TenantID,
+		&dbTenant.CreatedAt,
+		&dbTenant.UpdatedAt,
+		&dbTenant.Name,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("tenant not found (zone_id: %d, name: %s)", zoneID, name), err)
+		}
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve tenant - operation 'retrieve-tenant-by-name' encountered an issue (name: %s)", name), err)
+	}
+	return &dbTenant, nil
+}
+
 // DeleteTenant deletes an tenant.
 func (r *Repository) DeleteTenant(tx *sql.Tx, zoneID int64, tenantID string) (*Tenant, error) {
 	if err := azvalidators.ValidateCodeID("tenant", zoneID); err != nil {
@@ -115,7 +137,7 @@ func (r *Repository) DeleteTenant(tx *sql.Tx, zoneID int64, tenantID string) (*T
 }
 
 // FetchTenants retrieves tenants.
-func (r *Repository) FetchTenants(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]Tenant, error) {
+func (r *Repository) FetchTenants(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, options *FetchOptions) ([]Tenant, error) {
 	if page <= 0 || pageSize <= 0 {
 		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
 	}
@@ -151,19 +173,24 @@ func (r *Repository) FetchTenants(db *sqlx.DB, page int32, pageSize int32, zoneI
 		args = append(args, tenantName)
 	}
 
+	conditions, args, orderBy, err := applyFetchOptions("tenant", "tenant_id", options, conditions, args)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(conditions) > 0 {
 		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	baseQuery += " ORDER BY tenant_id ASC"
+	baseQuery += orderBy
 
 	limit := pageSize
-	offset := (page - 1) * pageSize
+	offset := options.fetchOffset(page, pageSize)
 	baseQuery += " LIMIT ? OFFSET ?"
 
 	args = append(args, limit, offset)
 
-	err := db.Select(&dbTenants, baseQuery, args...)
+	err = db.Select(&dbTenants, baseQuery, args...)
 	if err != nil {
 		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve tenants - operation 'retrieve-tenants' encountered an issue with parameters %v", args), err)
 	}
@@ -362,34 +362,34 @@ func TestRepoFetchTenantWithInvalidInput(t *testing.T) {
 	defer sqlDB.Close()
 
 	{ // Test with invalid page
-		_, err := ledger.FetchTenants(sqlDB, 0, 100, 581616507495, nil, nil)
+		_, err := ledger.FetchTenants(sqlDB, 0, 100, 581616507495, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid page size
-		_, err := ledger.FetchTenants(sqlDB, 1, 0, 581616507495, nil, nil)
+		_, err := ledger.FetchTenants(sqlDB, 1, 0, 581616507495, nil, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
 	}
 
 	{ // Test with invalid zone id
 		tenantID := GenerateUUID()
-		_, err := ledger.FetchTenants(sqlDB, 1, 1, 0, &tenantID, nil)
+		_, err := ledger.FetchTenants(sqlDB, 1, 1, 0, &tenantID, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
 	}
 
 	{ // Test with invalid tenant id
 		tenantID := ""
-		_, err := ledger.FetchTenants(sqlDB, 1, 1, 581616507495, &tenantID, nil)
+		_, err := ledger.FetchTenants(sqlDB, 1, 1, 581616507495, &tenantID, nil, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientID, err), "error should be errclientid")
 	}
 
 	{ // Test with invalid tenant id
 		tenantName := "@"
-		_, err := ledger.FetchTenants(sqlDB, 1, 1, 581616507495, nil, &tenantName)
+		_, err := ledger.FetchTenants(sqlDB, 1, 1, 581616507495, nil, &tenantName, nil)
 		assert.NotNil(err, "error should be not nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientName, err), "error should be errclientname")
 	}
@@ -412,7 +412,7 @@ func TestRepoFetchTenantWithSuccess(t *testing.T) {
 		WithArgs(sqlTenants[0].ZoneID, sqlTenants[0].TenantID, tenantName, pageSize, page-1).
 		WillReturnRows(sqlTenantRows)
 
-	dbOutTenant, err := ledger.FetchTenants(sqlDB, page, pageSize, sqlTenants[0].ZoneID, &sqlTenants[0].TenantID, &sqlTenants[0].Name)
+	dbOutTenant, err := ledger.FetchTenants(sqlDB, page, pageSize, sqlTenants[0].ZoneID, &sqlTenants[0].TenantID, &sqlTenants[0].Name, nil)
 
 	orderedSQLTenants := make([]Tenant, len(sqlTenants))
 	copy(orderedSQLTenants, sqlTenants)
@@ -430,3 +430,35 @@ func TestRepoFetchTenantWithSuccess(t *testing.T) {
 	}
 	assert.Nil(err, "error should be nil")
 }
+
+// TestRepoReadTenantByName tests the read of a tenant by name.
+func TestRepoReadTenantByName(t *testing.T) {
+	assert := assert.New(t)
+	ledger := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	sqlSelect := "SELECT zone_id, tenant_id, created_at, updated_at, name FROM tenants WHERE zone_id = ? and name = ?"
+	tenant := Tenant{ZoneID: 581616507495, TenantID: GenerateUUID(), Name: "rent-a-car", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).
+		WithArgs(tenant.ZoneID, tenant.Name).
+		WillReturnRows(sqlmock.NewRows([]string{"zone_id", "tenant_id", "created_at", "updated_at", "name"}).
+			AddRow(tenant.ZoneID, tenant.TenantID, tenant.CreatedAt, tenant.UpdatedAt, tenant.Name))
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).
+		WithArgs(tenant.ZoneID, "missing").
+		WillReturnRows(sqlmock.NewRows([]string{"zone_id", "tenant_id", "created_at", "updated_at", "name"}))
+
+	tx, _ := sqlDB.Begin()
+	_, err := ledger.ReadTenantByName(tx, 0, tenant.Name)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+
+	dbOutTenant, err := ledger.ReadTenantByName(tx, tenant.ZoneID, tenant.Name)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(tenant.TenantID, dbOutTenant.TenantID, "tenant id is not correct")
+
+	_, err = ledger.ReadTenantByName(tx, tenant.ZoneID, "missing")
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientNotFound, err), "error should be errclientnotfound")
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+}
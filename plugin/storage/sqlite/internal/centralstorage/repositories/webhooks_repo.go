@@ -0,0 +1,367 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azvalidators "github.com/permguard/permguard/pkg/core/validators"
+)
+
+const (
+	// WebhookType is the type of the webhook.
+	WebhookType = "webhook"
+)
+
+const (
+	// WebhookDeliveryStatusPending is the status of a delivery waiting to be sent or retried.
+	WebhookDeliveryStatusPending = "pending"
+	// WebhookDeliveryStatusDelivered is the status of a delivery acknowledged by the receiver.
+	WebhookDeliveryStatusDelivered = "delivered"
+	// WebhookDeliveryStatusDead is the status of a delivery that exhausted its retries.
+	WebhookDeliveryStatusDead = "dead"
+)
+
+const (
+	// errorMessageWebhookInvalidZoneID is the error message webhook invalid zone id.
+	errorMessageWebhookInvalidZoneID = "invalid client input - zone id is not valid (id: %d)"
+	// errorMessageWebhookInvalidID is the error message webhook invalid id.
+	errorMessageWebhookInvalidID = "invalid client input - webhook id is not valid (id: %s)"
+	// webhookSelectColumns are the columns selected for the webhooks.
+	webhookSelectColumns = "webhook_id, created_at, updated_at, zone_id, name, url, secret, events"
+	// webhookDeliverySelectColumns are the columns selected for the webhook deliveries.
+	webhookDeliverySelectColumns = "delivery_id, created_at, updated_at, zone_id, webhook_id, change_stream_id, event, payload, status, attempts, next_attempt_at, last_status_code, last_error"
+)
+
+// isValidWebhookDeliveryStatus checks if the webhook delivery status is valid.
+func isValidWebhookDeliveryStatus(status string) bool {
+	switch status {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+// validateWebhookURL validates the url of a webhook.
+func validateWebhookURL(webhookURL string) error {
+	parsedURL, err := url.Parse(webhookURL)
+	if err != nil {
+		return err
+	}
+	if (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return fmt.Errorf("repositories: url %s must be an absolute http or https url", webhookURL)
+	}
+	return nil
+}
+
+// UpsertWebhook creates or updates a webhook, the secret of an existing webhook is kept when the input secret is empty.
+func (r *Repository) UpsertWebhook(tx *sql.Tx, isCreate bool, webhook *Webhook) (*Webhook, error) {
+	if webhook == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook data is missing or malformed (%s)", LogWebhookEntry(webhook)))
+	}
+	if err := azvalidators.ValidateCodeID(WebhookType, webhook.ZoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageWebhookInvalidZoneID, webhook.ZoneID), err)
+	}
+	if !isCreate && azvalidators.ValidateUUID(WebhookType, webhook.WebhookID) != nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook id is not valid (%s)", LogWebhookEntry(webhook)))
+	}
+	if err := azvalidators.ValidateName(WebhookType, webhook.Name); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook name is not valid (%s)", LogWebhookEntry(webhook)), err)
+	}
+	if err := validateWebhookURL(webhook.URL); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook url is not valid (%s)", LogWebhookEntry(webhook)), err)
+	}
+	if strings.TrimSpace(webhook.Events) == "" {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook events are not valid (%s)", LogWebhookEntry(webhook)))
+	}
+	if isCreate && strings.TrimSpace(webhook.Secret) == "" {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook secret is not valid (%s)", LogWebhookEntry(webhook)))
+	}
+
+	zoneID := webhook.ZoneID
+	webhookID := webhook.WebhookID
+	var result sql.Result
+	var err error
+	if isCreate {
+		webhookID = GenerateUUID()
+		result, err = tx.Exec("INSERT INTO webhooks (zone_id, webhook_id, name, url, secret, events) VALUES (?, ?, ?, ?, ?, ?)",
+			zoneID, webhookID, webhook.Name, webhook.URL, webhook.Secret, webhook.Events)
+	} else {
+		result, err = tx.Exec("UPDATE webhooks SET name = ?, url = ?, events = ?, secret = CASE WHEN ? = '' THEN secret ELSE ? END, updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE zone_id = ? and webhook_id = ?",
+			webhook.Name, webhook.URL, webhook.Events, webhook.Secret, webhook.Secret, zoneID, webhookID)
+	}
+	if err != nil || result == nil {
+		action := "update"
+		if isCreate {
+			action = "create"
+		}
+		params := map[string]string{WrapSqlite3ParamForeignKey: "zone id"}
+		return nil, WrapSqlite3ErrorWithParams(fmt.Sprintf("failed to %s webhook - operation '%s-webhook' encountered an issue (%s)", action, action, LogWebhookEntry(webhook)), err, params)
+	}
+	if !isCreate {
+		if rows, err := result.RowsAffected(); err != nil || rows != 1 {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientNotFound, fmt.Sprintf("webhook not found (%s)", LogWebhookEntry(webhook)))
+		}
+	}
+	return r.readWebhook(tx, zoneID, webhookID)
+}
+
+// readWebhook reads a webhook within the transaction.
+func (r *Repository) readWebhook(tx *sql.Tx, zoneID int64, webhookID string) (*Webhook, error) {
+	var dbWebhook Webhook
+	err := tx.QueryRow("SELECT "+webhookSelectColumns+" FROM webhooks WHERE zone_id = ? and webhook_id = ?", zoneID, webhookID).Scan(
+		&dbWebhook.WebhookID,
+		&dbWebhook.CreatedAt,
+		&dbWebhook.UpdatedAt,
+		&dbWebhook.ZoneID,
+		&dbWebhook.Name,
+		&dbWebhook.URL,
+		&dbWebhook.Secret,
+		&dbWebhook.Events,
+	)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf(errorMessageWebhookInvalidID, webhookID), err)
+	}
+	return &dbWebhook, nil
+}
+
+// DeleteWebhook deletes a webhook along with its deliveries.
+func (r *Repository) DeleteWebhook(tx *sql.Tx, zoneID int64, webhookID string) (*Webhook, error) {
+	if err := azvalidators.ValidateCodeID(WebhookType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageWebhookInvalidZoneID, zoneID), err)
+	}
+	if err := azvalidators.ValidateUUID(WebhookType, webhookID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageWebhookInvalidID, webhookID), err)
+	}
+	dbWebhook, err := r.readWebhook(tx, zoneID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := tx.Exec("DELETE FROM webhooks WHERE zone_id = ? and webhook_id = ?", zoneID, webhookID)
+	if err != nil || res == nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to delete webhook - operation 'delete-webhook' encountered an issue (id: %s)", webhookID), err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil || rows != 1 {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to delete webhook - operation 'delete-webhook' could not find the webhook (id: %s)", webhookID), err)
+	}
+	return dbWebhook, nil
+}
+
+// FetchWebhooks retrieves webhooks.
+func (r *Repository) FetchWebhooks(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]Webhook, error) {
+	if page <= 0 || pageSize <= 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	if err := azvalidators.ValidateCodeID(WebhookType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf(errorMessageWebhookInvalidZoneID, zoneID), err)
+	}
+
+	var dbWebhooks []Webhook
+
+	baseQuery := "SELECT " + webhookSelectColumns + " FROM webhooks"
+	var conditions []string
+	var args []any
+
+	conditions = append(conditions, "zone_id = ?")
+	args = append(args, zoneID)
+
+	if filterID != nil {
+		webhookID := *filterID
+		if err := azvalidators.ValidateUUID(WebhookType, webhookID); err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf(errorMessageWebhookInvalidID, webhookID), err)
+		}
+		conditions = append(conditions, "webhook_id = ?")
+		args = append(args, webhookID)
+	}
+
+	if filterName != nil {
+		webhookName := *filterName
+		if err := azvalidators.ValidateName(WebhookType, webhookName); err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientName, fmt.Sprintf("invalid client input - webhook name is not valid (name: %s)", webhookName), err)
+		}
+		webhookName = "%" + webhookName + "%"
+		conditions = append(conditions, "name LIKE ?")
+		args = append(args, webhookName)
+	}
+
+	baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+	baseQuery += " ORDER BY webhook_id ASC"
+
+	limit := pageSize
+	offset := (page - 1) * pageSize
+	baseQuery += " LIMIT ? OFFSET ?"
+
+	args = append(args, limit, offset)
+
+	err := db.Select(&dbWebhooks, baseQuery, args...)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve webhooks - operation 'retrieve-webhooks' encountered an issue with parameters %v", args), err)
+	}
+
+	return dbWebhooks, nil
+}
+
+// FetchWebhookDeliveries retrieves the history of the webhook deliveries of a zone.
+func (r *Repository) FetchWebhookDeliveries(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterWebhookID *string, filterStatus *string) ([]WebhookDelivery, error) {
+	if page <= 0 || pageSize <= 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	if err := azvalidators.ValidateCodeID(WebhookType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf(errorMessageWebhookInvalidZoneID, zoneID), err)
+	}
+
+	var dbDeliveries []WebhookDelivery
+
+	baseQuery := "SELECT " + webhookDeliverySelectColumns + " FROM webhook_deliveries"
+	var conditions []string
+	var args []any
+
+	conditions = append(conditions, "zone_id = ?")
+	args = append(args, zoneID)
+
+	if filterWebhookID != nil {
+		webhookID := *filterWebhookID
+		if err := azvalidators.ValidateUUID(WebhookType, webhookID); err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientID, fmt.Sprintf(errorMessageWebhookInvalidID, webhookID), err)
+		}
+		conditions = append(conditions, "webhook_id = ?")
+		args = append(args, webhookID)
+	}
+
+	if filterStatus != nil {
+		status := *filterStatus
+		if !isValidWebhookDeliveryStatus(status) {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook delivery status is not valid (status: %s)", status))
+		}
+		conditions = append(conditions, "status = ?")
+		args = append(args, status)
+	}
+
+	baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+	baseQuery += " ORDER BY delivery_id ASC"
+
+	limit := pageSize
+	offset := (page - 1) * pageSize
+	baseQuery += " LIMIT ? OFFSET ?"
+
+	args = append(args, limit, offset)
+
+	err := db.Select(&dbDeliveries, baseQuery, args...)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve webhook deliveries - operation 'retrieve-webhook-deliveries' encountered an issue with parameters %v", args), err)
+	}
+
+	return dbDeliveries, nil
+}
+
+// FetchDueWebhookDeliveries retrieves the pending deliveries whose next attempt is due, across all the zones.
+func (r *Repository) FetchDueWebhookDeliveries(db *sqlx.DB, limit int32) ([]WebhookDispatch, error) {
+	if limit <= 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - limit %d is not valid", limit))
+	}
+	var dbDispatches []WebhookDispatch
+	query := "SELECT webhook_deliveries.delivery_id, webhook_deliveries.created_at, webhook_deliveries.updated_at, webhook_deliveries.zone_id, webhook_deliveries.webhook_id," +
+		" webhook_deliveries.change_stream_id, webhook_deliveries.event, webhook_deliveries.payload, webhook_deliveries.status, webhook_deliveries.attempts," +
+		" webhook_deliveries.next_attempt_at, webhook_deliveries.last_status_code, webhook_deliveries.last_error, webhooks.url, webhooks.secret" +
+		" FROM webhook_deliveries INNER JOIN webhooks ON webhooks.webhook_id = webhook_deliveries.webhook_id" +
+		" WHERE webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')" +
+		" ORDER BY webhook_deliveries.delivery_id ASC LIMIT ?"
+	err := db.Select(&dbDispatches, query, WebhookDeliveryStatusPending, limit)
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to retrieve due webhook deliveries - operation 'retrieve-due-webhook-deliveries' encountered an issue", err)
+	}
+	return dbDispatches, nil
+}
+
+// readWebhookDelivery reads a webhook delivery within the transaction.
+func (r *Repository) readWebhookDelivery(tx *sql.Tx, deliveryID int64) (*WebhookDelivery, error) {
+	var dbDelivery WebhookDelivery
+	err := tx.QueryRow("SELECT "+webhookDeliverySelectColumns+" FROM webhook_deliveries WHERE delivery_id = ?", deliveryID).Scan(
+		&dbDelivery.DeliveryID,
+		&dbDelivery.CreatedAt,
+		&dbDelivery.UpdatedAt,
+		&dbDelivery.ZoneID,
+		&dbDelivery.WebhookID,
+		&dbDelivery.ChangeStreamID,
+		&dbDelivery.Event,
+		&dbDelivery.Payload,
+		&dbDelivery.Status,
+		&dbDelivery.Attempts,
+		&dbDelivery.NextAttemptAt,
+		&dbDelivery.LastStatusCode,
+		&dbDelivery.LastError,
+	)
+	if err != nil {
+		return nil, WrapSqlite3Error(fmt.Sprintf("failed to retrieve webhook delivery - operation 'retrieve-webhook-delivery' encountered an issue (id: %d)", deliveryID), err)
+	}
+	return &dbDelivery, nil
+}
+
+// RecordWebhookDeliveryAttempt records the outcome of an attempt of a pending delivery, the next attempt is scheduled after the retry delay in seconds.
+func (r *Repository) RecordWebhookDeliveryAttempt(tx *sql.Tx, deliveryID int64, status string, statusCode int32, lastError string, retryDelaySeconds int64) (*WebhookDelivery, error) {
+	if !isValidWebhookDeliveryStatus(status) {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook delivery status is not valid (status: %s)", status))
+	}
+	if retryDelaySeconds < 0 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - retry delay is not valid (value: %d)", retryDelaySeconds))
+	}
+	result, err := tx.Exec("UPDATE webhook_deliveries SET status = ?, attempts = attempts + 1, last_status_code = ?, last_error = ?,"+
+		" next_attempt_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', '+' || ? || ' seconds'), updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')"+
+		" WHERE delivery_id = ? AND status = ?",
+		status, statusCode, lastError, retryDelaySeconds, deliveryID, WebhookDeliveryStatusPending)
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to record webhook delivery attempt", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to get rows affected for record webhook delivery attempt", err)
+	}
+	if rows != 1 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUpdateConflict, fmt.Sprintf("update failed, webhook delivery is not pending (delivery_id: %d)", deliveryID))
+	}
+	return r.readWebhookDelivery(tx, deliveryID)
+}
+
+// RequeueWebhookDelivery moves a dead delivery back to the outbox, resetting its attempts.
+func (r *Repository) RequeueWebhookDelivery(tx *sql.Tx, zoneID int64, deliveryID int64) (*WebhookDelivery, error) {
+	if err := azvalidators.ValidateCodeID(WebhookType, zoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf(errorMessageWebhookInvalidZoneID, zoneID), err)
+	}
+	result, err := tx.Exec("UPDATE webhook_deliveries SET status = ?, attempts = 0, last_status_code = 0, last_error = '',"+
+		" next_attempt_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW'), updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')"+
+		" WHERE zone_id = ? AND delivery_id = ? AND status = ?",
+		WebhookDeliveryStatusPending, zoneID, deliveryID, WebhookDeliveryStatusDead)
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to requeue webhook delivery", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, WrapSqlite3Error("failed to get rows affected for requeue webhook delivery", err)
+	}
+	if rows != 1 {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientUpdateConflict, fmt.Sprintf("update failed, webhook delivery is not dead (zone_id: %d, delivery_id: %d)", zoneID, deliveryID))
+	}
+	return r.readWebhookDelivery(tx, deliveryID)
+}
@@ -0,0 +1,348 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package repositories
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azidbtestutils "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories/testutils"
+)
+
+const (
+	// sqlSelectWebhook is the query selecting a webhook.
+	sqlSelectWebhook = "SELECT webhook_id, created_at, updated_at, zone_id, name, url, secret, events FROM webhooks WHERE zone_id = ? and webhook_id = ?"
+	// sqlSelectWebhookDelivery is the query selecting a webhook delivery.
+	sqlSelectWebhookDelivery = "SELECT delivery_id, created_at, updated_at, zone_id, webhook_id, change_stream_id, event, payload, status, attempts, next_attempt_at, last_status_code, last_error FROM webhook_deliveries WHERE delivery_id = ?"
+)
+
+// registerWebhookForMocking registers a webhook for mocking.
+func registerWebhookForMocking() (*Webhook, *sqlmock.Rows) {
+	webhook := &Webhook{
+		WebhookID: "2f1c9e0a7b3d4c5e8f6a1b2c3d4e5f60",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		ZoneID:    581616507495,
+		Name:      "deployments",
+		URL:       "https://hooks.example.com/permguard",
+		Secret:    "5e3b1f0c2a",
+		Events:    "ledger.ref_updated,ledger.created",
+	}
+	sqlRows := sqlmock.NewRows([]string{"webhook_id", "created_at", "updated_at", "zone_id", "name", "url", "secret", "events"}).
+		AddRow(webhook.WebhookID, webhook.CreatedAt, webhook.UpdatedAt, webhook.ZoneID, webhook.Name, webhook.URL, webhook.Secret, webhook.Events)
+	return webhook, sqlRows
+}
+
+// registerWebhookDeliveryForMocking registers a webhook delivery for mocking.
+func registerWebhookDeliveryForMocking(status string, attempts int32) (*WebhookDelivery, *sqlmock.Rows) {
+	delivery := &WebhookDelivery{
+		DeliveryID:     7,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+		ZoneID:         581616507495,
+		WebhookID:      "2f1c9e0a7b3d4c5e8f6a1b2c3d4e5f60",
+		ChangeStreamID: 42,
+		Event:          "ledger.ref_updated",
+		Payload:        `{"ledger_id": "c3160a533ab24fbcb1eab7a09fd85f36", "old_ref": "0", "new_ref": "1"}`,
+		Status:         status,
+		Attempts:       attempts,
+		NextAttemptAt:  time.Now(),
+	}
+	sqlRows := sqlmock.NewRows([]string{"delivery_id", "created_at", "updated_at", "zone_id", "webhook_id", "change_stream_id", "event", "payload", "status", "attempts", "next_attempt_at", "last_status_code", "last_error"}).
+		AddRow(delivery.DeliveryID, delivery.CreatedAt, delivery.UpdatedAt, delivery.ZoneID, delivery.WebhookID, delivery.ChangeStreamID, delivery.Event, delivery.Payload, delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.LastStatusCode, delivery.LastError)
+	return delivery, sqlRows
+}
+
+// TestRepoUpsertWebhookWithInvalidInput tests the upsert of a webhook with invalid input.
+func TestRepoUpsertWebhookWithInvalidInput(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, _ := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	tx, _ := sqlDB.Begin()
+
+	webhook, _ := registerWebhookForMocking()
+	tests := []struct {
+		IsCreate bool
+		Webhook  *Webhook
+	}{
+		{IsCreate: true, Webhook: nil},
+		{IsCreate: true, Webhook: &Webhook{Name: webhook.Name, URL: webhook.URL, Secret: webhook.Secret, Events: webhook.Events}},
+		{IsCreate: true, Webhook: &Webhook{ZoneID: webhook.ZoneID, Name: "Deployments", URL: webhook.URL, Secret: webhook.Secret, Events: webhook.Events}},
+		{IsCreate: true, Webhook: &Webhook{ZoneID: webhook.ZoneID, Name: webhook.Name, URL: "ftp://hooks.example.com", Secret: webhook.Secret, Events: webhook.Events}},
+		{IsCreate: true, Webhook: &Webhook{ZoneID: webhook.ZoneID, Name: webhook.Name, URL: "/permguard", Secret: webhook.Secret, Events: webhook.Events}},
+		{IsCreate: true, Webhook: &Webhook{ZoneID: webhook.ZoneID, Name: webhook.Name, URL: webhook.URL, Secret: webhook.Secret, Events: " "}},
+		{IsCreate: true, Webhook: &Webhook{ZoneID: webhook.ZoneID, Name: webhook.Name, URL: webhook.URL, Secret: "", Events: webhook.Events}},
+		{IsCreate: false, Webhook: &Webhook{WebhookID: "not-a-webhook", ZoneID: webhook.ZoneID, Name: webhook.Name, URL: webhook.URL, Events: webhook.Events}},
+	}
+	for _, test := range tests {
+		_, err := repository.UpsertWebhook(tx, test.IsCreate, test.Webhook)
+		assert.NotNil(err, "error should be not nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+}
+
+// TestRepoUpsertWebhookWithSuccess tests the upsert of a webhook with success.
+func TestRepoUpsertWebhookWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	tests := []bool{
+		true,
+		false,
+	}
+	for _, isCreate := range tests {
+		_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+
+		webhook, sqlWebhookRows := registerWebhookForMocking()
+
+		sqlDBMock.ExpectBegin()
+		if isCreate {
+			sqlDBMock.ExpectExec(regexp.QuoteMeta("INSERT INTO webhooks (zone_id, webhook_id, name, url, secret, events) VALUES (?, ?, ?, ?, ?, ?)")).
+				WithArgs(webhook.ZoneID, sqlmock.AnyArg(), webhook.Name, webhook.URL, webhook.Secret, webhook.Events).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+		} else {
+			sqlDBMock.ExpectExec(regexp.QuoteMeta("UPDATE webhooks SET name = ?, url = ?, events = ?, secret = CASE WHEN ? = '' THEN secret ELSE ? END, updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE zone_id = ? and webhook_id = ?")).
+				WithArgs(webhook.Name, webhook.URL, webhook.Events, "", "", webhook.ZoneID, webhook.WebhookID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelectWebhook)).
+			WithArgs(webhook.ZoneID, sqlmock.AnyArg()).
+			WillReturnRows(sqlWebhookRows)
+
+		tx, _ := sqlDB.Begin()
+		inWebhook := &Webhook{ZoneID: webhook.ZoneID, Name: webhook.Name, URL: webhook.URL, Events: webhook.Events}
+		if isCreate {
+			inWebhook.Secret = webhook.Secret
+		} else {
+			inWebhook.WebhookID = webhook.WebhookID
+		}
+		dbOutWebhook, err := repository.UpsertWebhook(tx, isCreate, inWebhook)
+
+		assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+		assert.Nil(err, "error should be nil")
+		assert.Equal(webhook.Secret, dbOutWebhook.Secret, "webhook secret is not correct")
+		assert.Equal(webhook.Events, dbOutWebhook.Events, "webhook events are not correct")
+		sqlDB.Close()
+	}
+}
+
+// TestRepoUpdateWebhookWithNotFound tests the update of a webhook which does not exist.
+func TestRepoUpdateWebhookWithNotFound(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	webhook, _ := registerWebhookForMocking()
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectExec(regexp.QuoteMeta("UPDATE webhooks SET")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	tx, _ := sqlDB.Begin()
+	_, err := repository.UpsertWebhook(tx, false, webhook)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientNotFound, err), "error should be errclientnotfound")
+}
+
+// TestRepoDeleteWebhookWithSuccess tests the deletion of a webhook with success.
+func TestRepoDeleteWebhookWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	webhook, sqlWebhookRows := registerWebhookForMocking()
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelectWebhook)).
+		WithArgs(webhook.ZoneID, webhook.WebhookID).
+		WillReturnRows(sqlWebhookRows)
+	sqlDBMock.ExpectExec(regexp.QuoteMeta("DELETE FROM webhooks WHERE zone_id = ? and webhook_id = ?")).
+		WithArgs(webhook.ZoneID, webhook.WebhookID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	tx, _ := sqlDB.Begin()
+	dbOutWebhook, err := repository.DeleteWebhook(tx, webhook.ZoneID, webhook.WebhookID)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Equal(webhook.WebhookID, dbOutWebhook.WebhookID, "webhook id is not correct")
+}
+
+// TestRepoFetchWebhooksWithSuccess tests the fetch of webhooks with success.
+func TestRepoFetchWebhooksWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	webhook, sqlWebhookRows := registerWebhookForMocking()
+	webhookName := webhook.Name
+
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT webhook_id, created_at, updated_at, zone_id, name, url, secret, events FROM webhooks WHERE zone_id = ? AND webhook_id = ? AND name LIKE ? ORDER BY webhook_id ASC LIMIT ? OFFSET ?")).
+		WithArgs(webhook.ZoneID, webhook.WebhookID, "%"+webhookName+"%", int32(100), int32(0)).
+		WillReturnRows(sqlWebhookRows)
+
+	dbOutWebhooks, err := repository.FetchWebhooks(sqlDB, 1, 100, webhook.ZoneID, &webhook.WebhookID, &webhookName)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Len(dbOutWebhooks, 1, "webhooks should have one item")
+	assert.Equal(webhook.URL, dbOutWebhooks[0].URL, "webhook url is not correct")
+}
+
+// TestRepoFetchWebhookDeliveries tests the fetch of the webhook deliveries.
+func TestRepoFetchWebhookDeliveries(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	delivery, sqlDeliveryRows := registerWebhookDeliveryForMocking(WebhookDeliveryStatusDead, 8)
+	status := WebhookDeliveryStatusDead
+
+	{ // Test with invalid status
+		invalidStatus := "failed"
+		_, err := repository.FetchWebhookDeliveries(sqlDB, 1, 100, delivery.ZoneID, nil, &invalidStatus)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("SELECT "+webhookDeliverySelectColumns+" FROM webhook_deliveries WHERE zone_id = ? AND webhook_id = ? AND status = ? ORDER BY delivery_id ASC LIMIT ? OFFSET ?")).
+		WithArgs(delivery.ZoneID, delivery.WebhookID, status, int32(100), int32(0)).
+		WillReturnRows(sqlDeliveryRows)
+
+	dbOutDeliveries, err := repository.FetchWebhookDeliveries(sqlDB, 1, 100, delivery.ZoneID, &delivery.WebhookID, &status)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Len(dbOutDeliveries, 1, "deliveries should have one item")
+	assert.Equal(delivery.Attempts, dbOutDeliveries[0].Attempts, "delivery attempts are not correct")
+}
+
+// TestRepoFetchDueWebhookDeliveries tests the fetch of the due webhook deliveries.
+func TestRepoFetchDueWebhookDeliveries(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	delivery, _ := registerWebhookDeliveryForMocking(WebhookDeliveryStatusPending, 0)
+	webhook, _ := registerWebhookForMocking()
+	sqlRows := sqlmock.NewRows([]string{"delivery_id", "created_at", "updated_at", "zone_id", "webhook_id", "change_stream_id", "event", "payload", "status", "attempts", "next_attempt_at", "last_status_code", "last_error", "url", "secret"}).
+		AddRow(delivery.DeliveryID, delivery.CreatedAt, delivery.UpdatedAt, delivery.ZoneID, delivery.WebhookID, delivery.ChangeStreamID, delivery.Event, delivery.Payload, delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.LastStatusCode, delivery.LastError, webhook.URL, webhook.Secret)
+
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta("FROM webhook_deliveries INNER JOIN webhooks ON webhooks.webhook_id = webhook_deliveries.webhook_id")).
+		WithArgs(WebhookDeliveryStatusPending, int32(10)).
+		WillReturnRows(sqlRows)
+
+	dbOutDispatches, err := repository.FetchDueWebhookDeliveries(sqlDB, 10)
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+	assert.Nil(err, "error should be nil")
+	assert.Len(dbOutDispatches, 1, "dispatches should have one item")
+	assert.Equal(delivery.DeliveryID, dbOutDispatches[0].DeliveryID, "delivery id is not correct")
+	assert.Equal(webhook.URL, dbOutDispatches[0].URL, "webhook url is not correct")
+	assert.Equal(webhook.Secret, dbOutDispatches[0].Secret, "webhook secret is not correct")
+}
+
+// TestRepoRecordWebhookDeliveryAttempt tests the recording of a webhook delivery attempt.
+func TestRepoRecordWebhookDeliveryAttempt(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	delivery, sqlDeliveryRows := registerWebhookDeliveryForMocking(WebhookDeliveryStatusPending, 1)
+	sqlUpdate := regexp.QuoteMeta("UPDATE webhook_deliveries SET status = ?, attempts = attempts + 1")
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectExec(sqlUpdate).
+		WithArgs(WebhookDeliveryStatusPending, int32(500), "unexpected status code", int64(20), delivery.DeliveryID, WebhookDeliveryStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelectWebhookDelivery)).
+		WithArgs(delivery.DeliveryID).
+		WillReturnRows(sqlDeliveryRows)
+	sqlDBMock.ExpectExec(sqlUpdate).
+		WithArgs(WebhookDeliveryStatusDelivered, int32(200), "", int64(0), delivery.DeliveryID, WebhookDeliveryStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	tx, _ := sqlDB.Begin()
+	{ // Test with invalid input
+		_, err := repository.RecordWebhookDeliveryAttempt(tx, delivery.DeliveryID, "failed", 500, "", 0)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+		_, err = repository.RecordWebhookDeliveryAttempt(tx, delivery.DeliveryID, WebhookDeliveryStatusPending, 500, "", -1)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	dbOutDelivery, err := repository.RecordWebhookDeliveryAttempt(tx, delivery.DeliveryID, WebhookDeliveryStatusPending, 500, "unexpected status code", 20)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(delivery.DeliveryID, dbOutDelivery.DeliveryID, "delivery id is not correct")
+
+	_, err = repository.RecordWebhookDeliveryAttempt(tx, delivery.DeliveryID, WebhookDeliveryStatusDelivered, 200, "", 0)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUpdateConflict, err), "error should be errclientupdateconflict")
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+}
+
+// TestRepoRequeueWebhookDelivery tests the requeue of a dead webhook delivery.
+func TestRepoRequeueWebhookDelivery(t *testing.T) {
+	assert := assert.New(t)
+	repository := Repository{}
+
+	_, sqlDB, _, sqlDBMock := azidbtestutils.CreateConnectionMocks(t)
+	defer sqlDB.Close()
+
+	delivery, sqlDeliveryRows := registerWebhookDeliveryForMocking(WebhookDeliveryStatusPending, 0)
+	sqlUpdate := regexp.QuoteMeta("UPDATE webhook_deliveries SET status = ?, attempts = 0")
+
+	sqlDBMock.ExpectBegin()
+	sqlDBMock.ExpectExec(sqlUpdate).
+		WithArgs(WebhookDeliveryStatusPending, delivery.ZoneID, delivery.DeliveryID, WebhookDeliveryStatusDead).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlDBMock.ExpectQuery(regexp.QuoteMeta(sqlSelectWebhookDelivery)).
+		WithArgs(delivery.DeliveryID).
+		WillReturnRows(sqlDeliveryRows)
+	sqlDBMock.ExpectExec(sqlUpdate).
+		WithArgs(WebhookDeliveryStatusPending, delivery.ZoneID, delivery.DeliveryID, WebhookDeliveryStatusDead).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	tx, _ := sqlDB.Begin()
+	dbOutDelivery, err := repository.RequeueWebhookDelivery(tx, delivery.ZoneID, delivery.DeliveryID)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(WebhookDeliveryStatusPending, dbOutDelivery.Status, "delivery status is not correct")
+
+	_, err = repository.RequeueWebhookDelivery(tx, delivery.ZoneID, delivery.DeliveryID)
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUpdateConflict, err), "error should be errclientupdateconflict")
+
+	assert.Nil(sqlDBMock.ExpectationsWereMet(), "there were unfulfilled expectations")
+}
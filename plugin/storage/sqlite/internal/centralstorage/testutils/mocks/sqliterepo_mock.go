@@ -57,8 +57,8 @@ func (m *MockSqliteRepo) DeleteZone(tx *sql.Tx, zoneID int64) (*azirepos.Zone, e
 }
 
 // FetchZones fetches zones.
-func (m *MockSqliteRepo) FetchZones(db *sqlx.DB, page int32, pageSize int32, filterID *int64, filterName *string) ([]azirepos.Zone, error) {
-	args := m.Called(db, page, pageSize, filterID, filterName)
+func (m *MockSqliteRepo) FetchZones(db *sqlx.DB, page int32, pageSize int32, filterID *int64, filterName *string, options *azirepos.FetchOptions) ([]azirepos.Zone, error) {
+	args := m.Called(db, page, pageSize, filterID, filterName, options)
 	var r0 []azirepos.Zone
 	if val, ok := args.Get(0).([]azirepos.Zone); ok {
 		r0 = val
@@ -87,8 +87,8 @@ func (m *MockSqliteRepo) DeleteIdentitySource(tx *sql.Tx, zoneID int64, identity
 }
 
 // FetchIdentitySources fetches identity sources.
-func (m *MockSqliteRepo) FetchIdentitySources(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.IdentitySource, error) {
-	args := m.Called(db, page, pageSize, zoneID, filterID, filterName)
+func (m *MockSqliteRepo) FetchIdentitySources(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, options *azirepos.FetchOptions) ([]azirepos.IdentitySource, error) {
+	args := m.Called(db, page, pageSize, zoneID, filterID, filterName, options)
 	var r0 []azirepos.IdentitySource
 	if val, ok := args.Get(0).([]azirepos.IdentitySource); ok {
 		r0 = val
@@ -106,6 +106,16 @@ func (m *MockSqliteRepo) UpsertIdentity(tx *sql.Tx, isCreate bool, identity *azi
 	return r0, args.Error(1)
 }
 
+// ReadIdentityByName reads an identity by name.
+func (m *MockSqliteRepo) ReadIdentityByName(tx *sql.Tx, zoneID int64, name string) (*azirepos.Identity, error) {
+	args := m.Called(tx, zoneID, name)
+	var r0 *azirepos.Identity
+	if val, ok := args.Get(0).(*azirepos.Identity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
 // DeleteIdentity deletes an identity.
 func (m *MockSqliteRepo) DeleteIdentity(tx *sql.Tx, zoneID int64, identityID string) (*azirepos.Identity, error) {
 	args := m.Called(tx, zoneID, identityID)
@@ -117,8 +127,8 @@ func (m *MockSqliteRepo) DeleteIdentity(tx *sql.Tx, zoneID int64, identityID str
 }
 
 // FetchIdentities fetches identities.
-func (m *MockSqliteRepo) FetchIdentities(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.Identity, error) {
-	args := m.Called(db, page, pageSize, zoneID, filterID, filterName)
+func (m *MockSqliteRepo) FetchIdentities(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, filterIdentitySourceID *string, filterKind *int16, options *azirepos.FetchOptions) ([]azirepos.Identity, error) {
+	args := m.Called(db, page, pageSize, zoneID, filterID, filterName, filterIdentitySourceID, filterKind, options)
 	var r0 []azirepos.Identity
 	if val, ok := args.Get(0).([]azirepos.Identity); ok {
 		r0 = val
@@ -126,6 +136,16 @@ func (m *MockSqliteRepo) FetchIdentities(db *sqlx.DB, page int32, pageSize int32
 	return r0, args.Error(1)
 }
 
+// CountIdentities counts the identities matching the filters.
+func (m *MockSqliteRepo) CountIdentities(db *sqlx.DB, zoneID int64, filterID *string, filterName *string, filterIdentitySourceID *string, filterKind *int16, options *azirepos.FetchOptions) (int64, error) {
+	args := m.Called(db, zoneID, filterID, filterName, filterIdentitySourceID, filterKind, options)
+	var r0 int64
+	if val, ok := args.Get(0).(int64); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
 // UpsertTenant creates or updates an tenant.
 func (m *MockSqliteRepo) UpsertTenant(tx *sql.Tx, isCreate bool, tenant *azirepos.Tenant) (*azirepos.Tenant, error) {
 	args := m.Called(tx, isCreate, tenant)
@@ -136,6 +156,16 @@ func (m *MockSqliteRepo) UpsertTenant(tx *sql.Tx, isCreate bool, tenant *azirepo
 	return r0, args.Error(1)
 }
 
+// ReadTenantByName reads a tenant by name.
+func (m *MockSqliteRepo) ReadTenantByName(tx *sql.Tx, zoneID int64, name string) (*azirepos.Tenant, error) {
+	args := m.Called(tx, zoneID, name)
+	var r0 *azirepos.Tenant
+	if val, ok := args.Get(0).(*azirepos.Tenant); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
 // DeleteTenant deletes an tenant.
 func (m *MockSqliteRepo) DeleteTenant(tx *sql.Tx, zoneID int64, tenantID string) (*azirepos.Tenant, error) {
 	args := m.Called(tx, zoneID, tenantID)
@@ -147,8 +177,8 @@ func (m *MockSqliteRepo) DeleteTenant(tx *sql.Tx, zoneID int64, tenantID string)
 }
 
 // FetchTenants fetches tenants.
-func (m *MockSqliteRepo) FetchTenants(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.Tenant, error) {
-	args := m.Called(db, page, pageSize, zoneID, filterID, filterName)
+func (m *MockSqliteRepo) FetchTenants(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, options *azirepos.FetchOptions) ([]azirepos.Tenant, error) {
+	args := m.Called(db, page, pageSize, zoneID, filterID, filterName, options)
 	var r0 []azirepos.Tenant
 	if val, ok := args.Get(0).([]azirepos.Tenant); ok {
 		r0 = val
@@ -169,7 +199,37 @@ func (m *MockSqliteRepo) UpsertLedger(tx *sql.Tx, isCreate bool, ledger *azirepo
 // UpsertLedger creates or updates a ledger.
 func (m *MockSqliteRepo) UpdateLedgerRef(tx *sql.Tx, zoneID int64, ledgerID, currentRef, newRef string) error {
 	args := m.Called(tx, zoneID, ledgerID, currentRef, newRef)
-	return args.Error(1)
+	return args.Error(0)
+}
+
+// UpdateLedgerCandidateRef updates the ledger candidate ref.
+func (m *MockSqliteRepo) UpdateLedgerCandidateRef(tx *sql.Tx, zoneID int64, ledgerID, candidateRef string) (*azirepos.Ledger, error) {
+	args := m.Called(tx, zoneID, ledgerID, candidateRef)
+	var r0 *azirepos.Ledger
+	if val, ok := args.Get(0).(*azirepos.Ledger); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// PromoteLedgerCandidateRef promotes the ledger candidate ref to the ledger ref.
+func (m *MockSqliteRepo) PromoteLedgerCandidateRef(tx *sql.Tx, zoneID int64, ledgerID string) (*azirepos.Ledger, error) {
+	args := m.Called(tx, zoneID, ledgerID)
+	var r0 *azirepos.Ledger
+	if val, ok := args.Get(0).(*azirepos.Ledger); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// UpdateLedgerRequiredApprovals updates the ledger required approvals.
+func (m *MockSqliteRepo) UpdateLedgerRequiredApprovals(tx *sql.Tx, zoneID int64, ledgerID string, requiredApprovals int32) (*azirepos.Ledger, error) {
+	args := m.Called(tx, zoneID, ledgerID, requiredApprovals)
+	var r0 *azirepos.Ledger
+	if val, ok := args.Get(0).(*azirepos.Ledger); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
 }
 
 // DeleteLedger deletes a ledger.
@@ -183,8 +243,8 @@ func (m *MockSqliteRepo) DeleteLedger(tx *sql.Tx, zoneID int64, ledgerID string)
 }
 
 // FetchLedgers fetches ledgers.
-func (m *MockSqliteRepo) FetchLedgers(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.Ledger, error) {
-	args := m.Called(db, page, pageSize, zoneID, filterID, filterName)
+func (m *MockSqliteRepo) FetchLedgers(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string, options *azirepos.FetchOptions) ([]azirepos.Ledger, error) {
+	args := m.Called(db, page, pageSize, zoneID, filterID, filterName, options)
 	var r0 []azirepos.Ledger
 	if val, ok := args.Get(0).([]azirepos.Ledger); ok {
 		r0 = val
@@ -192,6 +252,242 @@ func (m *MockSqliteRepo) FetchLedgers(db *sqlx.DB, page int32, pageSize int32, z
 	return r0, args.Error(1)
 }
 
+// UpsertEntity creates or updates an entity.
+func (m *MockSqliteRepo) UpsertEntity(tx *sql.Tx, isCreate bool, entity *azirepos.Entity) (*azirepos.Entity, error) {
+	args := m.Called(tx, isCreate, entity)
+	var r0 *azirepos.Entity
+	if val, ok := args.Get(0).(*azirepos.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// UpsertEntities creates or replaces a set of entities.
+func (m *MockSqliteRepo) UpsertEntities(tx *sql.Tx, entities []azirepos.Entity) ([]azirepos.Entity, error) {
+	args := m.Called(tx, entities)
+	var r0 []azirepos.Entity
+	if val, ok := args.Get(0).([]azirepos.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// DeleteEntity deletes an entity.
+func (m *MockSqliteRepo) DeleteEntity(tx *sql.Tx, zoneID int64, entityType, entityID string) (*azirepos.Entity, error) {
+	args := m.Called(tx, zoneID, entityType, entityID)
+	var r0 *azirepos.Entity
+	if val, ok := args.Get(0).(*azirepos.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchEntities fetches entities.
+func (m *MockSqliteRepo) FetchEntities(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterType *string, filterID *string) ([]azirepos.Entity, error) {
+	args := m.Called(db, page, pageSize, zoneID, filterType, filterID)
+	var r0 []azirepos.Entity
+	if val, ok := args.Get(0).([]azirepos.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchEntitiesByUIDs fetches the entities matching the uids.
+func (m *MockSqliteRepo) FetchEntitiesByUIDs(db *sqlx.DB, zoneID int64, uids []azirepos.EntityUID) ([]azirepos.Entity, error) {
+	args := m.Called(db, zoneID, uids)
+	var r0 []azirepos.Entity
+	if val, ok := args.Get(0).([]azirepos.Entity); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// CreateTemplateLink creates a template link.
+func (m *MockSqliteRepo) CreateTemplateLink(tx *sql.Tx, link *azirepos.TemplateLink) (*azirepos.TemplateLink, error) {
+	args := m.Called(tx, link)
+	var r0 *azirepos.TemplateLink
+	if val, ok := args.Get(0).(*azirepos.TemplateLink); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// DeleteTemplateLink deletes a template link.
+func (m *MockSqliteRepo) DeleteTemplateLink(tx *sql.Tx, zoneID int64, ledgerID string, linkID string) (*azirepos.TemplateLink, error) {
+	args := m.Called(tx, zoneID, ledgerID, linkID)
+	var r0 *azirepos.TemplateLink
+	if val, ok := args.Get(0).(*azirepos.TemplateLink); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchTemplateLinks fetches the template links of a ledger.
+func (m *MockSqliteRepo) FetchTemplateLinks(db *sqlx.DB, page int32, pageSize int32, zoneID int64, ledgerID string, filterTemplateID *string, filterLinkID *string) ([]azirepos.TemplateLink, error) {
+	args := m.Called(db, page, pageSize, zoneID, ledgerID, filterTemplateID, filterLinkID)
+	var r0 []azirepos.TemplateLink
+	if val, ok := args.Get(0).([]azirepos.TemplateLink); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchAllTemplateLinks fetches all the template links of a ledger.
+func (m *MockSqliteRepo) FetchAllTemplateLinks(db *sqlx.DB, zoneID int64, ledgerID string) ([]azirepos.TemplateLink, error) {
+	args := m.Called(db, zoneID, ledgerID)
+	var r0 []azirepos.TemplateLink
+	if val, ok := args.Get(0).([]azirepos.TemplateLink); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchTemplateLinksVersion fetches the version of the template links of a ledger.
+func (m *MockSqliteRepo) FetchTemplateLinksVersion(db *sqlx.DB, zoneID int64, ledgerID string) (string, error) {
+	args := m.Called(db, zoneID, ledgerID)
+	return args.String(0), args.Error(1)
+}
+
+// CreateChangeRequest creates a change request.
+func (m *MockSqliteRepo) CreateChangeRequest(tx *sql.Tx, changeRequest *azirepos.ChangeRequest) (*azirepos.ChangeRequest, error) {
+	args := m.Called(tx, changeRequest)
+	var r0 *azirepos.ChangeRequest
+	if val, ok := args.Get(0).(*azirepos.ChangeRequest); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// ReadChangeRequest reads a change request.
+func (m *MockSqliteRepo) ReadChangeRequest(tx *sql.Tx, zoneID int64, changeRequestID string) (*azirepos.ChangeRequest, error) {
+	args := m.Called(tx, zoneID, changeRequestID)
+	var r0 *azirepos.ChangeRequest
+	if val, ok := args.Get(0).(*azirepos.ChangeRequest); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// UpdateChangeRequestStatus updates the status of a change request.
+func (m *MockSqliteRepo) UpdateChangeRequestStatus(tx *sql.Tx, zoneID int64, changeRequestID string, currentStatus string, newStatus string) (*azirepos.ChangeRequest, error) {
+	args := m.Called(tx, zoneID, changeRequestID, currentStatus, newStatus)
+	var r0 *azirepos.ChangeRequest
+	if val, ok := args.Get(0).(*azirepos.ChangeRequest); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// CreateChangeRequestReview creates a change request review.
+func (m *MockSqliteRepo) CreateChangeRequestReview(tx *sql.Tx, review *azirepos.ChangeRequestReview) (*azirepos.ChangeRequestReview, error) {
+	args := m.Called(tx, review)
+	var r0 *azirepos.ChangeRequestReview
+	if val, ok := args.Get(0).(*azirepos.ChangeRequestReview); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// CountChangeRequestApprovals counts the approvals of a change request.
+func (m *MockSqliteRepo) CountChangeRequestApprovals(tx *sql.Tx, zoneID int64, changeRequestID string) (int32, error) {
+	args := m.Called(tx, zoneID, changeRequestID)
+	var r0 int32
+	if val, ok := args.Get(0).(int32); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchChangeRequests fetches the change requests of a ledger.
+func (m *MockSqliteRepo) FetchChangeRequests(db *sqlx.DB, page int32, pageSize int32, zoneID int64, ledgerID string, filterID *string, filterStatus *string) ([]azirepos.ChangeRequest, error) {
+	args := m.Called(db, page, pageSize, zoneID, ledgerID, filterID, filterStatus)
+	var r0 []azirepos.ChangeRequest
+	if val, ok := args.Get(0).([]azirepos.ChangeRequest); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchChangeRequestReviews fetches the reviews of a change request.
+func (m *MockSqliteRepo) FetchChangeRequestReviews(db *sqlx.DB, zoneID int64, changeRequestID string) ([]azirepos.ChangeRequestReview, error) {
+	args := m.Called(db, zoneID, changeRequestID)
+	var r0 []azirepos.ChangeRequestReview
+	if val, ok := args.Get(0).([]azirepos.ChangeRequestReview); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// UpsertWebhook creates or updates a webhook.
+func (m *MockSqliteRepo) UpsertWebhook(tx *sql.Tx, isCreate bool, webhook *azirepos.Webhook) (*azirepos.Webhook, error) {
+	args := m.Called(tx, isCreate, webhook)
+	var r0 *azirepos.Webhook
+	if val, ok := args.Get(0).(*azirepos.Webhook); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// DeleteWebhook deletes a webhook.
+func (m *MockSqliteRepo) DeleteWebhook(tx *sql.Tx, zoneID int64, webhookID string) (*azirepos.Webhook, error) {
+	args := m.Called(tx, zoneID, webhookID)
+	var r0 *azirepos.Webhook
+	if val, ok := args.Get(0).(*azirepos.Webhook); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchWebhooks fetches webhooks.
+func (m *MockSqliteRepo) FetchWebhooks(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterID *string, filterName *string) ([]azirepos.Webhook, error) {
+	args := m.Called(db, page, pageSize, zoneID, filterID, filterName)
+	var r0 []azirepos.Webhook
+	if val, ok := args.Get(0).([]azirepos.Webhook); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchWebhookDeliveries fetches webhook deliveries.
+func (m *MockSqliteRepo) FetchWebhookDeliveries(db *sqlx.DB, page int32, pageSize int32, zoneID int64, filterWebhookID *string, filterStatus *string) ([]azirepos.WebhookDelivery, error) {
+	args := m.Called(db, page, pageSize, zoneID, filterWebhookID, filterStatus)
+	var r0 []azirepos.WebhookDelivery
+	if val, ok := args.Get(0).([]azirepos.WebhookDelivery); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// FetchDueWebhookDeliveries fetches the pending webhook deliveries which are due.
+func (m *MockSqliteRepo) FetchDueWebhookDeliveries(db *sqlx.DB, limit int32) ([]azirepos.WebhookDispatch, error) {
+	args := m.Called(db, limit)
+	var r0 []azirepos.WebhookDispatch
+	if val, ok := args.Get(0).([]azirepos.WebhookDispatch); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// RecordWebhookDeliveryAttempt records the outcome of a webhook delivery attempt.
+func (m *MockSqliteRepo) RecordWebhookDeliveryAttempt(tx *sql.Tx, deliveryID int64, status string, statusCode int32, lastError string, retryDelaySeconds int64) (*azirepos.WebhookDelivery, error) {
+	args := m.Called(tx, deliveryID, status, statusCode, lastError, retryDelaySeconds)
+	var r0 *azirepos.WebhookDelivery
+	if val, ok := args.Get(0).(*azirepos.WebhookDelivery); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// RequeueWebhookDelivery requeues a dead webhook delivery.
+func (m *MockSqliteRepo) RequeueWebhookDelivery(tx *sql.Tx, zoneID int64, deliveryID int64) (*azirepos.WebhookDelivery, error) {
+	args := m.Called(tx, zoneID, deliveryID)
+	var r0 *azirepos.WebhookDelivery
+	if val, ok := args.Get(0).(*azirepos.WebhookDelivery); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
 // UpsertKeyValue creates or updates a key-value pair.
 func (m *MockSqliteRepo) UpsertKeyValue(tx *sql.Tx, keyValue *azirepos.KeyValue) (*azirepos.KeyValue, error) {
 	args := m.Called(tx, keyValue)
@@ -211,3 +507,23 @@ func (m *MockSqliteRepo) GetKeyValue(db *sqlx.DB, zoneID int64, key string) (*az
 	}
 	return r0, args.Error(1)
 }
+
+// ReadKeyValue reads a key value within a transaction.
+func (m *MockSqliteRepo) ReadKeyValue(tx *sql.Tx, zoneID int64, key string) (*azirepos.KeyValue, error) {
+	args := m.Called(tx, zoneID, key)
+	var r0 *azirepos.KeyValue
+	if val, ok := args.Get(0).(*azirepos.KeyValue); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
+
+// GetMigrationVersion retrieves the version of the latest applied migration.
+func (m *MockSqliteRepo) GetMigrationVersion(db *sqlx.DB) (int64, error) {
+	args := m.Called(db)
+	var r0 int64
+	if val, ok := args.Get(0).(int64); ok {
+		r0 = val
+	}
+	return r0, args.Error(1)
+}
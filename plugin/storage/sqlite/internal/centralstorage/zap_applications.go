@@ -149,7 +149,11 @@ func (s SQLiteCentralStorageZAP) FetchZones(page int32, pageSize int32, fields m
 		}
 		filterName = &zoneName
 	}
-	dbZones, err := s.sqlRepo.FetchZones(db, page, pageSize, filterID, filterName)
+	options, err := buildFetchOptions(fields, true)
+	if err != nil {
+		return nil, err
+	}
+	dbZones, err := s.sqlRepo.FetchZones(db, page, pageSize, filterID, filterName, options)
 	if err != nil {
 		return nil, err
 	}
@@ -333,7 +333,7 @@ func TestFetchZoneWithErrors(t *testing.T) {
 	{ // Test with invalid zone name
 		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
 		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
-		mockSQLRepo.On("FetchZones", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
+		mockSQLRepo.On("FetchZones", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
 		outZones, err := storage.FetchZones(1, 100, nil)
 		assert.Nil(outZones, "zones should be nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrServerGeneric, err), "error should be errservergeneric")
@@ -362,7 +362,7 @@ func TestFetchZoneWithSuccess(t *testing.T) {
 	}
 
 	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
-	mockSQLRepo.On("FetchZones", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutZones, nil)
+	mockSQLRepo.On("FetchZones", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutZones, nil)
 
 	outZones, err := storage.FetchZones(1, 100, map[string]any{azmodelzap.FieldZoneZoneID: int64(506074038324), azmodelzap.FieldZoneName: "rent-a-car2"})
 	assert.Nil(err, "error should be nil")
@@ -0,0 +1,154 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"database/sql"
+	"fmt"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azvalidators "github.com/permguard/permguard/pkg/core/validators"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// bulkRowFailure returns the outcome of a failed row.
+func bulkRowFailure(result azmodelzap.BulkRowResult, err error) azmodelzap.BulkRowResult {
+	result.Status = azmodelzap.BulkRowStatusFailed
+	result.Error = err.Error()
+	return result
+}
+
+// bulkRowConflict resolves the conflict of a row with an existing item, it returns true if the row has to update the existing item.
+func bulkRowConflict(result *azmodelzap.BulkRowResult, policy string, entity string, existingID string) bool {
+	result.ID = existingID
+	switch policy {
+	case azmodelzap.BulkConflictUpdate:
+		return true
+	case azmodelzap.BulkConflictSkip:
+		result.Status = azmodelzap.BulkRowStatusSkipped
+	default:
+		*result = bulkRowFailure(*result, azerrors.WrapSystemErrorWithMessage(azerrors.ErrStorageConstraintUnique, fmt.Sprintf("unique constraint failed - %s %s already exists", entity, result.Name)))
+	}
+	return false
+}
+
+// runBulkUpsert applies the rows of a bulk upsert in a single transaction, the transaction is committed only if no row failed and it is not a dry run.
+func (s SQLiteCentralStorageZAP) runBulkUpsert(options *azmodelzap.BulkUpsertOptions, count int, applyRow func(tx *sql.Tx, index int) azmodelzap.BulkRowResult) (*azmodelzap.BulkUpsertReport, error) {
+	if options == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - bulk options are nil")
+	}
+	if err := azvalidators.ValidateCodeID("zone", options.ZoneID); err != nil {
+		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - zone id is not valid (id: %d)", options.ZoneID), err)
+	}
+	if !azmodelzap.IsValidBulkConflictPolicy(options.ConflictPolicy) {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - conflict policy %s is not valid", options.ConflictPolicy))
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	report := &azmodelzap.BulkUpsertReport{DryRun: options.DryRun, Rows: []azmodelzap.BulkRowResult{}}
+	for i := 0; i < count; i++ {
+		report.AddRow(applyRow(tx, i))
+	}
+	if options.DryRun || report.Failed > 0 {
+		tx.Rollback()
+		return report, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	report.Committed = true
+	return report, nil
+}
+
+// UpsertIdentities creates or updates identities in bulk.
+func (s SQLiteCentralStorageZAP) UpsertIdentities(options *azmodelzap.BulkUpsertOptions, identities []azmodelzap.Identity) (*azmodelzap.BulkUpsertReport, error) {
+	return s.runBulkUpsert(options, len(identities), func(tx *sql.Tx, index int) azmodelzap.BulkRowResult {
+		identity := identities[index]
+		result := azmodelzap.BulkRowResult{Row: int32(index + 1), Name: identity.Name}
+		kind, err := azirepos.ConvertIdentityKindToID(identity.Kind)
+		if err != nil {
+			return bulkRowFailure(result, err)
+		}
+		dbInIdentity := &azirepos.Identity{
+			ZoneID:           options.ZoneID,
+			IdentitySourceID: identity.IdentitySourceID,
+			Kind:             kind,
+			Name:             identity.Name,
+		}
+		dbExistingIdentity, err := s.sqlRepo.ReadIdentityByName(tx, options.ZoneID, identity.Name)
+		if err != nil && !azerrors.AreErrorsEqual(azerrors.ErrClientNotFound, err) {
+			return bulkRowFailure(result, err)
+		}
+		isCreate := dbExistingIdentity == nil
+		if !isCreate {
+			if !bulkRowConflict(&result, options.ConflictPolicy, "identity", dbExistingIdentity.IdentityID) {
+				return result
+			}
+			dbInIdentity.IdentityID = dbExistingIdentity.IdentityID
+		}
+		dbOutIdentity, err := s.sqlRepo.UpsertIdentity(tx, isCreate, dbInIdentity)
+		if err != nil {
+			return bulkRowFailure(result, err)
+		}
+		result.ID = dbOutIdentity.IdentityID
+		result.Status = azmodelzap.BulkRowStatusCreated
+		if !isCreate {
+			result.Status = azmodelzap.BulkRowStatusUpdated
+		}
+		return result
+	})
+}
+
+// UpsertTenants creates or updates tenants in bulk.
+func (s SQLiteCentralStorageZAP) UpsertTenants(options *azmodelzap.BulkUpsertOptions, tenants []azmodelzap.Tenant) (*azmodelzap.BulkUpsertReport, error) {
+	return s.runBulkUpsert(options, len(tenants), func(tx *sql.Tx, index int) azmodelzap.BulkRowResult {
+		tenant := tenants[index]
+		result := azmodelzap.BulkRowResult{Row: int32(index + 1), Name: tenant.Name}
+		dbInTenant := &azirepos.Tenant{
+			ZoneID: options.ZoneID,
+			Name:   tenant.Name,
+		}
+		dbExistingTenant, err := s.sqlRepo.ReadTenantByName(tx, options.ZoneID, tenant.Name)
+		if err != nil && !azerrors.AreErrorsEqual(azerrors.ErrClientNotFound, err) {
+			return bulkRowFailure(result, err)
+		}
+		isCreate := dbExistingTenant == nil
+		if !isCreate {
+			if !bulkRowConflict(&result, options.ConflictPolicy, "tenant", dbExistingTenant.TenantID) {
+				return result
+			}
+			dbInTenant.TenantID = dbExistingTenant.TenantID
+		}
+		dbOutTenant, err := s.sqlRepo.UpsertTenant(tx, isCreate, dbInTenant)
+		if err != nil {
+			return bulkRowFailure(result, err)
+		}
+		result.ID = dbOutTenant.TenantID
+		result.Status = azmodelzap.BulkRowStatusCreated
+		if !isCreate {
+			result.Status = azmodelzap.BulkRowStatusUpdated
+		}
+		return result
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// TestUpsertTenantsWithInvalidOptions tests the UpsertTenants function with invalid options.
+func TestUpsertTenantsWithInvalidOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []*azmodelzap.BulkUpsertOptions{
+		nil,
+		{ZoneID: 0, ConflictPolicy: azmodelzap.BulkConflictSkip},
+		{ZoneID: 232956849236, ConflictPolicy: "merge"},
+	}
+	for _, options := range tests {
+		storage, _, _, _, _, _, _ := createSQLiteZAPCentralStorageWithMocks()
+		report, err := storage.UpsertTenants(options, []azmodelzap.Tenant{{Name: "rent-a-car"}})
+		assert.Nil(report, "report should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+}
+
+// TestUpsertTenantsWithConflictPolicies tests the UpsertTenants function with the conflict policies.
+func TestUpsertTenantsWithConflictPolicies(t *testing.T) {
+	assert := assert.New(t)
+
+	existingTenant := &azirepos.Tenant{ZoneID: 232956849236, TenantID: azirepos.GenerateUUID(), Name: "rent-a-car1"}
+	createdTenant := &azirepos.Tenant{ZoneID: 232956849236, TenantID: azirepos.GenerateUUID(), Name: "rent-a-car2"}
+	tests := map[string]struct {
+		Status    string
+		Committed bool
+	}{
+		azmodelzap.BulkConflictSkip:   {Status: azmodelzap.BulkRowStatusSkipped, Committed: true},
+		azmodelzap.BulkConflictUpdate: {Status: azmodelzap.BulkRowStatusUpdated, Committed: true},
+		azmodelzap.BulkConflictFail:   {Status: azmodelzap.BulkRowStatusFailed, Committed: false},
+	}
+	for policy, test := range tests {
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLiteZAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLDB.ExpectBegin()
+		mockSQLRepo.On("ReadTenantByName", mock.Anything, existingTenant.ZoneID, existingTenant.Name).Return(existingTenant, nil)
+		mockSQLRepo.On("ReadTenantByName", mock.Anything, existingTenant.ZoneID, createdTenant.Name).Return(nil, azerrors.ErrClientNotFound)
+		mockSQLRepo.On("UpsertTenant", mock.Anything, false, mock.Anything).Return(existingTenant, nil)
+		mockSQLRepo.On("UpsertTenant", mock.Anything, true, mock.Anything).Return(createdTenant, nil)
+		if test.Committed {
+			mockSQLDB.ExpectCommit()
+		} else {
+			mockSQLDB.ExpectRollback()
+		}
+
+		options := &azmodelzap.BulkUpsertOptions{ZoneID: existingTenant.ZoneID, ConflictPolicy: policy}
+		report, err := storage.UpsertTenants(options, []azmodelzap.Tenant{{Name: existingTenant.Name}, {Name: createdTenant.Name}})
+		assert.Nil(err, "error should be nil")
+		assert.Equal(test.Committed, report.Committed, "committed is not correct")
+		assert.Len(report.Rows, 2, "rows len should be correct")
+		assert.Equal(test.Status, report.Rows[0].Status, "status is not correct")
+		assert.Equal(existingTenant.TenantID, report.Rows[0].ID, "id is not correct")
+		assert.Equal(azmodelzap.BulkRowStatusCreated, report.Rows[1].Status, "status is not correct")
+		assert.Equal(int32(1), report.Created, "created count is not correct")
+		assert.Nil(mockSQLDB.ExpectationsWereMet(), "there were unfulfilled expectations")
+	}
+}
+
+// TestUpsertIdentitiesWithDryRun tests the UpsertIdentities function with a dry run and row errors.
+func TestUpsertIdentitiesWithDryRun(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLiteZAPCentralStorageWithMocks()
+	createdIdentity := &azirepos.Identity{ZoneID: 232956849236, IdentityID: azirepos.GenerateUUID(), Name: "nicola.gallo"}
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLDB.ExpectBegin()
+	mockSQLRepo.On("ReadIdentityByName", mock.Anything, createdIdentity.ZoneID, createdIdentity.Name).Return(nil, azerrors.ErrClientNotFound)
+	mockSQLRepo.On("ReadIdentityByName", mock.Anything, createdIdentity.ZoneID, "broken").Return(nil, errors.New("broken"))
+	mockSQLRepo.On("UpsertIdentity", mock.Anything, true, mock.Anything).Return(createdIdentity, nil)
+	mockSQLDB.ExpectRollback()
+
+	options := &azmodelzap.BulkUpsertOptions{ZoneID: createdIdentity.ZoneID, DryRun: true, ConflictPolicy: azmodelzap.BulkConflictFail}
+	identities := []azmodelzap.Identity{
+		{Name: createdIdentity.Name, Kind: "user"},
+		{Name: "robot", Kind: "robot"},
+		{Name: "broken", Kind: "user"},
+	}
+	report, err := storage.UpsertIdentities(options, identities)
+	assert.Nil(err, "error should be nil")
+	assert.True(report.DryRun, "dry run should be true")
+	assert.False(report.Committed, "committed should be false")
+	assert.Equal(int32(1), report.Created, "created count is not correct")
+	assert.Equal(int32(2), report.Failed, "failed count is not correct")
+	assert.Equal(createdIdentity.IdentityID, report.Rows[0].ID, "id is not correct")
+	assert.Equal(int32(2), report.Rows[1].Row, "row is not correct")
+	assert.NotEmpty(report.Rows[1].Error, "error should not be empty")
+	assert.Nil(mockSQLDB.ExpectationsWereMet(), "there were unfulfilled expectations")
+}
@@ -118,15 +118,17 @@ func (s SQLiteCentralStorageZAP) DeleteIdentity(zoneID int64, identityID string)
 	return mapIdentityToAgentIdentity(dbOutIdentity)
 }
 
-// FetchIdentities returns all identities.
-func (s SQLiteCentralStorageZAP) FetchIdentities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelzap.Identity, error) {
-	if page <= 0 || pageSize <= 0 || pageSize > s.config.GetDataFetchMaxPageSize() {
-		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
-	}
-	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
-	if err != nil {
-		return nil, err
-	}
+// identityFilters are the filters of the identities.
+type identityFilters struct {
+	id               *string
+	name             *string
+	identitySourceID *string
+	kind             *int16
+	options          *azirepos.FetchOptions
+}
+
+// buildIdentityFilters builds the filters of the identities from the fields.
+func buildIdentityFilters(fields map[string]any) (*identityFilters, error) {
 	var filterID *string
 	if _, ok := fields[azmodelzap.FieldIdentityIdentityID]; ok {
 		identityID, ok := fields[azmodelzap.FieldIdentityIdentityID].(string)
@@ -143,7 +145,47 @@ func (s SQLiteCentralStorageZAP) FetchIdentities(page int32, pageSize int32, zon
 		}
 		filterName = &identityName
 	}
-	dbIdentities, err := s.sqlRepo.FetchIdentities(db, page, pageSize, zoneID, filterID, filterName)
+	var filterIdentitySourceID *string
+	if _, ok := fields[azmodelzap.FieldIdentityIdentitySourceID]; ok {
+		identitySourceID, ok := fields[azmodelzap.FieldIdentityIdentitySourceID].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - identity source id is not valid (identity source id: %s)", identitySourceID))
+		}
+		filterIdentitySourceID = &identitySourceID
+	}
+	var filterKind *int16
+	if _, ok := fields[azmodelzap.FieldIdentityKind]; ok {
+		identityKind, ok := fields[azmodelzap.FieldIdentityKind].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - identity kind is not valid (identity kind: %s)", identityKind))
+		}
+		kind, err := azirepos.ConvertIdentityKindToID(identityKind)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - identity kind %s is not valid", identityKind), err)
+		}
+		filterKind = &kind
+	}
+	options, err := buildFetchOptions(fields, false)
+	if err != nil {
+		return nil, err
+	}
+	return &identityFilters{id: filterID, name: filterName, identitySourceID: filterIdentitySourceID, kind: filterKind, options: options}, nil
+}
+
+// FetchIdentities returns all identities.
+func (s SQLiteCentralStorageZAP) FetchIdentities(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelzap.Identity, error) {
+	if page <= 0 || pageSize <= 0 || pageSize > s.config.GetDataFetchMaxPageSize() {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := buildIdentityFilters(fields)
+	if err != nil {
+		return nil, err
+	}
+	dbIdentities, err := s.sqlRepo.FetchIdentities(db, page, pageSize, zoneID, filters.id, filters.name, filters.identitySourceID, filters.kind, filters.options)
 	if err != nil {
 		return nil, err
 	}
@@ -157,3 +199,16 @@ func (s SQLiteCentralStorageZAP) FetchIdentities(page int32, pageSize int32, zon
 	}
 	return identities, nil
 }
+
+// CountIdentities counts the identities matching the fields.
+func (s SQLiteCentralStorageZAP) CountIdentities(zoneID int64, fields map[string]any) (int64, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return 0, err
+	}
+	filters, err := buildIdentityFilters(fields)
+	if err != nil {
+		return 0, err
+	}
+	return s.sqlRepo.CountIdentities(db, zoneID, filters.id, filters.name, filters.identitySourceID, filters.kind, filters.options)
+}
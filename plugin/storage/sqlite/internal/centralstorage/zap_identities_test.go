@@ -326,10 +326,26 @@ func TestFetchIdentityWithErrors(t *testing.T) {
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
 	}
 
+	{ // Test with invalid identity source id
+		storage, mockStorageCtx, mockConnector, _, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		outIdentities, err := storage.FetchIdentities(1, 100, 232956849236, map[string]any{azmodelzap.FieldIdentityIdentitySourceID: 2})
+		assert.Nil(outIdentities, "identities should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with invalid identity kind
+		storage, mockStorageCtx, mockConnector, _, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		outIdentities, err := storage.FetchIdentities(1, 100, 232956849236, map[string]any{azmodelzap.FieldIdentityKind: "robot"})
+		assert.Nil(outIdentities, "identities should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
 	{ // Test with server error
 		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
 		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
-		mockSQLRepo.On("FetchIdentities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
+		mockSQLRepo.On("FetchIdentities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
 		outIdentities, err := storage.FetchIdentities(1, 100, 232956849236, nil)
 		assert.Nil(outIdentities, "identities should be nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrServerGeneric, err), "error should be errservergeneric")
@@ -364,7 +380,7 @@ func TestFetchIdentityWithSuccess(t *testing.T) {
 	}
 
 	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
-	mockSQLRepo.On("FetchIdentities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutIdentities, nil)
+	mockSQLRepo.On("FetchIdentities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutIdentities, nil)
 
 	outIdentities, err := storage.FetchIdentities(1, 100, 232956849236, map[string]any{azmodelzap.FieldIdentityIdentityID: azirepos.GenerateUUID(), azmodelzap.FieldIdentityName: "rent-a-car2"})
 	assert.Nil(err, "error should be nil")
@@ -377,3 +393,34 @@ func TestFetchIdentityWithSuccess(t *testing.T) {
 		assert.Equal(dbOutIdentities[i].UpdatedAt, outIdentity.UpdatedAt, "updated at should be equal")
 	}
 }
+
+// TestCountIdentities tests the CountIdentities function.
+func TestCountIdentities(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with invalid identity kind
+		storage, mockStorageCtx, mockConnector, _, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		_, err := storage.CountIdentities(232956849236, map[string]any{azmodelzap.FieldIdentityKind: "robot"})
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with server error
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLRepo.On("CountIdentities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
+		_, err := storage.CountIdentities(232956849236, nil)
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrServerGeneric, err), "error should be errservergeneric")
+	}
+
+	{ // Test with success
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		identitySourceID := azirepos.GenerateUUID()
+		kind := int16(1)
+		mockSQLRepo.On("CountIdentities", mock.Anything, int64(232956849236), (*string)(nil), (*string)(nil), &identitySourceID, &kind, mock.Anything).Return(int64(3), nil)
+		count, err := storage.CountIdentities(232956849236, map[string]any{azmodelzap.FieldIdentityIdentitySourceID: identitySourceID, azmodelzap.FieldIdentityKind: "user"})
+		assert.Nil(err, "error should be nil")
+		assert.Equal(int64(3), count, "count should be equal")
+	}
+}
@@ -131,7 +131,11 @@ func (s SQLiteCentralStorageZAP) FetchIdentitySources(page int32, pageSize int32
 		}
 		filterName = &identitySourceName
 	}
-	dbIdentitySources, err := s.sqlRepo.FetchIdentitySources(db, page, pageSize, zoneID, filterID, filterName)
+	options, err := buildFetchOptions(fields, false)
+	if err != nil {
+		return nil, err
+	}
+	dbIdentitySources, err := s.sqlRepo.FetchIdentitySources(db, page, pageSize, zoneID, filterID, filterName, options)
 	if err != nil {
 		return nil, err
 	}
@@ -315,7 +315,7 @@ func TestFetchIdentitySourceWithErrors(t *testing.T) {
 	{ // Test with server error
 		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
 		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
-		mockSQLRepo.On("FetchIdentitySources", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
+		mockSQLRepo.On("FetchIdentitySources", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
 		outIdentitySources, err := storage.FetchIdentitySources(1, 100, 232956849236, nil)
 		assert.Nil(outIdentitySources, "identity sources should be nil")
 		assert.True(azerrors.AreErrorsEqual(azerrors.ErrServerGeneric, err), "error should be errservergeneric")
@@ -346,7 +346,7 @@ func TestFetchIdentitySourceWithSuccess(t *testing.T) {
 	}
 
 	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
-	mockSQLRepo.On("FetchIdentitySources", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutIdentitySources, nil)
+	mockSQLRepo.On("FetchIdentitySources", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutIdentitySources, nil)
 
 	outIdentitySources, err := storage.FetchIdentitySources(1, 100, 232956849236, map[string]any{azmodelzap.FieldIdentitySourceIdentitySourceID: azirepos.GenerateUUID(), azmodelzap.FieldIdentitySourceName: "rent-a-car2"})
 	assert.Nil(err, "error should be nil")
@@ -131,7 +131,11 @@ func (s SQLiteCentralStorageZAP) FetchTenants(page int32, pageSize int32, zoneID
 		}
 		filterName = &tenantName
 	}
-	dbTenants, err := s.sqlRepo.FetchTenants(db, page, pageSize, zoneID, filterID, filterName)
+	options, err := buildFetchOptions(fields, false)
+	if err != nil {
+		return nil, err
+	}
+	dbTenants, err := s.sqlRepo.FetchTenants(db, page, pageSize, zoneID, filterID, filterName, options)
 	if err != nil {
 		return nil, err
 	}
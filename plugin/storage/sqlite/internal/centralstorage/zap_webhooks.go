@@ -0,0 +1,282 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+const (
+	// webhookSecretBytes is the number of random bytes of a generated webhook secret.
+	webhookSecretBytes = 32
+)
+
+// generateWebhookSecret generates a random webhook secret.
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrServerGeneric, "failed to generate the webhook secret", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// joinWebhookEvents validates and joins the webhook events.
+func joinWebhookEvents(events []string) (string, error) {
+	if len(events) == 0 {
+		return azmodelzap.WebhookEventAll, nil
+	}
+	for _, event := range events {
+		if !azmodelzap.IsValidWebhookEvent(event) {
+			return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook event %s is not valid", event))
+		}
+		if event == azmodelzap.WebhookEventAll && len(events) > 1 {
+			return "", azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - webhook event * cannot be combined with other events")
+		}
+	}
+	return strings.Join(events, ","), nil
+}
+
+// upsertWebhook creates or updates a webhook.
+func (s SQLiteCentralStorageZAP) upsertWebhook(isCreate bool, webhook *azmodelzap.Webhook) (*azmodelzap.Webhook, error) {
+	if webhook == nil {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, "invalid client input - webhook is nil")
+	}
+	events, err := joinWebhookEvents(webhook.Events)
+	if err != nil {
+		return nil, err
+	}
+	secret := webhook.Secret
+	if isCreate && secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbInWebhook := &azirepos.Webhook{
+		WebhookID: webhook.WebhookID,
+		ZoneID:    webhook.ZoneID,
+		Name:      webhook.Name,
+		URL:       webhook.URL,
+		Secret:    secret,
+		Events:    events,
+	}
+	dbOutWebhook, err := s.sqlRepo.UpsertWebhook(tx, isCreate, dbInWebhook)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapWebhookToAgentWebhook(dbOutWebhook, isCreate)
+}
+
+// CreateWebhook creates a new webhook, the secret is returned only on creation.
+func (s SQLiteCentralStorageZAP) CreateWebhook(webhook *azmodelzap.Webhook) (*azmodelzap.Webhook, error) {
+	return s.upsertWebhook(true, webhook)
+}
+
+// UpdateWebhook updates a webhook, an empty secret keeps the current one.
+func (s SQLiteCentralStorageZAP) UpdateWebhook(webhook *azmodelzap.Webhook) (*azmodelzap.Webhook, error) {
+	return s.upsertWebhook(false, webhook)
+}
+
+// DeleteWebhook deletes a webhook.
+func (s SQLiteCentralStorageZAP) DeleteWebhook(zoneID int64, webhookID string) (*azmodelzap.Webhook, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutWebhook, err := s.sqlRepo.DeleteWebhook(tx, zoneID, webhookID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapWebhookToAgentWebhook(dbOutWebhook, false)
+}
+
+// FetchWebhooks returns all webhooks.
+func (s SQLiteCentralStorageZAP) FetchWebhooks(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelzap.Webhook, error) {
+	if page <= 0 || pageSize <= 0 || pageSize > s.config.GetDataFetchMaxPageSize() {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, err
+	}
+	var filterID *string
+	if _, ok := fields[azmodelzap.FieldWebhookWebhookID]; ok {
+		webhookID, ok := fields[azmodelzap.FieldWebhookWebhookID].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook id is not valid (webhook id: %s)", webhookID))
+		}
+		filterID = &webhookID
+	}
+	var filterName *string
+	if _, ok := fields[azmodelzap.FieldWebhookName]; ok {
+		webhookName, ok := fields[azmodelzap.FieldWebhookName].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook name is not valid (webhook name: %s)", webhookName))
+		}
+		filterName = &webhookName
+	}
+	dbWebhooks, err := s.sqlRepo.FetchWebhooks(db, page, pageSize, zoneID, filterID, filterName)
+	if err != nil {
+		return nil, err
+	}
+	webhooks := make([]azmodelzap.Webhook, len(dbWebhooks))
+	for i, w := range dbWebhooks {
+		webhook, err := mapWebhookToAgentWebhook(&w, false)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, fmt.Sprintf("failed to convert webhook entity (%s)", azirepos.LogWebhookEntry(&w)), err)
+		}
+		webhooks[i] = *webhook
+	}
+	return webhooks, nil
+}
+
+// FetchWebhookDeliveries returns the delivery history of the webhooks.
+func (s SQLiteCentralStorageZAP) FetchWebhookDeliveries(page int32, pageSize int32, zoneID int64, fields map[string]any) ([]azmodelzap.WebhookDelivery, error) {
+	if page <= 0 || pageSize <= 0 || pageSize > s.config.GetDataFetchMaxPageSize() {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - page number %d or page size %d is not valid", page, pageSize))
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, err
+	}
+	var filterWebhookID *string
+	if _, ok := fields[azmodelzap.FieldWebhookDeliveryWebhookID]; ok {
+		webhookID, ok := fields[azmodelzap.FieldWebhookDeliveryWebhookID].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - webhook id is not valid (webhook id: %s)", webhookID))
+		}
+		filterWebhookID = &webhookID
+	}
+	var filterStatus *string
+	if _, ok := fields[azmodelzap.FieldWebhookDeliveryStatus]; ok {
+		status, ok := fields[azmodelzap.FieldWebhookDeliveryStatus].(string)
+		if !ok {
+			return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientParameter, fmt.Sprintf("invalid client input - delivery status is not valid (delivery status: %s)", status))
+		}
+		filterStatus = &status
+	}
+	dbDeliveries, err := s.sqlRepo.FetchWebhookDeliveries(db, page, pageSize, zoneID, filterWebhookID, filterStatus)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]azmodelzap.WebhookDelivery, len(dbDeliveries))
+	for i, d := range dbDeliveries {
+		delivery, err := mapWebhookDeliveryToAgentWebhookDelivery(&d)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, fmt.Sprintf("failed to convert webhook delivery entity (%s)", azirepos.LogWebhookDeliveryEntry(&d)), err)
+		}
+		deliveries[i] = *delivery
+	}
+	return deliveries, nil
+}
+
+// RequeueWebhookDelivery moves a dead webhook delivery back to the outbox.
+func (s SQLiteCentralStorageZAP) RequeueWebhookDelivery(zoneID int64, deliveryID int64) (*azmodelzap.WebhookDelivery, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutDelivery, err := s.sqlRepo.RequeueWebhookDelivery(tx, zoneID, deliveryID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapWebhookDeliveryToAgentWebhookDelivery(dbOutDelivery)
+}
+
+// FetchDueWebhookDeliveries returns the pending webhook deliveries which are due across all the zones.
+func (s SQLiteCentralStorageZAP) FetchDueWebhookDeliveries(limit int32) ([]azmodelzap.WebhookDispatch, error) {
+	if limit <= 0 || limit > s.config.GetDataFetchMaxPageSize() {
+		return nil, azerrors.WrapSystemErrorWithMessage(azerrors.ErrClientPagination, fmt.Sprintf("invalid client input - limit %d is not valid", limit))
+	}
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, err
+	}
+	dbDispatches, err := s.sqlRepo.FetchDueWebhookDeliveries(db, limit)
+	if err != nil {
+		return nil, err
+	}
+	dispatches := make([]azmodelzap.WebhookDispatch, len(dbDispatches))
+	for i, d := range dbDispatches {
+		delivery, err := mapWebhookDeliveryToAgentWebhookDelivery(&d.WebhookDelivery)
+		if err != nil {
+			return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageEntityMapping, fmt.Sprintf("failed to convert webhook delivery entity (%s)", azirepos.LogWebhookDeliveryEntry(&d.WebhookDelivery)), err)
+		}
+		dispatches[i] = azmodelzap.WebhookDispatch{
+			Delivery: *delivery,
+			URL:      d.URL,
+			Secret:   d.Secret,
+		}
+	}
+	return dispatches, nil
+}
+
+// RecordWebhookDeliveryAttempt records the outcome of a webhook delivery attempt.
+func (s SQLiteCentralStorageZAP) RecordWebhookDeliveryAttempt(deliveryID int64, status string, statusCode int32, lastError string, retryDelaySeconds int64) (*azmodelzap.WebhookDelivery, error) {
+	db, err := s.sqlExec.Connect(s.ctx, s.sqliteConnector)
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotConnect, err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotBeginTransaction, err)
+	}
+	dbOutDelivery, err := s.sqlRepo.RecordWebhookDeliveryAttempt(tx, deliveryID, status, statusCode, lastError, retryDelaySeconds)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, azirepos.WrapSqlite3Error(errorMessageCannotCommitTransaction, err)
+	}
+	return mapWebhookDeliveryToAgentWebhookDelivery(dbOutDelivery)
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"strings"
+
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// mapWebhookToAgentWebhook maps a Webhook to a model Webhook, the secret is kept only when requested.
+func mapWebhookToAgentWebhook(webhook *azirepos.Webhook, withSecret bool) (*azmodelzap.Webhook, error) {
+	agentWebhook := &azmodelzap.Webhook{
+		WebhookID: webhook.WebhookID,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+		ZoneID:    webhook.ZoneID,
+		Name:      webhook.Name,
+		URL:       webhook.URL,
+		Events:    strings.Split(webhook.Events, ","),
+	}
+	if withSecret {
+		agentWebhook.Secret = webhook.Secret
+	}
+	return agentWebhook, nil
+}
+
+// mapWebhookDeliveryToAgentWebhookDelivery maps a WebhookDelivery to a model WebhookDelivery.
+func mapWebhookDeliveryToAgentWebhookDelivery(delivery *azirepos.WebhookDelivery) (*azmodelzap.WebhookDelivery, error) {
+	return &azmodelzap.WebhookDelivery{
+		DeliveryID:     delivery.DeliveryID,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		ZoneID:         delivery.ZoneID,
+		WebhookID:      delivery.WebhookID,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+	}, nil
+}
@@ -0,0 +1,327 @@
+// Copyright 2024 Nitro Agility S.r.l.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package centralstorage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	azerrors "github.com/permguard/permguard/pkg/core/errors"
+	azmodelzap "github.com/permguard/permguard/pkg/transport/models/zap"
+	azirepos "github.com/permguard/permguard/plugin/storage/sqlite/internal/centralstorage/repositories"
+)
+
+// TestCreateWebhookWithErrors tests the CreateWebhook function with errors.
+func TestCreateWebhookWithErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with nil webhook
+		storage, _, _, _, _, _, _ := createSQLiteZAPCentralStorageWithMocks()
+		outWebhook, err := storage.CreateWebhook(nil)
+		assert.Nil(outWebhook, "webhook should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with invalid events
+		storage, _, _, _, _, _, _ := createSQLiteZAPCentralStorageWithMocks()
+		for _, events := range [][]string{{"ledger.moved"}, {azmodelzap.WebhookEventAll, azmodelzap.WebhookEventLedgerCreated}} {
+			outWebhook, err := storage.CreateWebhook(&azmodelzap.Webhook{Events: events})
+			assert.Nil(outWebhook, "webhook should be nil")
+			assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+		}
+	}
+
+	tests := map[string]struct {
+		IsCustomError bool
+		Error1        error
+	}{
+		"CONNECT-ERROR":  {IsCustomError: true, Error1: azerrors.ErrStorageGeneric},
+		"BEGIN-ERROR":    {IsCustomError: true, Error1: azerrors.ErrStorageGeneric},
+		"ROLLBACK-ERROR": {IsCustomError: false, Error1: errors.New("ROLLBACK-ERROR")},
+		"COMMIT-ERROR":   {IsCustomError: true, Error1: azerrors.ErrStorageGeneric},
+	}
+	for testcase, test := range tests {
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLiteZAPCentralStorageWithMocks()
+		switch testcase {
+		case "CONNECT-ERROR":
+			mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(nil, errors.New(testcase))
+		case "BEGIN-ERROR":
+			mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+			mockSQLDB.ExpectBegin().WillReturnError(errors.New(testcase))
+		case "ROLLBACK-ERROR":
+			mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+			mockSQLDB.ExpectBegin()
+			mockSQLRepo.On("UpsertWebhook", mock.Anything, true, mock.Anything).Return(nil, errors.New(testcase))
+		case "COMMIT-ERROR":
+			mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+			mockSQLDB.ExpectBegin()
+			mockSQLRepo.On("UpsertWebhook", mock.Anything, true, mock.Anything).Return(nil, nil)
+			mockSQLDB.ExpectCommit().WillReturnError(errors.New(testcase))
+		default:
+			assert.FailNow("Unknown testcase")
+		}
+
+		outWebhook, err := storage.CreateWebhook(&azmodelzap.Webhook{})
+		assert.Nil(outWebhook, "webhook should be nil")
+		assert.Error(err)
+		if test.IsCustomError {
+			assert.True(azerrors.AreErrorsEqual(err, test.Error1), "error should be equal")
+		} else {
+			assert.Equal(test.Error1, err, "error should be equal")
+		}
+	}
+}
+
+// TestCreateWebhookWithSuccess tests the CreateWebhook function with success.
+func TestCreateWebhookWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLiteZAPCentralStorageWithMocks()
+
+	dbOutWebhook := &azirepos.Webhook{
+		ZoneID:    232956849236,
+		WebhookID: azirepos.GenerateUUID(),
+		Name:      "deployments",
+		URL:       "https://hooks.example.com/permguard",
+		Secret:    "5e3b1f0c2a",
+		Events:    "ledger.ref_updated,ledger.deleted",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLDB.ExpectBegin()
+	mockSQLRepo.On("UpsertWebhook", mock.Anything, true, mock.MatchedBy(func(webhook *azirepos.Webhook) bool {
+		return len(webhook.Secret) == 2*webhookSecretBytes && webhook.Events == dbOutWebhook.Events
+	})).Return(dbOutWebhook, nil)
+	mockSQLDB.ExpectCommit().WillReturnError(nil)
+
+	inWebhook := &azmodelzap.Webhook{Events: []string{azmodelzap.WebhookEventLedgerRefUpdated, azmodelzap.WebhookEventLedgerDeleted}}
+	outWebhook, err := storage.CreateWebhook(inWebhook)
+	assert.Nil(err, "error should be nil")
+	assert.NotNil(outWebhook, "webhook should not be nil")
+	assert.Equal(dbOutWebhook.WebhookID, outWebhook.WebhookID, "webhook id should be equal")
+	assert.Equal(dbOutWebhook.Secret, outWebhook.Secret, "webhook secret should be returned on creation")
+	assert.Equal(inWebhook.Events, outWebhook.Events, "webhook events should be equal")
+}
+
+// TestUpdateWebhookWithSuccess tests the UpdateWebhook function with success.
+func TestUpdateWebhookWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLiteZAPCentralStorageWithMocks()
+
+	dbOutWebhook := &azirepos.Webhook{
+		ZoneID:    232956849236,
+		WebhookID: azirepos.GenerateUUID(),
+		Name:      "deployments",
+		URL:       "https://hooks.example.com/permguard",
+		Secret:    "5e3b1f0c2a",
+		Events:    azmodelzap.WebhookEventAll,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLDB.ExpectBegin()
+	mockSQLRepo.On("UpsertWebhook", mock.Anything, false, mock.MatchedBy(func(webhook *azirepos.Webhook) bool {
+		return webhook.Secret == "" && webhook.Events == azmodelzap.WebhookEventAll
+	})).Return(dbOutWebhook, nil)
+	mockSQLDB.ExpectCommit().WillReturnError(nil)
+
+	outWebhook, err := storage.UpdateWebhook(&azmodelzap.Webhook{WebhookID: dbOutWebhook.WebhookID})
+	assert.Nil(err, "error should be nil")
+	assert.Equal(dbOutWebhook.WebhookID, outWebhook.WebhookID, "webhook id should be equal")
+	assert.Empty(outWebhook.Secret, "webhook secret should not be returned on update")
+	assert.Equal([]string{azmodelzap.WebhookEventAll}, outWebhook.Events, "webhook events should be equal")
+}
+
+// TestDeleteWebhookWithSuccess tests the DeleteWebhook function with success.
+func TestDeleteWebhookWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLiteZAPCentralStorageWithMocks()
+
+	dbOutWebhook := &azirepos.Webhook{
+		ZoneID:    232956849236,
+		WebhookID: azirepos.GenerateUUID(),
+		Name:      "deployments",
+		Secret:    "5e3b1f0c2a",
+		Events:    azmodelzap.WebhookEventAll,
+	}
+
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLDB.ExpectBegin()
+	mockSQLRepo.On("DeleteWebhook", mock.Anything, dbOutWebhook.ZoneID, dbOutWebhook.WebhookID).Return(dbOutWebhook, nil)
+	mockSQLDB.ExpectCommit().WillReturnError(nil)
+
+	outWebhook, err := storage.DeleteWebhook(dbOutWebhook.ZoneID, dbOutWebhook.WebhookID)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(dbOutWebhook.WebhookID, outWebhook.WebhookID, "webhook id should be equal")
+	assert.Empty(outWebhook.Secret, "webhook secret should not be returned on delete")
+}
+
+// TestFetchWebhooksWithErrors tests the FetchWebhooks function with errors.
+func TestFetchWebhooksWithErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with invalid page
+		storage, _, _, _, _, _, _ := createSQLiteZAPCentralStorageWithMocks()
+		outWebhooks, err := storage.FetchWebhooks(0, 100, 232956849236, nil)
+		assert.Nil(outWebhooks, "webhooks should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
+	}
+
+	{ // Test with invalid webhook id
+		storage, mockStorageCtx, mockConnector, _, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		outWebhooks, err := storage.FetchWebhooks(1, 100, 232956849236, map[string]any{azmodelzap.FieldWebhookWebhookID: 232956849236})
+		assert.Nil(outWebhooks, "webhooks should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientParameter, err), "error should be errclientparameter")
+	}
+
+	{ // Test with server error
+		storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+		mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+		mockSQLRepo.On("FetchWebhooks", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, azerrors.ErrServerGeneric)
+		outWebhooks, err := storage.FetchWebhooks(1, 100, 232956849236, nil)
+		assert.Nil(outWebhooks, "webhooks should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrServerGeneric, err), "error should be errservergeneric")
+	}
+}
+
+// TestFetchWebhooksWithSuccess tests the FetchWebhooks function with success.
+func TestFetchWebhooksWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+
+	dbOutWebhooks := []azirepos.Webhook{
+		{
+			ZoneID:    232956849236,
+			WebhookID: azirepos.GenerateUUID(),
+			Name:      "deployments",
+			URL:       "https://hooks.example.com/permguard",
+			Secret:    "5e3b1f0c2a",
+			Events:    "ledger.ref_updated",
+		},
+	}
+
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLRepo.On("FetchWebhooks", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dbOutWebhooks, nil)
+
+	outWebhooks, err := storage.FetchWebhooks(1, 100, 232956849236, map[string]any{azmodelzap.FieldWebhookName: "deployments"})
+	assert.Nil(err, "error should be nil")
+	assert.Len(outWebhooks, 1, "webhooks should have one item")
+	assert.Empty(outWebhooks[0].Secret, "webhook secret should not be returned on fetch")
+}
+
+// TestFetchWebhookDeliveriesWithSuccess tests the FetchWebhookDeliveries function with success.
+func TestFetchWebhookDeliveriesWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+
+	status := azmodelzap.WebhookDeliveryStatusDead
+	dbOutDeliveries := []azirepos.WebhookDelivery{
+		{
+			DeliveryID: 7,
+			ZoneID:     232956849236,
+			WebhookID:  azirepos.GenerateUUID(),
+			Event:      azmodelzap.WebhookEventLedgerRefUpdated,
+			Status:     status,
+			Attempts:   8,
+		},
+	}
+
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLRepo.On("FetchWebhookDeliveries", mock.Anything, int32(1), int32(100), int64(232956849236), (*string)(nil), &status).Return(dbOutDeliveries, nil)
+
+	outDeliveries, err := storage.FetchWebhookDeliveries(1, 100, 232956849236, map[string]any{azmodelzap.FieldWebhookDeliveryStatus: status})
+	assert.Nil(err, "error should be nil")
+	assert.Len(outDeliveries, 1, "deliveries should have one item")
+	assert.Equal(int32(8), outDeliveries[0].Attempts, "delivery attempts should be equal")
+}
+
+// TestFetchDueWebhookDeliveriesWithSuccess tests the FetchDueWebhookDeliveries function with success.
+func TestFetchDueWebhookDeliveriesWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Test with invalid limit
+		storage, _, _, _, _, _, _ := createSQLiteZAPCentralStorageWithMocks()
+		outDispatches, err := storage.FetchDueWebhookDeliveries(0)
+		assert.Nil(outDispatches, "dispatches should be nil")
+		assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientPagination, err), "error should be errclientpagination")
+	}
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, _ := createSQLiteZAPCentralStorageWithMocks()
+
+	dbOutDispatches := []azirepos.WebhookDispatch{
+		{
+			WebhookDelivery: azirepos.WebhookDelivery{DeliveryID: 7, Event: azmodelzap.WebhookEventLedgerCreated, Status: azmodelzap.WebhookDeliveryStatusPending},
+			URL:             "https://hooks.example.com/permguard",
+			Secret:          "5e3b1f0c2a",
+		},
+	}
+
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLRepo.On("FetchDueWebhookDeliveries", mock.Anything, int32(10)).Return(dbOutDispatches, nil)
+
+	outDispatches, err := storage.FetchDueWebhookDeliveries(10)
+	assert.Nil(err, "error should be nil")
+	assert.Len(outDispatches, 1, "dispatches should have one item")
+	assert.Equal(int64(7), outDispatches[0].Delivery.DeliveryID, "delivery id should be equal")
+	assert.Equal("5e3b1f0c2a", outDispatches[0].Secret, "webhook secret should be equal")
+}
+
+// TestRecordWebhookDeliveryAttemptWithErrors tests the RecordWebhookDeliveryAttempt function with errors.
+func TestRecordWebhookDeliveryAttemptWithErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLiteZAPCentralStorageWithMocks()
+
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLDB.ExpectBegin()
+	mockSQLRepo.On("RecordWebhookDeliveryAttempt", mock.Anything, int64(7), azmodelzap.WebhookDeliveryStatusDelivered, int32(204), "", int64(0)).Return(nil, azerrors.ErrClientUpdateConflict)
+	mockSQLDB.ExpectRollback()
+
+	outDelivery, err := storage.RecordWebhookDeliveryAttempt(7, azmodelzap.WebhookDeliveryStatusDelivered, 204, "", 0)
+	assert.Nil(outDelivery, "delivery should be nil")
+	assert.True(azerrors.AreErrorsEqual(azerrors.ErrClientUpdateConflict, err), "error should be errclientupdateconflict")
+}
+
+// TestRequeueWebhookDeliveryWithSuccess tests the RequeueWebhookDelivery function with success.
+func TestRequeueWebhookDeliveryWithSuccess(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, mockStorageCtx, mockConnector, mockSQLRepo, mockSQLExec, sqlDB, mockSQLDB := createSQLiteZAPCentralStorageWithMocks()
+
+	dbOutDelivery := &azirepos.WebhookDelivery{DeliveryID: 7, ZoneID: 232956849236, Status: azmodelzap.WebhookDeliveryStatusPending}
+
+	mockSQLExec.On("Connect", mockStorageCtx, mockConnector).Return(sqlDB, nil)
+	mockSQLDB.ExpectBegin()
+	mockSQLRepo.On("RequeueWebhookDelivery", mock.Anything, int64(232956849236), int64(7)).Return(dbOutDelivery, nil)
+	mockSQLDB.ExpectCommit().WillReturnError(nil)
+
+	outDelivery, err := storage.RequeueWebhookDelivery(232956849236, 7)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(azmodelzap.WebhookDeliveryStatusPending, outDelivery.Status, "delivery status should be equal")
+}
@@ -29,6 +29,7 @@ import (
 	"go.uber.org/zap"
 
 	azstorage "github.com/permguard/permguard/pkg/agents/storage"
+	aztelemetry "github.com/permguard/permguard/pkg/agents/telemetry"
 	azoptions "github.com/permguard/permguard/pkg/cli/options"
 	azerrors "github.com/permguard/permguard/pkg/core/errors"
 )
@@ -127,6 +128,9 @@ func (c *SQLiteConnection) Connect(logger *zap.Logger, ctx *azstorage.StorageCon
 		return nil, azerrors.WrapHandledSysErrorWithMessage(azerrors.ErrStorageGeneric, "cannot connect to sqlite", err)
 	}
 	db.Exec("PRAGMA foreign_keys = ON;")
+	if err := aztelemetry.RegisterDBStats(db.DB, c.config.GetDBName()); err != nil {
+		logger.Warn("Cannot register the sqlite connection stats", zap.Error(err))
+	}
 	c.db = db
 	return c.db, nil
 }
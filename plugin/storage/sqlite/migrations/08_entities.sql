@@ -0,0 +1,33 @@
+-- Copyright 2024 Nitro Agility S.r.l.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+
+-- +goose Up
+CREATE TABLE entities (
+    zone_id INTEGER NOT NULL REFERENCES zones(zone_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    entity_type TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    updated_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    attributes TEXT NOT NULL DEFAULT '{}',
+    parents TEXT NOT NULL DEFAULT '[]',
+    PRIMARY KEY (zone_id, entity_type, entity_id)
+);
+
+CREATE INDEX entities_zoneid_entityid_idx ON entities(zone_id, entity_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS entities;
@@ -0,0 +1,35 @@
+-- Copyright 2024 Nitro Agility S.r.l.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+
+-- +goose Up
+CREATE TABLE template_links (
+    ledger_id TEXT NOT NULL REFERENCES ledgers(ledger_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    link_id TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    updated_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    template_id TEXT NOT NULL,
+    slots TEXT NOT NULL DEFAULT '{}',
+	-- REFERENCES
+	zone_id INTEGER NOT NULL REFERENCES zones(zone_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY (ledger_id, link_id)
+);
+
+CREATE INDEX template_links_zoneid_ledgerid_idx ON template_links(zone_id, ledger_id);
+CREATE INDEX template_links_templateid_idx ON template_links(template_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS template_links;
@@ -0,0 +1,54 @@
+-- Copyright 2024 Nitro Agility S.r.l.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+
+
+-- +goose Up
+ALTER TABLE ledgers ADD COLUMN required_approvals INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE change_requests (
+    change_request_id TEXT PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    updated_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    ledger_id TEXT NOT NULL REFERENCES ledgers(ledger_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    base_ref TEXT NOT NULL,
+    commit_id TEXT NOT NULL,
+    author TEXT NOT NULL,
+    plan TEXT NOT NULL DEFAULT '[]',
+    status TEXT NOT NULL DEFAULT 'pending',
+    required_approvals INTEGER NOT NULL,
+	-- REFERENCES
+	zone_id INTEGER NOT NULL REFERENCES zones(zone_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX change_requests_zoneid_ledgerid_idx ON change_requests(zone_id, ledger_id);
+CREATE INDEX change_requests_status_idx ON change_requests(status);
+
+CREATE TABLE change_request_reviews (
+    change_request_id TEXT NOT NULL REFERENCES change_requests(change_request_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    reviewer TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    decision TEXT NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+	-- REFERENCES
+	zone_id INTEGER NOT NULL REFERENCES zones(zone_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY (change_request_id, reviewer)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS change_request_reviews;
+DROP TABLE IF EXISTS change_requests;
+ALTER TABLE ledgers DROP COLUMN required_approvals;
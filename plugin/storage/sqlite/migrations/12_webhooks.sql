@@ -0,0 +1,131 @@
+-- Copyright 2024 Nitro Agility S.r.l.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+CREATE TABLE webhooks (
+    webhook_id TEXT NOT NULL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    updated_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    name TEXT NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    events TEXT NOT NULL DEFAULT '*',
+	-- REFERENCES
+	zone_id INTEGER NOT NULL REFERENCES zones(zone_id) ON UPDATE CASCADE ON DELETE CASCADE,
+	-- CONSTRAINTS
+	CONSTRAINT webhooks_zoneid_name_key UNIQUE (zone_id, name)
+);
+
+CREATE INDEX webhooks_zoneid_idx ON webhooks(zone_id);
+
+-- The outbox of the webhook deliveries, a delivery is created for each change matching the events of a webhook.
+CREATE TABLE webhook_deliveries (
+    delivery_id INTEGER NOT NULL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    updated_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    webhook_id TEXT NOT NULL REFERENCES webhooks(webhook_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    change_stream_id INTEGER NOT NULL,
+    event TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP DEFAULT(STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')) NOT NULL,
+    last_status_code INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+	-- REFERENCES
+	zone_id INTEGER NOT NULL REFERENCES zones(zone_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX webhook_deliveries_status_nextattemptat_idx ON webhook_deliveries(status, next_attempt_at);
+CREATE INDEX webhook_deliveries_zoneid_webhookid_idx ON webhook_deliveries(zone_id, webhook_id);
+
+-- The update of the `ledgers` table is tracked only when the ref does not move, the moves of the ref are tracked as
+-- REF_UPDATE, and the writes of the candidate ref alone are not tracked.
+DROP TRIGGER IF EXISTS ledgers_change_streams_after_update;
+
+-- +goose StatementBegin
+CREATE TRIGGER ledgers_change_streams_after_update
+AFTER UPDATE ON ledgers
+FOR EACH ROW
+BEGIN
+    UPDATE ledgers SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE ledger_id = OLD.ledger_id;
+    INSERT INTO change_streams (change_entity, change_type, change_entity_id, zone_id, payload)
+		SELECT 'LEDGER', 'UPDATE', NEW.ledger_id, NEW.zone_id,
+				'{"ledger_id": "' || NEW.ledger_id || '", "created_at": "' || NEW.created_at ||
+				'", "updated_at": "' || NEW.updated_at || '", "name": "' || NEW.name || '", "kind": "' || NEW.kind ||
+				'", "zone_id": ' || NEW.zone_id || ', "ref": "' || NEW.ref || '"}'
+		WHERE OLD.ref = NEW.ref
+			AND (OLD.name <> NEW.name OR OLD.kind <> NEW.kind OR OLD.required_approvals <> NEW.required_approvals);
+END;
+-- +goose StatementEnd
+
+-- Trigger to track the moves of the ref in the `ledgers` table
+-- +goose StatementBegin
+CREATE TRIGGER ledgers_change_streams_after_ref_update
+AFTER UPDATE OF ref ON ledgers
+FOR EACH ROW
+WHEN OLD.ref <> NEW.ref
+BEGIN
+    INSERT INTO change_streams (change_entity, change_type, change_entity_id, zone_id, payload)
+		VALUES ('LEDGER', 'REF_UPDATE', NEW.ledger_id, NEW.zone_id,
+				'{"ledger_id": "' || NEW.ledger_id || '", "name": "' || NEW.name ||
+				'", "zone_id": ' || NEW.zone_id || ', "old_ref": "' || OLD.ref || '", "new_ref": "' || NEW.ref || '"}');
+END;
+-- +goose StatementEnd
+
+-- Trigger to enqueue the webhook deliveries of the changes in the `change_streams` table
+-- +goose StatementBegin
+CREATE TRIGGER change_streams_webhook_deliveries_after_insert
+AFTER INSERT ON change_streams
+FOR EACH ROW
+WHEN NEW.change_entity IN ('LEDGER', 'IDENTITY', 'TENANT')
+BEGIN
+    INSERT INTO webhook_deliveries (webhook_id, change_stream_id, event, payload, zone_id)
+		SELECT webhooks.webhook_id, NEW.change_stream_id, changes.event, NEW.payload, webhooks.zone_id
+		FROM webhooks, (
+			SELECT CASE
+				WHEN NEW.change_type = 'REF_UPDATE' THEN 'ledger.ref_updated'
+				WHEN NEW.change_type = 'INSERT' THEN LOWER(NEW.change_entity) || '.created'
+				WHEN NEW.change_type = 'UPDATE' THEN LOWER(NEW.change_entity) || '.updated'
+				ELSE LOWER(NEW.change_entity) || '.deleted'
+			END AS event
+		) AS changes
+		WHERE webhooks.zone_id = NEW.zone_id
+			AND (webhooks.events = '*' OR INSTR(',' || webhooks.events || ',', ',' || changes.event || ',') > 0);
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS change_streams_webhook_deliveries_after_insert;
+DROP TRIGGER IF EXISTS ledgers_change_streams_after_ref_update;
+DROP TRIGGER IF EXISTS ledgers_change_streams_after_update;
+
+-- +goose StatementBegin
+CREATE TRIGGER ledgers_change_streams_after_update
+AFTER UPDATE ON ledgers
+FOR EACH ROW
+BEGIN
+    UPDATE ledgers SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE ledger_id = OLD.ledger_id;
+    INSERT INTO change_streams (change_entity, change_type, change_entity_id, zone_id, payload)
+		VALUES ('LEDGER', 'UPDATE', NEW.ledger_id, NEW.zone_id,
+				'{"ledger_id": "' || NEW.ledger_id || '", "created_at": "' || NEW.created_at ||
+				'", "updated_at": "' || NEW.updated_at || '", "name": "' || NEW.name || '", "kind": "' || NEW.kind ||
+				'", "zone_id": ' || NEW.zone_id || ', "ref": "' || NEW.ref || '"}');
+END;
+-- +goose StatementEnd
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
@@ -20,6 +20,7 @@ import (
 	"database/sql"
 	"embed"
 	"flag"
+	"io/fs"
 	"path/filepath"
 	"strings"
 
@@ -41,6 +42,23 @@ const (
 //go:embed migrations/*.sql
 var embedMigrations embed.FS
 
+// latestMigrationVersion returns the version of the latest embedded migration.
+func latestMigrationVersion() (int64, error) {
+	entries, err := fs.ReadDir(embedMigrations, "migrations")
+	if err != nil {
+		return 0, err
+	}
+	var latest int64
+	for _, entry := range entries {
+		version, err := goose.NumericComponent(entry.Name())
+		if err != nil {
+			return 0, err
+		}
+		latest = max(latest, version)
+	}
+	return latest, nil
+}
+
 // SQLiteStorageProvisioner is the storage provisioner for SQLite.
 type SQLiteStorageProvisioner struct {
 	debug    bool
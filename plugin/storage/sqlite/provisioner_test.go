@@ -17,9 +17,13 @@
 package sqlite
 
 import (
+	"database/sql"
 	"flag"
+	"path/filepath"
 	"testing"
 
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/pressly/goose/v3"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,3 +36,53 @@ func TestSQLiteStorageProvisioner(t *testing.T) {
 	assert.Nil(err, "error should be nil")
 	assert.Nil(storageProvisioner.AddFlags(&flag.FlagSet{}), "error should be nil")
 }
+
+// TestLatestMigrationVersion tests the latest migration version.
+func TestLatestMigrationVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	version, err := latestMigrationVersion()
+	assert.Nil(err, "error should be nil")
+	assert.Equal(int64(12), version, "version should be the one of the latest migration")
+}
+
+// TestWebhookDeliveriesOfLedgerUpdates tests that each update of a ledger enqueues at most one webhook delivery.
+func TestWebhookDeliveriesOfLedgerUpdates(t *testing.T) {
+	assert := assert.New(t)
+
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "permguard.db"))
+	assert.Nil(err, "error should be nil")
+	defer db.Close()
+	goose.SetBaseFS(embedMigrations)
+	assert.Nil(goose.SetDialect("sqlite3"), "error should be nil")
+	assert.Nil(goose.Up(db, "migrations"), "error should be nil")
+
+	countDeliveries := func(event string) int {
+		var count int
+		err := db.QueryRow("SELECT COUNT(*) FROM webhook_deliveries WHERE event = ?", event).Scan(&count)
+		assert.Nil(err, "error should be nil")
+		return count
+	}
+	exec := func(query string, args ...any) {
+		_, err := db.Exec(query, args...)
+		assert.Nil(err, "error should be nil")
+	}
+
+	exec("INSERT INTO zones (zone_id, name) VALUES (?, ?)", 273165098782, "magicfarmacia-dev")
+	exec("INSERT INTO webhooks (webhook_id, name, url, secret, zone_id) VALUES (?, ?, ?, ?, ?)", "w1", "audit", "https://example.com/hooks", "secret", 273165098782)
+	exec("INSERT INTO ledgers (ledger_id, name, kind, zone_id) VALUES (?, ?, ?, ?)", "l1", "magicfarmacia", 1, 273165098782)
+	assert.Equal(1, countDeliveries("ledger.created"), "the creation should enqueue one delivery")
+
+	exec("UPDATE ledgers SET candidate_ref = ? WHERE ledger_id = ?", "a294ba66f45afd23f8bda3892728601bb509989a80dbb54d7b513dacb8099d76", "l1")
+	assert.Equal(0, countDeliveries("ledger.updated"), "the write of the candidate ref should not enqueue deliveries")
+
+	exec("UPDATE ledgers SET ref = ? WHERE ledger_id = ?", "4ad3bb52786751f4b6f9839953fe3dcc2278c66648f0d0193f98088b7e4d0c1d", "l1")
+	assert.Equal(1, countDeliveries("ledger.ref_updated"), "the move of the ref should enqueue one delivery")
+	assert.Equal(0, countDeliveries("ledger.updated"), "the move of the ref should not enqueue an update delivery")
+
+	exec("UPDATE ledgers SET required_approvals = ? WHERE ledger_id = ?", 2, "l1")
+	assert.Equal(1, countDeliveries("ledger.updated"), "the update of the ledger should enqueue one delivery")
+	assert.Equal(1, countDeliveries("ledger.ref_updated"), "the update of the ledger should not enqueue a ref delivery")
+
+	assert.Nil(goose.DownTo(db, "migrations", 0), "error should be nil")
+}